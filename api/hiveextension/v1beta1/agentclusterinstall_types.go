@@ -478,11 +478,16 @@ type DiskEncryption struct {
 	EnableOn *string `json:"enableOn,omitempty"`
 
 	// The disk encryption mode to use.
-	// +kubebuilder:validation:Enum=tpmv2;tang
+	// +kubebuilder:validation:Enum=tpmv2;tang;threshold
 	Mode *string `json:"mode,omitempty"`
 
 	// JSON-formatted string containing additional information regarding tang's configuration
 	TangServers string `json:"tangServers,omitempty" gorm:"type:text"`
+
+	// JSON-formatted string containing the unlock policy of the threshold mode, the number of pins required
+	// to unlock the disk, whether the TPM is one of them, and the number of Tang servers required to unlock
+	// the Tang pin
+	ThresholdSpec string `json:"thresholdSpec,omitempty" gorm:"type:text"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
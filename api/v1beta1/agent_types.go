@@ -92,6 +92,32 @@ const (
 
 	CleanupCondition    conditionsv1.ConditionType = "Cleanup"
 	CleanupFailedReason string                     = "CleanupFailed"
+
+	NodeRemovalCondition               conditionsv1.ConditionType = "NodeRemoval"
+	NodeRemovalDrainingReason          string                     = "Draining"
+	NodeRemovalDrainingMsg             string                     = "The node is being cordoned and drained"
+	NodeRemovalDrainTimedOutReason     string                     = "DrainTimedOut"
+	NodeRemovalDrainTimedOutMsg        string                     = "The node did not drain within the drain timeout, removing it anyway"
+	NodeRemovalReclaimingReason        string                     = "Reclaiming"
+	NodeRemovalReclaimingMsg           string                     = "The node was drained; The host is being reclaimed and will reboot into discovery"
+	NodeRemovalPendingUserActionReason string                     = "PendingUserAction"
+	NodeRemovalPendingUserActionMsg    string                     = "The host could not be reclaimed; Pending host reboot from infraenv image"
+	NodeRemovedReason                  string                     = "NodeRemoved"
+	NodeRemovedMsg                     string                     = "The node was removed from the cluster and the host rebooted into discovery"
+	NodeRemovalFailedReason            string                     = "NodeRemovalFailed"
+	NodeRemovalFailedMsg               string                     = "Failed to remove the node:"
+	NodeRemovalNotInstalledReason      string                     = "AgentNotInstalled"
+	NodeRemovalNotInstalledMsg         string                     = "The agent isn't installed in a cluster, there is no node to remove"
+
+	NodeHealthyCondition    conditionsv1.ConditionType = "NodeHealthy"
+	NodeReadyReason         string                     = "NodeReady"
+	NodeReadyMsg            string                     = "The node of the agent is ready"
+	NodeNotReadyReason      string                     = "NodeNotReady"
+	NodeNotReadyMsg         string                     = "The node of the agent is not ready:"
+	NodeNotFoundReason      string                     = "NodeNotFound"
+	NodeNotFoundMsg         string                     = "The node of the agent was not found in the cluster"
+	NodeHealthUnknownReason string                     = "NodeHealthUnknown"
+	NodeHealthUnknownMsg    string                     = "The node of the agent could not be checked:"
 )
 
 type HostMemory struct {
@@ -205,6 +231,17 @@ type AgentSpec struct {
 	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
 	// FencingCredentialsSecretRef is a name of a secret in the Agent's namespace that contains fencing credentials
 	FencingCredentialsSecretRef string `json:"fencingCredentialsSecretRef,omitempty"`
+	// NodeRemoval requests the removal of the node of the agent from its installed cluster. The node is cordoned and
+	// drained, then the agent is unbound so that the host is reclaimed and reboots into discovery, and the node is
+	// deleted. The progress is reported in the NodeRemoval condition.
+	// +optional
+	NodeRemoval *AgentNodeRemoval `json:"nodeRemoval,omitempty"`
+}
+
+type AgentNodeRemoval struct {
+	// DrainTimeout is how long to wait for the node to drain before removing it anyway. Defaults to 10 minutes.
+	// +optional
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
 }
 
 type IgnitionEndpointTokenReference struct {
@@ -269,6 +306,15 @@ type AgentStatus struct {
 	// CSRStatus tracks the status of CSR approvals for the agent
 	CSRStatus CSRStatus `json:"csrStatus,omitempty"`
 
+	// NodeRemovalStartTime is the time the removal of the node requested in the spec started
+	// +optional
+	NodeRemovalStartTime *metav1.Time `json:"nodeRemovalStartTime,omitempty"`
+
+	// NodeHealth is the status of the node of the agent in its cluster after the installation. It is only synced
+	// when the agent node health controller is enabled.
+	// +optional
+	NodeHealth *AgentNodeHealth `json:"nodeHealth,omitempty"`
+
 	// Kind corresponds to the same field in the model Host. It indicates the type of cluster the host is
 	// being installed to; either an existing cluster (day-2) or a new cluster (day-1).
 	// Value is one of: "AddToExistingClusterHost" (day-2) or "Host" (day-1)
@@ -276,6 +322,36 @@ type AgentStatus struct {
 	Kind string `json:"kind,omitempty"`
 }
 
+// AgentNodeHealth is the status of the node of an installed agent, as reported by its cluster
+type AgentNodeHealth struct {
+	// NodeName is the name of the node of the agent
+	NodeName string `json:"nodeName,omitempty"`
+	// Ready is true when the Ready condition of the node is true
+	Ready bool `json:"ready"`
+	// KubeletVersion is the version of the kubelet of the node
+	// +optional
+	KubeletVersion string `json:"kubeletVersion,omitempty"`
+	// Conditions are the conditions of the node
+	// +optional
+	Conditions []AgentNodeCondition `json:"conditions,omitempty"`
+	// LastSyncTime is the last time the status of the node was synced from the cluster
+	LastSyncTime metav1.Time `json:"lastSyncTime"`
+}
+
+// AgentNodeCondition is a condition of the node of an agent
+type AgentNodeCondition struct {
+	// Type of the node condition, e.g. Ready or MemoryPressure
+	Type string `json:"type"`
+	// Status of the condition, one of True, False or Unknown
+	Status string `json:"status"`
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
 type DebugInfo struct {
 	// EventsURL specifies an HTTP/S URL that contains events which occured during the cluster installation process
 	// +optional
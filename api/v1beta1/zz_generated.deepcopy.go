@@ -26,6 +26,7 @@ import (
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -359,6 +360,65 @@ func (in *AgentServiceConfigStatus) DeepCopy() *AgentServiceConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentNodeCondition) DeepCopyInto(out *AgentNodeCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentNodeCondition.
+func (in *AgentNodeCondition) DeepCopy() *AgentNodeCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentNodeCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentNodeHealth) DeepCopyInto(out *AgentNodeHealth) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]AgentNodeCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastSyncTime.DeepCopyInto(&out.LastSyncTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentNodeHealth.
+func (in *AgentNodeHealth) DeepCopy() *AgentNodeHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentNodeHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentNodeRemoval) DeepCopyInto(out *AgentNodeRemoval) {
+	*out = *in
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentNodeRemoval.
+func (in *AgentNodeRemoval) DeepCopy() *AgentNodeRemoval {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentNodeRemoval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentSpec) DeepCopyInto(out *AgentSpec) {
 	*out = *in
@@ -386,6 +446,11 @@ func (in *AgentSpec) DeepCopyInto(out *AgentSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.NodeRemoval != nil {
+		in, out := &in.NodeRemoval, &out.NodeRemoval
+		*out = new(AgentNodeRemoval)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentSpec.
@@ -438,6 +503,15 @@ func (in *AgentStatus) DeepCopyInto(out *AgentStatus) {
 		**out = **in
 	}
 	in.CSRStatus.DeepCopyInto(&out.CSRStatus)
+	if in.NodeRemovalStartTime != nil {
+		in, out := &in.NodeRemovalStartTime, &out.NodeRemovalStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NodeHealth != nil {
+		in, out := &in.NodeHealth, &out.NodeHealth
+		*out = new(AgentNodeHealth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentStatus.
@@ -13,11 +13,13 @@ import (
 	rtclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/openshift/assisted-service/client/audit"
 	"github.com/openshift/assisted-service/client/events"
 	"github.com/openshift/assisted-service/client/installer"
 	"github.com/openshift/assisted-service/client/managed_domains"
 	"github.com/openshift/assisted-service/client/manifests"
 	"github.com/openshift/assisted-service/client/operators"
+	"github.com/openshift/assisted-service/client/service_accounts"
 	"github.com/openshift/assisted-service/client/versions"
 )
 
@@ -63,22 +65,26 @@ func New(c Config) *AssistedInstall {
 
 	cli := new(AssistedInstall)
 	cli.Transport = transport
+	cli.Audit = audit.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Events = events.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Installer = installer.New(transport, strfmt.Default, c.AuthInfo)
 	cli.ManagedDomains = managed_domains.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Manifests = manifests.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Operators = operators.New(transport, strfmt.Default, c.AuthInfo)
+	cli.ServiceAccounts = service_accounts.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Versions = versions.New(transport, strfmt.Default, c.AuthInfo)
 	return cli
 }
 
 // AssistedInstall is a client for assisted install
 type AssistedInstall struct {
-	Events         *events.Client
-	Installer      *installer.Client
-	ManagedDomains *managed_domains.Client
-	Manifests      *manifests.Client
-	Operators      *operators.Client
-	Versions       *versions.Client
-	Transport      runtime.ClientTransport
+	Audit           *audit.Client
+	Events          *events.Client
+	Installer       *installer.Client
+	ManagedDomains  *managed_domains.Client
+	Manifests       *manifests.Client
+	Operators       *operators.Client
+	ServiceAccounts *service_accounts.Client
+	Versions        *versions.Client
+	Transport       runtime.ClientTransport
 }
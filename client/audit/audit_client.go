@@ -0,0 +1,66 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the audit client
+type API interface {
+	/*
+	   V2ListAuditEvents Retrieves the audit log of the API requests that changed resources, the most recent first.*/
+	V2ListAuditEvents(ctx context.Context, params *V2ListAuditEventsParams) (*V2ListAuditEventsOK, error)
+}
+
+// New creates a new audit API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for audit API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2ListAuditEvents Retrieves the audit log of the API requests that changed resources, the most recent first.
+*/
+func (a *Client) V2ListAuditEvents(ctx context.Context, params *V2ListAuditEventsParams) (*V2ListAuditEventsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListAuditEvents",
+		Method:             "GET",
+		PathPattern:        "/v2/audit-events",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListAuditEventsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListAuditEventsOK), nil
+
+}
@@ -0,0 +1,385 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2ListAuditEventsParams creates a new V2ListAuditEventsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListAuditEventsParams() *V2ListAuditEventsParams {
+	return &V2ListAuditEventsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListAuditEventsParamsWithTimeout creates a new V2ListAuditEventsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListAuditEventsParamsWithTimeout(timeout time.Duration) *V2ListAuditEventsParams {
+	return &V2ListAuditEventsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListAuditEventsParamsWithContext creates a new V2ListAuditEventsParams object
+// with the ability to set a context for a request.
+func NewV2ListAuditEventsParamsWithContext(ctx context.Context) *V2ListAuditEventsParams {
+	return &V2ListAuditEventsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListAuditEventsParamsWithHTTPClient creates a new V2ListAuditEventsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListAuditEventsParamsWithHTTPClient(client *http.Client) *V2ListAuditEventsParams {
+	return &V2ListAuditEventsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListAuditEventsParams contains all the parameters to send to the API endpoint
+
+	for the v2 list audit events operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListAuditEventsParams struct {
+
+	/* Limit.
+
+	   The maximum number of records to retrieve.
+
+	   Default: 100
+	*/
+	Limit *int64
+
+	/* Offset.
+
+	   Number of records to skip before starting to return the records.
+	*/
+	Offset *int64
+
+	/* OrgID.
+
+	   Only the requests of the users of this organization are retrieved.
+	*/
+	OrgID *string
+
+	/* ResourceID.
+
+	   Only the requests on this resource are retrieved.
+	*/
+	ResourceID *string
+
+	/* Since.
+
+	   Only the requests received at or after this time are retrieved.
+
+	   Format: date-time
+	*/
+	Since *strfmt.DateTime
+
+	/* Until.
+
+	   Only the requests received before this time are retrieved.
+
+	   Format: date-time
+	*/
+	Until *strfmt.DateTime
+
+	/* Username.
+
+	   Only the requests of this user are retrieved.
+	*/
+	Username *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list audit events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListAuditEventsParams) WithDefaults() *V2ListAuditEventsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list audit events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListAuditEventsParams) SetDefaults() {
+	var (
+		limitDefault = int64(100)
+	)
+
+	val := V2ListAuditEventsParams{
+		Limit: &limitDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the v2 list audit events params
+func (o *V2ListAuditEventsParams) WithTimeout(timeout time.Duration) *V2ListAuditEventsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list audit events params
+func (o *V2ListAuditEventsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list audit events params
+func (o *V2ListAuditEventsParams) WithContext(ctx context.Context) *V2ListAuditEventsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list audit events params
+func (o *V2ListAuditEventsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list audit events params
+func (o *V2ListAuditEventsParams) WithHTTPClient(client *http.Client) *V2ListAuditEventsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list audit events params
+func (o *V2ListAuditEventsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the v2 list audit events params
+func (o *V2ListAuditEventsParams) WithLimit(limit *int64) *V2ListAuditEventsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the v2 list audit events params
+func (o *V2ListAuditEventsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the v2 list audit events params
+func (o *V2ListAuditEventsParams) WithOffset(offset *int64) *V2ListAuditEventsParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the v2 list audit events params
+func (o *V2ListAuditEventsParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithOrgID adds the orgID to the v2 list audit events params
+func (o *V2ListAuditEventsParams) WithOrgID(orgID *string) *V2ListAuditEventsParams {
+	o.SetOrgID(orgID)
+	return o
+}
+
+// SetOrgID adds the orgId to the v2 list audit events params
+func (o *V2ListAuditEventsParams) SetOrgID(orgID *string) {
+	o.OrgID = orgID
+}
+
+// WithResourceID adds the resourceID to the v2 list audit events params
+func (o *V2ListAuditEventsParams) WithResourceID(resourceID *string) *V2ListAuditEventsParams {
+	o.SetResourceID(resourceID)
+	return o
+}
+
+// SetResourceID adds the resourceId to the v2 list audit events params
+func (o *V2ListAuditEventsParams) SetResourceID(resourceID *string) {
+	o.ResourceID = resourceID
+}
+
+// WithSince adds the since to the v2 list audit events params
+func (o *V2ListAuditEventsParams) WithSince(since *strfmt.DateTime) *V2ListAuditEventsParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the v2 list audit events params
+func (o *V2ListAuditEventsParams) SetSince(since *strfmt.DateTime) {
+	o.Since = since
+}
+
+// WithUntil adds the until to the v2 list audit events params
+func (o *V2ListAuditEventsParams) WithUntil(until *strfmt.DateTime) *V2ListAuditEventsParams {
+	o.SetUntil(until)
+	return o
+}
+
+// SetUntil adds the until to the v2 list audit events params
+func (o *V2ListAuditEventsParams) SetUntil(until *strfmt.DateTime) {
+	o.Until = until
+}
+
+// WithUsername adds the username to the v2 list audit events params
+func (o *V2ListAuditEventsParams) WithUsername(username *string) *V2ListAuditEventsParams {
+	o.SetUsername(username)
+	return o
+}
+
+// SetUsername adds the username to the v2 list audit events params
+func (o *V2ListAuditEventsParams) SetUsername(username *string) {
+	o.Username = username
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListAuditEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.OrgID != nil {
+
+		// query param org_id
+		var qrOrgID string
+
+		if o.OrgID != nil {
+			qrOrgID = *o.OrgID
+		}
+		qOrgID := qrOrgID
+		if qOrgID != "" {
+
+			if err := r.SetQueryParam("org_id", qOrgID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.ResourceID != nil {
+
+		// query param resource_id
+		var qrResourceID string
+
+		if o.ResourceID != nil {
+			qrResourceID = *o.ResourceID
+		}
+		qResourceID := qrResourceID
+		if qResourceID != "" {
+
+			if err := r.SetQueryParam("resource_id", qResourceID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince strfmt.DateTime
+
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince.String()
+		if qSince != "" {
+
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Until != nil {
+
+		// query param until
+		var qrUntil strfmt.DateTime
+
+		if o.Until != nil {
+			qrUntil = *o.Until
+		}
+		qUntil := qrUntil.String()
+		if qUntil != "" {
+
+			if err := r.SetQueryParam("until", qUntil); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Username != nil {
+
+		// query param username
+		var qrUsername string
+
+		if o.Username != nil {
+			qrUsername = *o.Username
+		}
+		qUsername := qrUsername
+		if qUsername != "" {
+
+			if err := r.SetQueryParam("username", qUsername); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,372 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListAuditEventsReader is a Reader for the V2ListAuditEvents structure.
+type V2ListAuditEventsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListAuditEventsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListAuditEventsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2ListAuditEventsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2ListAuditEventsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListAuditEventsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListAuditEventsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListAuditEventsOK creates a V2ListAuditEventsOK with default headers values
+func NewV2ListAuditEventsOK() *V2ListAuditEventsOK {
+	return &V2ListAuditEventsOK{}
+}
+
+/*
+V2ListAuditEventsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListAuditEventsOK struct {
+	Payload models.AuditEventList
+}
+
+// IsSuccess returns true when this v2 list audit events o k response has a 2xx status code
+func (o *V2ListAuditEventsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list audit events o k response has a 3xx status code
+func (o *V2ListAuditEventsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list audit events o k response has a 4xx status code
+func (o *V2ListAuditEventsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list audit events o k response has a 5xx status code
+func (o *V2ListAuditEventsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list audit events o k response a status code equal to that given
+func (o *V2ListAuditEventsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListAuditEventsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/audit-events][%d] v2ListAuditEventsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListAuditEventsOK) String() string {
+	return fmt.Sprintf("[GET /v2/audit-events][%d] v2ListAuditEventsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListAuditEventsOK) GetPayload() models.AuditEventList {
+	return o.Payload
+}
+
+func (o *V2ListAuditEventsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListAuditEventsBadRequest creates a V2ListAuditEventsBadRequest with default headers values
+func NewV2ListAuditEventsBadRequest() *V2ListAuditEventsBadRequest {
+	return &V2ListAuditEventsBadRequest{}
+}
+
+/*
+V2ListAuditEventsBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2ListAuditEventsBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list audit events bad request response has a 2xx status code
+func (o *V2ListAuditEventsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list audit events bad request response has a 3xx status code
+func (o *V2ListAuditEventsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list audit events bad request response has a 4xx status code
+func (o *V2ListAuditEventsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list audit events bad request response has a 5xx status code
+func (o *V2ListAuditEventsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list audit events bad request response a status code equal to that given
+func (o *V2ListAuditEventsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2ListAuditEventsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/audit-events][%d] v2ListAuditEventsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ListAuditEventsBadRequest) String() string {
+	return fmt.Sprintf("[GET /v2/audit-events][%d] v2ListAuditEventsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ListAuditEventsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListAuditEventsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListAuditEventsUnauthorized creates a V2ListAuditEventsUnauthorized with default headers values
+func NewV2ListAuditEventsUnauthorized() *V2ListAuditEventsUnauthorized {
+	return &V2ListAuditEventsUnauthorized{}
+}
+
+/*
+V2ListAuditEventsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListAuditEventsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list audit events unauthorized response has a 2xx status code
+func (o *V2ListAuditEventsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list audit events unauthorized response has a 3xx status code
+func (o *V2ListAuditEventsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list audit events unauthorized response has a 4xx status code
+func (o *V2ListAuditEventsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list audit events unauthorized response has a 5xx status code
+func (o *V2ListAuditEventsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list audit events unauthorized response a status code equal to that given
+func (o *V2ListAuditEventsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListAuditEventsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/audit-events][%d] v2ListAuditEventsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListAuditEventsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/audit-events][%d] v2ListAuditEventsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListAuditEventsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListAuditEventsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListAuditEventsForbidden creates a V2ListAuditEventsForbidden with default headers values
+func NewV2ListAuditEventsForbidden() *V2ListAuditEventsForbidden {
+	return &V2ListAuditEventsForbidden{}
+}
+
+/*
+V2ListAuditEventsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListAuditEventsForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list audit events forbidden response has a 2xx status code
+func (o *V2ListAuditEventsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list audit events forbidden response has a 3xx status code
+func (o *V2ListAuditEventsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list audit events forbidden response has a 4xx status code
+func (o *V2ListAuditEventsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list audit events forbidden response has a 5xx status code
+func (o *V2ListAuditEventsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list audit events forbidden response a status code equal to that given
+func (o *V2ListAuditEventsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListAuditEventsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/audit-events][%d] v2ListAuditEventsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListAuditEventsForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/audit-events][%d] v2ListAuditEventsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListAuditEventsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListAuditEventsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListAuditEventsInternalServerError creates a V2ListAuditEventsInternalServerError with default headers values
+func NewV2ListAuditEventsInternalServerError() *V2ListAuditEventsInternalServerError {
+	return &V2ListAuditEventsInternalServerError{}
+}
+
+/*
+V2ListAuditEventsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListAuditEventsInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list audit events internal server error response has a 2xx status code
+func (o *V2ListAuditEventsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list audit events internal server error response has a 3xx status code
+func (o *V2ListAuditEventsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list audit events internal server error response has a 4xx status code
+func (o *V2ListAuditEventsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list audit events internal server error response has a 5xx status code
+func (o *V2ListAuditEventsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list audit events internal server error response a status code equal to that given
+func (o *V2ListAuditEventsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListAuditEventsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/audit-events][%d] v2ListAuditEventsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListAuditEventsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/audit-events][%d] v2ListAuditEventsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListAuditEventsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListAuditEventsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
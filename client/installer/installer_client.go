@@ -112,6 +112,9 @@ type API interface {
 	/*
 	   V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%.*/
 	V2CompleteInstallation(ctx context.Context, params *V2CompleteInstallationParams) (*V2CompleteInstallationAccepted, error)
+	/*
+	   V2DeleteHostBmcCredentials Removes the credentials of the BMC of the host.*/
+	V2DeleteHostBmcCredentials(ctx context.Context, params *V2DeleteHostBmcCredentialsParams) (*V2DeleteHostBmcCredentialsOK, error)
 	/*
 	   V2DeregisterCluster Deletes an OpenShift cluster definition.*/
 	V2DeregisterCluster(ctx context.Context, params *V2DeregisterClusterParams) (*V2DeregisterClusterNoContent, error)
@@ -124,6 +127,9 @@ type API interface {
 	/*
 	   V2DownloadInfraEnvFiles Downloads the customized ignition file for this host*/
 	V2DownloadInfraEnvFiles(ctx context.Context, params *V2DownloadInfraEnvFilesParams, writer io.Writer) (*V2DownloadInfraEnvFilesOK, error)
+	/*
+	   V2GenerateHostStaticNetworkConfig Generates the static network configuration of a host from its discovered inventory, and optionally applies it to the infra-env of the host.*/
+	V2GenerateHostStaticNetworkConfig(ctx context.Context, params *V2GenerateHostStaticNetworkConfigParams) (*V2GenerateHostStaticNetworkConfigOK, error)
 	/*
 	   V2GetCluster Retrieves the details of the OpenShift cluster.*/
 	V2GetCluster(ctx context.Context, params *V2GetClusterParams) (*V2GetClusterOK, error)
@@ -139,15 +145,27 @@ type API interface {
 	/*
 	   V2GetIgnoredValidations Fetch the validations which are to be ignored for this cluster.*/
 	V2GetIgnoredValidations(ctx context.Context, params *V2GetIgnoredValidationsParams) (*V2GetIgnoredValidationsOK, error)
+	/*
+	   V2GetInfraEnvVirtualMediaBoot Retrieves the status of the last virtual media boot of the infra-env.*/
+	V2GetInfraEnvVirtualMediaBoot(ctx context.Context, params *V2GetInfraEnvVirtualMediaBootParams) (*V2GetInfraEnvVirtualMediaBootOK, error)
 	/*
 	   V2GetNextSteps Retrieves the next operations that the host agent needs to perform.*/
 	V2GetNextSteps(ctx context.Context, params *V2GetNextStepsParams) (*V2GetNextStepsOK, error)
 	/*
 	   V2GetPreflightRequirements Get preflight requirements for a cluster.*/
 	V2GetPreflightRequirements(ctx context.Context, params *V2GetPreflightRequirementsParams) (*V2GetPreflightRequirementsOK, error)
+	/*
+	   V2GetQuotaUsage Retrieves the usage and the quotas of the resources of the organization of the user.*/
+	V2GetQuotaUsage(ctx context.Context, params *V2GetQuotaUsageParams) (*V2GetQuotaUsageOK, error)
+	/*
+	   V2HostPowerAction Runs a power action on the host through its BMC and returns once the BMC accepted it. The BMC credentials of the host must be set.*/
+	V2HostPowerAction(ctx context.Context, params *V2HostPowerActionParams) (*V2HostPowerActionOK, error)
 	/*
 	   V2ImportCluster Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster*/
 	V2ImportCluster(ctx context.Context, params *V2ImportClusterParams) (*V2ImportClusterCreated, error)
+	/*
+	   V2InfraEnvVirtualMediaBoot Boots servers from the discovery ISO of the infra-env. The ISO is mounted as Redfish virtual media through the BMC of each server, set as the next boot device and the server is powered on or rebooted. The servers are booted in the background, the status of each BMC is returned by the GET request.*/
+	V2InfraEnvVirtualMediaBoot(ctx context.Context, params *V2InfraEnvVirtualMediaBootParams) (*V2InfraEnvVirtualMediaBootAccepted, error)
 	/*
 	   V2InstallCluster Installs the OpenShift cluster.*/
 	V2InstallCluster(ctx context.Context, params *V2InstallClusterParams) (*V2InstallClusterAccepted, error)
@@ -172,6 +190,9 @@ type API interface {
 	/*
 	   V2RegisterHost Registers a new OpenShift agent.*/
 	V2RegisterHost(ctx context.Context, params *V2RegisterHostParams) (*V2RegisterHostCreated, error)
+	/*
+	   V2RemoveHostFromCluster Removes the node of an installed host from its cluster. The node is cordoned, drained and deleted, and the host is reclaimed and reboots into discovery. Only hosts managed by the kube-api are supported, the progress is reported in the NodeRemoval condition of their agent.*/
+	V2RemoveHostFromCluster(ctx context.Context, params *V2RemoveHostFromClusterParams) (*V2RemoveHostFromClusterAccepted, error)
 	/*
 	   V2ResetCluster Resets a failed installation.*/
 	V2ResetCluster(ctx context.Context, params *V2ResetClusterParams) (*V2ResetClusterAccepted, error)
@@ -198,6 +219,9 @@ type API interface {
 	/*
 	   V2UpdateHost Update an Openshift host*/
 	V2UpdateHost(ctx context.Context, params *V2UpdateHostParams) (*V2UpdateHostCreated, error)
+	/*
+	   V2UpdateHostBmcCredentials Sets the credentials of the BMC of the host, used to manage its power. The password is stored encrypted, the credentials can't be set unless an encryption key provider is configured. Only Redfish BMCs are supported.*/
+	V2UpdateHostBmcCredentials(ctx context.Context, params *V2UpdateHostBmcCredentialsParams) (*V2UpdateHostBmcCredentialsOK, error)
 	/*
 	   V2UpdateHostIgnition Patch the ignition file for this host*/
 	V2UpdateHostIgnition(ctx context.Context, params *V2UpdateHostIgnitionParams) (*V2UpdateHostIgnitionCreated, error)
@@ -213,6 +237,9 @@ type API interface {
 	/*
 	   V2UploadClusterIngressCert Transfer the ingress certificate for the cluster.*/
 	V2UploadClusterIngressCert(ctx context.Context, params *V2UploadClusterIngressCertParams) (*V2UploadClusterIngressCertCreated, error)
+	/*
+	   V2ValidateStaticNetworkConfig Validates each host of a static network configuration independently, without creating an infra-env, and previews the NetworkManager keyfiles generated for the valid hosts.*/
+	V2ValidateStaticNetworkConfig(ctx context.Context, params *V2ValidateStaticNetworkConfigParams) (*V2ValidateStaticNetworkConfigOK, error)
 }
 
 // New creates a new installer API client.
@@ -1014,6 +1041,31 @@ func (a *Client) V2CompleteInstallation(ctx context.Context, params *V2CompleteI
 
 }
 
+/*
+V2DeleteHostBmcCredentials Removes the credentials of the BMC of the host.
+*/
+func (a *Client) V2DeleteHostBmcCredentials(ctx context.Context, params *V2DeleteHostBmcCredentialsParams) (*V2DeleteHostBmcCredentialsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeleteHostBmcCredentials",
+		Method:             "DELETE",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/bmc-credentials",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeleteHostBmcCredentialsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeleteHostBmcCredentialsOK), nil
+
+}
+
 /*
 V2DeregisterCluster Deletes an OpenShift cluster definition.
 */
@@ -1114,6 +1166,31 @@ func (a *Client) V2DownloadInfraEnvFiles(ctx context.Context, params *V2Download
 
 }
 
+/*
+V2GenerateHostStaticNetworkConfig Generates the static network configuration of a host from its discovered inventory, and optionally applies it to the infra-env of the host.
+*/
+func (a *Client) V2GenerateHostStaticNetworkConfig(ctx context.Context, params *V2GenerateHostStaticNetworkConfigParams) (*V2GenerateHostStaticNetworkConfigOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GenerateHostStaticNetworkConfig",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GenerateHostStaticNetworkConfigReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GenerateHostStaticNetworkConfigOK), nil
+
+}
+
 /*
 V2GetCluster Retrieves the details of the OpenShift cluster.
 */
@@ -1239,6 +1316,31 @@ func (a *Client) V2GetIgnoredValidations(ctx context.Context, params *V2GetIgnor
 
 }
 
+/*
+V2GetInfraEnvVirtualMediaBoot Retrieves the status of the last virtual media boot of the infra-env.
+*/
+func (a *Client) V2GetInfraEnvVirtualMediaBoot(ctx context.Context, params *V2GetInfraEnvVirtualMediaBootParams) (*V2GetInfraEnvVirtualMediaBootOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetInfraEnvVirtualMediaBoot",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/actions/virtual-media-boot",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetInfraEnvVirtualMediaBootReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetInfraEnvVirtualMediaBootOK), nil
+
+}
+
 /*
 V2GetNextSteps Retrieves the next operations that the host agent needs to perform.
 */
@@ -1289,6 +1391,56 @@ func (a *Client) V2GetPreflightRequirements(ctx context.Context, params *V2GetPr
 
 }
 
+/*
+V2GetQuotaUsage Retrieves the usage and the quotas of the resources of the organization of the user.
+*/
+func (a *Client) V2GetQuotaUsage(ctx context.Context, params *V2GetQuotaUsageParams) (*V2GetQuotaUsageOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetQuotaUsage",
+		Method:             "GET",
+		PathPattern:        "/v2/quota-usage",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetQuotaUsageReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetQuotaUsageOK), nil
+
+}
+
+/*
+V2HostPowerAction Runs a power action on the host through its BMC and returns once the BMC accepted it. The BMC credentials of the host must be set.
+*/
+func (a *Client) V2HostPowerAction(ctx context.Context, params *V2HostPowerActionParams) (*V2HostPowerActionOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2HostPowerAction",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2HostPowerActionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2HostPowerActionOK), nil
+
+}
+
 /*
 V2ImportCluster Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster
 */
@@ -1314,6 +1466,31 @@ func (a *Client) V2ImportCluster(ctx context.Context, params *V2ImportClusterPar
 
 }
 
+/*
+V2InfraEnvVirtualMediaBoot Boots servers from the discovery ISO of the infra-env. The ISO is mounted as Redfish virtual media through the BMC of each server, set as the next boot device and the server is powered on or rebooted. The servers are booted in the background, the status of each BMC is returned by the GET request.
+*/
+func (a *Client) V2InfraEnvVirtualMediaBoot(ctx context.Context, params *V2InfraEnvVirtualMediaBootParams) (*V2InfraEnvVirtualMediaBootAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2InfraEnvVirtualMediaBoot",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/actions/virtual-media-boot",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2InfraEnvVirtualMediaBootReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2InfraEnvVirtualMediaBootAccepted), nil
+
+}
+
 /*
 V2InstallCluster Installs the OpenShift cluster.
 */
@@ -1514,6 +1691,31 @@ func (a *Client) V2RegisterHost(ctx context.Context, params *V2RegisterHostParam
 
 }
 
+/*
+V2RemoveHostFromCluster Removes the node of an installed host from its cluster. The node is cordoned, drained and deleted, and the host is reclaimed and reboots into discovery. Only hosts managed by the kube-api are supported, the progress is reported in the NodeRemoval condition of their agent.
+*/
+func (a *Client) V2RemoveHostFromCluster(ctx context.Context, params *V2RemoveHostFromClusterParams) (*V2RemoveHostFromClusterAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RemoveHostFromCluster",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/remove-from-cluster",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RemoveHostFromClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RemoveHostFromClusterAccepted), nil
+
+}
+
 /*
 V2ResetCluster Resets a failed installation.
 */
@@ -1716,6 +1918,31 @@ func (a *Client) V2UpdateHost(ctx context.Context, params *V2UpdateHostParams) (
 
 }
 
+/*
+V2UpdateHostBmcCredentials Sets the credentials of the BMC of the host, used to manage its power. The password is stored encrypted, the credentials can't be set unless an encryption key provider is configured. Only Redfish BMCs are supported.
+*/
+func (a *Client) V2UpdateHostBmcCredentials(ctx context.Context, params *V2UpdateHostBmcCredentialsParams) (*V2UpdateHostBmcCredentialsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2UpdateHostBmcCredentials",
+		Method:             "PUT",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/bmc-credentials",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2UpdateHostBmcCredentialsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2UpdateHostBmcCredentialsOK), nil
+
+}
+
 /*
 V2UpdateHostIgnition Patch the ignition file for this host
 */
@@ -1840,3 +2067,28 @@ func (a *Client) V2UploadClusterIngressCert(ctx context.Context, params *V2Uploa
 	return result.(*V2UploadClusterIngressCertCreated), nil
 
 }
+
+/*
+V2ValidateStaticNetworkConfig Validates each host of a static network configuration independently, without creating an infra-env, and previews the NetworkManager keyfiles generated for the valid hosts.
+*/
+func (a *Client) V2ValidateStaticNetworkConfig(ctx context.Context, params *V2ValidateStaticNetworkConfigParams) (*V2ValidateStaticNetworkConfigOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ValidateStaticNetworkConfig",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/validate-static-network",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ValidateStaticNetworkConfigReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ValidateStaticNetworkConfigOK), nil
+
+}
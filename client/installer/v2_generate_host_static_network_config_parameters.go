@@ -0,0 +1,201 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2GenerateHostStaticNetworkConfigParams creates a new V2GenerateHostStaticNetworkConfigParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GenerateHostStaticNetworkConfigParams() *V2GenerateHostStaticNetworkConfigParams {
+	return &V2GenerateHostStaticNetworkConfigParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GenerateHostStaticNetworkConfigParamsWithTimeout creates a new V2GenerateHostStaticNetworkConfigParams object
+// with the ability to set a timeout on a request.
+func NewV2GenerateHostStaticNetworkConfigParamsWithTimeout(timeout time.Duration) *V2GenerateHostStaticNetworkConfigParams {
+	return &V2GenerateHostStaticNetworkConfigParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GenerateHostStaticNetworkConfigParamsWithContext creates a new V2GenerateHostStaticNetworkConfigParams object
+// with the ability to set a context for a request.
+func NewV2GenerateHostStaticNetworkConfigParamsWithContext(ctx context.Context) *V2GenerateHostStaticNetworkConfigParams {
+	return &V2GenerateHostStaticNetworkConfigParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GenerateHostStaticNetworkConfigParamsWithHTTPClient creates a new V2GenerateHostStaticNetworkConfigParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GenerateHostStaticNetworkConfigParamsWithHTTPClient(client *http.Client) *V2GenerateHostStaticNetworkConfigParams {
+	return &V2GenerateHostStaticNetworkConfigParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GenerateHostStaticNetworkConfigParams contains all the parameters to send to the API endpoint
+
+	for the v2 generate host static network config operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GenerateHostStaticNetworkConfigParams struct {
+
+	/* GenerateStaticNetworkConfigParams.
+
+	   The optional bonds, VLANs and DNS servers of the static network configuration.
+	*/
+	GenerateStaticNetworkConfigParams *models.HostStaticNetworkConfigGenerateParams
+
+	/* HostID.
+
+	   The host whose static network configuration should be generated.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the host whose static network configuration should be generated.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 generate host static network config params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GenerateHostStaticNetworkConfigParams) WithDefaults() *V2GenerateHostStaticNetworkConfigParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 generate host static network config params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GenerateHostStaticNetworkConfigParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 generate host static network config params
+func (o *V2GenerateHostStaticNetworkConfigParams) WithTimeout(timeout time.Duration) *V2GenerateHostStaticNetworkConfigParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 generate host static network config params
+func (o *V2GenerateHostStaticNetworkConfigParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 generate host static network config params
+func (o *V2GenerateHostStaticNetworkConfigParams) WithContext(ctx context.Context) *V2GenerateHostStaticNetworkConfigParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 generate host static network config params
+func (o *V2GenerateHostStaticNetworkConfigParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 generate host static network config params
+func (o *V2GenerateHostStaticNetworkConfigParams) WithHTTPClient(client *http.Client) *V2GenerateHostStaticNetworkConfigParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 generate host static network config params
+func (o *V2GenerateHostStaticNetworkConfigParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithGenerateStaticNetworkConfigParams adds the generateStaticNetworkConfigParams to the v2 generate host static network config params
+func (o *V2GenerateHostStaticNetworkConfigParams) WithGenerateStaticNetworkConfigParams(generateStaticNetworkConfigParams *models.HostStaticNetworkConfigGenerateParams) *V2GenerateHostStaticNetworkConfigParams {
+	o.SetGenerateStaticNetworkConfigParams(generateStaticNetworkConfigParams)
+	return o
+}
+
+// SetGenerateStaticNetworkConfigParams adds the generateStaticNetworkConfigParams to the v2 generate host static network config params
+func (o *V2GenerateHostStaticNetworkConfigParams) SetGenerateStaticNetworkConfigParams(generateStaticNetworkConfigParams *models.HostStaticNetworkConfigGenerateParams) {
+	o.GenerateStaticNetworkConfigParams = generateStaticNetworkConfigParams
+}
+
+// WithHostID adds the hostID to the v2 generate host static network config params
+func (o *V2GenerateHostStaticNetworkConfigParams) WithHostID(hostID strfmt.UUID) *V2GenerateHostStaticNetworkConfigParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 generate host static network config params
+func (o *V2GenerateHostStaticNetworkConfigParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 generate host static network config params
+func (o *V2GenerateHostStaticNetworkConfigParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2GenerateHostStaticNetworkConfigParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 generate host static network config params
+func (o *V2GenerateHostStaticNetworkConfigParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GenerateHostStaticNetworkConfigParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.GenerateStaticNetworkConfigParams != nil {
+		if err := r.SetBodyParam(o.GenerateStaticNetworkConfigParams); err != nil {
+			return err
+		}
+	}
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,650 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GenerateHostStaticNetworkConfigReader is a Reader for the V2GenerateHostStaticNetworkConfig structure.
+type V2GenerateHostStaticNetworkConfigReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GenerateHostStaticNetworkConfigReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GenerateHostStaticNetworkConfigOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2GenerateHostStaticNetworkConfigBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2GenerateHostStaticNetworkConfigUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GenerateHostStaticNetworkConfigForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GenerateHostStaticNetworkConfigNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2GenerateHostStaticNetworkConfigMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2GenerateHostStaticNetworkConfigConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GenerateHostStaticNetworkConfigInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewV2GenerateHostStaticNetworkConfigServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GenerateHostStaticNetworkConfigOK creates a V2GenerateHostStaticNetworkConfigOK with default headers values
+func NewV2GenerateHostStaticNetworkConfigOK() *V2GenerateHostStaticNetworkConfigOK {
+	return &V2GenerateHostStaticNetworkConfigOK{}
+}
+
+/*
+V2GenerateHostStaticNetworkConfigOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GenerateHostStaticNetworkConfigOK struct {
+	Payload *models.HostStaticNetworkConfig
+}
+
+// IsSuccess returns true when this v2 generate host static network config o k response has a 2xx status code
+func (o *V2GenerateHostStaticNetworkConfigOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 generate host static network config o k response has a 3xx status code
+func (o *V2GenerateHostStaticNetworkConfigOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 generate host static network config o k response has a 4xx status code
+func (o *V2GenerateHostStaticNetworkConfigOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 generate host static network config o k response has a 5xx status code
+func (o *V2GenerateHostStaticNetworkConfigOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 generate host static network config o k response a status code equal to that given
+func (o *V2GenerateHostStaticNetworkConfigOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GenerateHostStaticNetworkConfigOK) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigOK) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigOK) GetPayload() *models.HostStaticNetworkConfig {
+	return o.Payload
+}
+
+func (o *V2GenerateHostStaticNetworkConfigOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.HostStaticNetworkConfig)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GenerateHostStaticNetworkConfigBadRequest creates a V2GenerateHostStaticNetworkConfigBadRequest with default headers values
+func NewV2GenerateHostStaticNetworkConfigBadRequest() *V2GenerateHostStaticNetworkConfigBadRequest {
+	return &V2GenerateHostStaticNetworkConfigBadRequest{}
+}
+
+/*
+V2GenerateHostStaticNetworkConfigBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2GenerateHostStaticNetworkConfigBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 generate host static network config bad request response has a 2xx status code
+func (o *V2GenerateHostStaticNetworkConfigBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 generate host static network config bad request response has a 3xx status code
+func (o *V2GenerateHostStaticNetworkConfigBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 generate host static network config bad request response has a 4xx status code
+func (o *V2GenerateHostStaticNetworkConfigBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 generate host static network config bad request response has a 5xx status code
+func (o *V2GenerateHostStaticNetworkConfigBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 generate host static network config bad request response a status code equal to that given
+func (o *V2GenerateHostStaticNetworkConfigBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2GenerateHostStaticNetworkConfigBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GenerateHostStaticNetworkConfigBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GenerateHostStaticNetworkConfigUnauthorized creates a V2GenerateHostStaticNetworkConfigUnauthorized with default headers values
+func NewV2GenerateHostStaticNetworkConfigUnauthorized() *V2GenerateHostStaticNetworkConfigUnauthorized {
+	return &V2GenerateHostStaticNetworkConfigUnauthorized{}
+}
+
+/*
+V2GenerateHostStaticNetworkConfigUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GenerateHostStaticNetworkConfigUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 generate host static network config unauthorized response has a 2xx status code
+func (o *V2GenerateHostStaticNetworkConfigUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 generate host static network config unauthorized response has a 3xx status code
+func (o *V2GenerateHostStaticNetworkConfigUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 generate host static network config unauthorized response has a 4xx status code
+func (o *V2GenerateHostStaticNetworkConfigUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 generate host static network config unauthorized response has a 5xx status code
+func (o *V2GenerateHostStaticNetworkConfigUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 generate host static network config unauthorized response a status code equal to that given
+func (o *V2GenerateHostStaticNetworkConfigUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GenerateHostStaticNetworkConfigUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GenerateHostStaticNetworkConfigUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GenerateHostStaticNetworkConfigForbidden creates a V2GenerateHostStaticNetworkConfigForbidden with default headers values
+func NewV2GenerateHostStaticNetworkConfigForbidden() *V2GenerateHostStaticNetworkConfigForbidden {
+	return &V2GenerateHostStaticNetworkConfigForbidden{}
+}
+
+/*
+V2GenerateHostStaticNetworkConfigForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GenerateHostStaticNetworkConfigForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 generate host static network config forbidden response has a 2xx status code
+func (o *V2GenerateHostStaticNetworkConfigForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 generate host static network config forbidden response has a 3xx status code
+func (o *V2GenerateHostStaticNetworkConfigForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 generate host static network config forbidden response has a 4xx status code
+func (o *V2GenerateHostStaticNetworkConfigForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 generate host static network config forbidden response has a 5xx status code
+func (o *V2GenerateHostStaticNetworkConfigForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 generate host static network config forbidden response a status code equal to that given
+func (o *V2GenerateHostStaticNetworkConfigForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GenerateHostStaticNetworkConfigForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GenerateHostStaticNetworkConfigForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GenerateHostStaticNetworkConfigNotFound creates a V2GenerateHostStaticNetworkConfigNotFound with default headers values
+func NewV2GenerateHostStaticNetworkConfigNotFound() *V2GenerateHostStaticNetworkConfigNotFound {
+	return &V2GenerateHostStaticNetworkConfigNotFound{}
+}
+
+/*
+V2GenerateHostStaticNetworkConfigNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GenerateHostStaticNetworkConfigNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 generate host static network config not found response has a 2xx status code
+func (o *V2GenerateHostStaticNetworkConfigNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 generate host static network config not found response has a 3xx status code
+func (o *V2GenerateHostStaticNetworkConfigNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 generate host static network config not found response has a 4xx status code
+func (o *V2GenerateHostStaticNetworkConfigNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 generate host static network config not found response has a 5xx status code
+func (o *V2GenerateHostStaticNetworkConfigNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 generate host static network config not found response a status code equal to that given
+func (o *V2GenerateHostStaticNetworkConfigNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GenerateHostStaticNetworkConfigNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GenerateHostStaticNetworkConfigNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GenerateHostStaticNetworkConfigMethodNotAllowed creates a V2GenerateHostStaticNetworkConfigMethodNotAllowed with default headers values
+func NewV2GenerateHostStaticNetworkConfigMethodNotAllowed() *V2GenerateHostStaticNetworkConfigMethodNotAllowed {
+	return &V2GenerateHostStaticNetworkConfigMethodNotAllowed{}
+}
+
+/*
+V2GenerateHostStaticNetworkConfigMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2GenerateHostStaticNetworkConfigMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 generate host static network config method not allowed response has a 2xx status code
+func (o *V2GenerateHostStaticNetworkConfigMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 generate host static network config method not allowed response has a 3xx status code
+func (o *V2GenerateHostStaticNetworkConfigMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 generate host static network config method not allowed response has a 4xx status code
+func (o *V2GenerateHostStaticNetworkConfigMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 generate host static network config method not allowed response has a 5xx status code
+func (o *V2GenerateHostStaticNetworkConfigMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 generate host static network config method not allowed response a status code equal to that given
+func (o *V2GenerateHostStaticNetworkConfigMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2GenerateHostStaticNetworkConfigMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigMethodNotAllowed) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GenerateHostStaticNetworkConfigMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GenerateHostStaticNetworkConfigConflict creates a V2GenerateHostStaticNetworkConfigConflict with default headers values
+func NewV2GenerateHostStaticNetworkConfigConflict() *V2GenerateHostStaticNetworkConfigConflict {
+	return &V2GenerateHostStaticNetworkConfigConflict{}
+}
+
+/*
+V2GenerateHostStaticNetworkConfigConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2GenerateHostStaticNetworkConfigConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 generate host static network config conflict response has a 2xx status code
+func (o *V2GenerateHostStaticNetworkConfigConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 generate host static network config conflict response has a 3xx status code
+func (o *V2GenerateHostStaticNetworkConfigConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 generate host static network config conflict response has a 4xx status code
+func (o *V2GenerateHostStaticNetworkConfigConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 generate host static network config conflict response has a 5xx status code
+func (o *V2GenerateHostStaticNetworkConfigConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 generate host static network config conflict response a status code equal to that given
+func (o *V2GenerateHostStaticNetworkConfigConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2GenerateHostStaticNetworkConfigConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigConflict) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GenerateHostStaticNetworkConfigConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GenerateHostStaticNetworkConfigInternalServerError creates a V2GenerateHostStaticNetworkConfigInternalServerError with default headers values
+func NewV2GenerateHostStaticNetworkConfigInternalServerError() *V2GenerateHostStaticNetworkConfigInternalServerError {
+	return &V2GenerateHostStaticNetworkConfigInternalServerError{}
+}
+
+/*
+V2GenerateHostStaticNetworkConfigInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GenerateHostStaticNetworkConfigInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 generate host static network config internal server error response has a 2xx status code
+func (o *V2GenerateHostStaticNetworkConfigInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 generate host static network config internal server error response has a 3xx status code
+func (o *V2GenerateHostStaticNetworkConfigInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 generate host static network config internal server error response has a 4xx status code
+func (o *V2GenerateHostStaticNetworkConfigInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 generate host static network config internal server error response has a 5xx status code
+func (o *V2GenerateHostStaticNetworkConfigInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 generate host static network config internal server error response a status code equal to that given
+func (o *V2GenerateHostStaticNetworkConfigInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GenerateHostStaticNetworkConfigInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GenerateHostStaticNetworkConfigInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GenerateHostStaticNetworkConfigServiceUnavailable creates a V2GenerateHostStaticNetworkConfigServiceUnavailable with default headers values
+func NewV2GenerateHostStaticNetworkConfigServiceUnavailable() *V2GenerateHostStaticNetworkConfigServiceUnavailable {
+	return &V2GenerateHostStaticNetworkConfigServiceUnavailable{}
+}
+
+/*
+V2GenerateHostStaticNetworkConfigServiceUnavailable describes a response with status code 503, with default header values.
+
+Unavailable.
+*/
+type V2GenerateHostStaticNetworkConfigServiceUnavailable struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 generate host static network config service unavailable response has a 2xx status code
+func (o *V2GenerateHostStaticNetworkConfigServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 generate host static network config service unavailable response has a 3xx status code
+func (o *V2GenerateHostStaticNetworkConfigServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 generate host static network config service unavailable response has a 4xx status code
+func (o *V2GenerateHostStaticNetworkConfigServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 generate host static network config service unavailable response has a 5xx status code
+func (o *V2GenerateHostStaticNetworkConfigServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 generate host static network config service unavailable response a status code equal to that given
+func (o *V2GenerateHostStaticNetworkConfigServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+func (o *V2GenerateHostStaticNetworkConfigServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigServiceUnavailable) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config][%d] v2GenerateHostStaticNetworkConfigServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *V2GenerateHostStaticNetworkConfigServiceUnavailable) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GenerateHostStaticNetworkConfigServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
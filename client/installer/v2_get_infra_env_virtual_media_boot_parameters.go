@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetInfraEnvVirtualMediaBootParams creates a new V2GetInfraEnvVirtualMediaBootParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetInfraEnvVirtualMediaBootParams() *V2GetInfraEnvVirtualMediaBootParams {
+	return &V2GetInfraEnvVirtualMediaBootParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetInfraEnvVirtualMediaBootParamsWithTimeout creates a new V2GetInfraEnvVirtualMediaBootParams object
+// with the ability to set a timeout on a request.
+func NewV2GetInfraEnvVirtualMediaBootParamsWithTimeout(timeout time.Duration) *V2GetInfraEnvVirtualMediaBootParams {
+	return &V2GetInfraEnvVirtualMediaBootParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetInfraEnvVirtualMediaBootParamsWithContext creates a new V2GetInfraEnvVirtualMediaBootParams object
+// with the ability to set a context for a request.
+func NewV2GetInfraEnvVirtualMediaBootParamsWithContext(ctx context.Context) *V2GetInfraEnvVirtualMediaBootParams {
+	return &V2GetInfraEnvVirtualMediaBootParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetInfraEnvVirtualMediaBootParamsWithHTTPClient creates a new V2GetInfraEnvVirtualMediaBootParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetInfraEnvVirtualMediaBootParamsWithHTTPClient(client *http.Client) *V2GetInfraEnvVirtualMediaBootParams {
+	return &V2GetInfraEnvVirtualMediaBootParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetInfraEnvVirtualMediaBootParams contains all the parameters to send to the API endpoint
+
+	for the v2 get infra env virtual media boot operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetInfraEnvVirtualMediaBootParams struct {
+
+	/* InfraEnvID.
+
+	   The infra-env whose virtual media boot status is retrieved.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get infra env virtual media boot params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetInfraEnvVirtualMediaBootParams) WithDefaults() *V2GetInfraEnvVirtualMediaBootParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get infra env virtual media boot params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetInfraEnvVirtualMediaBootParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get infra env virtual media boot params
+func (o *V2GetInfraEnvVirtualMediaBootParams) WithTimeout(timeout time.Duration) *V2GetInfraEnvVirtualMediaBootParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get infra env virtual media boot params
+func (o *V2GetInfraEnvVirtualMediaBootParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get infra env virtual media boot params
+func (o *V2GetInfraEnvVirtualMediaBootParams) WithContext(ctx context.Context) *V2GetInfraEnvVirtualMediaBootParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get infra env virtual media boot params
+func (o *V2GetInfraEnvVirtualMediaBootParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get infra env virtual media boot params
+func (o *V2GetInfraEnvVirtualMediaBootParams) WithHTTPClient(client *http.Client) *V2GetInfraEnvVirtualMediaBootParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get infra env virtual media boot params
+func (o *V2GetInfraEnvVirtualMediaBootParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 get infra env virtual media boot params
+func (o *V2GetInfraEnvVirtualMediaBootParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2GetInfraEnvVirtualMediaBootParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 get infra env virtual media boot params
+func (o *V2GetInfraEnvVirtualMediaBootParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetInfraEnvVirtualMediaBootParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,581 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetInfraEnvVirtualMediaBootReader is a Reader for the V2GetInfraEnvVirtualMediaBoot structure.
+type V2GetInfraEnvVirtualMediaBootReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetInfraEnvVirtualMediaBootReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetInfraEnvVirtualMediaBootOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2GetInfraEnvVirtualMediaBootBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2GetInfraEnvVirtualMediaBootUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetInfraEnvVirtualMediaBootForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetInfraEnvVirtualMediaBootNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2GetInfraEnvVirtualMediaBootMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetInfraEnvVirtualMediaBootInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewV2GetInfraEnvVirtualMediaBootServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetInfraEnvVirtualMediaBootOK creates a V2GetInfraEnvVirtualMediaBootOK with default headers values
+func NewV2GetInfraEnvVirtualMediaBootOK() *V2GetInfraEnvVirtualMediaBootOK {
+	return &V2GetInfraEnvVirtualMediaBootOK{}
+}
+
+/*
+V2GetInfraEnvVirtualMediaBootOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetInfraEnvVirtualMediaBootOK struct {
+	Payload *models.InfraEnvVirtualMediaBoot
+}
+
+// IsSuccess returns true when this v2 get infra env virtual media boot o k response has a 2xx status code
+func (o *V2GetInfraEnvVirtualMediaBootOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get infra env virtual media boot o k response has a 3xx status code
+func (o *V2GetInfraEnvVirtualMediaBootOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env virtual media boot o k response has a 4xx status code
+func (o *V2GetInfraEnvVirtualMediaBootOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get infra env virtual media boot o k response has a 5xx status code
+func (o *V2GetInfraEnvVirtualMediaBootOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get infra env virtual media boot o k response a status code equal to that given
+func (o *V2GetInfraEnvVirtualMediaBootOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootOK) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootOK) GetPayload() *models.InfraEnvVirtualMediaBoot {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraEnvVirtualMediaBoot)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInfraEnvVirtualMediaBootBadRequest creates a V2GetInfraEnvVirtualMediaBootBadRequest with default headers values
+func NewV2GetInfraEnvVirtualMediaBootBadRequest() *V2GetInfraEnvVirtualMediaBootBadRequest {
+	return &V2GetInfraEnvVirtualMediaBootBadRequest{}
+}
+
+/*
+V2GetInfraEnvVirtualMediaBootBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2GetInfraEnvVirtualMediaBootBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get infra env virtual media boot bad request response has a 2xx status code
+func (o *V2GetInfraEnvVirtualMediaBootBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get infra env virtual media boot bad request response has a 3xx status code
+func (o *V2GetInfraEnvVirtualMediaBootBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env virtual media boot bad request response has a 4xx status code
+func (o *V2GetInfraEnvVirtualMediaBootBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get infra env virtual media boot bad request response has a 5xx status code
+func (o *V2GetInfraEnvVirtualMediaBootBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get infra env virtual media boot bad request response a status code equal to that given
+func (o *V2GetInfraEnvVirtualMediaBootBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootBadRequest) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInfraEnvVirtualMediaBootUnauthorized creates a V2GetInfraEnvVirtualMediaBootUnauthorized with default headers values
+func NewV2GetInfraEnvVirtualMediaBootUnauthorized() *V2GetInfraEnvVirtualMediaBootUnauthorized {
+	return &V2GetInfraEnvVirtualMediaBootUnauthorized{}
+}
+
+/*
+V2GetInfraEnvVirtualMediaBootUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetInfraEnvVirtualMediaBootUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get infra env virtual media boot unauthorized response has a 2xx status code
+func (o *V2GetInfraEnvVirtualMediaBootUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get infra env virtual media boot unauthorized response has a 3xx status code
+func (o *V2GetInfraEnvVirtualMediaBootUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env virtual media boot unauthorized response has a 4xx status code
+func (o *V2GetInfraEnvVirtualMediaBootUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get infra env virtual media boot unauthorized response has a 5xx status code
+func (o *V2GetInfraEnvVirtualMediaBootUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get infra env virtual media boot unauthorized response a status code equal to that given
+func (o *V2GetInfraEnvVirtualMediaBootUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInfraEnvVirtualMediaBootForbidden creates a V2GetInfraEnvVirtualMediaBootForbidden with default headers values
+func NewV2GetInfraEnvVirtualMediaBootForbidden() *V2GetInfraEnvVirtualMediaBootForbidden {
+	return &V2GetInfraEnvVirtualMediaBootForbidden{}
+}
+
+/*
+V2GetInfraEnvVirtualMediaBootForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetInfraEnvVirtualMediaBootForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get infra env virtual media boot forbidden response has a 2xx status code
+func (o *V2GetInfraEnvVirtualMediaBootForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get infra env virtual media boot forbidden response has a 3xx status code
+func (o *V2GetInfraEnvVirtualMediaBootForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env virtual media boot forbidden response has a 4xx status code
+func (o *V2GetInfraEnvVirtualMediaBootForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get infra env virtual media boot forbidden response has a 5xx status code
+func (o *V2GetInfraEnvVirtualMediaBootForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get infra env virtual media boot forbidden response a status code equal to that given
+func (o *V2GetInfraEnvVirtualMediaBootForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInfraEnvVirtualMediaBootNotFound creates a V2GetInfraEnvVirtualMediaBootNotFound with default headers values
+func NewV2GetInfraEnvVirtualMediaBootNotFound() *V2GetInfraEnvVirtualMediaBootNotFound {
+	return &V2GetInfraEnvVirtualMediaBootNotFound{}
+}
+
+/*
+V2GetInfraEnvVirtualMediaBootNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetInfraEnvVirtualMediaBootNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get infra env virtual media boot not found response has a 2xx status code
+func (o *V2GetInfraEnvVirtualMediaBootNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get infra env virtual media boot not found response has a 3xx status code
+func (o *V2GetInfraEnvVirtualMediaBootNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env virtual media boot not found response has a 4xx status code
+func (o *V2GetInfraEnvVirtualMediaBootNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get infra env virtual media boot not found response has a 5xx status code
+func (o *V2GetInfraEnvVirtualMediaBootNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get infra env virtual media boot not found response a status code equal to that given
+func (o *V2GetInfraEnvVirtualMediaBootNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInfraEnvVirtualMediaBootMethodNotAllowed creates a V2GetInfraEnvVirtualMediaBootMethodNotAllowed with default headers values
+func NewV2GetInfraEnvVirtualMediaBootMethodNotAllowed() *V2GetInfraEnvVirtualMediaBootMethodNotAllowed {
+	return &V2GetInfraEnvVirtualMediaBootMethodNotAllowed{}
+}
+
+/*
+V2GetInfraEnvVirtualMediaBootMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2GetInfraEnvVirtualMediaBootMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get infra env virtual media boot method not allowed response has a 2xx status code
+func (o *V2GetInfraEnvVirtualMediaBootMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get infra env virtual media boot method not allowed response has a 3xx status code
+func (o *V2GetInfraEnvVirtualMediaBootMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env virtual media boot method not allowed response has a 4xx status code
+func (o *V2GetInfraEnvVirtualMediaBootMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get infra env virtual media boot method not allowed response has a 5xx status code
+func (o *V2GetInfraEnvVirtualMediaBootMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get infra env virtual media boot method not allowed response a status code equal to that given
+func (o *V2GetInfraEnvVirtualMediaBootMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootMethodNotAllowed) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInfraEnvVirtualMediaBootInternalServerError creates a V2GetInfraEnvVirtualMediaBootInternalServerError with default headers values
+func NewV2GetInfraEnvVirtualMediaBootInternalServerError() *V2GetInfraEnvVirtualMediaBootInternalServerError {
+	return &V2GetInfraEnvVirtualMediaBootInternalServerError{}
+}
+
+/*
+V2GetInfraEnvVirtualMediaBootInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetInfraEnvVirtualMediaBootInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get infra env virtual media boot internal server error response has a 2xx status code
+func (o *V2GetInfraEnvVirtualMediaBootInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get infra env virtual media boot internal server error response has a 3xx status code
+func (o *V2GetInfraEnvVirtualMediaBootInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env virtual media boot internal server error response has a 4xx status code
+func (o *V2GetInfraEnvVirtualMediaBootInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get infra env virtual media boot internal server error response has a 5xx status code
+func (o *V2GetInfraEnvVirtualMediaBootInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get infra env virtual media boot internal server error response a status code equal to that given
+func (o *V2GetInfraEnvVirtualMediaBootInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInfraEnvVirtualMediaBootServiceUnavailable creates a V2GetInfraEnvVirtualMediaBootServiceUnavailable with default headers values
+func NewV2GetInfraEnvVirtualMediaBootServiceUnavailable() *V2GetInfraEnvVirtualMediaBootServiceUnavailable {
+	return &V2GetInfraEnvVirtualMediaBootServiceUnavailable{}
+}
+
+/*
+V2GetInfraEnvVirtualMediaBootServiceUnavailable describes a response with status code 503, with default header values.
+
+Unavailable.
+*/
+type V2GetInfraEnvVirtualMediaBootServiceUnavailable struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get infra env virtual media boot service unavailable response has a 2xx status code
+func (o *V2GetInfraEnvVirtualMediaBootServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get infra env virtual media boot service unavailable response has a 3xx status code
+func (o *V2GetInfraEnvVirtualMediaBootServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env virtual media boot service unavailable response has a 4xx status code
+func (o *V2GetInfraEnvVirtualMediaBootServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get infra env virtual media boot service unavailable response has a 5xx status code
+func (o *V2GetInfraEnvVirtualMediaBootServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get infra env virtual media boot service unavailable response a status code equal to that given
+func (o *V2GetInfraEnvVirtualMediaBootServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootServiceUnavailable) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2GetInfraEnvVirtualMediaBootServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootServiceUnavailable) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvVirtualMediaBootServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2HostPowerActionParams creates a new V2HostPowerActionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2HostPowerActionParams() *V2HostPowerActionParams {
+	return &V2HostPowerActionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2HostPowerActionParamsWithTimeout creates a new V2HostPowerActionParams object
+// with the ability to set a timeout on a request.
+func NewV2HostPowerActionParamsWithTimeout(timeout time.Duration) *V2HostPowerActionParams {
+	return &V2HostPowerActionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2HostPowerActionParamsWithContext creates a new V2HostPowerActionParams object
+// with the ability to set a context for a request.
+func NewV2HostPowerActionParamsWithContext(ctx context.Context) *V2HostPowerActionParams {
+	return &V2HostPowerActionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2HostPowerActionParamsWithHTTPClient creates a new V2HostPowerActionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2HostPowerActionParamsWithHTTPClient(client *http.Client) *V2HostPowerActionParams {
+	return &V2HostPowerActionParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2HostPowerActionParams contains all the parameters to send to the API endpoint
+
+	for the v2 host power action operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2HostPowerActionParams struct {
+
+	/* HostPowerActionParams.
+
+	   The power action to run.
+	*/
+	HostPowerActionParams *models.HostPowerActionParams
+
+	/* HostID.
+
+	   The host whose power is managed.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the host whose power is managed.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 host power action params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2HostPowerActionParams) WithDefaults() *V2HostPowerActionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 host power action params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2HostPowerActionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 host power action params
+func (o *V2HostPowerActionParams) WithTimeout(timeout time.Duration) *V2HostPowerActionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 host power action params
+func (o *V2HostPowerActionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 host power action params
+func (o *V2HostPowerActionParams) WithContext(ctx context.Context) *V2HostPowerActionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 host power action params
+func (o *V2HostPowerActionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 host power action params
+func (o *V2HostPowerActionParams) WithHTTPClient(client *http.Client) *V2HostPowerActionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 host power action params
+func (o *V2HostPowerActionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithHostPowerActionParams adds the hostPowerActionParams to the v2 host power action params
+func (o *V2HostPowerActionParams) WithHostPowerActionParams(hostPowerActionParams *models.HostPowerActionParams) *V2HostPowerActionParams {
+	o.SetHostPowerActionParams(hostPowerActionParams)
+	return o
+}
+
+// SetHostPowerActionParams adds the hostPowerActionParams to the v2 host power action params
+func (o *V2HostPowerActionParams) SetHostPowerActionParams(hostPowerActionParams *models.HostPowerActionParams) {
+	o.HostPowerActionParams = hostPowerActionParams
+}
+
+// WithHostID adds the hostID to the v2 host power action params
+func (o *V2HostPowerActionParams) WithHostID(hostID strfmt.UUID) *V2HostPowerActionParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 host power action params
+func (o *V2HostPowerActionParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 host power action params
+func (o *V2HostPowerActionParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2HostPowerActionParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 host power action params
+func (o *V2HostPowerActionParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2HostPowerActionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.HostPowerActionParams != nil {
+		if err := r.SetBodyParam(o.HostPowerActionParams); err != nil {
+			return err
+		}
+	}
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
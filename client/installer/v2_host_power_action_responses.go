@@ -0,0 +1,719 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2HostPowerActionReader is a Reader for the V2HostPowerAction structure.
+type V2HostPowerActionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2HostPowerActionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2HostPowerActionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2HostPowerActionBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2HostPowerActionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2HostPowerActionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2HostPowerActionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2HostPowerActionMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2HostPowerActionConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2HostPowerActionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 502:
+		result := NewV2HostPowerActionBadGateway()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewV2HostPowerActionServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2HostPowerActionOK creates a V2HostPowerActionOK with default headers values
+func NewV2HostPowerActionOK() *V2HostPowerActionOK {
+	return &V2HostPowerActionOK{}
+}
+
+/*
+V2HostPowerActionOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2HostPowerActionOK struct {
+	Payload *models.Host
+}
+
+// IsSuccess returns true when this v2 host power action o k response has a 2xx status code
+func (o *V2HostPowerActionOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 host power action o k response has a 3xx status code
+func (o *V2HostPowerActionOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 host power action o k response has a 4xx status code
+func (o *V2HostPowerActionOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 host power action o k response has a 5xx status code
+func (o *V2HostPowerActionOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 host power action o k response a status code equal to that given
+func (o *V2HostPowerActionOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2HostPowerActionOK) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionOK  %+v", 200, o.Payload)
+}
+
+func (o *V2HostPowerActionOK) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionOK  %+v", 200, o.Payload)
+}
+
+func (o *V2HostPowerActionOK) GetPayload() *models.Host {
+	return o.Payload
+}
+
+func (o *V2HostPowerActionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Host)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2HostPowerActionBadRequest creates a V2HostPowerActionBadRequest with default headers values
+func NewV2HostPowerActionBadRequest() *V2HostPowerActionBadRequest {
+	return &V2HostPowerActionBadRequest{}
+}
+
+/*
+V2HostPowerActionBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2HostPowerActionBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 host power action bad request response has a 2xx status code
+func (o *V2HostPowerActionBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 host power action bad request response has a 3xx status code
+func (o *V2HostPowerActionBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 host power action bad request response has a 4xx status code
+func (o *V2HostPowerActionBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 host power action bad request response has a 5xx status code
+func (o *V2HostPowerActionBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 host power action bad request response a status code equal to that given
+func (o *V2HostPowerActionBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2HostPowerActionBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2HostPowerActionBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2HostPowerActionBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2HostPowerActionBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2HostPowerActionUnauthorized creates a V2HostPowerActionUnauthorized with default headers values
+func NewV2HostPowerActionUnauthorized() *V2HostPowerActionUnauthorized {
+	return &V2HostPowerActionUnauthorized{}
+}
+
+/*
+V2HostPowerActionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2HostPowerActionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 host power action unauthorized response has a 2xx status code
+func (o *V2HostPowerActionUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 host power action unauthorized response has a 3xx status code
+func (o *V2HostPowerActionUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 host power action unauthorized response has a 4xx status code
+func (o *V2HostPowerActionUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 host power action unauthorized response has a 5xx status code
+func (o *V2HostPowerActionUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 host power action unauthorized response a status code equal to that given
+func (o *V2HostPowerActionUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2HostPowerActionUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2HostPowerActionUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2HostPowerActionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2HostPowerActionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2HostPowerActionForbidden creates a V2HostPowerActionForbidden with default headers values
+func NewV2HostPowerActionForbidden() *V2HostPowerActionForbidden {
+	return &V2HostPowerActionForbidden{}
+}
+
+/*
+V2HostPowerActionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2HostPowerActionForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 host power action forbidden response has a 2xx status code
+func (o *V2HostPowerActionForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 host power action forbidden response has a 3xx status code
+func (o *V2HostPowerActionForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 host power action forbidden response has a 4xx status code
+func (o *V2HostPowerActionForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 host power action forbidden response has a 5xx status code
+func (o *V2HostPowerActionForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 host power action forbidden response a status code equal to that given
+func (o *V2HostPowerActionForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2HostPowerActionForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2HostPowerActionForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2HostPowerActionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2HostPowerActionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2HostPowerActionNotFound creates a V2HostPowerActionNotFound with default headers values
+func NewV2HostPowerActionNotFound() *V2HostPowerActionNotFound {
+	return &V2HostPowerActionNotFound{}
+}
+
+/*
+V2HostPowerActionNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2HostPowerActionNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 host power action not found response has a 2xx status code
+func (o *V2HostPowerActionNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 host power action not found response has a 3xx status code
+func (o *V2HostPowerActionNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 host power action not found response has a 4xx status code
+func (o *V2HostPowerActionNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 host power action not found response has a 5xx status code
+func (o *V2HostPowerActionNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 host power action not found response a status code equal to that given
+func (o *V2HostPowerActionNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2HostPowerActionNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2HostPowerActionNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2HostPowerActionNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2HostPowerActionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2HostPowerActionMethodNotAllowed creates a V2HostPowerActionMethodNotAllowed with default headers values
+func NewV2HostPowerActionMethodNotAllowed() *V2HostPowerActionMethodNotAllowed {
+	return &V2HostPowerActionMethodNotAllowed{}
+}
+
+/*
+V2HostPowerActionMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2HostPowerActionMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 host power action method not allowed response has a 2xx status code
+func (o *V2HostPowerActionMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 host power action method not allowed response has a 3xx status code
+func (o *V2HostPowerActionMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 host power action method not allowed response has a 4xx status code
+func (o *V2HostPowerActionMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 host power action method not allowed response has a 5xx status code
+func (o *V2HostPowerActionMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 host power action method not allowed response a status code equal to that given
+func (o *V2HostPowerActionMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2HostPowerActionMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2HostPowerActionMethodNotAllowed) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2HostPowerActionMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2HostPowerActionMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2HostPowerActionConflict creates a V2HostPowerActionConflict with default headers values
+func NewV2HostPowerActionConflict() *V2HostPowerActionConflict {
+	return &V2HostPowerActionConflict{}
+}
+
+/*
+V2HostPowerActionConflict describes a response with status code 409, with default header values.
+
+Conflict.
+*/
+type V2HostPowerActionConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 host power action conflict response has a 2xx status code
+func (o *V2HostPowerActionConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 host power action conflict response has a 3xx status code
+func (o *V2HostPowerActionConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 host power action conflict response has a 4xx status code
+func (o *V2HostPowerActionConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 host power action conflict response has a 5xx status code
+func (o *V2HostPowerActionConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 host power action conflict response a status code equal to that given
+func (o *V2HostPowerActionConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2HostPowerActionConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2HostPowerActionConflict) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2HostPowerActionConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2HostPowerActionConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2HostPowerActionInternalServerError creates a V2HostPowerActionInternalServerError with default headers values
+func NewV2HostPowerActionInternalServerError() *V2HostPowerActionInternalServerError {
+	return &V2HostPowerActionInternalServerError{}
+}
+
+/*
+V2HostPowerActionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2HostPowerActionInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 host power action internal server error response has a 2xx status code
+func (o *V2HostPowerActionInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 host power action internal server error response has a 3xx status code
+func (o *V2HostPowerActionInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 host power action internal server error response has a 4xx status code
+func (o *V2HostPowerActionInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 host power action internal server error response has a 5xx status code
+func (o *V2HostPowerActionInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 host power action internal server error response a status code equal to that given
+func (o *V2HostPowerActionInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2HostPowerActionInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2HostPowerActionInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2HostPowerActionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2HostPowerActionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2HostPowerActionBadGateway creates a V2HostPowerActionBadGateway with default headers values
+func NewV2HostPowerActionBadGateway() *V2HostPowerActionBadGateway {
+	return &V2HostPowerActionBadGateway{}
+}
+
+/*
+V2HostPowerActionBadGateway describes a response with status code 502, with default header values.
+
+The BMC failed to run the action.
+*/
+type V2HostPowerActionBadGateway struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 host power action bad gateway response has a 2xx status code
+func (o *V2HostPowerActionBadGateway) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 host power action bad gateway response has a 3xx status code
+func (o *V2HostPowerActionBadGateway) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 host power action bad gateway response has a 4xx status code
+func (o *V2HostPowerActionBadGateway) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 host power action bad gateway response has a 5xx status code
+func (o *V2HostPowerActionBadGateway) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 host power action bad gateway response a status code equal to that given
+func (o *V2HostPowerActionBadGateway) IsCode(code int) bool {
+	return code == 502
+}
+
+func (o *V2HostPowerActionBadGateway) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionBadGateway  %+v", 502, o.Payload)
+}
+
+func (o *V2HostPowerActionBadGateway) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionBadGateway  %+v", 502, o.Payload)
+}
+
+func (o *V2HostPowerActionBadGateway) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2HostPowerActionBadGateway) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2HostPowerActionServiceUnavailable creates a V2HostPowerActionServiceUnavailable with default headers values
+func NewV2HostPowerActionServiceUnavailable() *V2HostPowerActionServiceUnavailable {
+	return &V2HostPowerActionServiceUnavailable{}
+}
+
+/*
+V2HostPowerActionServiceUnavailable describes a response with status code 503, with default header values.
+
+Unavailable.
+*/
+type V2HostPowerActionServiceUnavailable struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 host power action service unavailable response has a 2xx status code
+func (o *V2HostPowerActionServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 host power action service unavailable response has a 3xx status code
+func (o *V2HostPowerActionServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 host power action service unavailable response has a 4xx status code
+func (o *V2HostPowerActionServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 host power action service unavailable response has a 5xx status code
+func (o *V2HostPowerActionServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 host power action service unavailable response a status code equal to that given
+func (o *V2HostPowerActionServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+func (o *V2HostPowerActionServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *V2HostPowerActionServiceUnavailable) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power][%d] v2HostPowerActionServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *V2HostPowerActionServiceUnavailable) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2HostPowerActionServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
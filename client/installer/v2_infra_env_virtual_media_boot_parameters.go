@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2InfraEnvVirtualMediaBootParams creates a new V2InfraEnvVirtualMediaBootParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2InfraEnvVirtualMediaBootParams() *V2InfraEnvVirtualMediaBootParams {
+	return &V2InfraEnvVirtualMediaBootParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2InfraEnvVirtualMediaBootParamsWithTimeout creates a new V2InfraEnvVirtualMediaBootParams object
+// with the ability to set a timeout on a request.
+func NewV2InfraEnvVirtualMediaBootParamsWithTimeout(timeout time.Duration) *V2InfraEnvVirtualMediaBootParams {
+	return &V2InfraEnvVirtualMediaBootParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2InfraEnvVirtualMediaBootParamsWithContext creates a new V2InfraEnvVirtualMediaBootParams object
+// with the ability to set a context for a request.
+func NewV2InfraEnvVirtualMediaBootParamsWithContext(ctx context.Context) *V2InfraEnvVirtualMediaBootParams {
+	return &V2InfraEnvVirtualMediaBootParams{
+		Context: ctx,
+	}
+}
+
+// NewV2InfraEnvVirtualMediaBootParamsWithHTTPClient creates a new V2InfraEnvVirtualMediaBootParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2InfraEnvVirtualMediaBootParamsWithHTTPClient(client *http.Client) *V2InfraEnvVirtualMediaBootParams {
+	return &V2InfraEnvVirtualMediaBootParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2InfraEnvVirtualMediaBootParams contains all the parameters to send to the API endpoint
+
+	for the v2 infra env virtual media boot operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2InfraEnvVirtualMediaBootParams struct {
+
+	/* InfraEnvVirtualMediaBootParams.
+
+	   The BMCs of the servers to boot.
+	*/
+	InfraEnvVirtualMediaBootParams *models.InfraEnvVirtualMediaBootParams
+
+	/* InfraEnvID.
+
+	   The infra-env whose discovery ISO is booted.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 infra env virtual media boot params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2InfraEnvVirtualMediaBootParams) WithDefaults() *V2InfraEnvVirtualMediaBootParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 infra env virtual media boot params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2InfraEnvVirtualMediaBootParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 infra env virtual media boot params
+func (o *V2InfraEnvVirtualMediaBootParams) WithTimeout(timeout time.Duration) *V2InfraEnvVirtualMediaBootParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 infra env virtual media boot params
+func (o *V2InfraEnvVirtualMediaBootParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 infra env virtual media boot params
+func (o *V2InfraEnvVirtualMediaBootParams) WithContext(ctx context.Context) *V2InfraEnvVirtualMediaBootParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 infra env virtual media boot params
+func (o *V2InfraEnvVirtualMediaBootParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 infra env virtual media boot params
+func (o *V2InfraEnvVirtualMediaBootParams) WithHTTPClient(client *http.Client) *V2InfraEnvVirtualMediaBootParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 infra env virtual media boot params
+func (o *V2InfraEnvVirtualMediaBootParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithInfraEnvVirtualMediaBootParams adds the infraEnvVirtualMediaBootParams to the v2 infra env virtual media boot params
+func (o *V2InfraEnvVirtualMediaBootParams) WithInfraEnvVirtualMediaBootParams(infraEnvVirtualMediaBootParams *models.InfraEnvVirtualMediaBootParams) *V2InfraEnvVirtualMediaBootParams {
+	o.SetInfraEnvVirtualMediaBootParams(infraEnvVirtualMediaBootParams)
+	return o
+}
+
+// SetInfraEnvVirtualMediaBootParams adds the infraEnvVirtualMediaBootParams to the v2 infra env virtual media boot params
+func (o *V2InfraEnvVirtualMediaBootParams) SetInfraEnvVirtualMediaBootParams(infraEnvVirtualMediaBootParams *models.InfraEnvVirtualMediaBootParams) {
+	o.InfraEnvVirtualMediaBootParams = infraEnvVirtualMediaBootParams
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 infra env virtual media boot params
+func (o *V2InfraEnvVirtualMediaBootParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2InfraEnvVirtualMediaBootParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 infra env virtual media boot params
+func (o *V2InfraEnvVirtualMediaBootParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2InfraEnvVirtualMediaBootParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.InfraEnvVirtualMediaBootParams != nil {
+		if err := r.SetBodyParam(o.InfraEnvVirtualMediaBootParams); err != nil {
+			return err
+		}
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
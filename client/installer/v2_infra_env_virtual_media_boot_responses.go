@@ -0,0 +1,650 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2InfraEnvVirtualMediaBootReader is a Reader for the V2InfraEnvVirtualMediaBoot structure.
+type V2InfraEnvVirtualMediaBootReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2InfraEnvVirtualMediaBootReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2InfraEnvVirtualMediaBootAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2InfraEnvVirtualMediaBootBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2InfraEnvVirtualMediaBootUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2InfraEnvVirtualMediaBootForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2InfraEnvVirtualMediaBootNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2InfraEnvVirtualMediaBootMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2InfraEnvVirtualMediaBootConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2InfraEnvVirtualMediaBootInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewV2InfraEnvVirtualMediaBootServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2InfraEnvVirtualMediaBootAccepted creates a V2InfraEnvVirtualMediaBootAccepted with default headers values
+func NewV2InfraEnvVirtualMediaBootAccepted() *V2InfraEnvVirtualMediaBootAccepted {
+	return &V2InfraEnvVirtualMediaBootAccepted{}
+}
+
+/*
+V2InfraEnvVirtualMediaBootAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2InfraEnvVirtualMediaBootAccepted struct {
+	Payload *models.InfraEnvVirtualMediaBoot
+}
+
+// IsSuccess returns true when this v2 infra env virtual media boot accepted response has a 2xx status code
+func (o *V2InfraEnvVirtualMediaBootAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 infra env virtual media boot accepted response has a 3xx status code
+func (o *V2InfraEnvVirtualMediaBootAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 infra env virtual media boot accepted response has a 4xx status code
+func (o *V2InfraEnvVirtualMediaBootAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 infra env virtual media boot accepted response has a 5xx status code
+func (o *V2InfraEnvVirtualMediaBootAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 infra env virtual media boot accepted response a status code equal to that given
+func (o *V2InfraEnvVirtualMediaBootAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+func (o *V2InfraEnvVirtualMediaBootAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootAccepted) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootAccepted) GetPayload() *models.InfraEnvVirtualMediaBoot {
+	return o.Payload
+}
+
+func (o *V2InfraEnvVirtualMediaBootAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraEnvVirtualMediaBoot)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InfraEnvVirtualMediaBootBadRequest creates a V2InfraEnvVirtualMediaBootBadRequest with default headers values
+func NewV2InfraEnvVirtualMediaBootBadRequest() *V2InfraEnvVirtualMediaBootBadRequest {
+	return &V2InfraEnvVirtualMediaBootBadRequest{}
+}
+
+/*
+V2InfraEnvVirtualMediaBootBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2InfraEnvVirtualMediaBootBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 infra env virtual media boot bad request response has a 2xx status code
+func (o *V2InfraEnvVirtualMediaBootBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 infra env virtual media boot bad request response has a 3xx status code
+func (o *V2InfraEnvVirtualMediaBootBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 infra env virtual media boot bad request response has a 4xx status code
+func (o *V2InfraEnvVirtualMediaBootBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 infra env virtual media boot bad request response has a 5xx status code
+func (o *V2InfraEnvVirtualMediaBootBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 infra env virtual media boot bad request response a status code equal to that given
+func (o *V2InfraEnvVirtualMediaBootBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2InfraEnvVirtualMediaBootBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InfraEnvVirtualMediaBootBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InfraEnvVirtualMediaBootUnauthorized creates a V2InfraEnvVirtualMediaBootUnauthorized with default headers values
+func NewV2InfraEnvVirtualMediaBootUnauthorized() *V2InfraEnvVirtualMediaBootUnauthorized {
+	return &V2InfraEnvVirtualMediaBootUnauthorized{}
+}
+
+/*
+V2InfraEnvVirtualMediaBootUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2InfraEnvVirtualMediaBootUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 infra env virtual media boot unauthorized response has a 2xx status code
+func (o *V2InfraEnvVirtualMediaBootUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 infra env virtual media boot unauthorized response has a 3xx status code
+func (o *V2InfraEnvVirtualMediaBootUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 infra env virtual media boot unauthorized response has a 4xx status code
+func (o *V2InfraEnvVirtualMediaBootUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 infra env virtual media boot unauthorized response has a 5xx status code
+func (o *V2InfraEnvVirtualMediaBootUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 infra env virtual media boot unauthorized response a status code equal to that given
+func (o *V2InfraEnvVirtualMediaBootUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2InfraEnvVirtualMediaBootUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2InfraEnvVirtualMediaBootUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InfraEnvVirtualMediaBootForbidden creates a V2InfraEnvVirtualMediaBootForbidden with default headers values
+func NewV2InfraEnvVirtualMediaBootForbidden() *V2InfraEnvVirtualMediaBootForbidden {
+	return &V2InfraEnvVirtualMediaBootForbidden{}
+}
+
+/*
+V2InfraEnvVirtualMediaBootForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2InfraEnvVirtualMediaBootForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 infra env virtual media boot forbidden response has a 2xx status code
+func (o *V2InfraEnvVirtualMediaBootForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 infra env virtual media boot forbidden response has a 3xx status code
+func (o *V2InfraEnvVirtualMediaBootForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 infra env virtual media boot forbidden response has a 4xx status code
+func (o *V2InfraEnvVirtualMediaBootForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 infra env virtual media boot forbidden response has a 5xx status code
+func (o *V2InfraEnvVirtualMediaBootForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 infra env virtual media boot forbidden response a status code equal to that given
+func (o *V2InfraEnvVirtualMediaBootForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2InfraEnvVirtualMediaBootForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2InfraEnvVirtualMediaBootForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InfraEnvVirtualMediaBootNotFound creates a V2InfraEnvVirtualMediaBootNotFound with default headers values
+func NewV2InfraEnvVirtualMediaBootNotFound() *V2InfraEnvVirtualMediaBootNotFound {
+	return &V2InfraEnvVirtualMediaBootNotFound{}
+}
+
+/*
+V2InfraEnvVirtualMediaBootNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2InfraEnvVirtualMediaBootNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 infra env virtual media boot not found response has a 2xx status code
+func (o *V2InfraEnvVirtualMediaBootNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 infra env virtual media boot not found response has a 3xx status code
+func (o *V2InfraEnvVirtualMediaBootNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 infra env virtual media boot not found response has a 4xx status code
+func (o *V2InfraEnvVirtualMediaBootNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 infra env virtual media boot not found response has a 5xx status code
+func (o *V2InfraEnvVirtualMediaBootNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 infra env virtual media boot not found response a status code equal to that given
+func (o *V2InfraEnvVirtualMediaBootNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2InfraEnvVirtualMediaBootNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InfraEnvVirtualMediaBootNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InfraEnvVirtualMediaBootMethodNotAllowed creates a V2InfraEnvVirtualMediaBootMethodNotAllowed with default headers values
+func NewV2InfraEnvVirtualMediaBootMethodNotAllowed() *V2InfraEnvVirtualMediaBootMethodNotAllowed {
+	return &V2InfraEnvVirtualMediaBootMethodNotAllowed{}
+}
+
+/*
+V2InfraEnvVirtualMediaBootMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2InfraEnvVirtualMediaBootMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 infra env virtual media boot method not allowed response has a 2xx status code
+func (o *V2InfraEnvVirtualMediaBootMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 infra env virtual media boot method not allowed response has a 3xx status code
+func (o *V2InfraEnvVirtualMediaBootMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 infra env virtual media boot method not allowed response has a 4xx status code
+func (o *V2InfraEnvVirtualMediaBootMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 infra env virtual media boot method not allowed response has a 5xx status code
+func (o *V2InfraEnvVirtualMediaBootMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 infra env virtual media boot method not allowed response a status code equal to that given
+func (o *V2InfraEnvVirtualMediaBootMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2InfraEnvVirtualMediaBootMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootMethodNotAllowed) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InfraEnvVirtualMediaBootMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InfraEnvVirtualMediaBootConflict creates a V2InfraEnvVirtualMediaBootConflict with default headers values
+func NewV2InfraEnvVirtualMediaBootConflict() *V2InfraEnvVirtualMediaBootConflict {
+	return &V2InfraEnvVirtualMediaBootConflict{}
+}
+
+/*
+V2InfraEnvVirtualMediaBootConflict describes a response with status code 409, with default header values.
+
+Conflict.
+*/
+type V2InfraEnvVirtualMediaBootConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 infra env virtual media boot conflict response has a 2xx status code
+func (o *V2InfraEnvVirtualMediaBootConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 infra env virtual media boot conflict response has a 3xx status code
+func (o *V2InfraEnvVirtualMediaBootConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 infra env virtual media boot conflict response has a 4xx status code
+func (o *V2InfraEnvVirtualMediaBootConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 infra env virtual media boot conflict response has a 5xx status code
+func (o *V2InfraEnvVirtualMediaBootConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 infra env virtual media boot conflict response a status code equal to that given
+func (o *V2InfraEnvVirtualMediaBootConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2InfraEnvVirtualMediaBootConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootConflict) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InfraEnvVirtualMediaBootConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InfraEnvVirtualMediaBootInternalServerError creates a V2InfraEnvVirtualMediaBootInternalServerError with default headers values
+func NewV2InfraEnvVirtualMediaBootInternalServerError() *V2InfraEnvVirtualMediaBootInternalServerError {
+	return &V2InfraEnvVirtualMediaBootInternalServerError{}
+}
+
+/*
+V2InfraEnvVirtualMediaBootInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2InfraEnvVirtualMediaBootInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 infra env virtual media boot internal server error response has a 2xx status code
+func (o *V2InfraEnvVirtualMediaBootInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 infra env virtual media boot internal server error response has a 3xx status code
+func (o *V2InfraEnvVirtualMediaBootInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 infra env virtual media boot internal server error response has a 4xx status code
+func (o *V2InfraEnvVirtualMediaBootInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 infra env virtual media boot internal server error response has a 5xx status code
+func (o *V2InfraEnvVirtualMediaBootInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 infra env virtual media boot internal server error response a status code equal to that given
+func (o *V2InfraEnvVirtualMediaBootInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2InfraEnvVirtualMediaBootInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InfraEnvVirtualMediaBootInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InfraEnvVirtualMediaBootServiceUnavailable creates a V2InfraEnvVirtualMediaBootServiceUnavailable with default headers values
+func NewV2InfraEnvVirtualMediaBootServiceUnavailable() *V2InfraEnvVirtualMediaBootServiceUnavailable {
+	return &V2InfraEnvVirtualMediaBootServiceUnavailable{}
+}
+
+/*
+V2InfraEnvVirtualMediaBootServiceUnavailable describes a response with status code 503, with default header values.
+
+Unavailable.
+*/
+type V2InfraEnvVirtualMediaBootServiceUnavailable struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 infra env virtual media boot service unavailable response has a 2xx status code
+func (o *V2InfraEnvVirtualMediaBootServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 infra env virtual media boot service unavailable response has a 3xx status code
+func (o *V2InfraEnvVirtualMediaBootServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 infra env virtual media boot service unavailable response has a 4xx status code
+func (o *V2InfraEnvVirtualMediaBootServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 infra env virtual media boot service unavailable response has a 5xx status code
+func (o *V2InfraEnvVirtualMediaBootServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 infra env virtual media boot service unavailable response a status code equal to that given
+func (o *V2InfraEnvVirtualMediaBootServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+func (o *V2InfraEnvVirtualMediaBootServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootServiceUnavailable) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot][%d] v2InfraEnvVirtualMediaBootServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *V2InfraEnvVirtualMediaBootServiceUnavailable) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InfraEnvVirtualMediaBootServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2RemoveHostFromClusterParams creates a new V2RemoveHostFromClusterParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2RemoveHostFromClusterParams() *V2RemoveHostFromClusterParams {
+	return &V2RemoveHostFromClusterParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2RemoveHostFromClusterParamsWithTimeout creates a new V2RemoveHostFromClusterParams object
+// with the ability to set a timeout on a request.
+func NewV2RemoveHostFromClusterParamsWithTimeout(timeout time.Duration) *V2RemoveHostFromClusterParams {
+	return &V2RemoveHostFromClusterParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2RemoveHostFromClusterParamsWithContext creates a new V2RemoveHostFromClusterParams object
+// with the ability to set a context for a request.
+func NewV2RemoveHostFromClusterParamsWithContext(ctx context.Context) *V2RemoveHostFromClusterParams {
+	return &V2RemoveHostFromClusterParams{
+		Context: ctx,
+	}
+}
+
+// NewV2RemoveHostFromClusterParamsWithHTTPClient creates a new V2RemoveHostFromClusterParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2RemoveHostFromClusterParamsWithHTTPClient(client *http.Client) *V2RemoveHostFromClusterParams {
+	return &V2RemoveHostFromClusterParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2RemoveHostFromClusterParams contains all the parameters to send to the API endpoint
+
+	for the v2 remove host from cluster operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2RemoveHostFromClusterParams struct {
+
+	/* HostNodeRemovalParams.
+
+	   The parameters of the removal of the node.
+	*/
+	HostNodeRemovalParams *models.HostNodeRemovalParams
+
+	/* HostID.
+
+	   The host that is being removed.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the host that is being removed.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 remove host from cluster params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RemoveHostFromClusterParams) WithDefaults() *V2RemoveHostFromClusterParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 remove host from cluster params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RemoveHostFromClusterParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 remove host from cluster params
+func (o *V2RemoveHostFromClusterParams) WithTimeout(timeout time.Duration) *V2RemoveHostFromClusterParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 remove host from cluster params
+func (o *V2RemoveHostFromClusterParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 remove host from cluster params
+func (o *V2RemoveHostFromClusterParams) WithContext(ctx context.Context) *V2RemoveHostFromClusterParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 remove host from cluster params
+func (o *V2RemoveHostFromClusterParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 remove host from cluster params
+func (o *V2RemoveHostFromClusterParams) WithHTTPClient(client *http.Client) *V2RemoveHostFromClusterParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 remove host from cluster params
+func (o *V2RemoveHostFromClusterParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithHostNodeRemovalParams adds the hostNodeRemovalParams to the v2 remove host from cluster params
+func (o *V2RemoveHostFromClusterParams) WithHostNodeRemovalParams(hostNodeRemovalParams *models.HostNodeRemovalParams) *V2RemoveHostFromClusterParams {
+	o.SetHostNodeRemovalParams(hostNodeRemovalParams)
+	return o
+}
+
+// SetHostNodeRemovalParams adds the hostNodeRemovalParams to the v2 remove host from cluster params
+func (o *V2RemoveHostFromClusterParams) SetHostNodeRemovalParams(hostNodeRemovalParams *models.HostNodeRemovalParams) {
+	o.HostNodeRemovalParams = hostNodeRemovalParams
+}
+
+// WithHostID adds the hostID to the v2 remove host from cluster params
+func (o *V2RemoveHostFromClusterParams) WithHostID(hostID strfmt.UUID) *V2RemoveHostFromClusterParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 remove host from cluster params
+func (o *V2RemoveHostFromClusterParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 remove host from cluster params
+func (o *V2RemoveHostFromClusterParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2RemoveHostFromClusterParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 remove host from cluster params
+func (o *V2RemoveHostFromClusterParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2RemoveHostFromClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.HostNodeRemovalParams != nil {
+		if err := r.SetBodyParam(o.HostNodeRemovalParams); err != nil {
+			return err
+		}
+	}
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2UpdateHostBmcCredentialsParams creates a new V2UpdateHostBmcCredentialsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2UpdateHostBmcCredentialsParams() *V2UpdateHostBmcCredentialsParams {
+	return &V2UpdateHostBmcCredentialsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2UpdateHostBmcCredentialsParamsWithTimeout creates a new V2UpdateHostBmcCredentialsParams object
+// with the ability to set a timeout on a request.
+func NewV2UpdateHostBmcCredentialsParamsWithTimeout(timeout time.Duration) *V2UpdateHostBmcCredentialsParams {
+	return &V2UpdateHostBmcCredentialsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2UpdateHostBmcCredentialsParamsWithContext creates a new V2UpdateHostBmcCredentialsParams object
+// with the ability to set a context for a request.
+func NewV2UpdateHostBmcCredentialsParamsWithContext(ctx context.Context) *V2UpdateHostBmcCredentialsParams {
+	return &V2UpdateHostBmcCredentialsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2UpdateHostBmcCredentialsParamsWithHTTPClient creates a new V2UpdateHostBmcCredentialsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2UpdateHostBmcCredentialsParamsWithHTTPClient(client *http.Client) *V2UpdateHostBmcCredentialsParams {
+	return &V2UpdateHostBmcCredentialsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2UpdateHostBmcCredentialsParams contains all the parameters to send to the API endpoint
+
+	for the v2 update host bmc credentials operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2UpdateHostBmcCredentialsParams struct {
+
+	/* HostBmcCredentials.
+
+	   The credentials of the BMC of the host.
+	*/
+	HostBmcCredentials *models.HostBmcCredentials
+
+	/* HostID.
+
+	   The host whose BMC credentials are set.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the host whose BMC credentials are set.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 update host bmc credentials params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateHostBmcCredentialsParams) WithDefaults() *V2UpdateHostBmcCredentialsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 update host bmc credentials params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateHostBmcCredentialsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 update host bmc credentials params
+func (o *V2UpdateHostBmcCredentialsParams) WithTimeout(timeout time.Duration) *V2UpdateHostBmcCredentialsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 update host bmc credentials params
+func (o *V2UpdateHostBmcCredentialsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 update host bmc credentials params
+func (o *V2UpdateHostBmcCredentialsParams) WithContext(ctx context.Context) *V2UpdateHostBmcCredentialsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 update host bmc credentials params
+func (o *V2UpdateHostBmcCredentialsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 update host bmc credentials params
+func (o *V2UpdateHostBmcCredentialsParams) WithHTTPClient(client *http.Client) *V2UpdateHostBmcCredentialsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 update host bmc credentials params
+func (o *V2UpdateHostBmcCredentialsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithHostBmcCredentials adds the hostBmcCredentials to the v2 update host bmc credentials params
+func (o *V2UpdateHostBmcCredentialsParams) WithHostBmcCredentials(hostBmcCredentials *models.HostBmcCredentials) *V2UpdateHostBmcCredentialsParams {
+	o.SetHostBmcCredentials(hostBmcCredentials)
+	return o
+}
+
+// SetHostBmcCredentials adds the hostBmcCredentials to the v2 update host bmc credentials params
+func (o *V2UpdateHostBmcCredentialsParams) SetHostBmcCredentials(hostBmcCredentials *models.HostBmcCredentials) {
+	o.HostBmcCredentials = hostBmcCredentials
+}
+
+// WithHostID adds the hostID to the v2 update host bmc credentials params
+func (o *V2UpdateHostBmcCredentialsParams) WithHostID(hostID strfmt.UUID) *V2UpdateHostBmcCredentialsParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 update host bmc credentials params
+func (o *V2UpdateHostBmcCredentialsParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 update host bmc credentials params
+func (o *V2UpdateHostBmcCredentialsParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2UpdateHostBmcCredentialsParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 update host bmc credentials params
+func (o *V2UpdateHostBmcCredentialsParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2UpdateHostBmcCredentialsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.HostBmcCredentials != nil {
+		if err := r.SetBodyParam(o.HostBmcCredentials); err != nil {
+			return err
+		}
+	}
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
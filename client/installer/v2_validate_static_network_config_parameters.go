@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2ValidateStaticNetworkConfigParams creates a new V2ValidateStaticNetworkConfigParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ValidateStaticNetworkConfigParams() *V2ValidateStaticNetworkConfigParams {
+	return &V2ValidateStaticNetworkConfigParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ValidateStaticNetworkConfigParamsWithTimeout creates a new V2ValidateStaticNetworkConfigParams object
+// with the ability to set a timeout on a request.
+func NewV2ValidateStaticNetworkConfigParamsWithTimeout(timeout time.Duration) *V2ValidateStaticNetworkConfigParams {
+	return &V2ValidateStaticNetworkConfigParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ValidateStaticNetworkConfigParamsWithContext creates a new V2ValidateStaticNetworkConfigParams object
+// with the ability to set a context for a request.
+func NewV2ValidateStaticNetworkConfigParamsWithContext(ctx context.Context) *V2ValidateStaticNetworkConfigParams {
+	return &V2ValidateStaticNetworkConfigParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ValidateStaticNetworkConfigParamsWithHTTPClient creates a new V2ValidateStaticNetworkConfigParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ValidateStaticNetworkConfigParamsWithHTTPClient(client *http.Client) *V2ValidateStaticNetworkConfigParams {
+	return &V2ValidateStaticNetworkConfigParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ValidateStaticNetworkConfigParams contains all the parameters to send to the API endpoint
+
+	for the v2 validate static network config operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ValidateStaticNetworkConfigParams struct {
+
+	/* StaticNetworkConfigValidationParams.
+
+	   The static network configuration to validate.
+	*/
+	StaticNetworkConfigValidationParams *models.StaticNetworkConfigValidationParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 validate static network config params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ValidateStaticNetworkConfigParams) WithDefaults() *V2ValidateStaticNetworkConfigParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 validate static network config params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ValidateStaticNetworkConfigParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 validate static network config params
+func (o *V2ValidateStaticNetworkConfigParams) WithTimeout(timeout time.Duration) *V2ValidateStaticNetworkConfigParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 validate static network config params
+func (o *V2ValidateStaticNetworkConfigParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 validate static network config params
+func (o *V2ValidateStaticNetworkConfigParams) WithContext(ctx context.Context) *V2ValidateStaticNetworkConfigParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 validate static network config params
+func (o *V2ValidateStaticNetworkConfigParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 validate static network config params
+func (o *V2ValidateStaticNetworkConfigParams) WithHTTPClient(client *http.Client) *V2ValidateStaticNetworkConfigParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 validate static network config params
+func (o *V2ValidateStaticNetworkConfigParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithStaticNetworkConfigValidationParams adds the staticNetworkConfigValidationParams to the v2 validate static network config params
+func (o *V2ValidateStaticNetworkConfigParams) WithStaticNetworkConfigValidationParams(staticNetworkConfigValidationParams *models.StaticNetworkConfigValidationParams) *V2ValidateStaticNetworkConfigParams {
+	o.SetStaticNetworkConfigValidationParams(staticNetworkConfigValidationParams)
+	return o
+}
+
+// SetStaticNetworkConfigValidationParams adds the staticNetworkConfigValidationParams to the v2 validate static network config params
+func (o *V2ValidateStaticNetworkConfigParams) SetStaticNetworkConfigValidationParams(staticNetworkConfigValidationParams *models.StaticNetworkConfigValidationParams) {
+	o.StaticNetworkConfigValidationParams = staticNetworkConfigValidationParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ValidateStaticNetworkConfigParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.StaticNetworkConfigValidationParams != nil {
+		if err := r.SetBodyParam(o.StaticNetworkConfigValidationParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ValidateStaticNetworkConfigReader is a Reader for the V2ValidateStaticNetworkConfig structure.
+type V2ValidateStaticNetworkConfigReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ValidateStaticNetworkConfigReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ValidateStaticNetworkConfigOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2ValidateStaticNetworkConfigBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2ValidateStaticNetworkConfigUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ValidateStaticNetworkConfigForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2ValidateStaticNetworkConfigMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ValidateStaticNetworkConfigInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewV2ValidateStaticNetworkConfigServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ValidateStaticNetworkConfigOK creates a V2ValidateStaticNetworkConfigOK with default headers values
+func NewV2ValidateStaticNetworkConfigOK() *V2ValidateStaticNetworkConfigOK {
+	return &V2ValidateStaticNetworkConfigOK{}
+}
+
+/*
+V2ValidateStaticNetworkConfigOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ValidateStaticNetworkConfigOK struct {
+	Payload *models.StaticNetworkConfigValidation
+}
+
+// IsSuccess returns true when this v2 validate static network config o k response has a 2xx status code
+func (o *V2ValidateStaticNetworkConfigOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 validate static network config o k response has a 3xx status code
+func (o *V2ValidateStaticNetworkConfigOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 validate static network config o k response has a 4xx status code
+func (o *V2ValidateStaticNetworkConfigOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 validate static network config o k response has a 5xx status code
+func (o *V2ValidateStaticNetworkConfigOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 validate static network config o k response a status code equal to that given
+func (o *V2ValidateStaticNetworkConfigOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ValidateStaticNetworkConfigOK) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigOK) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigOK) GetPayload() *models.StaticNetworkConfigValidation {
+	return o.Payload
+}
+
+func (o *V2ValidateStaticNetworkConfigOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.StaticNetworkConfigValidation)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ValidateStaticNetworkConfigBadRequest creates a V2ValidateStaticNetworkConfigBadRequest with default headers values
+func NewV2ValidateStaticNetworkConfigBadRequest() *V2ValidateStaticNetworkConfigBadRequest {
+	return &V2ValidateStaticNetworkConfigBadRequest{}
+}
+
+/*
+V2ValidateStaticNetworkConfigBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2ValidateStaticNetworkConfigBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 validate static network config bad request response has a 2xx status code
+func (o *V2ValidateStaticNetworkConfigBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 validate static network config bad request response has a 3xx status code
+func (o *V2ValidateStaticNetworkConfigBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 validate static network config bad request response has a 4xx status code
+func (o *V2ValidateStaticNetworkConfigBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 validate static network config bad request response has a 5xx status code
+func (o *V2ValidateStaticNetworkConfigBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 validate static network config bad request response a status code equal to that given
+func (o *V2ValidateStaticNetworkConfigBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2ValidateStaticNetworkConfigBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ValidateStaticNetworkConfigBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ValidateStaticNetworkConfigUnauthorized creates a V2ValidateStaticNetworkConfigUnauthorized with default headers values
+func NewV2ValidateStaticNetworkConfigUnauthorized() *V2ValidateStaticNetworkConfigUnauthorized {
+	return &V2ValidateStaticNetworkConfigUnauthorized{}
+}
+
+/*
+V2ValidateStaticNetworkConfigUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ValidateStaticNetworkConfigUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 validate static network config unauthorized response has a 2xx status code
+func (o *V2ValidateStaticNetworkConfigUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 validate static network config unauthorized response has a 3xx status code
+func (o *V2ValidateStaticNetworkConfigUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 validate static network config unauthorized response has a 4xx status code
+func (o *V2ValidateStaticNetworkConfigUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 validate static network config unauthorized response has a 5xx status code
+func (o *V2ValidateStaticNetworkConfigUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 validate static network config unauthorized response a status code equal to that given
+func (o *V2ValidateStaticNetworkConfigUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ValidateStaticNetworkConfigUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ValidateStaticNetworkConfigUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ValidateStaticNetworkConfigForbidden creates a V2ValidateStaticNetworkConfigForbidden with default headers values
+func NewV2ValidateStaticNetworkConfigForbidden() *V2ValidateStaticNetworkConfigForbidden {
+	return &V2ValidateStaticNetworkConfigForbidden{}
+}
+
+/*
+V2ValidateStaticNetworkConfigForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ValidateStaticNetworkConfigForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 validate static network config forbidden response has a 2xx status code
+func (o *V2ValidateStaticNetworkConfigForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 validate static network config forbidden response has a 3xx status code
+func (o *V2ValidateStaticNetworkConfigForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 validate static network config forbidden response has a 4xx status code
+func (o *V2ValidateStaticNetworkConfigForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 validate static network config forbidden response has a 5xx status code
+func (o *V2ValidateStaticNetworkConfigForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 validate static network config forbidden response a status code equal to that given
+func (o *V2ValidateStaticNetworkConfigForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ValidateStaticNetworkConfigForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ValidateStaticNetworkConfigForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ValidateStaticNetworkConfigMethodNotAllowed creates a V2ValidateStaticNetworkConfigMethodNotAllowed with default headers values
+func NewV2ValidateStaticNetworkConfigMethodNotAllowed() *V2ValidateStaticNetworkConfigMethodNotAllowed {
+	return &V2ValidateStaticNetworkConfigMethodNotAllowed{}
+}
+
+/*
+V2ValidateStaticNetworkConfigMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2ValidateStaticNetworkConfigMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 validate static network config method not allowed response has a 2xx status code
+func (o *V2ValidateStaticNetworkConfigMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 validate static network config method not allowed response has a 3xx status code
+func (o *V2ValidateStaticNetworkConfigMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 validate static network config method not allowed response has a 4xx status code
+func (o *V2ValidateStaticNetworkConfigMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 validate static network config method not allowed response has a 5xx status code
+func (o *V2ValidateStaticNetworkConfigMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 validate static network config method not allowed response a status code equal to that given
+func (o *V2ValidateStaticNetworkConfigMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2ValidateStaticNetworkConfigMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigMethodNotAllowed) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ValidateStaticNetworkConfigMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ValidateStaticNetworkConfigInternalServerError creates a V2ValidateStaticNetworkConfigInternalServerError with default headers values
+func NewV2ValidateStaticNetworkConfigInternalServerError() *V2ValidateStaticNetworkConfigInternalServerError {
+	return &V2ValidateStaticNetworkConfigInternalServerError{}
+}
+
+/*
+V2ValidateStaticNetworkConfigInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ValidateStaticNetworkConfigInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 validate static network config internal server error response has a 2xx status code
+func (o *V2ValidateStaticNetworkConfigInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 validate static network config internal server error response has a 3xx status code
+func (o *V2ValidateStaticNetworkConfigInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 validate static network config internal server error response has a 4xx status code
+func (o *V2ValidateStaticNetworkConfigInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 validate static network config internal server error response has a 5xx status code
+func (o *V2ValidateStaticNetworkConfigInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 validate static network config internal server error response a status code equal to that given
+func (o *V2ValidateStaticNetworkConfigInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ValidateStaticNetworkConfigInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ValidateStaticNetworkConfigInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ValidateStaticNetworkConfigServiceUnavailable creates a V2ValidateStaticNetworkConfigServiceUnavailable with default headers values
+func NewV2ValidateStaticNetworkConfigServiceUnavailable() *V2ValidateStaticNetworkConfigServiceUnavailable {
+	return &V2ValidateStaticNetworkConfigServiceUnavailable{}
+}
+
+/*
+V2ValidateStaticNetworkConfigServiceUnavailable describes a response with status code 503, with default header values.
+
+Unavailable.
+*/
+type V2ValidateStaticNetworkConfigServiceUnavailable struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 validate static network config service unavailable response has a 2xx status code
+func (o *V2ValidateStaticNetworkConfigServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 validate static network config service unavailable response has a 3xx status code
+func (o *V2ValidateStaticNetworkConfigServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 validate static network config service unavailable response has a 4xx status code
+func (o *V2ValidateStaticNetworkConfigServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 validate static network config service unavailable response has a 5xx status code
+func (o *V2ValidateStaticNetworkConfigServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 validate static network config service unavailable response a status code equal to that given
+func (o *V2ValidateStaticNetworkConfigServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+func (o *V2ValidateStaticNetworkConfigServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigServiceUnavailable) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/validate-static-network][%d] v2ValidateStaticNetworkConfigServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *V2ValidateStaticNetworkConfigServiceUnavailable) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ValidateStaticNetworkConfigServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
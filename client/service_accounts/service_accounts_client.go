@@ -0,0 +1,206 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package service_accounts
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the service accounts client
+type API interface {
+	/*
+	   V2CreateServiceAccount Creates a service account. API tokens are issued to service accounts for automation such as CI.*/
+	V2CreateServiceAccount(ctx context.Context, params *V2CreateServiceAccountParams) (*V2CreateServiceAccountCreated, error)
+	/*
+	   V2CreateServiceAccountToken Issues an API token to a service account. The secret of the token is only returned by this request.*/
+	V2CreateServiceAccountToken(ctx context.Context, params *V2CreateServiceAccountTokenParams) (*V2CreateServiceAccountTokenCreated, error)
+	/*
+	   V2DeleteServiceAccount Deletes a service account and its API tokens.*/
+	V2DeleteServiceAccount(ctx context.Context, params *V2DeleteServiceAccountParams) (*V2DeleteServiceAccountNoContent, error)
+	/*
+	   V2ListServiceAccountTokens Retrieves the API tokens of a service account, without their secrets.*/
+	V2ListServiceAccountTokens(ctx context.Context, params *V2ListServiceAccountTokensParams) (*V2ListServiceAccountTokensOK, error)
+	/*
+	   V2ListServiceAccounts Retrieves the service accounts.*/
+	V2ListServiceAccounts(ctx context.Context, params *V2ListServiceAccountsParams) (*V2ListServiceAccountsOK, error)
+	/*
+	   V2RevokeServiceAccountToken Revokes an API token of a service account.*/
+	V2RevokeServiceAccountToken(ctx context.Context, params *V2RevokeServiceAccountTokenParams) (*V2RevokeServiceAccountTokenNoContent, error)
+}
+
+// New creates a new service accounts API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for service accounts API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2CreateServiceAccount Creates a service account. API tokens are issued to service accounts for automation such as CI.
+*/
+func (a *Client) V2CreateServiceAccount(ctx context.Context, params *V2CreateServiceAccountParams) (*V2CreateServiceAccountCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2CreateServiceAccount",
+		Method:             "POST",
+		PathPattern:        "/v2/service-accounts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2CreateServiceAccountReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2CreateServiceAccountCreated), nil
+
+}
+
+/*
+V2CreateServiceAccountToken Issues an API token to a service account. The secret of the token is only returned by this request.
+*/
+func (a *Client) V2CreateServiceAccountToken(ctx context.Context, params *V2CreateServiceAccountTokenParams) (*V2CreateServiceAccountTokenCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2CreateServiceAccountToken",
+		Method:             "POST",
+		PathPattern:        "/v2/service-accounts/{service_account_id}/tokens",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2CreateServiceAccountTokenReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2CreateServiceAccountTokenCreated), nil
+
+}
+
+/*
+V2DeleteServiceAccount Deletes a service account and its API tokens.
+*/
+func (a *Client) V2DeleteServiceAccount(ctx context.Context, params *V2DeleteServiceAccountParams) (*V2DeleteServiceAccountNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeleteServiceAccount",
+		Method:             "DELETE",
+		PathPattern:        "/v2/service-accounts/{service_account_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeleteServiceAccountReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeleteServiceAccountNoContent), nil
+
+}
+
+/*
+V2ListServiceAccountTokens Retrieves the API tokens of a service account, without their secrets.
+*/
+func (a *Client) V2ListServiceAccountTokens(ctx context.Context, params *V2ListServiceAccountTokensParams) (*V2ListServiceAccountTokensOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListServiceAccountTokens",
+		Method:             "GET",
+		PathPattern:        "/v2/service-accounts/{service_account_id}/tokens",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListServiceAccountTokensReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListServiceAccountTokensOK), nil
+
+}
+
+/*
+V2ListServiceAccounts Retrieves the service accounts.
+*/
+func (a *Client) V2ListServiceAccounts(ctx context.Context, params *V2ListServiceAccountsParams) (*V2ListServiceAccountsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListServiceAccounts",
+		Method:             "GET",
+		PathPattern:        "/v2/service-accounts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListServiceAccountsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListServiceAccountsOK), nil
+
+}
+
+/*
+V2RevokeServiceAccountToken Revokes an API token of a service account.
+*/
+func (a *Client) V2RevokeServiceAccountToken(ctx context.Context, params *V2RevokeServiceAccountTokenParams) (*V2RevokeServiceAccountTokenNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RevokeServiceAccountToken",
+		Method:             "DELETE",
+		PathPattern:        "/v2/service-accounts/{service_account_id}/tokens/{token_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RevokeServiceAccountTokenReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RevokeServiceAccountTokenNoContent), nil
+
+}
@@ -17,15 +17,26 @@ import (
 
 // API is the interface of the versions client
 type API interface {
+	/*
+	   V2DeregisterCustomReleaseImage Deregisters a custom release image.*/
+	V2DeregisterCustomReleaseImage(ctx context.Context, params *V2DeregisterCustomReleaseImageParams) (*V2DeregisterCustomReleaseImageNoContent, error)
 	/*
 	   V2ListComponentVersions List of component versions.*/
 	V2ListComponentVersions(ctx context.Context, params *V2ListComponentVersionsParams) (*V2ListComponentVersionsOK, error)
+	/*
+	   V2ListCustomReleaseImages Retrieves the custom release images that are registered by the organization of the user.*/
+	V2ListCustomReleaseImages(ctx context.Context, params *V2ListCustomReleaseImagesParams) (*V2ListCustomReleaseImagesOK, error)
 	/*
 	   V2ListReleaseSources Retrieves openshift release sources configuration.*/
 	V2ListReleaseSources(ctx context.Context, params *V2ListReleaseSourcesParams) (*V2ListReleaseSourcesOK, error)
 	/*
 	   V2ListSupportedOpenshiftVersions Retrieves the list of OpenShift supported versions.*/
 	V2ListSupportedOpenshiftVersions(ctx context.Context, params *V2ListSupportedOpenshiftVersionsParams) (*V2ListSupportedOpenshiftVersionsOK, error)
+	/*
+	   V2RegisterCustomReleaseImage Registers a custom release image, such as a nightly or a custom built payload. The OpenShift version and
+	   the CPU architectures are read from the metadata of the release image, and the release image is available
+	   in the OpenShift versions of the organization of the user.*/
+	V2RegisterCustomReleaseImage(ctx context.Context, params *V2RegisterCustomReleaseImageParams) (*V2RegisterCustomReleaseImageCreated, error)
 }
 
 // New creates a new versions API client.
@@ -46,6 +57,31 @@ type Client struct {
 	authInfo  runtime.ClientAuthInfoWriter
 }
 
+/*
+V2DeregisterCustomReleaseImage Deregisters a custom release image.
+*/
+func (a *Client) V2DeregisterCustomReleaseImage(ctx context.Context, params *V2DeregisterCustomReleaseImageParams) (*V2DeregisterCustomReleaseImageNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeregisterCustomReleaseImage",
+		Method:             "DELETE",
+		PathPattern:        "/v2/custom-release-images/{custom_release_image_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeregisterCustomReleaseImageReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeregisterCustomReleaseImageNoContent), nil
+
+}
+
 /*
 V2ListComponentVersions List of component versions.
 */
@@ -71,6 +107,31 @@ func (a *Client) V2ListComponentVersions(ctx context.Context, params *V2ListComp
 
 }
 
+/*
+V2ListCustomReleaseImages Retrieves the custom release images that are registered by the organization of the user.
+*/
+func (a *Client) V2ListCustomReleaseImages(ctx context.Context, params *V2ListCustomReleaseImagesParams) (*V2ListCustomReleaseImagesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListCustomReleaseImages",
+		Method:             "GET",
+		PathPattern:        "/v2/custom-release-images",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListCustomReleaseImagesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListCustomReleaseImagesOK), nil
+
+}
+
 /*
 V2ListReleaseSources Retrieves openshift release sources configuration.
 */
@@ -120,3 +181,30 @@ func (a *Client) V2ListSupportedOpenshiftVersions(ctx context.Context, params *V
 	return result.(*V2ListSupportedOpenshiftVersionsOK), nil
 
 }
+
+/*
+V2RegisterCustomReleaseImage Registers a custom release image, such as a nightly or a custom built payload. The OpenShift version and
+the CPU architectures are read from the metadata of the release image, and the release image is available
+in the OpenShift versions of the organization of the user.
+*/
+func (a *Client) V2RegisterCustomReleaseImage(ctx context.Context, params *V2RegisterCustomReleaseImageParams) (*V2RegisterCustomReleaseImageCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RegisterCustomReleaseImage",
+		Method:             "POST",
+		PathPattern:        "/v2/custom-release-images",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RegisterCustomReleaseImageReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RegisterCustomReleaseImageCreated), nil
+
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	metal3_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/openshift/assisted-image-service/pkg/servers"
+	"github.com/openshift/assisted-service/internal/audit"
+	"github.com/openshift/assisted-service/internal/bmc"
 	"github.com/openshift/assisted-service/internal/bminventory"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
@@ -29,10 +31,12 @@ import (
 	"github.com/openshift/assisted-service/internal/controller/controllers"
 	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/internal/domains"
+	"github.com/openshift/assisted-service/internal/encryption"
 	"github.com/openshift/assisted-service/internal/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/feature"
 	"github.com/openshift/assisted-service/internal/garbagecollector"
+	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostcommands"
@@ -45,12 +49,19 @@ import (
 	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/migrations"
+	"github.com/openshift/assisted-service/internal/mirrorcheck"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/handler"
+	"github.com/openshift/assisted-service/internal/provider/plugin"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/provider/vsphere"
+	"github.com/openshift/assisted-service/internal/quota"
+	"github.com/openshift/assisted-service/internal/ratelimit"
+	"github.com/openshift/assisted-service/internal/releasesignature"
 	"github.com/openshift/assisted-service/internal/releasesources"
+	"github.com/openshift/assisted-service/internal/serviceaccount"
 	"github.com/openshift/assisted-service/internal/spec"
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
 	"github.com/openshift/assisted-service/internal/stream"
@@ -170,7 +181,17 @@ var Options struct {
 	ForceInsecurePolicyJson              bool          `envconfig:"FORCE_INSECURE_POLICY_JSON" default:"false"`
 	PreprovisioningImageControllerConfig controllers.PreprovisioningImageControllerConfig
 	BMACConfig                           controllers.BMACConfig
+	AgentNodeHealthConfig                controllers.AgentNodeHealthConfig
+	BMCConfig                            bmc.Config
+	EncryptionConfig                     encryption.Config
 	InstallerCacheConfig                 installercache.Config
+	ReleaseSignatureConfig               releasesignature.Config
+	MirrorCheckConfig                    mirrorcheck.Config
+	ProviderPluginConfig                 plugin.Config
+	VCenterValidationConfig              vsphere.VCenterConfig
+	QuotaConfig                          quota.Config
+	RateLimitConfig                      ratelimit.Config
+	AuditConfig                          audit.Config
 
 	// EnableSoftTimeouts is a boolean flag to enable Soft timeouts by assisted installer
 	EnableSoftTimeouts bool `envconfig:"ENABLE_SOFT_TIMEOUTS" default:"false"`
@@ -357,6 +378,7 @@ func startKubeAPIControllers(
 		AgentContainerImage:        Options.BMConfig.AgentDockerImg,
 		HostFSMountDir:             hostFSMountDir,
 		ImageServiceEnabled:        Options.EnableImageService,
+		Drainer:                    &controllers.KubectlDrainer{},
 	}).SetupWithManager(ctrlMgr), "unable to create controller Agent")
 
 	if Options.EnableImageService {
@@ -389,6 +411,17 @@ func startKubeAPIControllers(
 		Log:    log,
 	}).SetupWithManager(ctrlMgr), "unable to create controller AgentLabel")
 
+	if Options.AgentNodeHealthConfig.Enabled {
+		failOnError((&controllers.AgentNodeHealthReconciler{
+			Client:       ctrlMgr.GetClient(),
+			APIReader:    ctrlMgr.GetAPIReader(),
+			Log:          log,
+			SpokeClients: controllers.NewSpokeClientCache(spokeClientFactory),
+			Recorder:     ctrlMgr.GetEventRecorderFor("agent-node-health-controller"),
+			Config:       Options.AgentNodeHealthConfig,
+		}).SetupWithManager(ctrlMgr), "unable to create controller AgentNodeHealth")
+	}
+
 	if Options.EnableImageService && useConvergedFlow {
 		failOnError((&controllers.PreprovisioningImageReconciler{
 			Client:           ctrlMgr.GetClient(),
@@ -424,8 +457,17 @@ func main() {
 	}
 
 	port := flag.String("port", "8090", "define port that the service will listen to")
+	bootstrapToken := flag.Duration("bootstrap-token", 0,
+		"print an administrator token of the local authentication that expires after this duration, at most 1h, and exit")
 	flag.Parse()
 
+	if *bootstrapToken != 0 {
+		token, tokenErr := gencrypto.LocalUserJWT(*bootstrapToken)
+		failOnError(tokenErr, "failed to create the bootstrap token")
+		fmt.Println(token)
+		return
+	}
+
 	log.Println("Starting bm service")
 
 	if Options.EnableImageService && Options.BMConfig.ImageServiceBaseURL == "" {
@@ -461,6 +503,7 @@ func main() {
 	defer notificationStream.Close()
 
 	usageManager := usage.NewManager(log, notificationStream)
+	quotaManager := quota.NewManager(log.WithField("pkg", "quota"), db, Options.QuotaConfig)
 	ocmClient := getOCMClient(log)
 
 	authHandler, err := auth.NewAuthenticator(&Options.Auth, ocmClient, log.WithField("pkg", "auth"), db)
@@ -488,10 +531,13 @@ func main() {
 	}
 
 	Options.InstructionConfig.ReleaseImageMirror = Options.ReleaseImageMirror
+	Options.ClusterConfig.ReleaseImageMirror = Options.ReleaseImageMirror
 	Options.InstructionConfig.CheckClusterVersion = Options.CheckClusterVersion
 	Options.OperatorsConfig.CheckClusterVersion = Options.CheckClusterVersion
 	//Initialize Provider API
-	providerRegistry := registry.InitProviderRegistry(log.WithField("pkg", "provider"))
+	providerPlugins, err := plugin.NewProviders(Options.ProviderPluginConfig, log.WithField("pkg", "provider-plugin"))
+	failOnError(err, "failed to create the provider plugins")
+	providerRegistry := registry.InitProviderRegistry(log.WithField("pkg", "provider"), providerPlugins...)
 	// Make sure that prepare for installation timeout is more than the timeouts of all underlying tools + 2m extra
 	Options.ClusterConfig.PrepareConfig.PrepareForInstallationTimeout = maxDuration(Options.ClusterConfig.PrepareConfig.PrepareForInstallationTimeout,
 		maxDuration(Options.InstructionConfig.DiskCheckTimeout, Options.InstructionConfig.ImageAvailabilityTimeout)+2*time.Minute)
@@ -509,6 +555,14 @@ func main() {
 		mirrorRegistriesBuilder,
 		sys,
 	)
+	releaseVerifier, err := releasesignature.NewVerifier(Options.ReleaseSignatureConfig, releaseHandler)
+	failOnError(err, "failed to create release signature verifier")
+	releaseHandler = releasesignature.NewRelease(releaseHandler, releaseVerifier)
+	newMirrorRelease := func(builder mirrorregistries.ServiceMirrorRegistriesConfigBuilder) oc.Release {
+		return oc.NewRelease(&executer.CommonExecuter{}, oc.Config{MaxTries: oc.DefaultTries, RetryDelay: oc.DefaltRetryDelay}, builder, sys)
+	}
+	mirrorChecker := mirrorcheck.NewChecker(Options.MirrorCheckConfig, releaseHandler, newMirrorRelease, mirrorRegistriesBuilder,
+		Options.ReleaseImageMirror)
 
 	versionHandler, versionsAPIHandler, err := createVersionHandlers(
 		log,
@@ -543,7 +597,7 @@ func main() {
 	connectivityValidator := connectivity.NewValidator(log.WithField("pkg", "validators"))
 	Options.InstructionConfig.HostFSMountDir = hostFSMountDir
 	instructionApi := hostcommands.NewInstructionManager(log.WithField("pkg", "instructions"), db, hwValidator,
-		releaseHandler, Options.InstructionConfig, connectivityValidator, eventsHandler, versionHandler, osImages, Options.EnableKubeAPI)
+		releaseHandler, Options.InstructionConfig, connectivityValidator, eventsHandler, versionHandler, osImages, Options.EnableKubeAPI, mirrorChecker)
 
 	publicRegistries := map[string]bool{}
 	validations.ParsePublicRegistries(publicRegistries, Options.ValidationsConfig.PublicRegistries)
@@ -604,6 +658,10 @@ func main() {
 		log.Fatalf("not supported deploy target %s", Options.DeployTarget)
 	}
 
+	encryptor, err := encryption.NewEncryptor(log.WithField("pkg", "encryption"), Options.EncryptionConfig)
+	failOnError(err, "Failed to create the encryptor of the sensitive columns")
+	encryption.SetDefaultEncryptor(encryptor)
+
 	failOnError(autoMigrationWithLeader(startupLeader, db, log), "Failed auto migration process")
 
 	Options.UploaderConfig.AssistedServiceVersion = versions.GetRevision()
@@ -620,7 +678,8 @@ func main() {
 	manifestsGenerator := network.NewManifestsGenerator(manifestsApi, Options.ManifestsGeneratorConfig, db)
 	clusterApi := cluster.NewManager(Options.ClusterConfig, log.WithField("pkg", "cluster-state"), db,
 		notificationStream, eventsHandler, uploadClient, hostApi, metricsManager, manifestsGenerator, lead, operatorsManager,
-		ocmClient, objectHandler, dnsApi, authHandler, manifestsApi, Options.EnableSoftTimeouts, usageManager)
+		ocmClient, objectHandler, dnsApi, authHandler, manifestsApi, Options.EnableSoftTimeouts, usageManager, releaseVerifier, mirrorChecker,
+		vsphere.NewVCenterValidator(Options.VCenterValidationConfig))
 	infraEnvApi := infraenv.NewManager(log.WithField("pkg", "host-state"), db, objectHandler)
 
 	clusterEventsUploader := thread.New(
@@ -654,7 +713,7 @@ func main() {
 	Options.BMConfig.S3EndpointURL = newUrl
 
 	Options.InstallerCacheConfig.CacheDir = filepath.Join(Options.GeneratorConfig.GetWorkingDirectory(), "installercache")
-	installerCache, err := installercache.New(Options.InstallerCacheConfig, eventsHandler, metricsManager, diskStatsHelper, log)
+	installerCache, err := installercache.New(Options.InstallerCacheConfig, eventsHandler, metricsManager, diskStatsHelper, objectHandler, log)
 	failOnError(err, "failed to instantiate installercache")
 
 	generator := generator.New(log, objectHandler, Options.GeneratorConfig, providerRegistry, manifestsApi, eventsHandler, installerCache)
@@ -719,18 +778,30 @@ func main() {
 		Options.GeneratorConfig.GetWorkingDirectory(),
 	)
 
+	bmcApi, err := bmc.NewAPI(log.WithField("pkg", "bmc"), Options.BMCConfig)
+	failOnError(err, "failed to create the BMC API")
 	bm := bminventory.NewBareMetalInventory(db, notificationStream, log.WithField("pkg", "Inventory"), hostApi, clusterApi, infraEnvApi, Options.BMConfig,
-		generator, eventsHandler, objectHandler, metricsManager, usageManager, operatorsManager, authHandler, authzHandler, ocpClient, ocmClient,
+		generator, eventsHandler, objectHandler, metricsManager, usageManager, quotaManager, operatorsManager, authHandler, authzHandler, ocpClient, ocmClient,
 		lead, pullSecretValidator, versionHandler, osImages, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, staticNetworkConfig,
-		Options.GCConfig, providerRegistry, generateInsecureIPXEURLs, Options.GeneratorConfig.InstallInvoker, disconnectedIgnitionGenerator)
+		Options.GCConfig, providerRegistry, generateInsecureIPXEURLs, Options.GeneratorConfig.InstallInvoker, disconnectedIgnitionGenerator,
+		bmcApi)
 	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
 
+	auditor := audit.NewAuditor(log.WithField("pkg", "audit"), db, Options.AuditConfig, lead)
+	if Options.AuditConfig.Enabled {
+		auditRetentionWorker := thread.New(
+			log.WithField("pkg", "audit"), "Audit Log Retention Worker", Options.AuditConfig.RetentionInterval, auditor.DeleteExpiredEvents)
+		auditRetentionWorker.Start()
+		defer auditRetentionWorker.Stop()
+	}
+
 	//Set inner handler chain. Inner handlers requires access to the Route
 	innerHandler := func() func(http.Handler) http.Handler {
 		return func(h http.Handler) http.Handler {
 			wrapped := metrics.WithMatchedRoute(log.WithField("pkg", "matched-h"), prometheusRegistry)(h)
 
 			wrapped = paramctx.ContextHandler()(wrapped)
+			wrapped = auditor.Middleware()(wrapped)
 			return wrapped
 		}
 	}
@@ -740,7 +811,12 @@ func main() {
 		jsonConsumer = internaljson.UnknownFieldsRejectingConsumer()
 	}
 
+	rateLimiter, err := ratelimit.NewLimiter(log.WithField("pkg", "ratelimit"), Options.RateLimitConfig, prometheusRegistry)
+	failOnError(err, "failed to create the rate limiter")
+
 	operatorsHandler := handler.NewHandler(operatorsManager, log.WithField("pkg", "operators"), db, eventsHandler, clusterApi)
+	// The API tokens of the service accounts are authenticated by the service, next to the tokens of the users
+	serviceAccountsEnabled := Options.Auth.AuthType == auth.TypeLocal || Options.Auth.AuthType == auth.TypeOIDC
 	h, api, err := restapi.HandlerAPI(restapi.Config{
 		AuthAgentAuth:       authHandler.AuthAgentAuth,
 		AuthUserAuth:        authHandler.AuthUserAuth,
@@ -749,12 +825,14 @@ func main() {
 		AuthImageAuth:       authHandler.AuthImageAuth,
 		AuthImageURLAuth:    authHandler.AuthImageAuth,
 		APIKeyAuthenticator: authHandler.CreateAuthenticator(),
-		Authorizer:          authzHandler.CreateAuthorizer(),
+		Authorizer:          rateLimiter.Authorizer(auditor.Authorizer(authzHandler.CreateAuthorizer())),
 		InstallerAPI:        bm,
 		EventsAPI:           events,
+		AuditAPI:            audit.NewHandler(log.WithField("pkg", "audit"), db),
 		Logger:              log.Printf,
 		VersionsAPI:         versionsAPIHandler,
 		ManagedDomainsAPI:   domainHandler,
+		ServiceAccountsAPI:  serviceaccount.NewHandler(log.WithField("pkg", "service-accounts"), db, serviceAccountsEnabled),
 		InnerMiddleware:     innerHandler(),
 		ManifestsAPI:        manifestsApi,
 		OperatorsAPI:        operatorsHandler,
@@ -918,6 +996,16 @@ func autoMigrationWithLeader(migrationLeader leader.ElectorInterface, db *gorm.D
 		}
 		log.Info("Finished manual post migrations")
 
+		// The values of the sensitive columns are re-encrypted when the key of the key provider was rotated
+		if encryptor := encryption.DefaultEncryptor(); encryptor != nil {
+			log.Info("Re-encrypting the sensitive columns with the current key")
+			err = encryption.ReencryptColumns(context.Background(), log, db, encryptor, common.EncryptedColumns)
+			if err != nil {
+				log.WithError(err).Fatal("Failed to re-encrypt the sensitive columns")
+				return err
+			}
+		}
+
 		return nil
 	})
 }
@@ -1001,6 +1089,7 @@ func createVersionHandlers(
 		versionsClient,
 		ignoredOpenshiftVersions,
 		db,
+		authzHandler,
 		Options.EnableKubeAPI,
 		releaseSources,
 	)
@@ -0,0 +1,270 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/pkg/secretdump"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+const (
+	// agentAuthScheme is the security scheme of the requests of the agents, they report the state of the hosts and
+	// aren't audited
+	agentAuthScheme = "agentAuth"
+
+	// maxRequestDiffSize bounds the size of the request bodies kept in the audit log, larger bodies are truncated
+	maxRequestDiffSize = 64 * 1024
+
+	// maxRequestBodySize bounds the size of the request bodies read for the audit log, larger bodies are only
+	// described by their size and are passed to the handler without being buffered
+	maxRequestBodySize = 1024 * 1024
+)
+
+type Config struct {
+	Enabled bool `envconfig:"AUDIT_LOG_ENABLED" default:"true"`
+	// Retention is how long the audit events are kept
+	Retention         time.Duration `envconfig:"AUDIT_LOG_RETENTION" default:"2160h"` // 90d
+	RetentionInterval time.Duration `envconfig:"AUDIT_LOG_RETENTION_INTERVAL" default:"1h"`
+}
+
+// Auditor records the API requests that change resources in the audit log. The audit log is append-only: the
+// audit events are never updated, and they are only deleted once they are older than the retention.
+type Auditor struct {
+	log           logrus.FieldLogger
+	db            *gorm.DB
+	config        Config
+	leaderElector leader.Leader
+	// definitions are the models of the API, their secret fields are redacted from the request bodies
+	definitions spec.Definitions
+}
+
+func NewAuditor(log logrus.FieldLogger, db *gorm.DB, config Config, leaderElector leader.Leader) *Auditor {
+	var swagger spec.Swagger
+	if err := json.Unmarshal(restapi.SwaggerJSON, &swagger); err != nil {
+		// the request bodies that refer to the models are then recorded without their content
+		log.WithError(err).Error("Failed to load the models of the API for the audit log")
+	}
+	return &Auditor{
+		log:           log,
+		db:            db,
+		config:        config,
+		leaderElector: leaderElector,
+		definitions:   swagger.Definitions,
+	}
+}
+
+type actorKey struct{}
+
+// actor is the identity of the user that sent a request. The middleware adds it to the context of the request before
+// authentication, and the authorizer fills it once the request is authenticated.
+type actor struct {
+	authenticated bool
+	schemes       []string
+	username      string
+	orgID         string
+}
+
+// statusRecorder keeps the status code of the response
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	if r.statusCode == 0 {
+		r.statusCode = statusCode
+	}
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *statusRecorder) Write(data []byte) (int, error) {
+	if r.statusCode == 0 {
+		r.statusCode = http.StatusOK
+	}
+	return r.ResponseWriter.Write(data)
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// Middleware returns the inner middleware that records the requests that change resources once they are served.
+// It runs after routing but before authentication.
+func (a *Auditor) Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !a.config.Enabled {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isMutating(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			receivedAt := time.Now()
+			route := middleware.MatchedRouteFrom(r)
+			requestDiff, err := a.readRequestDiff(r, bodySchema(route))
+			if err != nil {
+				a.log.WithError(err).Warnf("Failed to read the body of %s %s for the audit log", r.Method, r.URL.Path)
+			}
+			requestActor := &actor{}
+			recorder := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), actorKey{}, requestActor)))
+
+			if !requestActor.authenticated || funk.ContainsString(requestActor.schemes, agentAuthScheme) {
+				return
+			}
+			event := &models.AuditEvent{
+				CreatedAt:   (*strfmt.DateTime)(&receivedAt),
+				RequestID:   requestid.FromContext(r.Context()),
+				Username:    requestActor.username,
+				OrgID:       requestActor.orgID,
+				Method:      swag.String(r.Method),
+				Path:        swag.String(r.URL.Path),
+				StatusCode:  swag.Int64(int64(recorder.statusCode)),
+				RequestDiff: requestDiff,
+			}
+			if route != nil && route.Operation != nil {
+				event.Action = route.Operation.ID
+				event.ResourceType, event.ResourceID = resource(route)
+			}
+			if err = a.db.Create(event).Error; err != nil {
+				a.log.WithError(err).Errorf("Failed to add %s %s of user %s to the audit log", r.Method, r.URL.Path, event.Username)
+			}
+		})
+	}
+}
+
+// Authorizer returns an authorizer that keeps the identity of the user of the request for the audit log, and passes
+// the request to next
+func (a *Auditor) Authorizer(next func(*http.Request) error) func(*http.Request) error {
+	if !a.config.Enabled {
+		return next
+	}
+	return func(request *http.Request) error {
+		if requestActor, ok := request.Context().Value(actorKey{}).(*actor); ok {
+			requestActor.authenticated = true
+			if route := middleware.MatchedRouteFrom(request); route != nil && route.Authenticator != nil {
+				requestActor.schemes = route.Authenticator.Schemes
+			}
+			if payload, ok := request.Context().Value(restapi.AuthKey).(*ocm.AuthPayload); ok {
+				requestActor.username = payload.Username
+				requestActor.orgID = payload.Organization
+			}
+		}
+		return next(request)
+	}
+}
+
+// bodySchema returns the schema of the body of the operation of the route, nil if it has no body
+func bodySchema(route *middleware.MatchedRoute) *spec.Schema {
+	if route == nil {
+		return nil
+	}
+	for _, param := range route.Parameters {
+		if param.In == "body" {
+			return param.Schema
+		}
+	}
+	return nil
+}
+
+// readRequestDiff returns the body of the request with the fields that the schema tags as secret redacted, and
+// restores the body for the handler. The bodies that aren't JSON, such as uploaded files, the bodies without schema
+// and the bodies that are too large are only described by their size.
+func (a *Auditor) readRequestDiff(r *http.Request, schema *spec.Schema) (string, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return "", nil
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "application/json" {
+		if r.ContentLength > 0 {
+			return fmt.Sprintf("<%d bytes of %s>", r.ContentLength, mediaType), nil
+		}
+		return "", nil
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBodySize+1))
+	// the handler reads what was read for the audit log, and then the rest of the body
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+	if err != nil || len(bytes.TrimSpace(body)) == 0 {
+		return "", err
+	}
+	if len(body) > maxRequestBodySize {
+		return fmt.Sprintf("<more than %d bytes of JSON>", maxRequestBodySize), nil
+	}
+	if !json.Valid(body) {
+		// Invalid bodies are refused by the API, they are recorded without their content
+		return fmt.Sprintf("<%d bytes of invalid JSON>", len(body)), nil
+	}
+	if schema == nil {
+		return fmt.Sprintf("<%d bytes of JSON>", len(body)), nil
+	}
+	diff, err := secretdump.DumpSecretJSON(body, schema, a.definitions)
+	if err != nil {
+		return fmt.Sprintf("<%d bytes of JSON>", len(body)), err
+	}
+	if len(diff) > maxRequestDiffSize {
+		diff = diff[:maxRequestDiffSize] + "...<truncated>"
+	}
+	return diff, nil
+}
+
+// resource returns the type and the ID of the resource of the route: the last ID of its path, e.g. the host of
+// /v2/infra-envs/{infra_env_id}/hosts/{host_id}. The routes that create resources have no ID, the type is then the
+// last segment of the path.
+func resource(route *middleware.MatchedRoute) (string, string) {
+	for i := len(route.Params) - 1; i >= 0; i-- {
+		param := route.Params[i]
+		if strings.HasSuffix(param.Name, "_id") {
+			return strings.ReplaceAll(strings.TrimSuffix(param.Name, "_id"), "_", "-"), param.Value
+		}
+	}
+	segments := strings.Split(strings.Trim(route.PathPattern, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if segments[i] != "" && !strings.HasPrefix(segments[i], "{") {
+			return segments[i], ""
+		}
+	}
+	return "", ""
+}
+
+// DeleteExpiredEvents deletes the audit events that are older than the retention
+func (a *Auditor) DeleteExpiredEvents() {
+	if !a.leaderElector.IsLeader() {
+		return
+	}
+	olderThan := time.Now().Add(-a.config.Retention)
+	reply := a.db.Where("created_at < ?", olderThan).Delete(&models.AuditEvent{})
+	if reply.Error != nil {
+		a.log.WithError(reply.Error).Error("Failed to delete the expired audit events")
+		return
+	}
+	if reply.RowsAffected > 0 {
+		a.log.Infof("Deleted %d audit events received before %s", reply.RowsAffected, olderThan)
+	}
+}
@@ -0,0 +1,68 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/audit"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const defaultListLimit = 100
+
+var _ restapi.AuditAPI = (*Handler)(nil)
+
+// Handler serves the audit log to the admins
+type Handler struct {
+	log logrus.FieldLogger
+	db  *gorm.DB
+}
+
+func NewHandler(log logrus.FieldLogger, db *gorm.DB) *Handler {
+	return &Handler{
+		log: log,
+		db:  db,
+	}
+}
+
+func (h *Handler) V2ListAuditEvents(ctx context.Context, params operations.V2ListAuditEventsParams) middleware.Responder {
+	if params.Since != nil && params.Until != nil && !time.Time(*params.Until).After(time.Time(*params.Since)) {
+		return common.NewApiError(http.StatusBadRequest, errors.New("until must be after since"))
+	}
+
+	query := h.db.Order("created_at DESC, id DESC")
+	if params.Since != nil {
+		query = query.Where("created_at >= ?", time.Time(*params.Since))
+	}
+	if params.Until != nil {
+		query = query.Where("created_at < ?", time.Time(*params.Until))
+	}
+	if params.Username != nil {
+		query = query.Where("username = ?", *params.Username)
+	}
+	if params.OrgID != nil {
+		query = query.Where("org_id = ?", *params.OrgID)
+	}
+	if params.ResourceID != nil {
+		query = query.Where("resource_id = ?", *params.ResourceID)
+	}
+	limit := defaultListLimit
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+	query = query.Limit(limit).Offset(int(swag.Int64Value(params.Offset)))
+
+	events := models.AuditEventList{}
+	if err := query.Find(&events).Error; err != nil {
+		return common.GenerateErrorResponder(errors.Wrap(err, "failed to list the audit events"))
+	}
+	return operations.NewV2ListAuditEventsOK().WithPayload(events)
+}
@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/audit"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		db      *gorm.DB
+		dbName  string
+		handler *Handler
+		ctx     = context.Background()
+		now     = time.Now()
+	)
+
+	createEvent := func(username, orgID, resourceID string, age time.Duration) {
+		createdAt := strfmt.DateTime(now.Add(-age))
+		Expect(db.Create(&models.AuditEvent{
+			CreatedAt:  &createdAt,
+			Username:   username,
+			OrgID:      orgID,
+			ResourceID: resourceID,
+			Method:     swag.String(http.MethodPatch),
+			Path:       swag.String("/api/assisted-install/v2/clusters/" + resourceID),
+			StatusCode: swag.Int64(http.StatusCreated),
+		}).Error).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		handler = NewHandler(common.GetTestLog(), db)
+		createEvent("jdoe", "org-1", "cluster-1", 3*time.Hour)
+		createEvent("jdoe", "org-1", "cluster-2", 2*time.Hour)
+		createEvent("asmith", "org-2", "cluster-3", time.Hour)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	list := func(params operations.V2ListAuditEventsParams) []string {
+		reply := handler.V2ListAuditEvents(ctx, params)
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ListAuditEventsOK()))
+		var resourceIDs []string
+		for _, event := range reply.(*operations.V2ListAuditEventsOK).Payload {
+			resourceIDs = append(resourceIDs, event.ResourceID)
+		}
+		return resourceIDs
+	}
+
+	It("lists the events, the most recent first", func() {
+		Expect(list(operations.V2ListAuditEventsParams{})).To(Equal([]string{"cluster-3", "cluster-2", "cluster-1"}))
+	})
+
+	It("filters the events by time", func() {
+		since := strfmt.DateTime(now.Add(-150 * time.Minute))
+		until := strfmt.DateTime(now.Add(-90 * time.Minute))
+		Expect(list(operations.V2ListAuditEventsParams{Since: &since, Until: &until})).To(Equal([]string{"cluster-2"}))
+	})
+
+	It("filters the events by actor", func() {
+		Expect(list(operations.V2ListAuditEventsParams{Username: swag.String("jdoe")})).To(Equal([]string{"cluster-2", "cluster-1"}))
+		Expect(list(operations.V2ListAuditEventsParams{OrgID: swag.String("org-2")})).To(Equal([]string{"cluster-3"}))
+	})
+
+	It("filters the events by resource", func() {
+		Expect(list(operations.V2ListAuditEventsParams{ResourceID: swag.String("cluster-1")})).To(Equal([]string{"cluster-1"}))
+	})
+
+	It("pages the events", func() {
+		Expect(list(operations.V2ListAuditEventsParams{Limit: swag.Int64(1), Offset: swag.Int64(1)})).To(Equal([]string{"cluster-2"}))
+	})
+
+	It("refuses a time range that ends before it starts", func() {
+		since := strfmt.DateTime(now)
+		until := strfmt.DateTime(now.Add(-time.Hour))
+		reply := handler.V2ListAuditEvents(ctx, operations.V2ListAuditEventsParams{Since: &since, Until: &until})
+		apiErr, ok := reply.(*common.ApiErrorResponse)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+})
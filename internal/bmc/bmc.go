@@ -0,0 +1,185 @@
+package bmc
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	// Timeout of each request sent to a BMC
+	Timeout time.Duration `envconfig:"BMC_REQUEST_TIMEOUT" default:"30s"`
+	// Networks of the BMCs that are allowed even though they are denied, e.g. 127.0.0.0/8 for a local BMC emulator
+	AllowedNetworks []string `envconfig:"BMC_ALLOWED_NETWORKS" default:""`
+	// Networks that the requests are never sent to, besides the loopback, link-local, multicast and unspecified
+	// addresses. The default are the pod and service networks of OpenShift.
+	DeniedNetworks []string `envconfig:"BMC_DENIED_NETWORKS" default:"10.128.0.0/14,172.30.0.0/16"`
+}
+
+// Credentials are the address and the credentials of the BMC of a host
+type Credentials struct {
+	Address                        string
+	Username                       string
+	Password                       string
+	DisableCertificateVerification bool
+}
+
+var (
+	// ErrUnsupportedProtocol is returned for the addresses of BMCs whose protocol isn't supported
+	ErrUnsupportedProtocol = errors.New("the protocol of the BMC isn't supported")
+	// ErrAddressNotAllowed is returned for the addresses of BMCs in networks that the requests aren't sent to
+	ErrAddressNotAllowed = errors.New("the address of the BMC isn't allowed")
+)
+
+// Error is an error of a request sent to a BMC. Its message can be shown to users, the error of the connection and
+// the response of the BMC are only in the wrapped error.
+type Error struct {
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return e.Message
+	}
+	return e.Message + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// PublicMessage returns the message of an error of the API that can be shown to users. The errors of the connections
+// and the responses of the BMCs aren't included, they may expose the services of the network of the service.
+func PublicMessage(err error) string {
+	var bmcErr *Error
+	switch {
+	case errors.As(err, &bmcErr):
+		return bmcErr.Message
+	case errors.Is(err, ErrAddressNotAllowed):
+		return ErrAddressNotAllowed.Error()
+	case errors.Is(err, ErrUnsupportedProtocol):
+		return ErrUnsupportedProtocol.Error()
+	}
+	return "the request to the BMC failed"
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=bmc -destination=mock_bmc.go . API
+type API interface {
+	// ValidateAddress checks that the address of a BMC is valid and that the requests can be sent to it
+	ValidateAddress(address string) error
+	PowerOn(ctx context.Context, credentials *Credentials) error
+	PowerOff(ctx context.Context, credentials *Credentials) error
+	// Reboot restarts the host, or powers it on when it's off
+	Reboot(ctx context.Context, credentials *Credentials) error
+	// BootFromISO mounts the ISO as virtual media, sets it as the next boot device and reboots the host
+	BootFromISO(ctx context.Context, credentials *Credentials, isoURL string) error
+}
+
+type bmcAPI struct {
+	log    logrus.FieldLogger
+	config Config
+	policy *networkPolicy
+}
+
+func NewAPI(log logrus.FieldLogger, config Config) (API, error) {
+	policy, err := newNetworkPolicy(config)
+	if err != nil {
+		return nil, err
+	}
+	return &bmcAPI{log: log, config: config, policy: policy}, nil
+}
+
+// ValidateAddress checks that the address of a BMC is valid and that its protocol is supported
+func ValidateAddress(address string) error {
+	_, err := parseAddress(address)
+	return err
+}
+
+func (b *bmcAPI) ValidateAddress(address string) error {
+	parsed, err := parseAddress(address)
+	if err != nil {
+		return err
+	}
+	return b.policy.checkHost(parsed.hostname)
+}
+
+type bmcAddress struct {
+	scheme   string
+	host     string
+	hostname string
+	path     string
+}
+
+// parseAddress parses an address in the format of the metal3 BMC addresses, e.g. redfish+https://host/path
+func parseAddress(address string) (*bmcAddress, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid BMC address %s", address)
+	}
+	if u.Host == "" {
+		return nil, errors.Errorf("BMC address %s has no host", address)
+	}
+	protocol, scheme, _ := strings.Cut(u.Scheme, "+")
+	switch protocol {
+	case "redfish", "redfish-virtualmedia", "idrac-redfish", "idrac-virtualmedia":
+		switch scheme {
+		case "":
+			scheme = "https"
+		case "http", "https":
+		default:
+			return nil, errors.Errorf("unsupported transport %s in BMC address %s", scheme, address)
+		}
+		return &bmcAddress{scheme: scheme, host: u.Host, hostname: u.Hostname(), path: strings.TrimSuffix(u.Path, "/")}, nil
+	case "ipmi":
+		return nil, errors.Wrapf(ErrUnsupportedProtocol, "IPMI BMC address %s isn't supported, use the Redfish address of the BMC", address)
+	}
+	return nil, errors.Wrapf(ErrUnsupportedProtocol, "unsupported protocol %s in BMC address %s, the supported protocol is redfish", u.Scheme, address)
+}
+
+func (b *bmcAPI) redfishClient(credentials *Credentials) (*redfishClient, error) {
+	address, err := parseAddress(credentials.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err = b.policy.checkHost(address.hostname); err != nil {
+		return nil, err
+	}
+	return newRedfishClient(b.log, address, credentials, b.config.Timeout, b.policy), nil
+}
+
+func (b *bmcAPI) PowerOn(ctx context.Context, credentials *Credentials) error {
+	client, err := b.redfishClient(credentials)
+	if err != nil {
+		return err
+	}
+	return client.powerOn(ctx)
+}
+
+func (b *bmcAPI) PowerOff(ctx context.Context, credentials *Credentials) error {
+	client, err := b.redfishClient(credentials)
+	if err != nil {
+		return err
+	}
+	return client.powerOff(ctx)
+}
+
+func (b *bmcAPI) Reboot(ctx context.Context, credentials *Credentials) error {
+	client, err := b.redfishClient(credentials)
+	if err != nil {
+		return err
+	}
+	return client.reboot(ctx)
+}
+
+func (b *bmcAPI) BootFromISO(ctx context.Context, credentials *Credentials, isoURL string) error {
+	client, err := b.redfishClient(credentials)
+	if err != nil {
+		return err
+	}
+	return client.bootFromISO(ctx, isoURL)
+}
@@ -0,0 +1,13 @@
+package bmc
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestBMC(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "bmc tests")
+}
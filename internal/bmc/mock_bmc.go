@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/bmc (interfaces: API)
+
+// Package bmc is a generated GoMock package.
+package bmc
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// BootFromISO mocks base method.
+func (m *MockAPI) BootFromISO(arg0 context.Context, arg1 *Credentials, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BootFromISO", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BootFromISO indicates an expected call of BootFromISO.
+func (mr *MockAPIMockRecorder) BootFromISO(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootFromISO", reflect.TypeOf((*MockAPI)(nil).BootFromISO), arg0, arg1, arg2)
+}
+
+// PowerOff mocks base method.
+func (m *MockAPI) PowerOff(arg0 context.Context, arg1 *Credentials) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerOff", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PowerOff indicates an expected call of PowerOff.
+func (mr *MockAPIMockRecorder) PowerOff(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerOff", reflect.TypeOf((*MockAPI)(nil).PowerOff), arg0, arg1)
+}
+
+// PowerOn mocks base method.
+func (m *MockAPI) PowerOn(arg0 context.Context, arg1 *Credentials) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerOn", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PowerOn indicates an expected call of PowerOn.
+func (mr *MockAPIMockRecorder) PowerOn(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerOn", reflect.TypeOf((*MockAPI)(nil).PowerOn), arg0, arg1)
+}
+
+// Reboot mocks base method.
+func (m *MockAPI) Reboot(arg0 context.Context, arg1 *Credentials) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reboot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reboot indicates an expected call of Reboot.
+func (mr *MockAPIMockRecorder) Reboot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reboot", reflect.TypeOf((*MockAPI)(nil).Reboot), arg0, arg1)
+}
+
+// ValidateAddress mocks base method.
+func (m *MockAPI) ValidateAddress(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAddress", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateAddress indicates an expected call of ValidateAddress.
+func (mr *MockAPIMockRecorder) ValidateAddress(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAddress", reflect.TypeOf((*MockAPI)(nil).ValidateAddress), arg0)
+}
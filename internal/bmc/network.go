@@ -0,0 +1,99 @@
+package bmc
+
+import (
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// deniedHostnameSuffixes are the suffixes of the names of the services of the cluster of the service
+var deniedHostnameSuffixes = []string{".svc", ".cluster.local", ".localhost"}
+
+// networkPolicy checks that the requests aren't sent to the service itself, to the metadata services of the cloud or
+// to the cluster of the service, since the addresses of the BMCs are set by the users
+type networkPolicy struct {
+	allowed []*net.IPNet
+	denied  []*net.IPNet
+}
+
+func newNetworkPolicy(config Config) (*networkPolicy, error) {
+	allowed, err := parseNetworks(config.AllowedNetworks)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid allowed BMC networks")
+	}
+	denied, err := parseNetworks(config.DeniedNetworks)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid denied BMC networks")
+	}
+	return &networkPolicy{allowed: allowed, denied: denied}, nil
+}
+
+func parseNetworks(cidrs []string) ([]*net.IPNet, error) {
+	var ret []*net.IPNet
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, network)
+	}
+	return ret, nil
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *networkPolicy) checkIP(ip net.IP) error {
+	if containsIP(p.allowed, ip) {
+		return nil
+	}
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() || ip.IsUnspecified() || containsIP(p.denied, ip) {
+		return errors.Wrapf(ErrAddressNotAllowed, "%s", ip)
+	}
+	return nil
+}
+
+// checkHost checks the host of an address. The names are checked again once resolved, when the connections are
+// opened.
+func (p *networkPolicy) checkHost(host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		return p.checkIP(ip)
+	}
+	name := strings.ToLower(strings.TrimSuffix(host, "."))
+	if name == "localhost" {
+		return errors.Wrapf(ErrAddressNotAllowed, "%s", host)
+	}
+	for _, suffix := range deniedHostnameSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return errors.Wrapf(ErrAddressNotAllowed, "%s", host)
+		}
+	}
+	return nil
+}
+
+// control is the control function of the dialer of the BMC requests. It checks the resolved address of each
+// connection, including those of the redirects.
+func (p *networkPolicy) control(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return errors.Wrapf(ErrAddressNotAllowed, "%s", host)
+	}
+	return p.checkIP(ip)
+}
@@ -0,0 +1,314 @@
+package bmc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	redfishServiceRoot = "/redfish/v1"
+
+	resetTypeOn           = "On"
+	resetTypeForceOff     = "ForceOff"
+	resetTypeForceRestart = "ForceRestart"
+
+	powerStateOn = "On"
+)
+
+type redfishLink struct {
+	ID string `json:"@odata.id"`
+}
+
+type redfishAction struct {
+	Target string `json:"target"`
+}
+
+type redfishCollection struct {
+	Members []redfishLink `json:"Members"`
+}
+
+type redfishSystem struct {
+	PowerState string `json:"PowerState"`
+	Actions    struct {
+		Reset redfishAction `json:"#ComputerSystem.Reset"`
+	} `json:"Actions"`
+	VirtualMedia *redfishLink `json:"VirtualMedia"`
+	Links        struct {
+		ManagedBy []redfishLink `json:"ManagedBy"`
+	} `json:"Links"`
+}
+
+type redfishManager struct {
+	VirtualMedia *redfishLink `json:"VirtualMedia"`
+}
+
+type redfishVirtualMedia struct {
+	ID         string   `json:"@odata.id"`
+	MediaTypes []string `json:"MediaTypes"`
+	Inserted   bool     `json:"Inserted"`
+	Actions    struct {
+		InsertMedia redfishAction `json:"#VirtualMedia.InsertMedia"`
+		EjectMedia  redfishAction `json:"#VirtualMedia.EjectMedia"`
+	} `json:"Actions"`
+}
+
+type redfishError struct {
+	Error struct {
+		Message      string `json:"message"`
+		ExtendedInfo []struct {
+			Message string `json:"Message"`
+		} `json:"@Message.ExtendedInfo"`
+	} `json:"error"`
+}
+
+// redfishClient runs actions on a system of a Redfish BMC
+type redfishClient struct {
+	log         logrus.FieldLogger
+	baseURL     string
+	systemPath  string
+	credentials *Credentials
+	httpClient  *http.Client
+}
+
+func newRedfishClient(log logrus.FieldLogger, address *bmcAddress, credentials *Credentials, timeout time.Duration, policy *networkPolicy) *redfishClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// The requests aren't sent through a proxy, the network policy couldn't check their addresses otherwise
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: policy.control}).DialContext
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: credentials.DisableCertificateVerification} //nolint:gosec
+	return &redfishClient{
+		log:         log.WithField("bmc", address.host),
+		baseURL:     fmt.Sprintf("%s://%s", address.scheme, address.host),
+		systemPath:  address.path,
+		credentials: credentials,
+		httpClient:  &http.Client{Transport: transport, Timeout: timeout},
+	}
+}
+
+func (c *redfishClient) do(ctx context.Context, method, path string, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.credentials.Username, c.credentials.Password)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to send %s request to BMC", method)
+		var netErr net.Error
+		switch {
+		case errors.Is(err, ErrAddressNotAllowed):
+			return err
+		case errors.As(err, &netErr) && netErr.Timeout():
+			return &Error{Message: "the BMC didn't respond in time", Err: err}
+		}
+		return &Error{Message: "the BMC is unreachable", Err: err}
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &Error{Message: "the BMC response couldn't be read", Err: err}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err = errors.Errorf("BMC returned %d for %s %s: %s", resp.StatusCode, method, path, redfishErrorMessage(data))
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return &Error{Message: "the BMC rejected the credentials", Err: err}
+		}
+		return &Error{Message: fmt.Sprintf("the BMC returned HTTP status %d", resp.StatusCode), Err: err}
+	}
+	if result == nil || len(data) == 0 {
+		return nil
+	}
+	if err = json.Unmarshal(data, result); err != nil {
+		return &Error{Message: "the BMC returned an invalid response", Err: errors.Wrapf(err, "failed to parse BMC response to %s %s", method, path)}
+	}
+	return nil
+}
+
+// withPublicMessage prefixes the public message of an error of the BMC with the step that failed
+func withPublicMessage(err error, step string) error {
+	var bmcErr *Error
+	if errors.As(err, &bmcErr) {
+		return &Error{Message: step + ": " + bmcErr.Message, Err: bmcErr.Err}
+	}
+	return &Error{Message: step + ": " + PublicMessage(err), Err: err}
+}
+
+func redfishErrorMessage(data []byte) string {
+	var redfishErr redfishError
+	if err := json.Unmarshal(data, &redfishErr); err == nil {
+		if len(redfishErr.Error.ExtendedInfo) > 0 && redfishErr.Error.ExtendedInfo[0].Message != "" {
+			return redfishErr.Error.ExtendedInfo[0].Message
+		}
+		if redfishErr.Error.Message != "" {
+			return redfishErr.Error.Message
+		}
+	}
+	return string(data)
+}
+
+// system returns the path and the resource of the system of the address. The first system of the BMC is used when
+// the address doesn't point to a system.
+func (c *redfishClient) system(ctx context.Context) (string, *redfishSystem, error) {
+	path := c.systemPath
+	if path == "" || path == redfishServiceRoot || path == redfishServiceRoot+"/Systems" {
+		var systems redfishCollection
+		if err := c.do(ctx, http.MethodGet, redfishServiceRoot+"/Systems", nil, &systems); err != nil {
+			return "", nil, err
+		}
+		if len(systems.Members) == 0 {
+			return "", nil, &Error{Message: "the BMC has no systems"}
+		}
+		path = systems.Members[0].ID
+	}
+	var system redfishSystem
+	if err := c.do(ctx, http.MethodGet, path, nil, &system); err != nil {
+		return "", nil, err
+	}
+	return path, &system, nil
+}
+
+func (c *redfishClient) reset(ctx context.Context, systemPath string, system *redfishSystem, resetType string) error {
+	target := system.Actions.Reset.Target
+	if target == "" {
+		target = systemPath + "/Actions/ComputerSystem.Reset"
+	}
+	c.log.Infof("Resetting system %s with %s", systemPath, resetType)
+	return c.do(ctx, http.MethodPost, target, map[string]string{"ResetType": resetType}, nil)
+}
+
+func (c *redfishClient) powerOn(ctx context.Context) error {
+	path, system, err := c.system(ctx)
+	if err != nil {
+		return err
+	}
+	if system.PowerState == powerStateOn {
+		return nil
+	}
+	return c.reset(ctx, path, system, resetTypeOn)
+}
+
+func (c *redfishClient) powerOff(ctx context.Context) error {
+	path, system, err := c.system(ctx)
+	if err != nil {
+		return err
+	}
+	if system.PowerState != powerStateOn {
+		return nil
+	}
+	return c.reset(ctx, path, system, resetTypeForceOff)
+}
+
+func (c *redfishClient) reboot(ctx context.Context) error {
+	path, system, err := c.system(ctx)
+	if err != nil {
+		return err
+	}
+	return c.restart(ctx, path, system)
+}
+
+func (c *redfishClient) restart(ctx context.Context, path string, system *redfishSystem) error {
+	if system.PowerState == powerStateOn {
+		return c.reset(ctx, path, system, resetTypeForceRestart)
+	}
+	return c.reset(ctx, path, system, resetTypeOn)
+}
+
+func (c *redfishClient) bootFromISO(ctx context.Context, isoURL string) error {
+	path, system, err := c.system(ctx)
+	if err != nil {
+		return err
+	}
+	media, err := c.cdVirtualMedia(ctx, system)
+	if err != nil {
+		return err
+	}
+	if media.Inserted {
+		ejectTarget := media.Actions.EjectMedia.Target
+		if ejectTarget == "" {
+			ejectTarget = media.ID + "/Actions/VirtualMedia.EjectMedia"
+		}
+		if err = c.do(ctx, http.MethodPost, ejectTarget, map[string]string{}, nil); err != nil {
+			return withPublicMessage(err, "the virtual media couldn't be ejected")
+		}
+	}
+	insertTarget := media.Actions.InsertMedia.Target
+	if insertTarget == "" {
+		insertTarget = media.ID + "/Actions/VirtualMedia.InsertMedia"
+	}
+	c.log.Infof("Inserting %s as virtual media %s", isoURL, media.ID)
+	if err = c.do(ctx, http.MethodPost, insertTarget, map[string]interface{}{
+		"Image":          isoURL,
+		"Inserted":       true,
+		"WriteProtected": true,
+	}, nil); err != nil {
+		return withPublicMessage(err, "the virtual media couldn't be inserted")
+	}
+	if err = c.do(ctx, http.MethodPatch, path, map[string]interface{}{
+		"Boot": map[string]string{
+			"BootSourceOverrideEnabled": "Once",
+			"BootSourceOverrideTarget":  "Cd",
+		},
+	}, nil); err != nil {
+		return withPublicMessage(err, "the boot device couldn't be set")
+	}
+	return c.restart(ctx, path, system)
+}
+
+// cdVirtualMedia returns the virtual media of the system that accepts CD images. The virtual media of the system are
+// used when available, otherwise those of its manager.
+func (c *redfishClient) cdVirtualMedia(ctx context.Context, system *redfishSystem) (*redfishVirtualMedia, error) {
+	collection := system.VirtualMedia
+	if collection == nil {
+		if len(system.Links.ManagedBy) == 0 {
+			return nil, &Error{Message: "the system has no virtual media and no manager"}
+		}
+		var manager redfishManager
+		if err := c.do(ctx, http.MethodGet, system.Links.ManagedBy[0].ID, nil, &manager); err != nil {
+			return nil, err
+		}
+		collection = manager.VirtualMedia
+	}
+	if collection == nil {
+		return nil, &Error{Message: "the BMC doesn't support virtual media"}
+	}
+	var members redfishCollection
+	if err := c.do(ctx, http.MethodGet, collection.ID, nil, &members); err != nil {
+		return nil, err
+	}
+	for _, member := range members.Members {
+		var media redfishVirtualMedia
+		if err := c.do(ctx, http.MethodGet, member.ID, nil, &media); err != nil {
+			return nil, err
+		}
+		if funk.ContainsString(media.MediaTypes, "CD") || funk.ContainsString(media.MediaTypes, "DVD") {
+			if media.ID == "" {
+				media.ID = member.ID
+			}
+			return &media, nil
+		}
+	}
+	return nil, &Error{Message: "the BMC has no virtual media for CD images"}
+}
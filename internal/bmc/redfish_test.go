@@ -0,0 +1,276 @@
+package bmc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+)
+
+// fakeRedfish emulates the resources of a system with virtual media the way sushy-tools does
+type fakeRedfish struct {
+	sync.Mutex
+	powerState    string
+	resets        []string
+	image         string
+	inserted      bool
+	ejects        int
+	bootOverride  map[string]string
+	failResetWith int
+}
+
+func (f *fakeRedfish) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	if user, password, ok := r.BasicAuth(); !ok || user != "admin" || password != "password" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	reply := func(body interface{}) {
+		Expect(json.NewEncoder(w).Encode(body)).To(Succeed())
+	}
+	var body map[string]interface{}
+	if r.Body != nil && r.Method != http.MethodGet {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}
+	switch r.Method + " " + r.URL.Path {
+	case "GET /redfish/v1/Systems":
+		reply(map[string]interface{}{"Members": []map[string]string{{"@odata.id": "/redfish/v1/Systems/vm-1"}}})
+	case "GET /redfish/v1/Systems/vm-1":
+		reply(map[string]interface{}{
+			"PowerState": f.powerState,
+			"Actions": map[string]interface{}{
+				"#ComputerSystem.Reset": map[string]string{"target": "/redfish/v1/Systems/vm-1/Actions/ComputerSystem.Reset"},
+			},
+			"Links": map[string]interface{}{"ManagedBy": []map[string]string{{"@odata.id": "/redfish/v1/Managers/bmc-1"}}},
+		})
+	case "PATCH /redfish/v1/Systems/vm-1":
+		boot := body["Boot"].(map[string]interface{})
+		f.bootOverride = map[string]string{}
+		for k, v := range boot {
+			f.bootOverride[k] = v.(string)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case "POST /redfish/v1/Systems/vm-1/Actions/ComputerSystem.Reset":
+		if f.failResetWith != 0 {
+			w.WriteHeader(f.failResetWith)
+			reply(map[string]interface{}{"error": map[string]interface{}{
+				"message":               "A general error has occurred.",
+				"@Message.ExtendedInfo": []map[string]string{{"Message": "The system is locked"}},
+			}})
+			return
+		}
+		resetType := body["ResetType"].(string)
+		f.resets = append(f.resets, resetType)
+		if resetType == resetTypeForceOff {
+			f.powerState = "Off"
+		} else {
+			f.powerState = "On"
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case "GET /redfish/v1/Managers/bmc-1":
+		reply(map[string]interface{}{"VirtualMedia": map[string]string{"@odata.id": "/redfish/v1/Managers/bmc-1/VirtualMedia"}})
+	case "GET /redfish/v1/Managers/bmc-1/VirtualMedia":
+		reply(map[string]interface{}{"Members": []map[string]string{
+			{"@odata.id": "/redfish/v1/Managers/bmc-1/VirtualMedia/Floppy"},
+			{"@odata.id": "/redfish/v1/Managers/bmc-1/VirtualMedia/Cd"},
+		}})
+	case "GET /redfish/v1/Managers/bmc-1/VirtualMedia/Floppy":
+		reply(map[string]interface{}{"@odata.id": "/redfish/v1/Managers/bmc-1/VirtualMedia/Floppy", "MediaTypes": []string{"Floppy", "USBStick"}})
+	case "GET /redfish/v1/Managers/bmc-1/VirtualMedia/Cd":
+		reply(map[string]interface{}{
+			"@odata.id":  "/redfish/v1/Managers/bmc-1/VirtualMedia/Cd",
+			"MediaTypes": []string{"CD", "DVD"},
+			"Inserted":   f.inserted,
+			"Actions": map[string]interface{}{
+				"#VirtualMedia.InsertMedia": map[string]string{"target": "/redfish/v1/Managers/bmc-1/VirtualMedia/Cd/Actions/VirtualMedia.InsertMedia"},
+				"#VirtualMedia.EjectMedia":  map[string]string{"target": "/redfish/v1/Managers/bmc-1/VirtualMedia/Cd/Actions/VirtualMedia.EjectMedia"},
+			},
+		})
+	case "POST /redfish/v1/Managers/bmc-1/VirtualMedia/Cd/Actions/VirtualMedia.InsertMedia":
+		f.image = body["Image"].(string)
+		f.inserted = true
+		w.WriteHeader(http.StatusNoContent)
+	case "POST /redfish/v1/Managers/bmc-1/VirtualMedia/Cd/Actions/VirtualMedia.EjectMedia":
+		f.image = ""
+		f.inserted = false
+		f.ejects++
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+var _ = Describe("Redfish", func() {
+	var (
+		api         API
+		server      *httptest.Server
+		fake        *fakeRedfish
+		credentials *Credentials
+		ctx         = context.Background()
+	)
+
+	BeforeEach(func() {
+		fake = &fakeRedfish{powerState: "Off"}
+		server = httptest.NewServer(fake)
+		var err error
+		api, err = NewAPI(common.GetTestLog(), Config{Timeout: 5 * time.Second, AllowedNetworks: []string{"127.0.0.0/8"}})
+		Expect(err).NotTo(HaveOccurred())
+		credentials = &Credentials{
+			Address:  "redfish+" + server.URL + "/redfish/v1/Systems/vm-1",
+			Username: "admin",
+			Password: "password",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("powers on a host that is off", func() {
+		Expect(api.PowerOn(ctx, credentials)).To(Succeed())
+		Expect(fake.resets).To(Equal([]string{resetTypeOn}))
+		// Powering on a host that is on does nothing
+		Expect(api.PowerOn(ctx, credentials)).To(Succeed())
+		Expect(fake.resets).To(HaveLen(1))
+	})
+
+	It("powers off a host that is on", func() {
+		fake.powerState = "On"
+		Expect(api.PowerOff(ctx, credentials)).To(Succeed())
+		Expect(fake.resets).To(Equal([]string{resetTypeForceOff}))
+		Expect(fake.powerState).To(Equal("Off"))
+	})
+
+	It("restarts a host that is on and powers on a host that is off when rebooting", func() {
+		Expect(api.Reboot(ctx, credentials)).To(Succeed())
+		Expect(api.Reboot(ctx, credentials)).To(Succeed())
+		Expect(fake.resets).To(Equal([]string{resetTypeOn, resetTypeForceRestart}))
+	})
+
+	It("uses the first system when the address doesn't point to a system", func() {
+		credentials.Address = "redfish+" + server.URL
+		Expect(api.PowerOn(ctx, credentials)).To(Succeed())
+		Expect(fake.resets).To(Equal([]string{resetTypeOn}))
+	})
+
+	It("boots from the ISO", func() {
+		fake.powerState = "On"
+		fake.inserted = true
+		Expect(api.BootFromISO(ctx, credentials, "https://images.example.com/discovery.iso")).To(Succeed())
+		Expect(fake.ejects).To(Equal(1))
+		Expect(fake.image).To(Equal("https://images.example.com/discovery.iso"))
+		Expect(fake.bootOverride).To(Equal(map[string]string{"BootSourceOverrideEnabled": "Once", "BootSourceOverrideTarget": "Cd"}))
+		Expect(fake.resets).To(Equal([]string{resetTypeForceRestart}))
+	})
+
+	It("reports the errors of the BMC", func() {
+		fake.failResetWith = http.StatusConflict
+		err := api.PowerOn(ctx, credentials)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("409"))
+		Expect(err.Error()).To(ContainSubstring("The system is locked"))
+		Expect(PublicMessage(err)).To(Equal("the BMC returned HTTP status 409"))
+	})
+
+	It("fails with wrong credentials", func() {
+		credentials.Password = "wrong"
+		err := api.PowerOn(ctx, credentials)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("401"))
+		Expect(PublicMessage(err)).To(Equal("the BMC rejected the credentials"))
+	})
+
+	It("doesn't include the connection errors in the public message", func() {
+		server.Close()
+		err := api.PowerOn(ctx, credentials)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("127.0.0.1"))
+		Expect(PublicMessage(err)).To(Equal("the BMC is unreachable"))
+	})
+
+	It("doesn't support IPMI", func() {
+		credentials.Address = "ipmi://192.0.2.10"
+		Expect(errors.Is(api.PowerOn(ctx, credentials), ErrUnsupportedProtocol)).To(BeTrue())
+	})
+
+	It("doesn't send requests to the denied networks", func() {
+		var err error
+		api, err = NewAPI(common.GetTestLog(), Config{Timeout: 5 * time.Second})
+		Expect(err).NotTo(HaveOccurred())
+		err = api.PowerOn(ctx, credentials)
+		Expect(errors.Is(err, ErrAddressNotAllowed)).To(BeTrue())
+		Expect(fake.resets).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Network policy", func() {
+	var api API
+
+	BeforeEach(func() {
+		var err error
+		api, err = NewAPI(common.GetTestLog(), Config{
+			AllowedNetworks: []string{"169.254.10.0/24"},
+			DeniedNetworks:  []string{"10.128.0.0/14", "172.30.0.0/16"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("allows the addresses of the BMC networks", func() {
+		for _, address := range []string{
+			"redfish://192.168.111.1/redfish/v1/Systems/1",
+			"redfish://10.0.0.10/redfish/v1/Systems/1",
+			"redfish://bmc.example.com/redfish/v1/Systems/1",
+			"redfish://[2001:db8::10]/redfish/v1/Systems/1",
+			"redfish://169.254.10.5/redfish/v1/Systems/1",
+		} {
+			Expect(api.ValidateAddress(address)).To(Succeed(), address)
+		}
+	})
+
+	It("denies the loopback, link-local and cluster addresses", func() {
+		for _, address := range []string{
+			"redfish://127.0.0.1:8000/redfish/v1/Systems/1",
+			"redfish://localhost/redfish/v1/Systems/1",
+			"redfish://[::1]/redfish/v1/Systems/1",
+			"redfish://169.254.169.254/latest/meta-data",
+			"redfish://0.0.0.0/redfish/v1/Systems/1",
+			"redfish://10.128.0.20/redfish/v1/Systems/1",
+			"redfish://172.30.0.1/redfish/v1/Systems/1",
+			"redfish://assisted-service.assisted-installer.svc/redfish/v1/Systems/1",
+			"redfish://kubernetes.default.svc.cluster.local/redfish/v1/Systems/1",
+		} {
+			Expect(errors.Is(api.ValidateAddress(address), ErrAddressNotAllowed)).To(BeTrue(), address)
+		}
+	})
+
+	It("fails for invalid networks", func() {
+		_, err := NewAPI(common.GetTestLog(), Config{DeniedNetworks: []string{"10.128.0.0"}})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("ValidateAddress", func() {
+	It("accepts the supported protocols", func() {
+		for _, address := range []string{
+			"redfish://192.0.2.10/redfish/v1/Systems/1",
+			"redfish+http://192.0.2.10:8000/redfish/v1/Systems/1",
+			"idrac-virtualmedia+https://192.0.2.10/redfish/v1/Systems/System.Embedded.1",
+		} {
+			Expect(ValidateAddress(address)).To(Succeed(), address)
+		}
+	})
+
+	It("rejects unknown protocols and invalid addresses", func() {
+		for _, address := range []string{"ilo5://192.0.2.10", "redfish+ftp://192.0.2.10", "192.0.2.10", "redfish://", "ipmi://192.0.2.10:623"} {
+			Expect(ValidateAddress(address)).NotTo(Succeed(), address)
+		}
+	})
+})
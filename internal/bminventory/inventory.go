@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/go-version"
 	"github.com/kennygrant/sanitize"
 	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/bmc"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
 	"github.com/openshift/assisted-service/internal/common"
@@ -28,6 +29,7 @@ import (
 	ignitioncommon "github.com/openshift/assisted-service/internal/common/ignition"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/dns"
+	"github.com/openshift/assisted-service/internal/encryption"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/featuresupport"
 	"github.com/openshift/assisted-service/internal/garbagecollector"
@@ -49,6 +51,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/lvm"
 	"github.com/openshift/assisted-service/internal/provider"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/quota"
 	"github.com/openshift/assisted-service/internal/stream"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
@@ -194,6 +197,7 @@ type InstallerInternals interface {
 //go:generate mockgen --build_flags=--mod=mod -package bminventory -destination mock_crd_utils.go . CRDUtils
 type CRDUtils interface {
 	CreateAgentCR(ctx context.Context, log logrus.FieldLogger, hostId string, infraenv *common.InfraEnv, cluster *common.Cluster) error
+	RequestAgentNodeRemoval(ctx context.Context, log logrus.FieldLogger, host *common.Host, drainTimeout *time.Duration) error
 }
 type bareMetalInventory struct {
 	Config
@@ -208,6 +212,7 @@ type bareMetalInventory struct {
 	objectHandler                 s3wrapper.API
 	metricApi                     metrics.API
 	usageApi                      usage.API
+	quotaApi                      quota.API
 	operatorManagerApi            operators.API
 	generator                     generator.InstallConfigGenerator
 	authHandler                   auth.Authenticator
@@ -228,6 +233,7 @@ type bareMetalInventory struct {
 	insecureIPXEURLs              bool
 	installerInvoker              string
 	disconnectedIgnitionGenerator *ignition.DisconnectedIgnitionGenerator
+	bmcApi                        bmc.API
 }
 
 func NewBareMetalInventory(
@@ -243,6 +249,7 @@ func NewBareMetalInventory(
 	objectHandler s3wrapper.API,
 	metricApi metrics.API,
 	usageApi usage.API,
+	quotaApi quota.API,
 	operatorManagerApi operators.API,
 	authHandler auth.Authenticator,
 	authzHandler auth.Authorizer,
@@ -263,6 +270,7 @@ func NewBareMetalInventory(
 	insecureIPXEURLs bool,
 	installerInvoker string,
 	oveIgnitionGenerator *ignition.DisconnectedIgnitionGenerator,
+	bmcApi bmc.API,
 ) *bareMetalInventory {
 	return &bareMetalInventory{
 		db:                            db,
@@ -278,6 +286,7 @@ func NewBareMetalInventory(
 		objectHandler:                 objectHandler,
 		metricApi:                     metricApi,
 		usageApi:                      usageApi,
+		quotaApi:                      quotaApi,
 		operatorManagerApi:            operatorManagerApi,
 		authHandler:                   authHandler,
 		authzHandler:                  authzHandler,
@@ -297,6 +306,7 @@ func NewBareMetalInventory(
 		insecureIPXEURLs:              insecureIPXEURLs,
 		installerInvoker:              installerInvoker,
 		disconnectedIgnitionGenerator: oveIgnitionGenerator,
+		bmcApi:                        bmcApi,
 	}
 }
 
@@ -789,6 +799,7 @@ func (b *bareMetalInventory) RegisterClusterInternal(ctx context.Context, kubeKe
 			errors.New("Failed to update Pull-secret with additional credentials"))
 	}
 	setPullSecret(cluster, ps)
+	setVspherePassword(cluster)
 
 	if err = validations.ValidateClusterNameFormat(swag.StringValue(params.NewClusterParams.Name),
 		getPlatformType(params.NewClusterParams.Platform)); err != nil {
@@ -809,9 +820,17 @@ func (b *bareMetalInventory) RegisterClusterInternal(ctx context.Context, kubeKe
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
-	err = b.clusterApi.RegisterCluster(ctx, cluster)
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		if err = b.quotaApi.Check(ctx, tx, cluster.OrgID, quota.ResourceClusters); err != nil {
+			return err
+		}
+		if err = b.clusterApi.RegisterCluster(ctx, cluster, tx); err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
+		return nil, err
 	}
 
 	if b.ocmClient != nil {
@@ -1115,10 +1134,18 @@ func (b *bareMetalInventory) V2ImportClusterInternal(ctx context.Context, kubeKe
 	}
 
 	// After registering the cluster, its status should be 'ClusterStatusAddingHosts'
-	err = b.clusterApi.RegisterCluster(ctx, &newCluster)
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		if err = b.quotaApi.Check(ctx, tx, newCluster.OrgID, quota.ResourceClusters); err != nil {
+			return err
+		}
+		if err = b.clusterApi.RegisterCluster(ctx, &newCluster, tx); err != nil {
+			log.Errorf("failed to register cluster %s ", clusterName)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return nil
+	})
 	if err != nil {
-		log.Errorf("failed to register cluster %s ", clusterName)
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
+		return nil, err
 	}
 
 	b.metricApi.ClusterRegistered()
@@ -1286,23 +1313,35 @@ func (b *bareMetalInventory) GenerateInfraEnvISOInternal(ctx context.Context, in
 		return common.NewApiError(http.StatusBadRequest, errors.New(errMsg))
 	}
 
-	now := time.Now()
-	updates := map[string]interface{}{}
-	updates["generated_at"] = strfmt.DateTime(now)
-	updates["image_expires_at"] = strfmt.DateTime(now.Add(b.Config.ImageExpirationTime))
-	dbReply := b.db.Model(&common.InfraEnv{}).Where("id = ?", infraEnv.ID.String()).Updates(updates)
-	if dbReply.Error != nil {
-		log.WithError(dbReply.Error).Errorf("failed to update infra env: %s", infraEnv.ID)
-		msg := "Failed to generate image: error updating metadata"
-		return common.NewApiError(http.StatusInternalServerError, errors.New(msg))
-	}
+	// The generation is counted in the quota of the organization along with its metadata, before the image is
+	// created, so that the concurrent generations of the organization are counted
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		if err := b.quotaApi.Check(ctx, tx, infraEnv.OrgID, quota.ResourceISOGenerationsPerDay); err != nil {
+			return err
+		}
 
-	err := b.createAndUploadNewImage(ctx, log, infraEnv.ProxyHash, *infraEnv.ID, common.ImageTypeValue(infraEnv.Type))
+		now := time.Now()
+		updates := map[string]interface{}{}
+		updates["generated_at"] = strfmt.DateTime(now)
+		updates["image_expires_at"] = strfmt.DateTime(now.Add(b.Config.ImageExpirationTime))
+		dbReply := tx.Model(&common.InfraEnv{}).Where("id = ?", infraEnv.ID.String()).Updates(updates)
+		if dbReply.Error != nil {
+			log.WithError(dbReply.Error).Errorf("failed to update infra env: %s", infraEnv.ID)
+			msg := "Failed to generate image: error updating metadata"
+			return common.NewApiError(http.StatusInternalServerError, errors.New(msg))
+		}
+
+		if err := b.quotaApi.Record(ctx, tx, infraEnv.OrgID, quota.ResourceISOGenerationsPerDay); err != nil {
+			log.WithError(err).Errorf("failed to record the image generation of infra env %s", infraEnv.ID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return b.createAndUploadNewImage(ctx, log, infraEnv.ProxyHash, *infraEnv.ID, common.ImageTypeValue(infraEnv.Type))
 }
 
 func (b *bareMetalInventory) createAndUploadNewImage(ctx context.Context, log logrus.FieldLogger, infraEnvProxyHash string,
@@ -1413,6 +1452,12 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 		return nil, common.NewApiError(http.StatusNotFound, err)
 	}
 
+	// The installation is refused before the roles of the hosts are assigned, and the quota is checked again in the
+	// transaction that records it
+	if err = b.quotaApi.Check(ctx, b.db, cluster.OrgID, quota.ResourceInstallsPerDay); err != nil {
+		return nil, err
+	}
+
 	b.orderClusterNetworks(cluster)
 
 	var autoAssigned bool
@@ -1480,6 +1525,10 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 
 	// prepare cluster and hosts for installation
 	err = b.db.Transaction(func(tx *gorm.DB) error {
+		if err = b.quotaApi.Check(ctx, tx, cluster.OrgID, quota.ResourceInstallsPerDay); err != nil {
+			return err
+		}
+
 		if err = b.clusterApi.PrepareForInstallation(ctx, cluster, tx); err != nil {
 			return err
 		}
@@ -1487,7 +1536,7 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 		if err = b.setBootstrapHost(ctx, *cluster, tx); err != nil {
 			return err
 		}
-		return nil
+		return b.quotaApi.Record(ctx, tx, cluster.OrgID, quota.ResourceInstallsPerDay)
 	})
 	if err != nil {
 		return nil, err
@@ -2166,7 +2215,11 @@ func (b *bareMetalInventory) validateHighAvailabilityWithControlPlaneCount(highA
 }
 
 func (b *bareMetalInventory) V2UpdateCluster(ctx context.Context, params installer.V2UpdateClusterParams) middleware.Responder {
-	c, err := b.v2UpdateClusterInternal(ctx, params, Interactive, nil)
+	mirrorRegistryConfiguration, err := b.getClusterMirrorRegistryConfigurationForUpdate(params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	c, err := b.v2UpdateClusterInternal(ctx, params, Interactive, mirrorRegistryConfiguration)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
@@ -2605,11 +2658,14 @@ func (b *bareMetalInventory) setDiskEncryptionUsage(c *models.Cluster, diskEncry
 	if diskEncryption.Mode != nil {
 		props["mode"] = swag.StringValue(diskEncryption.Mode)
 		props["tang_servers"] = diskEncryption.TangServers
+		if swag.StringValue(diskEncryption.Mode) == models.DiskEncryptionModeThreshold {
+			props["threshold_spec"] = diskEncryption.ThresholdSpec
+		}
 	}
 	b.setUsage(swag.StringValue(c.DiskEncryption.EnableOn) != models.DiskEncryptionEnableOnNone, usage.DiskEncryption, &props, usages)
 }
 
-func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *common.Cluster, params installer.V2UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger, interactivity Interactivity, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration, primaryIPStackUpdated bool, primaryIPStack *common.PrimaryIPStack) error {
+func (b *bareMetalInventory) updateClusterData(ctx context.Context, cluster *common.Cluster, params installer.V2UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger, interactivity Interactivity, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration, primaryIPStackUpdated bool, primaryIPStack *common.PrimaryIPStack) error {
 	var err error
 	updates := map[string]interface{}{}
 	optionalParam(params.ClusterUpdateParams.Name, "name", updates)
@@ -2686,6 +2742,9 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 		if params.ClusterUpdateParams.DiskEncryption.TangServers != "" {
 			updates["disk_encryption_tang_servers"] = params.ClusterUpdateParams.DiskEncryption.TangServers
 		}
+		if params.ClusterUpdateParams.DiskEncryption.ThresholdSpec != "" {
+			updates["disk_encryption_threshold_spec"] = params.ClusterUpdateParams.DiskEncryption.ThresholdSpec
+		}
 		b.setDiskEncryptionUsage(&cluster.Cluster, params.ClusterUpdateParams.DiskEncryption, usages)
 	}
 
@@ -2723,6 +2782,9 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 
 	if len(updates) > 0 {
 		updates["trigger_monitor_timestamp"] = time.Now()
+		if err = common.EncryptColumnUpdates(ctx, "clusters", updates); err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
 		err = db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(updates).Error
 		if err != nil {
 			return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to update cluster: %s", params.ClusterID))
@@ -2925,6 +2987,7 @@ func (b *bareMetalInventory) updateNetworkTables(db *gorm.DB, cluster *common.Cl
 
 func setUpdatesForPlatformParams(params installer.V2UpdateClusterParams, updates map[string]interface{}) {
 	updates["platform_type"] = params.ClusterUpdateParams.Platform.Type
+	setUpdatesForVsphereParams(params.ClusterUpdateParams.Platform, updates)
 	if *params.ClusterUpdateParams.Platform.Type != models.PlatformTypeExternal {
 		// clear any existing values in external settings
 		updates["platform_external_platform_name"] = nil
@@ -2940,6 +3003,33 @@ func setUpdatesForPlatformParams(params installer.V2UpdateClusterParams, updates
 	}
 }
 
+// setUpdatesForVsphereParams replaces the vSphere settings of the cluster with the ones of the update. The password is
+// kept when the update doesn't contain it, so that the other settings can be changed without sending it again.
+func setUpdatesForVsphereParams(platform *models.Platform, updates map[string]interface{}) {
+	settings := platform.Vsphere
+	if *platform.Type != models.PlatformTypeVsphere {
+		// clear any existing values in vSphere settings
+		settings = &models.PlatformVsphere{}
+	} else if settings == nil {
+		return
+	}
+
+	updates["platform_vsphere_vcenter"] = settings.Vcenter
+	updates["platform_vsphere_username"] = settings.Username
+	updates["platform_vsphere_datacenter"] = settings.Datacenter
+	updates["platform_vsphere_cluster"] = settings.Cluster
+	updates["platform_vsphere_default_datastore"] = settings.DefaultDatastore
+	updates["platform_vsphere_network"] = settings.Network
+	updates["platform_vsphere_folder"] = settings.Folder
+	if settings.Vcenter == "" {
+		updates["vsphere_password"] = ""
+		updates["platform_vsphere_password_set"] = false
+	} else if settings.Password != "" {
+		updates["vsphere_password"] = settings.Password.String()
+		updates["platform_vsphere_password_set"] = true
+	}
+}
+
 func (b *bareMetalInventory) updateClusterMirrorRegistry(cluster *common.Cluster, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration, updates map[string]interface{}) error {
 	mirrorConfigString, err := common.ConvertMirrorRegistryConfigToString(mirrorRegistryConfiguration)
 	if err != nil {
@@ -3707,10 +3797,24 @@ func (b *bareMetalInventory) handleReplyError(params installer.V2PostStepReplyPa
 	case models.StepTypeDownloadBootArtifacts:
 		log.Errorf("Failed to download boot artifacts to reclaim host %s, output: %s, error: %s", h.ID, params.Reply.Output, params.Reply.Error)
 		return b.hostApi.HandleReclaimFailure(ctx, h)
+
+	case models.StepTypeExecute:
+		// A custom diagnostic that fails is a result like any other
+		return b.processCustomDiagnosticReply(ctx, h, params.Reply)
 	}
 	return nil
 }
 
+// processCustomDiagnosticReply stores the output of a custom diagnostic step on the host. Replies of execute steps
+// that aren't custom diagnostic steps are ignored.
+func (b *bareMetalInventory) processCustomDiagnosticReply(ctx context.Context, host *models.Host, reply *models.StepReply) error {
+	name, ok := hostcommands.CustomDiagnosticNameFromStepID(reply.StepID)
+	if !ok {
+		return nil
+	}
+	return b.hostApi.UpdateCustomDiagnosticResult(ctx, host, hostcommands.NewCustomDiagnosticResult(name, reply))
+}
+
 func (b *bareMetalInventory) updateFreeAddressesReport(ctx context.Context, host *models.Host, freeAddressesReport string) error {
 	var (
 		err           error
@@ -3950,6 +4054,12 @@ func handleReplyByType(params installer.V2PostStepReplyParams, b *bareMetalInven
 		err = b.hostApi.HandleReclaimBootArtifactDownload(ctx, &host)
 	case models.StepTypeVerifyVips:
 		err = b.HandleVerifyVipsResponse(ctx, &host, stepReply)
+	case models.StepTypeMirrorRegistryCheck:
+		err = b.hostApi.UpdateMirrorRegistryCheckReport(ctx, &host, stepReply)
+	case models.StepTypeNetworkThroughputCheck:
+		err = b.hostApi.UpdateNetworkThroughputReport(ctx, &host, stepReply)
+	case models.StepTypeExecute:
+		err = b.processCustomDiagnosticReply(ctx, &host, params.Reply)
 	}
 	return err
 }
@@ -4012,6 +4122,10 @@ func filterReplyByType(params installer.V2PostStepReplyParams) (string, error) {
 		stepReply, err = filterReply(&models.UpgradeAgentResponse{}, params.Reply.Output)
 	case models.StepTypeVerifyVips:
 		stepReply, err = filterReply(&models.VerifyVipsResponse{}, params.Reply.Output)
+	case models.StepTypeMirrorRegistryCheck:
+		stepReply, err = filterReply(&models.MirrorRegistryCheckResponse{}, params.Reply.Output)
+	case models.StepTypeNetworkThroughputCheck:
+		stepReply, err = filterReply(&models.NetworkThroughputCheckResponse{}, params.Reply.Output)
 	}
 
 	return stepReply, err
@@ -4388,6 +4502,16 @@ func setPullSecret(cluster *common.Cluster, pullSecret string) {
 	}
 }
 
+// setVspherePassword moves the password of the vCenter user out of the platform settings, so that it is never returned
+func setVspherePassword(cluster *common.Cluster) {
+	if cluster.Platform == nil || cluster.Platform.Vsphere == nil {
+		return
+	}
+	cluster.VspherePassword = cluster.Platform.Vsphere.Password.String()
+	cluster.Platform.Vsphere.Password = ""
+	cluster.Platform.Vsphere.PasswordSet = swag.Bool(cluster.VspherePassword != "")
+}
+
 func setInfraEnvPullSecret(infraEnv *common.InfraEnv, pullSecret string) {
 	infraEnv.PullSecret = pullSecret
 	if pullSecret != "" {
@@ -4901,7 +5025,11 @@ func (b *bareMetalInventory) ListInfraEnvs(ctx context.Context, params installer
 }
 
 func (b *bareMetalInventory) RegisterInfraEnv(ctx context.Context, params installer.RegisterInfraEnvParams) middleware.Responder {
-	i, err := b.RegisterInfraEnvInternal(ctx, nil, nil, params)
+	mirrorRegistryConfiguration, err := b.getInfraEnvMirrorRegistryConfigurationForRegister(params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	i, err := b.RegisterInfraEnvInternal(ctx, nil, mirrorRegistryConfiguration, params)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
@@ -4983,6 +5111,11 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 	log = log.WithField(ctxparams.ClusterId, id)
 	log.Infof("Register infraenv: %s with id %s", swag.StringValue(params.InfraenvCreateParams.Name), id)
 
+	// The image of the infra-env is generated once it is registered, so the registration is refused beforehand
+	if err := b.quotaApi.Check(ctx, b.db, ocm.OrgIDFromContext(ctx), quota.ResourceISOGenerationsPerDay); err != nil {
+		return nil, err
+	}
+
 	var err error
 	err = b.db.Transaction(func(tx *gorm.DB) error {
 		params = b.setDefaultRegisterInfraEnvParams(ctx, params)
@@ -5330,7 +5463,11 @@ func (b *bareMetalInventory) setDiscoveryKernelArgumentsUsage(db *gorm.DB, clust
 }
 
 func (b *bareMetalInventory) UpdateInfraEnv(ctx context.Context, params installer.UpdateInfraEnvParams) middleware.Responder {
-	i, err := b.UpdateInfraEnvInternal(ctx, params, nil, nil)
+	mirrorRegistryConfiguration, err := b.getInfraEnvMirrorRegistryConfigurationForUpdate(params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	i, err := b.UpdateInfraEnvInternal(ctx, params, nil, mirrorRegistryConfiguration)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
@@ -5453,7 +5590,7 @@ func (b *bareMetalInventory) UpdateInfraEnvInternal(ctx context.Context, params
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
 
-		err = b.updateInfraEnvData(infraEnv, params, internalIgnitionConfig, tx, log, currentImageType, targetImageType, params.InfraEnvUpdateParams.RendezvousIP)
+		err = b.updateInfraEnvData(ctx, infraEnv, params, internalIgnitionConfig, tx, log, currentImageType, targetImageType, params.InfraEnvUpdateParams.RendezvousIP)
 		if err != nil {
 			log.WithError(err).Error("updateInfraEnvData")
 			return err
@@ -5509,7 +5646,7 @@ func (b *bareMetalInventory) validateDiscoveryIgnitionImageSize(ctx context.Cont
 	return nil
 }
 
-func (b *bareMetalInventory) updateInfraEnvData(infraEnv *common.InfraEnv, params installer.UpdateInfraEnvParams, internalIgnitionConfig *string, db *gorm.DB, log logrus.FieldLogger, currentImageType, targetImageType models.ImageType, rendezvousIP *string) error {
+func (b *bareMetalInventory) updateInfraEnvData(ctx context.Context, infraEnv *common.InfraEnv, params installer.UpdateInfraEnvParams, internalIgnitionConfig *string, db *gorm.DB, log logrus.FieldLogger, currentImageType, targetImageType models.ImageType, rendezvousIP *string) error {
 	updates := map[string]interface{}{}
 	if err := b.updateInfraEnvProxy(params, infraEnv, updates); err != nil {
 		return err
@@ -5578,6 +5715,9 @@ func (b *bareMetalInventory) updateInfraEnvData(infraEnv *common.InfraEnv, param
 
 	if len(updates) > 0 {
 		updates["generated"] = false
+		if err := common.EncryptColumnUpdates(ctx, "infra_envs", updates); err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
 		dbReply := db.Model(&common.InfraEnv{}).Where("id = ?", infraEnv.ID.String()).Updates(updates)
 		if dbReply.Error != nil {
 			return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(dbReply.Error, "failed to update infraEnv: %s", params.InfraEnvID))
@@ -5830,6 +5970,13 @@ func (b *bareMetalInventory) V2RegisterHost(ctx context.Context, params installe
 		// In case host doesn't exists check if the cluster accept new hosts registration
 		newRecord := err != nil && errors.Is(err, gorm.ErrRecordNotFound)
 
+		if newRecord {
+			if err = b.quotaApi.Check(ctx, tx, infraEnv.OrgID, quota.ResourceHosts); err != nil {
+				log.WithError(err).Warnf("failed to register host <%s> to infra-env %s", params.NewHostParams.HostID, params.InfraEnvID.String())
+				return err
+			}
+		}
+
 		url := installer.V2GetHostURL{InfraEnvID: params.InfraEnvID, HostID: *params.NewHostParams.HostID}
 		kind := swag.String(models.HostKindHost)
 
@@ -6360,6 +6507,338 @@ func (b *bareMetalInventory) V2UpdateHostInstallerArgsInternal(ctx context.Conte
 	return &h.Host, nil
 }
 
+func (b *bareMetalInventory) V2GenerateHostStaticNetworkConfig(ctx context.Context, params installer.V2GenerateHostStaticNetworkConfigParams) middleware.Responder {
+	hostConfig, err := b.V2GenerateHostStaticNetworkConfigInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GenerateHostStaticNetworkConfigOK().WithPayload(hostConfig)
+}
+
+func (b *bareMetalInventory) V2GenerateHostStaticNetworkConfigInternal(ctx context.Context, params installer.V2GenerateHostStaticNetworkConfigParams) (*models.HostStaticNetworkConfig, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	h, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("Host %s not found", params.HostID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if h.Inventory == "" {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("Host %s has not reported its inventory yet", params.HostID))
+	}
+	inventory, err := common.UnmarshalInventory(h.Inventory)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	hostConfig, err := staticnetworkconfig.GenerateFromInventory(inventory, params.GenerateStaticNetworkConfigParams)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err = b.staticNetworkConfig.ValidateStaticConfigParamsYAML([]*models.HostStaticNetworkConfig{hostConfig}); err != nil {
+		log.WithError(err).Errorf("generated an invalid static network configuration for host %s", params.HostID)
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if !swag.BoolValue(params.GenerateStaticNetworkConfigParams.Apply) {
+		return hostConfig, nil
+	}
+
+	if err = b.checkUpdateAccessToObj(ctx, h, "host", &params.HostID); err != nil {
+		return nil, err
+	}
+	infraEnv, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	staticNetworkConfig, err := replaceHostStaticNetworkConfig(infraEnv.StaticNetworkConfig, inventory, hostConfig)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if _, err = b.UpdateInfraEnvInternal(ctx, installer.UpdateInfraEnvParams{
+		InfraEnvID:           params.InfraEnvID,
+		InfraEnvUpdateParams: &models.InfraEnvUpdateParams{StaticNetworkConfig: staticNetworkConfig},
+	}, nil, nil); err != nil {
+		return nil, err
+	}
+	log.Infof("Applied the generated static network configuration of host %s to infra env %s", params.HostID, params.InfraEnvID)
+	return hostConfig, nil
+}
+
+// replaceHostStaticNetworkConfig returns the static network configuration of an infra-env where the configuration
+// of a host replaces the ones mapping any of the MAC addresses of the host
+func replaceHostStaticNetworkConfig(staticNetworkConfigStr string, inventory *models.Inventory,
+	hostConfig *models.HostStaticNetworkConfig) ([]*models.HostStaticNetworkConfig, error) {
+	var staticNetworkConfig []*models.HostStaticNetworkConfig
+	if staticNetworkConfigStr != "" {
+		if err := json.Unmarshal([]byte(staticNetworkConfigStr), &staticNetworkConfig); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the static network configuration of the infra env")
+		}
+	}
+	hostMacs := make(map[string]struct{})
+	for _, iface := range inventory.Interfaces {
+		hostMacs[strings.ToLower(iface.MacAddress)] = struct{}{}
+	}
+	ret := []*models.HostStaticNetworkConfig{hostConfig}
+	for _, config := range staticNetworkConfig {
+		isHostConfig := false
+		for _, item := range config.MacInterfaceMap {
+			if _, ok := hostMacs[strings.ToLower(item.MacAddress)]; ok {
+				isHostConfig = true
+				break
+			}
+		}
+		if !isHostConfig {
+			ret = append(ret, config)
+		}
+	}
+	return ret, nil
+}
+
+func (b *bareMetalInventory) V2ValidateStaticNetworkConfig(ctx context.Context, params installer.V2ValidateStaticNetworkConfigParams) middleware.Responder {
+	validation, err := b.V2ValidateStaticNetworkConfigInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ValidateStaticNetworkConfigOK().WithPayload(validation)
+}
+
+func (b *bareMetalInventory) V2ValidateStaticNetworkConfigInternal(ctx context.Context, params installer.V2ValidateStaticNetworkConfigParams) (*models.StaticNetworkConfigValidation, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	if len(params.StaticNetworkConfigValidationParams.StaticNetworkConfig) == 0 {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("at least one host static network configuration must be provided"))
+	}
+	expectedSubnets := make([]string, 0, len(params.StaticNetworkConfigValidationParams.ExpectedSubnets))
+	for _, subnet := range params.StaticNetworkConfigValidationParams.ExpectedSubnets {
+		if _, _, err := net.ParseCIDR(string(subnet)); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "invalid expected subnet %s", subnet))
+		}
+		expectedSubnets = append(expectedSubnets, string(subnet))
+	}
+
+	validation := b.staticNetworkConfig.ValidateStaticNetworkConfigPerHost(params.StaticNetworkConfigValidationParams.StaticNetworkConfig, expectedSubnets)
+	if !swag.BoolValue(validation.Valid) {
+		log.Infof("Static network configuration of %d hosts is invalid", len(validation.Hosts))
+	}
+	return validation, nil
+}
+
+func (b *bareMetalInventory) V2RemoveHostFromCluster(ctx context.Context, params installer.V2RemoveHostFromClusterParams) middleware.Responder {
+	h, err := b.V2RemoveHostFromClusterInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2RemoveHostFromClusterAccepted().WithPayload(&h.Host)
+}
+
+func (b *bareMetalInventory) V2RemoveHostFromClusterInternal(ctx context.Context, params installer.V2RemoveHostFromClusterParams) (*common.Host, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	h, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("Host %s not found", params.HostID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err = b.checkUpdateAccessToObj(ctx, h, "host", &params.HostID); err != nil {
+		return nil, err
+	}
+	if h.ClusterID == nil || !funk.ContainsString([]string{models.HostStatusInstalled, models.HostStatusAddedToExistingCluster}, swag.StringValue(h.Status)) {
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("Host %s isn't installed in a cluster", params.HostID))
+	}
+	if h.KubeKeyNamespace == "" {
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Host %s isn't managed by the kube-api, its node must be removed from the cluster manually", params.HostID))
+	}
+
+	var drainTimeout *time.Duration
+	if params.HostNodeRemovalParams != nil && params.HostNodeRemovalParams.DrainTimeout != nil {
+		timeout := time.Duration(swag.Int64Value(params.HostNodeRemovalParams.DrainTimeout)) * time.Second
+		drainTimeout = &timeout
+	}
+	if err = b.crdUtils.RequestAgentNodeRemoval(ctx, log, h, drainTimeout); err != nil {
+		log.WithError(err).Errorf("failed to request the removal of the node of host %s", params.HostID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Requested the removal of the node of host %s from cluster %s", params.HostID, h.ClusterID)
+	return h, nil
+}
+
+// getHostForUpdate returns the host of the infra-env if the user is allowed to update it
+func (b *bareMetalInventory) getHostForUpdate(ctx context.Context, infraEnvID, hostID strfmt.UUID) (*common.Host, error) {
+	h, err := common.GetHostFromDB(b.db, infraEnvID.String(), hostID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("Host %s not found", hostID))
+		}
+		if errors.Is(err, encryption.ErrNoKeyProvider) {
+			return nil, common.NewApiError(http.StatusServiceUnavailable, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err = b.checkUpdateAccessToObj(ctx, h, "host", &hostID); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func (b *bareMetalInventory) updateHostBMCColumns(ctx context.Context, infraEnvID, hostID strfmt.UUID, updates map[string]interface{}) (*common.Host, error) {
+	if err := common.EncryptColumnUpdates(ctx, "hosts", updates); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err := b.db.Model(&common.Host{}).Where("id = ? and infra_env_id = ?", hostID, infraEnvID).Updates(updates).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to update the BMC credentials of host %s", hostID))
+	}
+	h, err := common.GetHostFromDB(b.db, infraEnvID.String(), hostID.String())
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return h, nil
+}
+
+func (b *bareMetalInventory) V2UpdateHostBmcCredentials(ctx context.Context, params installer.V2UpdateHostBmcCredentialsParams) middleware.Responder {
+	h, err := b.V2UpdateHostBmcCredentialsInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2UpdateHostBmcCredentialsOK().WithPayload(&h.Host)
+}
+
+func (b *bareMetalInventory) V2UpdateHostBmcCredentialsInternal(ctx context.Context, params installer.V2UpdateHostBmcCredentialsParams) (*common.Host, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	h, err := b.getHostForUpdate(ctx, params.InfraEnvID, params.HostID)
+	if err != nil {
+		return nil, err
+	}
+	// The BMC passwords are never stored in plaintext
+	if encryption.DefaultEncryptor() == nil {
+		return nil, common.NewApiError(http.StatusServiceUnavailable,
+			errors.New("BMC credentials can't be stored because no encryption key provider is configured"))
+	}
+	credentials := params.HostBmcCredentials
+	address := swag.StringValue(credentials.Address)
+	if err = b.bmcApi.ValidateAddress(address); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	h, err = b.updateHostBMCColumns(ctx, params.InfraEnvID, params.HostID, map[string]interface{}{
+		"bmc_address":                          address,
+		"bmc_credentials_set":                  true,
+		"bmc_username":                         swag.StringValue(credentials.Username),
+		"bmc_password":                         credentials.Password.String(),
+		"bmc_disable_certificate_verification": swag.BoolValue(credentials.DisableCertificateVerification),
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Set the BMC credentials of host %s for %s", params.HostID, address)
+	eventgen.SendHostBmcCredentialsUpdatedEvent(ctx, b.eventsHandler, params.HostID, params.InfraEnvID, h.ClusterID,
+		hostutil.GetHostnameForMsg(&h.Host), address)
+	return h, nil
+}
+
+func (b *bareMetalInventory) V2DeleteHostBmcCredentials(ctx context.Context, params installer.V2DeleteHostBmcCredentialsParams) middleware.Responder {
+	h, err := b.V2DeleteHostBmcCredentialsInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2DeleteHostBmcCredentialsOK().WithPayload(&h.Host)
+}
+
+func (b *bareMetalInventory) V2DeleteHostBmcCredentialsInternal(ctx context.Context, params installer.V2DeleteHostBmcCredentialsParams) (*common.Host, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	h, err := b.getHostForUpdate(ctx, params.InfraEnvID, params.HostID)
+	if err != nil {
+		return nil, err
+	}
+	if !h.BmcCredentialsSet {
+		return h, nil
+	}
+	h, err = b.updateHostBMCColumns(ctx, params.InfraEnvID, params.HostID, map[string]interface{}{
+		"bmc_address":                          "",
+		"bmc_credentials_set":                  false,
+		"bmc_username":                         "",
+		"bmc_password":                         "",
+		"bmc_disable_certificate_verification": false,
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Removed the BMC credentials of host %s", params.HostID)
+	eventgen.SendHostBmcCredentialsRemovedEvent(ctx, b.eventsHandler, params.HostID, params.InfraEnvID, h.ClusterID,
+		hostutil.GetHostnameForMsg(&h.Host))
+	return h, nil
+}
+
+func (b *bareMetalInventory) V2HostPowerAction(ctx context.Context, params installer.V2HostPowerActionParams) middleware.Responder {
+	h, err := b.V2HostPowerActionInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2HostPowerActionOK().WithPayload(&h.Host)
+}
+
+func (b *bareMetalInventory) V2HostPowerActionInternal(ctx context.Context, params installer.V2HostPowerActionParams) (*common.Host, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	h, err := b.getHostForUpdate(ctx, params.InfraEnvID, params.HostID)
+	if err != nil {
+		return nil, err
+	}
+	if !h.BmcCredentialsSet {
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("Host %s has no BMC credentials", params.HostID))
+	}
+	credentials := &bmc.Credentials{
+		Address:                        h.BmcAddress,
+		Username:                       h.BMCUsername,
+		Password:                       h.BMCPassword,
+		DisableCertificateVerification: h.BMCDisableCertificateVerification,
+	}
+
+	action := swag.StringValue(params.HostPowerActionParams.Action)
+	switch action {
+	case models.HostPowerActionParamsActionPowerOn:
+		err = b.bmcApi.PowerOn(ctx, credentials)
+	case models.HostPowerActionParamsActionPowerOff:
+		err = b.bmcApi.PowerOff(ctx, credentials)
+	case models.HostPowerActionParamsActionReboot:
+		err = b.bmcApi.Reboot(ctx, credentials)
+	case models.HostPowerActionParamsActionBootFromIso:
+		var infraEnv *common.InfraEnv
+		infraEnv, err = common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		var isoURL string
+		if isoURL, err = b.virtualMediaISOURL(infraEnv); err != nil {
+			return nil, err
+		}
+		err = b.bmcApi.BootFromISO(ctx, credentials, isoURL)
+	default:
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Errorf("Unsupported power action %s", action))
+	}
+
+	hostname := hostutil.GetHostnameForMsg(&h.Host)
+	if err != nil {
+		log.WithError(err).Errorf("power action %s failed on host %s", action, params.HostID)
+		message := bmc.PublicMessage(err)
+		eventgen.SendHostPowerActionFailedEvent(ctx, b.eventsHandler, params.HostID, params.InfraEnvID, h.ClusterID, hostname, action, message)
+		if errors.Is(err, bmc.ErrUnsupportedProtocol) || errors.Is(err, bmc.ErrAddressNotAllowed) {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.New(message))
+		}
+		return nil, common.NewApiError(http.StatusBadGateway, errors.New(message))
+	}
+	log.Infof("Sent power action %s to the BMC of host %s", action, params.HostID)
+	eventgen.SendHostPowerActionSucceededEvent(ctx, b.eventsHandler, params.HostID, params.InfraEnvID, h.ClusterID, hostname, action)
+	return h, nil
+}
+
 func (b *bareMetalInventory) V2UpdateHostIgnition(ctx context.Context, params installer.V2UpdateHostIgnitionParams) middleware.Responder {
 	_, err := b.V2UpdateHostIgnitionInternal(ctx, params)
 	if err != nil {
@@ -7213,6 +7692,82 @@ func extractMirroredRegistriesFromConfig(log logrus.FieldLogger, mirrorRegistryC
 	return sourceRegistries
 }
 
+// parseMirrorRegistryConfigurationParams validates the mirror registry configuration given through the REST API and
+// returns the configuration to store. Nil is returned when registries.conf is empty, which removes the configuration.
+func parseMirrorRegistryConfigurationParams(params *models.MirrorRegistryConfigurationParams) (*common.MirrorRegistryConfiguration, error) {
+	if params == nil || params.RegistriesConf == "" {
+		if params != nil && params.CaBundleCrt != "" {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.New("the CA bundle of the mirror registries requires registries_conf"))
+		}
+		return nil, nil
+	}
+	if _, err := mirrorregistries.ExtractLocationMirrorDataFromRegistriesFromToml(params.RegistriesConf); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "invalid mirror registries configuration"))
+	}
+	if params.CaBundleCrt != "" {
+		if err := validations.ValidatePEMCertificateBundle(params.CaBundleCrt); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "invalid CA bundle of the mirror registries"))
+		}
+	}
+	mirrorRegistryConfiguration, err := mirrorregistries.ParseMirrorRegistryConfiguration(params.RegistriesConf, params.CaBundleCrt)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "invalid mirror registries configuration"))
+	}
+	return mirrorRegistryConfiguration, nil
+}
+
+// getClusterMirrorRegistryConfigurationForUpdate returns the mirror registry configuration that the cluster has after
+// an update through the REST API, which keeps the current configuration unless the update sets one
+func (b *bareMetalInventory) getClusterMirrorRegistryConfigurationForUpdate(params installer.V2UpdateClusterParams) (*common.MirrorRegistryConfiguration, error) {
+	if params.ClusterUpdateParams.MirrorRegistryConfiguration != nil {
+		return parseMirrorRegistryConfigurationParams(params.ClusterUpdateParams.MirrorRegistryConfiguration)
+	}
+	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusNotFound, err)
+	}
+	mirrorRegistryConfiguration, err := cluster.GetMirrorRegistryConfiguration()
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return mirrorRegistryConfiguration, nil
+}
+
+// getInfraEnvMirrorRegistryConfigurationForRegister returns the mirror registry configuration of an infra-env that is
+// registered through the REST API. Unless it is set, the infra-env uses the mirror registries of its cluster.
+func (b *bareMetalInventory) getInfraEnvMirrorRegistryConfigurationForRegister(params installer.RegisterInfraEnvParams) (*common.MirrorRegistryConfiguration, error) {
+	if params.InfraenvCreateParams.MirrorRegistryConfiguration != nil || params.InfraenvCreateParams.ClusterID == nil {
+		return parseMirrorRegistryConfigurationParams(params.InfraenvCreateParams.MirrorRegistryConfiguration)
+	}
+	cluster, err := common.GetClusterFromDB(b.db, *params.InfraenvCreateParams.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		// the cluster is validated when the infra-env is registered
+		return nil, nil
+	}
+	mirrorRegistryConfiguration, err := cluster.GetMirrorRegistryConfiguration()
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return mirrorRegistryConfiguration, nil
+}
+
+// getInfraEnvMirrorRegistryConfigurationForUpdate returns the mirror registry configuration that the infra-env has
+// after an update through the REST API, which keeps the current configuration unless the update sets one
+func (b *bareMetalInventory) getInfraEnvMirrorRegistryConfigurationForUpdate(params installer.UpdateInfraEnvParams) (*common.MirrorRegistryConfiguration, error) {
+	if params.InfraEnvUpdateParams.MirrorRegistryConfiguration != nil {
+		return parseMirrorRegistryConfigurationParams(params.InfraEnvUpdateParams.MirrorRegistryConfiguration)
+	}
+	infraEnv, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusNotFound, err)
+	}
+	mirrorRegistryConfiguration, err := infraEnv.GetMirrorRegistryConfiguration()
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return mirrorRegistryConfiguration, nil
+}
+
 func (b *bareMetalInventory) GetHostByIdInternal(ctx context.Context, hostId string) (*common.Host, error) {
 	return common.GetHostFromDBbyHostId(b.db, strfmt.UUID(hostId))
 }
@@ -20,6 +20,8 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -38,6 +40,7 @@ import (
 	gomega_format "github.com/onsi/gomega/format"
 	amgmtv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/bmc"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
 	"github.com/openshift/assisted-service/internal/common"
@@ -45,6 +48,7 @@ import (
 	commontesting "github.com/openshift/assisted-service/internal/common/testing"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/dns"
+	"github.com/openshift/assisted-service/internal/encryption"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
 	"github.com/openshift/assisted-service/internal/featuresupport"
@@ -63,6 +67,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/provider/vsphere"
+	"github.com/openshift/assisted-service/internal/quota"
 	"github.com/openshift/assisted-service/internal/stream"
 	testutils "github.com/openshift/assisted-service/internal/testing"
 	"github.com/openshift/assisted-service/internal/usage"
@@ -173,6 +178,7 @@ var (
 	mockIgnitionBuilder               *ignition.MockIgnitionBuilder
 	mockInstallConfigBuilder          *installcfg_builder.MockInstallConfigBuilder
 	mockStaticNetworkConfig           *staticnetworkconfig.MockStaticNetworkConfig
+	mockBMC                           *bmc.MockAPI
 	mockProviderRegistry              *registry.MockProviderRegistry
 	mockMirrorRegistriesConfigBuilder *mirrorregistries.MockServiceMirrorRegistriesConfigBuilder
 	mockInstallerCache                *installercache.MockInstallerCache
@@ -394,7 +400,7 @@ var _ = Describe("RegisterDisconnectedCluster", func() {
 		// Avoid AMS subscription side effects during registration in this test
 		//bm.ocmClient = nil
 		// Use real cluster manager so RegisterCluster persists to DB
-		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 		mockUsageReports()
 	})
 
@@ -1110,6 +1116,145 @@ var _ = Describe("v2PostStepReply", func() {
 		})
 	})
 
+	Context("mirror registry check", func() {
+		var (
+			hostId    strfmt.UUID
+			clusterId strfmt.UUID
+		)
+		BeforeEach(func() {
+			clusterId = strfmt.UUID(uuid.New().String())
+			hostId = strfmt.UUID(uuid.New().String())
+			host := models.Host{
+				ID:         &hostId,
+				InfraEnvID: clusterId,
+				ClusterID:  &clusterId,
+				Status:     swag.String(models.HostStatusKnown),
+			}
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+		makeStepReply := func(output string) installer.V2PostStepReplyParams {
+			return installer.V2PostStepReplyParams{
+				InfraEnvID: clusterId,
+				HostID:     hostId,
+				Reply: &models.StepReply{
+					Output:   output,
+					StepType: models.StepTypeMirrorRegistryCheck,
+				},
+			}
+		}
+
+		It("stores only the known fields of the report", func() {
+			expected := `{"images":[{"mirror":"mirror.example.com/art@sha256:1111","name":"quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:1111","result":"available"}]}`
+			mockHostApi.EXPECT().UpdateMirrorRegistryCheckReport(ctx, gomock.Any(), expected).Return(nil).Times(1)
+			reply := bm.V2PostStepReply(ctx, makeStepReply(`{"images":[{"name":"quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:1111",`+
+				`"mirror":"mirror.example.com/art@sha256:1111","result":"available","unknown":"field"}]}`))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+
+		It("fails on a malformed report", func() {
+			reply := bm.V2PostStepReply(ctx, makeStepReply("not json"))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyBadRequest()))
+		})
+	})
+
+	Context("network throughput check", func() {
+		var (
+			hostId    strfmt.UUID
+			clusterId strfmt.UUID
+		)
+		BeforeEach(func() {
+			clusterId = strfmt.UUID(uuid.New().String())
+			hostId = strfmt.UUID(uuid.New().String())
+			host := models.Host{
+				ID:         &hostId,
+				InfraEnvID: clusterId,
+				ClusterID:  &clusterId,
+				Status:     swag.String(models.HostStatusKnown),
+			}
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+		makeStepReply := func(output string) installer.V2PostStepReplyParams {
+			return installer.V2PostStepReplyParams{
+				InfraEnvID: clusterId,
+				HostID:     hostId,
+				Reply: &models.StepReply{
+					Output:   output,
+					StepType: models.StepTypeNetworkThroughputCheck,
+				},
+			}
+		}
+
+		It("updates the network throughput of the host", func() {
+			remoteHostID := uuid.New().String()
+			expected := `{"remote_hosts":[{"host_id":"` + remoteHostID + `","ip_address":"10.0.0.2","throughput_mbps":9400}]}`
+			mockHostApi.EXPECT().UpdateNetworkThroughputReport(ctx, gomock.Any(), expected).Return(nil).Times(1)
+			reply := bm.V2PostStepReply(ctx, makeStepReply(`{"remote_hosts":[{"host_id":"`+remoteHostID+`","ip_address":"10.0.0.2",`+
+				`"throughput_mbps":9400,"retransmits":3}]}`))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+
+		It("fails on a malformed report", func() {
+			reply := bm.V2PostStepReply(ctx, makeStepReply("not json"))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyBadRequest()))
+		})
+	})
+
+	Context("custom diagnostic", func() {
+		var (
+			hostId    strfmt.UUID
+			clusterId strfmt.UUID
+		)
+		BeforeEach(func() {
+			clusterId = strfmt.UUID(uuid.New().String())
+			hostId = strfmt.UUID(uuid.New().String())
+			host := models.Host{
+				ID:         &hostId,
+				InfraEnvID: clusterId,
+				ClusterID:  &clusterId,
+				Status:     swag.String(models.HostStatusKnown),
+			}
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+		makeStepReply := func(stepID string, exitCode int64, output string) installer.V2PostStepReplyParams {
+			return installer.V2PostStepReplyParams{
+				InfraEnvID: clusterId,
+				HostID:     hostId,
+				Reply: &models.StepReply{
+					StepID:   stepID,
+					StepType: models.StepTypeExecute,
+					ExitCode: exitCode,
+					Output:   output,
+				},
+			}
+		}
+		expectResult := func(exitCode int64, output string) {
+			mockHostApi.EXPECT().UpdateCustomDiagnosticResult(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *models.Host, result *models.CustomDiagnosticResult) error {
+					Expect(result.Name).To(Equal("lldp"))
+					Expect(result.ExitCode).To(Equal(exitCode))
+					Expect(result.Output).To(Equal(output))
+					return nil
+				}).Times(1)
+		}
+
+		It("stores the output of the step", func() {
+			expectResult(0, "lldp output")
+			reply := bm.V2PostStepReply(ctx, makeStepReply("custom-diagnostic-lldp-1a2b3c4d", 0, "lldp output"))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+
+		It("stores the result of a failed step", func() {
+			expectResult(1, "")
+			reply := bm.V2PostStepReply(ctx, makeStepReply("custom-diagnostic-lldp-1a2b3c4d", 1, ""))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+
+		It("ignores other execute steps", func() {
+			reply := bm.V2PostStepReply(ctx, makeStepReply("execute-1a2b3c4d", 0, "output"))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+	})
+
 	Context("Dhcp allocation", func() {
 		var (
 			clusterId, hostId *strfmt.UUID
@@ -2037,7 +2182,7 @@ var _ = Describe("cluster", func() {
 		})
 		It("happy flow", func() {
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockStream, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+				db, mockStream, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 			mockClusterRegisterSuccessWithVersion(models.ClusterCPUArchitectureX8664, "4.8")
 
 			MinimalOpenShiftVersionForNoneHA := "4.8.0-fc.0"
@@ -2054,7 +2199,7 @@ var _ = Describe("cluster", func() {
 		})
 		It("create non ha cluster fail, release version is lower than minimal", func() {
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 			insufficientOpenShiftVersionForNoneHA := "4.7"
 			clusterParams.OpenshiftVersion = swag.String(insufficientOpenShiftVersionForNoneHA)
 			clusterParams.ControlPlaneCount = swag.Int64(1)
@@ -2065,7 +2210,7 @@ var _ = Describe("cluster", func() {
 		})
 		It("create non ha cluster fail, release version is pre-release and lower than minimal", func() {
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 			insufficientOpenShiftVersionForNoneHA := "4.7.0-fc.1"
 			clusterParams.OpenshiftVersion = swag.String(insufficientOpenShiftVersionForNoneHA)
 			clusterParams.ControlPlaneCount = swag.Int64(1)
@@ -2076,7 +2221,7 @@ var _ = Describe("cluster", func() {
 		})
 		It("create non ha cluster success, release version is greater than minimal", func() {
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccessWithVersion(models.ClusterCPUArchitectureX8664, "4.8")
 			openShiftVersionForNoneHA := "4.8.0"
@@ -2093,7 +2238,7 @@ var _ = Describe("cluster", func() {
 		})
 		It("create non ha cluster success, release version is pre-release and greater than minimal", func() {
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccessWithVersion(models.ClusterCPUArchitectureX8664, "4.8")
 			openShiftVersionForNoneHA := "4.8.0-fc.2"
@@ -2111,7 +2256,7 @@ var _ = Describe("cluster", func() {
 		It("create non ha cluster fail, explicitly disabled UserManagedNetworking", func() {
 			errStr := "Can't set none platform with user-managed-networking disabled"
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 			openShiftVersionForNoneHA := "4.8.0-fc.2"
 			clusterParams.OpenshiftVersion = swag.String(openShiftVersionForNoneHA)
 			clusterParams.ControlPlaneCount = swag.Int64(1)
@@ -2123,7 +2268,7 @@ var _ = Describe("cluster", func() {
 		})
 		It("create non ha cluster fail, explicitly enabled VipDhcpAllocation", func() {
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 			openShiftVersionForNoneHA := "4.8.0-fc.2"
 			clusterParams.OpenshiftVersion = swag.String(openShiftVersionForNoneHA)
 			clusterParams.ControlPlaneCount = swag.Int64(1)
@@ -2137,7 +2282,7 @@ var _ = Describe("cluster", func() {
 	})
 	It("create non ha cluster success, release version is ci-release and greater than minimal", func() {
 		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-			db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+			db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 
 		mockClusterRegisterSuccessWithVersion(models.ClusterCPUArchitectureX8664, "4.8")
 		openShiftVersionForNoneHA := "4.8.0-0.ci.test-2021-05-20-000749-ci-op-7xrzwgwy-latest"
@@ -2264,7 +2409,7 @@ var _ = Describe("cluster", func() {
 		It("update cluster day1 with APIVipDNSName failed", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
 			mockNoChangeInOperatorDependencies(mockOperators)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(true)
 
@@ -2294,7 +2439,7 @@ var _ = Describe("cluster", func() {
 				cpuArchitecture := "irrelevant"
 				mockOperators := operators.NewMockAPI(ctrl)
 				mockNoChangeInOperatorDependencies(mockOperators)
-				bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil)
+				bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 
 				mockClusterRegisterSuccessWithVersion(cpuArchitecture, openshiftVersion)
 
@@ -2343,7 +2488,7 @@ var _ = Describe("cluster", func() {
 				cpuArchitecture := "irrelevant"
 				mockOperators := operators.NewMockAPI(ctrl)
 				mockNoChangeInOperatorDependencies(mockOperators)
-				bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil)
+				bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 
 				mockClusterRegisterSuccessWithVersion(cpuArchitecture, openshiftVersion)
 				clusterCreateParams := &models.ClusterCreateParams{
@@ -2438,7 +2583,7 @@ var _ = Describe("cluster", func() {
 				BeforeEach(func() {
 					openshiftVersion = "4.12.0"
 					bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-						db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+						db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 				})
 				Context("RegisterCluster - Multiple-VIPs Support", func() {
 
@@ -6241,6 +6386,71 @@ var _ = Describe("V2UpdateCluster", func() {
 					Expect(*actual2.Platform.Type).To(Equal(models.PlatformTypeVsphere))
 				})
 
+				It("Update vSphere settings - the password is stored and not returned", func() {
+					mockClusterUpdateSuccess(1, 0)
+					mockProviderRegistry.EXPECT().SetPlatformUsages(commontesting.EqPlatformType(models.PlatformTypeVsphere), gomock.Any(), mockUsage)
+
+					reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.V2ClusterUpdateParams{
+							Platform: &models.Platform{
+								Type: common.PlatformTypePtr(models.PlatformTypeVsphere),
+								Vsphere: &models.PlatformVsphere{
+									Vcenter:          "vcenter.example.com",
+									Username:         "admin",
+									Password:         "secret",
+									Datacenter:       "dc",
+									Cluster:          "cluster",
+									DefaultDatastore: "datastore",
+									Network:          "network",
+								},
+							},
+						},
+					})
+					Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+					actual := reply.(*installer.V2UpdateClusterCreated).Payload
+					Expect(actual.Platform.Vsphere.Vcenter).To(Equal("vcenter.example.com"))
+					Expect(actual.Platform.Vsphere.Password).To(BeEmpty())
+					Expect(swag.BoolValue(actual.Platform.Vsphere.PasswordSet)).To(BeTrue())
+
+					dbCluster, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbCluster.VspherePassword).To(Equal("secret"))
+				})
+
+				It("Update vSphere settings without the datastore - failure", func() {
+					reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.V2ClusterUpdateParams{
+							Platform: &models.Platform{
+								Type: common.PlatformTypePtr(models.PlatformTypeVsphere),
+								Vsphere: &models.PlatformVsphere{
+									Vcenter:    "vcenter.example.com",
+									Username:   "admin",
+									Password:   "secret",
+									Datacenter: "dc",
+									Cluster:    "cluster",
+									Network:    "network",
+								},
+							},
+						},
+					})
+					verifyApiErrorString(reply, http.StatusBadRequest, "vSphere settings default_datastore must be set with the vcenter")
+				})
+
+				It("Set vSphere settings with the baremetal platform - failure", func() {
+					reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.V2ClusterUpdateParams{
+							Platform: &models.Platform{
+								Type:    common.PlatformTypePtr(models.PlatformTypeBaremetal),
+								Vsphere: &models.PlatformVsphere{Vcenter: "vcenter.example.com"},
+							},
+						},
+					})
+					verifyApiErrorString(reply, http.StatusBadRequest, "vSphere settings can only be set with vsphere platform type")
+				})
+
 				It("Update UMN=true and vphsere platform while cluster platform already set to none - success", func() {
 					mockClusterUpdateSuccess(2, 0)
 					mockProviderRegistry.EXPECT().SetPlatformUsages(commontesting.EqPlatformType(models.PlatformTypeNone), gomock.Any(), mockUsage)
@@ -9239,6 +9449,42 @@ var _ = Describe("infraEnvs", func() {
 				Expect(len(mirrorRegistryConf.Insecure)).To(Equal(0))
 				Expect(len(mirrorRegistryConf.ImageTagMirrors)).To(Equal(0))
 			})
+			It("Saves the mirror registry given through the REST API", func() {
+				mockInfraEnvRegisterSuccess()
+				mockEvents.EXPECT().SendInfraEnvEvent(ctx, eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.InfraEnvRegisteredEventName))).Times(1)
+
+				reply := bm.RegisterInfraEnv(ctx, installer.RegisterInfraEnvParams{
+					InfraenvCreateParams: &models.InfraEnvCreateParams{
+						Name:             swag.String("some-infra-env-name"),
+						OpenshiftVersion: "4.8.0-fc.0",
+						PullSecret:       swag.String(fakePullSecret),
+						MirrorRegistryConfiguration: &models.MirrorRegistryConfigurationParams{
+							RegistriesConf: getSecureRegistryToml(sourceRegistry, mirrorRegistry),
+						},
+					},
+				})
+				Expect(reply).Should(BeAssignableToTypeOf(installer.NewRegisterInfraEnvCreated()))
+				actual := reply.(*installer.RegisterInfraEnvCreated).Payload
+
+				var infraEnv common.InfraEnv
+				Expect(db.First(&infraEnv, "id = ?", actual.ID).Error).ShouldNot(HaveOccurred())
+				mirrorRegistryConf, err := infraEnv.GetMirrorRegistryConfiguration()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(mirrorRegistryConf.RegistriesConf).To(Equal(getSecureRegistryToml(sourceRegistry, mirrorRegistry)))
+			})
+			It("Rejects a CA bundle without registries.conf", func() {
+				reply := bm.RegisterInfraEnv(ctx, installer.RegisterInfraEnvParams{
+					InfraenvCreateParams: &models.InfraEnvCreateParams{
+						Name:       swag.String("some-infra-env-name"),
+						PullSecret: swag.String(fakePullSecret),
+						MirrorRegistryConfiguration: &models.MirrorRegistryConfigurationParams{
+							CaBundleCrt: mirrorRegistryCertificate,
+						},
+					},
+				})
+				verifyApiErrorString(reply, http.StatusBadRequest, "requires registries_conf")
+			})
 
 		})
 
@@ -11724,7 +11970,7 @@ var _ = Describe("V2UploadClusterIngressCert test", func() {
 		mockOperators := operators.NewMockAPI(ctrl)
 		mockNoChangeInOperatorDependencies(mockOperators)
 		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-			db, commontesting.GetDummyNotificationStream(ctrl), nil, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil)
+			db, commontesting.GetDummyNotificationStream(ctrl), nil, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 		c = common.Cluster{Cluster: models.Cluster{
 			ID:               &clusterID,
 			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
@@ -13628,7 +13874,7 @@ var _ = Describe("GetSupportedPlatformsFromInventory", func() {
 		db, dbName = common.PrepareTestDB()
 		bm = createInventory(db, cfg)
 		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-			db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+			db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 		mockUsageReports()
 		mockClusterRegisterSuccess(true)
 		mockAMSSubscription(ctx)
@@ -13891,7 +14137,7 @@ var _ = Describe("Register AddHostsCluster test", func() {
 				OpenshiftClusterID: &openshiftClusterID,
 			},
 		}
-		mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any()).Return(nil).Times(1)
+		mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockMetric.EXPECT().ClusterRegistered().Times(1)
 		res := bm.V2ImportCluster(ctx, params)
 		actual := res.(*installer.V2ImportClusterCreated)
@@ -13918,7 +14164,7 @@ var _ = Describe("Register AddHostsCluster test", func() {
 				OpenshiftClusterID: &openshiftClusterID,
 			},
 		}
-		mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any()).Return(nil).Times(1)
+		mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockMetric.EXPECT().ClusterRegistered().Times(1)
 		res := bm.V2ImportCluster(ctx, params)
 		actual := res.(*installer.V2ImportClusterCreated)
@@ -14347,7 +14593,7 @@ var _ = Describe("RegisterCluster with image service disabled", func() {
 		Expect(cfg.DiskEncryptionSupport).Should(BeTrue())
 		bm = createInventoryWithImageService(db, cfg, false)
 		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-			db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperatorManager, nil, nil, nil, nil, nil, false, nil)
+			db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperatorManager, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 		mockUsageReports()
 	})
 
@@ -14403,7 +14649,7 @@ var _ = Describe("RegisterCluster", func() {
 		Expect(cfg.DiskEncryptionSupport).Should(BeTrue())
 		bm = createInventory(db, cfg)
 		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-			db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperatorManager, nil, nil, nil, nil, nil, false, nil)
+			db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperatorManager, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 		mockUsageReports()
 	})
 
@@ -14610,6 +14856,66 @@ var _ = Describe("RegisterCluster", func() {
 			Expect(len(mirrorRegistryConf.Insecure)).To(Equal(0))
 			Expect(len(mirrorRegistryConf.ImageTagMirrors)).To(Equal(0))
 		})
+		It("Saves the mirror registry given through the REST API", func() {
+			mockClusterRegisterSuccess(true)
+			mockAMSSubscription(ctx)
+			params := getClusterCreateParams()
+			params.MirrorRegistryConfiguration = &models.MirrorRegistryConfigurationParams{
+				RegistriesConf: getSecureRegistryToml(sourceRegistry, mirrorRegistry),
+			}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{NewClusterParams: params})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2RegisterClusterCreated()))
+			actual := reply.(*installer.V2RegisterClusterCreated).Payload
+
+			var clusterObj common.Cluster
+			Expect(db.First(&clusterObj, "id = ?", actual.ID).Error).ShouldNot(HaveOccurred())
+			mirrorRegistryConf, err := clusterObj.GetMirrorRegistryConfiguration()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(mirrorRegistryConf.RegistriesConf).To(Equal(getSecureRegistryToml(sourceRegistry, mirrorRegistry)))
+			Expect(mirrorRegistryConf.ImageDigestMirrors).To(HaveLen(1))
+			Expect(mirrorRegistryConf.ImageDigestMirrors[0].Source).To(Equal(sourceRegistry))
+		})
+		It("Rejects an invalid registries.conf given through the REST API", func() {
+			params := getClusterCreateParams()
+			params.MirrorRegistryConfiguration = &models.MirrorRegistryConfigurationParams{RegistriesConf: `?;,!`}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{NewClusterParams: params})
+			verifyApiErrorString(reply, http.StatusBadRequest, "invalid mirror registries configuration")
+		})
+		It("Rejects an invalid CA bundle given through the REST API", func() {
+			params := getClusterCreateParams()
+			params.MirrorRegistryConfiguration = &models.MirrorRegistryConfigurationParams{
+				RegistriesConf: getSecureRegistryToml(sourceRegistry, mirrorRegistry),
+				CaBundleCrt:    mirrorRegistryCertificate,
+			}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{NewClusterParams: params})
+			verifyApiErrorString(reply, http.StatusBadRequest, "invalid CA bundle of the mirror registries")
+		})
+		It("Keeps the mirror registry of the cluster when an update doesn't set it", func() {
+			conf, _ := getMirrorRegistryConfigurations(getSecureRegistryToml(sourceRegistry, mirrorRegistry), "")
+			confStr, err := common.ConvertMirrorRegistryConfigToString(conf)
+			Expect(err).ShouldNot(HaveOccurred())
+			clusterID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Cluster{
+				Cluster:                     models.Cluster{ID: &clusterID},
+				MirrorRegistryConfiguration: confStr,
+			}).Error).ShouldNot(HaveOccurred())
+
+			mirrorRegistryConf, err := bm.getClusterMirrorRegistryConfigurationForUpdate(installer.V2UpdateClusterParams{
+				ClusterID:           clusterID,
+				ClusterUpdateParams: &models.V2ClusterUpdateParams{},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(mirrorRegistryConf).To(Equal(conf))
+
+			mirrorRegistryConf, err = bm.getClusterMirrorRegistryConfigurationForUpdate(installer.V2UpdateClusterParams{
+				ClusterID: clusterID,
+				ClusterUpdateParams: &models.V2ClusterUpdateParams{
+					MirrorRegistryConfiguration: &models.MirrorRegistryConfigurationParams{},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(mirrorRegistryConf).To(BeNil())
+		})
 		Context("Pull secret validation", func() {
 			It("Successfully validates the pull secret if it does not contain auth for a mirrored registry", func() {
 				mockClusterRegisterSuccess(true)
@@ -15740,7 +16046,7 @@ var _ = Describe("RegisterCluster", func() {
 			var c *models.Cluster
 			diskEncryptionBm := createInventory(db, cfg)
 			diskEncryptionBm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperatorManager, nil, nil, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperatorManager, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 
 			By("Register cluster", func() {
 
@@ -15844,7 +16150,7 @@ var _ = Describe("RegisterCluster", func() {
 			cfg.DiskEncryptionSupport = false
 			bm = createInventory(db, cfg)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 			mockUsageReports()
 		})
 
@@ -16073,7 +16379,7 @@ var _ = Describe("RegisterCluster", func() {
 			cfg.DiskEncryptionSupport = false
 			bm = createInventory(db, cfg)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 			mockUsageReports()
 		})
 
@@ -16266,7 +16572,7 @@ var _ = Describe("RegisterCluster", func() {
 
 	It("cluster api failed to register", func() {
 		bm.clusterApi = mockClusterApi
-		mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any()).Return(errors.Errorf("error")).Times(1)
+		mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any(), gomock.Any()).Return(errors.Errorf("error")).Times(1)
 		mockClusterRegisterSteps()
 
 		reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
@@ -16530,7 +16836,7 @@ var _ = Describe("RegisterCluster", func() {
 			db, dbName = common.PrepareTestDB()
 			bm = createInventory(db, Config{})
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 			cfg := auth.GetConfigRHSSO()
 			cfg.EnableOrgBasedFeatureGates = true
 			mockOcmAuthz = ocm.NewMockOCMAuthorization(ctrl)
@@ -16586,7 +16892,7 @@ var _ = Describe("RegisterCluster", func() {
 			db, dbName = common.PrepareTestDB()
 			bm = createInventory(db, Config{})
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 		})
 
 		Context("with EnableOrgBasedFeatureGates true", func() {
@@ -17020,7 +17326,7 @@ var _ = Describe("RegisterCluster", func() {
 			db, dbName = common.PrepareTestDB()
 			bm = createInventory(db, cfg)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 		})
 
 		AfterEach(func() {
@@ -17368,7 +17674,7 @@ var _ = Describe("AMS subscriptions", func() {
 		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
 		db, dbName = common.PrepareTestDB()
 		bm = createInventory(db, cfg)
-		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 		mockUsageReports()
 	})
 
@@ -17396,7 +17702,7 @@ var _ = Describe("AMS subscriptions", func() {
 
 		It("register cluster - deregister if we failed to create AMS subscription", func() {
 			bm.clusterApi = mockClusterApi
-			mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any()).Return(nil)
+			mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any(), gomock.Any()).Return(nil)
 			mockClusterRegisterSteps()
 			mockAccountsMgmt.EXPECT().CreateSubscription(ctx, gomock.Any(), clusterName).Return(nil, errors.New("dummy"))
 			mockClusterApi.EXPECT().DeregisterCluster(ctx, gomock.Any())
@@ -17411,7 +17717,7 @@ var _ = Describe("AMS subscriptions", func() {
 
 		It("register cluster - delete AMS subscription if we failed to patch DB with ams_subscription_id", func() {
 			bm.clusterApi = mockClusterApi
-			mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any()).Return(nil)
+			mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any(), gomock.Any()).Return(nil)
 			mockClusterRegisterSteps()
 			mockAMSSubscription(ctx)
 			mockClusterApi.EXPECT().UpdateAmsSubscriptionID(ctx, gomock.Any(), strfmt.UUID("")).Return(common.NewApiError(http.StatusInternalServerError, errors.New("dummy")))
@@ -17429,7 +17735,7 @@ var _ = Describe("AMS subscriptions", func() {
 		It("deregister cluster that don't have 'Reserved' subscriptions", func() {
 			mockS3Client = s3wrapper.NewMockAPI(ctrl)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, mockS3Client, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, mockS3Client, nil, nil, nil, false, nil, nil, nil, nil)
 			mockClusterRegisterSuccess(true)
 			mockAMSSubscription(ctx)
 
@@ -17452,7 +17758,7 @@ var _ = Describe("AMS subscriptions", func() {
 		It("update cluster name happy flow", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
 			mockNoChangeInOperatorDependencies(mockOperators)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(true)
 			mockAMSSubscription(ctx)
@@ -17486,7 +17792,7 @@ var _ = Describe("AMS subscriptions", func() {
 		It("update cluster name with same name", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
 			mockNoChangeInOperatorDependencies(mockOperators)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(true)
 			mockAMSSubscription(ctx)
@@ -17517,7 +17823,7 @@ var _ = Describe("AMS subscriptions", func() {
 		It("update cluster without name field", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
 			mockNoChangeInOperatorDependencies(mockOperators)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(true)
 			mockAMSSubscription(ctx)
@@ -17627,7 +17933,7 @@ var _ = Describe("AMS subscriptions", func() {
 		It("register and deregister cluster happy flow - nil OCM client", func() {
 			mockS3Client = s3wrapper.NewMockAPI(ctrl)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, mockS3Client, nil, nil, nil, false, nil)
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, mockS3Client, nil, nil, nil, false, nil, nil, nil, nil)
 			bm.ocmClient = nil
 			mockClusterRegisterSuccess(true)
 
@@ -18966,7 +19272,7 @@ var _ = Describe("UnbindHost", func() {
 
 })
 
-var _ = Describe("V2UpdateHostInstallerArgs", func() {
+var _ = Describe("V2GenerateHostStaticNetworkConfig", func() {
 	var (
 		bm         *bareMetalInventory
 		cfg        Config
@@ -18976,6 +19282,7 @@ var _ = Describe("V2UpdateHostInstallerArgs", func() {
 		infraEnvID strfmt.UUID
 		hostID     strfmt.UUID
 		dbName     string
+		inventory  *models.Inventory
 	)
 
 	BeforeEach(func() {
@@ -18986,82 +19293,777 @@ var _ = Describe("V2UpdateHostInstallerArgs", func() {
 		err := db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error
 		Expect(err).ShouldNot(HaveOccurred())
 
-		// add a host
+		inventory = &models.Inventory{
+			Interfaces: []*models.Interface{
+				{Name: "ens3", Type: "physical", MacAddress: "52:54:00:aa:bb:01", IPV4Addresses: []string{"192.0.2.10/24"}},
+				{Name: "ens4", Type: "physical", MacAddress: "52:54:00:aa:bb:02"},
+			},
+			Routes: []*models.Route{{Family: 2, Interface: "ens3", Destination: "0.0.0.0", Gateway: "192.0.2.1"}},
+		}
+		inventoryStr, err := json.Marshal(inventory)
+		Expect(err).ShouldNot(HaveOccurred())
 		hostID = strfmt.UUID(uuid.New().String())
-		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID, "{}", db)
+		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID, string(inventoryStr), db)
 	})
 
 	AfterEach(func() {
 		common.DeleteTestDB(db, dbName)
 	})
 
-	It("saves the given array to the host", func() {
-		args := []string{"--append-karg", "nameserver=8.8.8.8", "-n"}
-		params := installer.V2UpdateHostInstallerArgsParams{
-			InfraEnvID:          infraEnvID,
-			HostID:              hostID,
-			InstallerArgsParams: &models.InstallerArgsParams{Args: args},
-		}
-		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
-			eventstest.WithNameMatcher(eventgen.HostInstallerArgsAppliedEventName),
-			eventstest.WithHostIdMatcher(params.HostID.String()),
-			eventstest.WithInfraEnvIdMatcher(params.InfraEnvID.String())))
-		response := bm.V2UpdateHostInstallerArgs(ctx, params)
-		Expect(response).To(BeAssignableToTypeOf(&installer.V2UpdateHostInstallerArgsCreated{}))
-
-		var updated models.Host
-		err := db.First(&updated, "id = ?", hostID).Error
-		Expect(err).ShouldNot(HaveOccurred())
+	It("generates the static network configuration of the host from its inventory", func() {
+		mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParamsYAML(gomock.Any()).Return(nil).Times(1)
+		response := bm.V2GenerateHostStaticNetworkConfig(ctx, installer.V2GenerateHostStaticNetworkConfigParams{
+			InfraEnvID: infraEnvID,
+			HostID:     hostID,
+			GenerateStaticNetworkConfigParams: &models.HostStaticNetworkConfigGenerateParams{
+				Bonds: []*models.StaticNetworkConfigBond{{Name: swag.String("bond0"), Ports: []string{"ens3", "ens4"}}},
+			},
+		})
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2GenerateHostStaticNetworkConfigOK{}))
+		hostConfig := response.(*installer.V2GenerateHostStaticNetworkConfigOK).Payload
+		Expect(hostConfig.MacInterfaceMap).To(HaveLen(2))
+		Expect(hostConfig.NetworkYaml).To(ContainSubstring("name: bond0"))
+		Expect(hostConfig.NetworkYaml).To(ContainSubstring("ip: 192.0.2.10"))
+	})
 
-		var newArgs []string
-		err = json.Unmarshal([]byte(updated.InstallerArgs), &newArgs)
-		Expect(err).ShouldNot(HaveOccurred())
+	It("refuses invalid generated configurations", func() {
+		mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParamsYAML(gomock.Any()).Return(errors.New("invalid yaml")).Times(1)
+		response := bm.V2GenerateHostStaticNetworkConfig(ctx, installer.V2GenerateHostStaticNetworkConfigParams{
+			InfraEnvID:                        infraEnvID,
+			HostID:                            hostID,
+			GenerateStaticNetworkConfigParams: &models.HostStaticNetworkConfigGenerateParams{},
+		})
+		verifyApiErrorString(response, http.StatusBadRequest, "invalid yaml")
+	})
 
-		Expect(newArgs).To(Equal(args))
+	It("refuses bonds of unknown interfaces", func() {
+		response := bm.V2GenerateHostStaticNetworkConfig(ctx, installer.V2GenerateHostStaticNetworkConfigParams{
+			InfraEnvID: infraEnvID,
+			HostID:     hostID,
+			GenerateStaticNetworkConfigParams: &models.HostStaticNetworkConfigGenerateParams{
+				Bonds: []*models.StaticNetworkConfigBond{{Name: swag.String("bond0"), Ports: []string{"eth9"}}},
+			},
+		})
+		verifyApiErrorString(response, http.StatusBadRequest, "isn't a physical interface of the host")
 	})
 
-	It("returns not found with a non-existant infra-env", func() {
-		args := []string{"--append-karg", "nameserver=8.8.8.8", "-n"}
-		params := installer.V2UpdateHostInstallerArgsParams{
-			InfraEnvID:          strfmt.UUID(uuid.New().String()),
-			HostID:              hostID,
-			InstallerArgsParams: &models.InstallerArgsParams{Args: args},
-		}
-		response := bm.V2UpdateHostInstallerArgs(ctx, params)
-		verifyApiError(response, http.StatusNotFound)
+	It("fails for hosts without inventory", func() {
+		Expect(db.Model(&models.Host{}).Where("id = ?", hostID.String()).Update("inventory", "").Error).ShouldNot(HaveOccurred())
+		response := bm.V2GenerateHostStaticNetworkConfig(ctx, installer.V2GenerateHostStaticNetworkConfigParams{
+			InfraEnvID:                        infraEnvID,
+			HostID:                            hostID,
+			GenerateStaticNetworkConfigParams: &models.HostStaticNetworkConfigGenerateParams{},
+		})
+		verifyApiErrorString(response, http.StatusConflict, "has not reported its inventory yet")
 	})
 
-	It("returns not found with a non-existant host", func() {
-		args := []string{"--append-karg", "nameserver=8.8.8.8", "-n"}
-		params := installer.V2UpdateHostInstallerArgsParams{
-			InfraEnvID:          infraEnvID,
-			HostID:              strfmt.UUID(uuid.New().String()),
-			InstallerArgsParams: &models.InstallerArgsParams{Args: args},
-		}
-		response := bm.V2UpdateHostInstallerArgs(ctx, params)
-		verifyApiError(response, http.StatusNotFound)
+	It("returns not found for unknown hosts", func() {
+		response := bm.V2GenerateHostStaticNetworkConfig(ctx, installer.V2GenerateHostStaticNetworkConfigParams{
+			InfraEnvID:                        infraEnvID,
+			HostID:                            strfmt.UUID(uuid.New().String()),
+			GenerateStaticNetworkConfigParams: &models.HostStaticNetworkConfigGenerateParams{},
+		})
+		verifyApiErrorString(response, http.StatusNotFound, "not found")
 	})
 
-	It("returns bad request when provided an invalid flag", func() {
-		args := []string{"--append-karg", "nameserver=8.8.8.8", "-a"}
-		params := installer.V2UpdateHostInstallerArgsParams{
-			InfraEnvID:          infraEnvID,
-			HostID:              hostID,
-			InstallerArgsParams: &models.InstallerArgsParams{Args: args},
+	It("replaces the configuration of the host in the configuration of the infra env", func() {
+		otherHostConfig := &models.HostStaticNetworkConfig{
+			MacInterfaceMap: models.MacInterfaceMap{{LogicalNicName: "eth0", MacAddress: "52:54:00:cc:dd:01"}},
+			NetworkYaml:     "other host",
 		}
-		response := bm.V2UpdateHostInstallerArgs(ctx, params)
-		verifyApiError(response, http.StatusBadRequest)
+		previousHostConfig := &models.HostStaticNetworkConfig{
+			MacInterfaceMap: models.MacInterfaceMap{{LogicalNicName: "eth0", MacAddress: "52:54:00:AA:BB:02"}},
+			NetworkYaml:     "previous",
+		}
+		staticNetworkConfigStr, err := json.Marshal([]*models.HostStaticNetworkConfig{previousHostConfig, otherHostConfig})
+		Expect(err).ShouldNot(HaveOccurred())
+		hostConfig := &models.HostStaticNetworkConfig{NetworkYaml: "generated"}
+
+		staticNetworkConfig, err := replaceHostStaticNetworkConfig(string(staticNetworkConfigStr), inventory, hostConfig)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(staticNetworkConfig).To(Equal([]*models.HostStaticNetworkConfig{hostConfig, otherHostConfig}))
 	})
 })
 
-var _ = Describe("V2UpdateHostInstallerArgs - with rhsso auth", func() {
+var _ = Describe("V2ValidateStaticNetworkConfig", func() {
 	var (
-		authCtx      context.Context
-		bm           *bareMetalInventory
-		db           *gorm.DB
-		ctx          = context.Background()
-		clusterID    strfmt.UUID
-		hostID       strfmt.UUID
+		bm     *bareMetalInventory
+		cfg    Config
+		db     *gorm.DB
+		ctx    = context.Background()
+		dbName string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("returns the validation of each host", func() {
+		hostConfig := &models.HostStaticNetworkConfig{
+			MacInterfaceMap: models.MacInterfaceMap{{LogicalNicName: "eth0", MacAddress: "52:54:00:aa:bb:01"}},
+			NetworkYaml:     "interfaces: []",
+		}
+		validation := &models.StaticNetworkConfigValidation{
+			Valid: swag.Bool(false),
+			Hosts: []*models.StaticNetworkConfigHostValidation{{
+				HostIndex: swag.Int64(0),
+				Valid:     swag.Bool(false),
+				Errors: []*models.StaticNetworkConfigValidationError{{
+					Code:    swag.String(models.StaticNetworkConfigValidationErrorCodeNmstateError),
+					Message: swag.String("invalid"),
+				}},
+			}},
+		}
+		mockStaticNetworkConfig.EXPECT().ValidateStaticNetworkConfigPerHost([]*models.HostStaticNetworkConfig{hostConfig},
+			[]string{"192.0.2.0/24"}).Return(validation).Times(1)
+		response := bm.V2ValidateStaticNetworkConfig(ctx, installer.V2ValidateStaticNetworkConfigParams{
+			StaticNetworkConfigValidationParams: &models.StaticNetworkConfigValidationParams{
+				StaticNetworkConfig: []*models.HostStaticNetworkConfig{hostConfig},
+				ExpectedSubnets:     []models.Subnet{"192.0.2.0/24"},
+			},
+		})
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2ValidateStaticNetworkConfigOK{}))
+		Expect(response.(*installer.V2ValidateStaticNetworkConfigOK).Payload).To(Equal(validation))
+	})
+
+	It("refuses an empty configuration", func() {
+		response := bm.V2ValidateStaticNetworkConfig(ctx, installer.V2ValidateStaticNetworkConfigParams{
+			StaticNetworkConfigValidationParams: &models.StaticNetworkConfigValidationParams{},
+		})
+		verifyApiErrorString(response, http.StatusBadRequest, "at least one host static network configuration must be provided")
+	})
+
+	It("refuses invalid expected subnets", func() {
+		response := bm.V2ValidateStaticNetworkConfig(ctx, installer.V2ValidateStaticNetworkConfigParams{
+			StaticNetworkConfigValidationParams: &models.StaticNetworkConfigValidationParams{
+				StaticNetworkConfig: []*models.HostStaticNetworkConfig{{NetworkYaml: "interfaces: []"}},
+				ExpectedSubnets:     []models.Subnet{"192.0.2.0"},
+			},
+		})
+		verifyApiErrorString(response, http.StatusBadRequest, "invalid expected subnet 192.0.2.0")
+	})
+})
+
+var _ = Describe("V2RemoveHostFromCluster", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		dbName     string
+		hostID     strfmt.UUID
+		infraEnvID strfmt.UUID
+		clusterID  strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		hostID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		clusterID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	addKubeHost := func(status string) {
+		host := &common.Host{
+			Host: models.Host{
+				ID:         &hostID,
+				InfraEnvID: infraEnvID,
+				ClusterID:  &clusterID,
+				Status:     swag.String(status),
+				Kind:       swag.String(models.HostKindHost),
+			},
+			KubeKeyNamespace: "test-namespace",
+		}
+		Expect(db.Create(host).Error).ShouldNot(HaveOccurred())
+	}
+
+	It("requests the removal of the node with the drain timeout", func() {
+		addKubeHost(models.HostStatusInstalled)
+		timeout := 5 * time.Minute
+		mockCRDUtils.EXPECT().RequestAgentNodeRemoval(ctx, gomock.Any(), gomock.Any(), &timeout).DoAndReturn(
+			func(_ context.Context, _ logrus.FieldLogger, host *common.Host, _ *time.Duration) error {
+				Expect(*host.ID).To(Equal(hostID))
+				Expect(host.KubeKeyNamespace).To(Equal("test-namespace"))
+				return nil
+			}).Times(1)
+		response := bm.V2RemoveHostFromCluster(ctx, installer.V2RemoveHostFromClusterParams{
+			InfraEnvID:            infraEnvID,
+			HostID:                hostID,
+			HostNodeRemovalParams: &models.HostNodeRemovalParams{DrainTimeout: swag.Int64(300)},
+		})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2RemoveHostFromClusterAccepted()))
+		Expect(*response.(*installer.V2RemoveHostFromClusterAccepted).Payload.ID).To(Equal(hostID))
+	})
+
+	It("uses the default drain timeout without parameters", func() {
+		addKubeHost(models.HostStatusAddedToExistingCluster)
+		mockCRDUtils.EXPECT().RequestAgentNodeRemoval(ctx, gomock.Any(), gomock.Any(), nil).Return(nil).Times(1)
+		response := bm.V2RemoveHostFromCluster(ctx, installer.V2RemoveHostFromClusterParams{
+			InfraEnvID: infraEnvID,
+			HostID:     hostID,
+		})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2RemoveHostFromClusterAccepted()))
+	})
+
+	It("fails for a host that isn't installed", func() {
+		addKubeHost(models.HostStatusKnown)
+		response := bm.V2RemoveHostFromCluster(ctx, installer.V2RemoveHostFromClusterParams{
+			InfraEnvID: infraEnvID,
+			HostID:     hostID,
+		})
+		verifyApiError(response, http.StatusConflict)
+	})
+
+	It("fails for a host that isn't managed by the kube-api", func() {
+		addHost(hostID, models.HostRoleWorker, models.HostStatusInstalled, models.HostKindHost, infraEnvID, clusterID, "", db)
+		response := bm.V2RemoveHostFromCluster(ctx, installer.V2RemoveHostFromClusterParams{
+			InfraEnvID: infraEnvID,
+			HostID:     hostID,
+		})
+		verifyApiError(response, http.StatusBadRequest)
+	})
+
+	It("fails for a missing host", func() {
+		response := bm.V2RemoveHostFromCluster(ctx, installer.V2RemoveHostFromClusterParams{
+			InfraEnvID: infraEnvID,
+			HostID:     hostID,
+		})
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("fails when the agent can't be updated", func() {
+		addKubeHost(models.HostStatusInstalled)
+		mockCRDUtils.EXPECT().RequestAgentNodeRemoval(ctx, gomock.Any(), gomock.Any(), nil).Return(errors.New("agent not found")).Times(1)
+		response := bm.V2RemoveHostFromCluster(ctx, installer.V2RemoveHostFromClusterParams{
+			InfraEnvID: infraEnvID,
+			HostID:     hostID,
+		})
+		verifyApiErrorString(response, http.StatusInternalServerError, "agent not found")
+	})
+})
+
+var _ = Describe("Host BMC", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		dbName     string
+		hostID     strfmt.UUID
+		infraEnvID strfmt.UUID
+		clusterID  strfmt.UUID
+		address    = "redfish-virtualmedia+https://192.168.111.1:8000/redfish/v1/Systems/vm-1"
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		hostID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		clusterID = strfmt.UUID(uuid.New().String())
+		addHost(hostID, models.HostRoleWorker, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID, "", db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	setCredentials := func() {
+		Expect(db.Model(&common.Host{}).Where("id = ?", hostID).Updates(map[string]interface{}{
+			"bmc_address":         address,
+			"bmc_credentials_set": true,
+			"bmc_username":        "admin",
+			"bmc_password":        "password",
+		}).Error).ShouldNot(HaveOccurred())
+	}
+
+	getHost := func() *common.Host {
+		h, err := common.GetHostFromDB(db, infraEnvID.String(), hostID.String())
+		Expect(err).ShouldNot(HaveOccurred())
+		return h
+	}
+
+	expectedCredentials := &bmc.Credentials{Address: address, Username: "admin", Password: "password"}
+
+	Context("V2UpdateHostBmcCredentials", func() {
+		var keyDir string
+
+		BeforeEach(func() {
+			key := make([]byte, 32)
+			_, err := rand.Read(key)
+			Expect(err).NotTo(HaveOccurred())
+			keyDir, err = os.MkdirTemp("", "encryption-keys")
+			Expect(err).NotTo(HaveOccurred())
+			keyFile := filepath.Join(keyDir, "keys")
+			Expect(os.WriteFile(keyFile, []byte("key-1 "+base64.StdEncoding.EncodeToString(key)), 0600)).To(Succeed())
+			encryptor, err := encryption.NewEncryptor(common.GetTestLog(), encryption.Config{
+				KeyProvider:  encryption.KeyProviderLocal,
+				LocalKeyFile: keyFile,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			encryption.SetDefaultEncryptor(encryptor)
+		})
+
+		AfterEach(func() {
+			encryption.SetDefaultEncryptor(nil)
+			Expect(os.RemoveAll(keyDir)).To(Succeed())
+		})
+
+		updateParams := func(bmcAddress string) installer.V2UpdateHostBmcCredentialsParams {
+			password := strfmt.Password("password")
+			return installer.V2UpdateHostBmcCredentialsParams{
+				InfraEnvID: infraEnvID,
+				HostID:     hostID,
+				HostBmcCredentials: &models.HostBmcCredentials{
+					Address:                        swag.String(bmcAddress),
+					Username:                       swag.String("admin"),
+					Password:                       &password,
+					DisableCertificateVerification: swag.Bool(true),
+				},
+			}
+		}
+
+		It("stores the credentials", func() {
+			mockBMC.EXPECT().ValidateAddress(address).Return(nil).Times(1)
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostBmcCredentialsUpdatedEventName),
+				eventstest.WithHostIdMatcher(hostID.String()))).Times(1)
+			response := bm.V2UpdateHostBmcCredentials(ctx, updateParams(address))
+			Expect(response).To(BeAssignableToTypeOf(installer.NewV2UpdateHostBmcCredentialsOK()))
+			payload := response.(*installer.V2UpdateHostBmcCredentialsOK).Payload
+			Expect(payload.BmcAddress).To(Equal(address))
+			Expect(payload.BmcCredentialsSet).To(BeTrue())
+
+			h := getHost()
+			Expect(h.BMCUsername).To(Equal("admin"))
+			Expect(h.BMCPassword).To(Equal("password"))
+			Expect(h.BMCDisableCertificateVerification).To(BeTrue())
+			var storedPassword string
+			Expect(db.Raw("SELECT bmc_password FROM hosts WHERE id = ?", hostID).Scan(&storedPassword).Error).ShouldNot(HaveOccurred())
+			Expect(encryption.IsEncrypted(storedPassword)).To(BeTrue())
+		})
+
+		It("doesn't store the credentials without an encryption key provider", func() {
+			encryption.SetDefaultEncryptor(nil)
+			verifyApiErrorString(bm.V2UpdateHostBmcCredentials(ctx, updateParams(address)), http.StatusServiceUnavailable,
+				"no encryption key provider is configured")
+			Expect(getHost().BmcCredentialsSet).To(BeFalse())
+		})
+
+		It("fails for an invalid address", func() {
+			mockBMC.EXPECT().ValidateAddress(gomock.Any()).DoAndReturn(bmc.ValidateAddress).Times(1)
+			verifyApiError(bm.V2UpdateHostBmcCredentials(ctx, updateParams("ftp://192.168.111.1")), http.StatusBadRequest)
+		})
+
+		It("fails for an IPMI address", func() {
+			mockBMC.EXPECT().ValidateAddress(gomock.Any()).DoAndReturn(bmc.ValidateAddress).Times(1)
+			verifyApiErrorString(bm.V2UpdateHostBmcCredentials(ctx, updateParams("ipmi://192.168.111.1")), http.StatusBadRequest,
+				"use the Redfish address of the BMC")
+			Expect(getHost().BmcCredentialsSet).To(BeFalse())
+		})
+
+		It("fails for an address that isn't allowed", func() {
+			mockBMC.EXPECT().ValidateAddress("redfish://169.254.169.254").Return(bmc.ErrAddressNotAllowed).Times(1)
+			verifyApiError(bm.V2UpdateHostBmcCredentials(ctx, updateParams("redfish://169.254.169.254")), http.StatusBadRequest)
+			Expect(getHost().BmcCredentialsSet).To(BeFalse())
+		})
+
+		It("fails for a missing host", func() {
+			params := updateParams(address)
+			params.HostID = strfmt.UUID(uuid.New().String())
+			verifyApiError(bm.V2UpdateHostBmcCredentials(ctx, params), http.StatusNotFound)
+		})
+	})
+
+	Context("V2DeleteHostBmcCredentials", func() {
+		It("removes the credentials", func() {
+			setCredentials()
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostBmcCredentialsRemovedEventName),
+				eventstest.WithHostIdMatcher(hostID.String()))).Times(1)
+			response := bm.V2DeleteHostBmcCredentials(ctx, installer.V2DeleteHostBmcCredentialsParams{InfraEnvID: infraEnvID, HostID: hostID})
+			Expect(response).To(BeAssignableToTypeOf(installer.NewV2DeleteHostBmcCredentialsOK()))
+
+			h := getHost()
+			Expect(h.BmcCredentialsSet).To(BeFalse())
+			Expect(h.BmcAddress).To(BeEmpty())
+			Expect(h.BMCPassword).To(BeEmpty())
+		})
+
+		It("succeeds for a host without credentials", func() {
+			response := bm.V2DeleteHostBmcCredentials(ctx, installer.V2DeleteHostBmcCredentialsParams{InfraEnvID: infraEnvID, HostID: hostID})
+			Expect(response).To(BeAssignableToTypeOf(installer.NewV2DeleteHostBmcCredentialsOK()))
+		})
+	})
+
+	Context("V2HostPowerAction", func() {
+		powerAction := func(action string) middleware.Responder {
+			return bm.V2HostPowerAction(ctx, installer.V2HostPowerActionParams{
+				InfraEnvID:            infraEnvID,
+				HostID:                hostID,
+				HostPowerActionParams: &models.HostPowerActionParams{Action: swag.String(action)},
+			})
+		}
+
+		expectActionEvent := func(name string) {
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(name),
+				eventstest.WithHostIdMatcher(hostID.String()))).Times(1)
+		}
+
+		It("fails for a host without credentials", func() {
+			verifyApiError(powerAction(models.HostPowerActionParamsActionPowerOn), http.StatusConflict)
+		})
+
+		Context("with credentials", func() {
+			BeforeEach(func() {
+				setCredentials()
+			})
+
+			It("powers on the host", func() {
+				mockBMC.EXPECT().PowerOn(ctx, expectedCredentials).Return(nil).Times(1)
+				expectActionEvent(eventgen.HostPowerActionSucceededEventName)
+				Expect(powerAction(models.HostPowerActionParamsActionPowerOn)).To(BeAssignableToTypeOf(installer.NewV2HostPowerActionOK()))
+			})
+
+			It("powers off the host", func() {
+				mockBMC.EXPECT().PowerOff(ctx, expectedCredentials).Return(nil).Times(1)
+				expectActionEvent(eventgen.HostPowerActionSucceededEventName)
+				Expect(powerAction(models.HostPowerActionParamsActionPowerOff)).To(BeAssignableToTypeOf(installer.NewV2HostPowerActionOK()))
+			})
+
+			It("reboots the host", func() {
+				mockBMC.EXPECT().Reboot(ctx, expectedCredentials).Return(nil).Times(1)
+				expectActionEvent(eventgen.HostPowerActionSucceededEventName)
+				Expect(powerAction(models.HostPowerActionParamsActionReboot)).To(BeAssignableToTypeOf(installer.NewV2HostPowerActionOK()))
+			})
+
+			It("boots the host from a new URL of the discovery ISO", func() {
+				infraEnv := createInfraEnv(db, infraEnvID, clusterID)
+				Expect(db.Model(infraEnv).Update("download_url", "https://image-service.example.com/images/expired").Error).ShouldNot(HaveOccurred())
+				mockOSImages.EXPECT().GetOsImageOrLatest(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).Times(1)
+				mockBMC.EXPECT().BootFromISO(ctx, expectedCredentials, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ *bmc.Credentials, isoURL string) error {
+						Expect(isoURL).To(HavePrefix(imageServiceBaseURL))
+						Expect(isoURL).To(ContainSubstring(infraEnvID.String()))
+						Expect(isoURL).To(HaveSuffix(".iso"))
+						return nil
+					}).Times(1)
+				expectActionEvent(eventgen.HostPowerActionSucceededEventName)
+				Expect(powerAction(models.HostPowerActionParamsActionBootFromIso)).To(BeAssignableToTypeOf(installer.NewV2HostPowerActionOK()))
+			})
+
+			It("fails to boot from the ISO without the image service", func() {
+				createInfraEnv(db, infraEnvID, clusterID)
+				bm.EnableImageService = false
+				verifyApiErrorString(powerAction(models.HostPowerActionParamsActionBootFromIso), http.StatusBadRequest,
+					"image service is disabled")
+			})
+
+			It("fails for a protocol without power actions", func() {
+				mockBMC.EXPECT().PowerOn(ctx, expectedCredentials).Return(bmc.ErrUnsupportedProtocol).Times(1)
+				expectActionEvent(eventgen.HostPowerActionFailedEventName)
+				verifyApiError(powerAction(models.HostPowerActionParamsActionPowerOn), http.StatusBadRequest)
+			})
+
+			It("fails when the BMC fails", func() {
+				mockBMC.EXPECT().Reboot(ctx, expectedCredentials).Return(&bmc.Error{
+					Message: "the BMC returned HTTP status 500",
+					Err:     errors.New("BMC returned 500 for POST /redfish/v1/Systems/vm-1: internal details"),
+				}).Times(1)
+				mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.HostPowerActionFailedEventName),
+					eventstest.WithHostIdMatcher(hostID.String()),
+					eventstest.WithMessageContainsMatcher("the BMC returned HTTP status 500"))).Times(1)
+				response := powerAction(models.HostPowerActionParamsActionReboot)
+				verifyApiErrorString(response, http.StatusBadGateway, "the BMC returned HTTP status 500")
+				Expect(response.(*common.ApiErrorResponse).Error()).NotTo(ContainSubstring("internal details"))
+			})
+
+			It("doesn't include the details of unexpected errors in the event", func() {
+				mockBMC.EXPECT().PowerOff(ctx, expectedCredentials).Return(errors.New("dial tcp 10.0.0.1:443: connection refused")).Times(1)
+				mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.HostPowerActionFailedEventName),
+					eventstest.WithMessageContainsMatcher("the request to the BMC failed"))).Times(1)
+				verifyApiErrorString(powerAction(models.HostPowerActionParamsActionPowerOff), http.StatusBadGateway, "the request to the BMC failed")
+			})
+
+			It("fails when the password can't be decrypted", func() {
+				encryptedPassword := "enc:v1:" + base64.StdEncoding.EncodeToString([]byte("password"))
+				Expect(db.Exec("UPDATE hosts SET bmc_password = ? WHERE id = ?", encryptedPassword, hostID).Error).ShouldNot(HaveOccurred())
+				verifyApiError(powerAction(models.HostPowerActionParamsActionPowerOn), http.StatusServiceUnavailable)
+			})
+		})
+	})
+})
+
+var _ = Describe("V2InfraEnvVirtualMediaBoot", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		dbName     string
+		infraEnvID strfmt.UUID
+		address1   = "redfish-virtualmedia+https://192.168.111.1:8000/redfish/v1/Systems/vm-1"
+		address2   = "redfish-virtualmedia+https://192.168.111.1:8000/redfish/v1/Systems/vm-2"
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		createInfraEnv(db, infraEnvID, "")
+		mockOSImages.EXPECT().GetOsImageOrLatest(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).AnyTimes()
+		mockBMC.EXPECT().ValidateAddress(gomock.Any()).DoAndReturn(bmc.ValidateAddress).AnyTimes()
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	bootParams := func(addresses ...string) installer.V2InfraEnvVirtualMediaBootParams {
+		params := installer.V2InfraEnvVirtualMediaBootParams{
+			InfraEnvID:                     infraEnvID,
+			InfraEnvVirtualMediaBootParams: &models.InfraEnvVirtualMediaBootParams{},
+		}
+		for _, address := range addresses {
+			password := strfmt.Password("password")
+			params.InfraEnvVirtualMediaBootParams.Bmcs = append(params.InfraEnvVirtualMediaBootParams.Bmcs, &models.HostBmcCredentials{
+				Address:  swag.String(address),
+				Username: swag.String("admin"),
+				Password: &password,
+			})
+		}
+		return params
+	}
+
+	getStatus := func() *models.InfraEnvVirtualMediaBoot {
+		response := bm.V2GetInfraEnvVirtualMediaBoot(ctx, installer.V2GetInfraEnvVirtualMediaBootParams{InfraEnvID: infraEnvID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2GetInfraEnvVirtualMediaBootOK()))
+		return response.(*installer.V2GetInfraEnvVirtualMediaBootOK).Payload
+	}
+
+	bmcStatuses := func() map[string]string {
+		ret := make(map[string]string)
+		for _, bmcStatus := range getStatus().Bmcs {
+			ret[bmcStatus.Address] = bmcStatus.Status
+		}
+		return ret
+	}
+
+	expectEvent := func(name string) {
+		mockEvents.EXPECT().SendInfraEnvEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(name),
+			eventstest.WithInfraEnvIdMatcher(infraEnvID.String()))).Times(1)
+	}
+
+	It("boots the servers of the BMCs from the discovery ISO", func() {
+		expectEvent(eventgen.InfraEnvVirtualMediaBootStartedEventName)
+		expectEvent(eventgen.InfraEnvVirtualMediaBootSucceededEventName)
+		expectEvent(eventgen.InfraEnvVirtualMediaBootFailedEventName)
+		mockBMC.EXPECT().BootFromISO(gomock.Any(), &bmc.Credentials{Address: address1, Username: "admin", Password: "password"},
+			gomock.Any()).DoAndReturn(func(_ context.Context, _ *bmc.Credentials, isoURL string) error {
+			Expect(isoURL).To(HavePrefix(imageServiceBaseURL))
+			Expect(isoURL).To(ContainSubstring(infraEnvID.String()))
+			Expect(isoURL).To(HaveSuffix(".iso"))
+			return nil
+		}).Times(1)
+		mockBMC.EXPECT().BootFromISO(gomock.Any(), gomock.Any(), gomock.Any()).Return(&bmc.Error{
+			Message: "the BMC has no virtual media for CD images",
+			Err:     errors.New("GET /redfish/v1/Systems/vm-2/VirtualMedia: internal details"),
+		}).Times(1)
+
+		response := bm.V2InfraEnvVirtualMediaBoot(ctx, bootParams(address1, address2))
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2InfraEnvVirtualMediaBootAccepted()))
+		payload := response.(*installer.V2InfraEnvVirtualMediaBootAccepted).Payload
+		Expect(payload.Bmcs).To(HaveLen(2))
+		Expect(payload.Bmcs[0].Status).To(Equal(models.VirtualMediaBootBmcStatusStatusInProgress))
+
+		Eventually(bmcStatuses).Should(Equal(map[string]string{
+			address1: models.VirtualMediaBootBmcStatusStatusSucceeded,
+			address2: models.VirtualMediaBootBmcStatusStatusFailed,
+		}))
+		Expect(getStatus().Bmcs[1].StatusInfo).To(Equal("the BMC has no virtual media for CD images"))
+	})
+
+	It("fails while a boot is in progress", func() {
+		status := &models.InfraEnvVirtualMediaBoot{
+			StartedAt: strfmt.DateTime(time.Now()),
+			Bmcs:      []*models.VirtualMediaBootBmcStatus{{Address: address1, Status: models.VirtualMediaBootBmcStatusStatusInProgress}},
+		}
+		Expect(bm.updateVirtualMediaBoot(infraEnvID, status)).To(Succeed())
+		verifyApiError(bm.V2InfraEnvVirtualMediaBoot(ctx, bootParams(address1)), http.StatusConflict)
+	})
+
+	It("starts only one of concurrent boots", func() {
+		var (
+			wg        sync.WaitGroup
+			started   int32
+			conflicts int32
+		)
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				status := &models.InfraEnvVirtualMediaBoot{
+					StartedAt: strfmt.DateTime(time.Now()),
+					Bmcs:      []*models.VirtualMediaBootBmcStatus{{Address: address1, Status: models.VirtualMediaBootBmcStatusStatusInProgress}},
+				}
+				err := bm.startVirtualMediaBoot(infraEnvID, status)
+				if err == nil {
+					atomic.AddInt32(&started, 1)
+					return
+				}
+				Expect(err.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusConflict))
+				atomic.AddInt32(&conflicts, 1)
+			}()
+		}
+		wg.Wait()
+		Expect(started).To(BeEquivalentTo(1))
+		Expect(conflicts).To(BeEquivalentTo(4))
+	})
+
+	It("ignores a boot that didn't complete in time", func() {
+		status := &models.InfraEnvVirtualMediaBoot{
+			StartedAt: strfmt.DateTime(time.Now().Add(-2 * virtualMediaBootTimeout)),
+			Bmcs:      []*models.VirtualMediaBootBmcStatus{{Address: address1, Status: models.VirtualMediaBootBmcStatusStatusInProgress}},
+		}
+		Expect(bm.updateVirtualMediaBoot(infraEnvID, status)).To(Succeed())
+		expectEvent(eventgen.InfraEnvVirtualMediaBootStartedEventName)
+		expectEvent(eventgen.InfraEnvVirtualMediaBootSucceededEventName)
+		mockBMC.EXPECT().BootFromISO(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		Expect(bm.V2InfraEnvVirtualMediaBoot(ctx, bootParams(address1))).To(BeAssignableToTypeOf(installer.NewV2InfraEnvVirtualMediaBootAccepted()))
+		Eventually(bmcStatuses).Should(Equal(map[string]string{address1: models.VirtualMediaBootBmcStatusStatusSucceeded}))
+	})
+
+	It("fails for an invalid BMC address", func() {
+		verifyApiError(bm.V2InfraEnvVirtualMediaBoot(ctx, bootParams("ftp://192.168.111.1")), http.StatusBadRequest)
+	})
+
+	It("fails for a BMC set twice", func() {
+		verifyApiError(bm.V2InfraEnvVirtualMediaBoot(ctx, bootParams(address1, address1)), http.StatusBadRequest)
+	})
+
+	It("fails for a missing infra-env", func() {
+		params := bootParams(address1)
+		params.InfraEnvID = strfmt.UUID(uuid.New().String())
+		verifyApiError(bm.V2InfraEnvVirtualMediaBoot(ctx, params), http.StatusNotFound)
+	})
+
+	It("returns an empty status before the first boot", func() {
+		status := getStatus()
+		Expect(status.Bmcs).To(BeEmpty())
+	})
+})
+
+var _ = Describe("V2UpdateHostInstallerArgs", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+		hostID     strfmt.UUID
+		dbName     string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		err := db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// add a host
+		hostID = strfmt.UUID(uuid.New().String())
+		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID, "{}", db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("saves the given array to the host", func() {
+		args := []string{"--append-karg", "nameserver=8.8.8.8", "-n"}
+		params := installer.V2UpdateHostInstallerArgsParams{
+			InfraEnvID:          infraEnvID,
+			HostID:              hostID,
+			InstallerArgsParams: &models.InstallerArgsParams{Args: args},
+		}
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostInstallerArgsAppliedEventName),
+			eventstest.WithHostIdMatcher(params.HostID.String()),
+			eventstest.WithInfraEnvIdMatcher(params.InfraEnvID.String())))
+		response := bm.V2UpdateHostInstallerArgs(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2UpdateHostInstallerArgsCreated{}))
+
+		var updated models.Host
+		err := db.First(&updated, "id = ?", hostID).Error
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var newArgs []string
+		err = json.Unmarshal([]byte(updated.InstallerArgs), &newArgs)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(newArgs).To(Equal(args))
+	})
+
+	It("returns not found with a non-existant infra-env", func() {
+		args := []string{"--append-karg", "nameserver=8.8.8.8", "-n"}
+		params := installer.V2UpdateHostInstallerArgsParams{
+			InfraEnvID:          strfmt.UUID(uuid.New().String()),
+			HostID:              hostID,
+			InstallerArgsParams: &models.InstallerArgsParams{Args: args},
+		}
+		response := bm.V2UpdateHostInstallerArgs(ctx, params)
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("returns not found with a non-existant host", func() {
+		args := []string{"--append-karg", "nameserver=8.8.8.8", "-n"}
+		params := installer.V2UpdateHostInstallerArgsParams{
+			InfraEnvID:          infraEnvID,
+			HostID:              strfmt.UUID(uuid.New().String()),
+			InstallerArgsParams: &models.InstallerArgsParams{Args: args},
+		}
+		response := bm.V2UpdateHostInstallerArgs(ctx, params)
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("returns bad request when provided an invalid flag", func() {
+		args := []string{"--append-karg", "nameserver=8.8.8.8", "-a"}
+		params := installer.V2UpdateHostInstallerArgsParams{
+			InfraEnvID:          infraEnvID,
+			HostID:              hostID,
+			InstallerArgsParams: &models.InstallerArgsParams{Args: args},
+		}
+		response := bm.V2UpdateHostInstallerArgs(ctx, params)
+		verifyApiError(response, http.StatusBadRequest)
+	})
+})
+
+var _ = Describe("V2UpdateHostInstallerArgs - with rhsso auth", func() {
+	var (
+		authCtx      context.Context
+		bm           *bareMetalInventory
+		db           *gorm.DB
+		ctx          = context.Background()
+		clusterID    strfmt.UUID
+		hostID       strfmt.UUID
 		infraEnvID   strfmt.UUID
 		dbName       string
 		userName1    = "test_user_1"
@@ -19457,6 +20459,7 @@ func createInventoryWithImageService(db *gorm.DB, cfg Config, enableImageService
 	mockInstallConfigBuilder = installcfg_builder.NewMockInstallConfigBuilder(ctrl)
 	mockHwValidator = hardware.NewMockValidator(ctrl)
 	mockStaticNetworkConfig = staticnetworkconfig.NewMockStaticNetworkConfig(ctrl)
+	mockBMC = bmc.NewMockAPI(ctrl)
 	mockExecuter = executer.NewMockExecuter(ctrl)
 	mockMirrorRegistriesConfigBuilder = mirrorregistries.NewMockServiceMirrorRegistriesConfigBuilder(ctrl)
 	mockInstallerCache = installercache.NewMockInstallerCache(ctrl)
@@ -19473,10 +20476,10 @@ func createInventoryWithImageService(db *gorm.DB, cfg Config, enableImageService
 	)
 
 	bm := NewBareMetalInventory(db, mockStream, common.GetTestLog(), mockHostApi, mockClusterApi, mockInfraEnvApi, cfg,
-		mockGenerator, mockEvents, mockS3Client, mockMetric, mockUsage, mockOperatorManager,
+		mockGenerator, mockEvents, mockS3Client, mockMetric, mockUsage, quota.NewManager(common.GetTestLog(), db, quota.Config{}), mockOperatorManager,
 		getTestAuthHandler(), getTestAuthzHandler(), mockK8sClient, ocmClient, nil, mockSecretValidator, mockVersions,
 		mockOSImages, mockCRDUtils, mockIgnitionBuilder, mockHwValidator, dnsApi, mockInstallConfigBuilder,
-		mockStaticNetworkConfig, gcConfig, mockProviderRegistry, true, "", disconnectedIgnitionGenerator)
+		mockStaticNetworkConfig, gcConfig, mockProviderRegistry, true, "", disconnectedIgnitionGenerator, mockBMC)
 
 	if enableImageService {
 		bm.ImageServiceBaseURL = imageServiceBaseURL
@@ -19686,7 +20689,7 @@ var _ = Describe("Dual-stack cluster", func() {
 				cfg.DiskEncryptionSupport = false
 				bm = createInventory(db, cfg)
 				bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-					db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil)
+					db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 				mockUsageReports()
 			})
 			AfterEach(func() {
@@ -20172,7 +21175,7 @@ var _ = Describe("Platform tests", func() {
 		bm = createInventory(db, cfg)
 		mockOperators := operators.NewMockAPI(ctrl)
 		mockNoChangeInOperatorDependencies(mockOperators)
-		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil)
+		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil, false, nil, nil, nil, nil)
 		bm.ocmClient = nil
 		clusterParams := getDefaultClusterCreateParams()
 		clusterParams.Name = swag.String("cluster")
@@ -22082,3 +23085,67 @@ var _ = Describe("Primary IP Stack Functionality", func() {
 		})
 	})
 })
+
+var _ = Describe("Quotas", func() {
+	var (
+		bm      *bareMetalInventory
+		cfg     Config
+		db      *gorm.DB
+		dbName  string
+		authCtx context.Context
+	)
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		bm.quotaApi = quota.NewManager(common.GetTestLog(), db, quota.Config{MaxClusters: 1, MaxInstallsPerDay: 1})
+		payload := &ocm.AuthPayload{Role: ocm.UserRole, Organization: "org1"}
+		authCtx = context.WithValue(context.Background(), restapi.AuthKey, payload)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	createCluster := func(orgID string) strfmt.UUID {
+		clusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:     &clusterID,
+			OrgID:  orgID,
+			Status: swag.String(models.ClusterStatusReady),
+		}}).Error).ShouldNot(HaveOccurred())
+		return clusterID
+	}
+
+	It("refuses to register clusters above the quota of the organization", func() {
+		createCluster("org1")
+		mockClusterRegisterSteps()
+		reply := bm.V2RegisterCluster(authCtx, installer.V2RegisterClusterParams{
+			NewClusterParams: getDefaultClusterCreateParams(),
+		})
+		verifyApiErrorString(reply, http.StatusForbidden, "the organization reached its quota of 1 clusters that aren't installed")
+	})
+
+	It("refuses to install clusters above the daily quota of the organization", func() {
+		clusterID := createCluster("org1")
+		Expect(bm.quotaApi.Record(authCtx, db, "org1", quota.ResourceInstallsPerDay)).To(Succeed())
+		reply := bm.V2InstallCluster(authCtx, installer.V2InstallClusterParams{ClusterID: clusterID})
+		verifyApiErrorString(reply, http.StatusTooManyRequests, "the organization reached its quota of 1 cluster installations per day")
+	})
+
+	It("returns the quota usage of the organization", func() {
+		createCluster("org1")
+		createCluster("org2")
+		reply := bm.V2GetQuotaUsage(authCtx, installer.V2GetQuotaUsageParams{})
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewV2GetQuotaUsageOK()))
+		usage := reply.(*installer.V2GetQuotaUsageOK).Payload
+		Expect(*usage.OrgID).To(Equal("org1"))
+		Expect(usage.Resources).To(ContainElement(&models.ResourceQuotaUsage{
+			Resource: swag.String(models.ResourceQuotaUsageResourceClusters),
+			Used:     swag.Int64(1),
+			Limit:    swag.Int64(1),
+		}))
+	})
+})
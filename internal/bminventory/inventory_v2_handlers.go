@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -16,6 +17,7 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-version"
+	"github.com/openshift/assisted-service/internal/bmc"
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	"github.com/openshift/assisted-service/internal/constants"
@@ -30,6 +32,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/pkg/errors"
 	"github.com/thoas/go-funk"
@@ -47,7 +50,11 @@ func (b *bareMetalInventory) V2UpdateHost(ctx context.Context, params installer.
 }
 
 func (b *bareMetalInventory) V2RegisterCluster(ctx context.Context, params installer.V2RegisterClusterParams) middleware.Responder {
-	c, err := b.RegisterClusterInternal(ctx, nil, nil, params)
+	mirrorRegistryConfiguration, err := parseMirrorRegistryConfigurationParams(params.NewClusterParams.MirrorRegistryConfiguration)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	c, err := b.RegisterClusterInternal(ctx, nil, mirrorRegistryConfiguration, params)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
@@ -85,7 +92,7 @@ func (b *bareMetalInventory) V2RegisterDisconnectedCluster(ctx context.Context,
 		},
 	}
 
-	err := b.clusterApi.RegisterCluster(ctx, cluster)
+	err := b.clusterApi.RegisterCluster(ctx, cluster, b.db)
 	if err != nil {
 		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
 	}
@@ -887,7 +894,7 @@ func (b *bareMetalInventory) GetInfraEnvDownloadURL(ctx context.Context, params
 
 func (b *bareMetalInventory) generateShortImageDownloadURL(infraEnvID, imageType, version, arch, imageTokenKey string) (string, *strfmt.DateTime, error) {
 	switch b.authHandler.AuthType() {
-	case auth.TypeLocal:
+	case auth.TypeLocal, auth.TypeOIDC:
 		return b.generateShortImageDownloadURLByAPIKey(infraEnvID, imageType, version, arch)
 	case auth.TypeRHSSO:
 		return b.generateShortImageDownloadURLByToken(infraEnvID, imageType, version, arch, imageTokenKey)
@@ -934,7 +941,7 @@ func (b *bareMetalInventory) generateShortImageDownloadURLByID(infraEnvID, image
 func (b *bareMetalInventory) signURL(ctx context.Context, infraEnvID, urlString, imageTokenKey string) (string, error) {
 	log := logutil.FromContext(ctx, b.log)
 
-	if b.authHandler.AuthType() == auth.TypeLocal {
+	if b.authHandler.AuthType().UsesLocalTokens() {
 		var err error
 		urlString, err = gencrypto.SignURL(urlString, infraEnvID, gencrypto.InfraEnvKey)
 		if err != nil {
@@ -1132,3 +1139,234 @@ func (b *bareMetalInventory) V2UpdateClusterFinalizingProgress(ctx context.Conte
 	b.log.Infof("updated finalizing stage of cluster %s to %s", params.ClusterID, params.FinalizingProgress.FinalizingStage)
 	return installer.NewV2UpdateClusterFinalizingProgressOK()
 }
+
+func (b *bareMetalInventory) V2GetQuotaUsage(ctx context.Context, params installer.V2GetQuotaUsageParams) middleware.Responder {
+	usage, err := b.quotaApi.GetUsage(ctx, ocm.OrgIDFromContext(ctx))
+	if err != nil {
+		b.log.WithError(err).Error("failed to get the quota usage")
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetQuotaUsageOK().WithPayload(usage)
+}
+
+// virtualMediaBootTimeout bounds the time spent booting the servers of a virtual media boot. A boot that didn't
+// complete within it, e.g. because the service restarted, doesn't prevent a new one.
+const virtualMediaBootTimeout = 30 * time.Minute
+
+func (b *bareMetalInventory) V2InfraEnvVirtualMediaBoot(ctx context.Context, params installer.V2InfraEnvVirtualMediaBootParams) middleware.Responder {
+	status, err := b.V2InfraEnvVirtualMediaBootInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2InfraEnvVirtualMediaBootAccepted().WithPayload(status)
+}
+
+func (b *bareMetalInventory) V2InfraEnvVirtualMediaBootInternal(ctx context.Context, params installer.V2InfraEnvVirtualMediaBootParams) (*models.InfraEnvVirtualMediaBoot, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	infraEnv, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("Infra-env %s not found", params.InfraEnvID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err = b.checkUpdateAccessToObj(ctx, infraEnv, "infra-env", infraEnv.ID); err != nil {
+		return nil, err
+	}
+	credentials := make([]*bmc.Credentials, 0, len(params.InfraEnvVirtualMediaBootParams.Bmcs))
+	addresses := make(map[string]bool)
+	for _, bmcCredentials := range params.InfraEnvVirtualMediaBootParams.Bmcs {
+		address := swag.StringValue(bmcCredentials.Address)
+		if err = b.bmcApi.ValidateAddress(address); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		if addresses[address] {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.Errorf("BMC %s is set more than once", address))
+		}
+		addresses[address] = true
+		credentials = append(credentials, &bmc.Credentials{
+			Address:                        address,
+			Username:                       swag.StringValue(bmcCredentials.Username),
+			Password:                       bmcCredentials.Password.String(),
+			DisableCertificateVerification: swag.BoolValue(bmcCredentials.DisableCertificateVerification),
+		})
+	}
+
+	isoURL, err := b.virtualMediaISOURL(infraEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	now := strfmt.DateTime(time.Now())
+	status := &models.InfraEnvVirtualMediaBoot{StartedAt: now}
+	for _, c := range credentials {
+		status.Bmcs = append(status.Bmcs, &models.VirtualMediaBootBmcStatus{
+			Address:   c.Address,
+			Status:    models.VirtualMediaBootBmcStatusStatusInProgress,
+			UpdatedAt: now,
+		})
+	}
+	if err = b.startVirtualMediaBoot(params.InfraEnvID, status); err != nil {
+		return nil, err
+	}
+	log.Infof("Booting %d servers from the discovery ISO of infra-env %s", len(credentials), params.InfraEnvID)
+	eventgen.SendInfraEnvVirtualMediaBootStartedEvent(ctx, b.eventsHandler, params.InfraEnvID, int64(len(credentials)))
+
+	// The goroutine updates its own copy of the status, the returned status isn't shared with it
+	tracked := *status
+	tracked.Bmcs = make([]*models.VirtualMediaBootBmcStatus, len(status.Bmcs))
+	for i := range status.Bmcs {
+		bmcStatus := *status.Bmcs[i]
+		tracked.Bmcs[i] = &bmcStatus
+	}
+	go func() {
+		asyncCtx, cancel := context.WithTimeout(ctxparams.Copy(ctx), virtualMediaBootTimeout)
+		defer cancel()
+		b.runVirtualMediaBoot(asyncCtx, params.InfraEnvID, isoURL, credentials, &tracked)
+	}()
+	return status, nil
+}
+
+// runVirtualMediaBoot boots the servers of the BMCs in parallel, the status of each BMC is stored once its server is
+// booted or failed to boot
+func (b *bareMetalInventory) runVirtualMediaBoot(ctx context.Context, infraEnvID strfmt.UUID, isoURL string,
+	credentials []*bmc.Credentials, status *models.InfraEnvVirtualMediaBoot) {
+	log := logutil.FromContext(ctx, b.log)
+
+	var (
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+	)
+	for i := range credentials {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := b.bmcApi.BootFromISO(ctx, credentials[i], isoURL)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			bmcStatus := status.Bmcs[i]
+			bmcStatus.UpdatedAt = strfmt.DateTime(time.Now())
+			if err != nil {
+				log.WithError(err).Errorf("failed to boot the server of BMC %s from the discovery ISO of infra-env %s", bmcStatus.Address, infraEnvID)
+				bmcStatus.Status = models.VirtualMediaBootBmcStatusStatusFailed
+				bmcStatus.StatusInfo = bmc.PublicMessage(err)
+				eventgen.SendInfraEnvVirtualMediaBootFailedEvent(ctx, b.eventsHandler, infraEnvID, bmcStatus.Address, bmcStatus.StatusInfo)
+			} else {
+				log.Infof("Booted the server of BMC %s from the discovery ISO of infra-env %s", bmcStatus.Address, infraEnvID)
+				bmcStatus.Status = models.VirtualMediaBootBmcStatusStatusSucceeded
+				eventgen.SendInfraEnvVirtualMediaBootSucceededEvent(ctx, b.eventsHandler, infraEnvID, bmcStatus.Address)
+			}
+			if err = b.updateVirtualMediaBoot(infraEnvID, status); err != nil {
+				log.WithError(err).Errorf("failed to store the virtual media boot status of infra-env %s", infraEnvID)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+// virtualMediaISOURL returns the URL of the discovery ISO of the infra-env mounted by the BMCs. The short URL is used
+// as some BMCs only accept URLs of files with the iso extension. It's generated for each boot, so that its image
+// token is valid while the BMC downloads the ISO.
+func (b *bareMetalInventory) virtualMediaISOURL(infraEnv *common.InfraEnv) (string, error) {
+	if !b.EnableImageService {
+		return "", common.NewApiError(http.StatusBadRequest, errors.New("image service is disabled"))
+	}
+	osImage, err := b.osImages.GetOsImageOrLatest(infraEnv.OpenshiftVersion, infraEnv.CPUArchitecture)
+	if err != nil {
+		return "", common.NewApiError(http.StatusBadRequest, err)
+	}
+	if osImage.OpenshiftVersion == nil {
+		return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("OS image entry '%+v' missing OpenshiftVersion field", osImage))
+	}
+	imageType := models.ImageTypeFullIso
+	if infraEnv.Type != nil {
+		imageType = *infraEnv.Type
+	}
+	isoURL, _, err := b.generateShortImageDownloadURL(infraEnv.ID.String(), string(imageType), *osImage.OpenshiftVersion,
+		infraEnv.CPUArchitecture, infraEnv.ImageTokenKey)
+	if err != nil {
+		return "", common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return isoURL, nil
+}
+
+// startVirtualMediaBoot stores the status of a new virtual media boot unless another one is in progress. The status
+// is only replaced when it didn't change since it was checked, so that concurrent requests don't boot the same servers
+// twice.
+func (b *bareMetalInventory) startVirtualMediaBoot(infraEnvID strfmt.UUID, status *models.InfraEnvVirtualMediaBoot) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return b.db.Transaction(func(tx *gorm.DB) error {
+		var infraEnv common.InfraEnv
+		if err = transaction.AddForUpdateQueryOption(tx).Select("id", "virtual_media_boot").
+			Take(&infraEnv, "id = ?", infraEnvID.String()).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		previous, err := getVirtualMediaBoot(&infraEnv)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if virtualMediaBootInProgress(previous) {
+			return common.NewApiError(http.StatusConflict,
+				errors.Errorf("A virtual media boot of infra-env %s started at %s is in progress", infraEnvID, previous.StartedAt))
+		}
+		result := tx.Model(&common.InfraEnv{}).
+			Where("id = ? AND COALESCE(virtual_media_boot, '') = ?", infraEnvID.String(), infraEnv.VirtualMediaBoot).
+			Update("virtual_media_boot", string(data))
+		if result.Error != nil {
+			return common.NewApiError(http.StatusInternalServerError, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return common.NewApiError(http.StatusConflict,
+				errors.Errorf("Another virtual media boot of infra-env %s started", infraEnvID))
+		}
+		return nil
+	})
+}
+
+func (b *bareMetalInventory) updateVirtualMediaBoot(infraEnvID strfmt.UUID, status *models.InfraEnvVirtualMediaBoot) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return b.db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID.String()).Update("virtual_media_boot", string(data)).Error
+}
+
+func getVirtualMediaBoot(infraEnv *common.InfraEnv) (*models.InfraEnvVirtualMediaBoot, error) {
+	status := &models.InfraEnvVirtualMediaBoot{Bmcs: []*models.VirtualMediaBootBmcStatus{}}
+	if infraEnv.VirtualMediaBoot == "" {
+		return status, nil
+	}
+	if err := json.Unmarshal([]byte(infraEnv.VirtualMediaBoot), status); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the virtual media boot status of infra-env %s", infraEnv.ID)
+	}
+	return status, nil
+}
+
+func virtualMediaBootInProgress(status *models.InfraEnvVirtualMediaBoot) bool {
+	if time.Since(time.Time(status.StartedAt)) > virtualMediaBootTimeout {
+		return false
+	}
+	return funk.Contains(status.Bmcs, func(bmcStatus *models.VirtualMediaBootBmcStatus) bool {
+		return bmcStatus.Status == models.VirtualMediaBootBmcStatusStatusInProgress
+	})
+}
+
+func (b *bareMetalInventory) V2GetInfraEnvVirtualMediaBoot(ctx context.Context, params installer.V2GetInfraEnvVirtualMediaBootParams) middleware.Responder {
+	infraEnv, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.GenerateErrorResponder(common.NewApiError(http.StatusNotFound, errors.Errorf("Infra-env %s not found", params.InfraEnvID)))
+		}
+		return common.GenerateErrorResponder(err)
+	}
+	status, err := getVirtualMediaBoot(infraEnv)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetInfraEnvVirtualMediaBootOK().WithPayload(status)
+}
@@ -7,6 +7,7 @@ package bminventory
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
@@ -49,3 +50,17 @@ func (mr *MockCRDUtilsMockRecorder) CreateAgentCR(arg0, arg1, arg2, arg3, arg4 i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAgentCR", reflect.TypeOf((*MockCRDUtils)(nil).CreateAgentCR), arg0, arg1, arg2, arg3, arg4)
 }
+
+// RequestAgentNodeRemoval mocks base method.
+func (m *MockCRDUtils) RequestAgentNodeRemoval(arg0 context.Context, arg1 logrus.FieldLogger, arg2 *common.Host, arg3 *time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestAgentNodeRemoval", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestAgentNodeRemoval indicates an expected call of RequestAgentNodeRemoval.
+func (mr *MockCRDUtilsMockRecorder) RequestAgentNodeRemoval(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAgentNodeRemoval", reflect.TypeOf((*MockCRDUtils)(nil).RequestAgentNodeRemoval), arg0, arg1, arg2, arg3)
+}
@@ -29,8 +29,11 @@ import (
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/mirrorcheck"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/provider/vsphere"
+	"github.com/openshift/assisted-service/internal/releasesignature"
 	"github.com/openshift/assisted-service/internal/stream"
 	"github.com/openshift/assisted-service/internal/uploader"
 	"github.com/openshift/assisted-service/internal/usage"
@@ -77,8 +80,8 @@ var ClusterOwnerFileNames = []string{
 //go:generate mockgen -source=cluster.go -package=cluster -destination=mock_cluster_api.go
 
 type RegistrationAPI interface {
-	// Register a new cluster (handles all cluster types based on Kind field)
-	RegisterCluster(ctx context.Context, c *common.Cluster) error
+	// Register a new cluster (handles all cluster types based on Kind field) in the transaction of db
+	RegisterCluster(ctx context.Context, c *common.Cluster, db *gorm.DB) error
 	// Register a new add-host-ocp cluster
 	RegisterAddHostsOCPCluster(c *common.Cluster, db *gorm.DB) error
 	//deregister cluster
@@ -155,6 +158,7 @@ type Config struct {
 	MonitorBlacklistDuration time.Duration `envconfig:"CLUSTER_MONITOR_BLACKLIST_DURATION" default:"15m"`
 	// MonitorCycleDeadline bounds the total time for one ClusterMonitoring cycle
 	MonitorCycleDeadline time.Duration `envconfig:"CLUSTER_MONITOR_CYCLE_DEADLINE" default:"4m"`
+	ReleaseImageMirror   string
 }
 
 type Manager struct {
@@ -190,7 +194,8 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, stream stream.N
 	uploadClient uploader.Client, hostAPI host.API, metricApi metrics.API, manifestsGeneratorAPI network.ManifestsGeneratorAPI,
 	leaderElector leader.Leader, operatorsApi operators.API, ocmClient *ocm.Client, objectHandler s3wrapper.API,
 	dnsApi dns.DNSApi, authHandler auth.Authenticator, manifestApi manifestsapi.ManifestsAPI, softTimeoutsEnabled bool,
-	usageApi usage.API) *Manager {
+	usageApi usage.API, releaseVerifier releasesignature.Verifier, mirrorChecker mirrorcheck.Checker,
+	vcenterValidator vsphere.VCenterValidator) *Manager {
 	th := &transitionHandler{
 		log:                 log,
 		db:                  db,
@@ -213,7 +218,7 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, stream stream.N
 		metricAPI:             metricApi,
 		manifestsGeneratorAPI: manifestsGeneratorAPI,
 		hostAPI:               hostAPI,
-		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi, usageApi, eventsHandler),
+		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi, usageApi, eventsHandler, releaseVerifier, cfg.ReleaseImageMirror, mirrorChecker, vcenterValidator),
 		leaderElector:         leaderElector,
 		prevMonitorInvokedAt:  time.Time{},
 		ocmClient:             ocmClient,
@@ -226,8 +231,8 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, stream stream.N
 	}
 }
 
-func (m *Manager) RegisterCluster(ctx context.Context, c *common.Cluster) error {
-	err := m.registrationAPI.RegisterCluster(ctx, c)
+func (m *Manager) RegisterCluster(ctx context.Context, c *common.Cluster, db *gorm.DB) error {
+	err := m.registrationAPI.RegisterCluster(ctx, c, db)
 	if err != nil {
 		return err
 	}
@@ -13,9 +13,12 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/mirrorcheck"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	operatorcommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/internal/provider/vsphere"
+	"github.com/openshift/assisted-service/internal/releasesignature"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
@@ -45,10 +48,15 @@ type refreshPreprocessor struct {
 }
 
 func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, operatorsAPI operators.API, usageAPI usage.API,
-	eventsHandler eventsapi.Handler) *refreshPreprocessor {
+	eventsHandler eventsapi.Handler, releaseVerifier releasesignature.Verifier, releaseImageMirror string, mirrorChecker mirrorcheck.Checker,
+	vcenterValidator vsphere.VCenterValidator) *refreshPreprocessor {
 	v := clusterValidator{
-		log:     log,
-		hostAPI: hostAPI,
+		log:                log,
+		hostAPI:            hostAPI,
+		releaseVerifier:    releaseVerifier,
+		releaseImageMirror: releaseImageMirror,
+		mirrorChecker:      mirrorChecker,
+		vcenterValidator:   vcenterValidator,
 	}
 
 	return &refreshPreprocessor{
@@ -373,6 +381,18 @@ func newValidations(v *clusterValidator) []validation {
 			id:        IsPullSecretSet,
 			condition: v.isPullSecretSet,
 		},
+		{
+			id:        IsReleaseImageSignatureVerified,
+			condition: v.isReleaseImageSignatureVerified,
+		},
+		{
+			id:        AreMirrorRegistriesContainingReleaseImages,
+			condition: v.areMirrorRegistriesContainingReleaseImages,
+		},
+		{
+			id:        AreVsphereCredentialsValid,
+			condition: v.areVsphereCredentialsValid,
+		},
 		{
 			id:        isClusterCidrDefined,
 			condition: v.isClusterCidrDefined,
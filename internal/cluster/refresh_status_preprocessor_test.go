@@ -48,6 +48,10 @@ var _ = Describe("Cluster Refresh Status Preprocessor", func() {
 			mockOperatorManager,
 			mockUsageApi,
 			nil,
+			nil,
+			"",
+			nil,
+			nil,
 		)
 	})
 
@@ -25,8 +25,8 @@ type registrar struct {
 	db  *gorm.DB
 }
 
-func (r *registrar) RegisterCluster(ctx context.Context, cluster *common.Cluster) error {
-	return r.registerCluster(cluster)
+func (r *registrar) RegisterCluster(ctx context.Context, cluster *common.Cluster, db *gorm.DB) error {
+	return r.registerCluster(cluster, db)
 }
 
 func (r *registrar) getStatusByClusterKind(kind string) (string, string) {
@@ -43,7 +43,7 @@ func (r *registrar) getStatusByClusterKind(kind string) (string, string) {
 	}
 }
 
-func (r *registrar) registerCluster(cluster *common.Cluster) error {
+func (r *registrar) registerCluster(cluster *common.Cluster, db *gorm.DB) error {
 	kind := models.ClusterKindCluster
 	if cluster.Kind != nil {
 		kind = *cluster.Kind
@@ -56,7 +56,7 @@ func (r *registrar) registerCluster(cluster *common.Cluster) error {
 	cluster.StatusInfo = swag.String(statusInfo)
 	cluster.StatusUpdatedAt = strfmt.DateTime(time.Now())
 
-	return r.db.Transaction(func(tx *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
 		var err error
 		if _, err = common.GetClusterFromDB(tx, *cluster.ID, common.SkipEagerLoading); err != nil {
 			if !errors.Is(err, gorm.ErrRecordNotFound) {
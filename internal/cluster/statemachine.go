@@ -110,6 +110,9 @@ func NewClusterStateMachine(th TransitionHandler) stateswitch.StateMachine {
 	)
 
 	var requiredForInstall = stateswitch.And(
+		If(IsReleaseImageSignatureVerified),
+		If(AreMirrorRegistriesContainingReleaseImages),
+		If(AreVsphereCredentialsValid),
 		If(IsMachineCidrEqualsToCalculatedCidr),
 		If(AreApiVipsValid),
 		If(AreIngressVipsValid),
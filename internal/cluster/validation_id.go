@@ -58,6 +58,9 @@ const (
 	AreMetallbRequirementsSatisfied                = ValidationID(models.ClusterValidationIDMetallbRequirementsSatisfied)
 	IsLokiRequirementsSatisfied                    = ValidationID(models.ClusterValidationIDLokiRequirementsSatisfied)
 	IsOpenShiftLoggingRequirementsSatisfied        = ValidationID(models.ClusterValidationIDOpenshiftLoggingRequirementsSatisfied)
+	IsReleaseImageSignatureVerified                = ValidationID(models.ClusterValidationIDReleaseImageSignatureVerified)
+	AreMirrorRegistriesContainingReleaseImages     = ValidationID(models.ClusterValidationIDMirrorRegistriesContainReleaseImages)
+	AreVsphereCredentialsValid                     = ValidationID(models.ClusterValidationIDVsphereCredentialsValid)
 )
 
 func (v ValidationID) Category() (string, error) {
@@ -68,7 +71,8 @@ func (v ValidationID) Category() (string, error) {
 		return "network", nil
 	case AllHostsAreReadyToInstall, SufficientMastersCount:
 		return "hosts-data", nil
-	case IsPullSecretSet, PlatformRequirementsSatisfied:
+	case IsPullSecretSet, PlatformRequirementsSatisfied, IsReleaseImageSignatureVerified, AreMirrorRegistriesContainingReleaseImages,
+		AreVsphereCredentialsValid:
 		return "configuration", nil
 	case IsOdfRequirementsSatisfied,
 		IsLsoRequirementsSatisfied,
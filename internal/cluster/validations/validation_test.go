@@ -1061,6 +1061,41 @@ var _ = Describe("Parse functions", func() {
 	})
 })
 
+var _ = Describe("Disk encryption validation", func() {
+	const tangServers = `[{"url":"http://tang1.example.com:7500","thumbprint":"PLjNyRdGw03zlRoGjQYMahSZGu9"},` +
+		`{"url":"http://tang2.example.com:7500","thumbprint":"XYjNyRdGw03zlRoGjQYMahSZGu3"}]`
+
+	thresholdParams := func(spec string) *models.DiskEncryption {
+		return &models.DiskEncryption{
+			EnableOn:      swag.String(models.DiskEncryptionEnableOnAll),
+			Mode:          swag.String(models.DiskEncryptionModeThreshold),
+			TangServers:   tangServers,
+			ThresholdSpec: spec,
+		}
+	}
+
+	It("accepts a threshold policy combining the TPM and the Tang servers", func() {
+		Expect(ValidateDiskEncryptionParams(thresholdParams(`{"threshold":2,"tpm2":true,"tang_threshold":1}`), true)).To(Succeed())
+	})
+
+	It("refuses the threshold mode without a threshold policy", func() {
+		Expect(ValidateDiskEncryptionParams(thresholdParams(""), true)).To(MatchError(ContainSubstring("threshold_spec isn't set")))
+	})
+
+	It("refuses the threshold mode without Tang servers", func() {
+		params := thresholdParams(`{"threshold":1,"tpm2":true,"tang_threshold":1}`)
+		params.TangServers = ""
+		Expect(ValidateDiskEncryptionParams(params, true)).To(MatchError(ContainSubstring("tang_servers isn't set")))
+	})
+
+	It("refuses threshold policies that can't be satisfied", func() {
+		Expect(ValidateDiskEncryptionParams(thresholdParams(`{"threshold":2,"tpm2":false,"tang_threshold":1}`), true)).
+			To(MatchError(ContainSubstring("number of pins")))
+		Expect(ValidateDiskEncryptionParams(thresholdParams(`{"threshold":1,"tpm2":true,"tang_threshold":3}`), true)).
+			To(MatchError(ContainSubstring("number of Tang servers")))
+	})
+})
+
 func TestCluster(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "cluster validations tests")
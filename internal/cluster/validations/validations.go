@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"net"
 	"net/http"
-	"net/url"
 	"regexp"
 	"strings"
 
@@ -712,7 +711,8 @@ func ValidateDiskEncryptionParams(diskEncryptionParams *models.DiskEncryption, D
 	if !DiskEncryptionSupport && swag.StringValue(diskEncryptionParams.EnableOn) != models.DiskEncryptionEnableOnNone {
 		return errors.New("Disk encryption support is not enabled. Cannot apply configurations to the cluster")
 	}
-	if diskEncryptionParams.Mode != nil && swag.StringValue(diskEncryptionParams.Mode) == models.DiskEncryptionModeTang {
+	switch swag.StringValue(diskEncryptionParams.Mode) {
+	case models.DiskEncryptionModeTang:
 		if diskEncryptionParams.TangServers == "" {
 			return errors.New("Setting Tang mode but tang_servers isn't set")
 		}
@@ -720,14 +720,26 @@ func ValidateDiskEncryptionParams(diskEncryptionParams *models.DiskEncryption, D
 		if err != nil {
 			return err
 		}
-		for _, ts := range tangServers {
-			if _, err := url.ParseRequestURI(ts.Url); err != nil {
-				return errors.Wrap(err, "Tang URL isn't valid")
-			}
-			if ts.Thumbprint == "" {
-				return errors.New("Tang thumbprint isn't set")
-			}
+		return tang.ValidateTangServers(tangServers)
+	case models.DiskEncryptionModeThreshold:
+		if diskEncryptionParams.TangServers == "" {
+			return errors.New("Setting threshold mode but tang_servers isn't set")
+		}
+		if diskEncryptionParams.ThresholdSpec == "" {
+			return errors.New("Setting threshold mode but threshold_spec isn't set")
+		}
+		tangServers, err := tang.UnmarshalTangServers(diskEncryptionParams.TangServers)
+		if err != nil {
+			return err
+		}
+		if err = tang.ValidateTangServers(tangServers); err != nil {
+			return err
+		}
+		spec, err := tang.UnmarshalThresholdSpec(diskEncryptionParams.ThresholdSpec)
+		if err != nil {
+			return err
 		}
+		return spec.Validate(tangServers)
 	}
 	return nil
 }
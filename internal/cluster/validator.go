@@ -12,8 +12,12 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/mirrorcheck"
 	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/provider/vsphere"
+	"github.com/openshift/assisted-service/internal/releasesignature"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
@@ -79,8 +83,12 @@ func validationStatusToBool(v ValidationStatus) bool {
 }
 
 type clusterValidator struct {
-	log     logrus.FieldLogger
-	hostAPI host.API
+	log                logrus.FieldLogger
+	hostAPI            host.API
+	releaseVerifier    releasesignature.Verifier
+	releaseImageMirror string
+	mirrorChecker      mirrorcheck.Checker
+	vcenterValidator   vsphere.VCenterValidator
 }
 
 func (v *clusterValidator) isMachineCidrDefined(c *clusterPreprocessContext) (ValidationStatus, string) {
@@ -582,6 +590,118 @@ func (v *clusterValidator) isPullSecretSet(c *clusterPreprocessContext) (Validat
 	return ValidationFailure, "The pull secret is not set."
 }
 
+func (v *clusterValidator) isReleaseImageSignatureVerified(c *clusterPreprocessContext) (ValidationStatus, string) {
+	if v.releaseVerifier == nil || !v.releaseVerifier.IsEnabled() {
+		return ValidationSuccess, "Release image signature verification is disabled."
+	}
+	if c.cluster.OcpReleaseImage == "" || c.cluster.PullSecret == "" {
+		return ValidationPending, "The release image or the pull secret is not set."
+	}
+	status := v.releaseVerifier.GetStatus(v.log, c.cluster.OcpReleaseImage, v.releaseImageMirror, c.cluster.PullSecret)
+	if status.InProgress {
+		return ValidationPending, fmt.Sprintf("The signature of release image %s is being verified.", c.cluster.OcpReleaseImage)
+	}
+	if status.Err != nil {
+		return ValidationFailure, fmt.Sprintf("The signature of release image %s could not be verified: %s", c.cluster.OcpReleaseImage, status.Err.Error())
+	}
+	return ValidationSuccess, fmt.Sprintf("The signature of release image %s is verified.", c.cluster.OcpReleaseImage)
+}
+
+// describeImages lists the first few images and how many more there are, so that the message stays readable for
+// releases that are missing most of their payload
+func describeImages(images []string) string {
+	const maxImages = 3
+	if len(images) <= maxImages {
+		return strings.Join(images, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(images[:maxImages], ", "), len(images)-maxImages)
+}
+
+func (v *clusterValidator) areMirrorRegistriesContainingReleaseImages(c *clusterPreprocessContext) (ValidationStatus, string) {
+	if v.mirrorChecker == nil {
+		return ValidationSuccess, "No mirror registries are configured."
+	}
+	mirrorConfiguration, err := c.cluster.GetMirrorRegistryConfiguration()
+	if err != nil {
+		return ValidationError, fmt.Sprintf("The mirror registry configuration of the cluster could not be parsed: %s", err.Error())
+	}
+	if !v.mirrorChecker.IsEnabled(mirrorConfiguration) {
+		return ValidationSuccess, "No mirror registries are configured."
+	}
+	if c.cluster.OcpReleaseImage == "" || c.cluster.PullSecret == "" {
+		return ValidationPending, "The release image or the pull secret is not set."
+	}
+	status := v.mirrorChecker.GetStatus(v.log, c.cluster.OcpReleaseImage, c.cluster.PullSecret, mirrorConfiguration)
+	if status.InProgress {
+		return ValidationPending, fmt.Sprintf("The mirror registries are being checked for the images of release %s.", c.cluster.OcpReleaseImage)
+	}
+	if len(status.MissingImages) > 0 {
+		return ValidationFailure, fmt.Sprintf("The mirror registries are missing %d of the %d images of release %s: %s.",
+			len(status.MissingImages), status.Images, c.cluster.OcpReleaseImage, describeImages(status.MissingImages))
+	}
+	images, err := v.mirrorChecker.GetReleaseImages(v.log, c.cluster.OcpReleaseImage, c.cluster.PullSecret, mirrorConfiguration)
+	if err != nil {
+		return ValidationPending, fmt.Sprintf("The images of release %s could not be listed: %s", c.cluster.OcpReleaseImage, err.Error())
+	}
+
+	// the hosts may reach the mirrors through a network that the service can't, so their reports are taken into
+	// account too
+	checkedByHost := false
+	for _, h := range c.cluster.Hosts {
+		statuses, err := common.UnmarshalMirrorRegistryCheck(h.MirrorRegistryCheck)
+		if err != nil {
+			v.log.WithError(err).Warnf("failed to parse the mirror registry check of host %s", h.ID.String())
+			continue
+		}
+		var unavailable []string
+		checked := 0
+		for _, image := range images {
+			imageStatus, ok := statuses[image]
+			if !ok {
+				continue
+			}
+			checked++
+			if imageStatus.Result != models.MirrorRegistryImageResultAvailable {
+				unavailable = append(unavailable, image)
+			}
+		}
+		if len(unavailable) > 0 {
+			return ValidationFailure, fmt.Sprintf("Host %s can't pull %d of the %d images of release %s from the mirror registries: %s.",
+				hostutil.GetHostnameForMsg(h), len(unavailable), len(images), c.cluster.OcpReleaseImage, describeImages(unavailable))
+		}
+		if len(images) > 0 && checked == len(images) {
+			checkedByHost = true
+		}
+	}
+
+	if status.Err != nil && !checkedByHost {
+		return ValidationPending, fmt.Sprintf("The mirror registries could not be checked from the service (%s), waiting for the hosts to check them.",
+			status.Err.Error())
+	}
+	return ValidationSuccess, fmt.Sprintf("All the images of release %s are available in the mirror registries.", c.cluster.OcpReleaseImage)
+}
+
+func (v *clusterValidator) areVsphereCredentialsValid(c *clusterPreprocessContext) (ValidationStatus, string) {
+	topology := vsphere.GetTopology(c.cluster)
+	if topology == nil || v.vcenterValidator == nil {
+		return ValidationSuccess, "The vSphere credentials are not set."
+	}
+	status := v.vcenterValidator.GetStatus(v.log, topology)
+	switch {
+	case status.InProgress:
+		return ValidationPending, fmt.Sprintf("The vSphere credentials and objects are being checked in vCenter %s.", topology.VCenter)
+	case status.Err != nil:
+		return ValidationFailure, fmt.Sprintf("The vSphere credentials could not be checked: %s.", status.Err.Error())
+	case len(status.MissingObjects) > 0:
+		return ValidationFailure, fmt.Sprintf("The following vSphere objects were not found in vCenter %s: %s.",
+			topology.VCenter, strings.Join(status.MissingObjects, ", "))
+	case len(status.MissingPrivileges) > 0:
+		return ValidationFailure, fmt.Sprintf("vCenter user %s is missing the following privileges: %s.",
+			topology.Username, strings.Join(status.MissingPrivileges, ", "))
+	}
+	return ValidationSuccess, fmt.Sprintf("The vSphere credentials and objects are valid in vCenter %s.", topology.VCenter)
+}
+
 func (v *clusterValidator) networkPrefixValid(c *clusterPreprocessContext) (ValidationStatus, string) {
 	var clusterCidrDefined ValidationStatus
 	clusterCidrDefined, _ = v.isClusterCidrDefined(c)
@@ -12,8 +12,12 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/mirrorcheck"
+	"github.com/openshift/assisted-service/internal/provider/vsphere"
+	"github.com/openshift/assisted-service/internal/releasesignature"
 	"github.com/openshift/assisted-service/internal/testing"
 	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
 )
@@ -839,6 +843,77 @@ var _ = Describe("Platform validations", func() {
 	})
 })
 
+var _ = Describe("isReleaseImageSignatureVerified", func() {
+	var (
+		ctrl              *gomock.Controller
+		mockVerifier      *releasesignature.MockVerifier
+		validator         clusterValidator
+		preprocessContext *clusterPreprocessContext
+		releaseImage      = "quay.io/openshift-release-dev/ocp-release:4.17.11-x86_64"
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockVerifier = releasesignature.NewMockVerifier(ctrl)
+		validator = clusterValidator{log: logrus.New(), releaseVerifier: mockVerifier, releaseImageMirror: "mirror.example.com/ocp-release"}
+		preprocessContext = &clusterPreprocessContext{cluster: &common.Cluster{
+			Cluster:    models.Cluster{OcpReleaseImage: releaseImage},
+			PullSecret: "pull-secret",
+		}}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("passes when there is no verifier", func() {
+		validator.releaseVerifier = nil
+		status, _ := validator.isReleaseImageSignatureVerified(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+	})
+
+	It("passes when verification is disabled", func() {
+		mockVerifier.EXPECT().IsEnabled().Return(false).Times(1)
+		status, message := validator.isReleaseImageSignatureVerified(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("Release image signature verification is disabled."))
+	})
+
+	It("is pending until the pull secret is set", func() {
+		preprocessContext.cluster.PullSecret = ""
+		mockVerifier.EXPECT().IsEnabled().Return(true).Times(1)
+		status, _ := validator.isReleaseImageSignatureVerified(preprocessContext)
+		Expect(status).To(Equal(ValidationPending))
+	})
+
+	It("is pending while the release is being verified", func() {
+		mockVerifier.EXPECT().IsEnabled().Return(true).Times(1)
+		mockVerifier.EXPECT().GetStatus(gomock.Any(), releaseImage, "mirror.example.com/ocp-release", "pull-secret").
+			Return(releasesignature.Status{InProgress: true}).Times(1)
+		status, message := validator.isReleaseImageSignatureVerified(preprocessContext)
+		Expect(status).To(Equal(ValidationPending))
+		Expect(message).To(Equal(fmt.Sprintf("The signature of release image %s is being verified.", releaseImage)))
+	})
+
+	It("passes when the release is verified", func() {
+		mockVerifier.EXPECT().IsEnabled().Return(true).Times(1)
+		mockVerifier.EXPECT().GetStatus(gomock.Any(), releaseImage, "mirror.example.com/ocp-release", "pull-secret").
+			Return(releasesignature.Status{Digest: "sha256:1234"}).Times(1)
+		status, message := validator.isReleaseImageSignatureVerified(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal(fmt.Sprintf("The signature of release image %s is verified.", releaseImage)))
+	})
+
+	It("fails when the release can't be verified", func() {
+		mockVerifier.EXPECT().IsEnabled().Return(true).Times(1)
+		mockVerifier.EXPECT().GetStatus(gomock.Any(), releaseImage, "mirror.example.com/ocp-release", "pull-secret").
+			Return(releasesignature.Status{Err: errors.New("no signature found")}).Times(1)
+		status, message := validator.isReleaseImageSignatureVerified(preprocessContext)
+		Expect(status).To(Equal(ValidationFailure))
+		Expect(message).To(Equal(fmt.Sprintf("The signature of release image %s could not be verified: no signature found", releaseImage)))
+	})
+})
+
 var _ = Describe("skipNetworkHostPrefixCheck", func() {
 
 	var (
@@ -1324,3 +1399,190 @@ var _ = Describe("isMachineCidrEqualsToCalculatedCidr", func() {
 		})
 	})
 })
+
+var _ = Describe("areMirrorRegistriesContainingReleaseImages", func() {
+	var (
+		ctrl              *gomock.Controller
+		mockChecker       *mirrorcheck.MockChecker
+		validator         clusterValidator
+		preprocessContext *clusterPreprocessContext
+		releaseImage      = "quay.io/openshift-release-dev/ocp-release:4.17.11-x86_64"
+		images            = []string{
+			"quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:1111",
+			"quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:2222",
+		}
+	)
+
+	hostReport := func(results ...models.MirrorRegistryImageResult) string {
+		statuses := common.MirrorRegistryImageStatuses{}
+		for i, result := range results {
+			statuses[images[i]] = &models.MirrorRegistryImageStatus{Name: images[i], Result: result}
+		}
+		report, err := common.MarshalMirrorRegistryCheck(statuses)
+		Expect(err).NotTo(HaveOccurred())
+		return report
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockChecker = mirrorcheck.NewMockChecker(ctrl)
+		validator = clusterValidator{log: logrus.New(), mirrorChecker: mockChecker}
+		hostID := strfmt.UUID(uuid.New().String())
+		preprocessContext = &clusterPreprocessContext{cluster: &common.Cluster{
+			Cluster: models.Cluster{
+				OcpReleaseImage: releaseImage,
+				Hosts:           []*models.Host{{ID: &hostID, RequestedHostname: "master-0"}},
+			},
+			PullSecret: "pull-secret",
+		}}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("passes when no mirror registries are configured", func() {
+		mockChecker.EXPECT().IsEnabled(gomock.Any()).Return(false).Times(1)
+		status, message := validator.areMirrorRegistriesContainingReleaseImages(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("No mirror registries are configured."))
+	})
+
+	It("checks the mirror registries of the cluster", func() {
+		mirrorConfiguration := &common.MirrorRegistryConfiguration{RegistriesConf: "[[registry]]"}
+		Expect(preprocessContext.cluster.SetMirrorRegistryConfiguration(mirrorConfiguration)).To(Succeed())
+		mockChecker.EXPECT().IsEnabled(mirrorConfiguration).Return(true).Times(1)
+		mockChecker.EXPECT().GetStatus(gomock.Any(), releaseImage, "pull-secret", mirrorConfiguration).Return(mirrorcheck.Status{InProgress: true}).Times(1)
+		status, _ := validator.areMirrorRegistriesContainingReleaseImages(preprocessContext)
+		Expect(status).To(Equal(ValidationPending))
+	})
+
+	It("is pending while the mirror registries are being checked", func() {
+		mockChecker.EXPECT().IsEnabled(gomock.Any()).Return(true).Times(1)
+		mockChecker.EXPECT().GetStatus(gomock.Any(), releaseImage, "pull-secret", gomock.Any()).Return(mirrorcheck.Status{InProgress: true}).Times(1)
+		status, _ := validator.areMirrorRegistriesContainingReleaseImages(preprocessContext)
+		Expect(status).To(Equal(ValidationPending))
+	})
+
+	It("passes when all the images are available", func() {
+		mockChecker.EXPECT().IsEnabled(gomock.Any()).Return(true).Times(1)
+		mockChecker.EXPECT().GetStatus(gomock.Any(), releaseImage, "pull-secret", gomock.Any()).Return(mirrorcheck.Status{Images: 2}).Times(1)
+		mockChecker.EXPECT().GetReleaseImages(gomock.Any(), releaseImage, "pull-secret", gomock.Any()).Return(images, nil).Times(1)
+		status, message := validator.areMirrorRegistriesContainingReleaseImages(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal(fmt.Sprintf("All the images of release %s are available in the mirror registries.", releaseImage)))
+	})
+
+	It("fails when the service finds missing images", func() {
+		mockChecker.EXPECT().IsEnabled(gomock.Any()).Return(true).Times(1)
+		mockChecker.EXPECT().GetStatus(gomock.Any(), releaseImage, "pull-secret", gomock.Any()).
+			Return(mirrorcheck.Status{Images: 2, MissingImages: images[1:]}).Times(1)
+		status, message := validator.areMirrorRegistriesContainingReleaseImages(preprocessContext)
+		Expect(status).To(Equal(ValidationFailure))
+		Expect(message).To(Equal(fmt.Sprintf("The mirror registries are missing 1 of the 2 images of release %s: %s.", releaseImage, images[1])))
+	})
+
+	It("fails when a host can't pull some of the images", func() {
+		preprocessContext.cluster.Hosts[0].MirrorRegistryCheck = hostReport(models.MirrorRegistryImageResultAvailable, models.MirrorRegistryImageResultMissing)
+		mockChecker.EXPECT().IsEnabled(gomock.Any()).Return(true).Times(1)
+		mockChecker.EXPECT().GetStatus(gomock.Any(), releaseImage, "pull-secret", gomock.Any()).Return(mirrorcheck.Status{Images: 2}).Times(1)
+		mockChecker.EXPECT().GetReleaseImages(gomock.Any(), releaseImage, "pull-secret", gomock.Any()).Return(images, nil).Times(1)
+		status, message := validator.areMirrorRegistriesContainingReleaseImages(preprocessContext)
+		Expect(status).To(Equal(ValidationFailure))
+		Expect(message).To(Equal(fmt.Sprintf("Host master-0 can't pull 1 of the 2 images of release %s from the mirror registries: %s.", releaseImage, images[1])))
+	})
+
+	It("waits for the hosts when the service can't reach the mirror registries", func() {
+		mockChecker.EXPECT().IsEnabled(gomock.Any()).Return(true).Times(2)
+		mockChecker.EXPECT().GetStatus(gomock.Any(), releaseImage, "pull-secret", gomock.Any()).
+			Return(mirrorcheck.Status{Images: 2, Err: errors.New("connection refused")}).Times(2)
+		mockChecker.EXPECT().GetReleaseImages(gomock.Any(), releaseImage, "pull-secret", gomock.Any()).Return(images, nil).Times(2)
+		status, _ := validator.areMirrorRegistriesContainingReleaseImages(preprocessContext)
+		Expect(status).To(Equal(ValidationPending))
+
+		preprocessContext.cluster.Hosts[0].MirrorRegistryCheck = hostReport(models.MirrorRegistryImageResultAvailable, models.MirrorRegistryImageResultAvailable)
+		status, _ = validator.areMirrorRegistriesContainingReleaseImages(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+	})
+})
+
+var _ = Describe("areVsphereCredentialsValid", func() {
+	var (
+		ctrl              *gomock.Controller
+		mockValidator     *vsphere.MockVCenterValidator
+		validator         clusterValidator
+		preprocessContext *clusterPreprocessContext
+		topology          *vsphere.Topology
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockValidator = vsphere.NewMockVCenterValidator(ctrl)
+		validator = clusterValidator{log: logrus.New(), vcenterValidator: mockValidator}
+		preprocessContext = &clusterPreprocessContext{cluster: &common.Cluster{
+			Cluster: models.Cluster{
+				Platform: &models.Platform{
+					Type: models.NewPlatformType(models.PlatformTypeVsphere),
+					Vsphere: &models.PlatformVsphere{
+						Vcenter:          "vcenter.example.com",
+						Username:         "admin",
+						Datacenter:       "dc",
+						Cluster:          "cluster",
+						DefaultDatastore: "datastore",
+						Network:          "network",
+					},
+				},
+			},
+			VspherePassword: "secret",
+		}}
+		topology = vsphere.GetTopology(preprocessContext.cluster)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("passes when the vCenter isn't set", func() {
+		preprocessContext.cluster.Platform.Vsphere = nil
+		status, message := validator.areVsphereCredentialsValid(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("The vSphere credentials are not set."))
+	})
+
+	It("is pending while the vCenter is being checked", func() {
+		mockValidator.EXPECT().GetStatus(gomock.Any(), topology).Return(vsphere.VCenterStatus{InProgress: true}).Times(1)
+		status, _ := validator.areVsphereCredentialsValid(preprocessContext)
+		Expect(status).To(Equal(ValidationPending))
+	})
+
+	It("passes when all the objects and privileges are found", func() {
+		mockValidator.EXPECT().GetStatus(gomock.Any(), topology).Return(vsphere.VCenterStatus{}).Times(1)
+		status, message := validator.areVsphereCredentialsValid(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("The vSphere credentials and objects are valid in vCenter vcenter.example.com."))
+	})
+
+	It("fails when the user can't log in", func() {
+		mockValidator.EXPECT().GetStatus(gomock.Any(), topology).
+			Return(vsphere.VCenterStatus{Err: errors.New("incorrect user name or password")}).Times(1)
+		status, message := validator.areVsphereCredentialsValid(preprocessContext)
+		Expect(status).To(Equal(ValidationFailure))
+		Expect(message).To(Equal("The vSphere credentials could not be checked: incorrect user name or password."))
+	})
+
+	It("fails when objects are missing", func() {
+		mockValidator.EXPECT().GetStatus(gomock.Any(), topology).
+			Return(vsphere.VCenterStatus{MissingObjects: []string{"datastore datastore", "network network"}}).Times(1)
+		status, message := validator.areVsphereCredentialsValid(preprocessContext)
+		Expect(status).To(Equal(ValidationFailure))
+		Expect(message).To(Equal("The following vSphere objects were not found in vCenter vcenter.example.com: datastore datastore, network network."))
+	})
+
+	It("fails when privileges are missing", func() {
+		mockValidator.EXPECT().GetStatus(gomock.Any(), topology).
+			Return(vsphere.VCenterStatus{MissingPrivileges: []string{"Network.Assign on the network"}}).Times(1)
+		status, message := validator.areVsphereCredentialsValid(preprocessContext)
+		Expect(status).To(Equal(ValidationFailure))
+		Expect(message).To(Equal("vCenter user admin is missing the following privileges: Network.Assign on the network."))
+	})
+})
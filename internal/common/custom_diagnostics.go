@@ -0,0 +1,66 @@
+package common
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// CustomDiagnosticResults are the results of a serialized custom_diagnostics host field, by step name
+type CustomDiagnosticResults map[string]*models.CustomDiagnosticResult
+
+func UnmarshalCustomDiagnostics(customDiagnosticsStr string) (CustomDiagnosticResults, error) {
+	ret := make(CustomDiagnosticResults)
+	if customDiagnosticsStr == "" {
+		return ret, nil
+	}
+	var results []*models.CustomDiagnosticResult
+	if err := json.Unmarshal([]byte(customDiagnosticsStr), &results); err != nil {
+		return ret, err
+	}
+	for _, result := range results {
+		if result != nil {
+			ret[result.Name] = result
+		}
+	}
+	return ret, nil
+}
+
+func MarshalCustomDiagnostics(results CustomDiagnosticResults) (string, error) {
+	list := make([]*models.CustomDiagnosticResult, 0, len(results))
+	for _, result := range results {
+		list = append(list, result)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	b, err := json.Marshal(list)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// CustomDiagnosticDispatchTimes are the times the custom diagnostic steps were last sent to a host, by step name
+type CustomDiagnosticDispatchTimes map[string]time.Time
+
+func UnmarshalCustomDiagnosticDispatchTimes(dispatchTimesStr string) (CustomDiagnosticDispatchTimes, error) {
+	ret := make(CustomDiagnosticDispatchTimes)
+	if dispatchTimesStr == "" {
+		return ret, nil
+	}
+	if err := json.Unmarshal([]byte(dispatchTimesStr), &ret); err != nil {
+		return ret, err
+	}
+	return ret, nil
+}
+
+func MarshalCustomDiagnosticDispatchTimes(dispatchTimes CustomDiagnosticDispatchTimes) (string, error) {
+	b, err := json.Marshal(dispatchTimes)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	v1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/encryption"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/transaction"
@@ -52,7 +54,10 @@ const (
 type Cluster struct {
 	models.Cluster
 	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
-	PullSecret string `json:"pull_secret" gorm:"type:TEXT"`
+	PullSecret string `json:"pull_secret" gorm:"type:TEXT;serializer:encrypted"`
+
+	// The password of the vCenter user, set with the vSphere platform settings
+	VspherePassword string `json:"vsphere_password" gorm:"type:TEXT;serializer:encrypted"`
 
 	// The compute hash value of the http-proxy, https-proxy and no-proxy attributes, used internally to indicate
 	// if the proxy settings were changed while downloading ISO
@@ -169,6 +174,20 @@ func (e *Event) Payload() any {
 	return &e.Event
 }
 
+// QuotaUsageRecord records a use of a resource whose quota is counted per day, such as a cluster installation
+type QuotaUsageRecord struct {
+	ID        uint      `gorm:"primarykey"`
+	OrgID     string    `gorm:"index:idx_quota_usage_records_org_resource"`
+	Resource  string    `gorm:"index:idx_quota_usage_records_org_resource"`
+	CreatedAt time.Time `gorm:"type:timestamp with time zone;index"`
+}
+
+// ServiceAccountToken is an API token of a service account, only the hash of its secret is stored
+type ServiceAccountToken struct {
+	models.ServiceAccountToken
+	TokenHash string `json:"-" gorm:"uniqueIndex"`
+}
+
 type Host struct {
 	models.Host
 	Approved bool `json:"approved"`
@@ -184,6 +203,24 @@ type Host struct {
 
 	// Json formatted string of the additional HTTP headers when fetching the ignition.
 	IgnitionEndpointHTTPHeaders string `json:"ignition_endpoint_http_headers,omitempty" gorm:"type:TEXT"`
+
+	// The user name of the BMC of the host, the BMC address is part of the host model.
+	BMCUsername string `json:"bmc_username,omitempty"`
+
+	// The password of the BMC of the host, encrypted at rest when an encryption key provider is configured.
+	BMCPassword string `json:"-" gorm:"type:TEXT;serializer:encrypted"`
+
+	// Whether the TLS certificate of the BMC of the host is verified.
+	BMCDisableCertificateVerification bool `json:"bmc_disable_certificate_verification,omitempty"`
+
+	// Json formatted times the custom diagnostic steps were last sent to the host, by step name.
+	CustomDiagnosticsDispatchedAt string `json:"-" gorm:"type:TEXT"`
+
+	// The last time the network throughput check step was sent to the host, which starts its throughput server.
+	NetworkThroughputServerStartedAt time.Time `json:"-"`
+
+	// The time the network throughput measurement that the host takes part in ends.
+	NetworkThroughputCheckEndsAt time.Time `json:"-"`
 }
 
 func (h *Host) GetClusterID() *strfmt.UUID {
@@ -208,7 +245,7 @@ type InfraEnv struct {
 	models.InfraEnv
 
 	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
-	PullSecret string `json:"pull_secret" gorm:"type:TEXT"`
+	PullSecret string `json:"pull_secret" gorm:"type:TEXT;serializer:encrypted"`
 
 	// Namespace of the KubeAPI resource
 	KubeKeyNamespace string `json:"kube_key_namespace"`
@@ -239,6 +276,9 @@ type InfraEnv struct {
 
 	// A JSON blob in which holds a mirror registry configurations if set
 	MirrorRegistryConfiguration string `gorm:"type:TEXT"`
+
+	// A JSON blob which holds the status of the last virtual media boot of the infra-env
+	VirtualMediaBoot string `gorm:"type:TEXT"`
 }
 
 func (i *InfraEnv) GetClusterID() *strfmt.UUID {
@@ -316,6 +356,32 @@ var ClusterSubTables = [...]string{
 	IngressVIPsTable,
 }
 
+// EncryptedColumns are the columns of sensitive values, encrypted at rest when an encryption key provider is
+// configured. Their fields are tagged with the encrypted serializer.
+var EncryptedColumns = []encryption.Column{
+	{Table: "clusters", Name: "pull_secret"},
+	{Table: "clusters", Name: "vsphere_password"},
+	{Table: "infra_envs", Name: "pull_secret"},
+	{Table: "hosts", Name: "bmc_password"},
+}
+
+// EncryptColumnUpdates encrypts the values of the encrypted columns of the table in the updates, as the updates with
+// a map of columns bypass the serializer of the columns
+func EncryptColumnUpdates(ctx context.Context, table string, updates map[string]interface{}) error {
+	for _, column := range EncryptedColumns {
+		value, ok := updates[column.Name].(string)
+		if column.Table != table || !ok {
+			continue
+		}
+		encrypted, err := encryption.EncryptValue(ctx, value)
+		if err != nil {
+			return errors.Wrapf(err, "failed to encrypt column %s", column.Name)
+		}
+		updates[column.Name] = encrypted
+	}
+	return nil
+}
+
 func AutoMigrate(db *gorm.DB) error {
 	return db.AutoMigrate(&models.MonitoredOperator{},
 		&Host{},
@@ -323,6 +389,11 @@ func AutoMigrate(db *gorm.DB) error {
 		&Event{},
 		&InfraEnv{},
 		&models.ReleaseImage{},
+		&models.CustomReleaseImage{},
+		&QuotaUsageRecord{},
+		&models.ServiceAccount{},
+		&ServiceAccountToken{},
+		&models.AuditEvent{},
 		&models.ClusterNetwork{},
 		&models.ServiceNetwork{},
 		&models.MachineNetwork{},
@@ -6782,3 +6782,651 @@ func (e *RebootsForNodeEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event host_bmc_credentials_updated
+//
+type HostBmcCredentialsUpdatedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    BmcAddress string
+}
+
+var HostBmcCredentialsUpdatedEventName string = "host_bmc_credentials_updated"
+
+func NewHostBmcCredentialsUpdatedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    bmcAddress string,
+) *HostBmcCredentialsUpdatedEvent {
+    return &HostBmcCredentialsUpdatedEvent{
+        eventName: HostBmcCredentialsUpdatedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        BmcAddress: bmcAddress,
+    }
+}
+
+func SendHostBmcCredentialsUpdatedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    bmcAddress string,) {
+    ev := NewHostBmcCredentialsUpdatedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        bmcAddress,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostBmcCredentialsUpdatedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    bmcAddress string,
+    eventTime time.Time) {
+    ev := NewHostBmcCredentialsUpdatedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        bmcAddress,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostBmcCredentialsUpdatedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostBmcCredentialsUpdatedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostBmcCredentialsUpdatedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostBmcCredentialsUpdatedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostBmcCredentialsUpdatedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostBmcCredentialsUpdatedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{bmc_address}", fmt.Sprint(e.BmcAddress),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostBmcCredentialsUpdatedEvent) FormatMessage() string {
+    s := "Host {host_name}: BMC credentials were set for {bmc_address}"
+    return e.format(&s)
+}
+
+//
+// Event host_bmc_credentials_removed
+//
+type HostBmcCredentialsRemovedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+}
+
+var HostBmcCredentialsRemovedEventName string = "host_bmc_credentials_removed"
+
+func NewHostBmcCredentialsRemovedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+) *HostBmcCredentialsRemovedEvent {
+    return &HostBmcCredentialsRemovedEvent{
+        eventName: HostBmcCredentialsRemovedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+    }
+}
+
+func SendHostBmcCredentialsRemovedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,) {
+    ev := NewHostBmcCredentialsRemovedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostBmcCredentialsRemovedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    eventTime time.Time) {
+    ev := NewHostBmcCredentialsRemovedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostBmcCredentialsRemovedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostBmcCredentialsRemovedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostBmcCredentialsRemovedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostBmcCredentialsRemovedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostBmcCredentialsRemovedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostBmcCredentialsRemovedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostBmcCredentialsRemovedEvent) FormatMessage() string {
+    s := "Host {host_name}: BMC credentials were removed"
+    return e.format(&s)
+}
+
+//
+// Event host_power_action_succeeded
+//
+type HostPowerActionSucceededEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    Action string
+}
+
+var HostPowerActionSucceededEventName string = "host_power_action_succeeded"
+
+func NewHostPowerActionSucceededEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    action string,
+) *HostPowerActionSucceededEvent {
+    return &HostPowerActionSucceededEvent{
+        eventName: HostPowerActionSucceededEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        Action: action,
+    }
+}
+
+func SendHostPowerActionSucceededEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    action string,) {
+    ev := NewHostPowerActionSucceededEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        action,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostPowerActionSucceededEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    action string,
+    eventTime time.Time) {
+    ev := NewHostPowerActionSucceededEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        action,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostPowerActionSucceededEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostPowerActionSucceededEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostPowerActionSucceededEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostPowerActionSucceededEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostPowerActionSucceededEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostPowerActionSucceededEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{action}", fmt.Sprint(e.Action),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostPowerActionSucceededEvent) FormatMessage() string {
+    s := "Host {host_name}: power action {action} was sent to its BMC"
+    return e.format(&s)
+}
+
+//
+// Event host_power_action_failed
+//
+type HostPowerActionFailedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    Action string
+    Error string
+}
+
+var HostPowerActionFailedEventName string = "host_power_action_failed"
+
+func NewHostPowerActionFailedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    action string,
+    error string,
+) *HostPowerActionFailedEvent {
+    return &HostPowerActionFailedEvent{
+        eventName: HostPowerActionFailedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        Action: action,
+        Error: error,
+    }
+}
+
+func SendHostPowerActionFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    action string,
+    error string,) {
+    ev := NewHostPowerActionFailedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        action,
+        error,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostPowerActionFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    action string,
+    error string,
+    eventTime time.Time) {
+    ev := NewHostPowerActionFailedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        action,
+        error,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostPowerActionFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostPowerActionFailedEvent) GetSeverity() string {
+    return "error"
+}
+func (e *HostPowerActionFailedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostPowerActionFailedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostPowerActionFailedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostPowerActionFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{action}", fmt.Sprint(e.Action),
+        "{error}", fmt.Sprint(e.Error),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostPowerActionFailedEvent) FormatMessage() string {
+    s := "Host {host_name}: power action {action} failed: {error}"
+    return e.format(&s)
+}
+
+//
+// Event infra_env_virtual_media_boot_started
+//
+type InfraEnvVirtualMediaBootStartedEvent struct {
+    eventName string
+    InfraEnvId strfmt.UUID
+    ServersCount int64
+}
+
+var InfraEnvVirtualMediaBootStartedEventName string = "infra_env_virtual_media_boot_started"
+
+func NewInfraEnvVirtualMediaBootStartedEvent(
+    infraEnvId strfmt.UUID,
+    serversCount int64,
+) *InfraEnvVirtualMediaBootStartedEvent {
+    return &InfraEnvVirtualMediaBootStartedEvent{
+        eventName: InfraEnvVirtualMediaBootStartedEventName,
+        InfraEnvId: infraEnvId,
+        ServersCount: serversCount,
+    }
+}
+
+func SendInfraEnvVirtualMediaBootStartedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    infraEnvId strfmt.UUID,
+    serversCount int64,) {
+    ev := NewInfraEnvVirtualMediaBootStartedEvent(
+        infraEnvId,
+        serversCount,
+    )
+    eventsHandler.SendInfraEnvEvent(ctx, ev)
+}
+
+func SendInfraEnvVirtualMediaBootStartedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    infraEnvId strfmt.UUID,
+    serversCount int64,
+    eventTime time.Time) {
+    ev := NewInfraEnvVirtualMediaBootStartedEvent(
+        infraEnvId,
+        serversCount,
+    )
+    eventsHandler.SendInfraEnvEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *InfraEnvVirtualMediaBootStartedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *InfraEnvVirtualMediaBootStartedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *InfraEnvVirtualMediaBootStartedEvent) GetClusterId() *strfmt.UUID {
+    return nil
+}
+func (e *InfraEnvVirtualMediaBootStartedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *InfraEnvVirtualMediaBootStartedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{servers_count}", fmt.Sprint(e.ServersCount),
+    )
+    return r.Replace(*message)
+}
+
+func (e *InfraEnvVirtualMediaBootStartedEvent) FormatMessage() string {
+    s := "Booting {servers_count} servers from the discovery ISO through their BMCs"
+    return e.format(&s)
+}
+
+//
+// Event infra_env_virtual_media_boot_succeeded
+//
+type InfraEnvVirtualMediaBootSucceededEvent struct {
+    eventName string
+    InfraEnvId strfmt.UUID
+    BmcAddress string
+}
+
+var InfraEnvVirtualMediaBootSucceededEventName string = "infra_env_virtual_media_boot_succeeded"
+
+func NewInfraEnvVirtualMediaBootSucceededEvent(
+    infraEnvId strfmt.UUID,
+    bmcAddress string,
+) *InfraEnvVirtualMediaBootSucceededEvent {
+    return &InfraEnvVirtualMediaBootSucceededEvent{
+        eventName: InfraEnvVirtualMediaBootSucceededEventName,
+        InfraEnvId: infraEnvId,
+        BmcAddress: bmcAddress,
+    }
+}
+
+func SendInfraEnvVirtualMediaBootSucceededEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    infraEnvId strfmt.UUID,
+    bmcAddress string,) {
+    ev := NewInfraEnvVirtualMediaBootSucceededEvent(
+        infraEnvId,
+        bmcAddress,
+    )
+    eventsHandler.SendInfraEnvEvent(ctx, ev)
+}
+
+func SendInfraEnvVirtualMediaBootSucceededEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    infraEnvId strfmt.UUID,
+    bmcAddress string,
+    eventTime time.Time) {
+    ev := NewInfraEnvVirtualMediaBootSucceededEvent(
+        infraEnvId,
+        bmcAddress,
+    )
+    eventsHandler.SendInfraEnvEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *InfraEnvVirtualMediaBootSucceededEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *InfraEnvVirtualMediaBootSucceededEvent) GetSeverity() string {
+    return "info"
+}
+func (e *InfraEnvVirtualMediaBootSucceededEvent) GetClusterId() *strfmt.UUID {
+    return nil
+}
+func (e *InfraEnvVirtualMediaBootSucceededEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *InfraEnvVirtualMediaBootSucceededEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{bmc_address}", fmt.Sprint(e.BmcAddress),
+    )
+    return r.Replace(*message)
+}
+
+func (e *InfraEnvVirtualMediaBootSucceededEvent) FormatMessage() string {
+    s := "The server of BMC {bmc_address} was booted from the discovery ISO"
+    return e.format(&s)
+}
+
+//
+// Event infra_env_virtual_media_boot_failed
+//
+type InfraEnvVirtualMediaBootFailedEvent struct {
+    eventName string
+    InfraEnvId strfmt.UUID
+    BmcAddress string
+    Error string
+}
+
+var InfraEnvVirtualMediaBootFailedEventName string = "infra_env_virtual_media_boot_failed"
+
+func NewInfraEnvVirtualMediaBootFailedEvent(
+    infraEnvId strfmt.UUID,
+    bmcAddress string,
+    error string,
+) *InfraEnvVirtualMediaBootFailedEvent {
+    return &InfraEnvVirtualMediaBootFailedEvent{
+        eventName: InfraEnvVirtualMediaBootFailedEventName,
+        InfraEnvId: infraEnvId,
+        BmcAddress: bmcAddress,
+        Error: error,
+    }
+}
+
+func SendInfraEnvVirtualMediaBootFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    infraEnvId strfmt.UUID,
+    bmcAddress string,
+    error string,) {
+    ev := NewInfraEnvVirtualMediaBootFailedEvent(
+        infraEnvId,
+        bmcAddress,
+        error,
+    )
+    eventsHandler.SendInfraEnvEvent(ctx, ev)
+}
+
+func SendInfraEnvVirtualMediaBootFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    infraEnvId strfmt.UUID,
+    bmcAddress string,
+    error string,
+    eventTime time.Time) {
+    ev := NewInfraEnvVirtualMediaBootFailedEvent(
+        infraEnvId,
+        bmcAddress,
+        error,
+    )
+    eventsHandler.SendInfraEnvEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *InfraEnvVirtualMediaBootFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *InfraEnvVirtualMediaBootFailedEvent) GetSeverity() string {
+    return "error"
+}
+func (e *InfraEnvVirtualMediaBootFailedEvent) GetClusterId() *strfmt.UUID {
+    return nil
+}
+func (e *InfraEnvVirtualMediaBootFailedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *InfraEnvVirtualMediaBootFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{bmc_address}", fmt.Sprint(e.BmcAddress),
+        "{error}", fmt.Sprint(e.Error),
+    )
+    return r.Replace(*message)
+}
+
+func (e *InfraEnvVirtualMediaBootFailedEvent) FormatMessage() string {
+    s := "Failed to boot the server of BMC {bmc_address} from the discovery ISO: {error}"
+    return e.format(&s)
+}
+
@@ -0,0 +1,43 @@
+package common
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// MirrorRegistryImageStatuses are the images of a serialized mirror_registry_check_response, by name
+type MirrorRegistryImageStatuses map[string]*models.MirrorRegistryImageStatus
+
+func UnmarshalMirrorRegistryCheck(mirrorRegistryCheckStr string) (MirrorRegistryImageStatuses, error) {
+	ret := make(MirrorRegistryImageStatuses)
+	if mirrorRegistryCheckStr == "" {
+		return ret, nil
+	}
+	var response models.MirrorRegistryCheckResponse
+	if err := json.Unmarshal([]byte(mirrorRegistryCheckStr), &response); err != nil {
+		return ret, err
+	}
+	for _, status := range response.Images {
+		if status != nil {
+			ret[status.Name] = status
+		}
+	}
+	return ret, nil
+}
+
+func MarshalMirrorRegistryCheck(statuses MirrorRegistryImageStatuses) (string, error) {
+	response := models.MirrorRegistryCheckResponse{Images: make([]*models.MirrorRegistryImageStatus, 0, len(statuses))}
+	for _, status := range statuses {
+		response.Images = append(response.Images, status)
+	}
+	sort.Slice(response.Images, func(i, j int) bool {
+		return response.Images[i].Name < response.Images[j].Name
+	})
+	b, err := json.Marshal(&response)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
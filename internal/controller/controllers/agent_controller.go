@@ -103,6 +103,7 @@ type AgentReconciler struct {
 	HostFSMountDir             string
 	reclaimer                  *agentReclaimer
 	ImageServiceEnabled        bool
+	Drainer                    Drainer
 }
 
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch;create;update;patch;delete
@@ -181,6 +182,10 @@ func (r *AgentReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (
 		}
 	}
 
+	if res, removalErr := r.reconcileNodeRemoval(ctx, log, agent, h); res != nil {
+		return *res, removalErr
+	}
+
 	if err = r.setInfraEnvNameLabel(ctx, log, h, agent); err != nil {
 		log.WithError(err).Warnf("failed to set infraEnv name label on agent %s/%s", agent.Namespace, agent.Name)
 	}
@@ -606,28 +611,8 @@ func (r *AgentReconciler) approveAIHostsCSRs(ctx context.Context, clients spoke_
 }
 
 func (r *AgentReconciler) spokeKubeClient(ctx context.Context, clusterRef *aiv1beta1.ClusterReference) (spoke_k8s_client.SpokeK8sClient, error) {
-	secret, err := spokeKubeconfigSecret(ctx, r.Log, r.Client, r.APIReader, clusterRef)
-	if err != nil {
-		r.Log.WithError(err).Errorf("failed to get spoke secret for cluster %s/%s", clusterRef.Namespace, clusterRef.Name)
-		return nil, err
-	}
-	clusterDeploymentKey := types.NamespacedName{
-		Namespace: clusterRef.Namespace,
-		Name:      clusterRef.Name,
-	}
-	clusterDeployment := &hivev1.ClusterDeployment{}
-	err = r.Client.Get(ctx, clusterDeploymentKey, clusterDeployment)
-	if err != nil {
-		if k8serrors.IsNotFound(err) {
-			clusterDeployment = nil
-			err = nil
-		}
-	}
+	clusterDeployment, secret, err := spokeClusterDeploymentAndSecret(ctx, r.Log, r.Client, r.APIReader, clusterRef)
 	if err != nil {
-		r.Log.WithError(err).Errorf(
-			"failed to get cluster deployment for cluster %s/%s",
-			clusterRef.Namespace, clusterRef.Name,
-		)
 		return nil, err
 	}
 	return r.SpokeK8sClientFactory.CreateFromSecret(clusterDeployment, secret)
@@ -1086,7 +1071,7 @@ func generateControllerLogsDownloadURL(baseURL string, clusterID string, authTyp
 	}
 
 	downloadURL := fmt.Sprintf("%s%s", baseURL, u.RequestURI())
-	if authType != auth.TypeLocal {
+	if !authType.UsesLocalTokens() {
 		return downloadURL, nil
 	}
 
@@ -0,0 +1,242 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	nodeNotReadyEventReason = "NodeNotReady"
+	nodeNotFoundEventReason = "NodeNotFound"
+	nodeReadyEventReason    = "NodeReady"
+)
+
+// The node conditions that report a problem when they are true
+var nodePressureConditionTypes = []corev1.NodeConditionType{
+	corev1.NodeMemoryPressure,
+	corev1.NodeDiskPressure,
+	corev1.NodePIDPressure,
+	corev1.NodeNetworkUnavailable,
+}
+
+type AgentNodeHealthConfig struct {
+	Enabled      bool          `envconfig:"ENABLE_AGENT_NODE_HEALTH_SYNC" default:"false"`
+	SyncInterval time.Duration `envconfig:"AGENT_NODE_HEALTH_SYNC_INTERVAL" default:"2m"`
+}
+
+// AgentNodeHealthReconciler syncs the status of the nodes of installed agents from their clusters
+type AgentNodeHealthReconciler struct {
+	client.Client
+	APIReader    client.Reader
+	Log          logrus.FieldLogger
+	SpokeClients SpokeClientCache
+	Recorder     record.EventRecorder
+	Config       AgentNodeHealthConfig
+}
+
+// +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch
+// +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *AgentNodeHealthReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := addRequestIdIfNeeded(origCtx)
+	log := r.Log.WithFields(
+		logrus.Fields{
+			"agent":           req.Name,
+			"agent_namespace": req.Namespace,
+		})
+
+	agent := &aiv1beta1.Agent{}
+	if err := r.Get(ctx, req.NamespacedName, agent); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.WithError(err).Errorf("failed to get agent %s", req.NamespacedName)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	patch := client.MergeFrom(agent.DeepCopy())
+	if !agentNodeIsTracked(agent) {
+		if agent.Status.NodeHealth == nil && conditionsv1.FindStatusCondition(agent.Status.Conditions, aiv1beta1.NodeHealthyCondition) == nil {
+			return ctrl.Result{}, nil
+		}
+		// The agent isn't installed anymore, its node is not relevant
+		agent.Status.NodeHealth = nil
+		conditionsv1.RemoveStatusCondition(&agent.Status.Conditions, aiv1beta1.NodeHealthyCondition)
+		return ctrl.Result{}, r.Status().Patch(ctx, agent, patch)
+	}
+
+	r.syncNodeHealth(ctx, log, agent)
+	if err := r.Status().Patch(ctx, agent, patch); err != nil {
+		log.WithError(err).Error("failed to patch agent status")
+		return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, err
+	}
+	return ctrl.Result{RequeueAfter: r.Config.SyncInterval}, nil
+}
+
+// agentNodeIsTracked returns whether the node of the agent should be synced. Agents whose node is being removed are
+// not tracked, as their node is expected to become unschedulable and to be deleted.
+func agentNodeIsTracked(agent *aiv1beta1.Agent) bool {
+	return agent.Spec.ClusterDeploymentName != nil &&
+		agent.Status.NodeRemovalStartTime == nil &&
+		funk.ContainsString([]string{models.HostStatusInstalled, models.HostStatusAddedToExistingCluster}, agent.Status.DebugInfo.State)
+}
+
+// syncNodeHealth gets the node of the agent from its cluster and sets the node health and the NodeHealthy condition
+// of the agent accordingly. An event is recorded when the node of the agent stops or starts being healthy.
+func (r *AgentNodeHealthReconciler) syncNodeHealth(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent) {
+	nodeName := getAgentHostname(agent)
+	wasHealthy := nodeWasHealthy(agent)
+
+	node, err := r.getNode(ctx, log, agent, nodeName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			agent.Status.NodeHealth = &aiv1beta1.AgentNodeHealth{NodeName: nodeName, LastSyncTime: metav1.Now()}
+			setNodeHealthyCondition(agent, corev1.ConditionFalse, aiv1beta1.NodeNotFoundReason, aiv1beta1.NodeNotFoundMsg)
+			if wasHealthy {
+				r.Recorder.Eventf(agent, corev1.EventTypeWarning, nodeNotFoundEventReason, "Node %s of agent %s was not found in the cluster", nodeName, agent.Name)
+			}
+			return
+		}
+		// The previous node health is kept, it is only unknown since the last sync
+		setNodeHealthyCondition(agent, corev1.ConditionUnknown, aiv1beta1.NodeHealthUnknownReason,
+			fmt.Sprintf("%s %s", aiv1beta1.NodeHealthUnknownMsg, err.Error()))
+		return
+	}
+
+	agent.Status.NodeHealth = nodeHealth(node)
+	problems := nodeProblems(node)
+	if len(problems) == 0 {
+		setNodeHealthyCondition(agent, corev1.ConditionTrue, aiv1beta1.NodeReadyReason, aiv1beta1.NodeReadyMsg)
+		if !wasHealthy {
+			r.Recorder.Eventf(agent, corev1.EventTypeNormal, nodeReadyEventReason, "Node %s of agent %s is ready again", nodeName, agent.Name)
+		}
+		return
+	}
+	message := strings.Join(problems, ", ")
+	setNodeHealthyCondition(agent, corev1.ConditionFalse, aiv1beta1.NodeNotReadyReason, fmt.Sprintf("%s %s", aiv1beta1.NodeNotReadyMsg, message))
+	if wasHealthy {
+		log.Warnf("Node %s is not healthy: %s", nodeName, message)
+		r.Recorder.Eventf(agent, corev1.EventTypeWarning, nodeNotReadyEventReason, "Node %s of agent %s is not healthy: %s", nodeName, agent.Name, message)
+	}
+}
+
+// nodeWasHealthy returns whether the node of the agent was healthy at the last sync. When the last sync failed, the
+// node health synced before is used.
+func nodeWasHealthy(agent *aiv1beta1.Agent) bool {
+	previous := conditionsv1.FindStatusCondition(agent.Status.Conditions, aiv1beta1.NodeHealthyCondition)
+	switch {
+	case previous == nil:
+		return true
+	case previous.Status == corev1.ConditionUnknown:
+		return agent.Status.NodeHealth == nil || agent.Status.NodeHealth.Ready
+	default:
+		return previous.Status == corev1.ConditionTrue
+	}
+}
+
+func (r *AgentNodeHealthReconciler) getNode(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent, nodeName string) (*corev1.Node, error) {
+	clusterDeployment, secret, err := spokeClusterDeploymentAndSecret(ctx, log, r.Client, r.APIReader, agent.Spec.ClusterDeploymentName)
+	if err != nil {
+		return nil, err
+	}
+	spokeClient, err := r.SpokeClients.Get(clusterDeployment, secret)
+	if err != nil {
+		log.WithError(err).Error("failed to create spoke client")
+		return nil, err
+	}
+	return spokeClient.GetNode(ctx, nodeName)
+}
+
+func nodeHealth(node *corev1.Node) *aiv1beta1.AgentNodeHealth {
+	ret := &aiv1beta1.AgentNodeHealth{
+		NodeName:       node.Name,
+		KubeletVersion: node.Status.NodeInfo.KubeletVersion,
+		LastSyncTime:   metav1.Now(),
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+			ret.Ready = true
+		}
+		ret.Conditions = append(ret.Conditions, aiv1beta1.AgentNodeCondition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime,
+		})
+	}
+	sort.Slice(ret.Conditions, func(i, j int) bool { return ret.Conditions[i].Type < ret.Conditions[j].Type })
+	return ret
+}
+
+// nodeProblems returns a description of each condition of the node that makes it unhealthy
+func nodeProblems(node *corev1.Node) []string {
+	var ret []string
+	ready := false
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			ready = condition.Status == corev1.ConditionTrue
+			continue
+		}
+		if condition.Status == corev1.ConditionTrue && funk.Contains(nodePressureConditionTypes, condition.Type) {
+			ret = append(ret, string(condition.Type))
+		}
+	}
+	if !ready {
+		ret = append([]string{"Ready condition is not true"}, ret...)
+	}
+	if node.Spec.Unschedulable {
+		ret = append(ret, "node is unschedulable")
+	}
+	return ret
+}
+
+func setNodeHealthyCondition(agent *aiv1beta1.Agent, status corev1.ConditionStatus, reason, message string) {
+	conditionsv1.SetStatusConditionNoHeartbeat(&agent.Status.Conditions, conditionsv1.Condition{
+		Type:    aiv1beta1.NodeHealthyCondition,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+func (r *AgentNodeHealthReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The status updates of this controller don't trigger a reconcile, the agents are synced periodically once
+	// installed
+	trackingChanged := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldAgent, ok := e.ObjectOld.(*aiv1beta1.Agent)
+			if !ok {
+				return false
+			}
+			newAgent, ok := e.ObjectNew.(*aiv1beta1.Agent)
+			if !ok {
+				return false
+			}
+			return agentNodeIsTracked(oldAgent) != agentNodeIsTracked(newAgent) ||
+				getAgentHostname(oldAgent) != getAgentHostname(newAgent)
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("agent-node-health").
+		For(&aiv1beta1.Agent{}, builder.WithPredicates(trackingChanged)).
+		Complete(r)
+}
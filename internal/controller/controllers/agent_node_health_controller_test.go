@@ -0,0 +1,207 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("AgentNodeHealthReconciler", func() {
+	var (
+		ctx              = context.Background()
+		c                client.Client
+		r                *AgentNodeHealthReconciler
+		mockCtrl         *gomock.Controller
+		mockSpokeClients *MockSpokeClientCache
+		mockSpokeClient  *spoke_k8s_client.MockSpokeK8sClient
+		recorder         *record.FakeRecorder
+		agent            *v1beta1.Agent
+		clusterName      = "test-cluster"
+		agentHostname    = "host.example.com"
+		syncInterval     = 2 * time.Minute
+	)
+
+	newNode := func(ready corev1.ConditionStatus, pressure ...corev1.NodeConditionType) *corev1.Node {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: agentHostname},
+			Status: corev1.NodeStatus{
+				NodeInfo:   corev1.NodeSystemInfo{KubeletVersion: "v1.29.5"},
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready, Reason: "KubeletReady"}},
+			},
+		}
+		for _, conditionType := range pressure {
+			node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{Type: conditionType, Status: corev1.ConditionTrue})
+		}
+		return node
+	}
+
+	setAgentStatus := func(status v1beta1.AgentStatus) {
+		agent.Status = status
+		Expect(c.Status().Update(ctx, agent)).To(Succeed())
+	}
+
+	reconcile := func() ctrl.Result {
+		res, err := r.Reconcile(ctx, newAgentRequest(agent))
+		Expect(err).NotTo(HaveOccurred())
+		return res
+	}
+
+	getAgent := func() *v1beta1.Agent {
+		ret := &v1beta1.Agent{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(agent), ret)).To(Succeed())
+		return ret
+	}
+
+	nodeHealthyCondition := func() *conditionsv1.Condition {
+		return conditionsv1.FindStatusCondition(getAgent().Status.Conditions, v1beta1.NodeHealthyCondition)
+	}
+
+	expectNode := func(node *corev1.Node, err error) {
+		mockSpokeClients.EXPECT().Get(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).Return(mockSpokeClient, nil)
+		mockSpokeClient.EXPECT().GetNode(gomock.Any(), agentHostname).Return(node, err)
+	}
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithStatusSubresource(&v1beta1.Agent{}).Build()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockSpokeClients = NewMockSpokeClientCache(mockCtrl)
+		mockSpokeClient = spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
+		recorder = record.NewFakeRecorder(10)
+		r = &AgentNodeHealthReconciler{
+			Client:       c,
+			APIReader:    c,
+			Log:          common.GetTestLog(),
+			SpokeClients: mockSpokeClients,
+			Recorder:     recorder,
+			Config:       AgentNodeHealthConfig{Enabled: true, SyncInterval: syncInterval},
+		}
+
+		cd := newClusterDeployment(clusterName, testNamespace, hivev1.ClusterDeploymentSpec{
+			ClusterName: clusterName,
+			ClusterMetadata: &hivev1.ClusterMetadata{
+				AdminKubeconfigSecretRef: corev1.LocalObjectReference{Name: "admin-kubeconfig"},
+			},
+		})
+		Expect(c.Create(ctx, cd)).To(Succeed())
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "admin-kubeconfig", Namespace: testNamespace},
+			Data:       map[string][]byte{"kubeconfig": []byte("definitely_a_kubeconfig")},
+		}
+		Expect(c.Create(ctx, secret)).To(Succeed())
+
+		agent = newAgent("host", testNamespace, v1beta1.AgentSpec{
+			Hostname:              agentHostname,
+			ClusterDeploymentName: &v1beta1.ClusterReference{Name: clusterName, Namespace: testNamespace},
+		})
+		Expect(c.Create(ctx, agent)).To(Succeed())
+		setAgentStatus(v1beta1.AgentStatus{DebugInfo: v1beta1.DebugInfo{State: models.HostStatusInstalled}})
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("doesn't sync agents that aren't installed", func() {
+		setAgentStatus(v1beta1.AgentStatus{DebugInfo: v1beta1.DebugInfo{State: models.HostStatusInstallingInProgress}})
+		Expect(reconcile()).To(Equal(ctrl.Result{}))
+		Expect(getAgent().Status.NodeHealth).To(BeNil())
+	})
+
+	It("syncs the status of a ready node", func() {
+		expectNode(newNode(corev1.ConditionTrue), nil)
+		Expect(reconcile().RequeueAfter).To(Equal(syncInterval))
+
+		nodeHealth := getAgent().Status.NodeHealth
+		Expect(nodeHealth).NotTo(BeNil())
+		Expect(nodeHealth.NodeName).To(Equal(agentHostname))
+		Expect(nodeHealth.Ready).To(BeTrue())
+		Expect(nodeHealth.KubeletVersion).To(Equal("v1.29.5"))
+		Expect(nodeHealth.Conditions).To(HaveLen(1))
+		Expect(nodeHealth.Conditions[0].Reason).To(Equal("KubeletReady"))
+		Expect(nodeHealthyCondition().Status).To(Equal(corev1.ConditionTrue))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("records an event when the node becomes unhealthy", func() {
+		expectNode(newNode(corev1.ConditionTrue), nil)
+		reconcile()
+		expectNode(newNode(corev1.ConditionFalse, corev1.NodeDiskPressure), nil)
+		reconcile()
+
+		Expect(getAgent().Status.NodeHealth.Ready).To(BeFalse())
+		condition := nodeHealthyCondition()
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(v1beta1.NodeNotReadyReason))
+		Expect(condition.Message).To(ContainSubstring("DiskPressure"))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Warning NodeNotReady")))
+
+		// The event is only recorded once
+		expectNode(newNode(corev1.ConditionFalse, corev1.NodeDiskPressure), nil)
+		reconcile()
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("records an event when the node recovers", func() {
+		expectNode(newNode(corev1.ConditionFalse), nil)
+		reconcile()
+		Expect(recorder.Events).To(Receive(ContainSubstring("Warning NodeNotReady")))
+		expectNode(newNode(corev1.ConditionTrue), nil)
+		reconcile()
+		Expect(nodeHealthyCondition().Status).To(Equal(corev1.ConditionTrue))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Normal NodeReady")))
+	})
+
+	It("reports a node removed from the cluster", func() {
+		expectNode(nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "nodes"}, agentHostname))
+		reconcile()
+
+		Expect(getAgent().Status.NodeHealth.Ready).To(BeFalse())
+		Expect(nodeHealthyCondition().Reason).To(Equal(v1beta1.NodeNotFoundReason))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Warning NodeNotFound")))
+	})
+
+	It("keeps the last node status when the cluster can't be reached", func() {
+		expectNode(newNode(corev1.ConditionTrue), nil)
+		reconcile()
+		expectNode(nil, errors.New("connection refused"))
+		Expect(reconcile().RequeueAfter).To(Equal(syncInterval))
+
+		Expect(getAgent().Status.NodeHealth.Ready).To(BeTrue())
+		condition := nodeHealthyCondition()
+		Expect(condition.Status).To(Equal(corev1.ConditionUnknown))
+		Expect(condition.Message).To(ContainSubstring("connection refused"))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("clears the node status when the agent isn't installed anymore", func() {
+		expectNode(newNode(corev1.ConditionTrue), nil)
+		reconcile()
+
+		updated := getAgent()
+		updated.Spec.ClusterDeploymentName = nil
+		Expect(c.Update(ctx, updated)).To(Succeed())
+		Expect(reconcile()).To(Equal(ctrl.Result{}))
+		Expect(getAgent().Status.NodeHealth).To(BeNil())
+		Expect(nodeHealthyCondition()).To(BeNil())
+	})
+})
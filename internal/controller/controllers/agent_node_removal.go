@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/swag"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultNodeRemovalDrainTimeout = 10 * time.Minute
+	nodeRemovalDrainRequeueAfter   = 20 * time.Second
+)
+
+// reconcileNodeRemoval drives the removal of the node of an installed agent requested in its spec. The node is
+// cordoned and drained, then the agent is unbound so that the reclaim and the spoke cleanup of unbound agents reboot
+// the host into discovery and delete the node. The node is only deleted once the host left the reclaim states, as
+// the agent that reclaims the host runs on it.
+// A result is returned when the reconcile should stop there.
+func (r *AgentReconciler) reconcileNodeRemoval(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent, h *common.Host) (*ctrl.Result, error) {
+	if agent.Spec.NodeRemoval == nil {
+		reportNodeRemovalProgress(agent, h)
+		return nil, nil
+	}
+	if agent.Spec.ClusterDeploymentName == nil {
+		// The agent is being unbound anyway
+		return nil, nil
+	}
+	if h.ClusterID == nil || !funk.ContainsString([]string{models.HostStatusInstalled, models.HostStatusAddedToExistingCluster}, swag.StringValue(h.Status)) {
+		setNodeRemovalCondition(agent, corev1.ConditionFalse, aiv1beta1.NodeRemovalNotInstalledReason, aiv1beta1.NodeRemovalNotInstalledMsg)
+		return nil, nil
+	}
+
+	log = log.WithField("node", getAgentHostname(agent))
+	patch := client.MergeFrom(agent.DeepCopy())
+	if agent.Status.NodeRemovalStartTime == nil {
+		log.Info("Starting the removal of the agent node")
+		now := metav1.Now()
+		agent.Status.NodeRemovalStartTime = &now
+	}
+	drainTimeout := defaultNodeRemovalDrainTimeout
+	if agent.Spec.NodeRemoval.DrainTimeout != nil {
+		drainTimeout = agent.Spec.NodeRemoval.DrainTimeout.Duration
+	}
+
+	if time.Since(agent.Status.NodeRemovalStartTime.Time) >= drainTimeout {
+		log.Warnf("Timed out waiting to drain node after %s, continuing with its removal", drainTimeout)
+		setNodeRemovalCondition(agent, corev1.ConditionFalse, aiv1beta1.NodeRemovalDrainTimedOutReason, aiv1beta1.NodeRemovalDrainTimedOutMsg)
+	} else {
+		requeue, err := r.drainNodeForRemoval(ctx, log, agent)
+		if err != nil {
+			setNodeRemovalCondition(agent, corev1.ConditionFalse, aiv1beta1.NodeRemovalFailedReason,
+				fmt.Sprintf("%s %s", aiv1beta1.NodeRemovalFailedMsg, err.Error()))
+			return &ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, r.Status().Patch(ctx, agent, patch)
+		}
+		if requeue {
+			setNodeRemovalCondition(agent, corev1.ConditionFalse, aiv1beta1.NodeRemovalDrainingReason, aiv1beta1.NodeRemovalDrainingMsg)
+			return &ctrl.Result{RequeueAfter: nodeRemovalDrainRequeueAfter}, r.Status().Patch(ctx, agent, patch)
+		}
+		setNodeRemovalCondition(agent, corev1.ConditionFalse, aiv1beta1.NodeRemovalReclaimingReason, aiv1beta1.NodeRemovalReclaimingMsg)
+	}
+	if err := r.Status().Patch(ctx, agent, patch); err != nil {
+		log.WithError(err).Error("failed to patch agent status")
+		return &ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, err
+	}
+
+	// Unbinding the agent reclaims the host, the node removal request is cleared so that it doesn't apply to the
+	// next cluster of the agent
+	log.Info("Unbinding the agent to reclaim the host of the removed node")
+	agent.Spec.ClusterDeploymentName = nil
+	agent.Spec.NodeRemoval = nil
+	if err := r.Update(ctx, agent); err != nil {
+		log.WithError(err).Error("failed to unbind agent")
+		return &ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, err
+	}
+	return &ctrl.Result{Requeue: true}, nil
+}
+
+// drainNodeForRemoval cordons and drains the node of the agent. It returns true when the node is not drained yet.
+func (r *AgentReconciler) drainNodeForRemoval(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent) (bool, error) {
+	clusterDeployment, secret, err := spokeClusterDeploymentAndSecret(ctx, log, r.Client, r.APIReader, agent.Spec.ClusterDeploymentName)
+	if err != nil {
+		return false, err
+	}
+	spokeClient, clientset, err := r.SpokeK8sClientFactory.ClientAndSetFromSecret(clusterDeployment, secret)
+	if err != nil {
+		log.WithError(err).Error("failed to create spoke client")
+		return false, err
+	}
+	node, err := spokeClient.GetNode(ctx, getAgentHostname(agent))
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			log.Warn("node not found, nothing to drain")
+			return false, nil
+		}
+		log.WithError(err).Error("failed to get node")
+		return false, err
+	}
+	return drainNode(ctx, log, r.Drainer, clientset, node)
+}
+
+// reportNodeRemovalProgress follows the reclaim of the host and the spoke cleanup after the agent of a removed node
+// was unbound
+func reportNodeRemovalProgress(agent *aiv1beta1.Agent, h *common.Host) {
+	if agent.Status.NodeRemovalStartTime == nil || h.ClusterID != nil {
+		return
+	}
+	switch swag.StringValue(h.Status) {
+	case models.HostStatusUnbinding, models.HostStatusReclaiming, models.HostStatusReclaimingRebooting:
+		return
+	case models.HostStatusUnbindingPendingUserAction:
+		setNodeRemovalCondition(agent, corev1.ConditionFalse, aiv1beta1.NodeRemovalPendingUserActionReason, aiv1beta1.NodeRemovalPendingUserActionMsg)
+		return
+	}
+	if agent.Status.DeprovisionInfo != nil {
+		// The node is not deleted yet
+		return
+	}
+	setNodeRemovalCondition(agent, corev1.ConditionTrue, aiv1beta1.NodeRemovedReason, aiv1beta1.NodeRemovedMsg)
+	agent.Status.NodeRemovalStartTime = nil
+}
+
+func setNodeRemovalCondition(agent *aiv1beta1.Agent, status corev1.ConditionStatus, reason, message string) {
+	conditionsv1.SetStatusConditionNoHeartbeat(&agent.Status.Conditions, conditionsv1.Condition{
+		Type:    aiv1beta1.NodeRemovalCondition,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
@@ -0,0 +1,242 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/kubectl/pkg/drain"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("reconcileNodeRemoval", func() {
+	var (
+		ctx               = context.Background()
+		c                 client.Client
+		r                 *AgentReconciler
+		mockCtrl          *gomock.Controller
+		mockClientFactory *spoke_k8s_client.MockSpokeK8sClientFactory
+		mockSpokeClient   *spoke_k8s_client.MockSpokeK8sClient
+		mockDrainer       *MockDrainer
+		agent             *v1beta1.Agent
+		host              *common.Host
+		clusterName       = "test-cluster"
+		agentHostname     = "host.example.com"
+	)
+
+	getAgent := func() *v1beta1.Agent {
+		ret := &v1beta1.Agent{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(agent), ret)).To(Succeed())
+		return ret
+	}
+
+	expectSpokeClient := func() {
+		mockClientFactory.EXPECT().ClientAndSetFromSecret(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).Return(
+			mockSpokeClient, &kubernetes.Clientset{}, nil)
+	}
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithStatusSubresource(&v1beta1.Agent{}).Build()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(mockCtrl)
+		mockSpokeClient = spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
+		mockDrainer = NewMockDrainer(mockCtrl)
+		r = &AgentReconciler{
+			Client:                c,
+			APIReader:             c,
+			Scheme:                scheme.Scheme,
+			Log:                   common.GetTestLog(),
+			SpokeK8sClientFactory: mockClientFactory,
+			Drainer:               mockDrainer,
+		}
+
+		cd := newClusterDeployment(clusterName, testNamespace, hivev1.ClusterDeploymentSpec{
+			ClusterName: clusterName,
+			ClusterMetadata: &hivev1.ClusterMetadata{
+				AdminKubeconfigSecretRef: corev1.LocalObjectReference{Name: "admin-kubeconfig"},
+			},
+		})
+		Expect(c.Create(ctx, cd)).To(Succeed())
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "admin-kubeconfig", Namespace: testNamespace},
+			Data:       map[string][]byte{"kubeconfig": []byte("definitely_a_kubeconfig")},
+		}
+		Expect(c.Create(ctx, secret)).To(Succeed())
+
+		agent = newAgent(uuid.New().String(), testNamespace, v1beta1.AgentSpec{
+			Hostname:              agentHostname,
+			ClusterDeploymentName: &v1beta1.ClusterReference{Name: clusterName, Namespace: testNamespace},
+			NodeRemoval:           &v1beta1.AgentNodeRemoval{},
+		})
+		Expect(c.Create(ctx, agent)).To(Succeed())
+
+		clusterID := strfmt.UUID(uuid.New().String())
+		host = &common.Host{Host: models.Host{
+			ClusterID: &clusterID,
+			Status:    swag.String(models.HostStatusInstalled),
+		}}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("does nothing when the removal of the node isn't requested", func() {
+		agent.Spec.NodeRemoval = nil
+		res, err := r.reconcileNodeRemoval(ctx, r.Log, agent, host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).To(BeNil())
+		Expect(conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.NodeRemovalCondition)).To(BeNil())
+	})
+
+	It("reports agents that aren't installed", func() {
+		host.Status = swag.String(models.HostStatusKnown)
+		res, err := r.reconcileNodeRemoval(ctx, r.Log, agent, host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).To(BeNil())
+		condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.NodeRemovalCondition)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(v1beta1.NodeRemovalNotInstalledReason))
+	})
+
+	It("requeues while the node is draining", func() {
+		expectSpokeClient()
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: agentHostname}}
+		mockSpokeClient.EXPECT().GetNode(gomock.Any(), agentHostname).Return(node, nil)
+		mockDrainer.EXPECT().RunCordonOrUncordon(gomock.AssignableToTypeOf(&drain.Helper{}), node, true).Return(nil)
+		mockDrainer.EXPECT().RunNodeDrain(gomock.AssignableToTypeOf(&drain.Helper{}), agentHostname).Return(fmt.Errorf("drain failed"))
+
+		res, err := r.reconcileNodeRemoval(ctx, r.Log, agent, host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).NotTo(BeNil())
+		Expect(res.RequeueAfter).To(Equal(nodeRemovalDrainRequeueAfter))
+
+		updated := getAgent()
+		Expect(updated.Status.NodeRemovalStartTime).NotTo(BeNil())
+		condition := conditionsv1.FindStatusCondition(updated.Status.Conditions, v1beta1.NodeRemovalCondition)
+		Expect(condition.Reason).To(Equal(v1beta1.NodeRemovalDrainingReason))
+		Expect(updated.Spec.ClusterDeploymentName).NotTo(BeNil())
+	})
+
+	It("reports the failure to cordon the node", func() {
+		expectSpokeClient()
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: agentHostname}}
+		mockSpokeClient.EXPECT().GetNode(gomock.Any(), agentHostname).Return(node, nil)
+		mockDrainer.EXPECT().RunCordonOrUncordon(gomock.AssignableToTypeOf(&drain.Helper{}), node, true).Return(fmt.Errorf("cordon failed"))
+
+		res, err := r.reconcileNodeRemoval(ctx, r.Log, agent, host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RequeueAfter).To(Equal(defaultRequeueAfterOnError))
+
+		condition := conditionsv1.FindStatusCondition(getAgent().Status.Conditions, v1beta1.NodeRemovalCondition)
+		Expect(condition.Reason).To(Equal(v1beta1.NodeRemovalFailedReason))
+		Expect(condition.Message).To(ContainSubstring("cordon failed"))
+	})
+
+	It("unbinds the agent once the node is drained", func() {
+		expectSpokeClient()
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: agentHostname}}
+		mockSpokeClient.EXPECT().GetNode(gomock.Any(), agentHostname).Return(node, nil)
+		mockDrainer.EXPECT().RunCordonOrUncordon(gomock.AssignableToTypeOf(&drain.Helper{}), node, true).Return(nil)
+		mockDrainer.EXPECT().RunNodeDrain(gomock.AssignableToTypeOf(&drain.Helper{}), agentHostname).Return(nil)
+
+		res, err := r.reconcileNodeRemoval(ctx, r.Log, agent, host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Requeue).To(BeTrue())
+
+		updated := getAgent()
+		Expect(updated.Spec.ClusterDeploymentName).To(BeNil())
+		Expect(updated.Spec.NodeRemoval).To(BeNil())
+		condition := conditionsv1.FindStatusCondition(updated.Status.Conditions, v1beta1.NodeRemovalCondition)
+		Expect(condition.Reason).To(Equal(v1beta1.NodeRemovalReclaimingReason))
+	})
+
+	It("unbinds the agent when its node doesn't exist", func() {
+		expectSpokeClient()
+		mockSpokeClient.EXPECT().GetNode(gomock.Any(), agentHostname).Return(nil,
+			k8serrors.NewNotFound(schema.GroupResource{Resource: "nodes"}, agentHostname))
+
+		res, err := r.reconcileNodeRemoval(ctx, r.Log, agent, host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Requeue).To(BeTrue())
+		Expect(getAgent().Spec.ClusterDeploymentName).To(BeNil())
+	})
+
+	It("unbinds the agent without draining once the drain timed out", func() {
+		start := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		agent.Status.NodeRemovalStartTime = &start
+		agent.Spec.NodeRemoval.DrainTimeout = &metav1.Duration{Duration: time.Minute}
+
+		res, err := r.reconcileNodeRemoval(ctx, r.Log, agent, host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Requeue).To(BeTrue())
+
+		updated := getAgent()
+		Expect(updated.Spec.ClusterDeploymentName).To(BeNil())
+		condition := conditionsv1.FindStatusCondition(updated.Status.Conditions, v1beta1.NodeRemovalCondition)
+		Expect(condition.Reason).To(Equal(v1beta1.NodeRemovalDrainTimedOutReason))
+	})
+})
+
+var _ = Describe("reportNodeRemovalProgress", func() {
+	var (
+		agent *v1beta1.Agent
+		host  *common.Host
+	)
+
+	BeforeEach(func() {
+		start := metav1.Now()
+		agent = newAgent("host", testNamespace, v1beta1.AgentSpec{})
+		agent.Status.NodeRemovalStartTime = &start
+		host = &common.Host{Host: models.Host{Status: swag.String(models.HostStatusReclaiming)}}
+	})
+
+	It("doesn't report anything while the host is reclaimed", func() {
+		reportNodeRemovalProgress(agent, host)
+		Expect(conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.NodeRemovalCondition)).To(BeNil())
+		Expect(agent.Status.NodeRemovalStartTime).NotTo(BeNil())
+	})
+
+	It("reports a reclaim waiting for the user", func() {
+		host.Status = swag.String(models.HostStatusUnbindingPendingUserAction)
+		reportNodeRemovalProgress(agent, host)
+		condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.NodeRemovalCondition)
+		Expect(condition.Reason).To(Equal(v1beta1.NodeRemovalPendingUserActionReason))
+	})
+
+	It("waits for the node to be deleted", func() {
+		host.Status = swag.String(models.HostStatusKnownUnbound)
+		agent.Status.DeprovisionInfo = &v1beta1.AgentDeprovisionInfo{ClusterName: "test-cluster"}
+		reportNodeRemovalProgress(agent, host)
+		Expect(conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.NodeRemovalCondition)).To(BeNil())
+	})
+
+	It("reports the removal of the node", func() {
+		host.Status = swag.String(models.HostStatusKnownUnbound)
+		reportNodeRemovalProgress(agent, host)
+		condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.NodeRemovalCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(v1beta1.NodeRemovedReason))
+		Expect(agent.Status.NodeRemovalStartTime).To(BeNil())
+	})
+})
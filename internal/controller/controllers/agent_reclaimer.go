@@ -141,7 +141,7 @@ func spokeReclaimSecretName(infraEnvID string) string {
 
 func (r *agentReclaimer) ensureSpokeAgentSecret(ctx context.Context, c client.Client, log logrus.FieldLogger, infraEnvID string) error {
 	authToken := ""
-	if r.AuthType == auth.TypeLocal {
+	if r.AuthType.UsesLocalTokens() {
 		var err error
 		authToken, err = gencrypto.LocalJWT(infraEnvID, gencrypto.InfraEnvKey)
 		if err != nil {
@@ -1151,6 +1151,10 @@ func (r *ClusterDeploymentsReconciler) updateIfNeeded(
 			params.DiskEncryption.TangServers = clusterInstall.Spec.DiskEncryption.TangServers
 			update = true
 		}
+		if clusterInstall.Spec.DiskEncryption.ThresholdSpec != cluster.DiskEncryption.ThresholdSpec {
+			params.DiskEncryption.ThresholdSpec = clusterInstall.Spec.DiskEncryption.ThresholdSpec
+			update = true
+		}
 	}
 
 	if clusterInstall.Spec.Proxy != nil {
@@ -1434,9 +1438,10 @@ func CreateClusterParams(clusterDeployment *hivev1.ClusterDeployment, clusterIns
 
 	if isDiskEncryptionEnabled(clusterInstall) {
 		clusterParams.DiskEncryption = &models.DiskEncryption{
-			EnableOn:    clusterInstall.Spec.DiskEncryption.EnableOn,
-			Mode:        clusterInstall.Spec.DiskEncryption.Mode,
-			TangServers: clusterInstall.Spec.DiskEncryption.TangServers,
+			EnableOn:      clusterInstall.Spec.DiskEncryption.EnableOn,
+			Mode:          clusterInstall.Spec.DiskEncryption.Mode,
+			TangServers:   clusterInstall.Spec.DiskEncryption.TangServers,
+			ThresholdSpec: clusterInstall.Spec.DiskEncryption.ThresholdSpec,
 		}
 	}
 
@@ -2456,7 +2461,7 @@ func (r *ClusterDeploymentsReconciler) generateControllerLogsDownloadURL(cluster
 	downloadURL := fmt.Sprintf("%s%s/v2/clusters/%s/logs",
 		r.ServiceBaseURL, restclient.DefaultBasePath, cluster.ID.String())
 
-	if r.AuthType != auth.TypeLocal {
+	if !r.AuthType.UsesLocalTokens() {
 		return downloadURL, nil
 	}
 
@@ -374,7 +374,7 @@ func IngressVipsEntriesToArray(entries []string) []*models.IngressVip {
 }
 
 func signURL(urlString string, authType auth.AuthType, id string, keyType gencrypto.LocalJWTKeyType) (string, error) {
-	if authType != auth.TypeLocal {
+	if !authType.UsesLocalTokens() {
 		return urlString, nil
 	}
 	return gencrypto.SignURL(urlString, id, keyType)
@@ -507,3 +507,34 @@ func spokeKubeconfigSecret(ctx context.Context, log logrus.FieldLogger, c client
 
 	return secret, nil
 }
+
+// spokeClusterDeploymentAndSecret returns the kubeconfig secret of a spoke cluster and its cluster deployment, nil
+// when the cluster deployment doesn't exist anymore
+func spokeClusterDeploymentAndSecret(ctx context.Context, log logrus.FieldLogger, c client.Client, reader client.Reader,
+	clusterRef *aiv1beta1.ClusterReference) (*hivev1.ClusterDeployment, *corev1.Secret, error) {
+	secret, err := spokeKubeconfigSecret(ctx, log, c, reader, clusterRef)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get spoke secret for cluster %s/%s", clusterRef.Namespace, clusterRef.Name)
+		return nil, nil, err
+	}
+	clusterDeploymentKey := types.NamespacedName{
+		Namespace: clusterRef.Namespace,
+		Name:      clusterRef.Name,
+	}
+	clusterDeployment := &hivev1.ClusterDeployment{}
+	err = c.Get(ctx, clusterDeploymentKey, clusterDeployment)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			clusterDeployment = nil
+			err = nil
+		}
+	}
+	if err != nil {
+		log.WithError(err).Errorf(
+			"failed to get cluster deployment for cluster %s/%s",
+			clusterRef.Namespace, clusterRef.Name,
+		)
+		return nil, nil, err
+	}
+	return clusterDeployment, secret, nil
+}
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-openapi/swag"
 	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
@@ -164,6 +165,23 @@ func (u *CRDUtils) CreateAgentCR(ctx context.Context, log logrus.FieldLogger, ho
 	return nil
 }
 
+// RequestAgentNodeRemoval requests the removal of the node of the agent of a host from its cluster. The removal is
+// done by the agent controller.
+func (u *CRDUtils) RequestAgentNodeRemoval(ctx context.Context, log logrus.FieldLogger, host *common.Host, drainTimeout *time.Duration) error {
+	agent := &aiv1beta1.Agent{}
+	key := types.NamespacedName{Name: host.ID.String(), Namespace: host.KubeKeyNamespace}
+	if err := u.client.Get(ctx, key, agent); err != nil {
+		return errors.Wrapf(err, "failed to get agent %s/%s", key.Namespace, key.Name)
+	}
+	patch := client.MergeFrom(agent.DeepCopy())
+	agent.Spec.NodeRemoval = &aiv1beta1.AgentNodeRemoval{}
+	if drainTimeout != nil {
+		agent.Spec.NodeRemoval.DrainTimeout = &metav1.Duration{Duration: *drainTimeout}
+	}
+	log.Infof("Requesting the removal of the node of agent %s/%s", key.Namespace, key.Name)
+	return u.client.Patch(ctx, agent, patch)
+}
+
 type DummyCRDUtils struct{}
 
 func NewDummyCRDUtils() *DummyCRDUtils {
@@ -174,6 +192,10 @@ func (u *DummyCRDUtils) CreateAgentCR(ctx context.Context, log logrus.FieldLogge
 	return nil
 }
 
+func (u *DummyCRDUtils) RequestAgentNodeRemoval(ctx context.Context, log logrus.FieldLogger, host *common.Host, drainTimeout *time.Duration) error {
+	return errors.New("the removal of nodes requires the kube-api")
+}
+
 func AddLabel(labels map[string]string, labelKey, labelValue string) map[string]string {
 	if labelKey == "" {
 		// Don't need to add a label.
@@ -1,7 +1,14 @@
 package controllers
 
 import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/kubectl/pkg/drain"
 )
 
@@ -22,3 +29,59 @@ func (d *KubectlDrainer) RunCordonOrUncordon(helper *drain.Helper, node *corev1.
 func (d *KubectlDrainer) RunNodeDrain(helper *drain.Helper, nodeName string) error {
 	return drain.RunNodeDrain(helper, nodeName)
 }
+
+func nodeUnreachable(node *corev1.Node) bool {
+	if node == nil {
+		return false
+	}
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionUnknown
+		}
+	}
+	return false
+}
+
+// drainNode cordons and drains a spoke node. It returns true when some pods were not evicted yet and the drain should
+// be retried later.
+func drainNode(ctx context.Context, log logrus.FieldLogger, drainer Drainer, clientset kubernetes.Interface, node *corev1.Node) (bool, error) {
+	nodeName := node.Name
+	out := new(bytes.Buffer)
+	drainHelper := &drain.Helper{
+		Client:              clientset,
+		Ctx:                 ctx,
+		Force:               true,
+		IgnoreAllDaemonSets: true,
+		DeleteEmptyDirData:  true,
+		GracePeriodSeconds:  -1,
+		Timeout:             20 * time.Second,
+		OnPodDeletionOrEvictionFinished: func(pod *corev1.Pod, usingEviction bool, err error) {
+			verbStr := "Deleted"
+			if usingEviction {
+				verbStr = "Evicted"
+			}
+			if err != nil {
+				log.Warnf("%s Pod %s/%s from Node %s, %s", verbStr, pod.Namespace, pod.Name, nodeName, err.Error())
+				return
+			}
+			log.Infof("%s Pod %s/%s from Node %s", verbStr, pod.Namespace, pod.Name, nodeName)
+		},
+		Out:    out,
+		ErrOut: out,
+	}
+	if nodeUnreachable(node) {
+		// When the node is unreachable and some pods are not evicted for as long as this timeout, we ignore them.
+		drainHelper.SkipWaitForDeleteTimeoutSeconds = 60 * 5 // 5 minutes
+	}
+	if err := drainer.RunCordonOrUncordon(drainHelper, node, true); err != nil {
+		log.WithError(err).Errorf("failed to cordon node %s: output: %s", nodeName, out)
+		return false, errors.Wrapf(err, "failed to cordon node %s", nodeName)
+	}
+	if err := drainer.RunNodeDrain(drainHelper, nodeName); err != nil {
+		log.WithError(err).Warnf("failed to drain node %s within %d timeout", nodeName, drainHelper.Timeout)
+		log.Debugf("node %s drain output: %s", nodeName, out)
+		return true, nil
+	}
+
+	return false, nil
+}
@@ -691,7 +691,7 @@ func generateStaticNetworkConfigDownloadURL(baseURL string, infraEnvId string, a
 	}
 
 	downloadURL := fmt.Sprintf("%s%s", baseURL, u.RequestURI())
-	if authType != auth.TypeLocal {
+	if !authType.UsesLocalTokens() {
 		return downloadURL, nil
 	}
 
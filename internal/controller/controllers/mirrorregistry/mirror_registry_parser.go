@@ -44,22 +44,7 @@ func getUserTomlConfigMapData(ctx context.Context, log logrus.FieldLogger, c cli
 
 // ParseMirrorRegistryConfig retrieves the mirror registry configuration from registries.conf and ca-bundle.crt
 func ParseMirrorRegistryConfig(registriesConf, caBundleCrt string) (*common2.MirrorRegistryConfiguration, error) {
-	if registriesConf == "" {
-		return nil, nil
-	}
-
-	imageDigestMirrors, imageTagMirrors, insecure, err := mirrorregistries.GetImageRegistries(registriesConf)
-	if err != nil {
-		return nil, err
-	}
-
-	return &common2.MirrorRegistryConfiguration{
-		ImageDigestMirrors: imageDigestMirrors,
-		ImageTagMirrors:    imageTagMirrors,
-		Insecure:           insecure,
-		CaBundleCrt:        caBundleCrt,
-		RegistriesConf:     registriesConf,
-	}, nil
+	return mirrorregistries.ParseMirrorRegistryConfiguration(registriesConf, caBundleCrt)
 }
 
 // ProcessMirrorRegistryConfig retrieves the mirror registry configuration from the referenced ConfigMap
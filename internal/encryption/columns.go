@@ -0,0 +1,95 @@
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// SerializerName is the gorm serializer of the encrypted columns, e.g. gorm:"type:TEXT;serializer:encrypted". The
+// values of the columns are encrypted with the default encryptor when they are written, and decrypted when they are
+// read.
+const SerializerName = "encrypted"
+
+func init() {
+	schema.RegisterSerializer(SerializerName, serializer{})
+}
+
+type serializer struct{}
+
+func (serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var value string
+	switch v := dbValue.(type) {
+	case nil:
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		return errors.Errorf("unsupported type %T of encrypted column %s", dbValue, field.DBName)
+	}
+	plaintext, err := DecryptValue(ctx, value)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decrypt column %s", field.DBName)
+	}
+	field.ReflectValueOf(ctx, dst).SetString(plaintext)
+	return nil
+}
+
+func (serializer) Value(ctx context.Context, field *schema.Field, _ reflect.Value, fieldValue interface{}) (interface{}, error) {
+	value, ok := fieldValue.(string)
+	if !ok {
+		return nil, errors.Errorf("unsupported type %T of encrypted column %s", fieldValue, field.DBName)
+	}
+	encrypted, err := EncryptValue(ctx, value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encrypt column %s", field.DBName)
+	}
+	return encrypted, nil
+}
+
+// Column is a column whose values are encrypted
+type Column struct {
+	Table string
+	Name  string
+}
+
+// ReencryptColumns encrypts the plaintext values of the columns, and rewraps the data keys of the values that
+// weren't wrapped with the current key of the key provider, e.g. after the key was rotated
+func ReencryptColumns(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, encryptor Encryptor, columns []Column) error {
+	for _, column := range columns {
+		var values []string
+		err := db.Table(column.Table).
+			Where(fmt.Sprintf("%s IS NOT NULL AND %s <> ''", column.Name, column.Name)).
+			Distinct(column.Name).
+			Pluck(column.Name, &values).Error
+		if err != nil {
+			return errors.Wrapf(err, "failed to read column %s of table %s", column.Name, column.Table)
+		}
+
+		updated := 0
+		for _, value := range values {
+			newValue, changed, err := encryptor.Rewrap(ctx, value)
+			if err != nil {
+				return errors.Wrapf(err, "failed to re-encrypt a value of column %s of table %s", column.Name, column.Table)
+			}
+			if !changed {
+				continue
+			}
+			query := fmt.Sprintf("UPDATE %s SET %s = ? WHERE %s = ?", column.Table, column.Name, column.Name)
+			if err = db.Exec(query, newValue, value).Error; err != nil {
+				return errors.Wrapf(err, "failed to update column %s of table %s", column.Name, column.Table)
+			}
+			updated++
+		}
+		if updated > 0 {
+			log.Infof("Re-encrypted %d values of column %s of table %s", updated, column.Name, column.Table)
+		}
+	}
+	return nil
+}
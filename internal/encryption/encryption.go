@@ -0,0 +1,285 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/utils/lru"
+)
+
+const (
+	KeyProviderLocal = "local"
+	KeyProviderVault = "vault"
+
+	// encryptedPrefix marks the values encrypted by the encryptor, values without it are plaintext
+	encryptedPrefix = "enc:v1:"
+
+	dataKeySize = 32
+
+	// defaultDataKeysCacheSize is the number of unwrapped data keys kept in memory when the configuration doesn't set
+	// it
+	defaultDataKeysCacheSize = 65536
+)
+
+type Config struct {
+	// KeyProvider is the backend of the keys that wrap the data keys, local or vault. The sensitive columns are stored
+	// in plaintext when it's empty.
+	KeyProvider string `envconfig:"DB_ENCRYPTION_KEY_PROVIDER" default:""`
+	// LocalKeyFile is the file of the keys of the local provider. Each line holds a key ID and a base64 encoded 32
+	// bytes key separated by a space, the key of the last line wraps the new data keys.
+	LocalKeyFile      string `envconfig:"DB_ENCRYPTION_LOCAL_KEY_FILE" default:""`
+	VaultAddress      string `envconfig:"DB_ENCRYPTION_VAULT_ADDRESS" default:""`
+	VaultToken        string `envconfig:"DB_ENCRYPTION_VAULT_TOKEN" default:""`
+	VaultTransitMount string `envconfig:"DB_ENCRYPTION_VAULT_TRANSIT_MOUNT" default:"transit"`
+	VaultKeyName      string `envconfig:"DB_ENCRYPTION_VAULT_KEY_NAME" default:"assisted-service"`
+	// DataKeysCacheSize is the number of unwrapped data keys kept in memory, the least recently used ones are evicted
+	// first. It should be larger than the number of encrypted values that the monitors read.
+	DataKeysCacheSize int `envconfig:"DB_ENCRYPTION_DATA_KEYS_CACHE_SIZE" default:"65536"`
+	// DataKeysCacheTTL is the time an unwrapped data key is kept in memory, the keys are kept until they're evicted
+	// when it's zero
+	DataKeysCacheTTL time.Duration `envconfig:"DB_ENCRYPTION_DATA_KEYS_CACHE_TTL" default:"1h"`
+}
+
+// KeyProvider wraps and unwraps the data keys of the encrypted values with keys it manages, like a KMS
+type KeyProvider interface {
+	// CurrentKeyID returns the ID of the key that wraps new data keys
+	CurrentKeyID(ctx context.Context) (string, error)
+	// WrapKey wraps a data key with the current key, it returns the wrapped data key and the ID of the key that
+	// wrapped it
+	WrapKey(ctx context.Context, dataKey []byte) (string, string, error)
+	// UnwrapKey unwraps a data key wrapped by the key of the ID
+	UnwrapKey(ctx context.Context, keyID, wrappedKey string) ([]byte, error)
+}
+
+// Encryptor encrypts the values of the sensitive columns with envelope encryption: each value is encrypted with its
+// own data key, and the data key is wrapped by the key provider and stored next to the value.
+type Encryptor interface {
+	Encrypt(ctx context.Context, plaintext string) (string, error)
+	// Decrypt decrypts an encrypted value, plaintext values are returned as is
+	Decrypt(ctx context.Context, value string) (string, error)
+	// Rewrap wraps the data key of an encrypted value with the current key of the key provider, and encrypts a
+	// plaintext value. It returns whether the value changed.
+	Rewrap(ctx context.Context, value string) (string, bool, error)
+}
+
+// envelope is the content of an encrypted value
+type envelope struct {
+	KeyID   string `json:"kid"`
+	DataKey string `json:"dek"`
+	Data    string `json:"data"`
+}
+
+// cachedDataKey is an unwrapped data key and the time it expires from the cache
+type cachedDataKey struct {
+	dataKey   []byte
+	expiresAt time.Time
+}
+
+type envelopeEncryptor struct {
+	log         logrus.FieldLogger
+	provider    KeyProvider
+	dataKeys    *lru.Cache
+	dataKeysTTL time.Duration
+}
+
+// NewEncryptor returns the encryptor of the key provider of the configuration, or nil when no key provider is
+// configured
+func NewEncryptor(log logrus.FieldLogger, config Config) (Encryptor, error) {
+	var (
+		provider KeyProvider
+		err      error
+	)
+	switch config.KeyProvider {
+	case "":
+		return nil, nil
+	case KeyProviderLocal:
+		provider, err = NewLocalKeyProvider(config.LocalKeyFile)
+	case KeyProviderVault:
+		provider, err = NewVaultKeyProvider(config.VaultAddress, config.VaultToken, config.VaultTransitMount, config.VaultKeyName)
+	default:
+		err = errors.Errorf("unsupported key provider %s, the supported key providers are %s and %s",
+			config.KeyProvider, KeyProviderLocal, KeyProviderVault)
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Encrypting the sensitive columns with keys of the %s key provider", config.KeyProvider)
+	return NewEnvelopeEncryptor(log, provider, config.DataKeysCacheSize, config.DataKeysCacheTTL), nil
+}
+
+func NewEnvelopeEncryptor(log logrus.FieldLogger, provider KeyProvider, dataKeysCacheSize int, dataKeysCacheTTL time.Duration) Encryptor {
+	if dataKeysCacheSize <= 0 {
+		dataKeysCacheSize = defaultDataKeysCacheSize
+	}
+	return &envelopeEncryptor{
+		log:         log,
+		provider:    provider,
+		dataKeys:    lru.New(dataKeysCacheSize),
+		dataKeysTTL: dataKeysCacheTTL,
+	}
+}
+
+// IsEncrypted returns whether a value was encrypted by an encryptor
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix)
+}
+
+func (e *envelopeEncryptor) Encrypt(ctx context.Context, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", errors.Wrap(err, "failed to generate data key")
+	}
+	wrappedKey, keyID, err := e.provider.WrapKey(ctx, dataKey)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to wrap data key")
+	}
+	data, err := gencrypto.Encrypt(dataKey, plaintext)
+	if err != nil {
+		return "", err
+	}
+	return marshalEnvelope(&envelope{KeyID: keyID, DataKey: wrappedKey, Data: data})
+}
+
+func (e *envelopeEncryptor) Decrypt(ctx context.Context, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	env, err := unmarshalEnvelope(value)
+	if err != nil {
+		return "", err
+	}
+	dataKey, err := e.dataKey(ctx, env)
+	if err != nil {
+		return "", err
+	}
+	return gencrypto.Decrypt(dataKey, env.Data)
+}
+
+func (e *envelopeEncryptor) Rewrap(ctx context.Context, value string) (string, bool, error) {
+	if value == "" {
+		return value, false, nil
+	}
+	if !IsEncrypted(value) {
+		encrypted, err := e.Encrypt(ctx, value)
+		return encrypted, err == nil, err
+	}
+	env, err := unmarshalEnvelope(value)
+	if err != nil {
+		return "", false, err
+	}
+	currentKeyID, err := e.provider.CurrentKeyID(ctx)
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to get the current key")
+	}
+	if env.KeyID == currentKeyID {
+		return value, false, nil
+	}
+	dataKey, err := e.dataKey(ctx, env)
+	if err != nil {
+		return "", false, err
+	}
+	if env.DataKey, env.KeyID, err = e.provider.WrapKey(ctx, dataKey); err != nil {
+		return "", false, errors.Wrap(err, "failed to wrap data key")
+	}
+	rewrapped, err := marshalEnvelope(env)
+	return rewrapped, err == nil, err
+}
+
+// dataKey unwraps the data key of the envelope. The unwrapped data keys are cached to avoid a call to the key
+// provider each time a value is read.
+func (e *envelopeEncryptor) dataKey(ctx context.Context, env *envelope) ([]byte, error) {
+	cacheKey := env.KeyID + "/" + env.DataKey
+	if cached, ok := e.dataKeys.Get(cacheKey); ok {
+		entry := cached.(*cachedDataKey)
+		if entry.expiresAt.IsZero() || time.Now().Before(entry.expiresAt) {
+			return entry.dataKey, nil
+		}
+		e.dataKeys.Remove(cacheKey)
+	}
+
+	dataKey, err := e.provider.UnwrapKey(ctx, env.KeyID, env.DataKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unwrap data key with key %s", env.KeyID)
+	}
+	entry := &cachedDataKey{dataKey: dataKey}
+	if e.dataKeysTTL > 0 {
+		entry.expiresAt = time.Now().Add(e.dataKeysTTL)
+	}
+	e.dataKeys.Add(cacheKey, entry)
+	return dataKey, nil
+}
+
+func marshalEnvelope(env *envelope) (string, error) {
+	data, err := json.Marshal(env)
+	if err != nil {
+		return "", err
+	}
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(data), nil
+}
+
+func unmarshalEnvelope(value string) (*envelope, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, encryptedPrefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode encrypted value")
+	}
+	env := &envelope{}
+	if err = json.Unmarshal(data, env); err != nil {
+		return nil, errors.Wrap(err, "failed to parse encrypted value")
+	}
+	return env, nil
+}
+
+var (
+	defaultEncryptorMutex sync.RWMutex
+	defaultEncryptor      Encryptor
+)
+
+// SetDefaultEncryptor sets the encryptor of the columns tagged with the encrypted serializer. The columns are stored in
+// plaintext when it's nil.
+func SetDefaultEncryptor(encryptor Encryptor) {
+	defaultEncryptorMutex.Lock()
+	defer defaultEncryptorMutex.Unlock()
+	defaultEncryptor = encryptor
+}
+
+func DefaultEncryptor() Encryptor {
+	defaultEncryptorMutex.RLock()
+	defer defaultEncryptorMutex.RUnlock()
+	return defaultEncryptor
+}
+
+// EncryptValue encrypts the value of an encrypted column with the default encryptor. It's needed by the updates that
+// bypass the serializer of the column, e.g. updates with a map of columns.
+func EncryptValue(ctx context.Context, value string) (string, error) {
+	encryptor := DefaultEncryptor()
+	if encryptor == nil {
+		return value, nil
+	}
+	return encryptor.Encrypt(ctx, value)
+}
+
+// ErrNoKeyProvider is returned when an encrypted value is read without an encryption key provider
+var ErrNoKeyProvider = errors.New("the value is encrypted but no encryption key provider is configured")
+
+// DecryptValue decrypts the value of an encrypted column with the default encryptor
+func DecryptValue(ctx context.Context, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	encryptor := DefaultEncryptor()
+	if encryptor == nil {
+		return "", ErrNoKeyProvider
+	}
+	return encryptor.Decrypt(ctx, value)
+}
@@ -0,0 +1,310 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm/schema"
+)
+
+func newKey() string {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	Expect(err).NotTo(HaveOccurred())
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func localKeys(keyIDs ...string) string {
+	lines := []string{"# keys of the tests"}
+	for _, keyID := range keyIDs {
+		lines = append(lines, fmt.Sprintf("%s %s", keyID, newKey()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+var _ = Describe("Envelope encryption with local keys", func() {
+	var (
+		ctx       = context.Background()
+		keyDir    string
+		keyFile   string
+		encryptor Encryptor
+	)
+
+	writeKeys := func(keys string) Encryptor {
+		Expect(os.WriteFile(keyFile, []byte(keys), 0600)).To(Succeed())
+		encryptor, err := NewEncryptor(logrus.New(), Config{KeyProvider: KeyProviderLocal, LocalKeyFile: keyFile})
+		Expect(err).NotTo(HaveOccurred())
+		return encryptor
+	}
+
+	BeforeEach(func() {
+		var err error
+		keyDir, err = os.MkdirTemp("", "encryption-keys")
+		Expect(err).NotTo(HaveOccurred())
+		keyFile = filepath.Join(keyDir, "keys")
+		encryptor = writeKeys(localKeys("key-1"))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(keyDir)).To(Succeed())
+	})
+
+	It("decrypts the encrypted value", func() {
+		encrypted, err := encryptor.Encrypt(ctx, `{"auths":{"cloud.openshift.com":{"auth":"secret"}}}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(IsEncrypted(encrypted)).To(BeTrue())
+		Expect(encrypted).NotTo(ContainSubstring("secret"))
+		decrypted, err := encryptor.Decrypt(ctx, encrypted)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decrypted).To(Equal(`{"auths":{"cloud.openshift.com":{"auth":"secret"}}}`))
+	})
+
+	It("uses a data key per value", func() {
+		first, err := encryptor.Encrypt(ctx, "secret")
+		Expect(err).NotTo(HaveOccurred())
+		second, err := encryptor.Encrypt(ctx, "secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first).NotTo(Equal(second))
+	})
+
+	It("returns plaintext values as is", func() {
+		decrypted, err := encryptor.Decrypt(ctx, "plaintext")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decrypted).To(Equal("plaintext"))
+		encrypted, err := encryptor.Encrypt(ctx, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(encrypted).To(BeEmpty())
+	})
+
+	It("rewraps the values after the key is rotated", func() {
+		encrypted, err := encryptor.Encrypt(ctx, "secret")
+		Expect(err).NotTo(HaveOccurred())
+		rewrapped, changed, err := encryptor.Rewrap(ctx, encrypted)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+		Expect(rewrapped).To(Equal(encrypted))
+
+		keys, err := os.ReadFile(keyFile)
+		Expect(err).NotTo(HaveOccurred())
+		rotated := writeKeys(string(keys) + "\n" + localKeys("key-2"))
+		rewrapped, changed, err = rotated.Rewrap(ctx, encrypted)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		env, err := unmarshalEnvelope(rewrapped)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(env.KeyID).To(Equal("key-2"))
+
+		// The old key isn't needed anymore once the value is rewrapped
+		newKeyOnly := writeKeys(readLastLine(keyFile))
+		decrypted, err := newKeyOnly.Decrypt(ctx, rewrapped)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decrypted).To(Equal("secret"))
+		_, err = newKeyOnly.Decrypt(ctx, encrypted)
+		Expect(err).To(MatchError(ContainSubstring("key key-1 isn't in the key file")))
+	})
+
+	It("encrypts plaintext values when rewrapping", func() {
+		encrypted, changed, err := encryptor.Rewrap(ctx, "secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(IsEncrypted(encrypted)).To(BeTrue())
+	})
+
+	It("fails for an invalid key file", func() {
+		Expect(os.WriteFile(keyFile, []byte("key-1 not-a-key"), 0600)).To(Succeed())
+		_, err := NewEncryptor(logrus.New(), Config{KeyProvider: KeyProviderLocal, LocalKeyFile: keyFile})
+		Expect(err).To(HaveOccurred())
+		Expect(os.WriteFile(keyFile, []byte("# no keys"), 0600)).To(Succeed())
+		_, err = NewEncryptor(logrus.New(), Config{KeyProvider: KeyProviderLocal, LocalKeyFile: keyFile})
+		Expect(err).To(MatchError(ContainSubstring("no keys")))
+	})
+
+	It("is disabled without a key provider", func() {
+		disabled, err := NewEncryptor(logrus.New(), Config{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(disabled).To(BeNil())
+		_, err = NewEncryptor(logrus.New(), Config{KeyProvider: "kms"})
+		Expect(err).To(MatchError(ContainSubstring("unsupported key provider kms")))
+	})
+
+	Context("encrypted serializer", func() {
+		type secretRow struct {
+			Secret string `gorm:"type:TEXT;serializer:encrypted"`
+		}
+
+		var field *schema.Field
+
+		BeforeEach(func() {
+			s, err := schema.Parse(&secretRow{}, &sync.Map{}, schema.NamingStrategy{})
+			Expect(err).NotTo(HaveOccurred())
+			field = s.LookUpField("Secret")
+			Expect(field.Serializer).NotTo(BeNil())
+		})
+
+		AfterEach(func() {
+			SetDefaultEncryptor(nil)
+		})
+
+		It("encrypts and decrypts the column with the default encryptor", func() {
+			SetDefaultEncryptor(encryptor)
+			row := &secretRow{}
+			value, err := field.Serializer.Value(ctx, field, reflect.ValueOf(row).Elem(), "secret")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(IsEncrypted(value.(string))).To(BeTrue())
+
+			Expect(field.Serializer.Scan(ctx, field, reflect.ValueOf(row).Elem(), []byte(value.(string)))).To(Succeed())
+			Expect(row.Secret).To(Equal("secret"))
+		})
+
+		It("stores plaintext values without a default encryptor", func() {
+			row := &secretRow{}
+			value, err := field.Serializer.Value(ctx, field, reflect.ValueOf(row).Elem(), "secret")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("secret"))
+			Expect(field.Serializer.Scan(ctx, field, reflect.ValueOf(row).Elem(), "secret")).To(Succeed())
+			Expect(row.Secret).To(Equal("secret"))
+		})
+
+		It("fails to read encrypted values without a default encryptor", func() {
+			encrypted, err := encryptor.Encrypt(ctx, "secret")
+			Expect(err).NotTo(HaveOccurred())
+			err = field.Serializer.Scan(ctx, field, reflect.ValueOf(&secretRow{}).Elem(), encrypted)
+			Expect(err).To(MatchError(ContainSubstring("no encryption key provider is configured")))
+		})
+	})
+})
+
+// countingKeyProvider counts the data keys unwrapped by a key provider
+type countingKeyProvider struct {
+	KeyProvider
+	unwraps int64
+}
+
+func (p *countingKeyProvider) UnwrapKey(ctx context.Context, keyID, wrappedKey string) ([]byte, error) {
+	atomic.AddInt64(&p.unwraps, 1)
+	return p.KeyProvider.UnwrapKey(ctx, keyID, wrappedKey)
+}
+
+var _ = Describe("Cache of the data keys", func() {
+	var (
+		ctx      = context.Background()
+		keyDir   string
+		provider *countingKeyProvider
+	)
+
+	BeforeEach(func() {
+		var err error
+		keyDir, err = os.MkdirTemp("", "encryption-keys")
+		Expect(err).NotTo(HaveOccurred())
+		keyFile := filepath.Join(keyDir, "keys")
+		Expect(os.WriteFile(keyFile, []byte(localKeys("key-1")), 0600)).To(Succeed())
+		localProvider, err := NewLocalKeyProvider(keyFile)
+		Expect(err).NotTo(HaveOccurred())
+		provider = &countingKeyProvider{KeyProvider: localProvider}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(keyDir)).To(Succeed())
+	})
+
+	encryptValues := func(encryptor Encryptor, count int) []string {
+		values := make([]string, count)
+		for i := range values {
+			var err error
+			values[i], err = encryptor.Encrypt(ctx, fmt.Sprintf("secret-%d", i))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		return values
+	}
+
+	// decryptValues decrypts the values from the first index to the last one, excluded
+	decryptValues := func(encryptor Encryptor, values []string, first, last int) {
+		for i := first; i < last; i++ {
+			decrypted, err := encryptor.Decrypt(ctx, values[i])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted).To(Equal(fmt.Sprintf("secret-%d", i)))
+		}
+	}
+
+	It("unwraps the data keys of the rows read by the monitors once", func() {
+		// the monitors read all the clusters, infra-envs and hosts in a loop
+		encryptor := NewEnvelopeEncryptor(logrus.New(), provider, 0, time.Hour)
+		values := encryptValues(encryptor, 20000)
+		for i := 0; i < 3; i++ {
+			decryptValues(encryptor, values, 0, len(values))
+		}
+		Expect(atomic.LoadInt64(&provider.unwraps)).To(BeEquivalentTo(len(values)))
+	})
+
+	It("evicts the least recently used data keys", func() {
+		encryptor := NewEnvelopeEncryptor(logrus.New(), provider, 100, 0)
+		values := encryptValues(encryptor, 150)
+		decryptValues(encryptor, values, 0, 100)
+		Expect(atomic.LoadInt64(&provider.unwraps)).To(BeEquivalentTo(100))
+
+		// the data keys of the most recently read values stay cached
+		decryptValues(encryptor, values, 0, 100)
+		Expect(atomic.LoadInt64(&provider.unwraps)).To(BeEquivalentTo(100))
+		_, err := encryptor.Decrypt(ctx, values[100])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(atomic.LoadInt64(&provider.unwraps)).To(BeEquivalentTo(101))
+		decryptValues(encryptor, values, 1, 100)
+		Expect(atomic.LoadInt64(&provider.unwraps)).To(BeEquivalentTo(101))
+	})
+
+	It("unwraps the data keys again once they expire", func() {
+		encryptor := NewEnvelopeEncryptor(logrus.New(), provider, 0, 10*time.Millisecond)
+		values := encryptValues(encryptor, 1)
+		decryptValues(encryptor, values, 0, len(values))
+		decryptValues(encryptor, values, 0, len(values))
+		Expect(atomic.LoadInt64(&provider.unwraps)).To(BeEquivalentTo(1))
+		time.Sleep(20 * time.Millisecond)
+		decryptValues(encryptor, values, 0, len(values))
+		Expect(atomic.LoadInt64(&provider.unwraps)).To(BeEquivalentTo(2))
+	})
+})
+
+func BenchmarkDecryptCachedDataKeys(b *testing.B) {
+	RegisterTestingT(b)
+	keyDir, err := os.MkdirTemp("", "encryption-keys")
+	Expect(err).NotTo(HaveOccurred())
+	defer os.RemoveAll(keyDir)
+	keyFile := filepath.Join(keyDir, "keys")
+	Expect(os.WriteFile(keyFile, []byte(localKeys("key-1")), 0600)).To(Succeed())
+	provider, err := NewLocalKeyProvider(keyFile)
+	Expect(err).NotTo(HaveOccurred())
+	encryptor := NewEnvelopeEncryptor(logrus.New(), provider, 0, time.Hour)
+
+	ctx := context.Background()
+	values := make([]string, 50000)
+	for i := range values {
+		values[i], err = encryptor.Encrypt(ctx, fmt.Sprintf("secret-%d", i))
+		Expect(err).NotTo(HaveOccurred())
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err = encryptor.Decrypt(ctx, values[i%len(values)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func readLastLine(path string) string {
+	data, err := os.ReadFile(path)
+	Expect(err).NotTo(HaveOccurred())
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	return lines[len(lines)-1]
+}
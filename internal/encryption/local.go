@@ -0,0 +1,83 @@
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/pkg/errors"
+)
+
+// localKeyProvider wraps the data keys with keys read from a file. Rotating the key is done by appending a new key
+// to the file, the previous keys are kept to unwrap the data keys they wrapped until they are rewrapped.
+type localKeyProvider struct {
+	keys         map[string][]byte
+	currentKeyID string
+}
+
+func NewLocalKeyProvider(keyFile string) (KeyProvider, error) {
+	if keyFile == "" {
+		return nil, errors.New("the local key provider requires DB_ENCRYPTION_LOCAL_KEY_FILE")
+	}
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read key file %s", keyFile)
+	}
+	return parseLocalKeys(data)
+}
+
+func parseLocalKeys(data []byte) (*localKeyProvider, error) {
+	provider := &localKeyProvider{keys: make(map[string][]byte)}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, errors.Errorf("line %d of the key file must hold a key ID and a key", lineNumber)
+		}
+		keyID := fields[0]
+		if _, ok := provider.keys[keyID]; ok {
+			return nil, errors.Errorf("key %s is set more than once in the key file", keyID)
+		}
+		key, err := gencrypto.ParseEncryptionKey(fields[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid key %s", keyID)
+		}
+		provider.keys[keyID] = key
+		provider.currentKeyID = keyID
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if provider.currentKeyID == "" {
+		return nil, errors.New("the key file has no keys")
+	}
+	return provider, nil
+}
+
+func (p *localKeyProvider) CurrentKeyID(_ context.Context) (string, error) {
+	return p.currentKeyID, nil
+}
+
+func (p *localKeyProvider) WrapKey(_ context.Context, dataKey []byte) (string, string, error) {
+	wrapped, err := gencrypto.Encrypt(p.keys[p.currentKeyID], string(dataKey))
+	return wrapped, p.currentKeyID, err
+}
+
+func (p *localKeyProvider) UnwrapKey(_ context.Context, keyID, wrappedKey string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, errors.Errorf("key %s isn't in the key file", keyID)
+	}
+	dataKey, err := gencrypto.Decrypt(key, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(dataKey), nil
+}
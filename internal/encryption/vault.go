@@ -0,0 +1,122 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const vaultRequestTimeout = 30 * time.Second
+
+// The version of the Vault key that encrypted a ciphertext, e.g. vault:v2:...
+var vaultCiphertextVersion = regexp.MustCompile(`^vault:v(\d+):`)
+
+// vaultKeyProvider wraps the data keys with a key of the transit secrets engine of Vault. Rotating the key is done
+// with the rotate endpoint of the key in Vault, the data keys are wrapped with its latest version.
+type vaultKeyProvider struct {
+	address    string
+	token      string
+	mount      string
+	keyName    string
+	httpClient *http.Client
+}
+
+type vaultResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []string        `json:"errors"`
+}
+
+func NewVaultKeyProvider(address, token, mount, keyName string) (KeyProvider, error) {
+	if address == "" || token == "" {
+		return nil, errors.New("the vault key provider requires DB_ENCRYPTION_VAULT_ADDRESS and DB_ENCRYPTION_VAULT_TOKEN")
+	}
+	return &vaultKeyProvider{
+		address:    strings.TrimSuffix(address, "/"),
+		token:      token,
+		mount:      strings.Trim(mount, "/"),
+		keyName:    keyName,
+		httpClient: &http.Client{Timeout: vaultRequestTimeout},
+	}, nil
+}
+
+func (p *vaultKeyProvider) do(ctx context.Context, method, path string, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/v1/%s/%s", p.address, p.mount, path), reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send request to vault")
+	}
+	defer resp.Body.Close()
+	var vaultResp vaultResponse
+	if err = json.NewDecoder(resp.Body).Decode(&vaultResp); err != nil {
+		return errors.Wrapf(err, "failed to parse the vault response to %s %s, status %d", method, path, resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("vault returned %d for %s %s: %s", resp.StatusCode, method, path, strings.Join(vaultResp.Errors, ", "))
+	}
+	return errors.Wrapf(json.Unmarshal(vaultResp.Data, result), "failed to parse the vault response to %s %s", method, path)
+}
+
+// keyID returns the ID of a version of the Vault key
+func (p *vaultKeyProvider) keyID(version string) string {
+	return fmt.Sprintf("%s:v%s", p.keyName, version)
+}
+
+func (p *vaultKeyProvider) CurrentKeyID(ctx context.Context) (string, error) {
+	var key struct {
+		LatestVersion int `json:"latest_version"`
+	}
+	if err := p.do(ctx, http.MethodGet, "keys/"+p.keyName, nil, &key); err != nil {
+		return "", err
+	}
+	return p.keyID(fmt.Sprint(key.LatestVersion)), nil
+}
+
+func (p *vaultKeyProvider) WrapKey(ctx context.Context, dataKey []byte) (string, string, error) {
+	var encrypted struct {
+		Ciphertext string `json:"ciphertext"`
+	}
+	if err := p.do(ctx, http.MethodPost, "encrypt/"+p.keyName,
+		map[string]string{"plaintext": base64.StdEncoding.EncodeToString(dataKey)}, &encrypted); err != nil {
+		return "", "", err
+	}
+	match := vaultCiphertextVersion.FindStringSubmatch(encrypted.Ciphertext)
+	if match == nil {
+		return "", "", errors.Errorf("unexpected vault ciphertext format")
+	}
+	return encrypted.Ciphertext, p.keyID(match[1]), nil
+}
+
+// UnwrapKey decrypts the data key with the Vault key, the version of the key is part of the ciphertext
+func (p *vaultKeyProvider) UnwrapKey(ctx context.Context, _, wrappedKey string) ([]byte, error) {
+	var decrypted struct {
+		Plaintext string `json:"plaintext"`
+	}
+	if err := p.do(ctx, http.MethodPost, "decrypt/"+p.keyName, map[string]string{"ciphertext": wrappedKey}, &decrypted); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(decrypted.Plaintext)
+}
@@ -0,0 +1,172 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/sirupsen/logrus"
+)
+
+// fakeVaultTransit emulates a key of the transit secrets engine of Vault
+type fakeVaultTransit struct {
+	mutex    sync.Mutex
+	token    string
+	keyName  string
+	versions [][]byte
+	requests int
+}
+
+func newFakeVaultTransit(token, keyName string) *fakeVaultTransit {
+	f := &fakeVaultTransit{token: token, keyName: keyName}
+	f.rotate()
+	return f
+}
+
+func (f *fakeVaultTransit) rotate() {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	Expect(err).NotTo(HaveOccurred())
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.versions = append(f.versions, key)
+}
+
+func (f *fakeVaultTransit) respond(w http.ResponseWriter, status int, data interface{}, errs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	Expect(json.NewEncoder(w).Encode(map[string]interface{}{"data": data, "errors": errs})).To(Succeed())
+}
+
+func (f *fakeVaultTransit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.requests++
+	if r.Header.Get("X-Vault-Token") != f.token {
+		f.respond(w, http.StatusForbidden, nil, "permission denied")
+		return
+	}
+	var body map[string]string
+	if r.Method == http.MethodPost {
+		Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/transit/keys/"+f.keyName:
+		f.respond(w, http.StatusOK, map[string]interface{}{"latest_version": len(f.versions)})
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/transit/encrypt/"+f.keyName:
+		ciphertext, err := gencrypto.Encrypt(f.versions[len(f.versions)-1], body["plaintext"])
+		Expect(err).NotTo(HaveOccurred())
+		f.respond(w, http.StatusOK, map[string]string{"ciphertext": fmt.Sprintf("vault:v%d:%s", len(f.versions), ciphertext)})
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/transit/decrypt/"+f.keyName:
+		match := regexp.MustCompile(`^vault:v(\d+):(.*)$`).FindStringSubmatch(body["ciphertext"])
+		if match == nil {
+			f.respond(w, http.StatusBadRequest, nil, "invalid ciphertext")
+			return
+		}
+		version, _ := strconv.Atoi(match[1])
+		plaintext, err := gencrypto.Decrypt(f.versions[version-1], match[2])
+		Expect(err).NotTo(HaveOccurred())
+		f.respond(w, http.StatusOK, map[string]string{"plaintext": plaintext})
+	default:
+		f.respond(w, http.StatusNotFound, nil)
+	}
+}
+
+var _ = Describe("Envelope encryption with Vault transit keys", func() {
+	var (
+		ctx       = context.Background()
+		vault     *fakeVaultTransit
+		server    *httptest.Server
+		encryptor Encryptor
+	)
+
+	BeforeEach(func() {
+		vault = newFakeVaultTransit("root-token", "assisted-service")
+		server = httptest.NewServer(vault)
+		var err error
+		encryptor, err = NewEncryptor(logrus.New(), Config{
+			KeyProvider:       KeyProviderVault,
+			VaultAddress:      server.URL + "/",
+			VaultToken:        "root-token",
+			VaultTransitMount: "transit",
+			VaultKeyName:      "assisted-service",
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("decrypts the encrypted value", func() {
+		encrypted, err := encryptor.Encrypt(ctx, "secret")
+		Expect(err).NotTo(HaveOccurred())
+		env, err := unmarshalEnvelope(encrypted)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(env.KeyID).To(Equal("assisted-service:v1"))
+		Expect(env.DataKey).To(HavePrefix("vault:v1:"))
+
+		decrypted, err := encryptor.Decrypt(ctx, encrypted)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decrypted).To(Equal("secret"))
+	})
+
+	It("caches the unwrapped data keys", func() {
+		encrypted, err := encryptor.Encrypt(ctx, "secret")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = encryptor.Decrypt(ctx, encrypted)
+		Expect(err).NotTo(HaveOccurred())
+		requests := vault.requests
+		_, err = encryptor.Decrypt(ctx, encrypted)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vault.requests).To(Equal(requests))
+	})
+
+	It("rewraps the values after the key is rotated", func() {
+		encrypted, err := encryptor.Encrypt(ctx, "secret")
+		Expect(err).NotTo(HaveOccurred())
+		_, changed, err := encryptor.Rewrap(ctx, encrypted)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+
+		vault.rotate()
+		rewrapped, changed, err := encryptor.Rewrap(ctx, encrypted)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		env, err := unmarshalEnvelope(rewrapped)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(env.KeyID).To(Equal("assisted-service:v2"))
+		decrypted, err := encryptor.Decrypt(ctx, rewrapped)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decrypted).To(Equal("secret"))
+	})
+
+	It("reports the errors of vault", func() {
+		encryptor, err := NewEncryptor(logrus.New(), Config{
+			KeyProvider:       KeyProviderVault,
+			VaultAddress:      server.URL,
+			VaultToken:        "wrong-token",
+			VaultTransitMount: "transit",
+			VaultKeyName:      "assisted-service",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = encryptor.Encrypt(ctx, "secret")
+		Expect(err).To(MatchError(ContainSubstring("vault returned 403")))
+		Expect(err).To(MatchError(ContainSubstring("permission denied")))
+	})
+
+	It("requires the address and the token of vault", func() {
+		_, err := NewEncryptor(logrus.New(), Config{KeyProvider: KeyProviderVault, VaultAddress: server.URL})
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(MatchError(ContainSubstring("DB_ENCRYPTION_VAULT_TOKEN")))
+	})
+})
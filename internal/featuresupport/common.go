@@ -137,6 +137,8 @@ func IsPlatformSupported(platformType models.PlatformType, externalPlatformName
 		featureID = models.FeatureSupportLevelIDNUTANIXINTEGRATION
 	case models.PlatformTypeVsphere:
 		featureID = models.FeatureSupportLevelIDVSPHEREINTEGRATION
+	case models.PlatformTypeOpenstack:
+		featureID = models.FeatureSupportLevelIDOPENSTACKINTEGRATION
 	case models.PlatformTypeNone:
 		featureID = models.FeatureSupportLevelIDNONEPLATFORM
 	case models.PlatformTypeExternal:
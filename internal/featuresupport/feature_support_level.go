@@ -62,12 +62,13 @@ var featuresList = map[models.FeatureSupportLevelID]SupportLevelFeature{
 	models.FeatureSupportLevelIDOPENSHIFTLOGGING:       (&OpenShiftLoggingFeature{}).New(),
 
 	// Platform features
-	models.FeatureSupportLevelIDNUTANIXINTEGRATION:  (&NutanixIntegrationFeature{}).New(),
-	models.FeatureSupportLevelIDVSPHEREINTEGRATION:  (&VsphereIntegrationFeature{}).New(),
-	models.FeatureSupportLevelIDEXTERNALPLATFORMOCI: (&OciIntegrationFeature{}).New(),
-	models.FeatureSupportLevelIDBAREMETALPLATFORM:   (&BaremetalPlatformFeature{}).New(),
-	models.FeatureSupportLevelIDNONEPLATFORM:        (&NonePlatformFeature{}).New(),
-	models.FeatureSupportLevelIDEXTERNALPLATFORM:    (&ExternalPlatformFeature{}).New(),
+	models.FeatureSupportLevelIDNUTANIXINTEGRATION:   (&NutanixIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDVSPHEREINTEGRATION:   (&VsphereIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDOPENSTACKINTEGRATION: (&OpenstackIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDEXTERNALPLATFORMOCI:  (&OciIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDBAREMETALPLATFORM:    (&BaremetalPlatformFeature{}).New(),
+	models.FeatureSupportLevelIDNONEPLATFORM:         (&NonePlatformFeature{}).New(),
+	models.FeatureSupportLevelIDEXTERNALPLATFORM:     (&ExternalPlatformFeature{}).New(),
 }
 
 func GetFeatureByID(featureID models.FeatureSupportLevelID) SupportLevelFeature {
@@ -140,6 +141,7 @@ func platformFeatures() []models.FeatureSupportLevelID {
 	return []models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 		models.FeatureSupportLevelIDEXTERNALPLATFORMOCI,
 		models.FeatureSupportLevelIDBAREMETALPLATFORM,
 		models.FeatureSupportLevelIDNONEPLATFORM,
@@ -131,6 +131,7 @@ var _ = Describe("GetFeatureSupportList API", func() {
 				models.PlatformTypeBaremetal,
 				models.PlatformTypeVsphere,
 				models.PlatformTypeNutanix,
+				models.PlatformTypeOpenstack,
 			}
 
 			for i := range platformTypes {
@@ -180,8 +181,8 @@ var _ = Describe("GetFeatureSupportList API", func() {
 			ociFeature := findFeatureByID(features, models.FeatureSupportLevelIDEXTERNALPLATFORMOCI)
 			Expect(ociFeature).ToNot(BeNil())
 
-			// Should have 50 features when no platform is specified
-			Expect(len(features)).To(Equal(50))
+			// Should have 51 features when no platform is specified
+			Expect(len(features)).To(Equal(51))
 		})
 	})
 
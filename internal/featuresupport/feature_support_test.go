@@ -19,6 +19,7 @@ func getPlatformFilters() []SupportLevelFilters {
 	return []SupportLevelFilters{
 		{PlatformType: models.PlatformTypeVsphere.Pointer()},
 		{PlatformType: models.PlatformTypeNutanix.Pointer()},
+		{PlatformType: models.PlatformTypeOpenstack.Pointer()},
 		{PlatformType: models.PlatformTypeBaremetal.Pointer()},
 		{PlatformType: models.PlatformTypeNone.Pointer()},
 		{PlatformType: models.PlatformTypeExternal.Pointer()},
@@ -547,6 +548,7 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 			},
 			Entry("Unavailable with Nutanix platform", models.PlatformTypeNutanix.Pointer(), "4.12", models.SupportLevelUnavailable),
 			Entry("Unavailable with Vsphere platform", models.PlatformTypeVsphere.Pointer(), "4.12", models.SupportLevelUnavailable),
+			Entry("Unavailable with OpenStack platform", models.PlatformTypeOpenstack.Pointer(), "4.12", models.SupportLevelUnavailable),
 			Entry("Unavailable with External platform", models.PlatformTypeExternal.Pointer(), "4.12", models.SupportLevelUnavailable),
 			Entry("Tech preview with Baremetal platform", models.PlatformTypeBaremetal.Pointer(), "4.12", models.SupportLevelTechPreview),
 			Entry("Tech preview with None platform", models.PlatformTypeNone.Pointer(), "4.12", models.SupportLevelTechPreview),
@@ -566,12 +568,12 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 
 		It("GetFeatureSupportList 4.12", func() {
 			list := GetFeatureSupportList("4.12", nil, nil, nil)
-			Expect(len(list)).To(Equal(50))
+			Expect(len(list)).To(Equal(51))
 		})
 
 		It("GetFeatureSupportList 4.13", func() {
 			list := GetFeatureSupportList("4.13", nil, nil, nil)
-			Expect(len(list)).To(Equal(50))
+			Expect(len(list)).To(Equal(51))
 		})
 
 		It("GetCpuArchitectureSupportList 4.12", func() {
@@ -26,8 +26,8 @@ func (feature *SnoFeature) getSupportLevel(filters SupportLevelFilters) (models.
 		return models.SupportLevelUnavailable, models.IncompatibilityReasonCPUArchitecture
 	}
 
-	// Sno is not available with Nutanix / Vsphere platforms
-	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeVsphere) {
+	// Sno is not available with Nutanix / Vsphere / OpenStack platforms
+	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeOpenstack) {
 		return models.SupportLevelUnavailable, models.IncompatibilityReasonPlatform
 	}
 
@@ -45,6 +45,7 @@ func (feature *SnoFeature) getIncompatibleFeatures(openshiftVersion string) []mo
 		models.FeatureSupportLevelIDODF,
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 		models.FeatureSupportLevelIDCLUSTERMANAGEDNETWORKING,
 		models.FeatureSupportLevelIDVIPAUTOALLOC,
 		models.FeatureSupportLevelIDUSERMANAGEDLOADBALANCER,
@@ -121,6 +122,7 @@ func (feature *TnaFeature) getIncompatibleFeatures(string) []models.FeatureSuppo
 		models.FeatureSupportLevelIDNONEPLATFORM,
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 		models.FeatureSupportLevelIDEXTERNALPLATFORM,
 		models.FeatureSupportLevelIDEXTERNALPLATFORMOCI,
 	}
@@ -358,6 +360,7 @@ func (f *NonStandardHAControlPlane) getIncompatibleFeatures(openshiftVersion str
 		models.FeatureSupportLevelIDEXTERNALPLATFORM,
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 		models.FeatureSupportLevelIDEXTERNALPLATFORMOCI,
 	}
 }
@@ -334,6 +334,7 @@ func (feature *PlatformManagedNetworkingFeature) getIncompatibleFeatures(string)
 	return []models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDBAREMETALPLATFORM,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 	}
 }
@@ -543,8 +544,8 @@ func (feature *DualStackPrimaryIPv6Feature) GetName() string {
 }
 
 func (feature *DualStackPrimaryIPv6Feature) getSupportLevel(filters SupportLevelFilters) (models.SupportLevel, models.IncompatibilityReason) {
-	// Primary IPv6 is not supported on Nutanix, vSphere or External platforms
-	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeExternal) {
+	// Primary IPv6 is not supported on Nutanix, vSphere, OpenStack or External platforms
+	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeOpenstack || *filters.PlatformType == models.PlatformTypeExternal) {
 		return models.SupportLevelUnavailable, models.IncompatibilityReasonPlatform
 	}
 
@@ -586,6 +587,7 @@ func (feature *DualStackPrimaryIPv6Feature) getIncompatibleFeatures(openshiftVer
 		models.FeatureSupportLevelIDEXTERNALPLATFORMOCI,
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 	}
 
 	// Primary IPv6 isn't supported for SNO versions < 4.19
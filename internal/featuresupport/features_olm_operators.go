@@ -72,7 +72,7 @@ func (feature *LvmFeature) getSupportLevel(filters SupportLevelFilters) (models.
 		return models.SupportLevelUnavailable, models.IncompatibilityReasonOpenshiftVersion
 	}
 
-	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeNutanix) {
+	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeOpenstack || *filters.PlatformType == models.PlatformTypeNutanix) {
 		return models.SupportLevelUnavailable, models.IncompatibilityReasonPlatform
 	}
 
@@ -94,6 +94,7 @@ func (feature *LvmFeature) getIncompatibleFeatures(OCPVersion string) []models.F
 	incompatibleFeatures := []models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 		models.FeatureSupportLevelIDODF,
 	}
 	if isEqual, _ := common.BaseVersionLessThan("4.15", OCPVersion); isEqual {
@@ -181,7 +182,7 @@ func (feature *CnvFeature) getSupportLevel(filters SupportLevelFilters) (models.
 		return models.SupportLevelUnavailable, models.IncompatibilityReasonCPUArchitecture
 	}
 
-	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeVsphere) {
+	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeOpenstack) {
 		return models.SupportLevelUnavailable, models.IncompatibilityReasonPlatform
 	}
 
@@ -196,6 +197,7 @@ func (feature *CnvFeature) getIncompatibleFeatures(string) []models.FeatureSuppo
 	return []models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 	}
 }
 
@@ -327,7 +329,7 @@ func (feature *MtvFeature) getSupportLevel(filters SupportLevelFilters) (models.
 		return models.SupportLevelUnavailable, models.IncompatibilityReasonCPUArchitecture
 	}
 
-	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeNutanix) {
+	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeOpenstack || *filters.PlatformType == models.PlatformTypeNutanix) {
 		return models.SupportLevelUnavailable, models.IncompatibilityReasonPlatform
 	}
 
@@ -351,6 +353,7 @@ func (feature *MtvFeature) getIncompatibleFeatures(string) []models.FeatureSuppo
 	return []models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 	}
 }
 
@@ -671,7 +674,7 @@ func (feature *OscFeature) getSupportLevel(filters SupportLevelFilters) (models.
 		return models.SupportLevelUnavailable, models.IncompatibilityReasonCPUArchitecture
 	}
 
-	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeNutanix) {
+	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeOpenstack || *filters.PlatformType == models.PlatformTypeNutanix) {
 		return models.SupportLevelUnavailable, models.IncompatibilityReasonPlatform
 	}
 
@@ -695,6 +698,7 @@ func (feature *OscFeature) getIncompatibleFeatures(string) []models.FeatureSuppo
 	return []models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 	}
 }
 
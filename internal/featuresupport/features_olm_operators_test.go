@@ -113,6 +113,7 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 			incompatibleFeatures["4.11"] = []models.FeatureSupportLevelID{
 				models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 				models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+				models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 				models.FeatureSupportLevelIDODF,
 				models.FeatureSupportLevelIDVIPAUTOALLOC,
 				models.FeatureSupportLevelIDCLUSTERMANAGEDNETWORKING,
@@ -121,6 +122,7 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 			incompatibleFeatures["4.12"] = []models.FeatureSupportLevelID{
 				models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 				models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+				models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 				models.FeatureSupportLevelIDODF,
 				models.FeatureSupportLevelIDVIPAUTOALLOC,
 				models.FeatureSupportLevelIDCLUSTERMANAGEDNETWORKING,
@@ -129,11 +131,13 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 			incompatibleFeatures["4.15"] = []models.FeatureSupportLevelID{
 				models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 				models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+				models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 				models.FeatureSupportLevelIDODF,
 			}
 			incompatibleFeatures["4.16.0-rc0"] = []models.FeatureSupportLevelID{
 				models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 				models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+				models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 				models.FeatureSupportLevelIDODF,
 			}
 
@@ -291,6 +295,7 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 			}
 		},
 			Entry("on Vsphere", "4.14", common.X86CPUArchitecture, models.PlatformTypeVsphere, models.SupportLevelUnavailable),
+			Entry("on OpenStack", "4.14", common.X86CPUArchitecture, models.PlatformTypeOpenstack, models.SupportLevelUnavailable),
 			Entry("on Nutanix", "4.14", common.X86CPUArchitecture, models.PlatformTypeNutanix, models.SupportLevelUnavailable),
 			Entry("on none", "4.14", common.X86CPUArchitecture, models.PlatformTypeNone, models.SupportLevelSupported),
 			Entry("on baremetal", "4.14", common.X86CPUArchitecture, models.PlatformTypeBaremetal, models.SupportLevelSupported),
@@ -264,6 +264,62 @@ func (feature *VsphereIntegrationFeature) getIncompatibleArchitectures(_ *string
 	}
 }
 
+// OpenstackIntegrationFeature
+type OpenstackIntegrationFeature struct{}
+
+func (feature *OpenstackIntegrationFeature) New() SupportLevelFeature {
+	return &OpenstackIntegrationFeature{}
+}
+
+func (feature *OpenstackIntegrationFeature) getId() models.FeatureSupportLevelID {
+	return models.FeatureSupportLevelIDOPENSTACKINTEGRATION
+}
+
+func (feature *OpenstackIntegrationFeature) GetName() string {
+	return "OpenStack Platform Integration"
+}
+
+func (feature *OpenstackIntegrationFeature) getSupportLevel(filters SupportLevelFilters) (models.SupportLevel, models.IncompatibilityReason) {
+	if isPlatformSet(filters) {
+		return "", ""
+	}
+
+	if !isFeatureCompatibleWithArchitecture(feature, filters.OpenshiftVersion, swag.StringValue(filters.CPUArchitecture)) {
+		return models.SupportLevelUnavailable, models.IncompatibilityReasonCPUArchitecture
+	}
+
+	return models.SupportLevelTechPreview, ""
+}
+
+func (feature *OpenstackIntegrationFeature) getFeatureActiveLevel(cluster *common.Cluster, _ *models.InfraEnv, clusterUpdateParams *models.V2ClusterUpdateParams, _ *models.InfraEnvUpdateParams) featureActiveLevel {
+	if isPlatformActive(cluster, clusterUpdateParams, models.PlatformTypeOpenstack) {
+		return activeLevelActive
+	}
+
+	return activeLevelNotActive
+}
+
+func (feature *OpenstackIntegrationFeature) getIncompatibleFeatures(_ string) []models.FeatureSupportLevelID {
+	return []models.FeatureSupportLevelID{
+		models.FeatureSupportLevelIDSNO,
+		models.FeatureSupportLevelIDTNA,
+		models.FeatureSupportLevelIDLVM,
+		models.FeatureSupportLevelIDPLATFORMMANAGEDNETWORKING,
+		models.FeatureSupportLevelIDCNV,
+		models.FeatureSupportLevelIDMTV,
+		models.FeatureSupportLevelIDNONSTANDARDHACONTROLPLANE,
+		models.FeatureSupportLevelIDOSC,
+		models.FeatureSupportLevelIDDUALSTACKPRIMARYIPV6,
+	}
+}
+
+func (feature *OpenstackIntegrationFeature) getIncompatibleArchitectures(_ *string) []models.ArchitectureSupportLevelID {
+	return []models.ArchitectureSupportLevelID{
+		models.ArchitectureSupportLevelIDS390XARCHITECTURE,
+		models.ArchitectureSupportLevelIDPPC64LEARCHITECTURE,
+	}
+}
+
 // OciIntegrationFeature
 type OciIntegrationFeature struct{}
 
@@ -48,6 +48,12 @@ var _ = Describe("IsPlatformSupported API", func() {
 			Entry("vsphere arm64 4.13", models.PlatformTypeVsphere, nil, "4.13.0", "arm64", true),
 			Entry("vsphere s390x 4.13", models.PlatformTypeVsphere, nil, "4.13.0", "s390x", false),
 			Entry("vsphere ppc64le 4.13", models.PlatformTypeVsphere, nil, "4.13.0", "ppc64le", false),
+
+			// OpenStack platform
+			Entry("openstack x86_64 4.13", models.PlatformTypeOpenstack, nil, "4.13.0", "x86_64", true),
+			Entry("openstack arm64 4.13", models.PlatformTypeOpenstack, nil, "4.13.0", "arm64", true),
+			Entry("openstack s390x 4.13", models.PlatformTypeOpenstack, nil, "4.13.0", "s390x", false),
+			Entry("openstack ppc64le 4.13", models.PlatformTypeOpenstack, nil, "4.13.0", "ppc64le", false),
 		)
 	})
 
@@ -0,0 +1,64 @@
+package gencrypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// ParseEncryptionKey decodes a base64 encoded AES-256 key
+func ParseEncryptionKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode encryption key")
+	}
+	if len(key) != 32 {
+		return nil, errors.Errorf("encryption key must be 32 bytes long, got %d bytes", len(key))
+	}
+	return key, nil
+}
+
+// Encrypt encrypts the plaintext with AES-GCM and returns the nonce and the ciphertext base64 encoded
+func Encrypt(key []byte, plaintext string) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", errors.Wrap(err, "failed to generate nonce")
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(plaintext), nil)), nil
+}
+
+// Decrypt decrypts a value returned by Encrypt
+func Decrypt(key []byte, encrypted string) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	data, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decode encrypted value")
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", errors.New("encrypted value is too short")
+	}
+	plaintext, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decrypt value")
+	}
+	return string(plaintext), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	return cipher.NewGCM(block)
+}
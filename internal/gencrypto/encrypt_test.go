@@ -0,0 +1,44 @@
+package gencrypto
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Encrypt", func() {
+	var key []byte
+
+	BeforeEach(func() {
+		key = make([]byte, 32)
+		_, err := rand.Read(key)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("decrypts the encrypted value", func() {
+		encrypted, err := Encrypt(key, "secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(encrypted).NotTo(ContainSubstring("secret"))
+		decrypted, err := Decrypt(key, encrypted)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decrypted).To(Equal("secret"))
+	})
+
+	It("fails to decrypt with another key", func() {
+		encrypted, err := Encrypt(key, "secret")
+		Expect(err).NotTo(HaveOccurred())
+		otherKey := make([]byte, 32)
+		_, err = Decrypt(otherKey, encrypted)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("parses a base64 encoded key", func() {
+		parsed, err := ParseEncryptionKey(base64.StdEncoding.EncodeToString(key))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed).To(Equal(key))
+		_, err = ParseEncryptionKey(base64.StdEncoding.EncodeToString(key[:16]))
+		Expect(err).To(HaveOccurred())
+	})
+})
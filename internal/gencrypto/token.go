@@ -48,6 +48,34 @@ func LocalJWTForKey(id string, private_key_pem string, keyType LocalJWTKeyType)
 	return tokenString, nil
 }
 
+// MaxLocalUserJWTLifetime is the longest lifetime of the administrator tokens of the local authentication, they can't
+// be revoked
+const MaxLocalUserJWTLifetime = time.Hour
+
+// LocalUserJWT returns an administrator token of the local authentication, signed by the key of the service, that
+// expires after lifetime
+func LocalUserJWT(lifetime time.Duration) (string, error) {
+	if lifetime <= 0 || lifetime > MaxLocalUserJWTLifetime {
+		return "", errors.Errorf("the lifetime of the token must be positive and at most %s", MaxLocalUserJWTLifetime)
+	}
+	key, ok := os.LookupEnv("EC_PRIVATE_KEY_PEM")
+	if !ok || key == "" {
+		return "", errors.Errorf("EC_PRIVATE_KEY_PEM not found")
+	}
+	priv, err := jwt.ParseECPrivateKeyFromPEM([]byte(key))
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"auth_scheme": "userAuth",
+		"iat":         now.Unix(),
+		"exp":         now.Add(lifetime).Unix(),
+	})
+	return token.SignedString(priv)
+}
+
 func SignURL(urlString string, id string, keyType LocalJWTKeyType) (string, error) {
 	tok, err := LocalJWT(id, keyType)
 	if err != nil {
@@ -96,6 +96,26 @@ var _ = Context("with an ECDSA key pair", func() {
 
 		validateToken(tokenString, publicKey, id)
 	})
+
+	It("LocalUserJWT creates a short-lived administrator token", func() {
+		os.Setenv("EC_PRIVATE_KEY_PEM", privateKeyPEM)
+		defer os.Unsetenv("EC_PRIVATE_KEY_PEM")
+		tokenString, err := LocalUserJWT(15 * time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+
+		parser := &jwt.Parser{ValidMethods: []string{jwt.SigningMethodES256.Alg()}}
+		parsed, err := parser.Parse(tokenString, func(t *jwt.Token) (interface{}, error) { return publicKey, nil })
+		Expect(err).ToNot(HaveOccurred())
+		claims := parsed.Claims.(jwt.MapClaims)
+		Expect(claims["auth_scheme"]).To(Equal("userAuth"))
+		Expect(claims.VerifyExpiresAt(time.Now().Add(14*time.Minute).Unix(), true)).To(BeTrue())
+		Expect(claims.VerifyExpiresAt(time.Now().Add(16*time.Minute).Unix(), true)).To(BeFalse())
+
+		_, err = LocalUserJWT(MaxLocalUserJWTLifetime + time.Minute)
+		Expect(err).To(HaveOccurred())
+		_, err = LocalUserJWT(-time.Minute)
+		Expect(err).To(HaveOccurred())
+	})
 })
 
 var _ = Describe("JWTForSymmetricKey", func() {
@@ -0,0 +1,167 @@
+package firmware
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/openshift/assisted-service/internal/hardware/virt"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	BootModeUEFI   = "uefi"
+	BootModeLegacy = "bios"
+
+	SecureBootEnabled  = "enabled"
+	SecureBootDisabled = "disabled"
+)
+
+// Policy is the firmware settings that hosts must have. Settings that aren't set aren't checked.
+type Policy struct {
+	// BootMode is the boot mode the hosts must boot in, uefi or bios
+	BootMode string `json:"boot_mode,omitempty"`
+	// SecureBoot is the state of Secure Boot that the hosts must have, enabled or disabled
+	SecureBoot string `json:"secure_boot,omitempty"`
+	// Virtualization requires the virtualization extensions of the CPU, VT-x or AMD-V
+	Virtualization bool `json:"virtualization,omitempty"`
+	// MinSRIOVNics is the number of network interfaces that must support SR-IOV
+	MinSRIOVNics int64 `json:"min_sriov_nics,omitempty"`
+	// MinBIOSVersions maps the system manufacturers to the minimal version of the BIOS of their machines
+	MinBIOSVersions map[string]string `json:"min_bios_versions,omitempty"`
+	// Roles are the roles of the hosts that the policy applies to, defaults to all the roles
+	Roles []models.HostRole `json:"roles,omitempty"`
+}
+
+// PolicyDecoder is decoded from a JSON firmware policy
+type PolicyDecoder Policy
+
+func (d *PolicyDecoder) Decode(value string) error {
+	var policy Policy
+	if strings.TrimSpace(value) != "" {
+		if err := json.Unmarshal([]byte(value), &policy); err != nil {
+			return errors.Wrap(err, "failed to parse the firmware policy")
+		}
+	}
+	if err := policy.validate(); err != nil {
+		return err
+	}
+	*d = PolicyDecoder(policy)
+	return nil
+}
+
+func (p *Policy) validate() error {
+	if p.BootMode != "" && p.BootMode != BootModeUEFI && p.BootMode != BootModeLegacy {
+		return errors.Errorf("invalid firmware policy boot mode %q, expected %s or %s", p.BootMode, BootModeUEFI, BootModeLegacy)
+	}
+	if p.SecureBoot != "" && p.SecureBoot != SecureBootEnabled && p.SecureBoot != SecureBootDisabled {
+		return errors.Errorf("invalid firmware policy Secure Boot state %q, expected %s or %s", p.SecureBoot,
+			SecureBootEnabled, SecureBootDisabled)
+	}
+	if p.MinSRIOVNics < 0 {
+		return errors.Errorf("the number of SR-IOV network interfaces of the firmware policy must not be negative")
+	}
+	for manufacturer, minVersion := range p.MinBIOSVersions {
+		if _, err := version.NewVersion(minVersion); err != nil {
+			return errors.Wrapf(err, "invalid minimal BIOS version %q of manufacturer %s", minVersion, manufacturer)
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns true if the policy doesn't check any setting
+func (p *Policy) IsEmpty() bool {
+	return p.BootMode == "" && p.SecureBoot == "" && !p.Virtualization && p.MinSRIOVNics == 0 && len(p.MinBIOSVersions) == 0
+}
+
+// AppliesTo returns true if the policy applies to hosts of the role
+func (p *Policy) AppliesTo(role models.HostRole) bool {
+	return len(p.Roles) == 0 || slices.Contains(p.Roles, role)
+}
+
+// Check returns the reasons the inventory of a host doesn't comply with the policy, or nothing if it complies
+func (p *Policy) Check(inventory *models.Inventory) []string {
+	var reasons []string
+	if p.BootMode != "" {
+		bootMode := ""
+		if inventory.Boot != nil {
+			bootMode = strings.ToLower(inventory.Boot.CurrentBootMode)
+		}
+		if bootMode != p.BootMode {
+			reasons = append(reasons, fmt.Sprintf("The host must boot in %s mode, but it booted in %s mode", bootModeName(p.BootMode),
+				bootModeName(bootMode)))
+		}
+	}
+	if p.SecureBoot != "" {
+		state := models.SecureBootStateUnknown
+		if inventory.Boot != nil && inventory.Boot.SecureBootState != "" {
+			state = inventory.Boot.SecureBootState
+		}
+		if !strings.EqualFold(string(state), p.SecureBoot) {
+			reasons = append(reasons, fmt.Sprintf("Secure Boot must be %s, but its state is %s", p.SecureBoot, state))
+		}
+	}
+	if p.Virtualization && (inventory.CPU == nil || !virt.IsVirtSupported(inventory)) {
+		reasons = append(reasons, "CPU does not have virtualization support")
+	}
+	if p.MinSRIOVNics > 0 {
+		if count := sriovNicCount(inventory); count < p.MinSRIOVNics {
+			reasons = append(reasons, fmt.Sprintf("The host must have %d SR-IOV capable network interfaces, but it has %d",
+				p.MinSRIOVNics, count))
+		}
+	}
+	if reason := p.checkBIOSVersion(inventory); reason != "" {
+		reasons = append(reasons, reason)
+	}
+	return reasons
+}
+
+func (p *Policy) checkBIOSVersion(inventory *models.Inventory) string {
+	if inventory.SystemVendor == nil {
+		return ""
+	}
+	minVersion, ok := p.MinBIOSVersions[inventory.SystemVendor.Manufacturer]
+	if !ok {
+		return ""
+	}
+	if inventory.Bios == nil || inventory.Bios.Version == "" {
+		return fmt.Sprintf("The BIOS version of the host is unknown, %s machines require version %s or newer",
+			inventory.SystemVendor.Manufacturer, minVersion)
+	}
+	current, err := version.NewVersion(inventory.Bios.Version)
+	if err != nil {
+		return fmt.Sprintf("The BIOS version %s of the host can't be compared to the required version %s",
+			inventory.Bios.Version, minVersion)
+	}
+	if current.LessThan(version.Must(version.NewVersion(minVersion))) {
+		return fmt.Sprintf("The BIOS version %s of the host is older than the required version %s", inventory.Bios.Version,
+			minVersion)
+	}
+	return ""
+}
+
+func sriovNicCount(inventory *models.Inventory) int64 {
+	var count int64
+	for _, iface := range inventory.Interfaces {
+		if iface.SriovTotalVfs > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+func bootModeName(bootMode string) string {
+	switch bootMode {
+	case BootModeUEFI:
+		return "UEFI"
+	case BootModeLegacy:
+		return "legacy BIOS"
+	case "":
+		return "an unknown"
+	default:
+		return bootMode
+	}
+}
@@ -0,0 +1,115 @@
+package firmware
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+func TestFirmware(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Firmware policy tests")
+}
+
+var _ = Describe("PolicyDecoder", func() {
+	It("decodes an empty policy", func() {
+		var decoder PolicyDecoder
+		Expect(decoder.Decode("")).To(Succeed())
+		policy := Policy(decoder)
+		Expect(policy.IsEmpty()).To(BeTrue())
+	})
+
+	It("decodes a policy", func() {
+		var decoder PolicyDecoder
+		Expect(decoder.Decode(`{"boot_mode": "uefi", "secure_boot": "enabled", "virtualization": true,
+			"min_sriov_nics": 1, "min_bios_versions": {"Dell Inc.": "2.14.1"}, "roles": ["worker"]}`)).To(Succeed())
+		Expect(Policy(decoder)).To(Equal(Policy{
+			BootMode:        BootModeUEFI,
+			SecureBoot:      SecureBootEnabled,
+			Virtualization:  true,
+			MinSRIOVNics:    1,
+			MinBIOSVersions: map[string]string{"Dell Inc.": "2.14.1"},
+			Roles:           []models.HostRole{models.HostRoleWorker},
+		}))
+	})
+
+	It("rejects invalid policies", func() {
+		var decoder PolicyDecoder
+		Expect(decoder.Decode(`{"boot_mode": "efi"}`)).ToNot(Succeed())
+		Expect(decoder.Decode(`{"secure_boot": "on"}`)).ToNot(Succeed())
+		Expect(decoder.Decode(`{"min_sriov_nics": -1}`)).ToNot(Succeed())
+		Expect(decoder.Decode(`{"min_bios_versions": {"Dell Inc.": "latest"}}`)).ToNot(Succeed())
+		Expect(decoder.Decode(`[]`)).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("Policy", func() {
+	var inventory *models.Inventory
+
+	BeforeEach(func() {
+		inventory = &models.Inventory{
+			Boot:         &models.Boot{CurrentBootMode: "uefi", SecureBootState: models.SecureBootStateEnabled},
+			CPU:          &models.CPU{Architecture: models.ClusterCPUArchitectureX8664, Flags: []string{"fpu", "vmx"}},
+			Interfaces:   []*models.Interface{{Name: "eth0", SriovTotalVfs: 64}, {Name: "eth1"}},
+			SystemVendor: &models.SystemVendor{Manufacturer: "Dell Inc."},
+			Bios:         &models.Bios{Vendor: "Dell Inc.", Version: "2.15.0"},
+		}
+	})
+
+	It("accepts a compliant host", func() {
+		policy := Policy{
+			BootMode:        BootModeUEFI,
+			SecureBoot:      SecureBootEnabled,
+			Virtualization:  true,
+			MinSRIOVNics:    1,
+			MinBIOSVersions: map[string]string{"Dell Inc.": "2.14.1", "HPE": "3.0"},
+		}
+		Expect(policy.Check(inventory)).To(BeEmpty())
+	})
+
+	It("returns all the reasons a host doesn't comply", func() {
+		inventory.Boot = &models.Boot{CurrentBootMode: "bios", SecureBootState: models.SecureBootStateNotSupported}
+		inventory.CPU.Flags = []string{"fpu"}
+		inventory.Bios.Version = "2.9.3"
+		policy := Policy{
+			BootMode:        BootModeUEFI,
+			SecureBoot:      SecureBootEnabled,
+			Virtualization:  true,
+			MinSRIOVNics:    2,
+			MinBIOSVersions: map[string]string{"Dell Inc.": "2.14.1"},
+		}
+		Expect(policy.Check(inventory)).To(Equal([]string{
+			"The host must boot in UEFI mode, but it booted in legacy BIOS mode",
+			"Secure Boot must be enabled, but its state is NotSupported",
+			"CPU does not have virtualization support",
+			"The host must have 2 SR-IOV capable network interfaces, but it has 1",
+			"The BIOS version 2.9.3 of the host is older than the required version 2.14.1",
+		}))
+	})
+
+	It("doesn't comply when the firmware settings are unknown", func() {
+		inventory.Boot = nil
+		inventory.Bios = nil
+		policy := Policy{BootMode: BootModeLegacy, SecureBoot: SecureBootDisabled, MinBIOSVersions: map[string]string{"Dell Inc.": "2.14.1"}}
+		Expect(policy.Check(inventory)).To(Equal([]string{
+			"The host must boot in legacy BIOS mode, but it booted in an unknown mode",
+			"Secure Boot must be disabled, but its state is Unknown",
+			"The BIOS version of the host is unknown, Dell Inc. machines require version 2.14.1 or newer",
+		}))
+	})
+
+	It("doesn't compare BIOS versions that aren't versions", func() {
+		inventory.Bios.Version = "U30"
+		policy := Policy{MinBIOSVersions: map[string]string{"Dell Inc.": "2.14.1"}}
+		Expect(policy.Check(inventory)).To(Equal([]string{"The BIOS version U30 of the host can't be compared to the required version 2.14.1"}))
+	})
+
+	It("applies to the roles of the policy", func() {
+		Expect((&Policy{}).AppliesTo(models.HostRoleMaster)).To(BeTrue())
+		policy := Policy{Roles: []models.HostRole{models.HostRoleWorker}}
+		Expect(policy.AppliesTo(models.HostRoleWorker)).To(BeTrue())
+		Expect(policy.AppliesTo(models.HostRoleMaster)).To(BeFalse())
+	})
+})
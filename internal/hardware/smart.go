@@ -0,0 +1,98 @@
+package hardware
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+const (
+	smartAttributeReallocatedSectors = 5
+	smartAttributePendingSectors     = 197
+)
+
+// smartctlOutput is the part of the output of smartctl --json that the health of a disk is derived from
+type smartctlOutput struct {
+	SmartSupport *struct {
+		Available bool `json:"available"`
+	} `json:"smart_support"`
+	SmartStatus *struct {
+		Passed bool `json:"passed"`
+	} `json:"smart_status"`
+	AtaSmartAttributes *struct {
+		Table []struct {
+			ID         int    `json:"id"`
+			WhenFailed string `json:"when_failed"`
+			Raw        struct {
+				Value int64 `json:"value"`
+			} `json:"raw"`
+		} `json:"table"`
+	} `json:"ata_smart_attributes"`
+	NvmeSmartHealthInformationLog *struct {
+		CriticalWarning int64 `json:"critical_warning"`
+		MediaErrors     int64 `json:"media_errors"`
+		PercentageUsed  int64 `json:"percentage_used"`
+		Temperature     int64 `json:"temperature"`
+		PowerOnHours    int64 `json:"power_on_hours"`
+	} `json:"nvme_smart_health_information_log"`
+	ScsiGrownDefectList *int64 `json:"scsi_grown_defect_list"`
+	Temperature         *struct {
+		Current int64 `json:"current"`
+	} `json:"temperature"`
+	PowerOnTime *struct {
+		Hours int64 `json:"hours"`
+	} `json:"power_on_time"`
+}
+
+// ParseSmartHealth derives the health of a disk from the SMART data that the agent reports for it. Only the JSON
+// output of smartctl is understood, nil is returned for any other output and for disks that don't support SMART.
+func ParseSmartHealth(smart string) *models.DiskHealth {
+	if !strings.HasPrefix(strings.TrimSpace(smart), "{") {
+		return nil
+	}
+	var output smartctlOutput
+	if err := json.Unmarshal([]byte(smart), &output); err != nil {
+		return nil
+	}
+	if output.SmartStatus == nil || (output.SmartSupport != nil && !output.SmartSupport.Available) {
+		return nil
+	}
+
+	health := &models.DiskHealth{
+		SmartPassed:      output.SmartStatus.Passed,
+		PredictedFailure: !output.SmartStatus.Passed,
+	}
+	if output.AtaSmartAttributes != nil {
+		for _, attribute := range output.AtaSmartAttributes.Table {
+			switch attribute.ID {
+			case smartAttributeReallocatedSectors:
+				health.ReallocatedSectors = attribute.Raw.Value
+			case smartAttributePendingSectors:
+				health.PendingSectors = attribute.Raw.Value
+			}
+			if attribute.WhenFailed == "now" {
+				health.PredictedFailure = true
+			}
+		}
+	}
+	if log := output.NvmeSmartHealthInformationLog; log != nil {
+		health.MediaErrors = log.MediaErrors
+		health.PercentageUsed = log.PercentageUsed
+		health.TemperatureCelsius = log.Temperature
+		health.PowerOnHours = log.PowerOnHours
+		if log.CriticalWarning != 0 {
+			health.PredictedFailure = true
+		}
+	}
+	if output.ScsiGrownDefectList != nil {
+		health.ReallocatedSectors = *output.ScsiGrownDefectList
+	}
+	if output.Temperature != nil {
+		health.TemperatureCelsius = output.Temperature.Current
+	}
+	if output.PowerOnTime != nil {
+		health.PowerOnHours = output.PowerOnTime.Hours
+	}
+	return health
+}
@@ -0,0 +1,72 @@
+package hardware
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("ParseSmartHealth", func() {
+	It("parses the attributes of an ATA disk", func() {
+		smart := `{
+			"smart_support": {"available": true, "enabled": true},
+			"smart_status": {"passed": true},
+			"ata_smart_attributes": {"table": [
+				{"id": 5, "name": "Reallocated_Sector_Ct", "when_failed": "", "raw": {"value": 8}},
+				{"id": 9, "name": "Power_On_Hours", "when_failed": "", "raw": {"value": 1200}},
+				{"id": 197, "name": "Current_Pending_Sector", "when_failed": "", "raw": {"value": 2}}
+			]},
+			"temperature": {"current": 34},
+			"power_on_time": {"hours": 1200}
+		}`
+		Expect(ParseSmartHealth(smart)).To(Equal(&models.DiskHealth{
+			SmartPassed:        true,
+			ReallocatedSectors: 8,
+			PendingSectors:     2,
+			TemperatureCelsius: 34,
+			PowerOnHours:       1200,
+		}))
+	})
+
+	It("predicts the failure of an ATA disk with a failing attribute", func() {
+		smart := `{
+			"smart_status": {"passed": true},
+			"ata_smart_attributes": {"table": [{"id": 184, "when_failed": "now", "raw": {"value": 1}}]}
+		}`
+		health := ParseSmartHealth(smart)
+		Expect(health).ToNot(BeNil())
+		Expect(health.SmartPassed).To(BeTrue())
+		Expect(health.PredictedFailure).To(BeTrue())
+	})
+
+	It("parses the health information log of an NVMe disk", func() {
+		smart := `{
+			"smart_support": {"available": true},
+			"smart_status": {"passed": false},
+			"nvme_smart_health_information_log": {
+				"critical_warning": 4, "temperature": 41, "percentage_used": 97, "power_on_hours": 30000, "media_errors": 3
+			}
+		}`
+		Expect(ParseSmartHealth(smart)).To(Equal(&models.DiskHealth{
+			PredictedFailure:   true,
+			MediaErrors:        3,
+			PercentageUsed:     97,
+			TemperatureCelsius: 41,
+			PowerOnHours:       30000,
+		}))
+	})
+
+	It("counts the grown defects of a SCSI disk as reallocated sectors", func() {
+		health := ParseSmartHealth(`{"smart_status": {"passed": true}, "scsi_grown_defect_list": 12}`)
+		Expect(health).ToNot(BeNil())
+		Expect(health.ReallocatedSectors).To(Equal(int64(12)))
+	})
+
+	It("returns nil for disks without SMART data", func() {
+		Expect(ParseSmartHealth("")).To(BeNil())
+		Expect(ParseSmartHealth("SMART support is: Unavailable - device lacks SMART capability.")).To(BeNil())
+		Expect(ParseSmartHealth(`{"smart_support": {"available": false}, "smart_status": {"passed": true}}`)).To(BeNil())
+		Expect(ParseSmartHealth(`{"device": {"name": "/dev/vda"}}`)).To(BeNil())
+		Expect(ParseSmartHealth(`{"smart_status":`)).To(BeNil())
+	})
+})
@@ -15,12 +15,14 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/feature"
+	"github.com/openshift/assisted-service/internal/hardware/firmware"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/openshift/assisted-service/pkg/tang"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -86,6 +88,7 @@ type ValidatorCfg struct {
 	MaxHostDisconnectionTime      time.Duration                `envconfig:"HOST_MAX_DISCONNECTION_TIME" default:"3m"`
 	AgentDockerImage              string                       `envconfig:"AGENT_DOCKER_IMAGE" default:"quay.io/edge-infrastructure/assisted-installer-agent:latest"`
 	EdgeWorkerProductNames        string                       `envconfig:"EDGE_WORKERS_PRODUCT_NAMES" default:"BlueField SoC"`
+	FirmwarePolicy                firmware.PolicyDecoder       `envconfig:"FIRMWARE_POLICY" default:""`
 }
 
 type validator struct {
@@ -360,9 +363,17 @@ func (v *validator) GetInfraEnvHostRequirements(ctx context.Context, infraEnv *c
 }
 
 func isDiskEncryptionSetWithTpm(c *common.Cluster) bool {
-	return c.DiskEncryption != nil &&
-		swag.StringValue(c.DiskEncryption.EnableOn) != models.DiskEncryptionEnableOnNone &&
-		swag.StringValue(c.DiskEncryption.Mode) == models.DiskEncryptionModeTpmv2
+	if c.DiskEncryption == nil || swag.StringValue(c.DiskEncryption.EnableOn) == models.DiskEncryptionEnableOnNone {
+		return false
+	}
+	switch swag.StringValue(c.DiskEncryption.Mode) {
+	case models.DiskEncryptionModeTpmv2:
+		return true
+	case models.DiskEncryptionModeThreshold:
+		spec, err := tang.UnmarshalThresholdSpec(c.DiskEncryption.ThresholdSpec)
+		return err == nil && spec.TPM2
+	}
+	return false
 }
 
 func (v *validator) GetPreflightHardwareRequirements(ctx context.Context, cluster *common.Cluster) (*models.PreflightHardwareRequirements, error) {
@@ -450,6 +461,13 @@ func totalizeRequirements(ocpRequirements models.ClusterHostRequirementsDetails,
 				total.PacketLossPercentage = ptr.To(math.Min(*total.PacketLossPercentage, *details.PacketLossPercentage))
 			}
 		}
+		if details.NetworkThroughputMbps != nil && *details.NetworkThroughputMbps > 0 {
+			if total.NetworkThroughputMbps == nil {
+				total.NetworkThroughputMbps = details.NetworkThroughputMbps
+			} else {
+				total.NetworkThroughputMbps = ptr.To(math.Max(*total.NetworkThroughputMbps, *details.NetworkThroughputMbps))
+			}
+		}
 	}
 	return total
 }
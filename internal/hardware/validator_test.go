@@ -1178,6 +1178,21 @@ var _ = Describe("Cluster host requirements", func() {
 		Expect(result.Total.PacketLossPercentage).To(Equal(details1.PacketLossPercentage))
 	})
 
+	It("should require the highest network throughput of the operators", func() {
+		details1.NetworkThroughputMbps = ptr.To(float64(1000))
+		details2.NetworkThroughputMbps = ptr.To(float64(10000))
+		id1 := strfmt.UUID(uuid.New().String())
+		host = &models.Host{ID: &id1, ClusterID: cluster.ID, Role: models.HostRoleWorker}
+
+		operatorsMock.EXPECT().GetRequirementsBreakdownForHostInCluster(gomock.Any(), gomock.Eq(cluster), gomock.Eq(host)).Return(operatorRequirements, nil)
+
+		result, err := hwvalidator.GetClusterHostRequirements(context.TODO(), cluster, host)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Ocp.NetworkThroughputMbps).To(BeNil())
+		Expect(result.Total.NetworkThroughputMbps).To(Equal(ptr.To(float64(10000))))
+	})
+
 	It("should contain correct default requirements for sno master host", func() {
 		role := models.HostRoleMaster
 		id1 := strfmt.UUID(uuid.New().String())
@@ -1801,6 +1816,48 @@ var _ = Describe("Preflight host requirements", func() {
 			Expect(result.Ocp.Worker.Quantitative.TpmEnabledInBios).To(BeFalse())
 		})
 
+		It("threshold with TPM - all roles", func() {
+
+			diskEncryptionClusterID := strfmt.UUID(uuid.New().String())
+			diskEncryptionCluster := &common.Cluster{Cluster: models.Cluster{
+				ID:               &diskEncryptionClusterID,
+				OpenshiftVersion: openShiftVersionNotInConfig,
+				DiskEncryption: &models.DiskEncryption{
+					EnableOn:      swag.String(models.DiskEncryptionEnableOnAll),
+					Mode:          swag.String(models.DiskEncryptionModeThreshold),
+					ThresholdSpec: `{"threshold":2,"tpm2":true,"tang_threshold":1}`,
+				},
+			}}
+
+			operatorsMock.EXPECT().GetPreflightRequirementsBreakdownForCluster(gomock.Any(), gomock.Eq(diskEncryptionCluster)).Return(operatorRequirements, nil)
+
+			result, err := hwvalidator.GetPreflightHardwareRequirements(context.TODO(), diskEncryptionCluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Ocp.Master.Quantitative.TpmEnabledInBios).To(BeTrue())
+			Expect(result.Ocp.Worker.Quantitative.TpmEnabledInBios).To(BeTrue())
+		})
+
+		It("threshold without TPM - all roles", func() {
+
+			diskEncryptionClusterID := strfmt.UUID(uuid.New().String())
+			diskEncryptionCluster := &common.Cluster{Cluster: models.Cluster{
+				ID:               &diskEncryptionClusterID,
+				OpenshiftVersion: openShiftVersionNotInConfig,
+				DiskEncryption: &models.DiskEncryption{
+					EnableOn:      swag.String(models.DiskEncryptionEnableOnAll),
+					Mode:          swag.String(models.DiskEncryptionModeThreshold),
+					ThresholdSpec: `{"threshold":1,"tpm2":false,"tang_threshold":2}`,
+				},
+			}}
+
+			operatorsMock.EXPECT().GetPreflightRequirementsBreakdownForCluster(gomock.Any(), gomock.Eq(diskEncryptionCluster)).Return(operatorRequirements, nil)
+
+			result, err := hwvalidator.GetPreflightHardwareRequirements(context.TODO(), diskEncryptionCluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Ocp.Master.Quantitative.TpmEnabledInBios).To(BeFalse())
+			Expect(result.Ocp.Worker.Quantitative.TpmEnabledInBios).To(BeFalse())
+		})
+
 		It("Tang - all roles", func() {
 
 			diskEncryptionClusterID := strfmt.UUID(uuid.New().String())
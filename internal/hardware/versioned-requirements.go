@@ -110,5 +110,6 @@ func copyClusterHostRequirementsDetails(details *models.ClusterHostRequirementsD
 		RAMMib:                           details.RAMMib,
 		NetworkLatencyThresholdMs:        details.NetworkLatencyThresholdMs,
 		PacketLossPercentage:             details.PacketLossPercentage,
+		NetworkThroughputMbps:            details.NetworkThroughputMbps,
 	}
 }
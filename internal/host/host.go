@@ -80,6 +80,9 @@ type API interface {
 	UpdateConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
 	UpdateApiVipConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
 	UpdateTangConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
+	UpdateMirrorRegistryCheckReport(ctx context.Context, h *models.Host, mirrorRegistryCheckReport string) error
+	UpdateNetworkThroughputReport(ctx context.Context, h *models.Host, networkThroughputReport string) error
+	UpdateCustomDiagnosticResult(ctx context.Context, h *models.Host, result *models.CustomDiagnosticResult) error
 	HostMonitoring()
 	CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse
 	IsRequireUserActionReset(h *models.Host) bool
@@ -352,6 +355,10 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 		}
 	}
 
+	for _, disk := range inventory.Disks {
+		disk.Health = hardware.ParseSmartHealth(disk.Smart)
+	}
+
 	err = m.populateDisksEligibility(ctx, inventory, infraEnv, cluster, h)
 	if err != nil {
 		log.WithError(err).Errorf("not updating inventory - failed to check disks eligibility for host %s", h.ID)
@@ -724,6 +731,10 @@ func (m *Manager) SetUploadLogsAt(ctx context.Context, h *models.Host, db *gorm.
 }
 
 func (m *Manager) UpdateConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error {
+	connectivityReport, err := keepNetworkThroughput(h.Connectivity, connectivityReport)
+	if err != nil {
+		return errors.Wrapf(err, "failed to keep the network throughput of host %s", h.ID.String())
+	}
 	if h.Connectivity != connectivityReport {
 		updates := map[string]interface{}{"connectivity": connectivityReport}
 
@@ -735,6 +746,78 @@ func (m *Manager) UpdateConnectivityReport(ctx context.Context, h *models.Host,
 	return nil
 }
 
+// keepNetworkThroughput copies the network throughput measured to the remote hosts from the previous connectivity
+// report of a host to the new one, which the connectivity check reports without it
+func keepNetworkThroughput(previousReport, report string) (string, error) {
+	if previousReport == "" || !strings.Contains(previousReport, "throughput_mbps") {
+		return report, nil
+	}
+	previous, err := hostutil.UnmarshalConnectivityReport(previousReport)
+	if err != nil {
+		return "", err
+	}
+	current, err := hostutil.UnmarshalConnectivityReport(report)
+	if err != nil {
+		return "", err
+	}
+	throughputs := map[strfmt.UUID]*float64{}
+	for _, r := range previous.RemoteHosts {
+		if r.ThroughputMbps != nil {
+			throughputs[r.HostID] = r.ThroughputMbps
+		}
+	}
+	for _, r := range current.RemoteHosts {
+		if throughput, ok := throughputs[r.HostID]; ok && r.ThroughputMbps == nil {
+			r.ThroughputMbps = throughput
+		}
+	}
+	return hostutil.MarshalConnectivityReport(current)
+}
+
+// UpdateNetworkThroughputReport sets the network throughput measured to the remote hosts in the connectivity report
+// of the host. The measurements that failed are not set, so that they are attempted again.
+func (m *Manager) UpdateNetworkThroughputReport(ctx context.Context, h *models.Host, networkThroughputReport string) error {
+	var response models.NetworkThroughputCheckResponse
+	if err := json.Unmarshal([]byte(networkThroughputReport), &response); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal the network throughput report of host %s", h.ID.String())
+	}
+	if h.Connectivity == "" {
+		return nil
+	}
+	report, err := hostutil.UnmarshalConnectivityReport(h.Connectivity)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal the connectivity report of host %s", h.ID.String())
+	}
+	updated := false
+	for _, measurement := range response.RemoteHosts {
+		if measurement.HostID == nil {
+			continue
+		}
+		if measurement.Error != "" {
+			m.log.Warnf("failed to measure the network throughput from host %s to host %s: %s",
+				h.ID.String(), measurement.HostID.String(), measurement.Error)
+			continue
+		}
+		for _, r := range report.RemoteHosts {
+			if r.HostID == *measurement.HostID {
+				r.ThroughputMbps = swag.Float64(measurement.ThroughputMbps)
+				updated = true
+			}
+		}
+	}
+	if !updated {
+		return nil
+	}
+	connectivityReport, err := hostutil.MarshalConnectivityReport(report)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal the connectivity report of host %s", h.ID.String())
+	}
+	if err := m.updateHost(ctx, m.db, h, map[string]interface{}{"connectivity": connectivityReport}).Error; err != nil {
+		return errors.Wrapf(err, "failed to set the network throughput to host %s", h.ID.String())
+	}
+	return nil
+}
+
 func (m *Manager) UpdateApiVipConnectivityReport(ctx context.Context, h *models.Host, apiVipConnectivityReport string) error {
 	if h.APIVipConnectivity != apiVipConnectivityReport {
 		updates := map[string]interface{}{"api_vip_connectivity": apiVipConnectivityReport}
@@ -757,6 +840,52 @@ func (m *Manager) UpdateTangConnectivityReport(ctx context.Context, h *models.Ho
 	return nil
 }
 
+// UpdateMirrorRegistryCheckReport merges the images of the report into the ones already reported by the host, since
+// the host is only asked to check the images that weren't available the last time
+func (m *Manager) UpdateMirrorRegistryCheckReport(ctx context.Context, h *models.Host, mirrorRegistryCheckReport string) error {
+	statuses, err := common.UnmarshalMirrorRegistryCheck(h.MirrorRegistryCheck)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal mirror_registry_check of host %s", h.ID.String())
+	}
+	newStatuses, err := common.UnmarshalMirrorRegistryCheck(mirrorRegistryCheckReport)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal mirror registry check report of host %s", h.ID.String())
+	}
+	for name, status := range newStatuses {
+		statuses[name] = status
+	}
+	marshalledStatuses, err := common.MarshalMirrorRegistryCheck(statuses)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal mirror_registry_check of host %s", h.ID.String())
+	}
+	if h.MirrorRegistryCheck != marshalledStatuses {
+		updates := map[string]interface{}{"mirror_registry_check": marshalledStatuses}
+		if err := m.updateHost(ctx, m.db, h, updates).Error; err != nil {
+			return errors.Wrapf(err, "failed to set mirror_registry_check to host %s", h.ID.String())
+		}
+	}
+	return nil
+}
+
+// UpdateCustomDiagnosticResult replaces the result of the custom diagnostic step of the same name, the results of the
+// other steps are kept
+func (m *Manager) UpdateCustomDiagnosticResult(ctx context.Context, h *models.Host, result *models.CustomDiagnosticResult) error {
+	results, err := common.UnmarshalCustomDiagnostics(h.CustomDiagnostics)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal custom_diagnostics of host %s", h.ID.String())
+	}
+	results[result.Name] = result
+	marshalledResults, err := common.MarshalCustomDiagnostics(results)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal custom_diagnostics of host %s", h.ID.String())
+	}
+	updates := map[string]interface{}{"custom_diagnostics": marshalledResults}
+	if err := m.updateHost(ctx, m.db, h, updates).Error; err != nil {
+		return errors.Wrapf(err, "failed to set custom_diagnostics to host %s", h.ID.String())
+	}
+	return nil
+}
+
 func (m *Manager) UpdateRole(ctx context.Context, h *models.Host, role models.HostRole, db *gorm.DB) error {
 	cdb := m.db
 	if db != nil {
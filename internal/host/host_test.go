@@ -4895,6 +4895,76 @@ var _ = Describe("UpdateDomainNameResolution", func() {
 	})
 })
 
+var _ = Describe("UpdateNetworkThroughputReport", func() {
+	var (
+		ctrl                   *gomock.Controller
+		manager                API
+		db                     *gorm.DB
+		dbName                 string
+		host                   models.Host
+		remoteID1, remoteID2   strfmt.UUID
+		hostID, infraEnvID     strfmt.UUID
+		connectivityRemoteHost func(id strfmt.UUID) *models.ConnectivityRemoteHost
+	)
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		db, dbName = common.PrepareTestDB()
+		manager = NewManager(common.GetTestLog(), db, testing.GetDummyNotificationStream(ctrl), nil, nil, nil, nil, nil, defaultConfig, nil, nil, nil, false, nil, nil, false)
+		hostID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		remoteID1 = strfmt.UUID(uuid.New().String())
+		remoteID2 = strfmt.UUID(uuid.New().String())
+		connectivityRemoteHost = func(id strfmt.UUID) *models.ConnectivityRemoteHost {
+			return &models.ConnectivityRemoteHost{
+				HostID:         id,
+				L3Connectivity: []*models.L3Connectivity{{RemoteIPAddress: "10.0.0.1", Successful: true}},
+			}
+		}
+		connectivity, err := hostutil.MarshalConnectivityReport(&models.ConnectivityReport{
+			RemoteHosts: []*models.ConnectivityRemoteHost{connectivityRemoteHost(remoteID1), connectivityRemoteHost(remoteID2)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		host = models.Host{ID: &hostID, InfraEnvID: infraEnvID, Connectivity: connectivity}
+		Expect(db.Create(&host).Error).ToNot(HaveOccurred())
+	})
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	getThroughputs := func() map[strfmt.UUID]*float64 {
+		h, err := common.GetHostFromDB(db, infraEnvID.String(), hostID.String())
+		Expect(err).ToNot(HaveOccurred())
+		report, err := hostutil.UnmarshalConnectivityReport(h.Connectivity)
+		Expect(err).ToNot(HaveOccurred())
+		throughputs := map[strfmt.UUID]*float64{}
+		for _, r := range report.RemoteHosts {
+			throughputs[r.HostID] = r.ThroughputMbps
+		}
+		return throughputs
+	}
+
+	It("sets the measured throughput and keeps it when the connectivity is reported again", func() {
+		response, err := json.Marshal(models.NetworkThroughputCheckResponse{
+			RemoteHosts: []*models.NetworkThroughputRemoteHost{
+				{HostID: &remoteID1, IPAddress: swag.String("10.0.0.1"), ThroughputMbps: 9400},
+				{HostID: &remoteID2, IPAddress: swag.String("10.0.0.2"), Error: "connection refused"},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manager.UpdateNetworkThroughputReport(context.Background(), &host, string(response))).To(Succeed())
+		Expect(getThroughputs()).To(Equal(map[strfmt.UUID]*float64{remoteID1: swag.Float64(9400), remoteID2: nil}))
+
+		h, err := common.GetHostFromDB(db, infraEnvID.String(), hostID.String())
+		Expect(err).ToNot(HaveOccurred())
+		connectivity, err := hostutil.MarshalConnectivityReport(&models.ConnectivityReport{
+			RemoteHosts: []*models.ConnectivityRemoteHost{connectivityRemoteHost(remoteID1), connectivityRemoteHost(remoteID2)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manager.UpdateConnectivityReport(context.Background(), &h.Host, connectivity)).To(Succeed())
+		Expect(getThroughputs()).To(Equal(map[strfmt.UUID]*float64{remoteID1: swag.Float64(9400), remoteID2: nil}))
+	})
+})
+
 var _ = Describe("HandleReclaimBootArtifactDownload", func() {
 	var (
 		ctx           = context.Background()
@@ -0,0 +1,233 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+const (
+	// CustomDiagnosticStepIDPrefix is the prefix of the IDs of the custom diagnostic steps, followed by the name of
+	// the step and a random suffix
+	CustomDiagnosticStepIDPrefix = "custom-diagnostic-"
+
+	defaultCustomDiagnosticTimeoutSeconds = int64(300)
+)
+
+var customDiagnosticNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+// discoveryHostStatuses are the statuses that custom diagnostic steps can run in, before the host is installed
+var discoveryHostStatuses = []string{
+	models.HostStatusDiscovering,
+	models.HostStatusKnown,
+	models.HostStatusInsufficient,
+	models.HostStatusPendingForInput,
+	models.HostStatusDiscoveringUnbound,
+	models.HostStatusKnownUnbound,
+	models.HostStatusInsufficientUnbound,
+}
+
+// CustomDiagnosticStep is an operator defined container that the agent runs on the hosts in discovery
+type CustomDiagnosticStep struct {
+	// Name identifies the step in the custom diagnostics of the host
+	Name string `json:"name"`
+	// Image is the container image to run
+	Image string `json:"image"`
+	// Args are the command and the arguments of the container, the entrypoint of the image is used if empty
+	Args []string `json:"args,omitempty"`
+	// TimeoutSeconds is the time the container may run for, defaults to 300
+	TimeoutSeconds int64 `json:"timeout_seconds,omitempty"`
+	// IntervalSeconds is the time after which the step runs again, the step runs once per host if it isn't set
+	IntervalSeconds int64 `json:"interval_seconds,omitempty"`
+	// HostStatuses are the statuses the step runs in, defaults to all the discovery statuses
+	HostStatuses []string `json:"host_statuses,omitempty"`
+}
+
+// CustomDiagnosticSteps is decoded from a JSON list of custom diagnostic steps
+type CustomDiagnosticSteps []CustomDiagnosticStep
+
+func (c *CustomDiagnosticSteps) Decode(value string) error {
+	steps := CustomDiagnosticSteps{}
+	if strings.TrimSpace(value) == "" {
+		*c = steps
+		return nil
+	}
+	if err := json.Unmarshal([]byte(value), &steps); err != nil {
+		return errors.Wrap(err, "failed to parse the custom diagnostic steps")
+	}
+
+	names := map[string]bool{}
+	for i := range steps {
+		step := &steps[i]
+		if !customDiagnosticNameRegexp.MatchString(step.Name) {
+			return errors.Errorf("invalid custom diagnostic step name %q, expected a lowercase RFC 1123 label", step.Name)
+		}
+		if names[step.Name] {
+			return errors.Errorf("custom diagnostic step %s is defined more than once", step.Name)
+		}
+		names[step.Name] = true
+		if step.Image == "" {
+			return errors.Errorf("the image of custom diagnostic step %s must be set", step.Name)
+		}
+		if step.TimeoutSeconds < 0 || step.IntervalSeconds < 0 {
+			return errors.Errorf("the timeout and interval of custom diagnostic step %s must not be negative", step.Name)
+		}
+		if step.TimeoutSeconds == 0 {
+			step.TimeoutSeconds = defaultCustomDiagnosticTimeoutSeconds
+		}
+		if len(step.HostStatuses) == 0 {
+			step.HostStatuses = discoveryHostStatuses
+		}
+		for _, status := range step.HostStatuses {
+			if !funk.ContainsString(discoveryHostStatuses, status) {
+				return errors.Errorf("custom diagnostic step %s can't run in host status %s, expected one of %s",
+					step.Name, status, strings.Join(discoveryHostStatuses, ", "))
+			}
+		}
+	}
+	*c = steps
+	return nil
+}
+
+// CustomDiagnosticNameFromStepID returns the name of the custom diagnostic step of a step ID, and false if the step
+// isn't a custom diagnostic step
+func CustomDiagnosticNameFromStepID(stepID string) (string, bool) {
+	if !strings.HasPrefix(stepID, CustomDiagnosticStepIDPrefix) {
+		return "", false
+	}
+	nameAndSuffix := strings.TrimPrefix(stepID, CustomDiagnosticStepIDPrefix)
+	i := strings.LastIndex(nameAndSuffix, "-")
+	if i <= 0 {
+		return "", false
+	}
+	return nameAndSuffix[:i], true
+}
+
+type customDiagnosticCmd struct {
+	baseCmd
+	db   *gorm.DB
+	step CustomDiagnosticStep
+}
+
+func NewCustomDiagnosticCmd(log logrus.FieldLogger, db *gorm.DB, step CustomDiagnosticStep) *customDiagnosticCmd {
+	return &customDiagnosticCmd{
+		baseCmd: baseCmd{log: log},
+		db:      db,
+		step:    step,
+	}
+}
+
+// isDue returns true if the host hasn't reported the result of the step yet, or if the result is older than the
+// interval of the step
+func (c *customDiagnosticCmd) isDue(host *models.Host) (bool, error) {
+	results, err := common.UnmarshalCustomDiagnostics(host.CustomDiagnostics)
+	if err != nil {
+		return false, err
+	}
+	result, ok := results[c.step.Name]
+	if !ok {
+		return true, nil
+	}
+	if c.step.IntervalSeconds == 0 {
+		return false, nil
+	}
+	interval := time.Duration(c.step.IntervalSeconds) * time.Second
+	return time.Since(time.Time(result.UpdatedAt)) >= interval, nil
+}
+
+// isRunning returns true if the step was sent to the host less than its timeout ago, the container of the step may
+// still be running then, and a second one would conflict with its name
+func (c *customDiagnosticCmd) isRunning(dispatchTimes common.CustomDiagnosticDispatchTimes) bool {
+	dispatchedAt, ok := dispatchTimes[c.step.Name]
+	return ok && time.Since(dispatchedAt) < time.Duration(c.step.TimeoutSeconds)*time.Second
+}
+
+func (c *customDiagnosticCmd) getDispatchTimes(host *models.Host) (common.CustomDiagnosticDispatchTimes, error) {
+	var h common.Host
+	if err := c.db.Select("custom_diagnostics_dispatched_at").Take(&h, "id = ? and infra_env_id = ?",
+		host.ID.String(), host.InfraEnvID.String()).Error; err != nil {
+		return nil, err
+	}
+	return common.UnmarshalCustomDiagnosticDispatchTimes(h.CustomDiagnosticsDispatchedAt)
+}
+
+func (c *customDiagnosticCmd) setDispatchTime(host *models.Host, dispatchTimes common.CustomDiagnosticDispatchTimes) error {
+	dispatchTimes[c.step.Name] = time.Now()
+	dispatchTimesStr, err := common.MarshalCustomDiagnosticDispatchTimes(dispatchTimes)
+	if err != nil {
+		return err
+	}
+	return c.db.Model(&common.Host{}).Where("id = ? and infra_env_id = ?", host.ID.String(), host.InfraEnvID.String()).
+		Update("custom_diagnostics_dispatched_at", dispatchTimesStr).Error
+}
+
+func (c *customDiagnosticCmd) GetSteps(_ context.Context, host *models.Host) ([]*models.Step, error) {
+	due, err := c.isDue(host)
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to get the custom diagnostics of host %s", host.ID.String())
+		return nil, err
+	}
+	if !due {
+		return nil, nil
+	}
+	dispatchTimes, err := c.getDispatchTimes(host)
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to get the custom diagnostic dispatch times of host %s", host.ID.String())
+		return nil, err
+	}
+	if c.isRunning(dispatchTimes) {
+		return nil, nil
+	}
+	if err = c.setDispatchTime(host, dispatchTimes); err != nil {
+		c.log.WithError(err).Errorf("failed to set the custom diagnostic dispatch time of host %s", host.ID.String())
+		return nil, err
+	}
+
+	args := []string{
+		"timeout", strconv.FormatInt(c.step.TimeoutSeconds, 10),
+		"podman", "run", "--rm", "--privileged", "--net=host", "--pid=host",
+		"-v", "/dev:/dev", "-v", "/run/udev:/run/udev",
+		"--name", fmt.Sprintf("%s%s", CustomDiagnosticStepIDPrefix, c.step.Name),
+		c.step.Image,
+	}
+	step := &models.Step{
+		StepType: models.StepTypeExecute,
+		StepID:   fmt.Sprintf("%s%s-%s", CustomDiagnosticStepIDPrefix, c.step.Name, uuid.New().String()[:8]),
+		Args:     append(args, c.step.Args...),
+	}
+	return []*models.Step{step}, nil
+}
+
+// maxCustomDiagnosticOutput limits the size of the output of the custom diagnostic steps that is stored on the host
+const maxCustomDiagnosticOutput = 64 * 1024
+
+// NewCustomDiagnosticResult creates the result of a custom diagnostic step from the reply of the host
+func NewCustomDiagnosticResult(name string, reply *models.StepReply) *models.CustomDiagnosticResult {
+	return &models.CustomDiagnosticResult{
+		Name:      name,
+		ExitCode:  reply.ExitCode,
+		Output:    truncate(reply.Output, maxCustomDiagnosticOutput),
+		Error:     truncate(reply.Error, maxCustomDiagnosticOutput),
+		UpdatedAt: strfmt.DateTime(time.Now()),
+	}
+}
+
+func truncate(s string, size int) string {
+	if len(s) <= size {
+		return s
+	}
+	return s[:size]
+}
@@ -0,0 +1,171 @@
+package hostcommands
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("CustomDiagnosticSteps", func() {
+	It("decodes the steps with their defaults", func() {
+		var steps CustomDiagnosticSteps
+		Expect(steps.Decode(`[{"name": "lldp", "image": "quay.io/example/lldp:latest", "args": ["lldpctl", "-f", "json"]}]`)).To(Succeed())
+		Expect(steps).To(Equal(CustomDiagnosticSteps{{
+			Name:           "lldp",
+			Image:          "quay.io/example/lldp:latest",
+			Args:           []string{"lldpctl", "-f", "json"},
+			TimeoutSeconds: defaultCustomDiagnosticTimeoutSeconds,
+			HostStatuses:   discoveryHostStatuses,
+		}}))
+	})
+
+	It("decodes an empty value", func() {
+		var steps CustomDiagnosticSteps
+		Expect(steps.Decode("")).To(Succeed())
+		Expect(steps).To(BeEmpty())
+	})
+
+	DescribeTable("rejects invalid steps",
+		func(value, expectedError string) {
+			var steps CustomDiagnosticSteps
+			err := steps.Decode(value)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedError))
+		},
+		Entry("invalid JSON", `{"name": "lldp"}`, "failed to parse"),
+		Entry("invalid name", `[{"name": "LLDP", "image": "lldp"}]`, "invalid custom diagnostic step name"),
+		Entry("duplicate name", `[{"name": "lldp", "image": "a"}, {"name": "lldp", "image": "b"}]`, "more than once"),
+		Entry("missing image", `[{"name": "lldp"}]`, "image of custom diagnostic step lldp must be set"),
+		Entry("negative timeout", `[{"name": "lldp", "image": "lldp", "timeout_seconds": -1}]`, "must not be negative"),
+		Entry("installation status", `[{"name": "lldp", "image": "lldp", "host_statuses": ["installing"]}]`, "can't run in host status installing"),
+	)
+})
+
+var _ = Describe("CustomDiagnosticNameFromStepID", func() {
+	DescribeTable("parses the step IDs",
+		func(stepID, expectedName string, expectedOk bool) {
+			name, ok := CustomDiagnosticNameFromStepID(stepID)
+			Expect(ok).To(Equal(expectedOk))
+			Expect(name).To(Equal(expectedName))
+		},
+		Entry("custom diagnostic step", "custom-diagnostic-lldp-1a2b3c4d", "lldp", true),
+		Entry("name with dashes", "custom-diagnostic-nic-firmware-1a2b3c4d", "nic-firmware", true),
+		Entry("other step", "execute-1a2b3c4d", "", false),
+		Entry("missing name", "custom-diagnostic-1a2b3c4d", "", false),
+	)
+})
+
+var _ = Describe("customDiagnosticCmd", func() {
+	var (
+		ctx    = context.Background()
+		db     *gorm.DB
+		dbName string
+		host   models.Host
+		step   CustomDiagnosticStep
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		host = hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()),
+			strfmt.UUID(uuid.New().String()), models.HostStatusKnown)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		step = CustomDiagnosticStep{
+			Name:           "lldp",
+			Image:          "quay.io/example/lldp:latest",
+			Args:           []string{"lldpctl", "-f", "json"},
+			TimeoutSeconds: 60,
+		}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	setDispatchTime := func(dispatchedAt time.Time) {
+		dispatchTimes, err := common.MarshalCustomDiagnosticDispatchTimes(common.CustomDiagnosticDispatchTimes{"lldp": dispatchedAt})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Model(&common.Host{}).Where("id = ?", host.ID.String()).
+			Update("custom_diagnostics_dispatched_at", dispatchTimes).Error).ToNot(HaveOccurred())
+	}
+
+	setResult := func(updatedAt time.Time) {
+		results := common.CustomDiagnosticResults{
+			"lldp": {Name: "lldp", Output: "{}", UpdatedAt: strfmt.DateTime(updatedAt)},
+		}
+		var err error
+		host.CustomDiagnostics, err = common.MarshalCustomDiagnostics(results)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("runs the container of the step", func() {
+		steps, err := NewCustomDiagnosticCmd(common.GetTestLog(), db, step).GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].StepType).To(Equal(models.StepTypeExecute))
+		name, ok := CustomDiagnosticNameFromStepID(steps[0].StepID)
+		Expect(ok).To(BeTrue())
+		Expect(name).To(Equal("lldp"))
+		Expect(steps[0].Args[:2]).To(Equal([]string{"timeout", "60"}))
+		Expect(steps[0].Args[len(steps[0].Args)-4:]).To(Equal([]string{"quay.io/example/lldp:latest", "lldpctl", "-f", "json"}))
+	})
+
+	It("doesn't send the step again while it runs", func() {
+		cmd := NewCustomDiagnosticCmd(common.GetTestLog(), db, step)
+		steps, err := cmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+
+		steps, err = cmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+
+		// the host didn't reply within the timeout of the step
+		setDispatchTime(time.Now().Add(-2 * time.Minute))
+		steps, err = cmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+	})
+
+	It("runs only once without an interval", func() {
+		setResult(time.Now().Add(-24 * time.Hour))
+		steps, err := NewCustomDiagnosticCmd(common.GetTestLog(), db, step).GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+	})
+
+	It("runs again once the interval elapsed", func() {
+		step.IntervalSeconds = 3600
+		cmd := NewCustomDiagnosticCmd(common.GetTestLog(), db, step)
+
+		setResult(time.Now().Add(-10 * time.Minute))
+		steps, err := cmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+
+		setResult(time.Now().Add(-2 * time.Hour))
+		steps, err = cmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+	})
+
+	It("truncates the output of the result", func() {
+		output := make([]byte, maxCustomDiagnosticOutput+10)
+		for i := range output {
+			output[i] = 'a'
+		}
+		result := NewCustomDiagnosticResult("lldp", &models.StepReply{ExitCode: 1, Output: string(output), Error: "failed"})
+		Expect(result.Name).To(Equal("lldp"))
+		Expect(result.ExitCode).To(BeEquivalentTo(1))
+		Expect(result.Output).To(HaveLen(maxCustomDiagnosticOutput))
+		Expect(result.Error).To(Equal("failed"))
+	})
+})
@@ -60,7 +60,7 @@ func (c *downloadBootArtifactsCmd) GetSteps(ctx context.Context, host *models.Ho
 		return nil, fmt.Errorf("failed to generate urls for DownloadBootArtifactsRequest: %w", err)
 	}
 	// Reclaiming a host is only used in the operator scenario (not SaaS) so other auth types don't need to be considered
-	if c.authType == auth.TypeLocal {
+	if c.authType.UsesLocalTokens() {
 		bootArtifactURLs.InitrdURL, err = gencrypto.SignURL(bootArtifactURLs.InitrdURL, infraEnv.ID.String(), gencrypto.InfraEnvKey)
 		if err != nil {
 			return nil, fmt.Errorf("failed to sign initrd url for DownloadBootArtifactsRequest: %w", err)
@@ -2,7 +2,9 @@ package hostcommands
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -15,11 +17,13 @@ import (
 	"github.com/openshift/assisted-service/internal/feature"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/mirrorcheck"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -42,6 +46,34 @@ type StepsStruct struct {
 
 type stateToStepsMap map[string]StepsStruct
 
+// StepIntervals is decoded from a JSON object of host statuses to the interval between two requests of the next
+// steps of the hosts in that status, for example {"known": "30s", "disconnected": "5m"}
+type StepIntervals map[string]time.Duration
+
+func (s *StepIntervals) Decode(value string) error {
+	intervals := StepIntervals{}
+	if strings.TrimSpace(value) == "" {
+		*s = intervals
+		return nil
+	}
+	var values map[string]string
+	if err := json.Unmarshal([]byte(value), &values); err != nil {
+		return errors.Wrap(err, "failed to parse the step intervals")
+	}
+	for status, intervalStr := range values {
+		interval, err := time.ParseDuration(intervalStr)
+		if err != nil {
+			return errors.Wrapf(err, "invalid step interval of host status %s", status)
+		}
+		if interval < time.Second {
+			return errors.Errorf("the step interval of host status %s must be at least 1s", status)
+		}
+		intervals[status] = interval
+	}
+	*s = intervals
+	return nil
+}
+
 type InstructionManager struct {
 	log                           logrus.FieldLogger
 	db                            *gorm.DB
@@ -57,26 +89,36 @@ type InstructionManager struct {
 type InstructionConfig struct {
 	feature.Flags
 
-	AuthType                 auth.AuthType     `envconfig:"AUTH_TYPE" default:""`
-	ServiceBaseURL           string            `envconfig:"SERVICE_BASE_URL"`
-	ServiceCACertPath        string            `envconfig:"SERVICE_CA_CERT_PATH" default:""`
-	ImageServiceBaseURL      string            `envconfig:"IMAGE_SERVICE_BASE_URL"`
-	ImageExpirationTime      time.Duration     `envconfig:"IMAGE_EXPIRATION_TIME" default:"4h"`
-	InstallerImage           string            `envconfig:"INSTALLER_IMAGE" default:"quay.io/edge-infrastructure/assisted-installer:latest"`
-	ControllerImage          string            `envconfig:"CONTROLLER_IMAGE" default:"quay.io/edge-infrastructure/assisted-installer-controller:latest"`
-	AgentImage               string            `envconfig:"AGENT_DOCKER_IMAGE" default:"quay.io/edge-infrastructure/assisted-installer-agent:latest"`
-	SkipCertVerification     bool              `envconfig:"SKIP_CERT_VERIFICATION" default:"false"`
-	DiskCheckTimeout         time.Duration     `envconfig:"DISK_CHECK_TIMEOUT" default:"8m"`
-	ImageAvailabilityTimeout time.Duration     `envconfig:"IMAGE_AVAILABILITY_TIMEOUT" default:"16m"`
-	DisabledSteps            []models.StepType `envconfig:"DISABLED_STEPS" default:""`
+	AuthType                 auth.AuthType         `envconfig:"AUTH_TYPE" default:""`
+	ServiceBaseURL           string                `envconfig:"SERVICE_BASE_URL"`
+	ServiceCACertPath        string                `envconfig:"SERVICE_CA_CERT_PATH" default:""`
+	ImageServiceBaseURL      string                `envconfig:"IMAGE_SERVICE_BASE_URL"`
+	ImageExpirationTime      time.Duration         `envconfig:"IMAGE_EXPIRATION_TIME" default:"4h"`
+	InstallerImage           string                `envconfig:"INSTALLER_IMAGE" default:"quay.io/edge-infrastructure/assisted-installer:latest"`
+	ControllerImage          string                `envconfig:"CONTROLLER_IMAGE" default:"quay.io/edge-infrastructure/assisted-installer-controller:latest"`
+	AgentImage               string                `envconfig:"AGENT_DOCKER_IMAGE" default:"quay.io/edge-infrastructure/assisted-installer-agent:latest"`
+	SkipCertVerification     bool                  `envconfig:"SKIP_CERT_VERIFICATION" default:"false"`
+	DiskCheckTimeout         time.Duration         `envconfig:"DISK_CHECK_TIMEOUT" default:"8m"`
+	ImageAvailabilityTimeout time.Duration         `envconfig:"IMAGE_AVAILABILITY_TIMEOUT" default:"16m"`
+	MirrorCheckTimeout       time.Duration         `envconfig:"MIRROR_REGISTRY_CHECK_TIMEOUT" default:"5m"`
+	DisabledSteps            []models.StepType     `envconfig:"DISABLED_STEPS" default:""`
+	StepIntervals            StepIntervals         `envconfig:"STEP_INTERVALS" default:""`
+	CustomDiagnosticSteps    CustomDiagnosticSteps `envconfig:"CUSTOM_DIAGNOSTIC_STEPS" default:""`
 	ReleaseImageMirror       string
 	CheckClusterVersion      bool
 	HostFSMountDir           string
+
+	// NetworkThroughputCheckPort is the port of the throughput servers of the hosts
+	NetworkThroughputCheckPort int64 `envconfig:"NETWORK_THROUGHPUT_CHECK_PORT" default:"5201"`
+	// NetworkThroughputCheckDuration is the time traffic is sent for to measure the throughput between two hosts
+	NetworkThroughputCheckDuration time.Duration `envconfig:"NETWORK_THROUGHPUT_CHECK_DURATION" default:"10s"`
+	// MaxConcurrentNetworkThroughputChecks limits the number of throughput measurements that run at the same time in a cluster
+	MaxConcurrentNetworkThroughputChecks int `envconfig:"MAX_CONCURRENT_NETWORK_THROUGHPUT_CHECKS" default:"2"`
 }
 
 func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Validator, ocRelease oc.Release,
 	instructionConfig InstructionConfig, connectivityValidator connectivity.Validator, eventsHandler eventsapi.Handler,
-	versionHandler versions.Handler, osImages versions.OSImages, kubeApiEnabled bool) *InstructionManager {
+	versionHandler versions.Handler, osImages versions.OSImages, kubeApiEnabled bool, mirrorChecker mirrorcheck.Checker) *InstructionManager {
 	connectivityCmd := NewConnectivityCheckCmd(log, db, connectivityValidator, instructionConfig.AgentImage)
 	installCmd := NewInstallCmd(log, db, hwValidator, ocRelease, instructionConfig, eventsHandler, versionHandler, instructionConfig.EnableSkipMcoReboot, !kubeApiEnabled)
 	inventoryCmd := NewInventoryCmd(log, instructionConfig.AgentImage)
@@ -95,18 +137,21 @@ func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hard
 	downloadBootArtifactsCmd := NewDownloadBootArtifactsCmd(log, instructionConfig.ImageServiceBaseURL, instructionConfig.AuthType, osImages, db, instructionConfig.ImageExpirationTime, instructionConfig.HostFSMountDir)
 	rebootForReclaimCmd := NewRebootForReclaimCmd(log, instructionConfig.HostFSMountDir)
 	verifyVipsCmd := newVerifyVipsCmd(log, db)
+	mirrorRegistryCheckCmd := NewMirrorRegistryCheckCmd(log, db, mirrorChecker, instructionConfig.MirrorCheckTimeout.Seconds())
+	networkThroughputCheckCmd := NewNetworkThroughputCheckCmd(log, db, hwValidator, instructionConfig.NetworkThroughputCheckPort,
+		instructionConfig.NetworkThroughputCheckDuration, instructionConfig.MaxConcurrentNetworkThroughputChecks)
 
-	return &InstructionManager{
+	instructionManager := &InstructionManager{
 		log:              log,
 		db:               db,
 		config:           instructionConfig,
 		disabledStepsMap: generateDisabledStepsMap(log, instructionConfig.DisabledSteps),
 		installingClusterStateToSteps: stateToStepsMap{
-			models.HostStatusKnown:                    {[]CommandGetter{connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, inventoryCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
-			models.HostStatusInsufficient:             {[]CommandGetter{inventoryCmd, connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusKnown:                    {[]CommandGetter{connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, inventoryCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd, mirrorRegistryCheckCmd, networkThroughputCheckCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusInsufficient:             {[]CommandGetter{inventoryCmd, connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd, mirrorRegistryCheckCmd, networkThroughputCheckCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusDisconnected:             {[]CommandGetter{inventoryCmd}, defaultBackedOffInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusDiscovering:              {[]CommandGetter{inventoryCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
-			models.HostStatusPendingForInput:          {[]CommandGetter{inventoryCmd, connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusPendingForInput:          {[]CommandGetter{inventoryCmd, connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd, mirrorRegistryCheckCmd, networkThroughputCheckCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusInstalling:               {[]CommandGetter{installCmd, dhcpAllocateCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusInstallingInProgress:     {[]CommandGetter{dhcpAllocateCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue}, //TODO inventory step here is a temporary solution until format command is moved to a different state
 			models.HostStatusPreparingForInstallation: {[]CommandGetter{dhcpAllocateCmd, diskPerfCheckCmd, imageAvailabilityCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
@@ -143,6 +188,45 @@ func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hard
 		upgradeAgentCmd: upgradeAgentCmd,
 		eventsHandler:   eventsHandler,
 	}
+	instructionManager.addCustomDiagnosticSteps(instructionConfig.CustomDiagnosticSteps)
+	instructionManager.setStepIntervals(instructionConfig.StepIntervals)
+	return instructionManager
+}
+
+func (i *InstructionManager) allStateToSteps() []stateToStepsMap {
+	return []stateToStepsMap{i.installingClusterStateToSteps, i.addHostsClusterToSteps, i.poolHostToSteps}
+}
+
+// addCustomDiagnosticSteps adds the custom diagnostic steps to the statuses they run in
+func (i *InstructionManager) addCustomDiagnosticSteps(customSteps CustomDiagnosticSteps) {
+	for _, customStep := range customSteps {
+		cmd := NewCustomDiagnosticCmd(i.log, i.db, customStep)
+		for _, stateToSteps := range i.allStateToSteps() {
+			for _, status := range customStep.HostStatuses {
+				if steps, ok := stateToSteps[status]; ok {
+					steps.Commands = append(steps.Commands, cmd)
+					stateToSteps[status] = steps
+				}
+			}
+		}
+	}
+}
+
+// setStepIntervals replaces the default intervals of the statuses that have a configured interval
+func (i *InstructionManager) setStepIntervals(intervals StepIntervals) {
+	for status, interval := range intervals {
+		found := false
+		for _, stateToSteps := range i.allStateToSteps() {
+			if steps, ok := stateToSteps[status]; ok {
+				steps.NextStepInSec = int64(interval.Seconds())
+				stateToSteps[status] = steps
+				found = true
+			}
+		}
+		if !found {
+			i.log.WithField("STEP_INTERVALS", intervals).Warnf("InstructionManager Found an unknown host status '%s' in STEP_INTERVALS. Ignoring...", status)
+		}
+	}
 }
 
 func (i *InstructionManager) isStepDisabled(stepType models.StepType) bool {
@@ -60,7 +60,7 @@ var _ = Describe("instruction_manager", func() {
 		hwValidator = hardware.NewMockValidator(ctrl)
 		mockRelease = oc.NewMockRelease(ctrl)
 		cnValidator = connectivity.NewMockValidator(ctrl)
-		instMng = NewInstructionManager(common.GetTestLog(), db, hwValidator, mockRelease, instructionConfig, cnValidator, mockEvents, mockVersions, mockOSImages, false)
+		instMng = NewInstructionManager(common.GetTestLog(), db, hwValidator, mockRelease, instructionConfig, cnValidator, mockEvents, mockVersions, mockOSImages, false, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 		infraEnvId = strfmt.UUID(uuid.New().String())
@@ -335,7 +335,7 @@ var _ = Describe("instruction_manager", func() {
 	Context("Disable Steps verification", func() {
 		createInstMngWithDisabledSteps := func(steps []models.StepType) *InstructionManager {
 			instructionConfig.DisabledSteps = steps
-			return NewInstructionManager(common.GetTestLog(), db, hwValidator, mockRelease, instructionConfig, cnValidator, mockEvents, mockVersions, mockOSImages, false)
+			return NewInstructionManager(common.GetTestLog(), db, hwValidator, mockRelease, instructionConfig, cnValidator, mockEvents, mockVersions, mockOSImages, false, nil)
 		}
 		Context("disabledStepsMap in InstructionManager", func() {
 			It("Should except empty DISABLED_STEPS", func() {
@@ -420,6 +420,56 @@ var _ = Describe("instruction_manager", func() {
 
 })
 
+var _ = Describe("step schedule", func() {
+	newInstructionManager := func(config InstructionConfig) *InstructionManager {
+		return NewInstructionManager(common.GetTestLog(), nil, nil, nil, config, nil, nil, nil, nil, false, nil)
+	}
+
+	It("decodes the step intervals", func() {
+		var intervals StepIntervals
+		Expect(intervals.Decode(`{"known": "30s", "disconnected": "5m"}`)).To(Succeed())
+		Expect(intervals).To(Equal(StepIntervals{
+			models.HostStatusKnown:        30 * time.Second,
+			models.HostStatusDisconnected: 5 * time.Minute,
+		}))
+	})
+
+	It("rejects invalid step intervals", func() {
+		var intervals StepIntervals
+		Expect(intervals.Decode(`{"known": "soon"}`)).ToNot(Succeed())
+		Expect(intervals.Decode(`{"known": "10ms"}`)).ToNot(Succeed())
+		Expect(intervals.Decode(`["known"]`)).ToNot(Succeed())
+	})
+
+	It("replaces the interval of the configured statuses", func() {
+		instMng := newInstructionManager(InstructionConfig{StepIntervals: StepIntervals{
+			models.HostStatusKnown:        30 * time.Second,
+			models.HostStatusKnownUnbound: 10 * time.Minute,
+			"unknown status":              time.Minute,
+		}})
+		Expect(instMng.installingClusterStateToSteps[models.HostStatusKnown].NextStepInSec).To(BeEquivalentTo(30))
+		Expect(instMng.addHostsClusterToSteps[models.HostStatusKnown].NextStepInSec).To(BeEquivalentTo(30))
+		Expect(instMng.poolHostToSteps[models.HostStatusKnownUnbound].NextStepInSec).To(BeEquivalentTo(600))
+		Expect(instMng.installingClusterStateToSteps[models.HostStatusInsufficient].NextStepInSec).To(Equal(defaultNextInstructionInSec))
+	})
+
+	It("adds the custom diagnostic steps to their statuses", func() {
+		var customSteps CustomDiagnosticSteps
+		Expect(customSteps.Decode(`[{"name": "lldp", "image": "lldp"}, {"name": "smart", "image": "smart", "host_statuses": ["known"]}]`)).To(Succeed())
+		defaultInstMng := newInstructionManager(InstructionConfig{})
+		instMng := newInstructionManager(InstructionConfig{CustomDiagnosticSteps: customSteps})
+
+		countCustomSteps := func(stateToSteps stateToStepsMap, status string) int {
+			return len(stateToSteps[status].Commands) - len(defaultInstMng.installingClusterStateToSteps[status].Commands)
+		}
+		Expect(countCustomSteps(instMng.installingClusterStateToSteps, models.HostStatusKnown)).To(Equal(2))
+		Expect(countCustomSteps(instMng.installingClusterStateToSteps, models.HostStatusInsufficient)).To(Equal(1))
+		Expect(countCustomSteps(instMng.installingClusterStateToSteps, models.HostStatusInstalling)).To(Equal(0))
+		Expect(len(instMng.poolHostToSteps[models.HostStatusKnownUnbound].Commands) -
+			len(defaultInstMng.poolHostToSteps[models.HostStatusKnownUnbound].Commands)).To(Equal(1))
+	})
+})
+
 var _ = Describe("agent_upgrade", func() {
 	var (
 		ctx                           = context.Background()
@@ -451,7 +501,7 @@ var _ = Describe("agent_upgrade", func() {
 		cnValidator = connectivity.NewMockValidator(ctrl)
 		instructionConfig = InstructionConfig{AgentImage: "quay.io/my/image:v1.2.3"}
 		instructionConfig.EnableUpgradeAgent = true
-		instMng = NewInstructionManager(common.GetTestLog(), db, hwValidator, mockRelease, instructionConfig, cnValidator, mockEvents, mockVersions, mockOSImages, false)
+		instMng = NewInstructionManager(common.GetTestLog(), db, hwValidator, mockRelease, instructionConfig, cnValidator, mockEvents, mockVersions, mockOSImages, false, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 		infraEnvId = strfmt.UUID(uuid.New().String())
@@ -540,6 +590,8 @@ func checkStepsByState(state string, host *models.Host, db *gorm.DB, mockEvents
 		mockRelease.EXPECT().GetMCOImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(defaultMCOImage, nil).Times(1)
 		mockVersions.EXPECT().GetMustGatherImages(gomock.Any(), gomock.Any(), gomock.Any()).Return(defaultMustGatherVersion, nil).Times(1)
 	}
+	mockValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&models.ClusterHostRequirements{Total: &models.ClusterHostRequirementsDetails{}}, nil).AnyTimes()
 	if funk.Contains(expectedStepTypes, models.StepTypeConnectivityCheck) {
 		mockConnectivity.EXPECT().GetHostValidInterfaces(gomock.Any()).Return([]*models.Interface{
 			{
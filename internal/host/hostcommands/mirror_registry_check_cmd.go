@@ -0,0 +1,96 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/mirrorcheck"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type mirrorRegistryCheckCmd struct {
+	baseCmd
+	db             *gorm.DB
+	mirrorChecker  mirrorcheck.Checker
+	timeoutSeconds float64
+}
+
+func NewMirrorRegistryCheckCmd(log logrus.FieldLogger, db *gorm.DB, mirrorChecker mirrorcheck.Checker, timeoutSeconds float64) *mirrorRegistryCheckCmd {
+	return &mirrorRegistryCheckCmd{
+		baseCmd:        baseCmd{log: log},
+		db:             db,
+		mirrorChecker:  mirrorChecker,
+		timeoutSeconds: timeoutSeconds,
+	}
+}
+
+// filterImagesToCheck returns the images that the host hasn't reported as available yet, missing images are
+// checked again so that the validation passes once they're mirrored
+func (c *mirrorRegistryCheckCmd) filterImagesToCheck(host *models.Host, images []string) ([]string, error) {
+	statuses, err := common.UnmarshalMirrorRegistryCheck(host.MirrorRegistryCheck)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]string, 0)
+	for _, image := range images {
+		if status, ok := statuses[image]; !ok || status.Result != models.MirrorRegistryImageResultAvailable {
+			ret = append(ret, image)
+		}
+	}
+	return ret, nil
+}
+
+func (c *mirrorRegistryCheckCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	if c.mirrorChecker == nil {
+		return nil, nil
+	}
+	var cluster common.Cluster
+	if err := c.db.First(&cluster, "id = ?", host.ClusterID).Error; err != nil {
+		c.log.WithError(err).Errorf("failed to fetch cluster %s", host.ClusterID)
+		return nil, err
+	}
+	mirrorConfiguration, err := cluster.GetMirrorRegistryConfiguration()
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to get the mirror registry configuration of cluster %s", host.ClusterID)
+		return nil, err
+	}
+	if !c.mirrorChecker.IsEnabled(mirrorConfiguration) {
+		return nil, nil
+	}
+	if cluster.OcpReleaseImage == "" || cluster.PullSecret == "" {
+		return nil, nil
+	}
+
+	images, err := c.mirrorChecker.GetReleaseImages(c.log, cluster.OcpReleaseImage, cluster.PullSecret, mirrorConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	imagesToCheck, err := c.filterImagesToCheck(host, images)
+	if err != nil {
+		return nil, err
+	}
+	if len(imagesToCheck) == 0 {
+		return nil, nil
+	}
+
+	request := models.MirrorRegistryCheckRequest{
+		Images:  imagesToCheck,
+		Timeout: int64(c.timeoutSeconds),
+	}
+	b, err := json.Marshal(&request)
+	if err != nil {
+		c.log.WithError(err).Errorf("Failed to JSON marshal %+v", request)
+		return nil, err
+	}
+
+	step := &models.Step{
+		StepType: models.StepTypeMirrorRegistryCheck,
+		Args: []string{
+			string(b),
+		},
+	}
+	return []*models.Step{step}, nil
+}
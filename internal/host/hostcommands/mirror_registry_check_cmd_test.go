@@ -0,0 +1,88 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/mirrorcheck"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("mirrorRegistryCheckCmd", func() {
+	const (
+		releaseImage = "quay.io/openshift-release-dev/ocp-release:4.17.11-x86_64"
+		mcoImage     = "quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:1111"
+		agentImage   = "quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:2222"
+	)
+	ctx := context.Background()
+	var (
+		ctrl          *gomock.Controller
+		mockChecker   *mirrorcheck.MockChecker
+		host          models.Host
+		db            *gorm.DB
+		cmd           *mirrorRegistryCheckCmd
+		id, clusterID strfmt.UUID
+		infraEnvID    strfmt.UUID
+		dbName        string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockChecker = mirrorcheck.NewMockChecker(ctrl)
+		cmd = NewMirrorRegistryCheckCmd(common.GetTestLog(), db, mockChecker, 300)
+
+		id = strfmt.UUID(uuid.New().String())
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(id, infraEnvID, clusterID, models.HostStatusKnown)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		cluster := common.Cluster{Cluster: models.Cluster{ID: &clusterID, OcpReleaseImage: releaseImage}, PullSecret: "pull-secret"}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("doesn't check anything without mirror registries", func() {
+		mockChecker.EXPECT().IsEnabled(gomock.Any()).Return(false).Times(1)
+		steps, err := cmd.GetSteps(ctx, &host)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+	})
+
+	It("checks the images that aren't available yet", func() {
+		host.MirrorRegistryCheck = `{"images":[{"name":"` + mcoImage + `","result":"available"},{"name":"` + agentImage + `","result":"missing"}]}`
+		mockChecker.EXPECT().IsEnabled(gomock.Any()).Return(true).Times(1)
+		mockChecker.EXPECT().GetReleaseImages(gomock.Any(), releaseImage, "pull-secret", gomock.Any()).Return([]string{mcoImage, agentImage}, nil).Times(1)
+
+		steps, err := cmd.GetSteps(ctx, &host)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].StepType).To(Equal(models.StepTypeMirrorRegistryCheck))
+		var request models.MirrorRegistryCheckRequest
+		Expect(json.Unmarshal([]byte(steps[0].Args[0]), &request)).To(Succeed())
+		Expect(request.Images).To(Equal([]string{agentImage}))
+		Expect(request.Timeout).To(Equal(int64(300)))
+	})
+
+	It("doesn't check again when all the images are available", func() {
+		host.MirrorRegistryCheck = `{"images":[{"name":"` + mcoImage + `","result":"available"}]}`
+		mockChecker.EXPECT().IsEnabled(gomock.Any()).Return(true).Times(1)
+		mockChecker.EXPECT().GetReleaseImages(gomock.Any(), releaseImage, "pull-secret", gomock.Any()).Return([]string{mcoImage}, nil).Times(1)
+
+		steps, err := cmd.GetSteps(ctx, &host)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+	})
+})
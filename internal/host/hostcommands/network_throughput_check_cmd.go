@@ -0,0 +1,190 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/transaction"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	// networkThroughputServerTTL is the time a host is considered to run its throughput server after it was last
+	// sent the network throughput check step
+	networkThroughputServerTTL = 5 * time.Minute
+
+	// networkThroughputCheckGrace is added to the duration of a measurement before its hosts can take part in
+	// another one
+	networkThroughputCheckGrace = 30 * time.Second
+)
+
+type networkThroughputCheckCmd struct {
+	baseCmd
+	db                  *gorm.DB
+	hwValidator         hardware.Validator
+	port                int64
+	duration            time.Duration
+	maxConcurrentChecks int
+}
+
+func NewNetworkThroughputCheckCmd(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Validator, port int64,
+	duration time.Duration, maxConcurrentChecks int) *networkThroughputCheckCmd {
+	return &networkThroughputCheckCmd{
+		baseCmd:             baseCmd{log: log},
+		db:                  db,
+		hwValidator:         hwValidator,
+		port:                port,
+		duration:            duration,
+		maxConcurrentChecks: maxConcurrentChecks,
+	}
+}
+
+func (c *networkThroughputCheckCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	if host.ClusterID == nil || hostutil.IsDay2Host(host) || common.GetEffectiveRole(host) == models.HostRoleAutoAssign {
+		return nil, nil
+	}
+	cluster, err := common.GetClusterFromDBWithHosts(c.db, *host.ClusterID)
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to get cluster %s", host.ClusterID.String())
+		return nil, err
+	}
+	requirements, err := c.hwValidator.GetClusterHostRequirements(ctx, cluster, host)
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to get the requirements of host %s", host.ID.String())
+		return nil, err
+	}
+	if requirements.Total.NetworkThroughputMbps == nil {
+		return nil, nil
+	}
+
+	remoteHosts, err := c.selectRemoteHosts(host, cluster.Hosts, time.Now())
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to select the remote hosts of the network throughput check of host %s", host.ID.String())
+		return nil, err
+	}
+	request := models.NetworkThroughputCheckRequest{
+		Port:        swag.Int64(c.port),
+		Duration:    swag.Int64(int64(c.duration.Seconds())),
+		RemoteHosts: remoteHosts,
+	}
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to marshal NetworkThroughputCheckRequest")
+		return nil, err
+	}
+	step := &models.Step{
+		StepType: models.StepTypeNetworkThroughputCheck,
+		Args:     []string{string(requestBytes)},
+	}
+	return []*models.Step{step}, nil
+}
+
+// selectRemoteHosts returns the host of the same role that the host measures the throughput to, if there is one
+// that runs its throughput server, isn't measured yet and doesn't take part in another measurement. No more than the
+// maximal number of measurements run at the same time in a cluster, so that they don't compete for the bandwidth.
+// An empty list is returned otherwise, which only starts the throughput server of the host.
+//
+// The schedule is stored in the hosts, and the row of the cluster is locked while it's updated, so that the replicas
+// of the service share it.
+func (c *networkThroughputCheckCmd) selectRemoteHosts(host *models.Host, hosts []*models.Host, now time.Time) ([]*models.NetworkThroughputRemoteHost, error) {
+	remoteHosts := []*models.NetworkThroughputRemoteHost{}
+	err := c.db.Transaction(func(tx *gorm.DB) error {
+		if err := transaction.AddForUpdateQueryOption(tx).Select("id").Take(&common.Cluster{}, "id = ?", host.ClusterID.String()).Error; err != nil {
+			return errors.Wrapf(err, "failed to lock cluster %s", host.ClusterID.String())
+		}
+		if err := tx.Model(&common.Host{}).Where("id = ? and cluster_id = ?", host.ID.String(), host.ClusterID.String()).
+			Update("network_throughput_server_started_at", now).Error; err != nil {
+			return errors.Wrapf(err, "failed to update the throughput server of host %s", host.ID.String())
+		}
+		var schedule []*common.Host
+		if err := tx.Select("id", "network_throughput_server_started_at", "network_throughput_check_ends_at").
+			Where("cluster_id = ?", host.ClusterID.String()).Find(&schedule).Error; err != nil {
+			return errors.Wrapf(err, "failed to get the network throughput checks of cluster %s", host.ClusterID.String())
+		}
+
+		// servers maps the hosts to the last time they were sent the step, busy to the end of their measurement
+		servers := map[strfmt.UUID]time.Time{}
+		busy := map[strfmt.UUID]time.Time{}
+		activeChecks := 0
+		for _, h := range schedule {
+			servers[*h.ID] = h.NetworkThroughputServerStartedAt
+			if h.NetworkThroughputCheckEndsAt.After(now) {
+				busy[*h.ID] = h.NetworkThroughputCheckEndsAt
+				activeChecks++
+			}
+		}
+		// Each measurement keeps two hosts busy
+		if _, isBusy := busy[*host.ID]; isBusy || activeChecks/2 >= c.maxConcurrentChecks || host.Connectivity == "" {
+			return nil
+		}
+
+		report, err := hostutil.UnmarshalConnectivityReport(host.Connectivity)
+		if err != nil {
+			c.log.WithError(err).Warnf("failed to unmarshal the connectivity report of host %s", host.ID.String())
+			return nil
+		}
+		for _, r := range report.RemoteHosts {
+			remoteHost := findHost(r.HostID, hosts)
+			if remoteHost == nil || common.GetEffectiveRole(remoteHost) != common.GetEffectiveRole(host) {
+				continue
+			}
+			if lastSeen, ok := servers[r.HostID]; !ok || now.Sub(lastSeen) > networkThroughputServerTTL {
+				continue
+			}
+			if _, isBusy := busy[r.HostID]; isBusy {
+				continue
+			}
+			throughput, err := hostutil.GetNetworkThroughputMbps(host, remoteHost)
+			if err != nil || throughput != nil {
+				continue
+			}
+			ipAddress := successfulL3Address(r)
+			if ipAddress == "" {
+				continue
+			}
+			if err = tx.Model(&common.Host{}).Where("id in ? and cluster_id = ?", []string{host.ID.String(), r.HostID.String()}, host.ClusterID.String()).
+				Update("network_throughput_check_ends_at", now.Add(c.duration+networkThroughputCheckGrace)).Error; err != nil {
+				return errors.Wrapf(err, "failed to schedule the network throughput check of host %s", host.ID.String())
+			}
+			hostID := r.HostID
+			remoteHosts = append(remoteHosts, &models.NetworkThroughputRemoteHost{
+				HostID:    &hostID,
+				IPAddress: swag.String(ipAddress),
+			})
+			return nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return remoteHosts, nil
+}
+
+// successfulL3Address returns an address of the remote host that the host reached, or an empty string if there is none
+func successfulL3Address(remoteHost *models.ConnectivityRemoteHost) string {
+	for _, l3 := range remoteHost.L3Connectivity {
+		if l3.Successful {
+			return l3.RemoteIPAddress
+		}
+	}
+	return ""
+}
+
+func findHost(hostID strfmt.UUID, hosts []*models.Host) *models.Host {
+	for _, h := range hosts {
+		if h.ID != nil && *h.ID == hostID {
+			return h
+		}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("networkThroughputCheckCmd", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockValidator *hardware.MockValidator
+		cmd           *networkThroughputCheckCmd
+		db            *gorm.DB
+		dbName        string
+		clusterID     strfmt.UUID
+		infraEnvID    strfmt.UUID
+		hosts         []*models.Host
+		now           time.Time
+	)
+
+	newHost := func(role models.HostRole) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		return &models.Host{ID: &id, ClusterID: &clusterID, Role: role}
+	}
+
+	// connect sets the connectivity reports of the hosts as if they all reached each other
+	connect := func(hosts []*models.Host) {
+		for i, h := range hosts {
+			report := models.ConnectivityReport{}
+			for j, remote := range hosts {
+				if i == j {
+					continue
+				}
+				report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+					HostID: *remote.ID,
+					L3Connectivity: []*models.L3Connectivity{
+						{RemoteIPAddress: fmt.Sprintf("192.168.1.%d", j+1), Successful: false},
+						{RemoteIPAddress: fmt.Sprintf("10.0.0.%d", j+1), Successful: true},
+					},
+				})
+			}
+			connectivity, err := hostutil.MarshalConnectivityReport(&report)
+			Expect(err).ToNot(HaveOccurred())
+			h.Connectivity = connectivity
+		}
+	}
+
+	setThroughput := func(h *models.Host, remoteID strfmt.UUID, throughput float64) {
+		report, err := hostutil.UnmarshalConnectivityReport(h.Connectivity)
+		Expect(err).ToNot(HaveOccurred())
+		for _, r := range report.RemoteHosts {
+			if r.HostID == remoteID {
+				r.ThroughputMbps = swag.Float64(throughput)
+			}
+		}
+		h.Connectivity, err = hostutil.MarshalConnectivityReport(report)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	selectRemoteHosts := func(cmd *networkThroughputCheckCmd, h *models.Host, at time.Time) []*models.NetworkThroughputRemoteHost {
+		remoteHosts, err := cmd.selectRemoteHosts(h, hosts, at)
+		Expect(err).ToNot(HaveOccurred())
+		return remoteHosts
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockValidator = hardware.NewMockValidator(ctrl)
+		cmd = NewNetworkThroughputCheckCmd(common.GetTestLog(), db, mockValidator, 5201, 10*time.Second, 1)
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		hosts = []*models.Host{
+			newHost(models.HostRoleMaster),
+			newHost(models.HostRoleMaster),
+			newHost(models.HostRoleMaster),
+			newHost(models.HostRoleWorker),
+		}
+		connect(hosts)
+		now = time.Now()
+
+		cluster := hostutil.GenerateTestCluster(clusterID)
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		for _, h := range hosts {
+			host := hostutil.GenerateTestHost(*h.ID, infraEnvID, clusterID, models.HostStatusKnown)
+			host.Role = h.Role
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	Context("selectRemoteHosts", func() {
+		It("only starts the server until another host of the role runs its server", func() {
+			Expect(selectRemoteHosts(cmd, hosts[0], now)).To(BeEmpty())
+			// The worker doesn't measure the throughput to the masters
+			Expect(selectRemoteHosts(cmd, hosts[3], now)).To(BeEmpty())
+
+			remoteHosts := selectRemoteHosts(cmd, hosts[1], now)
+			Expect(remoteHosts).To(HaveLen(1))
+			Expect(*remoteHosts[0].HostID).To(Equal(*hosts[0].ID))
+			Expect(*remoteHosts[0].IPAddress).To(Equal("10.0.0.1"))
+		})
+
+		It("limits the number of measurements that run at the same time", func() {
+			Expect(selectRemoteHosts(cmd, hosts[0], now)).To(BeEmpty())
+			Expect(selectRemoteHosts(cmd, hosts[1], now)).To(HaveLen(1))
+			Expect(selectRemoteHosts(cmd, hosts[2], now)).To(BeEmpty())
+
+			// The measurement ended
+			later := now.Add(time.Minute)
+			remoteHosts := selectRemoteHosts(cmd, hosts[2], later)
+			Expect(remoteHosts).To(HaveLen(1))
+			Expect(*remoteHosts[0].HostID).To(Equal(*hosts[0].ID))
+		})
+
+		It("doesn't measure the throughput again", func() {
+			setThroughput(hosts[0], *hosts[1].ID, 9000)
+			Expect(selectRemoteHosts(cmd, hosts[0], now)).To(BeEmpty())
+			Expect(selectRemoteHosts(cmd, hosts[1], now)).To(BeEmpty())
+
+			remoteHosts := selectRemoteHosts(cmd, hosts[2], now)
+			Expect(remoteHosts).To(HaveLen(1))
+			Expect(*remoteHosts[0].HostID).To(Equal(*hosts[0].ID))
+		})
+
+		It("shares the schedule between the replicas", func() {
+			otherCmd := NewNetworkThroughputCheckCmd(common.GetTestLog(), db, mockValidator, 5201, 10*time.Second, 1)
+			Expect(selectRemoteHosts(cmd, hosts[0], now)).To(BeEmpty())
+			remoteHosts := selectRemoteHosts(otherCmd, hosts[1], now)
+			Expect(remoteHosts).To(HaveLen(1))
+			Expect(*remoteHosts[0].HostID).To(Equal(*hosts[0].ID))
+			Expect(selectRemoteHosts(cmd, hosts[2], now)).To(BeEmpty())
+		})
+
+		It("doesn't measure the throughput to hosts whose server stopped", func() {
+			Expect(selectRemoteHosts(cmd, hosts[0], now)).To(BeEmpty())
+			Expect(selectRemoteHosts(cmd, hosts[1], now.Add(networkThroughputServerTTL+time.Second))).To(BeEmpty())
+		})
+	})
+
+	Context("GetSteps", func() {
+		var host *models.Host
+
+		BeforeEach(func() {
+			h, err := common.GetHostFromDB(db, infraEnvID.String(), hosts[0].ID.String())
+			Expect(err).ToNot(HaveOccurred())
+			host = &h.Host
+		})
+
+		It("doesn't run without a throughput requirement", func() {
+			mockValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				&models.ClusterHostRequirements{Total: &models.ClusterHostRequirementsDetails{}}, nil)
+			steps, err := cmd.GetSteps(context.Background(), host)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(steps).To(BeEmpty())
+		})
+
+		It("starts the throughput server", func() {
+			mockValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				&models.ClusterHostRequirements{Total: &models.ClusterHostRequirementsDetails{NetworkThroughputMbps: swag.Float64(1000)}}, nil)
+			steps, err := cmd.GetSteps(context.Background(), host)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(steps).To(HaveLen(1))
+			Expect(steps[0].StepType).To(Equal(models.StepTypeNetworkThroughputCheck))
+			var request models.NetworkThroughputCheckRequest
+			Expect(json.Unmarshal([]byte(steps[0].Args[0]), &request)).To(Succeed())
+			Expect(*request.Port).To(Equal(int64(5201)))
+			Expect(*request.Duration).To(Equal(int64(10)))
+			Expect(request.RemoteHosts).To(BeEmpty())
+		})
+	})
+})
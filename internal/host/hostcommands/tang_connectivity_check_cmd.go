@@ -9,6 +9,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/tang"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -46,8 +47,21 @@ func (c *tangConnectivityCheckCmd) getTangServersFromHostIgnition(host *models.H
 			c.log.Warn("luks clevis configuration is missing or incomplete in the host ignition, disk encryption will be assumed to be disabled. (MGMT-16721)")
 			return nil, nil
 		}
+		var servers interface{}
 		if len(luks.Clevis.Tang) != 0 {
-			if tangServers, err = json.Marshal(luks.Clevis.Tang); err != nil {
+			servers = luks.Clevis.Tang
+		} else if luks.Clevis.Custom != nil && luks.Clevis.Custom.Pin == tang.ClevisSSSPin {
+			// The Tang servers of the threshold mode are in the configuration of the Clevis sss pin
+			_, thresholdTangServers, err := tang.ParseClevisConfig(luks.Clevis.Custom.Config)
+			if err != nil {
+				return nil, err
+			}
+			if len(thresholdTangServers) != 0 {
+				servers = thresholdTangServers
+			}
+		}
+		if servers != nil {
+			if tangServers, err = json.Marshal(servers); err != nil {
 				return nil, err
 			}
 			serverList := string(tangServers[:])
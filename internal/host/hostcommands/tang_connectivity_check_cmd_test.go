@@ -65,6 +65,30 @@ var _ = Describe("tangConnectivitycheckcmd", func() {
 		}
 	  }`
 
+	const hostIgnitionWithThreshold = `{
+		"ignition": {
+		  "config": {},
+		  "version": "3.2.0"
+		},
+		"storage": {
+			"luks": [
+				{
+				  "clevis": {
+					"custom": {
+					  "pin": "sss",
+					  "config": "{\"t\":2,\"pins\":{\"tpm2\":{},\"sss\":{\"t\":1,\"pins\":{\"tang\":[{\"url\":\"http://foo.bar\",\"thp\":\"nWW89qAs1hDPKiIcae-ey2cQmUk\"}]}}}}",
+					  "needsNetwork": true
+					}
+				  },
+				  "device": "/dev/disk/by-partlabel/root",
+				  "name": "root",
+				  "wipeVolume": true
+				}
+			],
+		  "files": []
+		}
+	  }`
+
 	const hostIgnitionWithoutLuks = `{
 		"ignition": {
 		  "config": {},
@@ -133,6 +157,21 @@ var _ = Describe("tangConnectivitycheckcmd", func() {
 			Expect(stepErr).ShouldNot(HaveOccurred())
 		})
 
+		It("runs tang connectivity check using tang servers from the threshold configuration in host ignition", func() {
+			apiVipConnectivity, err := json.Marshal(models.APIVipConnectivityResponse{
+				IsSuccess: true,
+				Ignition:  hostIgnitionWithThreshold,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			host.APIVipConnectivity = string(apiVipConnectivity)
+			Expect(db.Save(&host).Error).ShouldNot(HaveOccurred())
+
+			stepReply, stepErr = tangConnectivityCheckCmd.GetSteps(ctx, &host)
+			Expect(stepErr).ShouldNot(HaveOccurred())
+			Expect(stepReply).To(HaveLen(1))
+			Expect(stepReply[0].Args[len(stepReply[0].Args)-1]).Should(Equal("{\"tang_servers\":\"[{\\\"url\\\":\\\"http://foo.bar\\\",\\\"thumbprint\\\":\\\"nWW89qAs1hDPKiIcae-ey2cQmUk\\\"}]\"}"))
+		})
+
 		It("skips tang check when APIVipConnectivity is empty for day 2 host", func() {
 			host.APIVipConnectivity = ""
 			Expect(db.Save(&host).Error).ShouldNot(HaveOccurred())
@@ -276,6 +276,26 @@ func UnmarshalConnectivityReport(reportStr string) (*models.ConnectivityReport,
 	return &report, nil
 }
 
+// GetNetworkThroughputMbps returns the network throughput measured between two hosts by either of them, or nil if it
+// wasn't measured yet
+func GetNetworkThroughputMbps(host, remoteHost *models.Host) (*float64, error) {
+	for _, pair := range [][2]*models.Host{{host, remoteHost}, {remoteHost, host}} {
+		if pair[0].Connectivity == "" {
+			continue
+		}
+		report, err := UnmarshalConnectivityReport(pair[0].Connectivity)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range report.RemoteHosts {
+			if r.HostID == *pair[1].ID && r.ThroughputMbps != nil {
+				return r.ThroughputMbps, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
 func GetHostCluster(log logrus.FieldLogger, db *gorm.DB, host *models.Host) (*common.Cluster, error) {
 	var cluster common.Cluster
 	err := db.First(&cluster, "id = ?", host.ClusterID).Error
@@ -565,6 +565,20 @@ func (mr *MockAPIMockRecorder) UpdateConnectivityReport(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnectivityReport", reflect.TypeOf((*MockAPI)(nil).UpdateConnectivityReport), arg0, arg1, arg2)
 }
 
+// UpdateCustomDiagnosticResult mocks base method.
+func (m *MockAPI) UpdateCustomDiagnosticResult(arg0 context.Context, arg1 *models.Host, arg2 *models.CustomDiagnosticResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomDiagnosticResult", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCustomDiagnosticResult indicates an expected call of UpdateCustomDiagnosticResult.
+func (mr *MockAPIMockRecorder) UpdateCustomDiagnosticResult(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomDiagnosticResult", reflect.TypeOf((*MockAPI)(nil).UpdateCustomDiagnosticResult), arg0, arg1, arg2)
+}
+
 // UpdateDomainNameResolution mocks base method.
 func (m *MockAPI) UpdateDomainNameResolution(arg0 context.Context, arg1 *models.Host, arg2 models.DomainResolutionResponse, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -747,6 +761,20 @@ func (mr *MockAPIMockRecorder) UpdateMediaConnected(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMediaConnected", reflect.TypeOf((*MockAPI)(nil).UpdateMediaConnected), arg0, arg1)
 }
 
+// UpdateMirrorRegistryCheckReport mocks base method.
+func (m *MockAPI) UpdateMirrorRegistryCheckReport(arg0 context.Context, arg1 *models.Host, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMirrorRegistryCheckReport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMirrorRegistryCheckReport indicates an expected call of UpdateMirrorRegistryCheckReport.
+func (mr *MockAPIMockRecorder) UpdateMirrorRegistryCheckReport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMirrorRegistryCheckReport", reflect.TypeOf((*MockAPI)(nil).UpdateMirrorRegistryCheckReport), arg0, arg1, arg2)
+}
+
 // UpdateNTP mocks base method.
 func (m *MockAPI) UpdateNTP(arg0 context.Context, arg1 *models.Host, arg2 []*models.NtpSource, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -761,6 +789,20 @@ func (mr *MockAPIMockRecorder) UpdateNTP(arg0, arg1, arg2, arg3 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNTP", reflect.TypeOf((*MockAPI)(nil).UpdateNTP), arg0, arg1, arg2, arg3)
 }
 
+// UpdateNetworkThroughputReport mocks base method.
+func (m *MockAPI) UpdateNetworkThroughputReport(arg0 context.Context, arg1 *models.Host, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkThroughputReport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNetworkThroughputReport indicates an expected call of UpdateNetworkThroughputReport.
+func (mr *MockAPIMockRecorder) UpdateNetworkThroughputReport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkThroughputReport", reflect.TypeOf((*MockAPI)(nil).UpdateNetworkThroughputReport), arg0, arg1, arg2)
+}
+
 // UpdateNodeLabels mocks base method.
 func (m *MockAPI) UpdateNodeLabels(arg0 context.Context, arg1 *models.Host, arg2 string, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
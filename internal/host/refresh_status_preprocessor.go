@@ -252,6 +252,10 @@ func newValidations(v *validator) []validation {
 			id:        HasSufficientPacketLossRequirementForRole,
 			condition: v.hasSufficientPacketLossRequirementForRole,
 		},
+		{
+			id:        HasSufficientNetworkThroughputRequirementForRole,
+			condition: v.hasSufficientNetworkThroughputRequirementForRole,
+		},
 		{
 			id:        HasDefaultRoute,
 			condition: v.hasDefaultRoute,
@@ -306,6 +310,14 @@ func newValidations(v *validator) []validation {
 			id:        NoSkipInstallationDisk,
 			condition: v.noSkipInstallationDisk,
 		},
+		{
+			id:        IsInstallationDiskHealthy,
+			condition: v.isInstallationDiskHealthy,
+		},
+		{
+			id:        IsFirmwareCompliant,
+			condition: v.isFirmwareCompliant,
+		},
 		{
 			id:        NoSkipMissingDisk,
 			condition: v.noSkipMissingDisk,
@@ -985,6 +985,7 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler) stat
 		If(AreOscRequirementsSatisfied),
 		If(HasSufficientNetworkLatencyRequirementForRole),
 		If(HasSufficientPacketLossRequirementForRole),
+		If(HasSufficientNetworkThroughputRequirementForRole),
 		If(HasDefaultRoute),
 		If(IsAPIDomainNameResolvedCorrectly),
 		If(IsAPIInternalDomainNameResolvedCorrectly),
@@ -996,6 +997,8 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler) stat
 		If(CompatibleAgent),
 		If(IsTimeSyncedBetweenHostAndService),
 		If(NoSkipInstallationDisk),
+		If(IsInstallationDiskHealthy),
+		If(IsFirmwareCompliant),
 		If(NoSkipMissingDisk),
 		If(NoIPCollisionsInNetwork),
 		If(NoIscsiNicBelongsToMachineCidr),
@@ -5488,6 +5488,7 @@ var _ = Describe("Refresh Host", func() {
 		BeforeEach(func() {
 			mockDefaultClusterHostRequirements(mockHwValidator)
 			pr.EXPECT().IsHostSupported(commontesting.EqPlatformType(models.PlatformTypeVsphere), gomock.Any()).Return(false, nil).AnyTimes()
+			pr.EXPECT().IsHostSupported(commontesting.EqPlatformType(models.PlatformTypeOpenstack), gomock.Any()).Return(true, nil).AnyTimes()
 			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
 				eventstest.WithNameMatcher(eventgen.HostStatusUpdatedEventName),
 				eventstest.WithHostIdMatcher(hostId.String()),
@@ -5498,6 +5499,7 @@ var _ = Describe("Refresh Host", func() {
 			name                  string
 			hostPlatform          string
 			userManagedNetworking bool
+			platformType          models.PlatformType
 			dstState              string
 		}{
 			{
@@ -5512,6 +5514,13 @@ var _ = Describe("Refresh Host", func() {
 				userManagedNetworking: true,
 				dstState:              models.HostStatusKnown,
 			},
+			{
+				name:                  fmt.Sprintf("validate %s and openstack platform", OpenStackPlatform),
+				hostPlatform:          OpenStackPlatform,
+				userManagedNetworking: false,
+				platformType:          models.PlatformTypeOpenstack,
+				dstState:              models.HostStatusKnown,
+			},
 		}
 
 		for i := range tests {
@@ -5530,6 +5539,9 @@ var _ = Describe("Refresh Host", func() {
 				Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
 				cluster = hostutil.GenerateTestCluster(clusterId)
 				cluster.UserManagedNetworking = swag.Bool(t.userManagedNetworking)
+				if t.platformType != "" {
+					cluster.Platform = &models.Platform{Type: common.PlatformTypePtr(t.platformType)}
+				}
 				cluster.Name = common.TestDefaultConfig.ClusterName
 				cluster.BaseDNSDomain = common.TestDefaultConfig.BaseDNSDomain
 				cluster.ControlPlaneCount = 1
@@ -6640,6 +6652,7 @@ var allValidationIDs = []validationID{
 	SufficientOrUnknownInstallationDiskSpeed,
 	HasSufficientNetworkLatencyRequirementForRole,
 	HasSufficientPacketLossRequirementForRole,
+	HasSufficientNetworkThroughputRequirementForRole,
 	HasDefaultRoute,
 	IsAPIDomainNameResolvedCorrectly,
 	IsAPIInternalDomainNameResolvedCorrectly,
@@ -6651,6 +6664,8 @@ var allValidationIDs = []validationID{
 	VSphereHostUUIDEnabled,
 	CompatibleAgent,
 	NoSkipInstallationDisk,
+	IsInstallationDiskHealthy,
+	IsFirmwareCompliant,
 	NoSkipMissingDisk,
 	NoIPCollisionsInNetwork,
 	IsReleaseDomainNameResolvedCorrectly,
@@ -6804,6 +6819,18 @@ var _ = Describe("State machine test - refresh transition", func() {
 			Expect(string(testState.State())).To(Equal(models.HostStatusKnown))
 		})
 
+		It("Moves from known to insufficient when the installation disk is unhealthy", func() {
+			refreshHostArgs.conditions[string(IsInstallationDiskHealthy)] = false
+
+			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+			Expect(string(testState.State())).To(Equal(models.HostStatusInsufficient))
+
+			refreshHostArgs.conditions[string(IsInstallationDiskHealthy)] = true
+
+			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+			Expect(string(testState.State())).To(Equal(models.HostStatusKnown))
+		})
+
 		It("Moves from known to insufficient when disk skip validations fail - no skip missing disk", func() {
 			refreshHostArgs.conditions[string(NoSkipMissingDisk)] = false
 
@@ -11,71 +11,74 @@ import (
 type validationID models.HostValidationID
 
 const (
-	IsMediaConnected                               = validationID(models.HostValidationIDMediaConnected)
-	IsConnected                                    = validationID(models.HostValidationIDConnected)
-	HasInventory                                   = validationID(models.HostValidationIDHasInventory)
-	IsMachineCidrDefined                           = validationID(models.HostValidationIDMachineCidrDefined)
-	BelongsToMachineCidr                           = validationID(models.HostValidationIDBelongsToMachineCidr)
-	HasMinCPUCores                                 = validationID(models.HostValidationIDHasMinCPUCores)
-	HasMinValidDisks                               = validationID(models.HostValidationIDHasMinValidDisks)
-	HasMinMemory                                   = validationID(models.HostValidationIDHasMinMemory)
-	HasCPUCoresForRole                             = validationID(models.HostValidationIDHasCPUCoresForRole)
-	HasMemoryForRole                               = validationID(models.HostValidationIDHasMemoryForRole)
-	IsHostnameUnique                               = validationID(models.HostValidationIDHostnameUnique)
-	IsHostnameValid                                = validationID(models.HostValidationIDHostnameValid)
-	IsIgnitionDownloadable                         = validationID(models.HostValidationIDIgnitionDownloadable)
-	BelongsToMajorityGroup                         = validationID(models.HostValidationIDBelongsToMajorityGroup)
-	IsPlatformNetworkSettingsValid                 = validationID(models.HostValidationIDValidPlatformNetworkSettings)
-	IsNTPSynced                                    = validationID(models.HostValidationIDNtpSynced)
-	IsTimeSyncedBetweenHostAndService              = validationID(models.HostValidationIDTimeSyncedBetweenHostAndService)
-	SucessfullOrUnknownContainerImagesAvailability = validationID(models.HostValidationIDContainerImagesAvailable)
-	AreLsoRequirementsSatisfied                    = validationID(models.HostValidationIDLsoRequirementsSatisfied)
-	AreOdfRequirementsSatisfied                    = validationID(models.HostValidationIDOdfRequirementsSatisfied)
-	AreCnvRequirementsSatisfied                    = validationID(models.HostValidationIDCnvRequirementsSatisfied)
-	AreLvmRequirementsSatisfied                    = validationID(models.HostValidationIDLvmRequirementsSatisfied)
-	AreMceRequirementsSatisfied                    = validationID(models.HostValidationIDMceRequirementsSatisfied)
-	AreMtvRequirementsSatisfied                    = validationID(models.HostValidationIDMtvRequirementsSatisfied)
-	AreOscRequirementsSatisfied                    = validationID(models.HostValidationIDOscRequirementsSatisfied)
-	SufficientOrUnknownInstallationDiskSpeed       = validationID(models.HostValidationIDSufficientInstallationDiskSpeed)
-	HasSufficientNetworkLatencyRequirementForRole  = validationID(models.HostValidationIDSufficientNetworkLatencyRequirementForRole)
-	HasSufficientPacketLossRequirementForRole      = validationID(models.HostValidationIDSufficientPacketLossRequirementForRole)
-	HasDefaultRoute                                = validationID(models.HostValidationIDHasDefaultRoute)
-	IsAPIDomainNameResolvedCorrectly               = validationID(models.HostValidationIDAPIDomainNameResolvedCorrectly)
-	IsAPIInternalDomainNameResolvedCorrectly       = validationID(models.HostValidationIDAPIIntDomainNameResolvedCorrectly)
-	IsAppsDomainNameResolvedCorrectly              = validationID(models.HostValidationIDAppsDomainNameResolvedCorrectly)
-	IsReleaseDomainNameResolvedCorrectly           = validationID(models.HostValidationIDReleaseDomainNameResolvedCorrectly)
-	CompatibleWithClusterPlatform                  = validationID(models.HostValidationIDCompatibleWithClusterPlatform)
-	IsDNSWildcardNotConfigured                     = validationID(models.HostValidationIDDNSWildcardNotConfigured)
-	DiskEncryptionRequirementsSatisfied            = validationID(models.HostValidationIDDiskEncryptionRequirementsSatisfied)
-	NonOverlappingSubnets                          = validationID(models.HostValidationIDNonOverlappingSubnets)
-	VSphereHostUUIDEnabled                         = validationID(models.HostValidationIDVsphereDiskUUIDEnabled)
-	CompatibleAgent                                = validationID(models.HostValidationIDCompatibleAgent)
-	NoSkipInstallationDisk                         = validationID(models.HostValidationIDNoSkipInstallationDisk)
-	NoSkipMissingDisk                              = validationID(models.HostValidationIDNoSkipMissingDisk)
-	NoIPCollisionsInNetwork                        = validationID(models.HostValidationIDNoIPCollisionsInNetwork)
-	NoIscsiNicBelongsToMachineCidr                 = validationID(models.HostValidationIDNoIscsiNicBelongsToMachineCidr)
-	AreNodeFeatureDiscoveryRequirementsSatisfied   = validationID(models.HostValidationIDNodeFeatureDiscoveryRequirementsSatisfied)
-	AreNvidiaGPURequirementsSatisfied              = validationID(models.HostValidationIDNvidiaGpuRequirementsSatisfied)
-	ArePipelinesRequirementsSatisfied              = validationID(models.HostValidationIDPipelinesRequirementsSatisfied)
-	AreServiceMeshRequirementsSatisfied            = validationID(models.HostValidationIDServicemeshRequirementsSatisfied)
-	AreServerLessRequirementsSatisfied             = validationID(models.HostValidationIDServerlessRequirementsSatisfied)
-	AreOpenShiftAIRequirementsSatisfied            = validationID(models.HostValidationIDOpenshiftAiRequirementsSatisfied)
-	AreAuthorinoRequirementsSatisfied              = validationID(models.HostValidationIDAuthorinoRequirementsSatisfied)
-	IsMtuValid                                     = validationID(models.HostValidationIDMtuValid)
-	AreNmstateRequirementsSatisfied                = validationID(models.HostValidationIDNmstateRequirementsSatisfied)
-	AreAMDGPURequirementsSatisfied                 = validationID(models.HostValidationIDAmdGpuRequirementsSatisfied)
-	AreKMMRequirementsSatisfied                    = validationID(models.HostValidationIDKmmRequirementsSatisfied)
-	AreNodeHealthcheckRequirementsSatisfied        = validationID(models.HostValidationIDNodeHealthcheckRequirementsSatisfied)
-	AreSelfNodeRemediationRequirementsSatisfied    = validationID(models.HostValidationIDSelfNodeRemediationRequirementsSatisfied)
-	AreFenceAgentsRemediationRequirementsSatisfied = validationID(models.HostValidationIDFenceAgentsRemediationRequirementsSatisfied)
-	AreNodeMaintenanceRequirementsSatisfied        = validationID(models.HostValidationIDNodeMaintenanceRequirementsSatisfied)
-	AreKubeDeschedulerRequirementsSatisfied        = validationID(models.HostValidationIDKubeDeschedulerRequirementsSatisfied)
-	AreClusterObservabilityRequirementsSatisfied   = validationID(models.HostValidationIDClusterObservabilityRequirementsSatisfied)
-	AreNUMAResourcesRequirementsSatisfied          = validationID(models.HostValidationIDNumaResourcesRequirementsSatisfied)
-	AreOADPRequirementsSatisfied                   = validationID(models.HostValidationIDOadpRequirementsSatisfied)
-	AreMetalLBRequirementsSatisfied                = validationID(models.HostValidationIDMetallbRequirementsSatisfied)
-	AreLokiRequirementsSatisfied                   = validationID(models.HostValidationIDLokiRequirementsSatisfied)
-	AreOpenShiftLoggingRequirementsSatisfied       = validationID(models.HostValidationIDOpenshiftLoggingRequirementsSatisfied)
+	IsMediaConnected                                 = validationID(models.HostValidationIDMediaConnected)
+	IsConnected                                      = validationID(models.HostValidationIDConnected)
+	HasInventory                                     = validationID(models.HostValidationIDHasInventory)
+	IsMachineCidrDefined                             = validationID(models.HostValidationIDMachineCidrDefined)
+	BelongsToMachineCidr                             = validationID(models.HostValidationIDBelongsToMachineCidr)
+	HasMinCPUCores                                   = validationID(models.HostValidationIDHasMinCPUCores)
+	HasMinValidDisks                                 = validationID(models.HostValidationIDHasMinValidDisks)
+	HasMinMemory                                     = validationID(models.HostValidationIDHasMinMemory)
+	HasCPUCoresForRole                               = validationID(models.HostValidationIDHasCPUCoresForRole)
+	HasMemoryForRole                                 = validationID(models.HostValidationIDHasMemoryForRole)
+	IsHostnameUnique                                 = validationID(models.HostValidationIDHostnameUnique)
+	IsHostnameValid                                  = validationID(models.HostValidationIDHostnameValid)
+	IsIgnitionDownloadable                           = validationID(models.HostValidationIDIgnitionDownloadable)
+	BelongsToMajorityGroup                           = validationID(models.HostValidationIDBelongsToMajorityGroup)
+	IsPlatformNetworkSettingsValid                   = validationID(models.HostValidationIDValidPlatformNetworkSettings)
+	IsNTPSynced                                      = validationID(models.HostValidationIDNtpSynced)
+	IsTimeSyncedBetweenHostAndService                = validationID(models.HostValidationIDTimeSyncedBetweenHostAndService)
+	SucessfullOrUnknownContainerImagesAvailability   = validationID(models.HostValidationIDContainerImagesAvailable)
+	AreLsoRequirementsSatisfied                      = validationID(models.HostValidationIDLsoRequirementsSatisfied)
+	AreOdfRequirementsSatisfied                      = validationID(models.HostValidationIDOdfRequirementsSatisfied)
+	AreCnvRequirementsSatisfied                      = validationID(models.HostValidationIDCnvRequirementsSatisfied)
+	AreLvmRequirementsSatisfied                      = validationID(models.HostValidationIDLvmRequirementsSatisfied)
+	AreMceRequirementsSatisfied                      = validationID(models.HostValidationIDMceRequirementsSatisfied)
+	AreMtvRequirementsSatisfied                      = validationID(models.HostValidationIDMtvRequirementsSatisfied)
+	AreOscRequirementsSatisfied                      = validationID(models.HostValidationIDOscRequirementsSatisfied)
+	SufficientOrUnknownInstallationDiskSpeed         = validationID(models.HostValidationIDSufficientInstallationDiskSpeed)
+	HasSufficientNetworkLatencyRequirementForRole    = validationID(models.HostValidationIDSufficientNetworkLatencyRequirementForRole)
+	HasSufficientPacketLossRequirementForRole        = validationID(models.HostValidationIDSufficientPacketLossRequirementForRole)
+	HasSufficientNetworkThroughputRequirementForRole = validationID(models.HostValidationIDSufficientNetworkThroughputRequirementForRole)
+	HasDefaultRoute                                  = validationID(models.HostValidationIDHasDefaultRoute)
+	IsAPIDomainNameResolvedCorrectly                 = validationID(models.HostValidationIDAPIDomainNameResolvedCorrectly)
+	IsAPIInternalDomainNameResolvedCorrectly         = validationID(models.HostValidationIDAPIIntDomainNameResolvedCorrectly)
+	IsAppsDomainNameResolvedCorrectly                = validationID(models.HostValidationIDAppsDomainNameResolvedCorrectly)
+	IsReleaseDomainNameResolvedCorrectly             = validationID(models.HostValidationIDReleaseDomainNameResolvedCorrectly)
+	CompatibleWithClusterPlatform                    = validationID(models.HostValidationIDCompatibleWithClusterPlatform)
+	IsDNSWildcardNotConfigured                       = validationID(models.HostValidationIDDNSWildcardNotConfigured)
+	DiskEncryptionRequirementsSatisfied              = validationID(models.HostValidationIDDiskEncryptionRequirementsSatisfied)
+	NonOverlappingSubnets                            = validationID(models.HostValidationIDNonOverlappingSubnets)
+	VSphereHostUUIDEnabled                           = validationID(models.HostValidationIDVsphereDiskUUIDEnabled)
+	CompatibleAgent                                  = validationID(models.HostValidationIDCompatibleAgent)
+	NoSkipInstallationDisk                           = validationID(models.HostValidationIDNoSkipInstallationDisk)
+	IsInstallationDiskHealthy                        = validationID(models.HostValidationIDInstallationDiskHealthy)
+	IsFirmwareCompliant                              = validationID(models.HostValidationIDFirmwareCompliant)
+	NoSkipMissingDisk                                = validationID(models.HostValidationIDNoSkipMissingDisk)
+	NoIPCollisionsInNetwork                          = validationID(models.HostValidationIDNoIPCollisionsInNetwork)
+	NoIscsiNicBelongsToMachineCidr                   = validationID(models.HostValidationIDNoIscsiNicBelongsToMachineCidr)
+	AreNodeFeatureDiscoveryRequirementsSatisfied     = validationID(models.HostValidationIDNodeFeatureDiscoveryRequirementsSatisfied)
+	AreNvidiaGPURequirementsSatisfied                = validationID(models.HostValidationIDNvidiaGpuRequirementsSatisfied)
+	ArePipelinesRequirementsSatisfied                = validationID(models.HostValidationIDPipelinesRequirementsSatisfied)
+	AreServiceMeshRequirementsSatisfied              = validationID(models.HostValidationIDServicemeshRequirementsSatisfied)
+	AreServerLessRequirementsSatisfied               = validationID(models.HostValidationIDServerlessRequirementsSatisfied)
+	AreOpenShiftAIRequirementsSatisfied              = validationID(models.HostValidationIDOpenshiftAiRequirementsSatisfied)
+	AreAuthorinoRequirementsSatisfied                = validationID(models.HostValidationIDAuthorinoRequirementsSatisfied)
+	IsMtuValid                                       = validationID(models.HostValidationIDMtuValid)
+	AreNmstateRequirementsSatisfied                  = validationID(models.HostValidationIDNmstateRequirementsSatisfied)
+	AreAMDGPURequirementsSatisfied                   = validationID(models.HostValidationIDAmdGpuRequirementsSatisfied)
+	AreKMMRequirementsSatisfied                      = validationID(models.HostValidationIDKmmRequirementsSatisfied)
+	AreNodeHealthcheckRequirementsSatisfied          = validationID(models.HostValidationIDNodeHealthcheckRequirementsSatisfied)
+	AreSelfNodeRemediationRequirementsSatisfied      = validationID(models.HostValidationIDSelfNodeRemediationRequirementsSatisfied)
+	AreFenceAgentsRemediationRequirementsSatisfied   = validationID(models.HostValidationIDFenceAgentsRemediationRequirementsSatisfied)
+	AreNodeMaintenanceRequirementsSatisfied          = validationID(models.HostValidationIDNodeMaintenanceRequirementsSatisfied)
+	AreKubeDeschedulerRequirementsSatisfied          = validationID(models.HostValidationIDKubeDeschedulerRequirementsSatisfied)
+	AreClusterObservabilityRequirementsSatisfied     = validationID(models.HostValidationIDClusterObservabilityRequirementsSatisfied)
+	AreNUMAResourcesRequirementsSatisfied            = validationID(models.HostValidationIDNumaResourcesRequirementsSatisfied)
+	AreOADPRequirementsSatisfied                     = validationID(models.HostValidationIDOadpRequirementsSatisfied)
+	AreMetalLBRequirementsSatisfied                  = validationID(models.HostValidationIDMetallbRequirementsSatisfied)
+	AreLokiRequirementsSatisfied                     = validationID(models.HostValidationIDLokiRequirementsSatisfied)
+	AreOpenShiftLoggingRequirementsSatisfied         = validationID(models.HostValidationIDOpenshiftLoggingRequirementsSatisfied)
 )
 
 func (v validationID) category() (string, error) {
@@ -91,6 +94,7 @@ func (v validationID) category() (string, error) {
 		SucessfullOrUnknownContainerImagesAvailability,
 		HasSufficientNetworkLatencyRequirementForRole,
 		HasSufficientPacketLossRequirementForRole,
+		HasSufficientNetworkThroughputRequirementForRole,
 		HasDefaultRoute,
 		IsAPIDomainNameResolvedCorrectly,
 		IsAPIInternalDomainNameResolvedCorrectly,
@@ -117,6 +121,8 @@ func (v validationID) category() (string, error) {
 		DiskEncryptionRequirementsSatisfied,
 		CompatibleAgent,
 		NoSkipInstallationDisk,
+		IsInstallationDiskHealthy,
+		IsFirmwareCompliant,
 		NoSkipMissingDisk:
 		return "hardware", nil
 	case AreLsoRequirementsSatisfied,
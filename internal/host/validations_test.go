@@ -22,6 +22,7 @@ import (
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/hardware/firmware"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
@@ -1258,6 +1259,78 @@ var _ = Describe("Validations test", func() {
 			Expect(validationMessage).To(Equal("Disk encryption check was not performed yet"))
 		})
 
+		It("threshold mode - validation success", func() {
+			c := hostutil.GenerateTestCluster(clusterID)
+			c.DiskEncryption = &models.DiskEncryption{
+				EnableOn:      swag.String(models.DiskEncryptionEnableOnMasters),
+				Mode:          swag.String(models.DiskEncryptionModeThreshold),
+				TangServers:   `[{"URL":"http://tang.example.com:7500","Thumbprint":"PLjNyRdGw03zlRoGjQYMahSZGu9"}]`,
+				ThresholdSpec: `{"threshold":2,"tpm2":true,"tang_threshold":1}`,
+			}
+			Expect(db.Create(&c).Error).ToNot(HaveOccurred())
+
+			h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleMaster)
+			h.TangConnectivity = hostutil.GenerateTestTangConnectivity(true)
+			h.Inventory = common.GenerateTestInventoryWithTpmVersion(models.InventoryTpmVersionNr20)
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+			mockAndRefreshStatus(&h)
+
+			h = hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).Host
+			validationStatus, validationMessage, found := getValidationResult(h.ValidationsInfo, diskEncryptionID)
+			Expect(found).To(BeTrue())
+			Expect(validationStatus).To(Equal(ValidationSuccess))
+			Expect(validationMessage).To(Equal(fmt.Sprintf("Installation disk can be encrypted using %s", models.DiskEncryptionModeThreshold)))
+		})
+
+		It("threshold mode - TPM is disabled in host's BIOS", func() {
+			c := hostutil.GenerateTestCluster(clusterID)
+			c.DiskEncryption = &models.DiskEncryption{
+				EnableOn:      swag.String(models.DiskEncryptionEnableOnMasters),
+				Mode:          swag.String(models.DiskEncryptionModeThreshold),
+				TangServers:   `[{"URL":"http://tang.example.com:7500","Thumbprint":"PLjNyRdGw03zlRoGjQYMahSZGu9"}]`,
+				ThresholdSpec: `{"threshold":2,"tpm2":true,"tang_threshold":1}`,
+			}
+			Expect(db.Create(&c).Error).ToNot(HaveOccurred())
+
+			h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleMaster)
+			h.TangConnectivity = hostutil.GenerateTestTangConnectivity(true)
+			h.Inventory = common.GenerateTestInventoryWithTpmVersion(models.InventoryTpmVersionNone)
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+			mockAndRefreshStatus(&h)
+
+			h = hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).Host
+			validationStatus, validationMessage, found := getValidationResult(h.ValidationsInfo, diskEncryptionID)
+			Expect(found).To(BeTrue())
+			Expect(validationStatus).To(Equal(ValidationFailure))
+			Expect(validationMessage).To(Equal("TPM version could not be found, make sure TPM is enabled in host's BIOS"))
+		})
+
+		It("threshold mode - Tang servers are unreachable", func() {
+			c := hostutil.GenerateTestCluster(clusterID)
+			c.DiskEncryption = &models.DiskEncryption{
+				EnableOn:      swag.String(models.DiskEncryptionEnableOnMasters),
+				Mode:          swag.String(models.DiskEncryptionModeThreshold),
+				TangServers:   `[{"URL":"http://tang.example.com:7500","Thumbprint":"PLjNyRdGw03zlRoGjQYMahSZGu9"}]`,
+				ThresholdSpec: `{"threshold":2,"tpm2":true,"tang_threshold":1}`,
+			}
+			Expect(db.Create(&c).Error).ToNot(HaveOccurred())
+
+			h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleMaster)
+			h.TangConnectivity = hostutil.GenerateTestTangConnectivity(false)
+			h.Inventory = common.GenerateTestInventoryWithTpmVersion(models.InventoryTpmVersionNr20)
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+			mockAndRefreshStatus(&h)
+
+			h = hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).Host
+			validationStatus, validationMessage, found := getValidationResult(h.ValidationsInfo, diskEncryptionID)
+			Expect(found).To(BeTrue())
+			Expect(validationStatus).To(Equal(ValidationFailure))
+			Expect(validationMessage).Should(ContainSubstring("Could not validate that all Tang servers are reachable and working"))
+		})
+
 		It("TPM is disabled in host's BIOS", func() {
 
 			c := hostutil.GenerateTestCluster(clusterID)
@@ -2048,6 +2121,185 @@ var _ = Describe("Validations test", func() {
 		})
 	})
 
+	Context("Has sufficient network throughput requirements for role", func() {
+		var (
+			hostValidator validator
+			hosts         []*models.Host
+			c             *validationContext
+		)
+
+		BeforeEach(func() {
+			hostValidator = validator{log: common.GetTestLog()}
+			hosts = nil
+			for i, role := range []models.HostRole{models.HostRoleMaster, models.HostRoleMaster, models.HostRoleMaster, models.HostRoleWorker} {
+				id := strfmt.UUID(uuid.New().String())
+				hosts = append(hosts, &models.Host{
+					ID:        &id,
+					ClusterID: &clusterID,
+					Role:      role,
+					Inventory: hostutil.GenerateMasterInventoryWithHostname(fmt.Sprintf("host-%d", i)),
+				})
+			}
+			c = &validationContext{
+				host:           hosts[0],
+				cluster:        &common.Cluster{Cluster: models.Cluster{ID: &clusterID, Hosts: hosts}},
+				inventory:      &models.Inventory{},
+				inventoryCache: InventoryCache{},
+				clusterHostRequirements: &models.ClusterHostRequirements{
+					Total: &models.ClusterHostRequirementsDetails{NetworkThroughputMbps: swag.Float64(1000)},
+				},
+			}
+		})
+
+		setThroughput := func(h, remote *models.Host, throughput float64) {
+			report := &models.ConnectivityReport{}
+			if h.Connectivity != "" {
+				var err error
+				report, err = hostutil.UnmarshalConnectivityReport(h.Connectivity)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{HostID: *remote.ID, ThroughputMbps: swag.Float64(throughput)})
+			connectivity, err := hostutil.MarshalConnectivityReport(report)
+			Expect(err).ToNot(HaveOccurred())
+			h.Connectivity = connectivity
+		}
+
+		It("succeeds without a throughput requirement", func() {
+			c.clusterHostRequirements.Total.NetworkThroughputMbps = nil
+			status, message := hostValidator.hasSufficientNetworkThroughputRequirementForRole(c)
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Network throughput requirement has been satisfied."))
+		})
+
+		It("is pending until the throughput to all the hosts of the role is measured", func() {
+			setThroughput(hosts[0], hosts[1], 9000)
+			status, message := hostValidator.hasSufficientNetworkThroughputRequirementForRole(c)
+			Expect(status).To(Equal(ValidationPending))
+			Expect(message).To(Equal("The network throughput to 1 hosts of the same role has not been measured yet."))
+		})
+
+		It("uses the throughput measured by the other hosts", func() {
+			setThroughput(hosts[0], hosts[1], 9000)
+			setThroughput(hosts[2], hosts[0], 1000)
+			status, _ := hostValidator.hasSufficientNetworkThroughputRequirementForRole(c)
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("fails when the throughput to a host of the role is too low", func() {
+			setThroughput(hosts[0], hosts[1], 9000)
+			setThroughput(hosts[2], hosts[0], 100)
+			status, message := hostValidator.hasSufficientNetworkThroughputRequirementForRole(c)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal(fmt.Sprintf("A network throughput below the required 1000.00 Mbps was measured between host %s and host-2 (100.00 Mbps)", hosts[0].ID)))
+		})
+	})
+
+	Context("Installation disk healthy", func() {
+		var (
+			hostValidator validator
+			host          *models.Host
+			disk          *models.Disk
+		)
+
+		BeforeEach(func() {
+			hostValidator = validator{log: common.GetTestLog()}
+			disk = &models.Disk{ID: "/dev/disk/by-id/wwn-0x1111", Name: "sda", Path: "/dev/sda", DriveType: models.DriveTypeHDD}
+			id := strfmt.UUID(uuid.New().String())
+			host = &models.Host{ID: &id, InstallationDiskID: disk.ID, InstallationDiskPath: disk.Path}
+		})
+
+		validate := func() (ValidationStatus, string) {
+			inventory, err := common.MarshalInventory(&models.Inventory{Disks: []*models.Disk{disk}})
+			Expect(err).ToNot(HaveOccurred())
+			host.Inventory = inventory
+			return hostValidator.isInstallationDiskHealthy(&validationContext{host: host, inventoryCache: InventoryCache{}})
+		}
+
+		It("is pending without an inventory", func() {
+			status, _ := hostValidator.isInstallationDiskHealthy(&validationContext{host: host, inventoryCache: InventoryCache{}})
+			Expect(status).To(Equal(ValidationPending))
+		})
+
+		It("succeeds for disks without SMART data", func() {
+			status, _ := validate()
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("succeeds for a healthy disk", func() {
+			disk.Health = &models.DiskHealth{SmartPassed: true, PendingSectors: 1}
+			status, message := validate()
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("The installation disk reports no SMART health problems"))
+		})
+
+		It("fails for a disk that predicts its failure", func() {
+			disk.Health = &models.DiskHealth{PredictedFailure: true}
+			status, message := validate()
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("The installation disk sda reports a predicted failure in its SMART data. Please replace the disk or select another installation disk"))
+		})
+
+		It("fails for a disk with reallocated sectors", func() {
+			disk.Health = &models.DiskHealth{SmartPassed: true, ReallocatedSectors: 8}
+			status, message := validate()
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("The installation disk sda reports 8 reallocated sectors in its SMART data. Please replace the disk or select another installation disk"))
+		})
+	})
+
+	Context("Firmware compliant", func() {
+		var (
+			hostValidator validator
+			host          *models.Host
+		)
+
+		BeforeEach(func() {
+			hostValidator = validator{log: common.GetTestLog(), hwValidatorCfg: &hardware.ValidatorCfg{
+				FirmwarePolicy: firmware.PolicyDecoder{BootMode: firmware.BootModeUEFI, Roles: []models.HostRole{models.HostRoleWorker}},
+			}}
+			id := strfmt.UUID(uuid.New().String())
+			host = &models.Host{ID: &id, Role: models.HostRoleWorker}
+		})
+
+		validate := func(inventory *models.Inventory) (ValidationStatus, string) {
+			if inventory != nil {
+				inventoryStr, err := common.MarshalInventory(inventory)
+				Expect(err).ToNot(HaveOccurred())
+				host.Inventory = inventoryStr
+			}
+			return hostValidator.isFirmwareCompliant(&validationContext{host: host, inventoryCache: InventoryCache{}})
+		}
+
+		It("succeeds without a firmware policy", func() {
+			hostValidator.hwValidatorCfg.FirmwarePolicy = firmware.PolicyDecoder{}
+			status, _ := validate(nil)
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("succeeds for the roles that the policy doesn't apply to", func() {
+			host.Role = models.HostRoleMaster
+			status, _ := validate(&models.Inventory{Boot: &models.Boot{CurrentBootMode: "bios"}})
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("is pending without an inventory", func() {
+			status, _ := validate(nil)
+			Expect(status).To(Equal(ValidationPending))
+		})
+
+		It("succeeds for a compliant host", func() {
+			status, message := validate(&models.Inventory{Boot: &models.Boot{CurrentBootMode: "uefi"}})
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("The firmware settings of the host comply with the firmware policy"))
+		})
+
+		It("fails for a host that doesn't comply", func() {
+			status, message := validate(&models.Inventory{Boot: &models.Boot{CurrentBootMode: "bios"}})
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("The firmware settings of the host don't comply with the firmware policy: The host must boot in UEFI mode, but it booted in legacy BIOS mode"))
+		})
+	})
+
 	Context("Has sufficient network latency requirements for role", func() {
 		var (
 			host    models.Host
@@ -18,6 +18,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/hardware/firmware"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
@@ -26,6 +27,7 @@ import (
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/tang"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -476,6 +478,15 @@ func (v *validator) areTangServersReachable(c *validationContext) (ValidationSta
 	return ValidationFailure, fmt.Sprintf("Could not validate that all Tang servers are reachable and working: %s", c.host.TangConnectivity)
 }
 
+// areThresholdPinsAvailable checks that the host can bind its disk to all the pins of the threshold policy. The
+// failure message is empty when the TPM is missing, as it is set by the caller
+func (v *validator) areThresholdPinsAvailable(c *validationContext, spec *tang.ThresholdSpec) (ValidationStatus, string) {
+	if spec.TPM2 && c.inventory.TpmVersion != models.InventoryTpmVersionNr20 {
+		return ValidationFailure, ""
+	}
+	return v.areTangServersReachable(c)
+}
+
 func (v *validator) diskEncryptionRequirementsSatisfied(c *validationContext) (ValidationStatus, string) {
 
 	var status ValidationStatus
@@ -510,8 +521,18 @@ func (v *validator) diskEncryptionRequirementsSatisfied(c *validationContext) (V
 			if status == ValidationFailure {
 				return status, message
 			}
+		} else if luks.Clevis.Custom != nil && luks.Clevis.Custom.Pin == tang.ClevisSSSPin {
+			c.cluster.DiskEncryption.Mode = swag.String(models.DiskEncryptionModeThreshold)
+			spec, _, err := tang.ParseClevisConfig(luks.Clevis.Custom.Config)
+			if err != nil {
+				return ValidationError, "Invalid Clevis sss configuration in ignition"
+			}
+			status, message = v.areThresholdPinsAvailable(c, spec)
+			if status == ValidationFailure && message != "" {
+				return status, message
+			}
 		} else {
-			// Only Tpm2 and Tang are available for disk encryption
+			// Only Tpm2, Tang and their threshold combination are available for disk encryption
 			status = ValidationFailure
 		}
 
@@ -525,12 +546,22 @@ func (v *validator) diskEncryptionRequirementsSatisfied(c *validationContext) (V
 		if !hostutil.IsDiskEncryptionEnabledForRole(*c.cluster.DiskEncryption, role) {
 			return ValidationSuccessSuppressOutput, ""
 		}
-		if swag.StringValue(c.cluster.DiskEncryption.Mode) == models.DiskEncryptionModeTang {
+		switch swag.StringValue(c.cluster.DiskEncryption.Mode) {
+		case models.DiskEncryptionModeTang:
 			status, message = v.areTangServersReachable(c)
 			if status == ValidationFailure {
 				return status, message
 			}
-		} else { // Mode TPMv2
+		case models.DiskEncryptionModeThreshold:
+			spec, err := tang.UnmarshalThresholdSpec(c.cluster.DiskEncryption.ThresholdSpec)
+			if err != nil {
+				return ValidationError, "Invalid threshold_spec"
+			}
+			status, message = v.areThresholdPinsAvailable(c, spec)
+			if status == ValidationFailure && message != "" {
+				return status, message
+			}
+		default: // Mode TPMv2
 			status = boolValue(c.inventory.TpmVersion == models.InventoryTpmVersionNr20)
 		}
 
@@ -652,8 +683,9 @@ func (v *validator) isValidPlatformNetworkSettings(c *validationContext) (Valida
 		if c.infraEnv != nil {
 			return ValidationSuccessSuppressOutput, ""
 		} else {
-			//In case userManagedNetworking is true, we don't care about the platform
-			if swag.BoolValue(c.cluster.UserManagedNetworking) {
+			//In case userManagedNetworking is true, or the cluster is integrated with OpenStack, we don't care about the platform
+			if swag.BoolValue(c.cluster.UserManagedNetworking) ||
+				(c.cluster.Platform != nil && common.PlatformTypeValue(c.cluster.Platform.Type) == models.PlatformTypeOpenstack) {
 				return ValidationSuccess, fmt.Sprintf("Platform %s is allowed", c.inventory.SystemVendor.ProductName)
 			}
 			return ValidationFailure, fmt.Sprintf("Platform %s is allowed only for Single Node OpenShift or user-managed networking", c.inventory.SystemVendor.ProductName)
@@ -1132,6 +1164,52 @@ func (v *validator) hasSufficientPacketLossRequirementForRole(c *validationConte
 	return status, fmt.Sprintf("Unexpected status %s", status)
 }
 
+func (v *validator) hasSufficientNetworkThroughputRequirementForRole(c *validationContext) (ValidationStatus, string) {
+	if c.inventory == nil {
+		return ValidationPending, "The inventory is not available yet."
+	}
+	if c.infraEnv != nil {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	if len(c.cluster.Hosts) == 1 || c.clusterHostRequirements.Total.NetworkThroughputMbps == nil || common.GetEffectiveRole(c.host) == models.HostRoleAutoAssign || hostutil.IsDay2Host(c.host) {
+		// Single Node use case || no requirements defined || role is auto assign
+		return ValidationSuccess, "Network throughput requirement has been satisfied."
+	}
+	required := *c.clusterHostRequirements.Total.NetworkThroughputMbps
+	role := common.GetEffectiveRole(c.host)
+	missing := 0
+	failedHostMetrics := []hostTimingMetric{}
+	for _, h := range c.cluster.Hosts {
+		if *h.ID == *c.host.ID || common.GetEffectiveRole(h) != role {
+			continue
+		}
+		throughput, err := hostutil.GetNetworkThroughputMbps(c.host, h)
+		if err != nil {
+			v.log.WithError(err).Errorf("Unable to get the network throughput between hosts %s and %s", c.host.ID, h.ID)
+			return ValidationError, "Parse error while attempting to process the connectivity report"
+		}
+		if throughput == nil {
+			missing++
+			continue
+		}
+		if *throughput < required {
+			hostname, _, err := GetHostnameAndEffectiveRoleByHostID(*h.ID, c.cluster.Hosts, c.inventoryCache)
+			if err != nil || hostname == "" {
+				hostname = h.ID.String()
+			}
+			failedHostMetrics = append(failedHostMetrics, hostTimingMetric{otherHostName: hostname, timingMetric: *throughput, timingSuffix: " Mbps"})
+		}
+	}
+	if len(failedHostMetrics) > 0 {
+		return ValidationFailure, fmt.Sprintf("A network throughput below the required %.2f Mbps was measured between host %s and %s",
+			required, c.host.ID, v.summarizeHostTimingMetrics(failedHostMetrics, true))
+	}
+	if missing > 0 {
+		return ValidationPending, fmt.Sprintf("The network throughput to %d hosts of the same role has not been measured yet.", missing)
+	}
+	return ValidationSuccess, "Network throughput requirement has been satisfied."
+}
+
 func (v *validator) generatePingCommand(c *validationContext, interfaceName string, addresses []string) string {
 	var message string
 
@@ -1820,6 +1898,61 @@ func (v *validator) noSkipInstallationDisk(c *validationContext) (ValidationStat
 	return ValidationFailure, failureMessage
 }
 
+func (v *validator) isInstallationDiskHealthy(c *validationContext) (ValidationStatus, string) {
+	const (
+		pendingMessage string = "Host inventory not available yet"
+		successMessage string = "The installation disk reports no SMART health problems"
+		errorMessage   string = "Failed to unmarshal this host's inventory"
+	)
+
+	if c.host.Inventory == "" {
+		return ValidationPending, pendingMessage
+	}
+	inventory, err := c.inventoryCache.GetOrUnmarshal(c.host)
+	if err != nil || inventory == nil {
+		return ValidationError, errorMessage
+	}
+	installationDisk := hostutil.GetDiskByInstallationPath(inventory.Disks, hostutil.GetHostInstallationPath(c.host))
+	// Disks that don't report SMART data, such as virtual disks, can't be checked
+	if installationDisk == nil || installationDisk.Health == nil {
+		return ValidationSuccess, successMessage
+	}
+
+	health := installationDisk.Health
+	if health.PredictedFailure {
+		return ValidationFailure, fmt.Sprintf("The installation disk %s reports a predicted failure in its SMART data. Please replace the disk or select another installation disk", installationDisk.Name)
+	}
+	if health.ReallocatedSectors > 0 {
+		return ValidationFailure, fmt.Sprintf("The installation disk %s reports %d reallocated sectors in its SMART data. Please replace the disk or select another installation disk", installationDisk.Name, health.ReallocatedSectors)
+	}
+	return ValidationSuccess, successMessage
+}
+
+func (v *validator) isFirmwareCompliant(c *validationContext) (ValidationStatus, string) {
+	const (
+		pendingMessage string = "Host inventory not available yet"
+		successMessage string = "The firmware settings of the host comply with the firmware policy"
+		errorMessage   string = "Failed to unmarshal this host's inventory"
+	)
+
+	policy := firmware.Policy(v.hwValidatorCfg.FirmwarePolicy)
+	if policy.IsEmpty() || !policy.AppliesTo(common.GetEffectiveRole(c.host)) {
+		return ValidationSuccess, successMessage
+	}
+	if c.host.Inventory == "" {
+		return ValidationPending, pendingMessage
+	}
+	inventory, err := c.inventoryCache.GetOrUnmarshal(c.host)
+	if err != nil || inventory == nil {
+		return ValidationError, errorMessage
+	}
+	if reasons := policy.Check(inventory); len(reasons) > 0 {
+		return ValidationFailure, fmt.Sprintf("The firmware settings of the host don't comply with the firmware policy: %s",
+			strings.Join(reasons, "; "))
+	}
+	return ValidationSuccess, successMessage
+}
+
 func (v *validator) noSkipMissingDisk(c *validationContext) (ValidationStatus, string) {
 	const (
 		pendingMessage string = "Host inventory not available yet"
@@ -208,6 +208,13 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte,
 	}
 
 	mirrorRegistriesBuilder := mirrorregistries.New(forceInsecurePolicyJson)
+	// the mirror registries of the cluster take precedence over the ones of the service
+	mirrorRegistryConfiguration, mirrorErr := g.cluster.GetMirrorRegistryConfiguration()
+	if mirrorErr != nil {
+		log.WithError(mirrorErr).Warnf("Failed to get the mirror registry configuration of cluster %s", g.cluster.ID.String())
+	} else if common.IsMirrorConfigurationSet(mirrorRegistryConfiguration) {
+		mirrorRegistriesBuilder = mirrorregistries.NewFromConfiguration(mirrorRegistryConfiguration, forceInsecurePolicyJson)
+	}
 	ocRelease := oc.NewRelease(
 		&executer.CommonExecuter{},
 		oc.Config{MaxTries: oc.DefaultTries, RetryDelay: oc.DefaltRetryDelay},
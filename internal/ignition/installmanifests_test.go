@@ -125,7 +125,7 @@ var _ = Describe("Bootstrap Ignition Update", func() {
 			MaxCapacity:    installercache.Size(5),
 			MaxReleaseSize: installercache.Size(5),
 		}
-		installerCache, err = installercache.New(installerCacheConfig, eventsHandler, metricsAPI, metrics.NewOSDiskStatsHelper(logrus.New()), logrus.New())
+		installerCache, err = installercache.New(installerCacheConfig, eventsHandler, metricsAPI, metrics.NewOSDiskStatsHelper(logrus.New()), nil, logrus.New())
 		Expect(err).NotTo(HaveOccurred())
 		mockS3Client = s3wrapper.NewMockAPI(ctrl)
 		manifestsAPI = manifestsapi.NewMockManifestsAPI(ctrl)
@@ -321,7 +321,7 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 			MaxCapacity:    installercache.Size(5),
 			MaxReleaseSize: installercache.Size(5),
 		}
-		installerCache, err = installercache.New(installerCacheConfig, eventsHandler, metricsAPI, metrics.NewOSDiskStatsHelper(logrus.New()), logrus.New())
+		installerCache, err = installercache.New(installerCacheConfig, eventsHandler, metricsAPI, metrics.NewOSDiskStatsHelper(logrus.New()), nil, logrus.New())
 		Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -623,7 +623,7 @@ var _ = Describe("createHostIgnitions", func() {
 			MaxCapacity:    installercache.Size(5),
 			MaxReleaseSize: installercache.Size(5),
 		}
-		installerCache, err = installercache.New(installerCacheConfig, eventsHandler, metricsAPI, metrics.NewOSDiskStatsHelper(logrus.New()), logrus.New())
+		installerCache, err = installercache.New(installerCacheConfig, eventsHandler, metricsAPI, metrics.NewOSDiskStatsHelper(logrus.New()), nil, logrus.New())
 		Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -1973,7 +1973,7 @@ var _ = Describe("Bare metal host generation", func() {
 			MaxReleaseSize:            installercache.Size(5),
 			ReleaseFetchRetryInterval: 1 * time.Microsecond,
 		}
-		installerCache, err = installercache.New(installerCacheConfig, eventsHandler, metricsAPI, metrics.NewOSDiskStatsHelper(logrus.New()), logrus.New())
+		installerCache, err = installercache.New(installerCacheConfig, eventsHandler, metricsAPI, metrics.NewOSDiskStatsHelper(logrus.New()), nil, logrus.New())
 		Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -2352,7 +2352,7 @@ var _ = Describe("Import Cluster TLS Certs for ephemeral installer", func() {
 			MaxReleaseSize:            installercache.Size(5),
 			ReleaseFetchRetryInterval: 1 * time.Microsecond,
 		}
-		installerCache, err = installercache.New(installerCacheConfig, eventsHandler, metricsAPI, metrics.NewOSDiskStatsHelper(logrus.New()), logrus.New())
+		installerCache, err = installercache.New(installerCacheConfig, eventsHandler, metricsAPI, metrics.NewOSDiskStatsHelper(logrus.New()), nil, logrus.New())
 		Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -12,6 +12,7 @@ type Platform struct {
 	Vsphere   *VsphereInstallConfigPlatform   `json:"vsphere,omitempty"`
 	Nutanix   *NutanixInstallConfigPlatform   `json:"nutanix,omitempty"`
 	External  *ExternalInstallConfigPlatform  `json:"external,omitempty"`
+	Openstack *OpenstackInstallConfigPlatform `json:"openstack,omitempty"`
 }
 
 type BMC struct {
@@ -92,6 +93,18 @@ type VsphereVCenter struct {
 	Username string `json:"user"`
 }
 
+// OpenstackInstallConfigPlatform stores the OpenStack platform fields https://github.com/openshift/installer/blob/master/pkg/types/openstack/platform.go
+type OpenstackInstallConfigPlatform struct {
+	// Cloud is the name of the entry of clouds.yaml holding the credentials of the OpenStack cloud
+	Cloud                string                                  `json:"cloud"`
+	ExternalNetwork      string                                  `json:"externalNetwork,omitempty"`
+	DeprecatedAPIVIP     string                                  `json:"apiVIP,omitempty"`
+	DeprecatedIngressVIP string                                  `json:"ingressVIP,omitempty"`
+	IngressVIPs          []string                                `json:"ingressVIPs,omitempty"`
+	APIVIPs              []string                                `json:"apiVIPs,omitempty"`
+	LoadBalancer         *configv1.OpenStackPlatformLoadBalancer `json:"loadBalancer,omitempty"`
+}
+
 type VsphereInstallConfigPlatform struct {
 	DeprecatedVCenter          string                                `json:"vCenter,omitempty"`
 	DeprecatedUsername         string                                `json:"username,omitempty"`
@@ -19,6 +19,7 @@ import (
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sys/unix"
 )
@@ -38,6 +39,8 @@ type Installers struct {
 	diskStatsHelper metrics.DiskStatsHelper
 	config          Config
 	metricsAPI      metrics.API
+	// objectHandler is the shared storage used as a second cache tier, if enabled
+	objectHandler s3wrapper.API
 }
 
 type Size int64
@@ -50,6 +53,9 @@ type Config struct {
 	MaxReleaseSize Size `envconfig:"INSTALLER_CACHE_MAX_RELEASE_SIZE" default:"2GiB"`
 	// ReleaseFetchRetryIntervalMicroseconds is the number of microseconds that the cache should wait before retrying the fetch of a release if unable to do so for capacity reasons.
 	ReleaseFetchRetryInterval time.Duration `envconfig:"INSTALLER_CACHE_RELEASE_FETCH_RETRY_INTERVAL" default:"30s"`
+	// SharedStorageEnabled enables a second cache tier where extracted binaries are stored in the object storage, keyed by
+	// release digest, so that a release is extracted once and then shared between all the replicas.
+	SharedStorageEnabled bool `envconfig:"INSTALLER_CACHE_SHARED_STORAGE_ENABLED" default:"false"`
 }
 
 func (s *Size) Decode(value string) error {
@@ -95,6 +101,8 @@ type Release struct {
 	releaseID string
 	// cached is `true` if the release was found in the cache, otherwise `false`.
 	cached bool
+	// sharedCached is `true` if the release was fetched from the shared storage instead of being extracted.
+	sharedCached bool
 	// extractDuration is the amount of time taken to perform extraction, zero if no extraction took place.
 	extractDuration float64
 }
@@ -109,6 +117,7 @@ func (rl *Release) Cleanup(ctx context.Context) error {
 		"start_time", rl.startTime.Format(time.RFC3339),
 		"end_time", time.Now().Format(time.RFC3339),
 		"cached", rl.cached,
+		"shared_cached", rl.sharedCached,
 		"extract_duration", rl.extractDuration,
 	)
 
@@ -147,20 +156,25 @@ func (r *Release) cleanPageCache() error {
 	return nil
 }
 
-// New constructs an installer cache with a given storage capacity
-func New(config Config, eventsHandler eventsapi.Handler, metricsAPI metrics.API, diskStatsHelper metrics.DiskStatsHelper, log logrus.FieldLogger) (*Installers, error) {
+// New constructs an installer cache with a given storage capacity. The objectHandler is only used when
+// the shared storage tier is enabled and may be nil otherwise.
+func New(config Config, eventsHandler eventsapi.Handler, metricsAPI metrics.API, diskStatsHelper metrics.DiskStatsHelper, objectHandler s3wrapper.API, log logrus.FieldLogger) (*Installers, error) {
 	if config.MaxCapacity > 0 && config.MaxReleaseSize == 0 {
 		return nil, fmt.Errorf("config.MaxReleaseSize (%d bytes) must not be zero", config.MaxReleaseSize)
 	}
 	if config.MaxCapacity > 0 && config.MaxReleaseSize > config.MaxCapacity {
 		return nil, fmt.Errorf("config.MaxReleaseSize (%d bytes) must not be greater than config.MaxCapacity (%d bytes)", config.MaxReleaseSize, config.MaxCapacity)
 	}
+	if config.SharedStorageEnabled && objectHandler == nil {
+		return nil, errors.New("an object handler must be provided when the shared storage is enabled")
+	}
 	return &Installers{
 		log:             log,
 		eventsHandler:   eventsHandler,
 		diskStatsHelper: diskStatsHelper,
 		config:          config,
 		metricsAPI:      metricsAPI,
+		objectHandler:   objectHandler,
 	}, nil
 }
 
@@ -178,7 +192,7 @@ func (i *Installers) Get(ctx context.Context, releaseID, releaseIDMirror, pullSe
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		default:
-			release, err := i.get(releaseID, releaseIDMirror, pullSecret, ocRelease, ocpVersion, clusterID)
+			release, err := i.get(ctx, releaseID, releaseIDMirror, pullSecret, ocRelease, ocpVersion, clusterID)
 			if err == nil {
 				i.metricsAPI.InstallerCacheGetReleaseCached(majorMinorVersion, release.cached)
 				return release, nil
@@ -200,30 +214,57 @@ func (i *Installers) getDiskUsageIncludingHardlinks() (uint64, error) {
 	return usedBytes, nil
 }
 
-func (i *Installers) extractReleaseIfNeeded(path, releaseID, releaseIDMirror, pullSecret, ocpVersion string, ocRelease oc.Release) (extractDuration float64, cached bool, err error) {
+func (i *Installers) extractReleaseIfNeeded(ctx context.Context, path, binary, releaseID, releaseIDMirror, pullSecret, ocpVersion string, ocRelease oc.Release) (extractDuration float64, cached, sharedCached bool, err error) {
 	_, err = os.Stat(path)
 	if err == nil {
-		return 0, true, nil // release was found in the cache
+		return 0, true, false, nil // release was found in the cache
 	}
 	if !os.IsNotExist(err) {
-		return 0, false, err
+		return 0, false, false, err
 	}
 	usedBytes, err := i.getDiskUsageIncludingHardlinks()
 	if err != nil && !os.IsNotExist(err) {
-		return 0, false, fmt.Errorf("could not determine disk usage information for cache dir %s: %w", i.config.CacheDir, err)
+		return 0, false, false, fmt.Errorf("could not determine disk usage information for cache dir %s: %w", i.config.CacheDir, err)
 	}
 	if i.shouldEvict(int64(usedBytes)) && !i.evict() { // nolint: gosec
-		return 0, false, &errorInsufficientCacheCapacity{Message: fmt.Sprintf("insufficient capacity in %s to store release", i.config.CacheDir)}
+		return 0, false, false, &errorInsufficientCacheCapacity{Message: fmt.Sprintf("insufficient capacity in %s to store release", i.config.CacheDir)}
+	}
+
+	var releaseDigest string
+	if i.isSharedStorageEnabled() {
+		releaseDigest, err = ocRelease.GetReleaseDigest(i.log, releaseID, releaseIDMirror, pullSecret)
+		if err != nil {
+			i.log.WithError(err).Warnf("failed to get digest of release %s, skipping shared storage", releaseID)
+			releaseDigest = ""
+		}
+	}
+	if releaseDigest != "" {
+		var found bool
+		found, err = i.fetchFromSharedStorage(ctx, releaseDigest, binary, path)
+		if err != nil {
+			i.log.WithError(err).Warnf("failed to fetch release %s from shared storage, falling back to extraction", releaseID)
+		}
+		if found {
+			i.log.Infof("fetched %s binary of release %s from shared storage", binary, releaseID)
+			return 0, false, true, nil
+		}
 	}
+
 	extractStartTime := time.Now()
 	_, err = ocRelease.Extract(i.log, releaseID, releaseIDMirror, i.config.CacheDir, pullSecret, ocpVersion)
 	if err != nil {
-		return 0, false, err
+		return 0, false, false, err
+	}
+	extractDuration = time.Since(extractStartTime).Seconds()
+	if releaseDigest != "" {
+		if err = i.storeInSharedStorage(ctx, releaseDigest, binary, path); err != nil {
+			i.log.WithError(err).Warnf("failed to store release %s in shared storage", releaseID)
+		}
 	}
-	return time.Since(extractStartTime).Seconds(), false, nil
+	return extractDuration, false, false, nil
 }
 
-func (i *Installers) get(releaseID, releaseIDMirror, pullSecret string, ocRelease oc.Release, ocpVersion string, clusterID strfmt.UUID) (*Release, error) {
+func (i *Installers) get(ctx context.Context, releaseID, releaseIDMirror, pullSecret string, ocRelease oc.Release, ocpVersion string, clusterID strfmt.UUID) (*Release, error) {
 	i.Lock()
 	defer i.Unlock()
 
@@ -238,7 +279,7 @@ func (i *Installers) get(releaseID, releaseIDMirror, pullSecret string, ocReleas
 	if err != nil {
 		return nil, err
 	}
-	release.extractDuration, release.cached, err = i.extractReleaseIfNeeded(path, releaseID, releaseIDMirror, pullSecret, ocpVersion, ocRelease)
+	release.extractDuration, release.cached, release.sharedCached, err = i.extractReleaseIfNeeded(ctx, path, binary, releaseID, releaseIDMirror, pullSecret, ocpVersion, ocRelease)
 	if err != nil {
 		return nil, err
 	}
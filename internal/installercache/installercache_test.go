@@ -22,6 +22,7 @@ import (
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/sirupsen/logrus"
 )
 
@@ -58,6 +59,7 @@ var _ = Describe("release event", func() {
 			"start_time", "2025-01-07T16:51:10Z",
 			"end_time", gomock.Any(),
 			"cached", r.cached,
+			"shared_cached", r.sharedCached,
 			"extract_duration", r.extractDuration,
 		).Times(1)
 		Expect(r.Cleanup(ctx)).To(Succeed())
@@ -96,7 +98,7 @@ var _ = Describe("installer cache", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(os.Mkdir(filepath.Join(cacheDir, "quay.io"), 0755)).To(Succeed())
 		Expect(os.Mkdir(filepath.Join(filepath.Join(cacheDir, "quay.io"), "release-dev"), 0755)).To(Succeed())
-		manager, err = New(getInstallerCacheConfig(12, 5), eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
+		manager, err = New(getInstallerCacheConfig(12, 5), eventsHandler, metricsAPI, diskStatsHelper, nil, logrus.New())
 		Expect(err).NotTo(HaveOccurred())
 		ctx = context.TODO()
 	})
@@ -232,7 +234,7 @@ var _ = Describe("installer cache", func() {
 	// returns the first error encountered or nil if no error encountered.
 	runParallelTest := func(maxCapacity int64, maxReleaseSize int64, tests []test) error {
 		var err error
-		manager, err = New(getInstallerCacheConfig(maxCapacity, maxReleaseSize), eventsHandler, metricsAPI, diskStatsHelper, getLogger())
+		manager, err = New(getInstallerCacheConfig(maxCapacity, maxReleaseSize), eventsHandler, metricsAPI, diskStatsHelper, nil, getLogger())
 		Expect(err).ToNot(HaveOccurred())
 		var wg sync.WaitGroup
 		var reportedError error
@@ -301,30 +303,30 @@ var _ = Describe("installer cache", func() {
 	})
 
 	It("Should raise error on construction if max release size is larger than cache and cache is enabled", func() {
-		_, err := New(getInstallerCacheConfig(5, 10), eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
+		_, err := New(getInstallerCacheConfig(5, 10), eventsHandler, metricsAPI, diskStatsHelper, nil, logrus.New())
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(Equal("config.MaxReleaseSize (10 bytes) must not be greater than config.MaxCapacity (5 bytes)"))
 	})
 
 	It("Should raise error on construction if max release size is zero and cache is enabled", func() {
-		_, err := New(getInstallerCacheConfig(5, 0), eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
+		_, err := New(getInstallerCacheConfig(5, 0), eventsHandler, metricsAPI, diskStatsHelper, nil, logrus.New())
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(Equal("config.MaxReleaseSize (0 bytes) must not be zero"))
 	})
 
 	It("Should not raise error on construction if max release size is larger than cache and cache eviction is disabled", func() {
-		_, err := New(getInstallerCacheConfig(0, 10), eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
+		_, err := New(getInstallerCacheConfig(0, 10), eventsHandler, metricsAPI, diskStatsHelper, nil, logrus.New())
 		Expect(err).ToNot(HaveOccurred())
 	})
 
 	It("Should not raise error on construction if max release size is zero and cache eviction is disabled", func() {
-		_, err := New(getInstallerCacheConfig(0, 0), eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
+		_, err := New(getInstallerCacheConfig(0, 0), eventsHandler, metricsAPI, diskStatsHelper, nil, logrus.New())
 		Expect(err).ToNot(HaveOccurred())
 	})
 
 	It("when cache limit is zero - eviction is skipped", func() {
 		var err error
-		manager, err = New(getInstallerCacheConfig(0, 5), eventsHandler, metricsAPI, diskStatsHelper, logrus.New())
+		manager, err = New(getInstallerCacheConfig(0, 5), eventsHandler, metricsAPI, diskStatsHelper, nil, logrus.New())
 		Expect(err).ToNot(HaveOccurred())
 		clusterId := strfmt.UUID(uuid.New().String())
 		r1, _ := testGet("4.8", "4.8.0", clusterId, false, "4.8")
@@ -446,6 +448,150 @@ var _ = Describe("installer cache", func() {
 
 })
 
+var _ = Describe("installer cache with shared storage", func() {
+	const (
+		releaseID     = "quay.io/release-dev/ocp-release:4.17.11-x86_64"
+		releaseDigest = "sha256:3c8f0a1b6d7e9c2f4a5b8d0e1f3a6c9b2d5e8f1a4c7b0d3e6f9a2c5b8e1d4f7a"
+		version       = "4.17.11"
+		binary        = "openshift-install"
+	)
+
+	var (
+		ctrl          *gomock.Controller
+		mockRelease   *oc.MockRelease
+		eventsHandler *eventsapi.MockHandler
+		metricsAPI    *metrics.MockAPI
+		objectHandler s3wrapper.API
+		manager       *Installers
+		cacheDir      string
+		storageDir    string
+		workdir       string
+		binaryPath    string
+		objectName    string
+		ctx           context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		mockRelease = oc.NewMockRelease(ctrl)
+		eventsHandler = eventsapi.NewMockHandler(ctrl)
+		metricsAPI = metrics.NewMockAPI(ctrl)
+		metricsAPI.EXPECT().FileSystemUsage(gomock.Any()).AnyTimes()
+		metricsAPI.EXPECT().InstallerCacheGetReleaseCached(gomock.Any(), gomock.Any()).AnyTimes()
+		eventsHandler.EXPECT().V2AddMetricsEvent(gomock.Any(), gomock.Any(), nil, nil, "", models.EventSeverityInfo,
+			metricEventInstallerCacheRelease, gomock.Any(), gomock.Any()).AnyTimes()
+		var err error
+		cacheDir, err = os.MkdirTemp("", "cacheDir")
+		Expect(err).NotTo(HaveOccurred())
+		storageDir, err = os.MkdirTemp("", "storageDir")
+		Expect(err).NotTo(HaveOccurred())
+		objectHandler = s3wrapper.NewFSClient(storageDir, logrus.New(), metricsAPI, 80,
+			s3wrapper.NewFilesystemBasedXattrClient(logrus.New(), storageDir))
+		manager, err = New(Config{CacheDir: cacheDir, SharedStorageEnabled: true}, eventsHandler, metricsAPI,
+			metrics.NewOSDiskStatsHelper(logrus.New()), objectHandler, logrus.New())
+		Expect(err).NotTo(HaveOccurred())
+
+		workdir = filepath.Join(cacheDir, releaseID)
+		binaryPath = filepath.Join(workdir, binary)
+		objectName = sharedStorageObjectName(releaseDigest, binary)
+		mockRelease.EXPECT().GetMajorMinorVersion(gomock.Any(), releaseID, gomock.Any(), gomock.Any()).Return("4.17", nil).AnyTimes()
+		mockRelease.EXPECT().GetReleaseBinaryPath(releaseID, cacheDir, version).Return(workdir, binary, binaryPath, nil).AnyTimes()
+		mockRelease.EXPECT().GetReleaseDigest(gomock.Any(), releaseID, gomock.Any(), gomock.Any()).Return(releaseDigest, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(cacheDir)
+		os.RemoveAll(storageDir)
+	})
+
+	expectExtract := func(content string) {
+		mockRelease.EXPECT().Extract(gomock.Any(), releaseID, gomock.Any(), cacheDir, gomock.Any(), version).DoAndReturn(
+			func(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, cacheDir string, pullSecret string, version string) (string, error) {
+				Expect(os.MkdirAll(workdir, 0755)).To(Succeed())
+				return binaryPath, os.WriteFile(binaryPath, []byte(content), 0600)
+			}).Times(1)
+	}
+
+	storeObjects := func(content, checksum string) {
+		Expect(objectHandler.Upload(ctx, []byte(content), objectName)).To(Succeed())
+		Expect(objectHandler.Upload(ctx, []byte(checksum), sharedStorageChecksumName(objectName))).To(Succeed())
+	}
+
+	readObject := func(name string) string {
+		reader, _, err := objectHandler.Download(ctx, name)
+		Expect(err).NotTo(HaveOccurred())
+		defer reader.Close()
+		content, err := io.ReadAll(reader)
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	getRelease := func() *Release {
+		release, err := manager.Get(ctx, releaseID, "", "pull-secret", mockRelease, version, strfmt.UUID(uuid.NewString()))
+		Expect(err).NotTo(HaveOccurred())
+		content, err := os.ReadFile(release.Path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("abcde"))
+		Expect(release.Cleanup(ctx)).To(Succeed())
+		return release
+	}
+
+	It("extracts the release and stores it in the shared storage when it isn't there", func() {
+		expectExtract("abcde")
+		release := getRelease()
+		Expect(release.cached).To(BeFalse())
+		Expect(release.sharedCached).To(BeFalse())
+		Expect(readObject(objectName)).To(Equal("abcde"))
+		checksum, err := fileChecksum(binaryPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(readObject(sharedStorageChecksumName(objectName))).To(Equal(checksum))
+	})
+
+	It("fetches the release from the shared storage instead of extracting it", func() {
+		storeObjects("abcde", "36bbe50ed96841d10443bcb670d6554f0a34b761be67ec9c4a8ad2c0c44ca42c")
+		release := getRelease()
+		Expect(release.cached).To(BeFalse())
+		Expect(release.sharedCached).To(BeTrue())
+		Expect(release.extractDuration).To(BeZero())
+
+		By("using the local cache afterwards")
+		release = getRelease()
+		Expect(release.cached).To(BeTrue())
+		Expect(release.sharedCached).To(BeFalse())
+	})
+
+	It("extracts the release and replaces the stored binary when its checksum doesn't match", func() {
+		storeObjects("corrupted", "36bbe50ed96841d10443bcb670d6554f0a34b761be67ec9c4a8ad2c0c44ca42c")
+		expectExtract("abcde")
+		release := getRelease()
+		Expect(release.sharedCached).To(BeFalse())
+		Expect(readObject(objectName)).To(Equal("abcde"))
+		entries, err := os.ReadDir(workdir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("extracts the release when its digest can't be determined", func() {
+		mockRelease = oc.NewMockRelease(ctrl)
+		mockRelease.EXPECT().GetMajorMinorVersion(gomock.Any(), releaseID, gomock.Any(), gomock.Any()).Return("4.17", nil).AnyTimes()
+		mockRelease.EXPECT().GetReleaseBinaryPath(releaseID, cacheDir, version).Return(workdir, binary, binaryPath, nil).AnyTimes()
+		mockRelease.EXPECT().GetReleaseDigest(gomock.Any(), releaseID, gomock.Any(), gomock.Any()).Return("", fmt.Errorf("failed")).Times(1)
+		expectExtract("abcde")
+		release := getRelease()
+		Expect(release.sharedCached).To(BeFalse())
+		exists, err := objectHandler.DoesObjectExist(ctx, objectName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("fails construction when the shared storage is enabled without an object handler", func() {
+		_, err := New(Config{CacheDir: cacheDir, SharedStorageEnabled: true}, eventsHandler, metricsAPI,
+			metrics.NewOSDiskStatsHelper(logrus.New()), nil, logrus.New())
+		Expect(err).To(HaveOccurred())
+	})
+})
+
 func TestInstallerCache(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "installercache tests")
@@ -0,0 +1,145 @@
+package installercache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const sharedStoragePrefix = "installercache"
+
+// sharedStorageObjectName returns the name of the object holding the binary extracted from the
+// release with the given digest.
+func sharedStorageObjectName(releaseDigest, binary string) string {
+	return path.Join(sharedStoragePrefix, strings.ReplaceAll(releaseDigest, ":", "-"), binary)
+}
+
+// sharedStorageChecksumName returns the name of the object holding the sha256 checksum of the binary
+// stored in objectName. It is uploaded after the binary, so its presence marks a complete entry.
+func sharedStorageChecksumName(objectName string) string {
+	return objectName + ".sha256"
+}
+
+func (i *Installers) isSharedStorageEnabled() bool {
+	return i.config.SharedStorageEnabled && i.objectHandler != nil
+}
+
+// fetchFromSharedStorage downloads the binary extracted from the given release into dest. It returns
+// false if the shared storage doesn't hold a complete and valid copy of the binary.
+func (i *Installers) fetchFromSharedStorage(ctx context.Context, releaseDigest, binary, dest string) (bool, error) {
+	objectName := sharedStorageObjectName(releaseDigest, binary)
+	checksumName := sharedStorageChecksumName(objectName)
+	exists, err := i.objectHandler.DoesObjectExist(ctx, checksumName)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if %s exists in shared storage: %w", checksumName, err)
+	}
+	if !exists {
+		return false, nil
+	}
+	expectedChecksum, err := i.downloadChecksum(ctx, checksumName)
+	if err != nil {
+		return false, err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return false, err
+	}
+	// download to a temporary file so that a partial download is never mistaken for a cached binary
+	tmp := filepath.Join(filepath.Dir(dest), fmt.Sprintf("tmp_%s_%s", uuid.NewString(), binary))
+	defer os.Remove(tmp)
+	checksum, err := i.downloadBinary(ctx, objectName, tmp)
+	if err != nil {
+		return false, err
+	}
+	if checksum != expectedChecksum {
+		i.log.Warnf("checksum mismatch for %s in shared storage (expected %s, got %s), removing it", objectName, expectedChecksum, checksum)
+		i.deleteFromSharedStorage(ctx, objectName)
+		return false, nil
+	}
+	if err = os.Rename(tmp, dest); err != nil {
+		return false, fmt.Errorf("failed to move %s to %s: %w", tmp, dest, err)
+	}
+	return true, nil
+}
+
+func (i *Installers) downloadChecksum(ctx context.Context, checksumName string) (string, error) {
+	reader, _, err := i.objectHandler.Download(ctx, checksumName)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s from shared storage: %w", checksumName, err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s from shared storage: %w", checksumName, err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+func (i *Installers) downloadBinary(ctx context.Context, objectName, dest string) (string, error) {
+	reader, size, err := i.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s from shared storage: %w", objectName, err)
+	}
+	defer reader.Close()
+	f, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	written, err := io.Copy(io.MultiWriter(f, hash), reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s from shared storage: %w", objectName, err)
+	}
+	if written != size {
+		return "", fmt.Errorf("downloaded %d bytes of %s from shared storage, expected %d", written, objectName, size)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// storeInSharedStorage uploads the binary extracted from the given release so that other replicas
+// can use it instead of extracting the release themselves.
+func (i *Installers) storeInSharedStorage(ctx context.Context, releaseDigest, binary, src string) error {
+	checksum, err := fileChecksum(src)
+	if err != nil {
+		return err
+	}
+	objectName := sharedStorageObjectName(releaseDigest, binary)
+	if err = i.objectHandler.UploadFile(ctx, src, objectName); err != nil {
+		return fmt.Errorf("failed to upload %s to shared storage: %w", objectName, err)
+	}
+	checksumName := sharedStorageChecksumName(objectName)
+	if err = i.objectHandler.Upload(ctx, []byte(checksum), checksumName); err != nil {
+		return fmt.Errorf("failed to upload %s to shared storage: %w", checksumName, err)
+	}
+	return nil
+}
+
+func (i *Installers) deleteFromSharedStorage(ctx context.Context, objectName string) {
+	for _, name := range []string{sharedStorageChecksumName(objectName), objectName} {
+		if _, err := i.objectHandler.DeleteObject(ctx, name); err != nil {
+			i.log.WithError(err).Warnf("failed to delete %s from shared storage", name)
+		}
+	}
+}
+
+func fileChecksum(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err = io.Copy(hash, f); err != nil {
+		return "", fmt.Errorf("failed to compute checksum of %s: %w", filePath, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/encryption"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// encryptSensitiveColumns encrypts the existing values of the encrypted columns. It does nothing when no encryption
+// key provider is configured, the values are then encrypted by the re-encryption done at startup once one is.
+func encryptSensitiveColumns() *gormigrate.Migration {
+	migrate := func(db *gorm.DB) error {
+		encryptor := encryption.DefaultEncryptor()
+		if encryptor == nil {
+			return nil
+		}
+		return db.Transaction(func(tx *gorm.DB) error {
+			return encryption.ReencryptColumns(context.Background(), logrus.WithField("pkg", "migrations"), tx, encryptor, common.EncryptedColumns)
+		})
+	}
+
+	rollback := func(tx *gorm.DB) error { return nil }
+
+	return &gormigrate.Migration{
+		ID:       "20261019100000",
+		Migrate:  gormigrate.MigrateFunc(migrate),
+		Rollback: gormigrate.RollbackFunc(rollback),
+	}
+}
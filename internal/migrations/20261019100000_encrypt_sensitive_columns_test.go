@@ -0,0 +1,114 @@
+package migrations
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/encryption"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("encryptSensitiveColumns", func() {
+	var (
+		db         *gorm.DB
+		dbName     string
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+		encryptor  encryption.Encryptor
+		keyDir     string
+		migration  *gormigrate.Migration = encryptSensitiveColumns()
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+
+		key := make([]byte, 32)
+		_, err := rand.Read(key)
+		Expect(err).NotTo(HaveOccurred())
+		keyDir, err = os.MkdirTemp("", "encryption-keys")
+		Expect(err).NotTo(HaveOccurred())
+		keyFile := filepath.Join(keyDir, "keys")
+		Expect(os.WriteFile(keyFile, []byte("key-1 "+base64.StdEncoding.EncodeToString(key)), 0600)).To(Succeed())
+		encryptor, err = encryption.NewEncryptor(common.GetTestLog(), encryption.Config{
+			KeyProvider:  encryption.KeyProviderLocal,
+			LocalKeyFile: keyFile,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		// The rows are created before encryption is enabled
+		Expect(db.Create(&common.Cluster{
+			Cluster:         models.Cluster{ID: &clusterID},
+			PullSecret:      "cluster-pull-secret",
+			VspherePassword: "vsphere-password",
+		}).Error).To(Succeed())
+		Expect(db.Create(&common.InfraEnv{
+			InfraEnv:   models.InfraEnv{ID: &infraEnvID},
+			PullSecret: "infra-env-pull-secret",
+		}).Error).To(Succeed())
+	})
+
+	AfterEach(func() {
+		encryption.SetDefaultEncryptor(nil)
+		common.DeleteTestDB(db, dbName)
+		Expect(os.RemoveAll(keyDir)).To(Succeed())
+	})
+
+	storedValue := func(table, column string, id strfmt.UUID) string {
+		var value string
+		Expect(db.Raw(fmt.Sprintf("SELECT %s FROM %s WHERE id = ?", column, table), id).Scan(&value).Error).To(Succeed())
+		return value
+	}
+
+	It("encrypts the existing values", func() {
+		Expect(storedValue("clusters", "pull_secret", clusterID)).To(Equal("cluster-pull-secret"))
+
+		encryption.SetDefaultEncryptor(encryptor)
+		Expect(migrateToBefore(db, migration.ID)).To(Succeed())
+		Expect(migrateTo(db, migration.ID)).To(Succeed())
+
+		Expect(encryption.IsEncrypted(storedValue("clusters", "pull_secret", clusterID))).To(BeTrue())
+		Expect(encryption.IsEncrypted(storedValue("clusters", "vsphere_password", clusterID))).To(BeTrue())
+		Expect(encryption.IsEncrypted(storedValue("infra_envs", "pull_secret", infraEnvID))).To(BeTrue())
+
+		cluster, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.PullSecret).To(Equal("cluster-pull-secret"))
+		Expect(cluster.VspherePassword).To(Equal("vsphere-password"))
+		infraEnv, err := common.GetInfraEnvFromDB(db, infraEnvID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(infraEnv.PullSecret).To(Equal("infra-env-pull-secret"))
+	})
+
+	It("keeps the values in plaintext without a key provider", func() {
+		Expect(migrateToBefore(db, migration.ID)).To(Succeed())
+		Expect(migrateTo(db, migration.ID)).To(Succeed())
+		Expect(storedValue("clusters", "pull_secret", clusterID)).To(Equal("cluster-pull-secret"))
+	})
+
+	It("encrypts the new values", func() {
+		encryption.SetDefaultEncryptor(encryptor)
+		newClusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &newClusterID}, PullSecret: "new-pull-secret"}).Error).To(Succeed())
+		Expect(encryption.IsEncrypted(storedValue("clusters", "pull_secret", newClusterID))).To(BeTrue())
+
+		updates := map[string]interface{}{"pull_secret": "updated-pull-secret"}
+		Expect(common.EncryptColumnUpdates(context.Background(), "clusters", updates)).To(Succeed())
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", newClusterID).Updates(updates).Error).To(Succeed())
+		cluster, err := common.GetClusterFromDB(db, newClusterID, common.SkipEagerLoading)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.PullSecret).To(Equal("updated-pull-secret"))
+	})
+})
@@ -49,6 +49,7 @@ func post() []*gormigrate.Migration {
 		addHostsByClusterIdIndex(),
 		addHostsByInfraEnvIdIndex(),
 		populatePrimaryIPStackForExistingClusters(),
+		encryptSensitiveColumns(),
 	}
 
 	sort.SliceStable(postMigrations, func(i, j int) bool { return postMigrations[i].ID < postMigrations[j].ID })
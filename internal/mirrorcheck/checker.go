@@ -0,0 +1,250 @@
+package mirrorcheck
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/openshift/assisted-service/pkg/mirrorregistries"
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+)
+
+type Config struct {
+	// Concurrency is the number of images that the service checks in parallel
+	Concurrency int `envconfig:"MIRROR_CHECK_CONCURRENCY" default:"8"`
+	// CacheTTL is the amount of time the result of a check is kept before the mirrors are checked again
+	CacheTTL time.Duration `envconfig:"MIRROR_CHECK_CACHE_TTL" default:"10m"`
+	// ImagesCacheTTL is the amount of time the images of a release are kept before they are listed again
+	ImagesCacheTTL time.Duration `envconfig:"MIRROR_CHECK_IMAGES_CACHE_TTL" default:"1h"`
+}
+
+// ReleaseFactory returns a release handler that resolves the release images through the mirror registries of the
+// builder
+type ReleaseFactory func(mirrorRegistriesBuilder mirrorregistries.ServiceMirrorRegistriesConfigBuilder) oc.Release
+
+// Status is the result of checking from the service that the images of a release are available in the mirrors
+type Status struct {
+	// InProgress is true until the first check of the release completes
+	InProgress bool
+	// Images is the number of images in the release payload
+	Images int
+	// MissingImages are the images of the release payload that none of their mirrors contain
+	MissingImages []string
+	// Err is set when the release or some of the images couldn't be checked
+	Err error
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=mirrorcheck -destination=mock_checker.go . Checker
+type Checker interface {
+	// IsEnabled returns true if mirror registries are configured for the cluster, or for the service when the
+	// cluster has no mirror registry configuration
+	IsEnabled(mirrorConfiguration *common.MirrorRegistryConfiguration) bool
+	// GetReleaseImages returns the images of the release payload, referenced by digest. The release is resolved
+	// through the mirror registries of the cluster, or of the service when the cluster has no mirror registry
+	// configuration. The images are cached per release, pull secret and mirrors like the statuses.
+	GetReleaseImages(log logrus.FieldLogger, releaseImage string, pullSecret string, mirrorConfiguration *common.MirrorRegistryConfiguration) ([]string, error)
+	// GetStatus returns the result of the last check of the release in the mirrors of the cluster, or of the
+	// service when the cluster has no mirror registry configuration. If there is none, a check is started in the
+	// background and a status that is in progress is returned. The results are cached per release, pull secret and
+	// mirrors, so that they are never shared between users with different credentials.
+	GetStatus(log logrus.FieldLogger, releaseImage string, pullSecret string, mirrorConfiguration *common.MirrorRegistryConfiguration) Status
+}
+
+type checker struct {
+	config                  Config
+	ocRelease               oc.Release
+	newRelease              ReleaseFactory
+	mirrorRegistriesBuilder mirrorregistries.ServiceMirrorRegistriesConfigBuilder
+	releaseImageMirror      string
+
+	images          *cache.Cache
+	imagesGroup     singleflight.Group
+	statuses        *cache.Cache
+	inProgressMutex sync.Mutex
+	inProgress      map[string]bool
+}
+
+// NewChecker returns a checker that resolves the releases with ocRelease, which uses the mirror registries of the
+// service, and with the release handlers of newRelease for the clusters that have their own mirror registries
+func NewChecker(config Config, ocRelease oc.Release, newRelease ReleaseFactory,
+	mirrorRegistriesBuilder mirrorregistries.ServiceMirrorRegistriesConfigBuilder, releaseImageMirror string) Checker {
+	return &checker{
+		config:                  config,
+		ocRelease:               ocRelease,
+		newRelease:              newRelease,
+		mirrorRegistriesBuilder: mirrorRegistriesBuilder,
+		releaseImageMirror:      releaseImageMirror,
+		images:                  cache.New(config.ImagesCacheTTL, 2*config.ImagesCacheTTL),
+		statuses:                cache.New(config.CacheTTL, 2*config.CacheTTL),
+		inProgress:              map[string]bool{},
+	}
+}
+
+// registriesBuilder returns the builder of the mirror registries of the cluster, and of the service when the
+// cluster has none
+func (c *checker) registriesBuilder(mirrorConfiguration *common.MirrorRegistryConfiguration) mirrorregistries.ServiceMirrorRegistriesConfigBuilder {
+	if common.IsMirrorConfigurationSet(mirrorConfiguration) {
+		return mirrorregistries.NewFromConfiguration(mirrorConfiguration, false)
+	}
+	return c.mirrorRegistriesBuilder
+}
+
+func (c *checker) IsEnabled(mirrorConfiguration *common.MirrorRegistryConfiguration) bool {
+	return c.registriesBuilder(mirrorConfiguration).IsMirrorRegistriesConfigured()
+}
+
+func (c *checker) GetReleaseImages(log logrus.FieldLogger, releaseImage string, pullSecret string,
+	mirrorConfiguration *common.MirrorRegistryConfiguration) ([]string, error) {
+	registries, err := c.registriesBuilder(mirrorConfiguration).GetMirrorRegistries()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the mirror registries configuration")
+	}
+	return c.releaseImages(log, releaseImage, pullSecret, mirrorConfiguration, registries)
+}
+
+func (c *checker) releaseImages(log logrus.FieldLogger, releaseImage string, pullSecret string,
+	mirrorConfiguration *common.MirrorRegistryConfiguration, registries []byte) ([]string, error) {
+	key := cacheKey(releaseImage, pullSecret, registries)
+	if images, found := c.images.Get(key); found {
+		return images.([]string), nil
+	}
+	images, err, _ := c.imagesGroup.Do(key, func() (interface{}, error) {
+		// the release image mirror of the service doesn't apply to the clusters that have their own mirrors
+		ocRelease, releaseImageMirror := c.ocRelease, c.releaseImageMirror
+		if common.IsMirrorConfigurationSet(mirrorConfiguration) {
+			ocRelease, releaseImageMirror = c.newRelease(c.registriesBuilder(mirrorConfiguration)), ""
+		}
+		images, err := ocRelease.GetReleaseImageReferences(log, releaseImage, releaseImageMirror, pullSecret)
+		if err != nil {
+			return nil, err
+		}
+		c.images.SetDefault(key, images)
+		return images, nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the images of release %s", releaseImage)
+	}
+	return images.([]string), nil
+}
+
+// cacheKey identifies the images and the check of a release by the release, the credentials used to get them and the
+// mirrors they are resolved and checked in
+func cacheKey(releaseImage string, pullSecret string, registries []byte) string {
+	hash := sha256.New()
+	for _, part := range [][]byte{[]byte(pullSecret), registries} {
+		hash.Write(part)
+		hash.Write([]byte{0})
+	}
+	return releaseImage + "/" + hex.EncodeToString(hash.Sum(nil))
+}
+
+func (c *checker) GetStatus(log logrus.FieldLogger, releaseImage string, pullSecret string,
+	mirrorConfiguration *common.MirrorRegistryConfiguration) Status {
+	builder := c.registriesBuilder(mirrorConfiguration)
+	registries, err := builder.GetMirrorRegistries()
+	if err != nil {
+		return Status{Err: errors.Wrap(err, "failed to get the mirror registries configuration")}
+	}
+	key := cacheKey(releaseImage, pullSecret, registries)
+	if status, found := c.statuses.Get(key); found {
+		return status.(Status)
+	}
+	c.inProgressMutex.Lock()
+	defer c.inProgressMutex.Unlock()
+	if !c.inProgress[key] {
+		c.inProgress[key] = true
+		go func() {
+			status := c.check(log, releaseImage, pullSecret, mirrorConfiguration, builder, registries)
+			c.inProgressMutex.Lock()
+			defer c.inProgressMutex.Unlock()
+			c.statuses.SetDefault(key, status)
+			delete(c.inProgress, key)
+		}()
+	}
+	return Status{InProgress: true}
+}
+
+func (c *checker) check(log logrus.FieldLogger, releaseImage string, pullSecret string,
+	mirrorConfiguration *common.MirrorRegistryConfiguration, builder mirrorregistries.ServiceMirrorRegistriesConfigBuilder,
+	registries []byte) Status {
+	images, err := c.releaseImages(log, releaseImage, pullSecret, mirrorConfiguration, registries)
+	if err != nil {
+		return Status{Err: err}
+	}
+	registriesConf, err := builder.ExtractLocationMirrorDataFromRegistries()
+	if err != nil {
+		return Status{Images: len(images), Err: errors.Wrap(err, "failed to get the mirror registries configuration")}
+	}
+
+	var (
+		mutex   sync.Mutex
+		wg      sync.WaitGroup
+		missing []string
+		failed  int
+		lastErr error
+	)
+	concurrency := c.config.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	semaphore := make(chan struct{}, concurrency)
+	for _, image := range images {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(image string) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			available, err := c.isAvailableInMirrors(log, image, registriesConf, pullSecret)
+			mutex.Lock()
+			defer mutex.Unlock()
+			switch {
+			case err != nil:
+				failed++
+				lastErr = err
+			case !available:
+				missing = append(missing, image)
+			}
+		}(image)
+	}
+	wg.Wait()
+	sort.Strings(missing)
+
+	status := Status{Images: len(images), MissingImages: missing}
+	if failed > 0 {
+		status.Err = errors.Wrapf(lastErr, "failed to check %d of the %d images", failed, len(images))
+	}
+	log.Infof("checked the %d images of release %s in the mirror registries, %d are missing and %d could not be checked",
+		len(images), releaseImage, len(missing), failed)
+	return status
+}
+
+// isAvailableInMirrors returns true if one of the mirrors of the image contains it. An error is returned if none of
+// the mirrors contains the image and some of them couldn't be checked.
+func (c *checker) isAvailableInMirrors(log logrus.FieldLogger, image string, registriesConf []mirrorregistries.RegistriesConf,
+	pullSecret string) (bool, error) {
+	var lastErr error
+	for _, mirrorImage := range mirrorregistries.GetMirrorImageReferences(image, registriesConf) {
+		available, err := c.ocRelease.IsImageAvailable(log, mirrorImage, pullSecret)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if available {
+			return true, nil
+		}
+	}
+	if lastErr != nil {
+		return false, fmt.Errorf("failed to check image %s in the mirror registries: %w", image, lastErr)
+	}
+	return false, nil
+}
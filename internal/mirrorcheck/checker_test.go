@@ -0,0 +1,181 @@
+package mirrorcheck
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/openshift/assisted-service/pkg/mirrorregistries"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	releaseImage = "quay.io/openshift-release-dev/ocp-release:4.17.11-x86_64"
+	pullSecret   = "pull-secret"
+	mcoImage     = "quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:1111"
+	agentImage   = "quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:2222"
+	otherImage   = "registry.example.com/other@sha256:3333"
+)
+
+var _ = Describe("Checker", func() {
+	var (
+		ctrl                    *gomock.Controller
+		mockRelease             *oc.MockRelease
+		mockClusterRelease      *oc.MockRelease
+		mockMirrorRegistriesCfg *mirrorregistries.MockServiceMirrorRegistriesConfigBuilder
+		c                       Checker
+		log                     = logrus.New()
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRelease = oc.NewMockRelease(ctrl)
+		mockClusterRelease = oc.NewMockRelease(ctrl)
+		mockMirrorRegistriesCfg = mirrorregistries.NewMockServiceMirrorRegistriesConfigBuilder(ctrl)
+		newRelease := func(mirrorregistries.ServiceMirrorRegistriesConfigBuilder) oc.Release {
+			return mockClusterRelease
+		}
+		c = NewChecker(Config{Concurrency: 2, CacheTTL: time.Minute, ImagesCacheTTL: time.Minute}, mockRelease, newRelease,
+			mockMirrorRegistriesCfg, "service-mirror.example.com/ocp-release:4.17.11-x86_64")
+		mockMirrorRegistriesCfg.EXPECT().ExtractLocationMirrorDataFromRegistries().Return([]mirrorregistries.RegistriesConf{
+			{Location: "quay.io/openshift-release-dev/ocp-v4.0-art-dev", Mirror: []string{"mirror.example.com/art", "backup.example.com/art"}},
+		}, nil).AnyTimes()
+		mockMirrorRegistriesCfg.EXPECT().GetMirrorRegistries().Return([]byte("service registries"), nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectImagesOf := func(pullSecret string, images ...string) {
+		mockRelease.EXPECT().GetReleaseImageReferences(gomock.Any(), releaseImage, "service-mirror.example.com/ocp-release:4.17.11-x86_64",
+			pullSecret).Return(images, nil).Times(1)
+	}
+
+	expectImages := func(images ...string) {
+		expectImagesOf(pullSecret, images...)
+	}
+
+	waitForStatusOf := func(pullSecret string, mirrorConfiguration *common.MirrorRegistryConfiguration) Status {
+		Expect(c.GetStatus(log, releaseImage, pullSecret, mirrorConfiguration).InProgress).To(BeTrue())
+		var status Status
+		Eventually(func() bool {
+			status = c.GetStatus(log, releaseImage, pullSecret, mirrorConfiguration)
+			return status.InProgress
+		}, "5s", "10ms").Should(BeFalse())
+		return status
+	}
+
+	waitForStatus := func() Status {
+		return waitForStatusOf(pullSecret, nil)
+	}
+
+	It("caches the images of a release", func() {
+		expectImages(mcoImage, agentImage)
+		for i := 0; i < 2; i++ {
+			images, err := c.GetReleaseImages(log, releaseImage, pullSecret, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(images).To(Equal([]string{mcoImage, agentImage}))
+		}
+	})
+
+	It("doesn't share the images of a release between pull secrets", func() {
+		expectImages(mcoImage)
+		images, err := c.GetReleaseImages(log, releaseImage, pullSecret, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(images).To(Equal([]string{mcoImage}))
+
+		mockRelease.EXPECT().GetReleaseImageReferences(gomock.Any(), releaseImage, gomock.Any(), "other-pull-secret").
+			Return(nil, errors.New("unauthorized")).Times(1)
+		_, err = c.GetReleaseImages(log, releaseImage, "other-pull-secret", nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("finds all the images in the mirrors", func() {
+		expectImages(mcoImage, agentImage)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "mirror.example.com/art@sha256:1111", pullSecret).Return(true, nil).Times(1)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "mirror.example.com/art@sha256:2222", pullSecret).Return(false, nil).Times(1)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "backup.example.com/art@sha256:2222", pullSecret).Return(true, nil).Times(1)
+
+		status := waitForStatus()
+		Expect(status.Err).NotTo(HaveOccurred())
+		Expect(status.Images).To(Equal(2))
+		Expect(status.MissingImages).To(BeEmpty())
+	})
+
+	It("reports images that no mirror contains", func() {
+		expectImages(mcoImage, agentImage, otherImage)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "mirror.example.com/art@sha256:1111", pullSecret).Return(true, nil).Times(1)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "mirror.example.com/art@sha256:2222", pullSecret).Return(false, nil).Times(1)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "backup.example.com/art@sha256:2222", pullSecret).Return(false, nil).Times(1)
+
+		status := waitForStatus()
+		Expect(status.Err).NotTo(HaveOccurred())
+		Expect(status.MissingImages).To(Equal([]string{agentImage, otherImage}))
+	})
+
+	It("reports images that couldn't be checked", func() {
+		expectImages(mcoImage)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "mirror.example.com/art@sha256:1111", pullSecret).Return(false, errors.New("no such host")).Times(1)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "backup.example.com/art@sha256:1111", pullSecret).Return(false, nil).Times(1)
+
+		status := waitForStatus()
+		Expect(status.Err).To(HaveOccurred())
+		Expect(status.Err.Error()).To(ContainSubstring("no such host"))
+		Expect(status.MissingImages).To(BeEmpty())
+	})
+
+	It("reports releases that can't be inspected", func() {
+		mockRelease.EXPECT().GetReleaseImageReferences(gomock.Any(), releaseImage, gomock.Any(), pullSecret).Return(nil, errors.New("unauthorized")).Times(1)
+
+		status := waitForStatus()
+		Expect(status.Err).To(HaveOccurred())
+	})
+
+	It("checks a release once until the result expires", func() {
+		expectImages(mcoImage)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "mirror.example.com/art@sha256:1111", pullSecret).Return(true, nil).Times(1)
+
+		waitForStatus()
+		Expect(c.GetStatus(log, releaseImage, pullSecret, nil).InProgress).To(BeFalse())
+	})
+
+	It("doesn't share the results between pull secrets", func() {
+		expectImages(mcoImage)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "mirror.example.com/art@sha256:1111", pullSecret).Return(false, errors.New("unauthorized")).Times(1)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "backup.example.com/art@sha256:1111", pullSecret).Return(false, nil).Times(1)
+		Expect(waitForStatus().Err).To(HaveOccurred())
+
+		expectImagesOf("other-pull-secret", mcoImage)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "mirror.example.com/art@sha256:1111", "other-pull-secret").Return(true, nil).Times(1)
+		Expect(waitForStatusOf("other-pull-secret", nil).Err).NotTo(HaveOccurred())
+	})
+
+	It("checks the mirror registries of the cluster", func() {
+		mirrorConfiguration := &common.MirrorRegistryConfiguration{RegistriesConf: `
+[[registry]]
+location = "quay.io/openshift-release-dev/ocp-v4.0-art-dev"
+
+[[registry.mirror]]
+location = "cluster-mirror.example.com/art"
+`}
+		Expect(c.IsEnabled(mirrorConfiguration)).To(BeTrue())
+		// the release is resolved through the mirrors of the cluster, not the release image mirror of the service
+		mockClusterRelease.EXPECT().GetReleaseImageReferences(gomock.Any(), releaseImage, "", pullSecret).Return([]string{mcoImage}, nil).Times(1)
+		mockRelease.EXPECT().IsImageAvailable(gomock.Any(), "cluster-mirror.example.com/art@sha256:1111", pullSecret).Return(false, nil).Times(1)
+
+		status := waitForStatusOf(pullSecret, mirrorConfiguration)
+		Expect(status.Err).NotTo(HaveOccurred())
+		Expect(status.MissingImages).To(Equal([]string{mcoImage}))
+	})
+})
+
+func TestMirrorCheck(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "mirror check tests")
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/mirrorcheck (interfaces: Checker)
+
+// Package mirrorcheck is a generated GoMock package.
+package mirrorcheck
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	common "github.com/openshift/assisted-service/internal/common"
+	logrus "github.com/sirupsen/logrus"
+)
+
+// MockChecker is a mock of Checker interface.
+type MockChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckerMockRecorder
+}
+
+// MockCheckerMockRecorder is the mock recorder for MockChecker.
+type MockCheckerMockRecorder struct {
+	mock *MockChecker
+}
+
+// NewMockChecker creates a new mock instance.
+func NewMockChecker(ctrl *gomock.Controller) *MockChecker {
+	mock := &MockChecker{ctrl: ctrl}
+	mock.recorder = &MockCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecker) EXPECT() *MockCheckerMockRecorder {
+	return m.recorder
+}
+
+// GetReleaseImages mocks base method.
+func (m *MockChecker) GetReleaseImages(arg0 logrus.FieldLogger, arg1, arg2 string, arg3 *common.MirrorRegistryConfiguration) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReleaseImages", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReleaseImages indicates an expected call of GetReleaseImages.
+func (mr *MockCheckerMockRecorder) GetReleaseImages(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseImages", reflect.TypeOf((*MockChecker)(nil).GetReleaseImages), arg0, arg1, arg2, arg3)
+}
+
+// GetStatus mocks base method.
+func (m *MockChecker) GetStatus(arg0 logrus.FieldLogger, arg1, arg2 string, arg3 *common.MirrorRegistryConfiguration) Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(Status)
+	return ret0
+}
+
+// GetStatus indicates an expected call of GetStatus.
+func (mr *MockCheckerMockRecorder) GetStatus(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockChecker)(nil).GetStatus), arg0, arg1, arg2, arg3)
+}
+
+// IsEnabled mocks base method.
+func (m *MockChecker) IsEnabled(arg0 *common.MirrorRegistryConfiguration) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEnabled", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsEnabled indicates an expected call of IsEnabled.
+func (mr *MockCheckerMockRecorder) IsEnabled(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEnabled", reflect.TypeOf((*MockChecker)(nil).IsEnabled), arg0)
+}
@@ -301,6 +301,11 @@ spec:
               - url: {{ .Url }}
                 thumbprint: {{ .Thumbprint }}
             {{- end }}
+		  {{- else if eq .MODE "threshold" }}
+            custom:
+              pin: sss
+              config: {{ .CLEVIS_CONFIG }}
+              needsNetwork: true
 		  {{- end }}
           options: [--cipher, {{ .CIPHER }}]
           wipeVolume: true
@@ -309,7 +314,7 @@ spec:
           format: xfs
           wipeFilesystem: true
           label: root
-{{- if eq .MODE "tang" }}
+{{- if or (eq .MODE "tang") (eq .MODE "threshold") }}
   kernelArguments:
     - rd.neednet=1
 {{- end }}`
@@ -360,6 +365,32 @@ func (m *ManifestsGenerator) AddDiskEncryptionManifest(ctx context.Context, log
 
 		manifestParams["MODE"] = "tang"
 		manifestParams["TANG_SERVERS"] = tangServers
+
+	case models.DiskEncryptionModeThreshold:
+
+		tangServers, err := tang.UnmarshalTangServers(c.DiskEncryption.TangServers)
+		if err != nil {
+			log.WithError(err).Error("failed to unmarshal tang_server from cluster object")
+			return err
+		}
+		thresholdSpec, err := tang.UnmarshalThresholdSpec(c.DiskEncryption.ThresholdSpec)
+		if err != nil {
+			log.WithError(err).Error("failed to unmarshal threshold_spec from cluster object")
+			return err
+		}
+		clevisConfig, err := thresholdSpec.ClevisConfig(tangServers)
+		if err != nil {
+			log.WithError(err).Error("failed to generate the clevis sss configuration")
+			return err
+		}
+		// The configuration is a JSON document, quote it as a YAML string
+		quotedClevisConfig, err := json.Marshal(clevisConfig)
+		if err != nil {
+			return err
+		}
+
+		manifestParams["MODE"] = "threshold"
+		manifestParams["CLEVIS_CONFIG"] = string(quotedClevisConfig)
 	}
 
 	enabledGroups := strings.Split(swag.StringValue(c.DiskEncryption.EnableOn), ",")
@@ -8,6 +8,7 @@ import (
 	"regexp"
 
 	configv31 "github.com/coreos/ignition/v2/config/v3_1"
+	configv32 "github.com/coreos/ignition/v2/config/v3_2"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/golang/mock/gomock"
@@ -18,6 +19,7 @@ import (
 	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/system"
 	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
 	mcfgv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -1015,6 +1017,16 @@ var _ = Describe("disk encryption manifest", func() {
 			numOfManifests: 1,
 			isTNA:          true,
 		},
+		{
+			name: "all, threshold",
+			diskEncryption: &models.DiskEncryption{
+				EnableOn:      swag.String(models.DiskEncryptionEnableOnAll),
+				Mode:          swag.String(models.DiskEncryptionModeThreshold),
+				TangServers:   `[{"url":"http://tang.invalid","thumbprint":"PLjNyRdGw03zlRoGjQYMahSZGu9"}]`,
+				ThresholdSpec: `{"threshold":1,"tpm2":true,"tang_threshold":1}`,
+			},
+			numOfManifests: 2,
+		},
 		{
 			name: "disks encryption not set",
 			// This is the default values for disk_encryption
@@ -1043,6 +1055,38 @@ var _ = Describe("disk encryption manifest", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	}
+
+	It("binds the disk to the TPM and the Tang servers with the clevis sss pin", func() {
+		c.DiskEncryption = &models.DiskEncryption{
+			EnableOn:      swag.String(models.DiskEncryptionEnableOnMasters),
+			Mode:          swag.String(models.DiskEncryptionModeThreshold),
+			TangServers:   `[{"url":"http://tang.invalid","thumbprint":"PLjNyRdGw03zlRoGjQYMahSZGu9"}]`,
+			ThresholdSpec: `{"threshold":2,"tpm2":true,"tang_threshold":1}`,
+		}
+		var content []byte
+		mockManifestsApi.EXPECT().CreateClusterManifestInternal(ctx, gomock.Any(), false).DoAndReturn(
+			func(_ context.Context, params operations.V2CreateClusterManifestParams, _ bool) (*models.Manifest, error) {
+				Expect(*params.CreateManifestParams.FileName).To(Equal("99-openshift-master-threshold-encryption.yaml"))
+				var err error
+				content, err = base64.StdEncoding.DecodeString(*params.CreateManifestParams.Content)
+				Expect(err).ToNot(HaveOccurred())
+				return &models.Manifest{}, nil
+			})
+		Expect(manifestsGeneratorApi.AddDiskEncryptionManifest(ctx, log, &c)).To(Succeed())
+
+		var machineConfig *mcfgv1.MachineConfig
+		Expect(yaml.Unmarshal(content, &machineConfig)).To(Succeed())
+		Expect(machineConfig.Spec.KernelArguments).To(ConsistOf("rd.neednet=1"))
+		config, _, err := configv32.Parse(machineConfig.Spec.Config.Raw)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.Storage.Luks).To(HaveLen(1))
+		custom := config.Storage.Luks[0].Clevis.Custom
+		Expect(custom).ToNot(BeNil())
+		Expect(custom.Pin).To(Equal("sss"))
+		Expect(swag.BoolValue(custom.NeedsNetwork)).To(BeTrue())
+		Expect(custom.Config).To(MatchJSON(
+			`{"t":2,"pins":{"tpm2":{},"sss":{"t":1,"pins":{"tang":[{"url":"http://tang.invalid","thp":"PLjNyRdGw03zlRoGjQYMahSZGu9"}]}}}}`))
+	})
 })
 
 var _ = Describe("GetDiskEncryptionCipher", func() {
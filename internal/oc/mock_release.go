@@ -200,3 +200,48 @@ func (mr *MockReleaseMockRecorder) GetReleaseBinaryPath(releaseImage, cacheDir,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseBinaryPath", reflect.TypeOf((*MockRelease)(nil).GetReleaseBinaryPath), releaseImage, cacheDir, ocpVersion)
 }
+
+// GetReleaseDigest mocks base method.
+func (m *MockRelease) GetReleaseDigest(log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReleaseDigest", log, releaseImage, releaseImageMirror, pullSecret)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReleaseDigest indicates an expected call of GetReleaseDigest.
+func (mr *MockReleaseMockRecorder) GetReleaseDigest(log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseDigest", reflect.TypeOf((*MockRelease)(nil).GetReleaseDigest), log, releaseImage, releaseImageMirror, pullSecret)
+}
+
+// GetReleaseImageReferences mocks base method.
+func (m *MockRelease) GetReleaseImageReferences(log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReleaseImageReferences", log, releaseImage, releaseImageMirror, pullSecret)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReleaseImageReferences indicates an expected call of GetReleaseImageReferences.
+func (mr *MockReleaseMockRecorder) GetReleaseImageReferences(log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseImageReferences", reflect.TypeOf((*MockRelease)(nil).GetReleaseImageReferences), log, releaseImage, releaseImageMirror, pullSecret)
+}
+
+// IsImageAvailable mocks base method.
+func (m *MockRelease) IsImageAvailable(log logrus.FieldLogger, image, pullSecret string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsImageAvailable", log, image, pullSecret)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsImageAvailable indicates an expected call of IsImageAvailable.
+func (mr *MockReleaseMockRecorder) IsImageAvailable(log, image, pullSecret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsImageAvailable", reflect.TypeOf((*MockRelease)(nil).IsImageAvailable), log, image, pullSecret)
+}
@@ -64,6 +64,9 @@ type Release interface {
 	GetMajorMinorVersion(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
 	GetReleaseArchitecture(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) ([]string, error)
 	GetImageArchitecture(log logrus.FieldLogger, image string, pullSecret string) ([]string, error)
+	GetReleaseDigest(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
+	GetReleaseImageReferences(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) ([]string, error)
+	IsImageAvailable(log logrus.FieldLogger, image string, pullSecret string) (bool, error)
 	GetReleaseBinaryPath(releaseImage string, cacheDir string, ocpVersion string) (workdir string, binary string, path string, err error)
 	Extract(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, cacheDir string, pullSecret string, ocpVersion string) (string, error)
 }
@@ -96,9 +99,11 @@ func NewRelease(executer executer.Executer, config Config, mirrorRegistriesBuild
 const (
 	templateGetImage              = "oc adm release info --image-for=%s --insecure=%t %s %s"
 	templateGetVersion            = "oc adm release info -o template --template '{{.metadata.version}}' --insecure=%t %s %s"
+	templateGetReferences         = "oc adm release info --output json --insecure=%t %s %s"
 	templateExtract               = "oc adm release extract --command=%s --to=%s --insecure=%t %s %s"
 	templateImageInfo             = "oc image info --output json %s %s"
 	templateSkopeoDetectMultiarch = "skopeo inspect --raw --no-tags docker://%s"
+	templateSkopeoInspect         = "skopeo inspect --no-tags docker://%s"
 	ocAuthArgument                = " --registry-config="
 	skopeoAuthArgument            = " --authfile "
 )
@@ -272,6 +277,94 @@ func (r *release) GetImageArchitecture(log logrus.FieldLogger, image, pullSecret
 	return []string{architecture}, nil
 }
 
+// GetReleaseDigest returns the digest of the release image, resolving it from the registry (or the
+// releaseImageMirror if provided) when the release image isn't referenced by digest.
+func (r *release) GetReleaseDigest(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	if releaseImage == "" && releaseImageMirror == "" {
+		return "", errors.New("no releaseImage nor releaseImageMirror provided")
+	}
+	if _, digest, found := strings.Cut(releaseImage, "@"); found {
+		return digest, nil
+	}
+
+	mirrorsFlag, err := r.getMirrorsFlagFromRegistriesConfig(log, templateImageInfo)
+	if err != nil {
+		return "", err
+	}
+	defer mirrorsFlag.Delete()
+
+	image, _ := r.getReleaseImageToUse(releaseImage, releaseImageMirror, mirrorsFlag)
+	cmd := fmt.Sprintf(templateImageInfo, mirrorsFlag, image)
+	imageInfoStr, err := execute(log, r.executer, pullSecret, cmd, ocAuthArgument)
+	if err == nil {
+		digest, err := jsonparser.GetString([]byte(imageInfoStr), "digest")
+		if err != nil {
+			return "", fmt.Errorf("failed to get digest from image info of %s: %w", image, err)
+		}
+		return digest, nil
+	}
+
+	// oc can't inspect multiarch release images without filtering by os, but skopeo reports the
+	// digest of the manifest list
+	skopeoImageInfoStr, err2 := execute(log, r.executer, pullSecret, fmt.Sprintf(templateSkopeoInspect, image), skopeoAuthArgument)
+	if err2 != nil {
+		return "", fmt.Errorf("failed to inspect image, oc: %v, skopeo: %v", err, err2)
+	}
+	digest, err := jsonparser.GetString([]byte(skopeoImageInfoStr), "Digest")
+	if err != nil {
+		return "", fmt.Errorf("failed to get digest from image info of %s: %w", image, err)
+	}
+	return digest, nil
+}
+
+// GetReleaseImageReferences returns the references, by digest, of all the images in the release payload
+func (r *release) GetReleaseImageReferences(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) ([]string, error) {
+	if releaseImage == "" && releaseImageMirror == "" {
+		return nil, errors.New("no releaseImage nor releaseImageMirror provided")
+	}
+	mirrorsFlag, err := r.getMirrorsFlagFromRegistriesConfig(log, templateGetReferences)
+	if err != nil {
+		return nil, err
+	}
+	defer mirrorsFlag.Delete()
+	image, insecure := r.getReleaseImageToUse(releaseImage, releaseImageMirror, mirrorsFlag)
+
+	cmd := fmt.Sprintf(templateGetReferences, insecure, mirrorsFlag, image)
+	releaseInfoStr, err := execute(log, r.executer, pullSecret, cmd, ocAuthArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	var references []string
+	_, err = jsonparser.ArrayEach([]byte(releaseInfoStr), func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		if name, _ := jsonparser.GetString(value, "from", "name"); name != "" {
+			references = append(references, name)
+		}
+	}, "references", "spec", "tags")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the image references of release %s: %w", image, err)
+	}
+	if len(references) == 0 {
+		return nil, fmt.Errorf("release %s doesn't reference any image", image)
+	}
+	return references, nil
+}
+
+// IsImageAvailable returns false if the registry reports that the image doesn't exist, and an error if the
+// registry can't be queried. Mirrors aren't applied, the image is looked up where it's referenced.
+func (r *release) IsImageAvailable(log logrus.FieldLogger, image string, pullSecret string) (bool, error) {
+	_, err := execute(log, r.executer, pullSecret, fmt.Sprintf(templateSkopeoDetectMultiarch, image), skopeoAuthArgument)
+	if err == nil {
+		return true, nil
+	}
+	for _, notFound := range []string{"manifest unknown", "name unknown", "not found"} {
+		if strings.Contains(strings.ToLower(err.Error()), notFound) {
+			return false, nil
+		}
+	}
+	return false, err
+}
+
 func getImageKey(imageName, releaseImage string) string {
 	return imageName + "@" + releaseImage
 }
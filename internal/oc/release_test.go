@@ -371,6 +371,124 @@ var _ = Describe("oc", func() {
 		})
 	})
 
+	Context("GetReleaseDigest", func() {
+		const digest = "sha256:0f2b8bd6fa7b1b6d1ef4cd3e0d2a6e5bd6ab5e0d7e1b68f3b5f1a0d8c0c2e9a1"
+
+		It("returns the digest of a release image referenced by digest without inspecting it", func() {
+			res, err := oc.GetReleaseDigest(log, "quay.io/openshift-release-dev/ocp-release@"+digest, "", pullSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res).Should(Equal(digest))
+		})
+
+		It("fetch digest of single-arch release image", func() {
+			command := fmt.Sprintf(templateImageInfo+" --registry-config=%s", releaseImage, "", tempFilePath)
+			args := splitStringToInterfacesArray(command)
+			imageInfoStr := fmt.Sprintf("{ \"digest\": \"%s\" }", digest)
+			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(imageInfoStr, "", 0).Times(1)
+
+			res, err := oc.GetReleaseDigest(log, releaseImage, "", pullSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res).Should(Equal(digest))
+		})
+
+		It("fetch digest of multi-arch release image", func() {
+			command := fmt.Sprintf(templateImageInfo+" --registry-config=%s", releaseImage, "", tempFilePath)
+			command2 := fmt.Sprintf(templateSkopeoInspect+" --authfile %s", releaseImage, tempFilePath)
+			args := splitStringToInterfacesArray(command)
+			args2 := splitStringToInterfacesArray(command2)
+			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "the image is a manifest list", 1).Times(1)
+			mockExecuter.EXPECT().Execute(args2[0], args2[1:]...).Return(fmt.Sprintf("{ \"Digest\": \"%s\" }", digest), "", 0).Times(1)
+
+			res, err := oc.GetReleaseDigest(log, releaseImage, "", pullSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res).Should(Equal(digest))
+		})
+
+		It("broken release image", func() {
+			command := fmt.Sprintf(templateImageInfo+" --registry-config=%s", releaseImage, "", tempFilePath)
+			command2 := fmt.Sprintf(templateSkopeoInspect+" --authfile %s", releaseImage, tempFilePath)
+			args := splitStringToInterfacesArray(command)
+			args2 := splitStringToInterfacesArray(command2)
+			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "that's not even an image", 1).Times(1)
+			mockExecuter.EXPECT().Execute(args2[0], args2[1:]...).Return("", "that's still not an image", 1).Times(1)
+
+			res, err := oc.GetReleaseDigest(log, releaseImage, "", pullSecret)
+			Expect(err).Should(HaveOccurred())
+			Expect(res).Should(BeEmpty())
+		})
+
+		It("no release image", func() {
+			_, err := oc.GetReleaseDigest(log, "", "", pullSecret)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("GetReleaseImageReferences", func() {
+		It("returns the images of the release payload", func() {
+			command := fmt.Sprintf(templateGetReferences+" --registry-config=%s", false, releaseImage, "", tempFilePath)
+			args := splitStringToInterfacesArray(command)
+			releaseInfoStr := `{"references": {"spec": {"tags": [
+				{"name": "machine-config-operator", "from": {"kind": "DockerImage", "name": "quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:1111"}},
+				{"name": "ironic-agent", "from": {"kind": "DockerImage", "name": "quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:2222"}}
+			]}}}`
+			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(releaseInfoStr, "", 0).Times(1)
+
+			res, err := oc.GetReleaseImageReferences(log, releaseImage, "", pullSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res).Should(Equal([]string{
+				"quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:1111",
+				"quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:2222",
+			}))
+		})
+
+		It("fails for a release without references", func() {
+			command := fmt.Sprintf(templateGetReferences+" --registry-config=%s", false, releaseImage, "", tempFilePath)
+			args := splitStringToInterfacesArray(command)
+			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(`{"references": {"spec": {"tags": []}}}`, "", 0).Times(1)
+
+			_, err := oc.GetReleaseImageReferences(log, releaseImage, "", pullSecret)
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("fails when the release can't be inspected", func() {
+			command := fmt.Sprintf(templateGetReferences+" --registry-config=%s", false, releaseImage, "", tempFilePath)
+			args := splitStringToInterfacesArray(command)
+			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "unauthorized", 1).Times(1)
+
+			_, err := oc.GetReleaseImageReferences(log, releaseImage, "", pullSecret)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("IsImageAvailable", func() {
+		const image = "mirror.example.com/ocp-v4.0-art-dev@sha256:1111"
+		var args []interface{}
+
+		BeforeEach(func() {
+			args = splitStringToInterfacesArray(fmt.Sprintf(templateSkopeoDetectMultiarch+" --authfile %s", image, tempFilePath))
+		})
+
+		It("available image", func() {
+			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("{}", "", 0).Times(1)
+			available, err := oc.IsImageAvailable(log, image, pullSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(available).Should(BeTrue())
+		})
+
+		It("missing image", func() {
+			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "reading manifest sha256:1111 in mirror.example.com/ocp-v4.0-art-dev: manifest unknown", 1).Times(1)
+			available, err := oc.IsImageAvailable(log, image, pullSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(available).Should(BeFalse())
+		})
+
+		It("unreachable registry", func() {
+			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "dial tcp: lookup mirror.example.com: no such host", 1).Times(1)
+			_, err := oc.IsImageAvailable(log, image, pullSecret)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
 	Context("Extract", func() {
 		BeforeEach(func() {
 			mockSystemInfo.EXPECT().FIPSEnabled().Return(false, nil).AnyTimes()
@@ -8,7 +8,7 @@ import (
 	"github.com/lib/pq"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/featuresupport"
-	"github.com/openshift/assisted-service/internal/hardware/virt"
+	"github.com/openshift/assisted-service/internal/hardware/firmware"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/internal/operators/lso"
@@ -138,8 +138,8 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID()}, err
 	}
 
-	if !virt.IsVirtSupported(inventory) {
-		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{"CPU does not have virtualization support"}}, nil
+	if reasons := o.checkFirmware(host, inventory); len(reasons) > 0 {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: reasons}, nil
 	}
 
 	if shouldInstallHPP(o.config, cluster) {
@@ -177,6 +177,16 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 	return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID()}, nil
 }
 
+// checkFirmware returns the reasons the firmware settings of the host don't support OpenShift Virtualization
+func (o *operator) checkFirmware(host *models.Host, inventory *models.Inventory) []string {
+	policy := firmware.Policy(o.config.FirmwarePolicy)
+	if !policy.AppliesTo(common.GetEffectiveRole(host)) {
+		policy = firmware.Policy{}
+	}
+	policy.Virtualization = true
+	return policy.Check(inventory)
+}
+
 // GenerateManifests generates manifests for the operator
 func (o *operator) GenerateManifests(c *common.Cluster) (map[string][]byte, []byte, error) {
 	return Manifests(o.config, c)
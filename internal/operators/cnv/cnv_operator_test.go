@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/firmware"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/internal/operators/cnv"
 	"github.com/openshift/assisted-service/internal/operators/lso"
@@ -295,6 +296,19 @@ var _ = Describe("CNV operator", func() {
 				api.ValidationResult{Status: api.Success, ValidationId: cnvOperator.GetHostValidationID(), Reasons: nil},
 			),
 		)
+
+		It("validates the firmware policy of OpenShift Virtualization", func() {
+			policyCfg := cfg
+			policyCfg.FirmwarePolicy = firmware.PolicyDecoder{SecureBoot: firmware.SecureBootEnabled}
+			policyOperator := cnv.NewCNVOperator(log, policyCfg)
+			cluster := &common.Cluster{Cluster: models.Cluster{OpenshiftVersion: "4.10", ControlPlaneCount: sno, Hosts: []*models.Host{masterWithoutVirt}}}
+			res, err := policyOperator.ValidateHost(context.TODO(), cluster, masterWithoutVirt, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(api.ValidationResult{Status: api.Failure, ValidationId: policyOperator.GetHostValidationID(), Reasons: []string{
+				"Secure Boot must be enabled, but its state is Unknown",
+				"CPU does not have virtualization support",
+			}}))
+		})
 	})
 
 	DescribeTable("GetPreflightRequirements, should be returned", func(cfg cnv.Config, cluster common.Cluster) {
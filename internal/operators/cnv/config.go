@@ -2,6 +2,8 @@ package cnv
 
 import (
 	"strings"
+
+	"github.com/openshift/assisted-service/internal/hardware/firmware"
 )
 
 type DeviceIDDecoder map[string]bool
@@ -17,6 +19,8 @@ type Config struct {
 	SNOInstallHPP bool `envconfig:"CNV_SNO_INSTALL_HPP" default:"true"`
 	// In CNV+SNO we'll deploy the HPP storage provisioner. This defines the request size for the storage pool that backs HPP; we validate by checking host's disks against this value
 	SNOPoolSizeRequestHPPGib int64 `envconfig:"CNV_SNO_POOL_SIZE_REQUEST_HPP_GIB" default:"50"`
+	// Firmware settings that the hosts need for OpenShift Virtualization, in addition to the virtualization extensions of the CPU
+	FirmwarePolicy firmware.PolicyDecoder `envconfig:"CNV_FIRMWARE_POLICY" default:""`
 }
 
 func (d *DeviceIDDecoder) Decode(value string) error {
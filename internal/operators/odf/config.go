@@ -10,4 +10,6 @@ type Config struct {
 	ODFPerHostCPUStandardMode       int64 `envconfig:"ODF_PER_HOST_CPU_STANDARD_MODE" default:"8"`
 	ODFPerHostMemoryGiBStandardMode int64 `envconfig:"ODF_PER_HOST_MEMORY_GIB_STANDARD_MODE" default:"19"`
 	ODFMinDiskSizeGB                int64 `envconfig:"ODF_MIN_DISK_SIZE_GB" default:"25"`
+	// ODFMinNetworkThroughputMbps is the throughput required between the hosts that run ODF, 0 disables the requirement
+	ODFMinNetworkThroughputMbps float64 `envconfig:"ODF_MIN_NETWORK_THROUGHPUT_MBPS" default:"0"`
 }
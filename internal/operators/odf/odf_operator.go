@@ -7,6 +7,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/go-openapi/swag"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/lib/pq"
 	"github.com/openshift/assisted-service/internal/common"
@@ -273,19 +274,30 @@ func (o *operator) GetHostRequirements(_ context.Context, cluster *common.Cluste
 
 		// Each ODF disk requires 2 CPUs and 5 GiB RAM
 		return &models.ClusterHostRequirementsDetails{
-			CPUCores: o.config.ODFPerHostCPUCompactMode + (diskCount * o.config.ODFPerDiskCPUCount),
-			RAMMib:   conversions.GibToMib(o.config.ODFPerHostMemoryGiBCompactMode + (diskCount * o.config.ODFPerDiskRAMGiB)),
+			CPUCores:              o.config.ODFPerHostCPUCompactMode + (diskCount * o.config.ODFPerDiskCPUCount),
+			RAMMib:                conversions.GibToMib(o.config.ODFPerHostMemoryGiBCompactMode + (diskCount * o.config.ODFPerDiskRAMGiB)),
+			NetworkThroughputMbps: o.networkThroughputRequirement(),
 		}, nil
 	}
 
 	// worker in standard mode
 	// Each ODF disk odf requires 2 CPUs and 5 GiB RAM
 	return &models.ClusterHostRequirementsDetails{
-		CPUCores: o.config.ODFPerHostCPUStandardMode + (diskCount * o.config.ODFPerDiskCPUCount),
-		RAMMib:   conversions.GibToMib(o.config.ODFPerHostMemoryGiBStandardMode + (diskCount * o.config.ODFPerDiskRAMGiB)),
+		CPUCores:              o.config.ODFPerHostCPUStandardMode + (diskCount * o.config.ODFPerDiskCPUCount),
+		RAMMib:                conversions.GibToMib(o.config.ODFPerHostMemoryGiBStandardMode + (diskCount * o.config.ODFPerDiskRAMGiB)),
+		NetworkThroughputMbps: o.networkThroughputRequirement(),
 	}, nil
 }
 
+// networkThroughputRequirement returns the throughput the hosts that run ODF need between them, or nil if it isn't
+// required
+func (o *operator) networkThroughputRequirement() *float64 {
+	if o.config.ODFMinNetworkThroughputMbps <= 0 {
+		return nil
+	}
+	return swag.Float64(o.config.ODFMinNetworkThroughputMbps)
+}
+
 // GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
 func (o *operator) GetPreflightRequirements(context context.Context, cluster *common.Cluster) (*models.OperatorHardwareRequirements, error) {
 	dependencies, err := o.GetDependencies(cluster)
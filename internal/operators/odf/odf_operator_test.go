@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	"github.com/onsi/ginkgo/extensions/table"
@@ -476,6 +477,23 @@ var _ = Describe("Odf Operator", func() {
 				},
 			),
 		)
+
+		It("requires the configured network throughput from the hosts that run ODF", func() {
+			config := *operator.config
+			config.ODFMinNetworkThroughputMbps = 10000
+			throughputOperator := newOdfOperatorWithConfig(common.GetTestLog(), &config)
+			cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID, Hosts: []*models.Host{
+				masterWithThreeDisk, masterWithOneDisk, masterWithNoDisk,
+			}}}
+
+			res, err := throughputOperator.GetHostRequirements(ctx, cluster, masterWithThreeDisk)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.NetworkThroughputMbps).To(Equal(swag.Float64(10000)))
+
+			res, err = operator.GetHostRequirements(ctx, cluster, masterWithThreeDisk)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.NetworkThroughputMbps).To(BeNil())
+		})
 	})
 
 	Context("ValidateHost", func() {
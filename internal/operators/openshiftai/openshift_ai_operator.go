@@ -8,6 +8,7 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	"github.com/lib/pq"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/firmware"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/internal/operators/lvm"
@@ -254,6 +255,12 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 		)
 	}
 
+	// Check firmware settings:
+	policy := firmware.Policy(o.config.FirmwarePolicy)
+	if policy.AppliesTo(common.GetEffectiveRole(host)) {
+		result.Reasons = append(result.Reasons, policy.Check(inventory)...)
+	}
+
 	if len(result.Reasons) > 0 {
 		result.Status = api.Failure
 	} else {
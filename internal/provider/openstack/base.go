@@ -0,0 +1,61 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+type openstackProvider struct {
+	Log logrus.FieldLogger
+}
+
+// NewOpenstackProvider creates a new OpenStack provider.
+func NewOpenstackProvider(log logrus.FieldLogger) provider.Provider {
+	return &openstackProvider{
+		Log: log,
+	}
+}
+
+// Name returns the name of the provider
+func (p *openstackProvider) Name() models.PlatformType {
+	return models.PlatformTypeOpenstack
+}
+
+func (p *openstackProvider) IsHostSupported(host *models.Host) (bool, error) {
+	// during the discovery there is a short time that host didn't return its inventory to the service
+	if host.Inventory == "" {
+		return false, nil
+	}
+	hostInventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		return false, fmt.Errorf("error marshaling host to inventory, error %w", err)
+	}
+	if hostInventory.SystemVendor == nil {
+		return false, nil
+	}
+	return hostInventory.SystemVendor.Manufacturer == OpenstackManufacturer ||
+		hostInventory.SystemVendor.ProductName == OpenstackProductName, nil
+}
+
+func (p *openstackProvider) AreHostsSupported(hosts []*models.Host) (bool, error) {
+	for _, h := range hosts {
+		supported, err := p.IsHostSupported(h)
+		if err != nil {
+			return false, fmt.Errorf("error while checking if host is supported, error is: %w", err)
+		}
+		if !supported {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (p *openstackProvider) IsProviderForPlatform(platform *models.Platform) bool {
+	return platform != nil &&
+		platform.Type != nil &&
+		*platform.Type == p.Name()
+}
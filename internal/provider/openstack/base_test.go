@@ -0,0 +1,71 @@
+package openstack
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("base", func() {
+	var log = common.GetTestLog()
+	Context("is host supported", func() {
+		var provider provider.Provider
+		var host *models.Host
+		BeforeEach(func() {
+			provider = NewOpenstackProvider(log)
+			host = &models.Host{}
+		})
+
+		setHostInventory := func(inventory *models.Inventory, host *models.Host) {
+			data, err := json.Marshal(inventory)
+			Expect(err).To(BeNil())
+			host.Inventory = string(data)
+		}
+
+		DescribeTable("system vendor",
+			func(systemVendor *models.SystemVendor, expected bool) {
+				setHostInventory(&models.Inventory{SystemVendor: systemVendor}, host)
+				supported, err := provider.IsHostSupported(host)
+				Expect(err).To(BeNil())
+				Expect(supported).To(Equal(expected))
+			},
+			Entry("OpenStack instance", &models.SystemVendor{Manufacturer: OpenstackManufacturer, ProductName: OpenstackProductName}, true),
+			Entry("RHOSP instance", &models.SystemVendor{Manufacturer: "Red Hat", ProductName: OpenstackProductName}, true),
+			Entry("KVM virtual machine", &models.SystemVendor{Manufacturer: "Red Hat", ProductName: "KVM"}, false),
+			Entry("vSphere virtual machine", &models.SystemVendor{Manufacturer: "VMware, Inc."}, false),
+			Entry("no system vendor", nil, false),
+		)
+
+		It("no inventory", func() {
+			supported, err := provider.IsHostSupported(host)
+			Expect(err).To(BeNil())
+			Expect(supported).To(BeFalse())
+		})
+
+		It("invalid inventory", func() {
+			host.Inventory = "invalid-inventory"
+			_, err := provider.IsHostSupported(host)
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("all hosts must be supported", func() {
+			openstackHost := &models.Host{}
+			setHostInventory(&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: OpenstackManufacturer}}, openstackHost)
+			otherHost := &models.Host{}
+			setHostInventory(&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "Dell Inc."}}, otherHost)
+
+			supported, err := provider.AreHostsSupported([]*models.Host{openstackHost, openstackHost})
+			Expect(err).To(BeNil())
+			Expect(supported).To(BeTrue())
+
+			supported, err = provider.AreHostsSupported([]*models.Host{openstackHost, otherHost})
+			Expect(err).To(BeNil())
+			Expect(supported).To(BeFalse())
+		})
+	})
+})
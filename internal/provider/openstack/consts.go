@@ -0,0 +1,24 @@
+package openstack
+
+const (
+	// PhCloud is the name of the clouds.yaml entry used by the install config, the credentials
+	// secret holds a clouds.yaml with an entry of that name that has to be replaced in day2
+	PhCloud        = "openstack"
+	PhAuthURL      = "https://authurlplaceholder:5000/v3"
+	PhUsername     = "usernameplaceholder"
+	PhPassword     = "passwordplaceholder"
+	PhProjectName  = "projectplaceholder"
+	PhDomainName   = "domainplaceholder"
+	PhRegionName   = "regionplaceholder"
+	CredentialsKey = "clouds.yaml"
+
+	// The cloud credential operator and the OpenStack cloud provider read the credentials from this secret
+	CredentialsSecretName      = "openstack-credentials"
+	CredentialsSecretNamespace = "kube-system"
+	CredentialsManifestName    = "99_cloud-creds-secret.yaml"
+
+	// Nova sets the system manufacturer of the instances to "OpenStack Foundation", but downstream
+	// distributions such as RHOSP set their own manufacturer and keep the product name
+	OpenstackManufacturer string = "OpenStack Foundation"
+	OpenstackProductName  string = "OpenStack Compute"
+)
@@ -0,0 +1,135 @@
+package openstack
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/openshift/assisted-service/internal/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// cloudsYAML returns a clouds.yaml with placeholder credentials for the cloud used by the install config
+func cloudsYAML() ([]byte, error) {
+	clouds := map[string]interface{}{
+		"clouds": map[string]interface{}{
+			PhCloud: map[string]interface{}{
+				"auth": map[string]interface{}{
+					"auth_url":            PhAuthURL,
+					"username":            PhUsername,
+					"password":            PhPassword,
+					"project_name":        PhProjectName,
+					"user_domain_name":    PhDomainName,
+					"project_domain_name": PhDomainName,
+				},
+				"region_name": PhRegionName,
+			},
+		},
+	}
+	return yaml.Marshal(clouds)
+}
+
+func (p openstackProvider) PreCreateManifestsHook(_ *common.Cluster, envVars *[]string, workDir string) error {
+	// The installer reads the credentials of the cloud of the install config from clouds.yaml
+	content, err := cloudsYAML()
+	if err != nil {
+		return fmt.Errorf("error generating clouds.yaml: %w", err)
+	}
+	cloudsFile := filepath.Join(workDir, CredentialsKey)
+	if err = os.WriteFile(cloudsFile, content, 0o600); err != nil {
+		return fmt.Errorf("error writing %s: %w", cloudsFile, err)
+	}
+	*envVars = append(*envVars, "OS_CLIENT_CONFIG_FILE="+cloudsFile)
+	return nil
+}
+
+func (p openstackProvider) PostCreateManifestsHook(_ *common.Cluster, _ *[]string, workDir string) error {
+	// Deleting machines and machineSets for openstack platform after manifest generation, the hosts
+	// are not provisioned by the installer
+
+	// Delete machines
+	p.Log.Info("Deleting machines manifests")
+	files, _ := filepath.Glob(path.Join(workDir, "openshift", "*_openshift-cluster-api_master-machines-*.yaml"))
+	err := p.deleteAllFiles(files)
+
+	if err != nil {
+		return fmt.Errorf("error deleting master machine: %w", err)
+	}
+
+	// Delete machine-set
+	p.Log.Info("Deleting machine set manifest")
+	files, _ = filepath.Glob(path.Join(workDir, "openshift", "*_openshift-cluster-api_worker-machineset-*.yaml"))
+	err = p.deleteAllFiles(files)
+
+	if err != nil {
+		return fmt.Errorf("error deleting machineset: %w", err)
+	}
+
+	// Delete control-plane-machine-set
+	p.Log.Info("Deleting control-plane machine set")
+	files, _ = filepath.Glob(path.Join(workDir, "openshift", "*_openshift-machine-api_master-control-plane-machine-set*.yaml"))
+	err = p.deleteAllFiles(files)
+
+	if err != nil {
+		return fmt.Errorf("error deleting control-plane machineset: %w", err)
+	}
+
+	if err = p.writeCredentialsManifest(workDir); err != nil {
+		return fmt.Errorf("error writing the credentials manifest: %w", err)
+	}
+
+	return nil
+}
+
+// writeCredentialsManifest adds the secret with the placeholder credentials that the cloud credential
+// operator and the cloud provider read, unless the installer already generated it
+func (p openstackProvider) writeCredentialsManifest(workDir string) error {
+	files, _ := filepath.Glob(path.Join(workDir, "openshift", "*_cloud-creds-secret.yaml"))
+	if len(files) > 0 {
+		return nil
+	}
+
+	content, err := cloudsYAML()
+	if err != nil {
+		return err
+	}
+	secret := corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CredentialsSecretName,
+			Namespace: CredentialsSecretNamespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			CredentialsKey: content,
+		},
+	}
+	manifest, err := yaml.Marshal(&secret)
+	if err != nil {
+		return err
+	}
+
+	manifestPath := filepath.Join(workDir, "openshift", CredentialsManifestName)
+	p.Log.Infof("Writing manifest %s", manifestPath)
+	if err = os.MkdirAll(filepath.Dir(manifestPath), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(manifestPath, manifest, 0o600)
+}
+
+func (p openstackProvider) deleteAllFiles(files []string) error {
+	for _, f := range files {
+		p.Log.Infof("Deleting manifest %s", f)
+
+		if err := os.Remove(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package openstack
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/swag"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+	errorWrap "github.com/pkg/errors"
+)
+
+func (p openstackProvider) addLoadBalancer(cfg *installcfg.InstallerConfigBaremetal, cluster *common.Cluster) error {
+	if cluster.LoadBalancer == nil {
+		return nil
+	}
+	switch cluster.LoadBalancer.Type {
+	case models.LoadBalancerTypeClusterManaged:
+		// Nothing, this is the default.
+	case models.LoadBalancerTypeUserManaged:
+		cfg.Platform.Openstack.LoadBalancer = &configv1.OpenStackPlatformLoadBalancer{
+			Type: configv1.LoadBalancerTypeUserManaged,
+		}
+	default:
+		return fmt.Errorf(
+			"load balancer type is set to unsupported value '%s', supported values are "+
+				"'%s' and '%s'",
+			cluster.LoadBalancer.Type,
+			models.LoadBalancerTypeClusterManaged,
+			models.LoadBalancerTypeUserManaged,
+		)
+	}
+	return nil
+}
+
+func (p openstackProvider) AddPlatformToInstallConfig(
+	cfg *installcfg.InstallerConfigBaremetal, cluster *common.Cluster, infraEnvs []*common.InfraEnv) error {
+	// The credentials are not stored by the service, the install config refers to the entry of
+	// the clouds.yaml of the credentials secret that is replaced in day2
+	osPlatform := &installcfg.OpenstackInstallConfigPlatform{
+		Cloud: PhCloud,
+	}
+
+	if !swag.BoolValue(cluster.UserManagedNetworking) {
+		if len(cluster.APIVips) == 0 {
+			return errors.New("invalid cluster parameters, APIVip must be provided")
+		}
+
+		if len(cluster.IngressVips) == 0 {
+			return errors.New("invalid cluster parameters, IngressVip must be provided")
+		}
+
+		if featuresupport.IsFeatureAvailable(models.FeatureSupportLevelIDDUALSTACKVIPS, cluster.OpenshiftVersion, swag.String(cluster.CPUArchitecture)) {
+			osPlatform.APIVIPs = network.GetApiVips(cluster)
+			osPlatform.IngressVIPs = network.GetIngressVips(cluster)
+		} else {
+			osPlatform.APIVIPs = []string{network.GetApiVips(cluster)[0]}
+			osPlatform.IngressVIPs = []string{network.GetIngressVips(cluster)[0]}
+			osPlatform.DeprecatedAPIVIP = network.GetApiVipById(cluster, 0)
+			osPlatform.DeprecatedIngressVIP = network.GetIngressVipById(cluster, 0)
+		}
+	} else {
+		cfg.Networking.MachineNetwork = provider.GetMachineNetworkForUserManagedNetworking(p.Log, cluster)
+		if cluster.NetworkType != nil {
+			cfg.Networking.NetworkType = swag.StringValue(cluster.NetworkType)
+		}
+	}
+
+	cfg.Platform = installcfg.Platform{
+		Openstack: osPlatform,
+	}
+
+	if err := p.addLoadBalancer(cfg, cluster); err != nil {
+		return errorWrap.Wrap(err, "failed to set OpenStack's cluster install-config.yaml load balancer as user-managed")
+	}
+
+	return nil
+}
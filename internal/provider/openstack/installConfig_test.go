@@ -0,0 +1,134 @@
+package openstack
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("AddPlatformToInstallConfig", func() {
+	var (
+		cluster  *common.Cluster
+		cfg      *installcfg.InstallerConfigBaremetal
+		provider provider.Provider
+	)
+
+	BeforeEach(func() {
+		cluster = &common.Cluster{
+			Cluster: models.Cluster{
+				OpenshiftVersion: common.MinimumVersionForUserManagedLoadBalancerFeature,
+				APIVips: []*models.APIVip{
+					{IP: "192.168.127.1"},
+				},
+				IngressVips: []*models.IngressVip{
+					{IP: "192.168.127.2"},
+				},
+			},
+		}
+		cfg = &installcfg.InstallerConfigBaremetal{}
+		provider = NewOpenstackProvider(common.GetTestLog())
+	})
+
+	It("sets the VIPs and the placeholder cloud", func() {
+		Expect(provider.AddPlatformToInstallConfig(cfg, cluster, nil)).To(Succeed())
+		Expect(cfg.Platform.Openstack).ToNot(BeNil())
+		Expect(cfg.Platform.Openstack.Cloud).To(Equal(PhCloud))
+		Expect(cfg.Platform.Openstack.APIVIPs).To(Equal([]string{"192.168.127.1"}))
+		Expect(cfg.Platform.Openstack.IngressVIPs).To(Equal([]string{"192.168.127.2"}))
+		Expect(cfg.Platform.Openstack.LoadBalancer).To(BeNil())
+	})
+
+	It("requires the VIPs with cluster managed networking", func() {
+		cluster.APIVips = nil
+		Expect(provider.AddPlatformToInstallConfig(cfg, cluster, nil)).ToNot(Succeed())
+	})
+
+	It("doesn't require the VIPs with user managed networking", func() {
+		cluster.APIVips = nil
+		cluster.IngressVips = nil
+		cluster.UserManagedNetworking = swag.Bool(true)
+		cluster.NetworkType = swag.String(models.ClusterNetworkTypeOVNKubernetes)
+		Expect(provider.AddPlatformToInstallConfig(cfg, cluster, nil)).To(Succeed())
+		Expect(cfg.Platform.Openstack.APIVIPs).To(BeEmpty())
+		Expect(cfg.Networking.NetworkType).To(Equal(models.ClusterNetworkTypeOVNKubernetes))
+	})
+
+	It("adds user-managed load balancer", func() {
+		cluster.LoadBalancer = &models.LoadBalancer{Type: models.LoadBalancerTypeUserManaged}
+		Expect(provider.AddPlatformToInstallConfig(cfg, cluster, nil)).To(Succeed())
+		Expect(cfg.Platform.Openstack.LoadBalancer).ToNot(BeNil())
+		Expect(cfg.Platform.Openstack.LoadBalancer.Type).To(Equal(configv1.LoadBalancerTypeUserManaged))
+	})
+
+	It("fails for unsupported load balancer type", func() {
+		cluster.LoadBalancer = &models.LoadBalancer{Type: "invalid"}
+		Expect(provider.AddPlatformToInstallConfig(cfg, cluster, nil)).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("manifests hooks", func() {
+	var (
+		workDir  string
+		provider provider.Provider
+	)
+
+	BeforeEach(func() {
+		var err error
+		workDir, err = os.MkdirTemp("", "openstack-manifests")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.MkdirAll(filepath.Join(workDir, "openshift"), 0o755)).To(Succeed())
+		provider = NewOpenstackProvider(common.GetTestLog())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(workDir)
+	})
+
+	It("points the installer to a clouds.yaml with the placeholder cloud", func() {
+		envVars := []string{}
+		Expect(provider.PreCreateManifestsHook(&common.Cluster{}, &envVars, workDir)).To(Succeed())
+		cloudsFile := filepath.Join(workDir, CredentialsKey)
+		Expect(envVars).To(ConsistOf("OS_CLIENT_CONFIG_FILE=" + cloudsFile))
+		content, err := os.ReadFile(cloudsFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(PhAuthURL))
+	})
+
+	It("deletes the machines and adds the credentials secret", func() {
+		machines := filepath.Join(workDir, "openshift", "99_openshift-cluster-api_master-machines-0.yaml")
+		machineSet := filepath.Join(workDir, "openshift", "99_openshift-cluster-api_worker-machineset-0.yaml")
+		Expect(os.WriteFile(machines, []byte("kind: Machine"), 0o600)).To(Succeed())
+		Expect(os.WriteFile(machineSet, []byte("kind: MachineSet"), 0o600)).To(Succeed())
+
+		Expect(provider.PostCreateManifestsHook(&common.Cluster{}, &[]string{}, workDir)).To(Succeed())
+		Expect(machines).ToNot(BeAnExistingFile())
+		Expect(machineSet).ToNot(BeAnExistingFile())
+
+		content, err := os.ReadFile(filepath.Join(workDir, "openshift", CredentialsManifestName))
+		Expect(err).ToNot(HaveOccurred())
+		var secret corev1.Secret
+		Expect(yaml.Unmarshal(content, &secret)).To(Succeed())
+		Expect(secret.Name).To(Equal(CredentialsSecretName))
+		Expect(secret.Namespace).To(Equal(CredentialsSecretNamespace))
+		Expect(string(secret.Data[CredentialsKey])).To(ContainSubstring(PhUsername))
+	})
+
+	It("keeps the credentials secret generated by the installer", func() {
+		manifest := filepath.Join(workDir, "openshift", CredentialsManifestName)
+		Expect(os.WriteFile(manifest, []byte("kind: Secret"), 0o600)).To(Succeed())
+		Expect(provider.PostCreateManifestsHook(&common.Cluster{}, &[]string{}, workDir)).To(Succeed())
+		content, err := os.ReadFile(manifest)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("kind: Secret"))
+	})
+})
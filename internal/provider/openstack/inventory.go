@@ -0,0 +1,20 @@
+package openstack
+
+import (
+	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/models"
+)
+
+func (p *openstackProvider) CleanPlatformValuesFromDBUpdates(_ map[string]interface{}) error {
+	return nil
+}
+
+func (p *openstackProvider) SetPlatformUsages(
+	usages map[string]models.Usage,
+	usageApi usage.API) error {
+	props := &map[string]interface{}{
+		"platform_type": p.Name()}
+	usageApi.Add(usages, usage.PlatformSelectionUsage, props)
+	usageApi.Add(usages, usage.OpenstackIntegration, props)
+	return nil
+}
@@ -0,0 +1,13 @@
+package openstack
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestOpenstack(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "openstack tests")
+}
@@ -130,6 +130,10 @@ func checkPlatformWrongParamsInput(platform *models.Platform, userManagedNetwork
 		return err
 	}
 
+	if err := validateVspherePlatform(platform, cluster); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -327,6 +331,8 @@ func GetPlatformFeatureID(platformType models.PlatformType) models.FeatureSuppor
 		return models.FeatureSupportLevelIDVSPHEREINTEGRATION
 	case models.PlatformTypeNutanix:
 		return models.FeatureSupportLevelIDNUTANIXINTEGRATION
+	case models.PlatformTypeOpenstack:
+		return models.FeatureSupportLevelIDOPENSTACKINTEGRATION
 	default:
 		return "" // Return empty string on platform without a feature support ID
 	}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
@@ -112,3 +113,55 @@ func validateExternalPlatform(platform *models.Platform, cluster *common.Cluster
 
 	return nil
 }
+
+// validateVspherePlatform checks that the vSphere settings are set only with the vsphere platform type, and that the
+// settings needed to check the vCenter and to write them to the install config are set together
+func validateVspherePlatform(platform *models.Platform, cluster *common.Cluster) error {
+	if platform == nil || platform.Vsphere == nil {
+		// nothing to check
+		return nil
+	}
+
+	if common.PlatformTypeValue(platform.Type) != models.PlatformTypeVsphere {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("vSphere settings can only be set with vsphere platform type"))
+	}
+
+	settings := platform.Vsphere
+	values := []struct {
+		name  string
+		value string
+	}{
+		{"username", settings.Username},
+		{"datacenter", settings.Datacenter},
+		{"cluster", settings.Cluster},
+		{"default_datastore", settings.DefaultDatastore},
+		{"network", settings.Network},
+		{"folder", settings.Folder},
+	}
+	if settings.Vcenter == "" {
+		for _, v := range values {
+			if v.value != "" {
+				return common.NewApiError(http.StatusBadRequest, errors.Errorf("vSphere setting %s can only be set with the vcenter", v.name))
+			}
+		}
+		return nil
+	}
+
+	var missing []string
+	for _, v := range values {
+		if v.value == "" && v.name != "folder" {
+			missing = append(missing, v.name)
+		}
+	}
+	if settings.Password == "" && (cluster == nil || cluster.VspherePassword == "") {
+		missing = append(missing, "password")
+	}
+	if len(missing) > 0 {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("vSphere settings %s must be set with the vcenter", strings.Join(missing, ", ")))
+	}
+	if settings.Folder != "" && !strings.HasPrefix(settings.Folder, "/") {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("vSphere folder %s must be an absolute path", settings.Folder))
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// The plugin protocol is JSON over HTTP: each operation is a POST request to <url>/<operation>.
+// The plugin replies with 200 and the response document, or with an error status and a message.
+const (
+	hostsSupportedOperation      = "v1/hosts-supported"
+	installConfigOperation       = "v1/install-config"
+	preCreateManifestsOperation  = "v1/pre-create-manifests"
+	postCreateManifestsOperation = "v1/post-create-manifests"
+)
+
+// maxResponseSize limits the size of the responses of the plugins
+const maxResponseSize = 64 * 1024 * 1024
+
+// workDirFiles are the files and directories of the installer working directory that are sent to the plugins
+var workDirFiles = []string{"install-config.yaml", "manifests", "openshift"}
+
+type hostsSupportedRequest struct {
+	Hosts []*models.Host `json:"hosts"`
+}
+
+type hostsSupportedResponse struct {
+	Supported bool `json:"supported"`
+}
+
+type installConfigRequest struct {
+	Cluster       *models.Cluster                      `json:"cluster"`
+	InfraEnvs     []*models.InfraEnv                   `json:"infra_envs"`
+	InstallConfig *installcfg.InstallerConfigBaremetal `json:"install_config"`
+}
+
+type installConfigResponse struct {
+	InstallConfig *installcfg.InstallerConfigBaremetal `json:"install_config"`
+}
+
+type manifestsHookRequest struct {
+	Cluster *models.Cluster `json:"cluster"`
+	EnvVars []string        `json:"env_vars"`
+	// Files maps the paths relative to the working directory to the content of the files
+	Files map[string][]byte `json:"files"`
+}
+
+type manifestsHookResponse struct {
+	// EnvVars are added to the environment of the installer
+	EnvVars []string `json:"env_vars,omitempty"`
+	// Files are created or replaced in the working directory
+	Files map[string][]byte `json:"files,omitempty"`
+	// DeletedFiles are removed from the working directory
+	DeletedFiles []string `json:"deleted_files,omitempty"`
+}
+
+type client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func (c *client) call(operation string, request, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal the %s request", operation)
+	}
+
+	url := strings.TrimSuffix(c.baseURL, "/") + "/" + operation
+	reply, err := c.httpClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to call %s", url)
+	}
+	defer reply.Body.Close()
+
+	replyBody, err := io.ReadAll(io.LimitReader(reply.Body, maxResponseSize))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the response of %s", url)
+	}
+	if reply.StatusCode != http.StatusOK {
+		return errors.Errorf("%s returned %d: %s", url, reply.StatusCode, strings.TrimSpace(string(replyBody)))
+	}
+	if err = json.Unmarshal(replyBody, response); err != nil {
+		return errors.Wrapf(err, "failed to parse the response of %s", url)
+	}
+	return nil
+}
+
+// readWorkDirFiles returns the content of the files of the working directory the plugins can change
+func readWorkDirFiles(workDir string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	for _, name := range workDirFiles {
+		err := filepath.WalkDir(filepath.Join(workDir, name), func(path string, entry os.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			if !entry.Type().IsRegular() {
+				return nil
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			relativePath, err := filepath.Rel(workDir, path)
+			if err != nil {
+				return err
+			}
+			files[filepath.ToSlash(relativePath)] = content
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", name)
+		}
+	}
+	return files, nil
+}
+
+// applyWorkDirChanges writes and deletes the files returned by a plugin, which must be inside the
+// files and directories that are sent to the plugins
+func applyWorkDirChanges(workDir string, files map[string][]byte, deletedFiles []string) error {
+	for name, content := range files {
+		path, err := workDirPath(workDir, name)
+		if err != nil {
+			return err
+		}
+		if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return errors.Wrapf(err, "failed to create the directory of %s", name)
+		}
+		if err = os.WriteFile(path, content, 0o600); err != nil {
+			return errors.Wrapf(err, "failed to write %s", name)
+		}
+	}
+	for _, name := range deletedFiles {
+		path, err := workDirPath(workDir, name)
+		if err != nil {
+			return err
+		}
+		if err = os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to delete %s", name)
+		}
+	}
+	return nil
+}
+
+func workDirPath(workDir, name string) (string, error) {
+	cleanName := filepath.Clean(filepath.FromSlash(name))
+	if filepath.IsAbs(cleanName) {
+		return "", errors.Errorf("invalid file %s, expected a path relative to the working directory", name)
+	}
+	for _, allowed := range workDirFiles {
+		if cleanName == allowed || strings.HasPrefix(cleanName, allowed+string(filepath.Separator)) {
+			return filepath.Join(workDir, cleanName), nil
+		}
+	}
+	return "", errors.Errorf("invalid file %s, plugins may only change %s", name, strings.Join(workDirFiles, ", "))
+}
@@ -0,0 +1,199 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/internal/provider/external"
+	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+type Config struct {
+	// Plugins is a JSON list of the plugins, for example:
+	// [{"name": "mycloud", "url": "http://mycloud-provider-plugin:8080"}]
+	Plugins string        `envconfig:"PROVIDER_PLUGINS" default:""`
+	Timeout time.Duration `envconfig:"PROVIDER_PLUGIN_TIMEOUT" default:"1m"`
+}
+
+// PluginConfig describes a platform provider implemented outside of the service.
+type PluginConfig struct {
+	// Name is the external platform name (platform.external.platform_name) handled by the plugin
+	Name string `json:"name"`
+	// URL is the base URL of the plugin server
+	URL string `json:"url"`
+}
+
+// pluginProvider implements provider.Provider by delegating to a plugin server. The plugin
+// handles clusters of the external platform type with the configured platform name. Everything
+// the plugin doesn't handle behaves like the generic external platform.
+type pluginProvider struct {
+	log    logrus.FieldLogger
+	name   string
+	client *client
+	// base provides the default external platform behavior
+	base provider.Provider
+}
+
+// NewProviders creates a provider for each of the configured plugins. The providers should be
+// registered before the in-tree providers, so that they take precedence for their platform names.
+func NewProviders(cfg Config, log logrus.FieldLogger) ([]provider.Provider, error) {
+	if cfg.Plugins == "" {
+		return nil, nil
+	}
+
+	var plugins []PluginConfig
+	if err := json.Unmarshal([]byte(cfg.Plugins), &plugins); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the provider plugins configuration")
+	}
+
+	names := map[string]bool{}
+	providers := make([]provider.Provider, 0, len(plugins))
+	for _, pluginConfig := range plugins {
+		if err := validatePluginConfig(pluginConfig); err != nil {
+			return nil, err
+		}
+		if names[pluginConfig.Name] {
+			return nil, errors.Errorf("provider plugin %s is configured more than once", pluginConfig.Name)
+		}
+		names[pluginConfig.Name] = true
+
+		providers = append(providers, NewProvider(pluginConfig, &http.Client{Timeout: cfg.Timeout}, log))
+		log.Infof("Registered provider plugin %s at %s", pluginConfig.Name, pluginConfig.URL)
+	}
+	return providers, nil
+}
+
+// NewProvider creates a provider that delegates to the plugin server described by the configuration.
+func NewProvider(pluginConfig PluginConfig, httpClient *http.Client, log logrus.FieldLogger) provider.Provider {
+	log = log.WithField("provider-plugin", pluginConfig.Name)
+	return &pluginProvider{
+		log:    log,
+		name:   pluginConfig.Name,
+		client: &client{baseURL: pluginConfig.URL, httpClient: httpClient},
+		base:   external.NewExternalProvider(log),
+	}
+}
+
+func validatePluginConfig(pluginConfig PluginConfig) error {
+	if pluginConfig.Name == "" {
+		return errors.New("provider plugin name must not be empty")
+	}
+	u, err := url.Parse(pluginConfig.URL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid URL of provider plugin %s", pluginConfig.Name)
+	}
+	if !funk.ContainsString([]string{"http", "https"}, u.Scheme) || u.Host == "" {
+		return errors.Errorf("invalid URL %q of provider plugin %s, expected an http or https URL", pluginConfig.URL, pluginConfig.Name)
+	}
+	return nil
+}
+
+// Name returns the name of the provider
+func (p *pluginProvider) Name() models.PlatformType {
+	return models.PlatformTypeExternal
+}
+
+func (p *pluginProvider) IsProviderForPlatform(platform *models.Platform) bool {
+	return platform != nil &&
+		platform.Type != nil &&
+		*platform.Type == models.PlatformTypeExternal &&
+		platform.External != nil &&
+		platform.External.PlatformName != nil &&
+		*platform.External.PlatformName == p.name
+}
+
+func (p *pluginProvider) AddPlatformToInstallConfig(
+	cfg *installcfg.InstallerConfigBaremetal, cluster *common.Cluster, infraEnvs []*common.InfraEnv) error {
+	if err := p.base.AddPlatformToInstallConfig(cfg, cluster, infraEnvs); err != nil {
+		return err
+	}
+
+	request := installConfigRequest{
+		Cluster:       &cluster.Cluster,
+		InstallConfig: cfg,
+	}
+	for _, infraEnv := range infraEnvs {
+		request.InfraEnvs = append(request.InfraEnvs, &infraEnv.InfraEnv)
+	}
+	var response installConfigResponse
+	if err := p.client.call(installConfigOperation, &request, &response); err != nil {
+		return fmt.Errorf("provider plugin %s failed to add the platform to the install config: %w", p.name, err)
+	}
+	if response.InstallConfig == nil {
+		return fmt.Errorf("provider plugin %s returned an empty install config", p.name)
+	}
+
+	*cfg = *response.InstallConfig
+	return nil
+}
+
+func (p *pluginProvider) CleanPlatformValuesFromDBUpdates(updates map[string]interface{}) error {
+	return p.base.CleanPlatformValuesFromDBUpdates(updates)
+}
+
+func (p *pluginProvider) SetPlatformUsages(usages map[string]models.Usage, usageApi usage.API) error {
+	return p.base.SetPlatformUsages(usages, usageApi)
+}
+
+func (p *pluginProvider) IsHostSupported(host *models.Host) (bool, error) {
+	return p.AreHostsSupported([]*models.Host{host})
+}
+
+// AreHostsSupported asks the plugin whether it supports the hosts. A plugin that can't be reached
+// doesn't support any host, so that it doesn't fail the platform selection of other platforms.
+func (p *pluginProvider) AreHostsSupported(hosts []*models.Host) (bool, error) {
+	var response hostsSupportedResponse
+	if err := p.client.call(hostsSupportedOperation, &hostsSupportedRequest{Hosts: hosts}, &response); err != nil {
+		p.log.WithError(err).Warn("failed to check whether the hosts are supported by the provider plugin")
+		return false, nil
+	}
+	return response.Supported, nil
+}
+
+func (p *pluginProvider) PreCreateManifestsHook(cluster *common.Cluster, envVars *[]string, workDir string) error {
+	if err := p.manifestsHook(preCreateManifestsOperation, cluster, envVars, workDir); err != nil {
+		return fmt.Errorf("provider plugin %s failed to run the pre manifests creation hook: %w", p.name, err)
+	}
+	return nil
+}
+
+func (p *pluginProvider) PostCreateManifestsHook(cluster *common.Cluster, envVars *[]string, workDir string) error {
+	if err := p.manifestsHook(postCreateManifestsOperation, cluster, envVars, workDir); err != nil {
+		return fmt.Errorf("provider plugin %s failed to run the post manifests creation hook: %w", p.name, err)
+	}
+	return nil
+}
+
+// manifestsHook sends the files of the working directory to the plugin and applies the changes it returns
+func (p *pluginProvider) manifestsHook(operation string, cluster *common.Cluster, envVars *[]string, workDir string) error {
+	files, err := readWorkDirFiles(workDir)
+	if err != nil {
+		return err
+	}
+
+	request := manifestsHookRequest{
+		Cluster: &cluster.Cluster,
+		EnvVars: *envVars,
+		Files:   files,
+	}
+	var response manifestsHookResponse
+	if err = p.client.call(operation, &request, &response); err != nil {
+		return err
+	}
+
+	if err = applyWorkDirChanges(workDir, response.Files, response.DeletedFiles); err != nil {
+		return err
+	}
+	*envVars = append(*envVars, response.EnvVars...)
+	return nil
+}
@@ -0,0 +1,221 @@
+package plugin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+)
+
+func externalPlatform(name string) *models.Platform {
+	return &models.Platform{
+		Type: models.NewPlatformType(models.PlatformTypeExternal),
+		External: &models.PlatformExternal{
+			PlatformName:           swag.String(name),
+			CloudControllerManager: swag.String(models.PlatformExternalCloudControllerManagerExternal),
+		},
+	}
+}
+
+var _ = Describe("NewProviders", func() {
+	It("returns no providers when no plugin is configured", func() {
+		providers, err := NewProviders(Config{}, common.GetTestLog())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(providers).To(BeEmpty())
+	})
+
+	It("creates a provider for each plugin", func() {
+		providers, err := NewProviders(Config{
+			Plugins: `[{"name": "mycloud", "url": "http://mycloud:8080"}, {"name": "othercloud", "url": "https://othercloud"}]`,
+		}, common.GetTestLog())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(providers).To(HaveLen(2))
+		Expect(providers[0].IsProviderForPlatform(externalPlatform("mycloud"))).To(BeTrue())
+		Expect(providers[1].IsProviderForPlatform(externalPlatform("othercloud"))).To(BeTrue())
+	})
+
+	DescribeTable("rejects invalid configurations",
+		func(plugins, expectedError string) {
+			_, err := NewProviders(Config{Plugins: plugins}, common.GetTestLog())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedError))
+		},
+		Entry("invalid JSON", `{"name": "mycloud"}`, "failed to parse"),
+		Entry("missing name", `[{"url": "http://mycloud:8080"}]`, "name must not be empty"),
+		Entry("missing URL", `[{"name": "mycloud"}]`, "invalid URL"),
+		Entry("unsupported scheme", `[{"name": "mycloud", "url": "grpc://mycloud:8080"}]`, "invalid URL"),
+		Entry("duplicate name", `[{"name": "mycloud", "url": "http://a"}, {"name": "mycloud", "url": "http://b"}]`, "more than once"),
+	)
+})
+
+var _ = Describe("pluginProvider", func() {
+	var (
+		server   *httptest.Server
+		handlers map[string]http.HandlerFunc
+		requests map[string]map[string]interface{}
+		p        provider.Provider
+		cluster  *common.Cluster
+	)
+
+	respond := func(response interface{}) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			Expect(json.NewEncoder(w).Encode(response)).To(Succeed())
+		}
+	}
+
+	BeforeEach(func() {
+		handlers = map[string]http.HandlerFunc{}
+		requests = map[string]map[string]interface{}{}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Method).To(Equal(http.MethodPost))
+			request := map[string]interface{}{}
+			Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+			requests[r.URL.Path] = request
+			handler, ok := handlers[r.URL.Path]
+			if !ok {
+				http.Error(w, "unknown operation", http.StatusNotFound)
+				return
+			}
+			handler(w, r)
+		}))
+		p = NewProvider(PluginConfig{Name: "mycloud", URL: server.URL}, server.Client(), common.GetTestLog())
+
+		clusterID := strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{
+			Cluster: models.Cluster{
+				ID:                    &clusterID,
+				Platform:              externalPlatform("mycloud"),
+				UserManagedNetworking: swag.Bool(true),
+			},
+			PullSecret: "secret",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("handles only the external platform with its name", func() {
+		Expect(p.Name()).To(Equal(models.PlatformTypeExternal))
+		Expect(p.IsProviderForPlatform(externalPlatform("mycloud"))).To(BeTrue())
+		Expect(p.IsProviderForPlatform(externalPlatform("oci"))).To(BeFalse())
+		Expect(p.IsProviderForPlatform(&models.Platform{Type: models.NewPlatformType(models.PlatformTypeBaremetal)})).To(BeFalse())
+		Expect(p.IsProviderForPlatform(nil)).To(BeFalse())
+	})
+
+	Context("AddPlatformToInstallConfig", func() {
+		It("applies the install config returned by the plugin", func() {
+			handlers["/v1/install-config"] = func(w http.ResponseWriter, r *http.Request) {
+				cfg := installcfg.InstallerConfigBaremetal{}
+				cfg.Platform.External = &installcfg.ExternalInstallConfigPlatform{PlatformName: "mycloud", CloudControllerManager: "External"}
+				cfg.AdditionalTrustBundle = "plugin-ca"
+				respond(installConfigResponse{InstallConfig: &cfg})(w, r)
+			}
+
+			cfg := &installcfg.InstallerConfigBaremetal{}
+			Expect(p.AddPlatformToInstallConfig(cfg, cluster, nil)).To(Succeed())
+			Expect(cfg.AdditionalTrustBundle).To(Equal("plugin-ca"))
+			Expect(cfg.Platform.External.PlatformName).To(Equal("mycloud"))
+
+			// The plugin gets the default external platform and never the pull secret
+			request := requests["/v1/install-config"]
+			Expect(request["install_config"]).To(HaveKeyWithValue("platform",
+				HaveKeyWithValue("external", HaveKeyWithValue("PlatformName", "mycloud"))))
+			Expect(request["cluster"]).To(HaveKeyWithValue("id", cluster.ID.String()))
+			Expect(request["cluster"]).ToNot(HaveKey("pull_secret"))
+		})
+
+		It("fails when the plugin fails", func() {
+			handlers["/v1/install-config"] = func(w http.ResponseWriter, _ *http.Request) {
+				http.Error(w, "no quota left", http.StatusInternalServerError)
+			}
+			err := p.AddPlatformToInstallConfig(&installcfg.InstallerConfigBaremetal{}, cluster, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no quota left"))
+		})
+	})
+
+	Context("AreHostsSupported", func() {
+		It("returns the answer of the plugin", func() {
+			handlers["/v1/hosts-supported"] = respond(hostsSupportedResponse{Supported: true})
+			supported, err := p.AreHostsSupported([]*models.Host{{Inventory: "{}"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(supported).To(BeTrue())
+			Expect(requests["/v1/hosts-supported"]["hosts"]).To(HaveLen(1))
+		})
+
+		It("doesn't support hosts when the plugin fails", func() {
+			supported, err := p.IsHostSupported(&models.Host{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(supported).To(BeFalse())
+		})
+	})
+
+	Context("manifests hooks", func() {
+		var workDir string
+
+		BeforeEach(func() {
+			var err error
+			workDir, err = os.MkdirTemp("", "provider-plugin")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.MkdirAll(filepath.Join(workDir, "openshift"), 0o755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(workDir, "install-config.yaml"), []byte("apiVersion: v1"), 0o600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(workDir, "openshift", "obsolete.yaml"), []byte("kind: Secret"), 0o600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(workDir, "auth.json"), []byte("secret"), 0o600)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(workDir)
+		})
+
+		It("sends the working directory files and applies the changes of the plugin", func() {
+			handlers["/v1/post-create-manifests"] = respond(manifestsHookResponse{
+				EnvVars:      []string{"MYCLOUD_REGION=east"},
+				Files:        map[string][]byte{"manifests/mycloud-ccm.yaml": []byte("kind: Deployment")},
+				DeletedFiles: []string{"openshift/obsolete.yaml"},
+			})
+
+			envVars := []string{"OPENSHIFT_INSTALL_RELEASE_IMAGE_OVERRIDE=release"}
+			Expect(p.PostCreateManifestsHook(cluster, &envVars, workDir)).To(Succeed())
+
+			Expect(envVars).To(Equal([]string{"OPENSHIFT_INSTALL_RELEASE_IMAGE_OVERRIDE=release", "MYCLOUD_REGION=east"}))
+			content, err := os.ReadFile(filepath.Join(workDir, "manifests", "mycloud-ccm.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(Equal("kind: Deployment"))
+			Expect(filepath.Join(workDir, "openshift", "obsolete.yaml")).ToNot(BeAnExistingFile())
+
+			files := requests["/v1/post-create-manifests"]["files"]
+			Expect(files).To(HaveKey("install-config.yaml"))
+			Expect(files).To(HaveKey("openshift/obsolete.yaml"))
+			Expect(files).ToNot(HaveKey("auth.json"))
+		})
+
+		DescribeTable("rejects files outside of the allowed paths",
+			func(name string) {
+				handlers["/v1/pre-create-manifests"] = respond(manifestsHookResponse{
+					Files: map[string][]byte{name: []byte("x")},
+				})
+				envVars := []string{}
+				err := p.PreCreateManifestsHook(cluster, &envVars, workDir)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid file"))
+			},
+			Entry("other file", "auth.json"),
+			Entry("parent directory", "manifests/../../escape.yaml"),
+			Entry("absolute path", "/etc/passwd"),
+		)
+	})
+})
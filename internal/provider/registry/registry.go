@@ -11,10 +11,12 @@ import (
 	"github.com/openshift/assisted-service/internal/provider/external"
 	"github.com/openshift/assisted-service/internal/provider/none"
 	"github.com/openshift/assisted-service/internal/provider/nutanix"
+	"github.com/openshift/assisted-service/internal/provider/openstack"
 	"github.com/openshift/assisted-service/internal/provider/vsphere"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
 )
 
 // ErrNoSuchProvider is returned or thrown in panic when the specified provider is not registered.
@@ -137,7 +139,7 @@ func (r *registry) GetSupportedProvidersByHosts(hosts []*models.Host) ([]models.
 				"error while checking if hosts are supported by platform %s, error %w",
 				p.Name(), err)
 		}
-		if supported {
+		if supported && !funk.Contains(clusterSupportedPlatforms, p.Name()) {
 			clusterSupportedPlatforms = append(clusterSupportedPlatforms, p.Name())
 		}
 	}
@@ -168,12 +170,18 @@ func (r *registry) PostCreateManifestsHook(cluster *common.Cluster, envVars *[]s
 	return currentProvider.PostCreateManifestsHook(cluster, envVars, workDir)
 }
 
-func InitProviderRegistry(log logrus.FieldLogger) ProviderRegistry {
+// InitProviderRegistry creates a registry with the in-tree providers. The additional providers,
+// typically provider plugins, are registered first, so that they take precedence.
+func InitProviderRegistry(log logrus.FieldLogger, additionalProviders ...provider.Provider) ProviderRegistry {
 	providerRegistry := NewProviderRegistry()
+	for _, additionalProvider := range additionalProviders {
+		providerRegistry.Register(additionalProvider)
+	}
 	providerRegistry.Register(vsphere.NewVsphereProvider(log))
 	providerRegistry.Register(baremetal.NewBaremetalProvider(log))
 	providerRegistry.Register(none.NewNoneProvider(log))
 	providerRegistry.Register(nutanix.NewNutanixProvider(log))
+	providerRegistry.Register(openstack.NewOpenstackProvider(log))
 	providerRegistry.Register(external.NewOciExternalProvider(log))
 	providerRegistry.Register(external.NewExternalProvider(log))
 	return providerRegistry
@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/provider"
 	"github.com/openshift/assisted-service/internal/provider/vsphere"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/models"
@@ -113,6 +114,32 @@ var _ = Describe("IsHostSupported", func() {
 	})
 })
 
+var _ = Describe("Additional providers", func() {
+	It("take precedence over the in-tree providers", func() {
+		ctrl = gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		platform := &models.Platform{
+			Type: models.NewPlatformType(models.PlatformTypeExternal),
+			External: &models.PlatformExternal{
+				PlatformName: swag.String("mycloud"),
+			},
+		}
+		pluginProvider := provider.NewMockProvider(ctrl)
+		pluginProvider.EXPECT().IsProviderForPlatform(platform).Return(true).Times(1)
+		pluginProvider.EXPECT().IsProviderForPlatform(gomock.Any()).Return(false).AnyTimes()
+
+		providerRegistry = InitProviderRegistry(common.GetTestLog(), pluginProvider)
+
+		p, err := providerRegistry.Get(platform)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p).To(Equal(pluginProvider))
+
+		p, err = providerRegistry.Get(&models.Platform{Type: models.NewPlatformType(models.PlatformTypeBaremetal)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.Name()).To(Equal(models.PlatformTypeBaremetal))
+	})
+})
+
 var _ = Describe("Test AddPlatformToInstallConfig", func() {
 	BeforeEach(func() {
 		providerRegistry = InitProviderRegistry(common.GetTestLog())
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	configv1 "github.com/openshift/api/config/v1"
 	"github.com/openshift/assisted-service/internal/common"
@@ -15,25 +16,44 @@ import (
 	errorWrap "github.com/pkg/errors"
 )
 
-func setPlatformValues(openshiftVersion string, platform *installcfg.VsphereInstallConfigPlatform) {
-	// Add placeholders to make it easier to replace in day2
+// placeholderTopology is used when the vCenter of the cluster isn't set, to make it easier to replace in day2
+var placeholderTopology = Topology{
+	VCenter:    PhVcenter,
+	Username:   PhUsername,
+	Password:   PhPassword,
+	Datacenter: PhDatacenter,
+	Cluster:    PhCluster,
+	Datastore:  PhDefaultDatastore,
+	Network:    PhNetwork,
+	Folder:     fmt.Sprintf("/%s/vm/%s", PhDatacenter, PhFolder),
+}
+
+func setPlatformValues(openshiftVersion string, platform *installcfg.VsphereInstallConfigPlatform, topology *Topology) {
+	usePlaceholders := topology == nil
+	if usePlaceholders {
+		topology = &placeholderTopology
+	}
+
 	if isLessThan, err := common.BaseVersionLessThan("4.13", openshiftVersion); isLessThan || err != nil {
-		platform.DeprecatedCluster = PhCluster
-		platform.DeprecatedVCenter = PhVcenter
-		platform.DeprecatedNetwork = PhNetwork
-		platform.DeprecatedDefaultDatastore = PhDefaultDatastore
-		platform.DeprecatedUsername = PhUsername
-		platform.DeprecatedPassword = PhPassword
-		platform.DeprecatedDatacenter = PhDatacenter
+		platform.DeprecatedCluster = topology.Cluster
+		platform.DeprecatedVCenter = topology.VCenter
+		platform.DeprecatedNetwork = topology.Network
+		platform.DeprecatedDefaultDatastore = topology.Datastore
+		platform.DeprecatedUsername = topology.Username
+		platform.DeprecatedPassword = strfmt.Password(topology.Password)
+		platform.DeprecatedDatacenter = topology.Datacenter
+		if !usePlaceholders {
+			platform.DeprecatedFolder = topology.Folder
+		}
 		return
 	}
 
 	platform.VCenters = []installcfg.VsphereVCenter{
 		{
-			Datacenters: []string{PhDatacenter},
-			Password:    PhPassword,
-			Server:      PhVcenter,
-			Username:    PhUsername,
+			Datacenters: []string{topology.Datacenter},
+			Password:    strfmt.Password(topology.Password),
+			Server:      topology.VCenter,
+			Username:    topology.Username,
 		},
 	}
 
@@ -41,13 +61,13 @@ func setPlatformValues(openshiftVersion string, platform *installcfg.VsphereInst
 		{
 			Name:   "assisted-generated-failure-domain",
 			Region: "assisted-generated-region",
-			Server: PhVcenter,
+			Server: topology.VCenter,
 			Topology: installcfg.VsphereFailureDomainTopology{
-				ComputeCluster: fmt.Sprintf("/%s/host/%s", PhDatacenter, PhCluster),
-				Datacenter:     PhDatacenter,
-				Datastore:      fmt.Sprintf("/%s/datastore/%s", PhDatacenter, PhDefaultDatastore),
-				Folder:         fmt.Sprintf("/%s/vm/%s", PhDatacenter, PhFolder),
-				Networks:       []string{PhNetwork},
+				ComputeCluster: fmt.Sprintf("/%s/host/%s", topology.Datacenter, topology.Cluster),
+				Datacenter:     topology.Datacenter,
+				Datastore:      fmt.Sprintf("/%s/datastore/%s", topology.Datacenter, topology.Datastore),
+				Folder:         topology.Folder,
+				Networks:       []string{topology.Network},
 			},
 			Zone: "assisted-generated-zone",
 		},
@@ -106,7 +126,7 @@ func (p vsphereProvider) AddPlatformToInstallConfig(
 		}
 	}
 
-	setPlatformValues(cluster.OpenshiftVersion, vsPlatform)
+	setPlatformValues(cluster.OpenshiftVersion, vsPlatform, GetTopology(cluster))
 	cfg.Platform = installcfg.Platform{
 		Vsphere: vsPlatform,
 	}
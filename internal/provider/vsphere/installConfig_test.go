@@ -85,4 +85,41 @@ var _ = Describe("AddPlatformToInstallConfig", func() {
 			Expect(err.Error()).To(ContainSubstring("load balancer type is set to unsupported value 'unsupported'"))
 		})
 	})
+
+	Context("setPlatformValues", func() {
+		It("Uses placeholders when the vCenter isn't set", func() {
+			err := provider.AddPlatformToInstallConfig(cfg, cluster, infraEnvs)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.Platform.Vsphere.VCenters[0].Server).To(Equal(PhVcenter))
+			Expect(cfg.Platform.Vsphere.FailureDomains[0].Topology.Datacenter).To(Equal(PhDatacenter))
+		})
+
+		It("Uses the vSphere settings of the cluster", func() {
+			cluster.Platform = &models.Platform{
+				Type: models.NewPlatformType(models.PlatformTypeVsphere),
+				Vsphere: &models.PlatformVsphere{
+					Vcenter:          "vcenter.example.com",
+					Username:         "admin",
+					Datacenter:       "dc",
+					Cluster:          "cluster",
+					DefaultDatastore: "datastore",
+					Network:          "network",
+					Folder:           "/dc/vm/folder",
+				},
+			}
+			cluster.VspherePassword = "secret"
+			err := provider.AddPlatformToInstallConfig(cfg, cluster, infraEnvs)
+			Expect(err).ToNot(HaveOccurred())
+			vcenter := cfg.Platform.Vsphere.VCenters[0]
+			Expect(vcenter.Server).To(Equal("vcenter.example.com"))
+			Expect(vcenter.Username).To(Equal("admin"))
+			Expect(string(vcenter.Password)).To(Equal("secret"))
+			Expect(vcenter.Datacenters).To(Equal([]string{"dc"}))
+			topology := cfg.Platform.Vsphere.FailureDomains[0].Topology
+			Expect(topology.ComputeCluster).To(Equal("/dc/host/cluster"))
+			Expect(topology.Datastore).To(Equal("/dc/datastore/datastore"))
+			Expect(topology.Networks).To(Equal([]string{"network"}))
+			Expect(topology.Folder).To(Equal("/dc/vm/folder"))
+		})
+	})
 })
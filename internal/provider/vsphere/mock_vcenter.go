@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/provider/vsphere (interfaces: VCenterValidator)
+
+// Package vsphere is a generated GoMock package.
+package vsphere
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	logrus "github.com/sirupsen/logrus"
+)
+
+// MockVCenterValidator is a mock of VCenterValidator interface.
+type MockVCenterValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockVCenterValidatorMockRecorder
+}
+
+// MockVCenterValidatorMockRecorder is the mock recorder for MockVCenterValidator.
+type MockVCenterValidatorMockRecorder struct {
+	mock *MockVCenterValidator
+}
+
+// NewMockVCenterValidator creates a new mock instance.
+func NewMockVCenterValidator(ctrl *gomock.Controller) *MockVCenterValidator {
+	mock := &MockVCenterValidator{ctrl: ctrl}
+	mock.recorder = &MockVCenterValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVCenterValidator) EXPECT() *MockVCenterValidatorMockRecorder {
+	return m.recorder
+}
+
+// GetStatus mocks base method.
+func (m *MockVCenterValidator) GetStatus(arg0 logrus.FieldLogger, arg1 *Topology) VCenterStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", arg0, arg1)
+	ret0, _ := ret[0].(VCenterStatus)
+	return ret0
+}
+
+// GetStatus indicates an expected call of GetStatus.
+func (mr *MockVCenterValidatorMockRecorder) GetStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockVCenterValidator)(nil).GetStatus), arg0, arg1)
+}
@@ -0,0 +1,248 @@
+package vsphere
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type VCenterConfig struct {
+	// CacheTTL is the amount of time the result of a check is kept before the vCenter is checked again
+	CacheTTL time.Duration `envconfig:"VSPHERE_VALIDATION_CACHE_TTL" default:"10m"`
+	// Timeout of the check of a vCenter
+	Timeout time.Duration `envconfig:"VSPHERE_VALIDATION_TIMEOUT" default:"1m"`
+	// InsecureSkipVerify disables the verification of the certificates of the vCenter servers
+	InsecureSkipVerify bool `envconfig:"VSPHERE_VALIDATION_INSECURE_SKIP_VERIFY" default:"false"`
+}
+
+// Topology is the vCenter server and the vSphere objects that a cluster is installed on
+type Topology struct {
+	VCenter    string
+	Username   string
+	Password   string
+	Datacenter string
+	Cluster    string
+	Datastore  string
+	Network    string
+	Folder     string
+}
+
+// GetTopology returns the vSphere settings of the cluster, or nil if the cluster isn't installed on vSphere or its
+// vCenter isn't set
+func GetTopology(cluster *common.Cluster) *Topology {
+	if cluster.Platform == nil || common.PlatformTypeValue(cluster.Platform.Type) != models.PlatformTypeVsphere ||
+		cluster.Platform.Vsphere == nil || cluster.Platform.Vsphere.Vcenter == "" {
+		return nil
+	}
+	return &Topology{
+		VCenter:    cluster.Platform.Vsphere.Vcenter,
+		Username:   cluster.Platform.Vsphere.Username,
+		Password:   cluster.VspherePassword,
+		Datacenter: cluster.Platform.Vsphere.Datacenter,
+		Cluster:    cluster.Platform.Vsphere.Cluster,
+		Datastore:  cluster.Platform.Vsphere.DefaultDatastore,
+		Network:    cluster.Platform.Vsphere.Network,
+		Folder:     cluster.Platform.Vsphere.Folder,
+	}
+}
+
+func (t *Topology) key() string {
+	hash := sha256.New()
+	for _, value := range []string{t.VCenter, t.Username, t.Password, t.Datacenter, t.Cluster, t.Datastore, t.Network, t.Folder} {
+		hash.Write([]byte(value))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// VCenterStatus is the result of checking the topology of a cluster against its vCenter
+type VCenterStatus struct {
+	// InProgress is true until the first check of the topology completes
+	InProgress bool
+	// Err is set when the vCenter couldn't be reached or the user couldn't log in
+	Err error
+	// MissingObjects are the vSphere objects of the topology that don't exist
+	MissingObjects []string
+	// MissingPrivileges are the privileges that the user doesn't have, with the object they are missing on
+	MissingPrivileges []string
+}
+
+// requiredPrivileges are the privileges the cloud provider and the storage driver of the cluster need on the objects
+// of the topology
+var requiredPrivileges = map[string][]string{
+	"vCenter": {
+		"Cns.Searchable",
+		"InventoryService.Tagging.AttachTag",
+		"StorageProfile.View",
+	},
+	"cluster": {
+		"Resource.AssignVMToPool",
+		"VirtualMachine.Config.AddExistingDisk",
+		"VirtualMachine.Config.AddRemoveDevice",
+		"VirtualMachine.Config.RemoveDisk",
+	},
+	"datastore": {
+		"Datastore.AllocateSpace",
+		"Datastore.Browse",
+		"Datastore.FileManagement",
+	},
+	"network": {
+		"Network.Assign",
+	},
+	"folder": {
+		"VirtualMachine.Config.AddExistingDisk",
+		"VirtualMachine.Config.AddRemoveDevice",
+		"VirtualMachine.Config.RemoveDisk",
+	},
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=vsphere -destination=mock_vcenter.go . VCenterValidator
+type VCenterValidator interface {
+	// GetStatus returns the result of the last check of the topology. If there is none, a check is started in the
+	// background and a status that is in progress is returned.
+	GetStatus(log logrus.FieldLogger, topology *Topology) VCenterStatus
+}
+
+type vcenterValidator struct {
+	config          VCenterConfig
+	statuses        *cache.Cache
+	inProgressMutex sync.Mutex
+	inProgress      map[string]bool
+}
+
+func NewVCenterValidator(config VCenterConfig) VCenterValidator {
+	return &vcenterValidator{
+		config:     config,
+		statuses:   cache.New(config.CacheTTL, 2*config.CacheTTL),
+		inProgress: map[string]bool{},
+	}
+}
+
+func (v *vcenterValidator) GetStatus(log logrus.FieldLogger, topology *Topology) VCenterStatus {
+	key := topology.key()
+	if status, found := v.statuses.Get(key); found {
+		return status.(VCenterStatus)
+	}
+	v.inProgressMutex.Lock()
+	defer v.inProgressMutex.Unlock()
+	if !v.inProgress[key] {
+		v.inProgress[key] = true
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), v.config.Timeout)
+			defer cancel()
+			status := v.check(ctx, log, topology)
+			v.inProgressMutex.Lock()
+			defer v.inProgressMutex.Unlock()
+			v.statuses.SetDefault(key, status)
+			delete(v.inProgress, key)
+		}()
+	}
+	return VCenterStatus{InProgress: true}
+}
+
+func (v *vcenterValidator) check(ctx context.Context, log logrus.FieldLogger, topology *Topology) VCenterStatus {
+	u := &url.URL{
+		Scheme: "https",
+		Host:   topology.VCenter,
+		Path:   "/sdk",
+		User:   url.UserPassword(topology.Username, topology.Password),
+	}
+	client, err := govmomi.NewClient(ctx, u, v.config.InsecureSkipVerify)
+	if err != nil {
+		return VCenterStatus{Err: errors.Wrapf(err, "failed to log in to vCenter %s as %s", topology.VCenter, topology.Username)}
+	}
+	defer func() {
+		if err := client.Logout(context.Background()); err != nil {
+			log.WithError(err).Warnf("failed to log out of vCenter %s", topology.VCenter)
+		}
+	}()
+
+	status := checkTopology(ctx, client, topology)
+	log.Infof("checked the topology of vCenter %s, %d objects and %d privileges are missing", topology.VCenter,
+		len(status.MissingObjects), len(status.MissingPrivileges))
+	return status
+}
+
+// checkTopology finds the objects of the topology and checks the privileges of the user of the client on them
+func checkTopology(ctx context.Context, client *govmomi.Client, topology *Topology) VCenterStatus {
+	var status VCenterStatus
+	finder := find.NewFinder(client.Client)
+
+	datacenter, err := finder.Datacenter(ctx, topology.Datacenter)
+	if err != nil {
+		status.MissingObjects = append(status.MissingObjects, fmt.Sprintf("datacenter %s", topology.Datacenter))
+		return status
+	}
+	finder.SetDatacenter(datacenter)
+
+	entities := map[string]types.ManagedObjectReference{
+		"vCenter": client.ServiceContent.RootFolder,
+	}
+	lookups := []struct {
+		kind string
+		name string
+		find func() (object.Reference, error)
+	}{
+		{"cluster", topology.Cluster, func() (object.Reference, error) {
+			return finder.ClusterComputeResource(ctx, topology.Cluster)
+		}},
+		{"datastore", topology.Datastore, func() (object.Reference, error) { return finder.Datastore(ctx, topology.Datastore) }},
+		{"network", topology.Network, func() (object.Reference, error) { return finder.Network(ctx, topology.Network) }},
+		{"folder", topology.Folder, func() (object.Reference, error) { return finder.Folder(ctx, topology.Folder) }},
+	}
+	for _, lookup := range lookups {
+		if lookup.name == "" {
+			continue
+		}
+		ref, err := lookup.find()
+		if err != nil {
+			status.MissingObjects = append(status.MissingObjects, fmt.Sprintf("%s %s", lookup.kind, lookup.name))
+			continue
+		}
+		entities[lookup.kind] = ref.Reference()
+	}
+
+	userSession, err := session.NewManager(client.Client).UserSession(ctx)
+	if err != nil || userSession == nil {
+		status.Err = errors.Errorf("failed to get the session of user %s", topology.Username)
+		return status
+	}
+	authorizationManager := object.NewAuthorizationManager(client.Client)
+	for kind, entity := range entities {
+		privileges := requiredPrivileges[kind]
+		granted, err := authorizationManager.HasPrivilegeOnEntity(ctx, entity, userSession.Key, privileges)
+		if err != nil {
+			status.Err = errors.Wrapf(err, "failed to check the privileges of user %s on the %s", topology.Username, kind)
+			return status
+		}
+		status.MissingPrivileges = append(status.MissingPrivileges, missingPrivileges(kind, privileges, granted)...)
+	}
+	sort.Strings(status.MissingPrivileges)
+	return status
+}
+
+func missingPrivileges(kind string, privileges []string, granted []bool) []string {
+	var missing []string
+	for i, privilege := range privileges {
+		if i >= len(granted) || !granted[i] {
+			missing = append(missing, fmt.Sprintf("%s on the %s", privilege, kind))
+		}
+	}
+	return missing
+}
@@ -0,0 +1,165 @@
+package vsphere
+
+import (
+	"context"
+	"crypto/tls"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/vmware/govmomi/simulator"
+)
+
+var _ = Describe("GetTopology", func() {
+	It("returns nil when the cluster isn't installed on vSphere", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Platform: &models.Platform{Type: models.NewPlatformType(models.PlatformTypeBaremetal)},
+		}}
+		Expect(GetTopology(cluster)).To(BeNil())
+	})
+
+	It("returns nil when the vCenter isn't set", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Platform: &models.Platform{
+				Type:    models.NewPlatformType(models.PlatformTypeVsphere),
+				Vsphere: &models.PlatformVsphere{},
+			},
+		}}
+		Expect(GetTopology(cluster)).To(BeNil())
+	})
+
+	It("returns the vSphere settings with the stored password", func() {
+		cluster := &common.Cluster{
+			Cluster: models.Cluster{
+				Platform: &models.Platform{
+					Type: models.NewPlatformType(models.PlatformTypeVsphere),
+					Vsphere: &models.PlatformVsphere{
+						Vcenter:          "vcenter.example.com",
+						Username:         "admin",
+						Datacenter:       "dc",
+						Cluster:          "cluster",
+						DefaultDatastore: "datastore",
+						Network:          "network",
+					},
+				},
+			},
+			VspherePassword: "secret",
+		}
+		Expect(GetTopology(cluster)).To(Equal(&Topology{
+			VCenter:    "vcenter.example.com",
+			Username:   "admin",
+			Password:   "secret",
+			Datacenter: "dc",
+			Cluster:    "cluster",
+			Datastore:  "datastore",
+			Network:    "network",
+		}))
+	})
+})
+
+var _ = Describe("vcenterValidator", func() {
+	var (
+		model     *simulator.Model
+		server    *simulator.Server
+		validator *vcenterValidator
+		topology  *Topology
+	)
+
+	BeforeEach(func() {
+		model = simulator.VPX()
+		Expect(model.Create()).To(Succeed())
+		model.Service.Listen = &url.URL{User: url.UserPassword("admin", "secret")}
+		model.Service.TLS = &tls.Config{}
+		server = model.Service.NewServer()
+
+		validator = NewVCenterValidator(VCenterConfig{
+			CacheTTL:           time.Minute,
+			Timeout:            time.Minute,
+			InsecureSkipVerify: true,
+		}).(*vcenterValidator)
+		topology = &Topology{
+			VCenter:    server.URL.Host,
+			Username:   "admin",
+			Password:   "secret",
+			Datacenter: "DC0",
+			Cluster:    "DC0_C0",
+			Datastore:  "LocalDS_0",
+			Network:    "VM Network",
+			Folder:     "/DC0/vm",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+		model.Remove()
+	})
+
+	It("succeeds when all the objects exist", func() {
+		status := validator.check(context.Background(), common.GetTestLog(), topology)
+		Expect(status.Err).ToNot(HaveOccurred())
+		Expect(status.MissingObjects).To(BeEmpty())
+		Expect(status.MissingPrivileges).To(BeEmpty())
+	})
+
+	It("fails with wrong credentials", func() {
+		topology.Password = "wrong"
+		status := validator.check(context.Background(), common.GetTestLog(), topology)
+		Expect(status.Err).To(HaveOccurred())
+		Expect(status.Err.Error()).To(ContainSubstring("failed to log in to vCenter"))
+	})
+
+	It("reports a missing datacenter", func() {
+		topology.Datacenter = "missing"
+		status := validator.check(context.Background(), common.GetTestLog(), topology)
+		Expect(status.Err).ToNot(HaveOccurred())
+		Expect(status.MissingObjects).To(Equal([]string{"datacenter missing"}))
+	})
+
+	It("reports the missing objects of the datacenter", func() {
+		topology.Datastore = "missing-datastore"
+		topology.Network = "missing-network"
+		topology.Folder = "/DC0/vm/missing-folder"
+		status := validator.check(context.Background(), common.GetTestLog(), topology)
+		Expect(status.Err).ToNot(HaveOccurred())
+		Expect(status.MissingObjects).To(ConsistOf(
+			"datastore missing-datastore",
+			"network missing-network",
+			"folder /DC0/vm/missing-folder",
+		))
+	})
+
+	It("checks in the background and caches the result", func() {
+		Expect(validator.GetStatus(common.GetTestLog(), topology).InProgress).To(BeTrue())
+		Eventually(func() bool {
+			return validator.GetStatus(common.GetTestLog(), topology).InProgress
+		}, "10s", "100ms").Should(BeFalse())
+		status := validator.GetStatus(common.GetTestLog(), topology)
+		Expect(status.Err).ToNot(HaveOccurred())
+		Expect(status.MissingObjects).To(BeEmpty())
+	})
+
+	It("checks again when the topology changes", func() {
+		Eventually(func() bool {
+			return validator.GetStatus(common.GetTestLog(), topology).InProgress
+		}, "10s", "100ms").Should(BeFalse())
+		changed := *topology
+		changed.Network = "missing-network"
+		Expect(validator.GetStatus(common.GetTestLog(), &changed).InProgress).To(BeTrue())
+	})
+})
+
+var _ = Describe("missingPrivileges", func() {
+	It("returns the privileges that aren't granted", func() {
+		Expect(missingPrivileges("datastore",
+			[]string{"Datastore.AllocateSpace", "Datastore.Browse", "Datastore.FileManagement"},
+			[]bool{true, false, true},
+		)).To(Equal([]string{"Datastore.Browse on the datastore"}))
+	})
+
+	It("returns nothing when all the privileges are granted", func() {
+		Expect(missingPrivileges("network", []string{"Network.Assign"}, []bool{true})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,240 @@
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Resource string
+
+const (
+	// ResourceClusters are the clusters of the organization that aren't installed yet
+	ResourceClusters Resource = Resource(models.ResourceQuotaUsageResourceClusters)
+	// ResourceHosts are the hosts registered to the infra-envs of the organization
+	ResourceHosts Resource = Resource(models.ResourceQuotaUsageResourceHosts)
+	// ResourceInstallsPerDay are the cluster installations started by the organization in the last day
+	ResourceInstallsPerDay Resource = Resource(models.ResourceQuotaUsageResourceInstallsPerDay)
+	// ResourceISOGenerationsPerDay are the discovery images generated for the organization in the last day
+	ResourceISOGenerationsPerDay Resource = Resource(models.ResourceQuotaUsageResourceIsoGenerationsPerDay)
+)
+
+var allResources = []Resource{ResourceClusters, ResourceHosts, ResourceInstallsPerDay, ResourceISOGenerationsPerDay}
+
+// usageWindow is the period that the daily quotas are counted over
+const usageWindow = 24 * time.Hour
+
+// lockClass is the first key of the advisory locks of the quotas, the second one is the hash of the organization
+const lockClass = 0x71756f74 // "quot"
+
+// Limits are the quotas of an organization, 0 means unlimited
+type Limits struct {
+	Clusters             int64 `json:"clusters"`
+	Hosts                int64 `json:"hosts"`
+	InstallsPerDay       int64 `json:"installs_per_day"`
+	ISOGenerationsPerDay int64 `json:"iso_generations_per_day"`
+}
+
+func (l *Limits) get(resource Resource) int64 {
+	switch resource {
+	case ResourceClusters:
+		return l.Clusters
+	case ResourceHosts:
+		return l.Hosts
+	case ResourceInstallsPerDay:
+		return l.InstallsPerDay
+	case ResourceISOGenerationsPerDay:
+		return l.ISOGenerationsPerDay
+	}
+	return 0
+}
+
+// OrgLimitsDecoder is decoded from a JSON object that maps organization IDs to their quotas
+type OrgLimitsDecoder map[string]Limits
+
+func (d *OrgLimitsDecoder) Decode(value string) error {
+	orgLimits := OrgLimitsDecoder{}
+	if strings.TrimSpace(value) != "" {
+		if err := json.Unmarshal([]byte(value), &orgLimits); err != nil {
+			return errors.Wrap(err, "failed to parse the organization quotas")
+		}
+	}
+	for orgID, limits := range orgLimits {
+		for _, resource := range allResources {
+			if limits.get(resource) < 0 {
+				return errors.Errorf("the %s quota of organization %s must not be negative", resource, orgID)
+			}
+		}
+	}
+	*d = orgLimits
+	return nil
+}
+
+type Config struct {
+	MaxClusters             int64            `envconfig:"QUOTA_MAX_CLUSTERS_PER_ORG" default:"0"`
+	MaxHosts                int64            `envconfig:"QUOTA_MAX_HOSTS_PER_ORG" default:"0"`
+	MaxInstallsPerDay       int64            `envconfig:"QUOTA_MAX_INSTALLS_PER_ORG_PER_DAY" default:"0"`
+	MaxISOGenerationsPerDay int64            `envconfig:"QUOTA_MAX_ISO_GENERATIONS_PER_ORG_PER_DAY" default:"0"`
+	OrgLimits               OrgLimitsDecoder `envconfig:"QUOTA_ORG_LIMITS" default:""`
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=quota -destination=mock_quota.go . API
+type API interface {
+	// Check returns an API error if the organization reached its quota of the resource. Resources that aren't
+	// owned by an organization are never limited. The quotas of the organization stay locked until the end of the
+	// transaction of db, so that the resource must be created, or its use recorded, in the same transaction for
+	// the concurrent requests of the organization to be counted.
+	Check(ctx context.Context, db *gorm.DB, orgID string, resource Resource) error
+	// Record records a use of a resource whose quota is counted per day, in the transaction of its check
+	Record(ctx context.Context, db *gorm.DB, orgID string, resource Resource) error
+	// GetUsage returns the usage and the quotas of the resources of the organization
+	GetUsage(ctx context.Context, orgID string) (*models.QuotaUsage, error)
+}
+
+var _ API = &Manager{}
+
+type Manager struct {
+	log    logrus.FieldLogger
+	db     *gorm.DB
+	config Config
+}
+
+func NewManager(log logrus.FieldLogger, db *gorm.DB, config Config) *Manager {
+	return &Manager{
+		log:    log,
+		db:     db,
+		config: config,
+	}
+}
+
+// limits returns the quotas of the organization, the quotas of the organization override the default ones
+func (m *Manager) limits(orgID string) Limits {
+	if limits, ok := m.config.OrgLimits[orgID]; ok {
+		return limits
+	}
+	return Limits{
+		Clusters:             m.config.MaxClusters,
+		Hosts:                m.config.MaxHosts,
+		InstallsPerDay:       m.config.MaxInstallsPerDay,
+		ISOGenerationsPerDay: m.config.MaxISOGenerationsPerDay,
+	}
+}
+
+func (m *Manager) Check(ctx context.Context, db *gorm.DB, orgID string, resource Resource) error {
+	limits := m.limits(orgID)
+	limit := limits.get(resource)
+	if orgID == "" || limit == 0 {
+		return nil
+	}
+	// The lock is released when the transaction ends, the concurrent checks of the organization wait for it and
+	// then count what the transaction created
+	if err := db.Exec("SELECT pg_advisory_xact_lock(?, hashtext(?))", lockClass, orgID).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to lock the quotas of organization %s", orgID))
+	}
+	used, err := m.count(db, orgID, resource)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if used < limit {
+		return nil
+	}
+
+	logutil.FromContext(ctx, m.log).Infof("organization %s reached its %s quota of %d", orgID, resource, limit)
+	switch resource {
+	case ResourceInstallsPerDay, ResourceISOGenerationsPerDay:
+		return common.NewApiError(http.StatusTooManyRequests, errors.Errorf(
+			"the organization reached its quota of %d %s, try again later", limit, resourceName(resource)))
+	default:
+		return common.NewApiError(http.StatusForbidden, errors.Errorf(
+			"the organization reached its quota of %d %s", limit, resourceName(resource)))
+	}
+}
+
+func (m *Manager) Record(ctx context.Context, db *gorm.DB, orgID string, resource Resource) error {
+	if orgID == "" {
+		return nil
+	}
+	record := &common.QuotaUsageRecord{
+		OrgID:     orgID,
+		Resource:  string(resource),
+		CreatedAt: time.Now(),
+	}
+	if err := db.Create(record).Error; err != nil {
+		return errors.Wrapf(err, "failed to record the %s usage of organization %s", resource, orgID)
+	}
+	// The records are only needed for the usage of the last day
+	err := db.Where("org_id = ? and resource = ? and created_at < ?", orgID, string(resource), time.Now().Add(-usageWindow)).
+		Delete(&common.QuotaUsageRecord{}).Error
+	if err != nil {
+		logutil.FromContext(ctx, m.log).WithError(err).Warnf("failed to delete the expired %s usage of organization %s",
+			resource, orgID)
+	}
+	return nil
+}
+
+func (m *Manager) GetUsage(ctx context.Context, orgID string) (*models.QuotaUsage, error) {
+	limits := m.limits(orgID)
+	usage := &models.QuotaUsage{
+		OrgID:     &orgID,
+		Resources: []*models.ResourceQuotaUsage{},
+	}
+	for _, resource := range allResources {
+		used, err := m.count(m.db, orgID, resource)
+		if err != nil {
+			return nil, err
+		}
+		resourceName := string(resource)
+		usage.Resources = append(usage.Resources, &models.ResourceQuotaUsage{
+			Resource: &resourceName,
+			Used:     &used,
+			Limit:    swag.Int64(limits.get(resource)),
+		})
+	}
+	return usage, nil
+}
+
+func (m *Manager) count(db *gorm.DB, orgID string, resource Resource) (int64, error) {
+	var count int64
+	var err error
+	switch resource {
+	case ResourceClusters:
+		err = db.Model(&common.Cluster{}).Where("org_id = ? and status != ?", orgID, models.ClusterStatusInstalled).
+			Count(&count).Error
+	case ResourceHosts:
+		err = db.Model(&common.Host{}).Joins("JOIN infra_envs ON infra_envs.id = hosts.infra_env_id").
+			Where("infra_envs.org_id = ?", orgID).Count(&count).Error
+	default:
+		err = db.Model(&common.QuotaUsageRecord{}).
+			Where("org_id = ? and resource = ? and created_at >= ?", orgID, string(resource), time.Now().Add(-usageWindow)).
+			Count(&count).Error
+	}
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to count the %s of organization %s", resource, orgID)
+	}
+	return count, nil
+}
+
+func resourceName(resource Resource) string {
+	switch resource {
+	case ResourceClusters:
+		return "clusters that aren't installed"
+	case ResourceHosts:
+		return "registered hosts"
+	case ResourceInstallsPerDay:
+		return "cluster installations per day"
+	case ResourceISOGenerationsPerDay:
+		return "discovery image generations per day"
+	}
+	return string(resource)
+}
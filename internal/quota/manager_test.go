@@ -0,0 +1,189 @@
+package quota
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+func TestQuota(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Quota test Suite")
+}
+
+var _ = BeforeSuite(func() {
+	common.InitializeDBTest()
+})
+
+var _ = AfterSuite(func() {
+	common.TerminateDBTest()
+})
+
+var _ = Describe("OrgLimitsDecoder", func() {
+	It("decodes the quotas of the organizations", func() {
+		var decoder OrgLimitsDecoder
+		Expect(decoder.Decode(`{"org1": {"clusters": 5, "installs_per_day": 10}}`)).To(Succeed())
+		Expect(decoder).To(Equal(OrgLimitsDecoder{"org1": {Clusters: 5, InstallsPerDay: 10}}))
+	})
+
+	It("decodes no quotas", func() {
+		var decoder OrgLimitsDecoder
+		Expect(decoder.Decode("")).To(Succeed())
+		Expect(decoder).To(BeEmpty())
+	})
+
+	It("rejects invalid quotas", func() {
+		var decoder OrgLimitsDecoder
+		Expect(decoder.Decode(`{"org1": {"hosts": -1}}`)).ToNot(Succeed())
+		Expect(decoder.Decode(`["org1"]`)).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("Manager", func() {
+	var (
+		db      *gorm.DB
+		dbName  string
+		manager *Manager
+		ctx     = context.Background()
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		manager = NewManager(common.GetTestLog(), db, Config{
+			MaxClusters:             2,
+			MaxHosts:                1,
+			MaxInstallsPerDay:       1,
+			MaxISOGenerationsPerDay: 0,
+			OrgLimits:               OrgLimitsDecoder{"big-org": {Clusters: 10}},
+		})
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createCluster := func(orgID, status string) {
+		id := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &id, OrgID: orgID, Status: swag.String(status)}}).Error).To(Succeed())
+	}
+
+	expectApiError := func(err error, code int32) {
+		Expect(err).To(HaveOccurred())
+		apiErr, ok := err.(*common.ApiErrorResponse)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.StatusCode()).To(Equal(code))
+	}
+
+	It("limits the clusters that aren't installed", func() {
+		createCluster("org1", models.ClusterStatusReady)
+		createCluster("org1", models.ClusterStatusInstalled)
+		createCluster("org2", models.ClusterStatusReady)
+		Expect(manager.Check(ctx, db, "org1", ResourceClusters)).To(Succeed())
+
+		createCluster("org1", models.ClusterStatusInsufficient)
+		expectApiError(manager.Check(ctx, db, "org1", ResourceClusters), http.StatusForbidden)
+	})
+
+	It("uses the quotas of the organization", func() {
+		createCluster("big-org", models.ClusterStatusReady)
+		createCluster("big-org", models.ClusterStatusReady)
+		Expect(manager.Check(ctx, db, "big-org", ResourceClusters)).To(Succeed())
+		// The quotas of the organization replace all the default ones
+		Expect(manager.Record(ctx, db, "big-org", ResourceInstallsPerDay)).To(Succeed())
+		Expect(manager.Check(ctx, db, "big-org", ResourceInstallsPerDay)).To(Succeed())
+	})
+
+	It("limits the registered hosts", func() {
+		infraEnvID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID, OrgID: "org1"}}).Error).To(Succeed())
+		Expect(manager.Check(ctx, db, "org1", ResourceHosts)).To(Succeed())
+
+		hostID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Host{Host: models.Host{ID: &hostID, InfraEnvID: infraEnvID}}).Error).To(Succeed())
+		expectApiError(manager.Check(ctx, db, "org1", ResourceHosts), http.StatusForbidden)
+		Expect(manager.Check(ctx, db, "org2", ResourceHosts)).To(Succeed())
+	})
+
+	It("limits the installations of the last day", func() {
+		Expect(db.Create(&common.QuotaUsageRecord{OrgID: "org1", Resource: string(ResourceInstallsPerDay),
+			CreatedAt: time.Now().Add(-25 * time.Hour)}).Error).To(Succeed())
+		Expect(manager.Check(ctx, db, "org1", ResourceInstallsPerDay)).To(Succeed())
+
+		Expect(manager.Record(ctx, db, "org1", ResourceInstallsPerDay)).To(Succeed())
+		expectApiError(manager.Check(ctx, db, "org1", ResourceInstallsPerDay), http.StatusTooManyRequests)
+
+		// The expired record was deleted
+		var count int64
+		Expect(db.Model(&common.QuotaUsageRecord{}).Count(&count).Error).To(Succeed())
+		Expect(count).To(Equal(int64(1)))
+	})
+
+	It("counts the concurrent uses of the organization one after the other", func() {
+		const requests = 5
+		results := make(chan error, requests)
+		var wg sync.WaitGroup
+		for i := 0; i < requests; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				results <- db.Transaction(func(tx *gorm.DB) error {
+					if err := manager.Check(ctx, tx, "org1", ResourceInstallsPerDay); err != nil {
+						return err
+					}
+					return manager.Record(ctx, tx, "org1", ResourceInstallsPerDay)
+				})
+			}()
+		}
+		wg.Wait()
+		close(results)
+
+		var succeeded int
+		for err := range results {
+			if err == nil {
+				succeeded++
+			} else {
+				expectApiError(err, http.StatusTooManyRequests)
+			}
+		}
+		Expect(succeeded).To(Equal(1))
+		var count int64
+		Expect(db.Model(&common.QuotaUsageRecord{}).Count(&count).Error).To(Succeed())
+		Expect(count).To(Equal(int64(1)))
+	})
+
+	It("doesn't limit resources without an organization", func() {
+		createCluster("", models.ClusterStatusReady)
+		createCluster("", models.ClusterStatusReady)
+		Expect(manager.Check(ctx, db, "", ResourceClusters)).To(Succeed())
+		Expect(manager.Record(ctx, db, "", ResourceInstallsPerDay)).To(Succeed())
+		var count int64
+		Expect(db.Model(&common.QuotaUsageRecord{}).Count(&count).Error).To(Succeed())
+		Expect(count).To(BeZero())
+	})
+
+	It("returns the usage of the organization", func() {
+		createCluster("org1", models.ClusterStatusReady)
+		Expect(manager.Record(ctx, db, "org1", ResourceISOGenerationsPerDay)).To(Succeed())
+		usage, err := manager.GetUsage(ctx, "org1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*usage.OrgID).To(Equal("org1"))
+		Expect(usage.Resources).To(ConsistOf(
+			&models.ResourceQuotaUsage{Resource: swag.String("clusters"), Used: swag.Int64(1), Limit: swag.Int64(2)},
+			&models.ResourceQuotaUsage{Resource: swag.String("hosts"), Used: swag.Int64(0), Limit: swag.Int64(1)},
+			&models.ResourceQuotaUsage{Resource: swag.String("installs-per-day"), Used: swag.Int64(0), Limit: swag.Int64(1)},
+			&models.ResourceQuotaUsage{Resource: swag.String("iso-generations-per-day"), Used: swag.Int64(1), Limit: swag.Int64(0)},
+		))
+	})
+})
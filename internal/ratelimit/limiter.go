@@ -0,0 +1,290 @@
+package ratelimit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	ctxparams "github.com/openshift/assisted-service/pkg/context"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"golang.org/x/time/rate"
+)
+
+const (
+	KeyByUser = "user"
+	KeyByOrg  = "org"
+)
+
+type identityType string
+
+const (
+	identityUser  identityType = "user"
+	identityOrg   identityType = "org"
+	identityAgent identityType = "agent"
+	identityAddr  identityType = "address"
+)
+
+const (
+	agentAuthScheme = "agentAuth"
+	agentAuthHeader = "X-Secret-Key"
+
+	// bucketExpiration is how long the bucket of an identity that stopped sending requests is kept
+	bucketExpiration = 10 * time.Minute
+
+	counterThrottledRequests            = "assisted_installer_throttled_requests_total"
+	counterDescriptionThrottledRequests = "Number of requests that were refused because their identity exceeded its rate limit, by operation and identity type"
+)
+
+// Limit is a token bucket, Rate tokens are added to the bucket every second up to Burst tokens. A Rate of 0 means
+// unlimited.
+type Limit struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+func (l Limit) validate() error {
+	if l.Rate < 0 {
+		return errors.Errorf("the rate must not be negative")
+	}
+	if l.Burst < 0 {
+		return errors.Errorf("the burst must not be negative")
+	}
+	return nil
+}
+
+// RouteLimitsDecoder is decoded from a JSON object that maps operation IDs to their limits
+type RouteLimitsDecoder map[string]Limit
+
+func (d *RouteLimitsDecoder) Decode(value string) error {
+	routeLimits := RouteLimitsDecoder{}
+	if strings.TrimSpace(value) != "" {
+		if err := json.Unmarshal([]byte(value), &routeLimits); err != nil {
+			return errors.Wrap(err, "failed to parse the route rate limits")
+		}
+	}
+	for operationID, limit := range routeLimits {
+		if err := limit.validate(); err != nil {
+			return errors.Wrapf(err, "invalid rate limit of operation %s", operationID)
+		}
+	}
+	*d = routeLimits
+	return nil
+}
+
+type Config struct {
+	Enabled bool `envconfig:"RATE_LIMIT_ENABLED" default:"false"`
+	// KeyBy is the identity that the user requests are limited by, user or org
+	KeyBy      string             `envconfig:"RATE_LIMIT_KEY_BY" default:"user"`
+	UserRate   float64            `envconfig:"RATE_LIMIT_USER_RATE" default:"10"`
+	UserBurst  int                `envconfig:"RATE_LIMIT_USER_BURST" default:"50"`
+	AgentRate  float64            `envconfig:"RATE_LIMIT_AGENT_RATE" default:"50"`
+	AgentBurst int                `envconfig:"RATE_LIMIT_AGENT_BURST" default:"200"`
+	Routes     RouteLimitsDecoder `envconfig:"RATE_LIMIT_ROUTES" default:""`
+}
+
+type identity struct {
+	kind identityType
+	key  string
+}
+
+// TooManyRequestsError is returned for the requests of an identity that exceeded its rate limit
+type TooManyRequestsError struct {
+	retryAfter time.Duration
+}
+
+func (e *TooManyRequestsError) Error() string {
+	return fmt.Sprintf("Too many requests, retry after %d seconds", retryAfterSeconds(e.retryAfter))
+}
+
+func (e *TooManyRequestsError) Code() int32 {
+	return http.StatusTooManyRequests
+}
+
+// RetryAfter returns how long the client should wait before sending the request again
+func (e *TooManyRequestsError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+func retryAfterSeconds(d time.Duration) int {
+	return int(math.Max(1, math.Ceil(d.Seconds())))
+}
+
+// Limiter limits the rate of the API requests of each identity with token buckets. The requests that are
+// authenticated by an agent token are limited by the host or infra-env that they are sent for, the other requests
+// are limited by their user or organization. Operations that have their own limit use a bucket per identity and
+// operation, the other operations share the bucket of the identity. The buckets are kept in memory, so each replica
+// of the service limits the requests that it receives.
+type Limiter struct {
+	log       logrus.FieldLogger
+	config    Config
+	buckets   *cache.Cache
+	throttled *prometheus.CounterVec
+}
+
+func NewLimiter(log logrus.FieldLogger, config Config, registry prometheus.Registerer) (*Limiter, error) {
+	if config.KeyBy != KeyByUser && config.KeyBy != KeyByOrg {
+		return nil, errors.Errorf("invalid rate limit key %q, expected %s or %s", config.KeyBy, KeyByUser, KeyByOrg)
+	}
+	for _, limit := range []Limit{{Rate: config.UserRate, Burst: config.UserBurst}, {Rate: config.AgentRate, Burst: config.AgentBurst}} {
+		if err := limit.validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid rate limit")
+		}
+	}
+	throttled := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "",
+		Subsystem: "service",
+		Name:      counterThrottledRequests,
+		Help:      counterDescriptionThrottledRequests,
+	}, []string{"operation", "identity_type"})
+	if err := registry.Register(throttled); err != nil {
+		return nil, errors.Wrap(err, "failed to register the throttled requests metric")
+	}
+	return &Limiter{
+		log:       log,
+		config:    config,
+		buckets:   cache.New(bucketExpiration, bucketExpiration),
+		throttled: throttled,
+	}, nil
+}
+
+// Authorizer returns an authorizer that refuses the requests of the identities that exceeded their rate limit and
+// passes the other requests to next. It runs after authentication, so the requests of unauthenticated clients
+// don't consume the budget of the identity they claim. The agent requests are authorized by next before they are
+// limited, because their identity comes from the IDs in their path.
+func (l *Limiter) Authorizer(next func(*http.Request) error) func(*http.Request) error {
+	if !l.config.Enabled {
+		return next
+	}
+	return func(request *http.Request) error {
+		var operationID string
+		var schemes []string
+		var params middleware.RouteParams
+		if route := middleware.MatchedRouteFrom(request); route != nil {
+			if route.Operation != nil {
+				operationID = route.Operation.ID
+			}
+			if route.Authenticator != nil {
+				schemes = route.Authenticator.Schemes
+			}
+			params = route.Params
+		}
+		id := l.identity(request, schemes, params)
+		if id.kind == identityAgent {
+			if err := next(request); err != nil {
+				return err
+			}
+			return l.limit(id, operationID)
+		}
+		if err := l.limit(id, operationID); err != nil {
+			return err
+		}
+		return next(request)
+	}
+}
+
+func (l *Limiter) limit(id identity, operationID string) error {
+	if retryAfter, ok := l.allow(id, operationID); !ok {
+		l.throttled.WithLabelValues(operationID, string(id.kind)).Inc()
+		l.log.Debugf("%s %s exceeded its rate limit on %s", id.kind, id.key, operationID)
+		return &TooManyRequestsError{retryAfter: retryAfter}
+	}
+	return nil
+}
+
+// identity returns the identity that the request is limited by, the schemes are the security schemes that
+// authenticated the request and the params are the path parameters of its route
+func (l *Limiter) identity(request *http.Request, schemes []string, params middleware.RouteParams) identity {
+	if funk.ContainsString(schemes, agentAuthScheme) {
+		// The agent tokens of RHSSO are the pull secrets of the users, so all the hosts of a user share the
+		// token, and each host or infra-env gets its own bucket instead
+		if hostID := params.Get(ctxparams.HostId); hostID != "" {
+			return identity{kind: identityAgent, key: fmt.Sprintf("%s/%s", params.Get(ctxparams.InfraEnvId), hostID)}
+		}
+		if infraEnvID := params.Get(ctxparams.InfraEnvId); infraEnvID != "" {
+			return identity{kind: identityAgent, key: infraEnvID}
+		}
+		if clusterID := params.Get(ctxparams.ClusterId); clusterID != "" {
+			return identity{kind: identityAgent, key: clusterID}
+		}
+		if token := request.Header.Get(agentAuthHeader); token != "" {
+			// Tokens are hashed so they aren't kept in memory
+			sum := sha256.Sum256([]byte(token))
+			return identity{kind: identityAgent, key: hex.EncodeToString(sum[:])}
+		}
+		return identity{kind: identityAgent, key: remoteHost(request)}
+	}
+	payload, ok := request.Context().Value(restapi.AuthKey).(*ocm.AuthPayload)
+	if !ok || payload.Username == "" {
+		// Image tokens and unauthenticated requests don't have a user
+		return identity{kind: identityAddr, key: remoteHost(request)}
+	}
+	if l.config.KeyBy == KeyByOrg && payload.Organization != "" {
+		return identity{kind: identityOrg, key: payload.Organization}
+	}
+	return identity{kind: identityUser, key: payload.Username}
+}
+
+// allow takes a token from the bucket of the identity, or returns how long to wait for the next token if the
+// bucket is empty
+func (l *Limiter) allow(id identity, operationID string) (time.Duration, bool) {
+	limit := Limit{Rate: l.config.UserRate, Burst: l.config.UserBurst}
+	if id.kind == identityAgent {
+		limit = Limit{Rate: l.config.AgentRate, Burst: l.config.AgentBurst}
+	}
+	bucketKey := fmt.Sprintf("%s/%s", id.kind, id.key)
+	if routeLimit, ok := l.config.Routes[operationID]; ok {
+		limit = routeLimit
+		bucketKey = fmt.Sprintf("%s/%s", bucketKey, operationID)
+	}
+	if limit.Rate == 0 {
+		return 0, true
+	}
+
+	now := time.Now()
+	reservation := l.bucket(bucketKey, limit).ReserveN(now, 1)
+	if !reservation.OK() {
+		return time.Duration(float64(time.Second) / limit.Rate), false
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
+
+func (l *Limiter) bucket(key string, limit Limit) *rate.Limiter {
+	if bucket, ok := l.buckets.Get(key); ok {
+		// Keep the bucket as long as the identity sends requests
+		l.buckets.SetDefault(key, bucket)
+		return bucket.(*rate.Limiter)
+	}
+	bucket := rate.NewLimiter(rate.Limit(limit.Rate), int(math.Max(1, float64(limit.Burst))))
+	if err := l.buckets.Add(key, bucket, cache.DefaultExpiration); err != nil {
+		// Another request of the identity created the bucket first
+		if existing, ok := l.buckets.Get(key); ok {
+			return existing.(*rate.Limiter)
+		}
+	}
+	return bucket
+}
+
+func remoteHost(request *http.Request) string {
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+	return host
+}
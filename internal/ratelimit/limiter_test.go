@@ -0,0 +1,208 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRateLimit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rate limit tests")
+}
+
+var _ = Describe("RouteLimitsDecoder", func() {
+	It("decodes the limits of the routes", func() {
+		var decoder RouteLimitsDecoder
+		Expect(decoder.Decode(`{"v2ListClusters": {"rate": 0.5, "burst": 5}}`)).To(Succeed())
+		Expect(decoder).To(Equal(RouteLimitsDecoder{"v2ListClusters": {Rate: 0.5, Burst: 5}}))
+	})
+
+	It("decodes no limits", func() {
+		var decoder RouteLimitsDecoder
+		Expect(decoder.Decode("")).To(Succeed())
+		Expect(decoder).To(BeEmpty())
+	})
+
+	It("rejects invalid limits", func() {
+		var decoder RouteLimitsDecoder
+		Expect(decoder.Decode(`{"v2ListClusters": {"rate": -1}}`)).ToNot(Succeed())
+		Expect(decoder.Decode(`{"v2ListClusters": {"rate": 1, "burst": -1}}`)).ToNot(Succeed())
+		Expect(decoder.Decode(`["v2ListClusters"]`)).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("Limiter", func() {
+	var (
+		config Config
+		called int
+		next   = func(*http.Request) error {
+			called++
+			return nil
+		}
+	)
+
+	BeforeEach(func() {
+		called = 0
+		config = Config{
+			Enabled:    true,
+			KeyBy:      KeyByUser,
+			UserRate:   1,
+			UserBurst:  2,
+			AgentRate:  1,
+			AgentBurst: 5,
+		}
+	})
+
+	newLimiter := func() *Limiter {
+		limiter, err := NewLimiter(common.GetTestLog(), config, prometheus.NewRegistry())
+		Expect(err).ToNot(HaveOccurred())
+		return limiter
+	}
+
+	userRequest := func(username, orgID string) *http.Request {
+		request := httptest.NewRequest(http.MethodGet, "/api/assisted-install/v2/clusters", nil)
+		payload := &ocm.AuthPayload{Username: username, Organization: orgID, Role: ocm.UserRole}
+		return request.WithContext(context.WithValue(request.Context(), restapi.AuthKey, payload))
+	}
+
+	It("doesn't limit the requests when it's disabled", func() {
+		config.Enabled = false
+		authorizer := newLimiter().Authorizer(next)
+		for i := 0; i < 10; i++ {
+			Expect(authorizer(userRequest("user1", "org1"))).To(Succeed())
+		}
+		Expect(called).To(Equal(10))
+	})
+
+	It("limits the requests of each user", func() {
+		limiter := newLimiter()
+		authorizer := limiter.Authorizer(next)
+		Expect(authorizer(userRequest("user1", "org1"))).To(Succeed())
+		Expect(authorizer(userRequest("user1", "org1"))).To(Succeed())
+
+		err := authorizer(userRequest("user1", "org1"))
+		Expect(err).To(HaveOccurred())
+		tooMany, ok := err.(*TooManyRequestsError)
+		Expect(ok).To(BeTrue())
+		Expect(tooMany.Code()).To(BeEquivalentTo(http.StatusTooManyRequests))
+		Expect(tooMany.RetryAfter()).To(BeNumerically(">", 0))
+		Expect(tooMany.RetryAfter()).To(BeNumerically("<=", time.Second))
+		Expect(called).To(Equal(2))
+		Expect(testutil.ToFloat64(limiter.throttled.WithLabelValues("", "user"))).To(Equal(float64(1)))
+
+		// Other users of the organization have their own budget
+		Expect(authorizer(userRequest("user2", "org1"))).To(Succeed())
+	})
+
+	It("limits the requests of each organization", func() {
+		config.KeyBy = KeyByOrg
+		authorizer := newLimiter().Authorizer(next)
+		Expect(authorizer(userRequest("user1", "org1"))).To(Succeed())
+		Expect(authorizer(userRequest("user2", "org1"))).To(Succeed())
+		Expect(authorizer(userRequest("user3", "org1"))).To(HaveOccurred())
+		Expect(authorizer(userRequest("user1", "org2"))).To(Succeed())
+	})
+
+	It("doesn't limit identities whose rate is 0", func() {
+		config.UserRate = 0
+		authorizer := newLimiter().Authorizer(next)
+		for i := 0; i < 10; i++ {
+			Expect(authorizer(userRequest("user1", "org1"))).To(Succeed())
+		}
+	})
+
+	It("limits the agents by their host or infra-env", func() {
+		limiter := newLimiter()
+		agentRequest := func(token string) *http.Request {
+			request := httptest.NewRequest(http.MethodGet, "/api/assisted-install/v2/infra-envs/id/hosts/id/instructions", nil)
+			request.Header.Set(agentAuthHeader, token)
+			return request
+		}
+		hostParams := func(infraEnvID, hostID string) middleware.RouteParams {
+			return middleware.RouteParams{{Name: "infra_env_id", Value: infraEnvID}, {Name: "host_id", Value: hostID}}
+		}
+		host1 := limiter.identity(agentRequest("token1"), []string{agentAuthScheme}, hostParams("infra-env1", "host1"))
+		Expect(host1).To(Equal(identity{kind: identityAgent, key: "infra-env1/host1"}))
+
+		// The hosts that share the token have their own budget
+		host2 := limiter.identity(agentRequest("token1"), []string{agentAuthScheme}, hostParams("infra-env1", "host2"))
+		Expect(host2).ToNot(Equal(host1))
+		infraEnv := limiter.identity(agentRequest("token1"), []string{agentAuthScheme},
+			middleware.RouteParams{{Name: "infra_env_id", Value: "infra-env1"}})
+		Expect(infraEnv).To(Equal(identity{kind: identityAgent, key: "infra-env1"}))
+
+		for i := 0; i < 5; i++ {
+			_, ok := limiter.allow(host1, "v2GetNextSteps")
+			Expect(ok).To(BeTrue())
+		}
+		_, ok := limiter.allow(host1, "v2GetNextSteps")
+		Expect(ok).To(BeFalse())
+		_, ok = limiter.allow(host2, "v2GetNextSteps")
+		Expect(ok).To(BeTrue())
+		_, ok = limiter.allow(infraEnv, "v2GetNextSteps")
+		Expect(ok).To(BeTrue())
+	})
+
+	It("limits the agent requests without IDs by their token", func() {
+		limiter := newLimiter()
+		request := httptest.NewRequest(http.MethodGet, "/api/assisted-install/v2/component-versions", nil)
+		request.Header.Set(agentAuthHeader, "token1")
+		agent := limiter.identity(request, []string{agentAuthScheme}, nil)
+		Expect(agent.kind).To(Equal(identityAgent))
+		Expect(agent.key).ToNot(ContainSubstring("token1"))
+		request.Header.Set(agentAuthHeader, "token2")
+		Expect(limiter.identity(request, []string{agentAuthScheme}, nil)).ToNot(Equal(agent))
+	})
+
+	It("limits the requests without a user by their address", func() {
+		limiter := newLimiter()
+		request := httptest.NewRequest(http.MethodGet, "/api/assisted-install/v2/infra-envs/id/downloads/image", nil)
+		request.RemoteAddr = "192.0.2.10:40000"
+		Expect(limiter.identity(request, []string{"imageAuth"}, nil)).To(Equal(identity{kind: identityAddr, key: "192.0.2.10"}))
+	})
+
+	It("limits the routes with their own limit separately", func() {
+		config.Routes = RouteLimitsDecoder{"v2ListClusters": {Rate: 0.1, Burst: 1}}
+		limiter := newLimiter()
+		user := identity{kind: identityUser, key: "user1"}
+
+		_, ok := limiter.allow(user, "v2ListClusters")
+		Expect(ok).To(BeTrue())
+		retryAfter, ok := limiter.allow(user, "v2ListClusters")
+		Expect(ok).To(BeFalse())
+		Expect(retryAfter).To(BeNumerically(">", 9*time.Second))
+
+		// The other routes use the budget of the user
+		_, ok = limiter.allow(user, "v2GetCluster")
+		Expect(ok).To(BeTrue())
+	})
+
+	It("rejects invalid configurations", func() {
+		config.KeyBy = "token"
+		_, err := NewLimiter(common.GetTestLog(), config, prometheus.NewRegistry())
+		Expect(err).To(HaveOccurred())
+		config.KeyBy = KeyByUser
+		config.AgentRate = -1
+		_, err = NewLimiter(common.GetTestLog(), config, prometheus.NewRegistry())
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("TooManyRequestsError", func() {
+	It("rounds the retry delay up to seconds", func() {
+		Expect((&TooManyRequestsError{retryAfter: 1500 * time.Millisecond}).Error()).To(Equal("Too many requests, retry after 2 seconds"))
+		Expect((&TooManyRequestsError{retryAfter: time.Millisecond}).Error()).To(Equal("Too many requests, retry after 1 seconds"))
+	})
+})
@@ -0,0 +1,126 @@
+package releasesignature
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/distribution/reference"
+	godigest "github.com/opencontainers/go-digest"
+	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// release verifies the signature of release images before images are taken from them or
+// binaries are extracted from them. Metadata lookups are not verified.
+type release struct {
+	oc.Release
+	verifier Verifier
+}
+
+// NewRelease wraps ocRelease so that release images whose signature can't be verified are never used
+func NewRelease(ocRelease oc.Release, verifier Verifier) oc.Release {
+	return &release{Release: ocRelease, verifier: verifier}
+}
+
+// verify verifies the release image and returns the release image and its mirror referenced by the verified digest,
+// so that a tag that is moved after the verification can't make them point to content that wasn't verified
+func (r *release) verify(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, string, error) {
+	verifiedDigest, err := r.verifier.Verify(log, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil || verifiedDigest == "" {
+		return releaseImage, releaseImageMirror, err
+	}
+	if releaseImage, err = withDigest(releaseImage, verifiedDigest); err != nil {
+		return "", "", err
+	}
+	if releaseImageMirror, err = withDigest(releaseImageMirror, verifiedDigest); err != nil {
+		return "", "", err
+	}
+	return releaseImage, releaseImageMirror, nil
+}
+
+// withDigest replaces the tag or the digest of the image with the digest
+func withDigest(image string, imageDigest string) (string, error) {
+	if image == "" {
+		return "", nil
+	}
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse release image %s", image)
+	}
+	canonical, err := reference.WithDigest(reference.TrimNamed(named), godigest.Digest(imageDigest))
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid digest %s of release image %s", imageDigest, image)
+	}
+	return canonical.String(), nil
+}
+
+func (r *release) GetMCOImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	releaseImage, releaseImageMirror, err := r.verify(log, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil {
+		return "", err
+	}
+	return r.Release.GetMCOImage(log, releaseImage, releaseImageMirror, pullSecret)
+}
+
+func (r *release) GetIronicAgentImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	releaseImage, releaseImageMirror, err := r.verify(log, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil {
+		return "", err
+	}
+	return r.Release.GetIronicAgentImage(log, releaseImage, releaseImageMirror, pullSecret)
+}
+
+func (r *release) GetOKDRPMSImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	releaseImage, releaseImageMirror, err := r.verify(log, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil {
+		return "", err
+	}
+	return r.Release.GetOKDRPMSImage(log, releaseImage, releaseImageMirror, pullSecret)
+}
+
+func (r *release) GetMustGatherImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	releaseImage, releaseImageMirror, err := r.verify(log, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil {
+		return "", err
+	}
+	return r.Release.GetMustGatherImage(log, releaseImage, releaseImageMirror, pullSecret)
+}
+
+func (r *release) GetCoreOSImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	releaseImage, releaseImageMirror, err := r.verify(log, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil {
+		return "", err
+	}
+	return r.Release.GetCoreOSImage(log, releaseImage, releaseImageMirror, pullSecret)
+}
+
+// Extract extracts the binary of the verified digest, and moves it where the callers look for the binary of the
+// release image they requested
+func (r *release) Extract(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, cacheDir string, pullSecret string, ocpVersion string) (string, error) {
+	verifiedImage, verifiedMirror, err := r.verify(log, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil {
+		return "", err
+	}
+	if verifiedImage == releaseImage {
+		return r.Release.Extract(log, releaseImage, verifiedMirror, cacheDir, pullSecret, ocpVersion)
+	}
+	verifiedPath, err := r.Release.Extract(log, verifiedImage, verifiedMirror, cacheDir, pullSecret, ocpVersion)
+	if err != nil {
+		return "", err
+	}
+	workdir, _, path, err := r.Release.GetReleaseBinaryPath(releaseImage, cacheDir, ocpVersion)
+	if err != nil {
+		return "", err
+	}
+	if err = os.MkdirAll(workdir, 0755); err != nil {
+		return "", err
+	}
+	if err = os.Rename(verifiedPath, path); err != nil {
+		return "", errors.Wrapf(err, "failed to move the binary of release %s to %s", verifiedImage, path)
+	}
+	if err = os.Remove(filepath.Dir(verifiedPath)); err != nil {
+		log.WithError(err).Warnf("failed to remove the extraction directory of release %s", verifiedImage)
+	}
+	return path, nil
+}
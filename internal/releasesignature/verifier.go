@@ -0,0 +1,277 @@
+package releasesignature
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/openpgp" //nolint:staticcheck // OpenShift release signatures are verified with the same package by the cluster-version-operator
+)
+
+// Policy defines what happens when the signature of a release image can't be verified
+type Policy string
+
+const (
+	// PolicyDisabled skips the verification of release signatures
+	PolicyDisabled Policy = "disabled"
+	// PolicyEnforce fails any usage of a release image whose signature can't be verified
+	PolicyEnforce Policy = "enforce"
+
+	// atomicSignatureType is the type of the signatures that OpenShift publishes for its releases
+	atomicSignatureType = "atomic container signature"
+	// maxSignatures is the number of signatures that are looked up in each store, the same limit as the
+	// cluster-version-operator
+	maxSignatures = 10
+)
+
+type Config struct {
+	// Policy is either "disabled" or "enforce"
+	Policy Policy `envconfig:"RELEASE_SIGNATURE_POLICY" default:"disabled"`
+	// Stores is the list of base URLs of the stores that serve release signatures, using the layout of the
+	// OpenShift signature stores: <store>/sha256=<digest>/signature-<n>
+	Stores []string `envconfig:"RELEASE_SIGNATURE_STORES" default:"https://mirror.openshift.com/pub/openshift-v4/signatures/openshift/release"`
+	// TrustedKeysFile is the path to the armored GPG public keys that are trusted to sign releases
+	TrustedKeysFile string `envconfig:"RELEASE_SIGNATURE_TRUSTED_KEYS_FILE" default:""`
+	// CacheTTL is the amount of time the result of a verification is kept before the release is verified again
+	CacheTTL time.Duration `envconfig:"RELEASE_SIGNATURE_CACHE_TTL" default:"10m"`
+	// StoreTimeout is the timeout for fetching a signature from a store
+	StoreTimeout time.Duration `envconfig:"RELEASE_SIGNATURE_STORE_TIMEOUT" default:"30s"`
+}
+
+// Status is the result of verifying the signature of a release in the background
+type Status struct {
+	// InProgress is true until the first verification of the release completes
+	InProgress bool
+	// Digest is the verified digest of the release
+	Digest string
+	// Err is set when the release couldn't be verified
+	Err error
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=releasesignature -destination=mock_verifier.go . Verifier
+type Verifier interface {
+	// IsEnabled returns true if release signatures are verified
+	IsEnabled() bool
+	// Verify returns an error if the release image doesn't have a signature that matches its digest and
+	// is signed by one of the trusted keys. It returns the verified digest, which must be used to pull the release
+	// instead of its tag, or an empty digest when the verification is disabled.
+	Verify(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
+	// GetStatus returns the result of the last verification of the release. If there is none, the release is
+	// verified in the background and a status that is in progress is returned. The results are cached per release,
+	// mirror and pull secret, so that they are never shared between users with different credentials.
+	GetStatus(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) Status
+}
+
+// atomicSignature is the content of a signed release signature
+type atomicSignature struct {
+	Critical struct {
+		Type  string `json:"type"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+type verifier struct {
+	config     Config
+	ocRelease  oc.Release
+	keyring    openpgp.EntityList
+	httpClient *http.Client
+	// results holds the outcome of the last verification of each release digest
+	results *cache.Cache
+	// statuses holds the outcome of the last background verification of each release
+	statuses        *cache.Cache
+	inProgressMutex sync.Mutex
+	inProgress      map[string]bool
+}
+
+// NewVerifier creates a release signature verifier. The ocRelease is only used to resolve release digests
+// and must not be a release wrapped by NewRelease.
+func NewVerifier(config Config, ocRelease oc.Release) (Verifier, error) {
+	v := &verifier{
+		config:     config,
+		ocRelease:  ocRelease,
+		httpClient: &http.Client{Timeout: config.StoreTimeout, Transport: &http.Transport{Proxy: http.ProxyFromEnvironment}},
+		results:    cache.New(config.CacheTTL, 2*config.CacheTTL),
+		statuses:   cache.New(config.CacheTTL, 2*config.CacheTTL),
+		inProgress: map[string]bool{},
+	}
+	switch config.Policy {
+	case PolicyDisabled:
+		return v, nil
+	case PolicyEnforce:
+	default:
+		return nil, errors.Errorf("invalid release signature policy %q, must be one of %q or %q", config.Policy, PolicyDisabled, PolicyEnforce)
+	}
+	if len(config.Stores) == 0 {
+		return nil, errors.New("at least one release signature store must be configured")
+	}
+	if config.TrustedKeysFile == "" {
+		return nil, errors.New("trusted keys file must be configured to verify release signatures")
+	}
+	keys, err := os.Open(config.TrustedKeysFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open trusted keys file %s", config.TrustedKeysFile)
+	}
+	defer keys.Close()
+	v.keyring, err = openpgp.ReadArmoredKeyRing(keys)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read trusted keys from %s", config.TrustedKeysFile)
+	}
+	return v, nil
+}
+
+func (v *verifier) IsEnabled() bool {
+	return v.config.Policy == PolicyEnforce
+}
+
+func (v *verifier) Verify(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	if !v.IsEnabled() {
+		return "", nil
+	}
+	// the tag is resolved every time, since it can be moved to another digest
+	digest, err := v.ocRelease.GetReleaseDigest(log, releaseImage, releaseImageMirror, pullSecret)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get the digest of release image %s", releaseImage)
+	}
+	if result, found := v.results.Get(digest); found {
+		if result == nil {
+			return digest, nil
+		}
+		return "", result.(error)
+	}
+	err = v.verify(log, releaseImage, digest)
+	if err != nil {
+		log.WithError(err).Warnf("failed to verify the signature of release image %s (%s)", releaseImage, digest)
+	}
+	v.results.SetDefault(digest, err)
+	if err != nil {
+		return "", err
+	}
+	return digest, nil
+}
+
+func (v *verifier) GetStatus(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) Status {
+	if !v.IsEnabled() {
+		return Status{}
+	}
+	key := statusKey(releaseImage, releaseImageMirror, pullSecret)
+	if status, found := v.statuses.Get(key); found {
+		return status.(Status)
+	}
+	v.inProgressMutex.Lock()
+	defer v.inProgressMutex.Unlock()
+	if !v.inProgress[key] {
+		v.inProgress[key] = true
+		go func() {
+			digest, err := v.Verify(log, releaseImage, releaseImageMirror, pullSecret)
+			v.inProgressMutex.Lock()
+			defer v.inProgressMutex.Unlock()
+			v.statuses.SetDefault(key, Status{Digest: digest, Err: err})
+			delete(v.inProgress, key)
+		}()
+	}
+	return Status{InProgress: true}
+}
+
+// statusKey identifies the verification of a release by the release, the mirror it's resolved through and the
+// credentials used to resolve it
+func statusKey(releaseImage string, releaseImageMirror string, pullSecret string) string {
+	hash := sha256.Sum256([]byte(pullSecret))
+	return releaseImage + "/" + releaseImageMirror + "/" + hex.EncodeToString(hash[:])
+}
+
+func (v *verifier) verify(log logrus.FieldLogger, releaseImage string, digest string) error {
+	algorithm, hash, found := strings.Cut(digest, ":")
+	if !found {
+		return errors.Errorf("invalid digest %s of release image %s", digest, releaseImage)
+	}
+
+	var signaturesFound int
+	for _, store := range v.config.Stores {
+		for i := 1; i <= maxSignatures; i++ {
+			url := fmt.Sprintf("%s/%s=%s/signature-%d", strings.TrimSuffix(store, "/"), algorithm, hash, i)
+			signature, err := v.fetchSignature(url)
+			if err != nil {
+				log.WithError(err).Warnf("failed to fetch release signature %s", url)
+				break
+			}
+			if signature == nil {
+				break
+			}
+			signaturesFound++
+			if err = v.verifySignature(signature, digest); err != nil {
+				log.WithError(err).Infof("release signature %s is not valid for %s", url, releaseImage)
+				continue
+			}
+			log.Infof("release image %s (%s) is verified by signature %s", releaseImage, digest, url)
+			return nil
+		}
+	}
+	if signaturesFound == 0 {
+		return errors.Errorf("no signature found for digest %s in the configured signature stores", digest)
+	}
+	return errors.Errorf("none of the %d signatures found for digest %s is valid and signed by a trusted key", signaturesFound, digest)
+}
+
+// fetchSignature returns nil if the signature doesn't exist in the store
+func (v *verifier) fetchSignature(url string) ([]byte, error) {
+	resp, err := v.httpClient.Get(url) // #nosec G107 -- the store URLs come from the service configuration
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, errors.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+}
+
+// verifySignature checks that the signature is signed by a trusted key and that it refers to the expected digest
+func (v *verifier) verifySignature(signature []byte, digest string) error {
+	md, err := openpgp.ReadMessage(bytes.NewReader(signature), v.keyring, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to read signature")
+	}
+	if !md.IsSigned {
+		return errors.New("message is not signed")
+	}
+	content, err := io.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		return errors.Wrap(err, "failed to read signed content")
+	}
+	// the signature is only checked once the whole body has been read
+	if md.SignatureError != nil {
+		return errors.Wrap(md.SignatureError, "invalid signature")
+	}
+	if md.SignedBy == nil {
+		return errors.Errorf("signed by unknown key %X", md.SignedByKeyId)
+	}
+
+	var parsed atomicSignature
+	if err = json.Unmarshal(content, &parsed); err != nil {
+		return errors.Wrap(err, "failed to parse signed content")
+	}
+	if parsed.Critical.Type != atomicSignatureType {
+		return errors.Errorf("unexpected signature type %q", parsed.Critical.Type)
+	}
+	if parsed.Critical.Image.DockerManifestDigest != digest {
+		return errors.Errorf("signature is for digest %s", parsed.Critical.Image.DockerManifestDigest)
+	}
+	return nil
+}
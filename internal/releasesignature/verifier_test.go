@@ -0,0 +1,325 @@
+package releasesignature
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/openpgp"        //nolint:staticcheck
+	"golang.org/x/crypto/openpgp/armor"  //nolint:staticcheck
+	"golang.org/x/crypto/openpgp/packet" //nolint:staticcheck
+)
+
+const (
+	releaseImage = "quay.io/openshift-release-dev/ocp-release:4.17.11-x86_64"
+	pullSecret   = "pull-secret"
+	digest       = "sha256:1ad0b4c1ea8b3ed4b5c6e9f1c4a4ebdd1b5e8c6a0c1cd8ba8ef29b1f4e7c2d9a"
+)
+
+func newEntity() *openpgp.Entity {
+	entity, err := openpgp.NewEntity("release signer", "", "release@example.com", &packet.Config{DefaultHash: crypto.SHA256})
+	Expect(err).NotTo(HaveOccurred())
+	// sha256, otherwise signing falls back to ripemd160 that isn't compiled in
+	for _, identity := range entity.Identities {
+		identity.SelfSignature.PreferredHash = []uint8{8}
+	}
+	return entity
+}
+
+func sign(entity *openpgp.Entity, content string) []byte {
+	var buf bytes.Buffer
+	w, err := openpgp.Sign(&buf, entity, nil, &packet.Config{DefaultHash: crypto.SHA256})
+	Expect(err).NotTo(HaveOccurred())
+	_, err = w.Write([]byte(content))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(w.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func signatureContent(digest string) string {
+	return fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"%s"},"image":{"docker-manifest-digest":"%s"},"type":"atomic container signature"},"optional":{}}`,
+		releaseImage, digest)
+}
+
+func writeKeys(dir string, entities ...*openpgp.Entity) string {
+	path := filepath.Join(dir, "keys.gpg")
+	f, err := os.Create(path)
+	Expect(err).NotTo(HaveOccurred())
+	defer f.Close()
+	w, err := armor.Encode(f, openpgp.PublicKeyType, nil)
+	Expect(err).NotTo(HaveOccurred())
+	for _, entity := range entities {
+		Expect(entity.Serialize(w)).To(Succeed())
+	}
+	Expect(w.Close()).To(Succeed())
+	return path
+}
+
+var _ = Describe("Verifier", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockRelease *oc.MockRelease
+		trusted     *openpgp.Entity
+		dir         string
+		signatures  map[string][]byte
+		requests    int32
+		server      *httptest.Server
+		log         = logrus.New()
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRelease = oc.NewMockRelease(ctrl)
+		trusted = newEntity()
+		var err error
+		dir, err = os.MkdirTemp("", "releasesignature")
+		Expect(err).NotTo(HaveOccurred())
+		signatures = map[string][]byte{}
+		atomic.StoreInt32(&requests, 0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			signature, ok := signatures[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(signature)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+		ctrl.Finish()
+	})
+
+	signaturePath := func(n int) string {
+		return fmt.Sprintf("/release/sha256=%s/signature-%d", strings.TrimPrefix(digest, "sha256:"), n)
+	}
+
+	newVerifier := func() Verifier {
+		v, err := NewVerifier(Config{
+			Policy:          PolicyEnforce,
+			Stores:          []string{server.URL + "/release"},
+			TrustedKeysFile: writeKeys(dir, trusted),
+			CacheTTL:        time.Minute,
+			StoreTimeout:    time.Second,
+		}, mockRelease)
+		Expect(err).NotTo(HaveOccurred())
+		return v
+	}
+
+	expectDigest := func() {
+		mockRelease.EXPECT().GetReleaseDigest(gomock.Any(), releaseImage, "", pullSecret).Return(digest, nil).Times(1)
+	}
+
+	It("accepts a release signed by a trusted key", func() {
+		signatures[signaturePath(1)] = sign(trusted, signatureContent(digest))
+		expectDigest()
+		verifiedDigest, err := newVerifier().Verify(log, releaseImage, "", pullSecret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(verifiedDigest).To(Equal(digest))
+	})
+
+	It("looks for a valid signature past the invalid ones", func() {
+		signatures[signaturePath(1)] = sign(newEntity(), signatureContent(digest))
+		signatures[signaturePath(2)] = sign(trusted, signatureContent(digest))
+		expectDigest()
+		Expect(newVerifier().Verify(log, releaseImage, "", pullSecret)).To(Equal(digest))
+	})
+
+	It("rejects a release without signatures", func() {
+		expectDigest()
+		_, err := newVerifier().Verify(log, releaseImage, "", pullSecret)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("no signature found"))
+	})
+
+	It("rejects a release signed by an untrusted key", func() {
+		signatures[signaturePath(1)] = sign(newEntity(), signatureContent(digest))
+		expectDigest()
+		_, err := newVerifier().Verify(log, releaseImage, "", pullSecret)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("none of the 1 signatures"))
+	})
+
+	It("rejects a signature for another digest", func() {
+		signatures[signaturePath(1)] = sign(trusted, signatureContent("sha256:0000"))
+		expectDigest()
+		_, err := newVerifier().Verify(log, releaseImage, "", pullSecret)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects a release whose digest can't be resolved", func() {
+		mockRelease.EXPECT().GetReleaseDigest(gomock.Any(), releaseImage, "", pullSecret).Return("", errors.New("failed")).Times(1)
+		_, err := newVerifier().Verify(log, releaseImage, "", pullSecret)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("caches the verification result of each digest", func() {
+		signatures[signaturePath(1)] = sign(trusted, signatureContent(digest))
+		mockRelease.EXPECT().GetReleaseDigest(gomock.Any(), releaseImage, "", pullSecret).Return(digest, nil).Times(2)
+		v := newVerifier()
+		Expect(v.Verify(log, releaseImage, "", pullSecret)).To(Equal(digest))
+		served := atomic.LoadInt32(&requests)
+		Expect(v.Verify(log, releaseImage, "", pullSecret)).To(Equal(digest))
+		Expect(atomic.LoadInt32(&requests)).To(Equal(served))
+	})
+
+	It("verifies the new digest of a tag that moved", func() {
+		signatures[signaturePath(1)] = sign(trusted, signatureContent(digest))
+		otherDigest := "sha256:" + strings.Repeat("0", 64)
+		gomock.InOrder(
+			mockRelease.EXPECT().GetReleaseDigest(gomock.Any(), releaseImage, "", pullSecret).Return(digest, nil).Times(1),
+			mockRelease.EXPECT().GetReleaseDigest(gomock.Any(), releaseImage, "", pullSecret).Return(otherDigest, nil).Times(1),
+		)
+		v := newVerifier()
+		Expect(v.Verify(log, releaseImage, "", pullSecret)).To(Equal(digest))
+		_, err := v.Verify(log, releaseImage, "", pullSecret)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(otherDigest))
+	})
+
+	It("verifies a release in the background", func() {
+		signatures[signaturePath(1)] = sign(trusted, signatureContent(digest))
+		expectDigest()
+		v := newVerifier()
+		Expect(v.GetStatus(log, releaseImage, "", pullSecret).InProgress).To(BeTrue())
+		var status Status
+		Eventually(func() bool {
+			status = v.GetStatus(log, releaseImage, "", pullSecret)
+			return status.InProgress
+		}, "5s", "10ms").Should(BeFalse())
+		Expect(status.Err).NotTo(HaveOccurred())
+		Expect(status.Digest).To(Equal(digest))
+	})
+
+	It("doesn't share the background verifications between pull secrets", func() {
+		signatures[signaturePath(1)] = sign(trusted, signatureContent(digest))
+		expectDigest()
+		mockRelease.EXPECT().GetReleaseDigest(gomock.Any(), releaseImage, "", "other-pull-secret").Return("", errors.New("unauthorized")).Times(1)
+		v := newVerifier()
+		Eventually(func() bool {
+			return v.GetStatus(log, releaseImage, "", pullSecret).InProgress
+		}, "5s", "10ms").Should(BeFalse())
+		var status Status
+		Eventually(func() bool {
+			status = v.GetStatus(log, releaseImage, "", "other-pull-secret")
+			return status.InProgress
+		}, "5s", "10ms").Should(BeFalse())
+		Expect(status.Err).To(HaveOccurred())
+	})
+
+	It("doesn't verify anything when disabled", func() {
+		v, err := NewVerifier(Config{Policy: PolicyDisabled}, mockRelease)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v.IsEnabled()).To(BeFalse())
+		Expect(v.Verify(log, releaseImage, "", pullSecret)).To(BeEmpty())
+		Expect(v.GetStatus(log, releaseImage, "", pullSecret)).To(Equal(Status{}))
+	})
+
+	It("fails construction with an invalid configuration", func() {
+		_, err := NewVerifier(Config{Policy: "lenient"}, mockRelease)
+		Expect(err).To(HaveOccurred())
+		_, err = NewVerifier(Config{Policy: PolicyEnforce, Stores: []string{server.URL}}, mockRelease)
+		Expect(err).To(HaveOccurred())
+		_, err = NewVerifier(Config{Policy: PolicyEnforce, Stores: []string{server.URL}, TrustedKeysFile: filepath.Join(dir, "missing")}, mockRelease)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Release", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockRelease  *oc.MockRelease
+		mockVerifier *MockVerifier
+		release      oc.Release
+		log          = logrus.New()
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRelease = oc.NewMockRelease(ctrl)
+		mockVerifier = NewMockVerifier(ctrl)
+		release = NewRelease(mockRelease, mockVerifier)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("extracts the verified digest of a release where the binary of its tag is expected", func() {
+		cacheDir, err := os.MkdirTemp("", "releases")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(cacheDir)
+		verifiedImage := "quay.io/openshift-release-dev/ocp-release@" + digest
+		verifiedMirror := "mirror.example.com/ocp-release@" + digest
+		workdir := filepath.Join(cacheDir, releaseImage)
+		verifiedWorkdir := filepath.Join(cacheDir, verifiedImage)
+
+		mockVerifier.EXPECT().Verify(gomock.Any(), releaseImage, "mirror.example.com/ocp-release:4.17.11-x86_64", pullSecret).Return(digest, nil).Times(1)
+		mockRelease.EXPECT().Extract(gomock.Any(), verifiedImage, verifiedMirror, cacheDir, pullSecret, "4.17.11").
+			DoAndReturn(func(logrus.FieldLogger, string, string, string, string, string) (string, error) {
+				Expect(os.MkdirAll(verifiedWorkdir, 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(verifiedWorkdir, "openshift-install"), []byte("installer"), 0600)).To(Succeed())
+				return filepath.Join(verifiedWorkdir, "openshift-install"), nil
+			}).Times(1)
+		mockRelease.EXPECT().GetReleaseBinaryPath(releaseImage, cacheDir, "4.17.11").
+			Return(workdir, "openshift-install", filepath.Join(workdir, "openshift-install"), nil).Times(1)
+
+		path, err := release.Extract(log, releaseImage, "mirror.example.com/ocp-release:4.17.11-x86_64", cacheDir, pullSecret, "4.17.11")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(path).To(Equal(filepath.Join(workdir, "openshift-install")))
+		Expect(os.ReadFile(path)).To(Equal([]byte("installer")))
+		Expect(verifiedWorkdir).NotTo(BeADirectory())
+	})
+
+	It("takes the images from the verified digest of a release", func() {
+		mockVerifier.EXPECT().Verify(gomock.Any(), releaseImage, "", pullSecret).Return(digest, nil).Times(1)
+		mockRelease.EXPECT().GetMCOImage(gomock.Any(), "quay.io/openshift-release-dev/ocp-release@"+digest, "", pullSecret).
+			Return("quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:1111", nil).Times(1)
+		Expect(release.GetMCOImage(log, releaseImage, "", pullSecret)).To(Equal("quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:1111"))
+	})
+
+	It("uses the release as is when the verification is disabled", func() {
+		mockVerifier.EXPECT().Verify(gomock.Any(), releaseImage, "", pullSecret).Return("", nil).Times(1)
+		mockRelease.EXPECT().Extract(gomock.Any(), releaseImage, "", "/tmp", pullSecret, "4.17.11").Return("/tmp/openshift-install", nil).Times(1)
+		path, err := release.Extract(log, releaseImage, "", "/tmp", pullSecret, "4.17.11")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(path).To(Equal("/tmp/openshift-install"))
+	})
+
+	It("doesn't extract or use images of a release that fails verification", func() {
+		mockVerifier.EXPECT().Verify(gomock.Any(), releaseImage, "", pullSecret).Return("", errors.New("not signed")).Times(2)
+		_, err := release.Extract(log, releaseImage, "", "/tmp", pullSecret, "4.17.11")
+		Expect(err).To(HaveOccurred())
+		_, err = release.GetMCOImage(log, releaseImage, "", pullSecret)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("doesn't verify metadata lookups", func() {
+		mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(), releaseImage, "", pullSecret).Return("4.17.11", nil).Times(1)
+		version, err := release.GetOpenshiftVersion(log, releaseImage, "", pullSecret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal("4.17.11"))
+	})
+})
+
+func TestReleaseSignature(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "release signature tests")
+}
@@ -0,0 +1,213 @@
+package serviceaccount
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/service_accounts"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ restapi.ServiceAccountsAPI = (*Handler)(nil)
+
+// Handler manages the service accounts and their API tokens. The tokens authenticate requests only when the
+// service uses local authentication.
+type Handler struct {
+	log     logrus.FieldLogger
+	db      *gorm.DB
+	enabled bool
+}
+
+// NewHandler returns the service accounts handler, enabled is false if the service doesn't use local authentication
+func NewHandler(log logrus.FieldLogger, db *gorm.DB, enabled bool) *Handler {
+	return &Handler{
+		log:     log,
+		db:      db,
+		enabled: enabled,
+	}
+}
+
+// checkAllowed refuses the requests of the service accounts themselves, so that a leaked token can't be used to
+// mint more tokens that outlive its revocation
+func (h *Handler) checkAllowed(ctx context.Context) error {
+	if !h.enabled {
+		return common.NewApiError(http.StatusBadRequest, errors.New("Service accounts are only supported with local authentication"))
+	}
+	if payload := ocm.PayloadFromContext(ctx); IsUserName(payload.Username) {
+		return common.NewApiError(http.StatusForbidden, errors.Errorf("%s: Service accounts can't manage service accounts", payload.Username))
+	}
+	return nil
+}
+
+func (h *Handler) getServiceAccount(db *gorm.DB, id strfmt.UUID) (*models.ServiceAccount, error) {
+	var account models.ServiceAccount
+	if err := db.Take(&account, "id = ?", id.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("Service account %s was not found", id))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to get service account %s", id))
+	}
+	return &account, nil
+}
+
+func (h *Handler) V2ListServiceAccounts(ctx context.Context, params operations.V2ListServiceAccountsParams) middleware.Responder {
+	if err := h.checkAllowed(ctx); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	accounts := models.ServiceAccountList{}
+	if err := h.db.Order("name").Find(&accounts).Error; err != nil {
+		return common.GenerateErrorResponder(errors.Wrap(err, "failed to list the service accounts"))
+	}
+	return operations.NewV2ListServiceAccountsOK().WithPayload(accounts)
+}
+
+func (h *Handler) V2CreateServiceAccount(ctx context.Context, params operations.V2CreateServiceAccountParams) middleware.Responder {
+	if err := h.checkAllowed(ctx); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	log := logutil.FromContext(ctx, h.log)
+	name := *params.NewServiceAccountParams.Name
+
+	var count int64
+	if err := h.db.Model(&models.ServiceAccount{}).Where("name = ?", name).Count(&count).Error; err != nil {
+		return common.GenerateErrorResponder(errors.Wrap(err, "failed to get the service accounts"))
+	}
+	if count > 0 {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("Service account %s already exists", name))
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	createdAt := strfmt.DateTime(time.Now())
+	account := &models.ServiceAccount{
+		ID:          &id,
+		Name:        &name,
+		Description: params.NewServiceAccountParams.Description,
+		CreatedAt:   &createdAt,
+	}
+	if err := h.db.Create(account).Error; err != nil {
+		return common.GenerateErrorResponder(errors.Wrapf(err, "failed to create service account %s", name))
+	}
+	log.Infof("Created service account %s (%s)", name, id)
+	return operations.NewV2CreateServiceAccountCreated().WithPayload(account)
+}
+
+func (h *Handler) V2DeleteServiceAccount(ctx context.Context, params operations.V2DeleteServiceAccountParams) middleware.Responder {
+	if err := h.checkAllowed(ctx); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	log := logutil.FromContext(ctx, h.log)
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		account, err := h.getServiceAccount(tx, params.ServiceAccountID)
+		if err != nil {
+			return err
+		}
+		if err = tx.Where("service_account_id = ?", params.ServiceAccountID.String()).Delete(&common.ServiceAccountToken{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete the API tokens of service account %s", *account.Name)
+		}
+		if err = tx.Delete(account).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete service account %s", *account.Name)
+		}
+		log.Infof("Deleted service account %s (%s)", *account.Name, params.ServiceAccountID)
+		return nil
+	})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2DeleteServiceAccountNoContent()
+}
+
+func (h *Handler) V2ListServiceAccountTokens(ctx context.Context, params operations.V2ListServiceAccountTokensParams) middleware.Responder {
+	if err := h.checkAllowed(ctx); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if _, err := h.getServiceAccount(h.db, params.ServiceAccountID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	var tokens []*common.ServiceAccountToken
+	if err := h.db.Where("service_account_id = ?", params.ServiceAccountID.String()).Order("created_at").Find(&tokens).Error; err != nil {
+		return common.GenerateErrorResponder(errors.Wrapf(err, "failed to list the API tokens of service account %s", params.ServiceAccountID))
+	}
+	payload := models.ServiceAccountTokenList{}
+	for _, token := range tokens {
+		payload = append(payload, &token.ServiceAccountToken)
+	}
+	return operations.NewV2ListServiceAccountTokensOK().WithPayload(payload)
+}
+
+func (h *Handler) V2CreateServiceAccountToken(ctx context.Context, params operations.V2CreateServiceAccountTokenParams) middleware.Responder {
+	if err := h.checkAllowed(ctx); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	log := logutil.FromContext(ctx, h.log)
+	account, err := h.getServiceAccount(h.db, params.ServiceAccountID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	secret, err := generateToken()
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	id := strfmt.UUID(uuid.New().String())
+	now := time.Now()
+	createdAt := strfmt.DateTime(now)
+	token := &common.ServiceAccountToken{
+		ServiceAccountToken: models.ServiceAccountToken{
+			ID:               &id,
+			ServiceAccountID: account.ID,
+			Name:             params.NewServiceAccountTokenParams.Name,
+			Scope:            params.NewServiceAccountTokenParams.Scope,
+			CreatedAt:        &createdAt,
+		},
+		TokenHash: hashToken(secret),
+	}
+	if days := swag.Int64Value(params.NewServiceAccountTokenParams.ExpiresInDays); days > 0 {
+		expiresAt := strfmt.DateTime(now.AddDate(0, 0, int(days)))
+		token.ExpiresAt = &expiresAt
+	}
+	if err = h.db.Create(token).Error; err != nil {
+		return common.GenerateErrorResponder(errors.Wrapf(err, "failed to create an API token for service account %s", *account.Name))
+	}
+	log.Infof("Issued %s API token %s (%s) to service account %s", *token.Scope, *token.Name, id, *account.Name)
+
+	payload := token.ServiceAccountToken
+	payload.Token = secret
+	return operations.NewV2CreateServiceAccountTokenCreated().WithPayload(&payload)
+}
+
+func (h *Handler) V2RevokeServiceAccountToken(ctx context.Context, params operations.V2RevokeServiceAccountTokenParams) middleware.Responder {
+	if err := h.checkAllowed(ctx); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	log := logutil.FromContext(ctx, h.log)
+	var token common.ServiceAccountToken
+	err := h.db.Take(&token, "id = ? and service_account_id = ?", params.TokenID.String(), params.ServiceAccountID.String()).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, errors.Errorf("API token %s of service account %s was not found",
+				params.TokenID, params.ServiceAccountID))
+		}
+		return common.GenerateErrorResponder(errors.Wrapf(err, "failed to get API token %s", params.TokenID))
+	}
+	if token.RevokedAt != nil {
+		return operations.NewV2RevokeServiceAccountTokenNoContent()
+	}
+	if err = h.db.Model(&common.ServiceAccountToken{}).Where("id = ?", params.TokenID.String()).
+		Update("revoked_at", strfmt.DateTime(time.Now())).Error; err != nil {
+		return common.GenerateErrorResponder(errors.Wrapf(err, "failed to revoke API token %s", params.TokenID))
+	}
+	log.Infof("Revoked API token %s (%s) of service account %s", *token.Name, params.TokenID, params.ServiceAccountID)
+	return operations.NewV2RevokeServiceAccountTokenNoContent()
+}
@@ -0,0 +1,190 @@
+package serviceaccount
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/service_accounts"
+	"gorm.io/gorm"
+)
+
+func TestServiceAccount(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Service account test Suite")
+}
+
+var _ = BeforeSuite(func() {
+	common.InitializeDBTest()
+})
+
+var _ = AfterSuite(func() {
+	common.TerminateDBTest()
+})
+
+var _ = Describe("Handler", func() {
+	var (
+		db      *gorm.DB
+		dbName  string
+		handler *Handler
+		ctx     = context.Background()
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		handler = NewHandler(common.GetTestLog(), db, true)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	expectApiError := func(reply interface{}, code int32) {
+		apiErr, ok := reply.(*common.ApiErrorResponse)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.StatusCode()).To(Equal(code))
+	}
+
+	createAccount := func(name string) *models.ServiceAccount {
+		reply := handler.V2CreateServiceAccount(ctx, operations.V2CreateServiceAccountParams{
+			NewServiceAccountParams: &models.ServiceAccountCreateParams{Name: swag.String(name), Description: "CI pipelines"},
+		})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2CreateServiceAccountCreated()))
+		return reply.(*operations.V2CreateServiceAccountCreated).Payload
+	}
+
+	createToken := func(accountID strfmt.UUID, scope models.ServiceAccountTokenScope, expiresInDays int64) *models.ServiceAccountToken {
+		reply := handler.V2CreateServiceAccountToken(ctx, operations.V2CreateServiceAccountTokenParams{
+			ServiceAccountID: accountID,
+			NewServiceAccountTokenParams: &models.ServiceAccountTokenCreateParams{
+				Name:          swag.String("pipeline"),
+				Scope:         scope.Pointer(),
+				ExpiresInDays: swag.Int64(expiresInDays),
+			},
+		})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2CreateServiceAccountTokenCreated()))
+		return reply.(*operations.V2CreateServiceAccountTokenCreated).Payload
+	}
+
+	It("creates and lists service accounts", func() {
+		createAccount("ci")
+		createAccount("backup")
+
+		reply := handler.V2ListServiceAccounts(ctx, operations.V2ListServiceAccountsParams{})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ListServiceAccountsOK()))
+		accounts := reply.(*operations.V2ListServiceAccountsOK).Payload
+		Expect(accounts).To(HaveLen(2))
+		Expect(*accounts[0].Name).To(Equal("backup"))
+		Expect(*accounts[1].Name).To(Equal("ci"))
+		Expect(accounts[1].Description).To(Equal("CI pipelines"))
+	})
+
+	It("refuses service accounts with the name of another service account", func() {
+		createAccount("ci")
+		reply := handler.V2CreateServiceAccount(ctx, operations.V2CreateServiceAccountParams{
+			NewServiceAccountParams: &models.ServiceAccountCreateParams{Name: swag.String("ci")},
+		})
+		expectApiError(reply, http.StatusConflict)
+	})
+
+	It("stores the hash of the tokens", func() {
+		account := createAccount("ci")
+		token := createToken(*account.ID, models.ServiceAccountTokenScopeReadOnly, 0)
+		Expect(IsToken(token.Token)).To(BeTrue())
+		Expect(token.ExpiresAt).To(BeNil())
+
+		var stored common.ServiceAccountToken
+		Expect(db.Take(&stored, "id = ?", token.ID.String()).Error).To(Succeed())
+		Expect(stored.TokenHash).To(Equal(hashToken(token.Token)))
+		Expect(stored.TokenHash).ToNot(ContainSubstring(token.Token))
+
+		reply := handler.V2ListServiceAccountTokens(ctx, operations.V2ListServiceAccountTokensParams{ServiceAccountID: *account.ID})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ListServiceAccountTokensOK()))
+		tokens := reply.(*operations.V2ListServiceAccountTokensOK).Payload
+		Expect(tokens).To(HaveLen(1))
+		Expect(tokens[0].Token).To(BeEmpty())
+		Expect(*tokens[0].Scope).To(Equal(models.ServiceAccountTokenScopeReadOnly))
+	})
+
+	It("authenticates tokens and tracks their last use", func() {
+		account := createAccount("ci")
+		token := createToken(*account.ID, models.ServiceAccountTokenScopeClusterAdmin, 30)
+		Expect(time.Time(*token.ExpiresAt)).To(BeTemporally("~", time.Now().AddDate(0, 0, 30), time.Minute))
+
+		authenticated, saToken, err := Authenticate(common.GetTestLog(), db, token.Token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*authenticated.Name).To(Equal("ci"))
+		Expect(UserName(authenticated)).To(Equal("serviceaccount:ci"))
+		Expect(*saToken.Scope).To(Equal(models.ServiceAccountTokenScopeClusterAdmin))
+
+		var stored common.ServiceAccountToken
+		Expect(db.Take(&stored, "id = ?", token.ID.String()).Error).To(Succeed())
+		Expect(stored.LastUsedAt).ToNot(BeNil())
+	})
+
+	It("doesn't authenticate expired, revoked or unknown tokens", func() {
+		account := createAccount("ci")
+		expired := createToken(*account.ID, models.ServiceAccountTokenScopeReadOnly, 1)
+		Expect(db.Model(&common.ServiceAccountToken{}).Where("id = ?", expired.ID.String()).
+			Update("expires_at", strfmt.DateTime(time.Now().Add(-time.Hour))).Error).To(Succeed())
+		_, _, err := Authenticate(common.GetTestLog(), db, expired.Token)
+		Expect(err).To(MatchError(ContainSubstring("expired")))
+
+		revoked := createToken(*account.ID, models.ServiceAccountTokenScopeReadOnly, 0)
+		reply := handler.V2RevokeServiceAccountToken(ctx, operations.V2RevokeServiceAccountTokenParams{
+			ServiceAccountID: *account.ID,
+			TokenID:          *revoked.ID,
+		})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2RevokeServiceAccountTokenNoContent()))
+		_, _, err = Authenticate(common.GetTestLog(), db, revoked.Token)
+		Expect(err).To(MatchError(ContainSubstring("revoked")))
+
+		_, _, err = Authenticate(common.GetTestLog(), db, TokenPrefix+"unknown")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("deletes the tokens of deleted service accounts", func() {
+		account := createAccount("ci")
+		token := createToken(*account.ID, models.ServiceAccountTokenScopeReadOnly, 0)
+
+		reply := handler.V2DeleteServiceAccount(ctx, operations.V2DeleteServiceAccountParams{ServiceAccountID: *account.ID})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2DeleteServiceAccountNoContent()))
+		_, _, err := Authenticate(common.GetTestLog(), db, token.Token)
+		Expect(err).To(HaveOccurred())
+
+		reply = handler.V2ListServiceAccountTokens(ctx, operations.V2ListServiceAccountTokensParams{ServiceAccountID: *account.ID})
+		expectApiError(reply, http.StatusNotFound)
+	})
+
+	It("refuses the requests of service accounts", func() {
+		account := createAccount("ci")
+		serviceAccountCtx := context.WithValue(ctx, restapi.AuthKey, &ocm.AuthPayload{Username: UserName(account), Role: ocm.AdminRole})
+		reply := handler.V2CreateServiceAccountToken(serviceAccountCtx, operations.V2CreateServiceAccountTokenParams{
+			ServiceAccountID: *account.ID,
+			NewServiceAccountTokenParams: &models.ServiceAccountTokenCreateParams{
+				Name:  swag.String("token"),
+				Scope: models.ServiceAccountTokenScopeClusterAdmin.Pointer(),
+			},
+		})
+		expectApiError(reply, http.StatusForbidden)
+		reply = handler.V2CreateServiceAccount(serviceAccountCtx, operations.V2CreateServiceAccountParams{
+			NewServiceAccountParams: &models.ServiceAccountCreateParams{Name: swag.String("other")},
+		})
+		expectApiError(reply, http.StatusForbidden)
+	})
+
+	It("refuses requests when the service doesn't use local authentication", func() {
+		handler = NewHandler(common.GetTestLog(), db, false)
+		reply := handler.V2ListServiceAccounts(ctx, operations.V2ListServiceAccountsParams{})
+		expectApiError(reply, http.StatusBadRequest)
+	})
+})
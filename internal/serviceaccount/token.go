@@ -0,0 +1,90 @@
+package serviceaccount
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// TokenPrefix distinguishes the API tokens of service accounts from the other tokens
+const TokenPrefix = "assisted_sat_"
+
+// lastUsedGranularity limits the updates of the last use of a token to one per period
+const lastUsedGranularity = time.Minute
+
+// IsToken returns true if the token is an API token of a service account
+func IsToken(token string) bool {
+	return strings.HasPrefix(token, TokenPrefix)
+}
+
+// userNamePrefix distinguishes the user names of the service accounts from the names of the users
+const userNamePrefix = "serviceaccount:"
+
+// UserName returns the user name of the requests that are authenticated by the tokens of the service account
+func UserName(account *models.ServiceAccount) string {
+	return userNamePrefix + *account.Name
+}
+
+// IsUserName returns true if the user name is the user name of a service account
+func IsUserName(username string) bool {
+	return strings.HasPrefix(username, userNamePrefix)
+}
+
+func generateToken() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", errors.Wrap(err, "failed to generate the API token")
+	}
+	return TokenPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// hashToken returns the hash of a token, tokens are random so they don't need a salt
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Authenticate returns the service account and the API token that the token belongs to, or an error if the token
+// doesn't exist, expired or was revoked
+func Authenticate(log logrus.FieldLogger, db *gorm.DB, token string) (*models.ServiceAccount, *models.ServiceAccountToken, error) {
+	var saToken common.ServiceAccountToken
+	if err := db.Take(&saToken, "token_hash = ?", hashToken(token)).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, errors.New("unknown API token")
+		}
+		return nil, nil, errors.Wrap(err, "failed to get the API token")
+	}
+	now := time.Now()
+	if saToken.RevokedAt != nil {
+		return nil, nil, errors.Errorf("API token %s was revoked", *saToken.Name)
+	}
+	if saToken.ExpiresAt != nil && time.Time(*saToken.ExpiresAt).Before(now) {
+		return nil, nil, errors.Errorf("API token %s expired", *saToken.Name)
+	}
+
+	var account models.ServiceAccount
+	if err := db.Take(&account, "id = ?", saToken.ServiceAccountID.String()).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get the service account of API token %s", *saToken.Name)
+	}
+
+	if saToken.LastUsedAt == nil || now.Sub(time.Time(*saToken.LastUsedAt)) > lastUsedGranularity {
+		lastUsedAt := strfmt.DateTime(now)
+		if err := db.Model(&common.ServiceAccountToken{}).Where("id = ?", saToken.ID.String()).
+			Update("last_used_at", lastUsedAt).Error; err != nil {
+			log.WithError(err).Warnf("failed to update the last use of API token %s", saToken.ID)
+		} else {
+			saToken.LastUsedAt = &lastUsedAt
+		}
+	}
+	return &account, &saToken.ServiceAccountToken, nil
+}
@@ -232,6 +232,12 @@ func (h *apiHandler) V2ListSupportedOpenshiftVersions(ctx context.Context, param
 		return common.GenerateErrorResponder(errors.Wrap(err, "error occurred while trying to get release images from DB"))
 	}
 
+	customReleaseImages, err := handler.getCustomReleaseImages(ctx, nil)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	releaseImages = append(releaseImages, customReleaseImages...)
+
 	releaseImages = filterIgnoredVersions(h.log, releaseImages, handler.ignoredOpenshiftVersions)
 	releaseImagesByVersionPattern := filterReleaseImagesByVersion(releaseImages, params.Version)
 	releaseImagesByOnlyLatest, err := filterReleaseImagesByOnlyLatest(releaseImages, params.OnlyLatest, h.log)
@@ -12,6 +12,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/oc"
 	models "github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/openshift/assisted-service/pkg/leader"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -41,6 +42,7 @@ func NewHandler(
 	kubeClient client.Client,
 	ignoredOpenshiftVersions []string,
 	db *gorm.DB,
+	authzHandler auth.Authorizer,
 	enableKubeAPI bool,
 	releaseSources models.ReleaseSources,
 ) (Handler, error) {
@@ -72,6 +74,7 @@ func NewHandler(
 		mustGatherVersions:       mustGatherVersions,
 		ignoredOpenshiftVersions: ignoredOpenshiftVersions,
 		db:                       db,
+		authzHandler:             authzHandler,
 	}
 
 	return restHandler, nil
@@ -18,7 +18,7 @@ import (
 
 var _ = Describe("NewHandler", func() {
 	validateNewHandler := func(releaseImages models.ReleaseImages) error {
-		_, err := NewHandler(common.GetTestLog(), nil, releaseImages, nil, "", nil, nil, nil, true, nil)
+		_, err := NewHandler(common.GetTestLog(), nil, releaseImages, nil, "", nil, nil, nil, nil, true, nil)
 		return err
 	}
 
@@ -113,7 +113,7 @@ var _ = Describe("NewHandler", func() {
 			},
 		}
 
-		_, err := NewHandler(common.GetTestLog(), nil, releaseImages, nil, "", nil, nil, nil, false, nil)
+		_, err := NewHandler(common.GetTestLog(), nil, releaseImages, nil, "", nil, nil, nil, nil, false, nil)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(*releaseImages[0].CPUArchitecture).To(Equal(common.X86CPUArchitecture))
 		Expect(*releaseImages[1].CPUArchitecture).To(Equal(common.ARM64CPUArchitecture))
@@ -129,7 +129,7 @@ var _ = Describe("NewHandler", func() {
 			},
 		}
 
-		_, err := NewHandler(common.GetTestLog(), nil, releaseImages, nil, "", nil, nil, nil, false, nil)
+		_, err := NewHandler(common.GetTestLog(), nil, releaseImages, nil, "", nil, nil, nil, nil, false, nil)
 		Expect(err).To(HaveOccurred())
 	})
 })
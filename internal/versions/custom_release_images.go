@@ -0,0 +1,225 @@
+package versions
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	operations "github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Custom release images are release images registered by users at runtime. They are stored in
+// their own table, because the release_images table is overwritten from the configuration and the
+// release sources, and they are only visible to their owners, as defined by the OwnedBy scope of the
+// authorizer. The internal callers, e.g. the monitors, see all of them.
+
+func (h *apiHandler) V2ListCustomReleaseImages(ctx context.Context, params operations.V2ListCustomReleaseImagesParams) middleware.Responder {
+	handler, ok := h.versionsHandler.(*restAPIVersionsHandler)
+	// Should not happen
+	if !ok {
+		return common.GenerateErrorResponder(errors.New("KubeAPI version handler found in restAPI mode"))
+	}
+
+	customReleaseImages := models.CustomReleaseImages{}
+	err := handler.ownedCustomReleaseImages(ctx).Order("created_at").Find(&customReleaseImages).Error
+	if err != nil {
+		return common.GenerateErrorResponder(errors.Wrap(err, "error occurred while trying to get custom release images from DB"))
+	}
+
+	return operations.NewV2ListCustomReleaseImagesOK().WithPayload(customReleaseImages)
+}
+
+func (h *apiHandler) V2RegisterCustomReleaseImage(ctx context.Context, params operations.V2RegisterCustomReleaseImageParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	handler, ok := h.versionsHandler.(*restAPIVersionsHandler)
+	// Should not happen
+	if !ok {
+		return common.GenerateErrorResponder(errors.New("KubeAPI version handler found in restAPI mode"))
+	}
+
+	url := strings.TrimSpace(swag.StringValue(params.NewCustomReleaseImageParams.URL))
+	pullSecret := swag.StringValue(params.NewCustomReleaseImageParams.PullSecret)
+	orgID := ocm.OrgIDFromContext(ctx)
+
+	var count int64
+	err := handler.ownedCustomReleaseImages(ctx).Where("url = ?", url).Count(&count).Error
+	if err != nil {
+		return common.GenerateErrorResponder(errors.Wrap(err, "error occurred while trying to get custom release images from DB"))
+	}
+	if count > 0 {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("release image %s is already registered", url))
+	}
+
+	releaseImage, err := handler.inspectReleaseImage(url, pullSecret)
+	if err != nil {
+		log.WithError(err).Warnf("failed to inspect custom release image %s", url)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	// The release image is only useful if hosts can be booted for at least one of its architectures
+	if !h.hasOsImage(releaseImage) {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf(
+			"no OS image is available for version %s and CPU architectures %s of release image %s",
+			*releaseImage.Version, strings.Join(releaseImage.CPUArchitectures, ", "), url))
+	}
+
+	customReleaseImage := &models.CustomReleaseImage{
+		ID:               common.StrFmtUUIDPtr(strfmt.UUID(uuid.New().String())),
+		URL:              releaseImage.URL,
+		OpenshiftVersion: releaseImage.OpenshiftVersion,
+		Version:          releaseImage.Version,
+		CPUArchitecture:  releaseImage.CPUArchitecture,
+		CPUArchitectures: releaseImage.CPUArchitectures,
+		OrgID:            orgID,
+		UserName:         ocm.UserNameFromContext(ctx),
+		CreatedAt:        strfmt.DateTime(time.Now()),
+	}
+	if err = handler.db.Create(customReleaseImage).Error; err != nil {
+		return common.GenerateErrorResponder(errors.Wrapf(err, "failed to store custom release image %s", url))
+	}
+
+	log.Infof("Registered custom release image %s with version %s and CPU architectures %s",
+		url, *customReleaseImage.Version, customReleaseImage.CPUArchitectures)
+	return operations.NewV2RegisterCustomReleaseImageCreated().WithPayload(customReleaseImage)
+}
+
+func (h *apiHandler) V2DeregisterCustomReleaseImage(ctx context.Context, params operations.V2DeregisterCustomReleaseImageParams) middleware.Responder {
+	handler, ok := h.versionsHandler.(*restAPIVersionsHandler)
+	// Should not happen
+	if !ok {
+		return common.GenerateErrorResponder(errors.New("KubeAPI version handler found in restAPI mode"))
+	}
+
+	reply := handler.ownedCustomReleaseImages(ctx).
+		Where("id = ?", params.CustomReleaseImageID.String()).
+		Delete(&models.CustomReleaseImage{})
+	if reply.Error != nil {
+		return common.GenerateErrorResponder(errors.Wrapf(reply.Error, "failed to delete custom release image %s", params.CustomReleaseImageID))
+	}
+	if reply.RowsAffected == 0 {
+		return common.NewApiError(http.StatusNotFound, errors.Errorf("custom release image %s not found", params.CustomReleaseImageID))
+	}
+
+	return operations.NewV2DeregisterCustomReleaseImageNoContent()
+}
+
+// hasOsImage returns true if there is an OS image for at least one of the architectures of the release image
+func (h *apiHandler) hasOsImage(releaseImage *models.ReleaseImage) bool {
+	if h.osImages == nil {
+		return false
+	}
+	for _, arch := range releaseImage.CPUArchitectures {
+		if _, err := h.osImages.GetOsImage(*releaseImage.Version, arch); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// inspectReleaseImage reads the version and the CPU architectures of a release image from its metadata
+func (h *restAPIVersionsHandler) inspectReleaseImage(url, pullSecret string) (*models.ReleaseImage, error) {
+	if url == "" {
+		return nil, errors.New("release image URL must not be empty")
+	}
+
+	version, err := h.releaseHandler.GetOpenshiftVersion(h.log, url, "", pullSecret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the version of release image %s", url)
+	}
+
+	cpuArchitectures, err := h.releaseHandler.GetReleaseArchitecture(h.log, url, "", pullSecret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the CPU architecture of release image %s", url)
+	}
+	if len(cpuArchitectures) == 0 {
+		return nil, errors.Errorf("no CPU architecture found in release image %s", url)
+	}
+
+	majorMinorVersion, err := common.GetMajorMinorVersion(version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid version %s of release image %s", version, url)
+	}
+
+	cpuArchitecture := cpuArchitectures[0]
+	if len(cpuArchitectures) > 1 {
+		cpuArchitecture = common.MultiCPUArchitecture
+	}
+
+	releaseImage := &models.ReleaseImage{
+		OpenshiftVersion: majorMinorVersion,
+		Version:          swag.String(version),
+		URL:              swag.String(url),
+		CPUArchitecture:  swag.String(cpuArchitecture),
+		CPUArchitectures: cpuArchitectures,
+	}
+	normalizeReleaseImageCPUArchitecture(releaseImage)
+	if err = validateReleaseImage(releaseImage); err != nil {
+		return nil, errors.Wrapf(err, "invalid release image %s", url)
+	}
+
+	return releaseImage, nil
+}
+
+// ownedCustomReleaseImages returns a query of the custom release images visible to the user of the request
+func (h *restAPIVersionsHandler) ownedCustomReleaseImages(ctx context.Context) *gorm.DB {
+	return h.authzHandler.OwnedBy(ctx, h.db.Session(&gorm.Session{}).Model(&models.CustomReleaseImage{}))
+}
+
+// getCustomReleaseImages returns the custom release images visible to the user of the request
+func (h *restAPIVersionsHandler) getCustomReleaseImages(ctx context.Context, query func(*gorm.DB) *gorm.DB) (models.ReleaseImages, error) {
+	var customReleaseImages models.CustomReleaseImages
+	db := h.ownedCustomReleaseImages(ctx)
+	if query != nil {
+		db = query(db)
+	}
+	if err := db.Find(&customReleaseImages).Error; err != nil {
+		return nil, errors.Wrap(err, "error occurred while trying to get custom release images from DB")
+	}
+
+	releaseImages := make(models.ReleaseImages, 0, len(customReleaseImages))
+	for _, customReleaseImage := range customReleaseImages {
+		releaseImages = append(releaseImages, customReleaseImageToReleaseImage(customReleaseImage))
+	}
+	return releaseImages, nil
+}
+
+// getCustomReleaseImage returns the latest custom release image matching the OpenShift version and the CPU architecture
+func (h *restAPIVersionsHandler) getCustomReleaseImage(ctx context.Context, openshiftVersion, cpuArchitecture string) (*models.ReleaseImage, error) {
+	cpuArchitecture = common.NormalizeCPUArchitecture(cpuArchitecture)
+	releaseImages, err := h.getCustomReleaseImages(ctx, func(db *gorm.DB) *gorm.DB {
+		db = db.Where(h.db.Session(&gorm.Session{}).
+			Where("? = Any(cpu_architectures)", cpuArchitecture).
+			Or("cpu_architecture = ?", cpuArchitecture),
+		)
+		if common.GetVersionFormat(openshiftVersion) == common.MajorMinorPatchVersion {
+			return db.Where("version = ?", openshiftVersion)
+		}
+		return db.Where("openshift_version = ?", openshiftVersion)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return getLatestReleaseImage(releaseImages, h.ignoredOpenshiftVersions)
+}
+
+func customReleaseImageToReleaseImage(customReleaseImage *models.CustomReleaseImage) *models.ReleaseImage {
+	return &models.ReleaseImage{
+		OpenshiftVersion: customReleaseImage.OpenshiftVersion,
+		Version:          customReleaseImage.Version,
+		URL:              customReleaseImage.URL,
+		CPUArchitecture:  customReleaseImage.CPUArchitecture,
+		CPUArchitectures: customReleaseImage.CPUArchitectures,
+	}
+}
@@ -0,0 +1,233 @@
+package versions
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/openshift/assisted-service/models"
+	auth "github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Custom release images", func() {
+	var (
+		db          *gorm.DB
+		dbName      string
+		ctrl        *gomock.Controller
+		mockRelease *oc.MockRelease
+		handler     Handler
+		h           restapi.VersionsAPI
+		ctx         context.Context
+		otherCtx    context.Context
+		logger      = common.GetTestLog()
+		url         = "quay.io/example/ocp-release:4.16.3-x86_64"
+		pullSecret  = "{\"auths\":{\"quay.io\":{\"auth\":\"dXNlcjpwYXNzd29yZA==\"}}}"
+	)
+
+	orgContext := func(org, user string) context.Context {
+		payload := &ocm.AuthPayload{Username: user, Organization: org, Role: ocm.UserRole}
+		return context.WithValue(context.Background(), restapi.AuthKey, payload)
+	}
+
+	register := func(ctx context.Context) middleware.Responder {
+		return h.V2RegisterCustomReleaseImage(ctx, operations.V2RegisterCustomReleaseImageParams{
+			NewCustomReleaseImageParams: &models.CustomReleaseImageCreateParams{
+				URL:        swag.String(url),
+				PullSecret: swag.String(pullSecret),
+			},
+		})
+	}
+
+	mockInspect := func(version string, architectures []string) {
+		mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(), url, "", pullSecret).Return(version, nil).Times(1)
+		mockRelease.EXPECT().GetReleaseArchitecture(gomock.Any(), url, "", pullSecret).Return(architectures, nil).Times(1)
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockRelease = oc.NewMockRelease(ctrl)
+
+		osImages := osImageList{
+			{
+				OpenshiftVersion: swag.String("4.16"),
+				CPUArchitecture:  swag.String(common.X86CPUArchitecture),
+				URL:              swag.String("https://mirror.openshift.com/pub/openshift-v4/x86_64/dependencies/rhcos/4.16/4.16.3/rhcos-4.16.3-x86_64-live.x86_64.iso"),
+				Version:          swag.String("416.94.202406251923-0"),
+			},
+		}
+
+		var err error
+		authzHandler := auth.NewAuthzHandler(auth.GetConfigRHSSO(), nil, logger, db)
+		handler, err = NewHandler(logger, mockRelease, nil, nil, "", nil, nil, db, authzHandler, false, nil)
+		Expect(err).ToNot(HaveOccurred())
+		h = NewAPIHandler(logger, Versions{}, authzHandler, handler, osImages, nil)
+
+		ctx = orgContext("org-1", "user-1")
+		otherCtx = orgContext("org-2", "user-2")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("registers a release image with the version and architecture from its metadata", func() {
+		mockInspect("4.16.3", []string{common.X86CPUArchitecture})
+
+		reply := register(ctx)
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2RegisterCustomReleaseImageCreated()))
+		payload := reply.(*operations.V2RegisterCustomReleaseImageCreated).Payload
+		Expect(swag.StringValue(payload.URL)).To(Equal(url))
+		Expect(swag.StringValue(payload.OpenshiftVersion)).To(Equal("4.16"))
+		Expect(swag.StringValue(payload.Version)).To(Equal("4.16.3"))
+		Expect(swag.StringValue(payload.CPUArchitecture)).To(Equal(common.X86CPUArchitecture))
+		Expect(payload.OrgID).To(Equal("org-1"))
+		Expect(payload.UserName).To(Equal("user-1"))
+
+		var stored models.CustomReleaseImage
+		Expect(db.Take(&stored, "id = ?", payload.ID.String()).Error).ToNot(HaveOccurred())
+	})
+
+	It("uses the multi architecture for release images with several architectures", func() {
+		mockInspect("4.16.3", []string{common.X86CPUArchitecture, common.AARCH64CPUArchitecture})
+
+		reply := register(ctx)
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2RegisterCustomReleaseImageCreated()))
+		payload := reply.(*operations.V2RegisterCustomReleaseImageCreated).Payload
+		Expect(swag.StringValue(payload.CPUArchitecture)).To(Equal(common.MultiCPUArchitecture))
+		Expect([]string(payload.CPUArchitectures)).To(ConsistOf(common.X86CPUArchitecture, common.ARM64CPUArchitecture))
+	})
+
+	It("fails when the metadata of the release image can't be read", func() {
+		mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(), url, "", pullSecret).Return("", errors.New("unauthorized")).Times(1)
+
+		reply := register(ctx)
+		Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+		Expect(reply.(*common.ApiErrorResponse).Error()).To(ContainSubstring("unauthorized"))
+	})
+
+	It("fails when there is no OS image for the release image", func() {
+		mockInspect("4.17.0", []string{common.X86CPUArchitecture})
+
+		reply := register(ctx)
+		Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+		Expect(reply.(*common.ApiErrorResponse).Error()).To(ContainSubstring("no OS image is available"))
+	})
+
+	It("rejects registering the same release image twice in an organization", func() {
+		mockInspect("4.16.3", []string{common.X86CPUArchitecture})
+		Expect(register(ctx)).To(BeAssignableToTypeOf(operations.NewV2RegisterCustomReleaseImageCreated()))
+
+		reply := register(ctx)
+		Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+
+		mockInspect("4.16.3", []string{common.X86CPUArchitecture})
+		Expect(register(otherCtx)).To(BeAssignableToTypeOf(operations.NewV2RegisterCustomReleaseImageCreated()))
+	})
+
+	Context("with a registered release image", func() {
+		var id strfmt.UUID
+
+		BeforeEach(func() {
+			mockInspect("4.16.3", []string{common.X86CPUArchitecture})
+			reply := register(ctx)
+			Expect(reply).To(BeAssignableToTypeOf(operations.NewV2RegisterCustomReleaseImageCreated()))
+			id = *reply.(*operations.V2RegisterCustomReleaseImageCreated).Payload.ID
+		})
+
+		It("lists it only for its organization", func() {
+			reply := h.V2ListCustomReleaseImages(ctx, operations.V2ListCustomReleaseImagesParams{})
+			Expect(reply.(*operations.V2ListCustomReleaseImagesOK).Payload).To(HaveLen(1))
+
+			reply = h.V2ListCustomReleaseImages(otherCtx, operations.V2ListCustomReleaseImagesParams{})
+			Expect(reply.(*operations.V2ListCustomReleaseImagesOK).Payload).To(BeEmpty())
+		})
+
+		It("adds it to the supported versions of its organization", func() {
+			reply := h.V2ListSupportedOpenshiftVersions(ctx, operations.V2ListSupportedOpenshiftVersionsParams{})
+			payload := reply.(*operations.V2ListSupportedOpenshiftVersionsOK).Payload
+			Expect(payload).To(HaveKey("4.16.3"))
+			Expect(payload["4.16.3"].CPUArchitectures).To(Equal([]string{common.X86CPUArchitecture}))
+
+			reply = h.V2ListSupportedOpenshiftVersions(otherCtx, operations.V2ListSupportedOpenshiftVersionsParams{})
+			Expect(reply.(*operations.V2ListSupportedOpenshiftVersionsOK).Payload).To(BeEmpty())
+		})
+
+		It("returns it as the release image of its organization", func() {
+			releaseImage, err := handler.GetReleaseImage(ctx, "4.16", common.X86CPUArchitecture, pullSecret)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(swag.StringValue(releaseImage.URL)).To(Equal(url))
+
+			releaseImage, err = handler.GetReleaseImage(ctx, "4.16.3", common.X86CPUArchitecture, pullSecret)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(swag.StringValue(releaseImage.URL)).To(Equal(url))
+
+			releaseImage, err = handler.GetReleaseImageByURL(ctx, url, pullSecret)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(swag.StringValue(releaseImage.Version)).To(Equal("4.16.3"))
+
+			_, err = handler.GetReleaseImage(otherCtx, "4.16", common.X86CPUArchitecture, pullSecret)
+			Expect(err).To(HaveOccurred())
+			_, err = handler.GetReleaseImage(ctx, "4.16", common.ARM64CPUArchitecture, pullSecret)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns it to the internal callers", func() {
+			releaseImage, err := handler.GetReleaseImageByURL(context.Background(), url, pullSecret)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(swag.StringValue(releaseImage.Version)).To(Equal("4.16.3"))
+		})
+
+		It("uses the same scope for the lookups as for the list without organization tenancy", func() {
+			cfg := auth.GetConfigRHSSO()
+			cfg.EnableOrgTenancy = false
+			authzHandler := auth.NewAuthzHandler(cfg, nil, logger, db)
+			var err error
+			handler, err = NewHandler(logger, mockRelease, nil, nil, "", nil, nil, db, authzHandler, false, nil)
+			Expect(err).ToNot(HaveOccurred())
+			h = NewAPIHandler(logger, Versions{}, authzHandler, handler, nil, nil)
+			sameOrgCtx := orgContext("org-1", "user-3")
+
+			reply := h.V2ListCustomReleaseImages(ctx, operations.V2ListCustomReleaseImagesParams{})
+			Expect(reply.(*operations.V2ListCustomReleaseImagesOK).Payload).To(HaveLen(1))
+			_, err = handler.GetReleaseImage(ctx, "4.16", common.X86CPUArchitecture, pullSecret)
+			Expect(err).ToNot(HaveOccurred())
+
+			reply = h.V2ListCustomReleaseImages(sameOrgCtx, operations.V2ListCustomReleaseImagesParams{})
+			Expect(reply.(*operations.V2ListCustomReleaseImagesOK).Payload).To(BeEmpty())
+			_, err = handler.GetReleaseImage(sameOrgCtx, "4.16", common.X86CPUArchitecture, pullSecret)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("deregisters it", func() {
+			reply := h.V2DeregisterCustomReleaseImage(otherCtx, operations.V2DeregisterCustomReleaseImageParams{CustomReleaseImageID: id})
+			Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+
+			reply = h.V2DeregisterCustomReleaseImage(ctx, operations.V2DeregisterCustomReleaseImageParams{CustomReleaseImageID: id})
+			Expect(reply).To(BeAssignableToTypeOf(operations.NewV2DeregisterCustomReleaseImageNoContent()))
+
+			reply = h.V2DeregisterCustomReleaseImage(ctx, operations.V2DeregisterCustomReleaseImageParams{CustomReleaseImageID: strfmt.UUID(uuid.New().String())})
+			Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+
+			_, err := handler.GetReleaseImage(ctx, "4.16", common.X86CPUArchitecture, pullSecret)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/oc"
 	models "github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -23,13 +24,30 @@ type restAPIVersionsHandler struct {
 	mustGatherVersions       MustGatherVersions
 	ignoredOpenshiftVersions []string
 	db                       *gorm.DB
+	authzHandler             auth.Authorizer
 }
 
 // GetReleaseImage retrieves a release image based on a specified OpenShift version and CPU architecture.
 // If the provided OpenShift version includes a patch version, it attempts to retrieve an exact match for the release image if available.
 // For OpenShift versions specified as major.minor, it fetches the latest matching release image.
 // The function returns an error Returns an error for other formats of OpenShift version or if no matching image can be found.
-func (h *restAPIVersionsHandler) GetReleaseImage(_ context.Context, openshiftVersion, cpuArchitecture, _ string) (*models.ReleaseImage, error) {
+// Custom release images owned by the user of the request are used when there is no matching release image.
+func (h *restAPIVersionsHandler) GetReleaseImage(ctx context.Context, openshiftVersion, cpuArchitecture, _ string) (*models.ReleaseImage, error) {
+	releaseImage, err := h.getReleaseImage(openshiftVersion, cpuArchitecture)
+	if err == nil {
+		return releaseImage, nil
+	}
+
+	customReleaseImage, customErr := h.getCustomReleaseImage(ctx, openshiftVersion, cpuArchitecture)
+	if customErr != nil {
+		h.log.WithError(customErr).Debugf("no custom release image found for openshiftVersion: '%s' and CPU architecture '%s'", openshiftVersion, cpuArchitecture)
+		return nil, err
+	}
+
+	return customReleaseImage, nil
+}
+
+func (h *restAPIVersionsHandler) getReleaseImage(openshiftVersion, cpuArchitecture string) (*models.ReleaseImage, error) {
 	cpuArchitecture = common.NormalizeCPUArchitecture(cpuArchitecture)
 	// validations
 	if err := validateCPUArchitecture(cpuArchitecture); err != nil {
@@ -88,12 +106,18 @@ func (h *restAPIVersionsHandler) GetReleaseImage(_ context.Context, openshiftVer
 
 // GetReleaseImageByURL fetches a release image using the specified URL.
 // It returns an error if no matching image is found.
-func (h *restAPIVersionsHandler) GetReleaseImageByURL(_ context.Context, url, _ string) (*models.ReleaseImage, error) {
+func (h *restAPIVersionsHandler) GetReleaseImageByURL(ctx context.Context, url, _ string) (*models.ReleaseImage, error) {
 	var releaseImage models.ReleaseImage
 	query := h.db.Session(&gorm.Session{})
 	err := query.Model(&models.ReleaseImage{}).Where("url = ?", url).Take(&releaseImage).Error
 	if err != nil {
-		return nil, errors.Wrapf(err, "error occurred while trying to retrieve release image with url '%s' from the db", url)
+		customReleaseImages, customErr := h.getCustomReleaseImages(ctx, func(db *gorm.DB) *gorm.DB {
+			return db.Where("url = ?", url)
+		})
+		if customErr != nil || len(customReleaseImages) == 0 {
+			return nil, errors.Wrapf(err, "error occurred while trying to retrieve release image with url '%s' from the db", url)
+		}
+		releaseImage = *customReleaseImages[0]
 	}
 
 	if err := assertNotIgnored(*releaseImage.Version, h.ignoredOpenshiftVersions); err != nil {
@@ -316,6 +316,20 @@ func (mr *MockInstallerAPIMockRecorder) V2CompleteInstallation(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CompleteInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).V2CompleteInstallation), arg0, arg1)
 }
 
+// V2DeleteHostBmcCredentials mocks base method.
+func (m *MockInstallerAPI) V2DeleteHostBmcCredentials(arg0 context.Context, arg1 installer.V2DeleteHostBmcCredentialsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DeleteHostBmcCredentials", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DeleteHostBmcCredentials indicates an expected call of V2DeleteHostBmcCredentials.
+func (mr *MockInstallerAPIMockRecorder) V2DeleteHostBmcCredentials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DeleteHostBmcCredentials", reflect.TypeOf((*MockInstallerAPI)(nil).V2DeleteHostBmcCredentials), arg0, arg1)
+}
+
 // V2DeregisterCluster mocks base method.
 func (m *MockInstallerAPI) V2DeregisterCluster(arg0 context.Context, arg1 installer.V2DeregisterClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -414,6 +428,20 @@ func (mr *MockInstallerAPIMockRecorder) V2DownloadInfraEnvFiles(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DownloadInfraEnvFiles", reflect.TypeOf((*MockInstallerAPI)(nil).V2DownloadInfraEnvFiles), arg0, arg1)
 }
 
+// V2GenerateHostStaticNetworkConfig mocks base method.
+func (m *MockInstallerAPI) V2GenerateHostStaticNetworkConfig(arg0 context.Context, arg1 installer.V2GenerateHostStaticNetworkConfigParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GenerateHostStaticNetworkConfig", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GenerateHostStaticNetworkConfig indicates an expected call of V2GenerateHostStaticNetworkConfig.
+func (mr *MockInstallerAPIMockRecorder) V2GenerateHostStaticNetworkConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GenerateHostStaticNetworkConfig", reflect.TypeOf((*MockInstallerAPI)(nil).V2GenerateHostStaticNetworkConfig), arg0, arg1)
+}
+
 // V2GetCluster mocks base method.
 func (m *MockInstallerAPI) V2GetCluster(arg0 context.Context, arg1 installer.V2GetClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -526,6 +554,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetIgnoredValidations(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetIgnoredValidations", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetIgnoredValidations), arg0, arg1)
 }
 
+// V2GetInfraEnvVirtualMediaBoot mocks base method.
+func (m *MockInstallerAPI) V2GetInfraEnvVirtualMediaBoot(arg0 context.Context, arg1 installer.V2GetInfraEnvVirtualMediaBootParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetInfraEnvVirtualMediaBoot", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetInfraEnvVirtualMediaBoot indicates an expected call of V2GetInfraEnvVirtualMediaBoot.
+func (mr *MockInstallerAPIMockRecorder) V2GetInfraEnvVirtualMediaBoot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetInfraEnvVirtualMediaBoot", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetInfraEnvVirtualMediaBoot), arg0, arg1)
+}
+
 // V2GetNextSteps mocks base method.
 func (m *MockInstallerAPI) V2GetNextSteps(arg0 context.Context, arg1 installer.V2GetNextStepsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -582,6 +624,34 @@ func (mr *MockInstallerAPIMockRecorder) V2GetPresignedForClusterFiles(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetPresignedForClusterFiles", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetPresignedForClusterFiles), arg0, arg1)
 }
 
+// V2GetQuotaUsage mocks base method.
+func (m *MockInstallerAPI) V2GetQuotaUsage(arg0 context.Context, arg1 installer.V2GetQuotaUsageParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetQuotaUsage", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetQuotaUsage indicates an expected call of V2GetQuotaUsage.
+func (mr *MockInstallerAPIMockRecorder) V2GetQuotaUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetQuotaUsage", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetQuotaUsage), arg0, arg1)
+}
+
+// V2HostPowerAction mocks base method.
+func (m *MockInstallerAPI) V2HostPowerAction(arg0 context.Context, arg1 installer.V2HostPowerActionParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2HostPowerAction", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2HostPowerAction indicates an expected call of V2HostPowerAction.
+func (mr *MockInstallerAPIMockRecorder) V2HostPowerAction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2HostPowerAction", reflect.TypeOf((*MockInstallerAPI)(nil).V2HostPowerAction), arg0, arg1)
+}
+
 // V2ImportCluster mocks base method.
 func (m *MockInstallerAPI) V2ImportCluster(arg0 context.Context, arg1 installer.V2ImportClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -596,6 +666,20 @@ func (mr *MockInstallerAPIMockRecorder) V2ImportCluster(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ImportCluster", reflect.TypeOf((*MockInstallerAPI)(nil).V2ImportCluster), arg0, arg1)
 }
 
+// V2InfraEnvVirtualMediaBoot mocks base method.
+func (m *MockInstallerAPI) V2InfraEnvVirtualMediaBoot(arg0 context.Context, arg1 installer.V2InfraEnvVirtualMediaBootParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2InfraEnvVirtualMediaBoot", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2InfraEnvVirtualMediaBoot indicates an expected call of V2InfraEnvVirtualMediaBoot.
+func (mr *MockInstallerAPIMockRecorder) V2InfraEnvVirtualMediaBoot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2InfraEnvVirtualMediaBoot", reflect.TypeOf((*MockInstallerAPI)(nil).V2InfraEnvVirtualMediaBoot), arg0, arg1)
+}
+
 // V2InstallCluster mocks base method.
 func (m *MockInstallerAPI) V2InstallCluster(arg0 context.Context, arg1 installer.V2InstallClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -708,6 +792,20 @@ func (mr *MockInstallerAPIMockRecorder) V2RegisterHost(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2RegisterHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2RegisterHost), arg0, arg1)
 }
 
+// V2RemoveHostFromCluster mocks base method.
+func (m *MockInstallerAPI) V2RemoveHostFromCluster(arg0 context.Context, arg1 installer.V2RemoveHostFromClusterParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2RemoveHostFromCluster", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2RemoveHostFromCluster indicates an expected call of V2RemoveHostFromCluster.
+func (mr *MockInstallerAPIMockRecorder) V2RemoveHostFromCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2RemoveHostFromCluster", reflect.TypeOf((*MockInstallerAPI)(nil).V2RemoveHostFromCluster), arg0, arg1)
+}
+
 // V2ResetCluster mocks base method.
 func (m *MockInstallerAPI) V2ResetCluster(arg0 context.Context, arg1 installer.V2ResetClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -848,6 +946,20 @@ func (mr *MockInstallerAPIMockRecorder) V2UpdateHost(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2UpdateHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2UpdateHost), arg0, arg1)
 }
 
+// V2UpdateHostBmcCredentials mocks base method.
+func (m *MockInstallerAPI) V2UpdateHostBmcCredentials(arg0 context.Context, arg1 installer.V2UpdateHostBmcCredentialsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2UpdateHostBmcCredentials", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2UpdateHostBmcCredentials indicates an expected call of V2UpdateHostBmcCredentials.
+func (mr *MockInstallerAPIMockRecorder) V2UpdateHostBmcCredentials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2UpdateHostBmcCredentials", reflect.TypeOf((*MockInstallerAPI)(nil).V2UpdateHostBmcCredentials), arg0, arg1)
+}
+
 // V2UpdateHostIgnition mocks base method.
 func (m *MockInstallerAPI) V2UpdateHostIgnition(arg0 context.Context, arg1 installer.V2UpdateHostIgnitionParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -931,3 +1043,17 @@ func (mr *MockInstallerAPIMockRecorder) V2UploadLogs(arg0, arg1 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2UploadLogs", reflect.TypeOf((*MockInstallerAPI)(nil).V2UploadLogs), arg0, arg1)
 }
+
+// V2ValidateStaticNetworkConfig mocks base method.
+func (m *MockInstallerAPI) V2ValidateStaticNetworkConfig(arg0 context.Context, arg1 installer.V2ValidateStaticNetworkConfigParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ValidateStaticNetworkConfig", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ValidateStaticNetworkConfig indicates an expected call of V2ValidateStaticNetworkConfig.
+func (mr *MockInstallerAPIMockRecorder) V2ValidateStaticNetworkConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ValidateStaticNetworkConfig", reflect.TypeOf((*MockInstallerAPI)(nil).V2ValidateStaticNetworkConfig), arg0, arg1)
+}
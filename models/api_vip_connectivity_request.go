@@ -24,7 +24,7 @@ type APIVipConnectivityRequest struct {
 	CaCertificate *string `json:"ca_certificate,omitempty"`
 
 	// A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url (DEPRECATED use request_headers to pass this token).
-	IgnitionEndpointToken *string `json:"ignition_endpoint_token,omitempty"`
+	IgnitionEndpointToken *string `json:"ignition_endpoint_token,omitempty" secret:"true"`
 
 	// Additional request headers to include when fetching the ignition from ignition_endpoint_url.
 	RequestHeaders []*APIVipConnectivityAdditionalRequestHeader `json:"request_headers,omitempty"`
@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AuditEvent audit event
+//
+// swagger:model audit-event
+type AuditEvent struct {
+
+	// The API operation of the request.
+	Action string `json:"action,omitempty"`
+
+	// The time the request was received.
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at" gorm:"type:timestamp with time zone;index"`
+
+	// Unique identifier of the audit event.
+	// Required: true
+	ID *int64 `json:"id" gorm:"primaryKey;autoIncrement"`
+
+	// The HTTP method of the request.
+	// Required: true
+	Method *string `json:"method"`
+
+	// The organization of the user that sent the request.
+	OrgID string `json:"org_id,omitempty" gorm:"index"`
+
+	// The path of the request.
+	// Required: true
+	Path *string `json:"path"`
+
+	// The body of the request in JSON format, the values of secret fields such as pull secrets and passwords are redacted.
+	RequestDiff string `json:"request_diff,omitempty" gorm:"type:text"`
+
+	// Unique identifier of the request.
+	RequestID string `json:"request_id,omitempty"`
+
+	// Unique identifier of the resource the request changed, empty for creations.
+	ResourceID string `json:"resource_id,omitempty" gorm:"index"`
+
+	// The type of the resource the request changed, e.g. cluster or host.
+	ResourceType string `json:"resource_type,omitempty"`
+
+	// The HTTP status code of the response.
+	// Required: true
+	StatusCode *int64 `json:"status_code"`
+
+	// The user that sent the request.
+	Username string `json:"username,omitempty" gorm:"index"`
+}
+
+// Validate validates this audit event
+func (m *AuditEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePath(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatusCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AuditEvent) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditEvent) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditEvent) validateMethod(formats strfmt.Registry) error {
+
+	if err := validate.Required("method", "body", m.Method); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditEvent) validatePath(formats strfmt.Registry) error {
+
+	if err := validate.Required("path", "body", m.Path); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditEvent) validateStatusCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("status_code", "body", m.StatusCode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this audit event based on context it is used
+func (m *AuditEvent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AuditEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AuditEvent) UnmarshalBinary(b []byte) error {
+	var res AuditEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
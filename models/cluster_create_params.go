@@ -61,12 +61,12 @@ type ClusterCreateParams struct {
 	// A proxy URL to use for creating HTTP connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
-	HTTPProxy *string `json:"http_proxy,omitempty"`
+	HTTPProxy *string `json:"http_proxy,omitempty" secret:"true"`
 
 	// A proxy URL to use for creating HTTPS connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
-	HTTPSProxy *string `json:"https_proxy,omitempty"`
+	HTTPSProxy *string `json:"https_proxy,omitempty" secret:"true"`
 
 	// Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.
 	// Enum: [none masters arbiters workers masters,arbiters masters,workers arbiters,workers masters,arbiters,workers all]
@@ -84,6 +84,9 @@ type ClusterCreateParams struct {
 	// Machine networks that are associated with this cluster.
 	MachineNetworks []*MachineNetwork `json:"machine_networks"`
 
+	// mirror registry configuration
+	MirrorRegistryConfiguration *MirrorRegistryConfigurationParams `json:"mirror_registry_configuration,omitempty"`
+
 	// Name of the OpenShift cluster.
 	// Required: true
 	// Max Length: 54
@@ -114,7 +117,7 @@ type ClusterCreateParams struct {
 
 	// The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.
 	// Required: true
-	PullSecret *string `json:"pull_secret"`
+	PullSecret *string `json:"pull_secret" secret:"true"`
 
 	// Schedule workloads on masters
 	SchedulableMasters *bool `json:"schedulable_masters,omitempty"`
@@ -191,6 +194,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMirrorRegistryConfiguration(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -577,6 +584,25 @@ func (m *ClusterCreateParams) validateMachineNetworks(formats strfmt.Registry) e
 	return nil
 }
 
+func (m *ClusterCreateParams) validateMirrorRegistryConfiguration(formats strfmt.Registry) error {
+	if swag.IsZero(m.MirrorRegistryConfiguration) { // not required
+		return nil
+	}
+
+	if m.MirrorRegistryConfiguration != nil {
+		if err := m.MirrorRegistryConfiguration.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mirror_registry_configuration")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("mirror_registry_configuration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -769,6 +795,10 @@ func (m *ClusterCreateParams) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMirrorRegistryConfiguration(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateOlmOperators(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -915,6 +945,22 @@ func (m *ClusterCreateParams) contextValidateMachineNetworks(ctx context.Context
 	return nil
 }
 
+func (m *ClusterCreateParams) contextValidateMirrorRegistryConfiguration(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MirrorRegistryConfiguration != nil {
+		if err := m.MirrorRegistryConfiguration.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mirror_registry_configuration")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("mirror_registry_configuration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) contextValidateOlmOperators(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.OlmOperators); i++ {
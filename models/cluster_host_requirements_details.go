@@ -29,6 +29,9 @@ type ClusterHostRequirementsDetails struct {
 	// Maximum network average latency (RTT) at L3 for role.
 	NetworkLatencyThresholdMs *float64 `json:"network_latency_threshold_ms,omitempty"`
 
+	// Minimum network throughput in Mbps between the hosts of the role.
+	NetworkThroughputMbps *float64 `json:"network_throughput_mbps,omitempty"`
+
 	// Maximum packet loss allowed at L3 for role.
 	PacketLossPercentage *float64 `json:"packet_loss_percentage,omitempty"`
 
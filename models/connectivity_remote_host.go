@@ -32,6 +32,11 @@ type ConnectivityRemoteHost struct {
 
 	// mtu report
 	MtuReport []*MtuReport `json:"mtu_report"`
+
+	// Network throughput in Mbps measured between the hosts by the network-throughput-check step, not set until
+	// it is measured.
+	//
+	ThroughputMbps *float64 `json:"throughput_mbps,omitempty"`
 }
 
 // Validate validates this connectivity remote host
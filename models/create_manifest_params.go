@@ -22,7 +22,7 @@ type CreateManifestParams struct {
 
 	// base64 encoded manifest content.
 	// Required: true
-	Content *string `json:"content"`
+	Content *string `json:"content" secret:"true"`
 
 	// The name of the manifest to customize the installed OCP cluster.
 	// Required: true
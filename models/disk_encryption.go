@@ -24,13 +24,22 @@ type DiskEncryption struct {
 	// Enum: [none masters arbiters workers masters,arbiters masters,workers arbiters,workers masters,arbiters,workers all]
 	EnableOn *string `json:"enable_on,omitempty"`
 
-	// The disk encryption mode to use.
-	// Enum: [tpmv2 tang]
+	// The disk encryption mode to use. The threshold mode combines the TPM and the Tang servers with Shamir Secret
+	// Sharing, according to threshold_spec.
+	//
+	// Enum: [tpmv2 tang threshold]
 	Mode *string `json:"mode,omitempty"`
 
 	// JSON-formatted string containing additional information regarding tang's configuration
 	// Example: [{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]
 	TangServers string `json:"tang_servers,omitempty" gorm:"type:text"`
+
+	// JSON-formatted string containing the unlock policy of the threshold mode. The disk is unlocked when threshold
+	// of its pins are available: the TPM, if tpm2 is set, and the Tang servers, which are available when
+	// tang_threshold of them are reachable.
+	//
+	// Example: {\"threshold\":2,\"tpm2\":true,\"tang_threshold\":1}
+	ThresholdSpec string `json:"threshold_spec,omitempty" gorm:"type:text"`
 }
 
 // Validate validates this disk encryption
@@ -118,7 +127,7 @@ var diskEncryptionTypeModePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["tpmv2","tang"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["tpmv2","tang","threshold"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -133,6 +142,9 @@ const (
 
 	// DiskEncryptionModeTang captures enum value "tang"
 	DiskEncryptionModeTang string = "tang"
+
+	// DiskEncryptionModeThreshold captures enum value "threshold"
+	DiskEncryptionModeThreshold string = "threshold"
 )
 
 // prop value enum
@@ -30,7 +30,7 @@ type FencingCredentialsParams struct {
 
 	// The password to connect to the host's BMC.
 	// Required: true
-	Password *string `json:"password"`
+	Password *string `json:"password" secret:"true"`
 
 	// The username to connect to the host's BMC.
 	// Required: true
@@ -26,6 +26,12 @@ type Host struct {
 	// Contains a serialized api_vip_connectivity_response
 	APIVipConnectivity string `json:"api_vip_connectivity,omitempty" gorm:"type:text"`
 
+	// The address of the BMC of the host, set with its BMC credentials.
+	BmcAddress string `json:"bmc_address,omitempty"`
+
+	// True if the credentials of the BMC of the host are set.
+	BmcCredentialsSet bool `json:"bmc_credentials_set,omitempty"`
+
 	// bootstrap
 	Bootstrap bool `json:"bootstrap,omitempty"`
 
@@ -47,6 +53,9 @@ type Host struct {
 	// Format: date-time
 	CreatedAt timeext.Time `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// Contains a serialized list of custom_diagnostic_result
+	CustomDiagnostics string `json:"custom_diagnostics,omitempty" gorm:"type:text"`
+
 	// swagger:ignore
 	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"type:timestamp with time zone;index"`
 
@@ -136,6 +145,9 @@ type Host struct {
 	// Enum: [connected disconnected]
 	MediaStatus *string `json:"media_status,omitempty"`
 
+	// Contains a serialized mirror_registry_check_response
+	MirrorRegistryCheck string `json:"mirror_registry_check,omitempty" gorm:"type:text"`
+
 	// Json containing node's labels.
 	NodeLabels string `json:"node_labels,omitempty" gorm:"type:text"`
 
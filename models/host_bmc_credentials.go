@@ -0,0 +1,123 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostBmcCredentials host bmc credentials
+//
+// swagger:model host-bmc-credentials
+type HostBmcCredentials struct {
+
+	// The address of the BMC, e.g. redfish+https://192.0.2.10/redfish/v1/Systems/1. Only Redfish BMCs are supported, IPMI addresses (ipmi://) are rejected, use the Redfish address of the BMC instead. The redfish scheme uses https. When the path of a Redfish address doesn't point to a system, the first system of the BMC is used.
+	// Required: true
+	Address *string `json:"address"`
+
+	// Whether the certificate of a BMC reached with https is verified.
+	DisableCertificateVerification *bool `json:"disable_certificate_verification,omitempty"`
+
+	// password
+	// Required: true
+	// Min Length: 1
+	// Format: password
+	Password *strfmt.Password `json:"password" secret:"true"`
+
+	// username
+	// Required: true
+	// Min Length: 1
+	Username *string `json:"username"`
+}
+
+// Validate validates this host bmc credentials
+func (m *HostBmcCredentials) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePassword(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUsername(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostBmcCredentials) validateAddress(formats strfmt.Registry) error {
+
+	if err := validate.Required("address", "body", m.Address); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostBmcCredentials) validatePassword(formats strfmt.Registry) error {
+
+	if err := validate.Required("password", "body", m.Password); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("password", "body", m.Password.String(), 1); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("password", "body", "password", m.Password.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostBmcCredentials) validateUsername(formats strfmt.Registry) error {
+
+	if err := validate.Required("username", "body", m.Username); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("username", "body", *m.Username, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this host bmc credentials based on context it is used
+func (m *HostBmcCredentials) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostBmcCredentials) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostBmcCredentials) UnmarshalBinary(b []byte) error {
+	var res HostBmcCredentials
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -18,7 +18,7 @@ import (
 type HostIgnitionParams struct {
 
 	// config
-	Config string `json:"config,omitempty"`
+	Config string `json:"config,omitempty" secret:"true"`
 }
 
 // Validate validates this host ignition params
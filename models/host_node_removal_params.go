@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostNodeRemovalParams host node removal params
+//
+// swagger:model host-node-removal-params
+type HostNodeRemovalParams struct {
+
+	// How long to wait for the node to drain, in seconds, before removing it anyway. Defaults to 10 minutes.
+	// Minimum: 0
+	DrainTimeout *int64 `json:"drain_timeout,omitempty"`
+}
+
+// Validate validates this host node removal params
+func (m *HostNodeRemovalParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDrainTimeout(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostNodeRemovalParams) validateDrainTimeout(formats strfmt.Registry) error {
+	if swag.IsZero(m.DrainTimeout) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("drain_timeout", "body", *m.DrainTimeout, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this host node removal params based on context it is used
+func (m *HostNodeRemovalParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostNodeRemovalParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostNodeRemovalParams) UnmarshalBinary(b []byte) error {
+	var res HostNodeRemovalParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
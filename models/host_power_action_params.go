@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostPowerActionParams host power action params
+//
+// swagger:model host-power-action-params
+type HostPowerActionParams struct {
+
+	// The power action. boot-from-iso mounts the discovery ISO of the infra-env of the host as virtual media, sets it as the next boot device and powers the host on, or restarts it when it is already on.
+	// Required: true
+	// Enum: [power-on power-off reboot boot-from-iso]
+	Action *string `json:"action"`
+}
+
+// Validate validates this host power action params
+func (m *HostPowerActionParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var hostPowerActionParamsTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["power-on","power-off","reboot","boot-from-iso"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hostPowerActionParamsTypeActionPropEnum = append(hostPowerActionParamsTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// HostPowerActionParamsActionPowerOn captures enum value "power-on"
+	HostPowerActionParamsActionPowerOn string = "power-on"
+
+	// HostPowerActionParamsActionPowerOff captures enum value "power-off"
+	HostPowerActionParamsActionPowerOff string = "power-off"
+
+	// HostPowerActionParamsActionReboot captures enum value "reboot"
+	HostPowerActionParamsActionReboot string = "reboot"
+
+	// HostPowerActionParamsActionBootFromIso captures enum value "boot-from-iso"
+	HostPowerActionParamsActionBootFromIso string = "boot-from-iso"
+)
+
+// prop value enum
+func (m *HostPowerActionParams) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, hostPowerActionParamsTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HostPowerActionParams) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", *m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this host power action params based on context it is used
+func (m *HostPowerActionParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostPowerActionParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostPowerActionParams) UnmarshalBinary(b []byte) error {
+	var res HostPowerActionParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,179 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// HostStaticNetworkConfigGenerateParams host static network config generate params
+//
+// swagger:model host-static-network-config-generate-params
+type HostStaticNetworkConfigGenerateParams struct {
+
+	// Whether to replace the static network configuration of the host in its infra-env with the generated one.
+	Apply *bool `json:"apply,omitempty"`
+
+	// Bonds to create from the physical interfaces of the host.
+	Bonds []*StaticNetworkConfigBond `json:"bonds"`
+
+	// DNS servers of the host, the discovered inventory doesn't contain them.
+	DNSServers []string `json:"dns_servers"`
+
+	// VLANs to create on the physical interfaces or bonds of the host.
+	Vlans []*StaticNetworkConfigVlan `json:"vlans"`
+}
+
+// Validate validates this host static network config generate params
+func (m *HostStaticNetworkConfigGenerateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBonds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVlans(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostStaticNetworkConfigGenerateParams) validateBonds(formats strfmt.Registry) error {
+	if swag.IsZero(m.Bonds) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Bonds); i++ {
+		if swag.IsZero(m.Bonds[i]) { // not required
+			continue
+		}
+
+		if m.Bonds[i] != nil {
+			if err := m.Bonds[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("bonds" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("bonds" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostStaticNetworkConfigGenerateParams) validateVlans(formats strfmt.Registry) error {
+	if swag.IsZero(m.Vlans) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Vlans); i++ {
+		if swag.IsZero(m.Vlans[i]) { // not required
+			continue
+		}
+
+		if m.Vlans[i] != nil {
+			if err := m.Vlans[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vlans" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vlans" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this host static network config generate params based on the context it is used
+func (m *HostStaticNetworkConfigGenerateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateBonds(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVlans(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostStaticNetworkConfigGenerateParams) contextValidateBonds(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Bonds); i++ {
+
+		if m.Bonds[i] != nil {
+			if err := m.Bonds[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("bonds" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("bonds" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostStaticNetworkConfigGenerateParams) contextValidateVlans(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Vlans); i++ {
+
+		if m.Vlans[i] != nil {
+			if err := m.Vlans[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vlans" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vlans" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostStaticNetworkConfigGenerateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostStaticNetworkConfigGenerateParams) UnmarshalBinary(b []byte) error {
+	var res HostStaticNetworkConfigGenerateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -41,7 +41,7 @@ type HostUpdateParams struct {
 	IgnitionEndpointHTTPHeaders []*IgnitionEndpointHTTPHeadersParams `json:"ignition_endpoint_http_headers"`
 
 	// A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.
-	IgnitionEndpointToken *string `json:"ignition_endpoint_token,omitempty"`
+	IgnitionEndpointToken *string `json:"ignition_endpoint_token,omitempty" secret:"true"`
 
 	// machine config pool name
 	MachineConfigPoolName *string `json:"machine_config_pool_name,omitempty"`
@@ -40,7 +40,7 @@ type InfraEnvCreateParams struct {
 	CPUArchitecture string `json:"cpu_architecture,omitempty"`
 
 	// JSON formatted string containing the user overrides for the initial ignition config.
-	IgnitionConfigOverride string `json:"ignition_config_override,omitempty"`
+	IgnitionConfigOverride string `json:"ignition_config_override,omitempty" secret:"true"`
 
 	// image type
 	ImageType ImageType `json:"image_type,omitempty"`
@@ -48,6 +48,9 @@ type InfraEnvCreateParams struct {
 	// kernel arguments
 	KernelArguments KernelArguments `json:"kernel_arguments"`
 
+	// mirror registry configuration
+	MirrorRegistryConfiguration *MirrorRegistryConfigurationParams `json:"mirror_registry_configuration,omitempty"`
+
 	// Name of the infra-env.
 	// Required: true
 	Name *string `json:"name"`
@@ -60,7 +63,7 @@ type InfraEnvCreateParams struct {
 
 	// The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.
 	// Required: true
-	PullSecret *string `json:"pull_secret"`
+	PullSecret *string `json:"pull_secret" secret:"true"`
 
 	// The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.
 	// This is optional for disconnected-iso image type and specifies which host will run the assisted service
@@ -99,6 +102,10 @@ func (m *InfraEnvCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMirrorRegistryConfiguration(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -234,6 +241,25 @@ func (m *InfraEnvCreateParams) validateKernelArguments(formats strfmt.Registry)
 	return nil
 }
 
+func (m *InfraEnvCreateParams) validateMirrorRegistryConfiguration(formats strfmt.Registry) error {
+	if swag.IsZero(m.MirrorRegistryConfiguration) { // not required
+		return nil
+	}
+
+	if m.MirrorRegistryConfiguration != nil {
+		if err := m.MirrorRegistryConfiguration.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mirror_registry_configuration")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("mirror_registry_configuration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvCreateParams) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -321,6 +347,10 @@ func (m *InfraEnvCreateParams) ContextValidate(ctx context.Context, formats strf
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMirrorRegistryConfiguration(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProxy(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -363,6 +393,22 @@ func (m *InfraEnvCreateParams) contextValidateKernelArguments(ctx context.Contex
 	return nil
 }
 
+func (m *InfraEnvCreateParams) contextValidateMirrorRegistryConfiguration(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MirrorRegistryConfiguration != nil {
+		if err := m.MirrorRegistryConfiguration.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mirror_registry_configuration")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("mirror_registry_configuration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvCreateParams) contextValidateProxy(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Proxy != nil {
@@ -28,7 +28,7 @@ type InfraEnvUpdateParams struct {
 	AdditionalTrustBundle *string `json:"additional_trust_bundle,omitempty"`
 
 	// JSON formatted string containing the user overrides for the initial ignition config.
-	IgnitionConfigOverride string `json:"ignition_config_override,omitempty"`
+	IgnitionConfigOverride string `json:"ignition_config_override,omitempty" secret:"true"`
 
 	// image type
 	ImageType ImageType `json:"image_type,omitempty"`
@@ -36,6 +36,9 @@ type InfraEnvUpdateParams struct {
 	// kernel arguments
 	KernelArguments KernelArguments `json:"kernel_arguments"`
 
+	// mirror registry configuration
+	MirrorRegistryConfiguration *MirrorRegistryConfigurationParams `json:"mirror_registry_configuration,omitempty"`
+
 	// Version of the OS image
 	OpenshiftVersion *string `json:"openshift_version,omitempty"`
 
@@ -43,7 +46,7 @@ type InfraEnvUpdateParams struct {
 	Proxy *Proxy `json:"proxy,omitempty" gorm:"embedded;embeddedPrefix:proxy_"`
 
 	// The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.
-	PullSecret string `json:"pull_secret,omitempty"`
+	PullSecret string `json:"pull_secret,omitempty" secret:"true"`
 
 	// The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.
 	// This is optional for disconnected-iso image type and specifies which host will run the assisted service
@@ -74,6 +77,10 @@ func (m *InfraEnvUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMirrorRegistryConfiguration(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProxy(formats); err != nil {
 		res = append(res, err)
 	}
@@ -138,6 +145,25 @@ func (m *InfraEnvUpdateParams) validateKernelArguments(formats strfmt.Registry)
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) validateMirrorRegistryConfiguration(formats strfmt.Registry) error {
+	if swag.IsZero(m.MirrorRegistryConfiguration) { // not required
+		return nil
+	}
+
+	if m.MirrorRegistryConfiguration != nil {
+		if err := m.MirrorRegistryConfiguration.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mirror_registry_configuration")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("mirror_registry_configuration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvUpdateParams) validateProxy(formats strfmt.Registry) error {
 	if swag.IsZero(m.Proxy) { // not required
 		return nil
@@ -207,6 +233,10 @@ func (m *InfraEnvUpdateParams) ContextValidate(ctx context.Context, formats strf
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMirrorRegistryConfiguration(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProxy(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -249,6 +279,22 @@ func (m *InfraEnvUpdateParams) contextValidateKernelArguments(ctx context.Contex
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) contextValidateMirrorRegistryConfiguration(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MirrorRegistryConfiguration != nil {
+		if err := m.MirrorRegistryConfiguration.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mirror_registry_configuration")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("mirror_registry_configuration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvUpdateParams) contextValidateProxy(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Proxy != nil {
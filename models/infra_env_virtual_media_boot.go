@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InfraEnvVirtualMediaBoot infra env virtual media boot
+//
+// swagger:model infra-env-virtual-media-boot
+type InfraEnvVirtualMediaBoot struct {
+
+	// The status of the boot of each BMC.
+	Bmcs []*VirtualMediaBootBmcStatus `json:"bmcs"`
+
+	// The time the virtual media boot was requested.
+	// Format: date-time
+	StartedAt strfmt.DateTime `json:"started_at,omitempty"`
+}
+
+// Validate validates this infra env virtual media boot
+func (m *InfraEnvVirtualMediaBoot) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBmcs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InfraEnvVirtualMediaBoot) validateBmcs(formats strfmt.Registry) error {
+	if swag.IsZero(m.Bmcs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Bmcs); i++ {
+		if swag.IsZero(m.Bmcs[i]) { // not required
+			continue
+		}
+
+		if m.Bmcs[i] != nil {
+			if err := m.Bmcs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("bmcs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("bmcs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InfraEnvVirtualMediaBoot) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("started_at", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this infra env virtual media boot based on the context it is used
+func (m *InfraEnvVirtualMediaBoot) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateBmcs(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InfraEnvVirtualMediaBoot) contextValidateBmcs(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Bmcs); i++ {
+
+		if m.Bmcs[i] != nil {
+			if err := m.Bmcs[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("bmcs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("bmcs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InfraEnvVirtualMediaBoot) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InfraEnvVirtualMediaBoot) UnmarshalBinary(b []byte) error {
+	var res InfraEnvVirtualMediaBoot
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
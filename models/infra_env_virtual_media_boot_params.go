@@ -0,0 +1,131 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InfraEnvVirtualMediaBootParams infra env virtual media boot params
+//
+// swagger:model infra-env-virtual-media-boot-params
+type InfraEnvVirtualMediaBootParams struct {
+
+	// The BMCs of the servers to boot from the discovery ISO. Only Redfish BMCs are supported.
+	// Required: true
+	// Max Items: 100
+	// Min Items: 1
+	Bmcs []*HostBmcCredentials `json:"bmcs"`
+}
+
+// Validate validates this infra env virtual media boot params
+func (m *InfraEnvVirtualMediaBootParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBmcs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InfraEnvVirtualMediaBootParams) validateBmcs(formats strfmt.Registry) error {
+
+	if err := validate.Required("bmcs", "body", m.Bmcs); err != nil {
+		return err
+	}
+
+	iBmcsSize := int64(len(m.Bmcs))
+
+	if err := validate.MinItems("bmcs", "body", iBmcsSize, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxItems("bmcs", "body", iBmcsSize, 100); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Bmcs); i++ {
+		if swag.IsZero(m.Bmcs[i]) { // not required
+			continue
+		}
+
+		if m.Bmcs[i] != nil {
+			if err := m.Bmcs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("bmcs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("bmcs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this infra env virtual media boot params based on the context it is used
+func (m *InfraEnvVirtualMediaBootParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateBmcs(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InfraEnvVirtualMediaBootParams) contextValidateBmcs(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Bmcs); i++ {
+
+		if m.Bmcs[i] != nil {
+			if err := m.Bmcs[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("bmcs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("bmcs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InfraEnvVirtualMediaBootParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InfraEnvVirtualMediaBootParams) UnmarshalBinary(b []byte) error {
+	var res InfraEnvVirtualMediaBootParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -50,6 +50,9 @@ type Interface struct {
 	// speed mbps
 	SpeedMbps int64 `json:"speed_mbps,omitempty"`
 
+	// The number of SR-IOV virtual functions that the interface supports, 0 if it doesn't support SR-IOV.
+	SriovTotalVfs int64 `json:"sriov_total_vfs,omitempty"`
+
 	// type
 	Type string `json:"type,omitempty"`
 
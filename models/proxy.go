@@ -20,12 +20,12 @@ type Proxy struct {
 	// A proxy URL to use for creating HTTP connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
-	HTTPProxy *string `json:"http_proxy,omitempty"`
+	HTTPProxy *string `json:"http_proxy,omitempty" secret:"true"`
 
 	// A proxy URL to use for creating HTTPS connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
-	HTTPSProxy *string `json:"https_proxy,omitempty" gorm:"column:https_proxy"`
+	HTTPSProxy *string `json:"https_proxy,omitempty" gorm:"column:https_proxy" secret:"true"`
 
 	// An "*" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.
 	NoProxy *string `json:"no_proxy,omitempty"`
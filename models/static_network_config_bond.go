@@ -0,0 +1,166 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StaticNetworkConfigBond static network config bond
+//
+// swagger:model static-network-config-bond
+type StaticNetworkConfigBond struct {
+
+	// Bonding mode of the bond.
+	// Enum: [balance-rr active-backup balance-xor broadcast 802.3ad balance-tlb balance-alb]
+	Mode *string `json:"mode,omitempty"`
+
+	// Name of the bond interface.
+	// Required: true
+	// Pattern: ^bond[0-9]+$
+	Name *string `json:"name"`
+
+	// Names of the physical interfaces of the bond, as discovered in the host inventory.
+	// Required: true
+	// Min Items: 1
+	Ports []string `json:"ports"`
+}
+
+// Validate validates this static network config bond
+func (m *StaticNetworkConfigBond) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePorts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var staticNetworkConfigBondTypeModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["balance-rr","active-backup","balance-xor","broadcast","802.3ad","balance-tlb","balance-alb"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		staticNetworkConfigBondTypeModePropEnum = append(staticNetworkConfigBondTypeModePropEnum, v)
+	}
+}
+
+const (
+
+	// StaticNetworkConfigBondModeBalanceRr captures enum value "balance-rr"
+	StaticNetworkConfigBondModeBalanceRr string = "balance-rr"
+
+	// StaticNetworkConfigBondModeActiveBackup captures enum value "active-backup"
+	StaticNetworkConfigBondModeActiveBackup string = "active-backup"
+
+	// StaticNetworkConfigBondModeBalanceXor captures enum value "balance-xor"
+	StaticNetworkConfigBondModeBalanceXor string = "balance-xor"
+
+	// StaticNetworkConfigBondModeBroadcast captures enum value "broadcast"
+	StaticNetworkConfigBondModeBroadcast string = "broadcast"
+
+	// StaticNetworkConfigBondModeNr8023ad captures enum value "802.3ad"
+	StaticNetworkConfigBondModeNr8023ad string = "802.3ad"
+
+	// StaticNetworkConfigBondModeBalanceTlb captures enum value "balance-tlb"
+	StaticNetworkConfigBondModeBalanceTlb string = "balance-tlb"
+
+	// StaticNetworkConfigBondModeBalanceAlb captures enum value "balance-alb"
+	StaticNetworkConfigBondModeBalanceAlb string = "balance-alb"
+)
+
+// prop value enum
+func (m *StaticNetworkConfigBond) validateModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, staticNetworkConfigBondTypeModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigBond) validateMode(formats strfmt.Registry) error {
+	if swag.IsZero(m.Mode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateModeEnum("mode", "body", *m.Mode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StaticNetworkConfigBond) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("name", "body", *m.Name, `^bond[0-9]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StaticNetworkConfigBond) validatePorts(formats strfmt.Registry) error {
+
+	if err := validate.Required("ports", "body", m.Ports); err != nil {
+		return err
+	}
+
+	iPortsSize := int64(len(m.Ports))
+
+	if err := validate.MinItems("ports", "body", iPortsSize, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this static network config bond based on context it is used
+func (m *StaticNetworkConfigBond) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaticNetworkConfigBond) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaticNetworkConfigBond) UnmarshalBinary(b []byte) error {
+	var res StaticNetworkConfigBond
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
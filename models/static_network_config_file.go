@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// StaticNetworkConfigFile static network config file
+//
+// swagger:model static-network-config-file
+type StaticNetworkConfigFile struct {
+
+	// The contents of the file.
+	Contents string `json:"contents,omitempty"`
+
+	// The path of the file, relative to the directory of the host.
+	Path string `json:"path,omitempty"`
+}
+
+// Validate validates this static network config file
+func (m *StaticNetworkConfigFile) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this static network config file based on context it is used
+func (m *StaticNetworkConfigFile) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaticNetworkConfigFile) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaticNetworkConfigFile) UnmarshalBinary(b []byte) error {
+	var res StaticNetworkConfigFile
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
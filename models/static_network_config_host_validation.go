@@ -0,0 +1,208 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StaticNetworkConfigHostValidation static network config host validation
+//
+// swagger:model static-network-config-host-validation
+type StaticNetworkConfigHostValidation struct {
+
+	// The errors found in the configuration of the host.
+	Errors []*StaticNetworkConfigValidationError `json:"errors"`
+
+	// The index of the host in the static network configuration.
+	// Required: true
+	HostIndex *int64 `json:"host_index"`
+
+	// The NetworkManager keyfiles generated by nmstate for the host, when its configuration is valid.
+	NmconnectionFiles []*StaticNetworkConfigFile `json:"nmconnection_files"`
+
+	// Whether the configuration of the host is valid.
+	// Required: true
+	Valid *bool `json:"valid"`
+}
+
+// Validate validates this static network config host validation
+func (m *StaticNetworkConfigHostValidation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateErrors(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostIndex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNmconnectionFiles(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValid(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigHostValidation) validateErrors(formats strfmt.Registry) error {
+	if swag.IsZero(m.Errors) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Errors); i++ {
+		if swag.IsZero(m.Errors[i]) { // not required
+			continue
+		}
+
+		if m.Errors[i] != nil {
+			if err := m.Errors[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("errors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("errors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StaticNetworkConfigHostValidation) validateHostIndex(formats strfmt.Registry) error {
+
+	if err := validate.Required("host_index", "body", m.HostIndex); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StaticNetworkConfigHostValidation) validateNmconnectionFiles(formats strfmt.Registry) error {
+	if swag.IsZero(m.NmconnectionFiles) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NmconnectionFiles); i++ {
+		if swag.IsZero(m.NmconnectionFiles[i]) { // not required
+			continue
+		}
+
+		if m.NmconnectionFiles[i] != nil {
+			if err := m.NmconnectionFiles[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nmconnection_files" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nmconnection_files" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StaticNetworkConfigHostValidation) validateValid(formats strfmt.Registry) error {
+
+	if err := validate.Required("valid", "body", m.Valid); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this static network config host validation based on the context it is used
+func (m *StaticNetworkConfigHostValidation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateErrors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateNmconnectionFiles(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigHostValidation) contextValidateErrors(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Errors); i++ {
+
+		if m.Errors[i] != nil {
+			if err := m.Errors[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("errors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("errors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StaticNetworkConfigHostValidation) contextValidateNmconnectionFiles(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.NmconnectionFiles); i++ {
+
+		if m.NmconnectionFiles[i] != nil {
+			if err := m.NmconnectionFiles[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nmconnection_files" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nmconnection_files" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaticNetworkConfigHostValidation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaticNetworkConfigHostValidation) UnmarshalBinary(b []byte) error {
+	var res StaticNetworkConfigHostValidation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StaticNetworkConfigValidation static network config validation
+//
+// swagger:model static-network-config-validation
+type StaticNetworkConfigValidation struct {
+
+	// The validation of each host, in the order of the static network configuration.
+	// Required: true
+	Hosts []*StaticNetworkConfigHostValidation `json:"hosts"`
+
+	// Whether the configuration of all the hosts is valid.
+	// Required: true
+	Valid *bool `json:"valid"`
+}
+
+// Validate validates this static network config validation
+func (m *StaticNetworkConfigValidation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHosts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValid(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigValidation) validateHosts(formats strfmt.Registry) error {
+
+	if err := validate.Required("hosts", "body", m.Hosts); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Hosts); i++ {
+		if swag.IsZero(m.Hosts[i]) { // not required
+			continue
+		}
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StaticNetworkConfigValidation) validateValid(formats strfmt.Registry) error {
+
+	if err := validate.Required("valid", "body", m.Valid); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this static network config validation based on the context it is used
+func (m *StaticNetworkConfigValidation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateHosts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigValidation) contextValidateHosts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Hosts); i++ {
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaticNetworkConfigValidation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaticNetworkConfigValidation) UnmarshalBinary(b []byte) error {
+	var res StaticNetworkConfigValidation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,142 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StaticNetworkConfigValidationError static network config validation error
+//
+// swagger:model static-network-config-validation-error
+type StaticNetworkConfigValidationError struct {
+
+	// The kind of the error.
+	// Required: true
+	// Enum: [invalid-yaml missing-mac-mapping duplicate-mac-mapping duplicate-ip ip-outside-subnet invalid-route nmstate-error]
+	Code *string `json:"code"`
+
+	// The interface of the error, empty for errors of the whole host.
+	Interface string `json:"interface,omitempty"`
+
+	// The description of the error.
+	// Required: true
+	Message *string `json:"message"`
+}
+
+// Validate validates this static network config validation error
+func (m *StaticNetworkConfigValidationError) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMessage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var staticNetworkConfigValidationErrorTypeCodePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["invalid-yaml","missing-mac-mapping","duplicate-mac-mapping","duplicate-ip","ip-outside-subnet","invalid-route","nmstate-error"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		staticNetworkConfigValidationErrorTypeCodePropEnum = append(staticNetworkConfigValidationErrorTypeCodePropEnum, v)
+	}
+}
+
+const (
+
+	// StaticNetworkConfigValidationErrorCodeInvalidYaml captures enum value "invalid-yaml"
+	StaticNetworkConfigValidationErrorCodeInvalidYaml string = "invalid-yaml"
+
+	// StaticNetworkConfigValidationErrorCodeMissingMacMapping captures enum value "missing-mac-mapping"
+	StaticNetworkConfigValidationErrorCodeMissingMacMapping string = "missing-mac-mapping"
+
+	// StaticNetworkConfigValidationErrorCodeDuplicateMacMapping captures enum value "duplicate-mac-mapping"
+	StaticNetworkConfigValidationErrorCodeDuplicateMacMapping string = "duplicate-mac-mapping"
+
+	// StaticNetworkConfigValidationErrorCodeDuplicateIP captures enum value "duplicate-ip"
+	StaticNetworkConfigValidationErrorCodeDuplicateIP string = "duplicate-ip"
+
+	// StaticNetworkConfigValidationErrorCodeIPOutsideSubnet captures enum value "ip-outside-subnet"
+	StaticNetworkConfigValidationErrorCodeIPOutsideSubnet string = "ip-outside-subnet"
+
+	// StaticNetworkConfigValidationErrorCodeInvalidRoute captures enum value "invalid-route"
+	StaticNetworkConfigValidationErrorCodeInvalidRoute string = "invalid-route"
+
+	// StaticNetworkConfigValidationErrorCodeNmstateError captures enum value "nmstate-error"
+	StaticNetworkConfigValidationErrorCodeNmstateError string = "nmstate-error"
+)
+
+// prop value enum
+func (m *StaticNetworkConfigValidationError) validateCodeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, staticNetworkConfigValidationErrorTypeCodePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigValidationError) validateCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("code", "body", m.Code); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateCodeEnum("code", "body", *m.Code); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StaticNetworkConfigValidationError) validateMessage(formats strfmt.Registry) error {
+
+	if err := validate.Required("message", "body", m.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this static network config validation error based on context it is used
+func (m *StaticNetworkConfigValidationError) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaticNetworkConfigValidationError) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaticNetworkConfigValidationError) UnmarshalBinary(b []byte) error {
+	var res StaticNetworkConfigValidationError
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StaticNetworkConfigValidationParams static network config validation params
+//
+// swagger:model static-network-config-validation-params
+type StaticNetworkConfigValidationParams struct {
+
+	// The subnets the static addresses of the hosts are expected in, usually the machine networks of the cluster. The addresses of a family without expected subnets aren't checked.
+	ExpectedSubnets []Subnet `json:"expected_subnets"`
+
+	// The static network configuration of the hosts, as set in the infra-env.
+	// Required: true
+	StaticNetworkConfig []*HostStaticNetworkConfig `json:"static_network_config"`
+}
+
+// Validate validates this static network config validation params
+func (m *StaticNetworkConfigValidationParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateExpectedSubnets(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStaticNetworkConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigValidationParams) validateExpectedSubnets(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExpectedSubnets) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ExpectedSubnets); i++ {
+
+		if err := m.ExpectedSubnets[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("expected_subnets" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("expected_subnets" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StaticNetworkConfigValidationParams) validateStaticNetworkConfig(formats strfmt.Registry) error {
+
+	if err := validate.Required("static_network_config", "body", m.StaticNetworkConfig); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.StaticNetworkConfig); i++ {
+		if swag.IsZero(m.StaticNetworkConfig[i]) { // not required
+			continue
+		}
+
+		if m.StaticNetworkConfig[i] != nil {
+			if err := m.StaticNetworkConfig[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("static_network_config" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("static_network_config" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this static network config validation params based on the context it is used
+func (m *StaticNetworkConfigValidationParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateExpectedSubnets(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStaticNetworkConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigValidationParams) contextValidateExpectedSubnets(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ExpectedSubnets); i++ {
+
+		if err := m.ExpectedSubnets[i].ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("expected_subnets" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("expected_subnets" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StaticNetworkConfigValidationParams) contextValidateStaticNetworkConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.StaticNetworkConfig); i++ {
+
+		if m.StaticNetworkConfig[i] != nil {
+			if err := m.StaticNetworkConfig[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("static_network_config" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("static_network_config" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaticNetworkConfigValidationParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaticNetworkConfigValidationParams) UnmarshalBinary(b []byte) error {
+	var res StaticNetworkConfigValidationParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StaticNetworkConfigVlan static network config vlan
+//
+// swagger:model static-network-config-vlan
+type StaticNetworkConfigVlan struct {
+
+	// Name of the physical interface or the bond that carries the VLAN.
+	// Required: true
+	BaseInterface *string `json:"base_interface"`
+
+	// The VLAN ID.
+	// Required: true
+	// Maximum: 4094
+	// Minimum: 1
+	ID *int64 `json:"id"`
+}
+
+// Validate validates this static network config vlan
+func (m *StaticNetworkConfigVlan) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBaseInterface(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigVlan) validateBaseInterface(formats strfmt.Registry) error {
+
+	if err := validate.Required("base_interface", "body", m.BaseInterface); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StaticNetworkConfigVlan) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("id", "body", *m.ID, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("id", "body", *m.ID, 4094, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this static network config vlan based on context it is used
+func (m *StaticNetworkConfigVlan) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaticNetworkConfigVlan) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaticNetworkConfigVlan) UnmarshalBinary(b []byte) error {
+	var res StaticNetworkConfigVlan
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -86,6 +86,12 @@ const (
 
 	// StepTypeVerifyVips captures enum value "verify-vips"
 	StepTypeVerifyVips StepType = "verify-vips"
+
+	// StepTypeMirrorRegistryCheck captures enum value "mirror-registry-check"
+	StepTypeMirrorRegistryCheck StepType = "mirror-registry-check"
+
+	// StepTypeNetworkThroughputCheck captures enum value "network-throughput-check"
+	StepTypeNetworkThroughputCheck StepType = "network-throughput-check"
 )
 
 // for schema
@@ -93,7 +99,7 @@ var stepTypeEnum []interface{}
 
 func init() {
 	var res []StepType
-	if err := json.Unmarshal([]byte(`["connectivity-check","execute","inventory","install","free-network-addresses","dhcp-lease-allocate","api-vip-connectivity-check","tang-connectivity-check","ntp-synchronizer","installation-disk-speed-check","container-image-availability","domain-resolution","stop-installation","logs-gather","next-step-runner","upgrade-agent","download-boot-artifacts","reboot-for-reclaim","verify-vips"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["connectivity-check","execute","inventory","install","free-network-addresses","dhcp-lease-allocate","api-vip-connectivity-check","tang-connectivity-check","ntp-synchronizer","installation-disk-speed-check","container-image-availability","domain-resolution","stop-installation","logs-gather","next-step-runner","upgrade-agent","download-boot-artifacts","reboot-for-reclaim","verify-vips","mirror-registry-check","network-throughput-check"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
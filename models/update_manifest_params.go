@@ -31,7 +31,7 @@ type UpdateManifestParams struct {
 	Folder string `json:"folder"`
 
 	// The new base64 encoded manifest content.
-	UpdatedContent *string `json:"updated_content,omitempty"`
+	UpdatedContent *string `json:"updated_content,omitempty" secret:"true"`
 
 	// The new file name for the manifest.
 	// Pattern: ^[^\/]*\.(json|ya?ml(\.patch_?[a-zA-Z0-9_]*)?)$
@@ -54,12 +54,12 @@ type V2ClusterUpdateParams struct {
 	// A proxy URL to use for creating HTTP connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
-	HTTPProxy *string `json:"http_proxy,omitempty"`
+	HTTPProxy *string `json:"http_proxy,omitempty" secret:"true"`
 
 	// A proxy URL to use for creating HTTPS connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
-	HTTPSProxy *string `json:"https_proxy,omitempty"`
+	HTTPSProxy *string `json:"https_proxy,omitempty" secret:"true"`
 
 	// Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.
 	// Enum: [none masters arbiters workers masters,arbiters masters,workers arbiters,workers masters,arbiters,workers all]
@@ -81,6 +81,9 @@ type V2ClusterUpdateParams struct {
 	// Machine networks that are associated with this cluster.
 	MachineNetworks []*MachineNetwork `json:"machine_networks"`
 
+	// mirror registry configuration
+	MirrorRegistryConfiguration *MirrorRegistryConfigurationParams `json:"mirror_registry_configuration,omitempty"`
+
 	// OpenShift cluster name.
 	// Max Length: 54
 	// Min Length: 1
@@ -102,7 +105,7 @@ type V2ClusterUpdateParams struct {
 	Platform *Platform `json:"platform,omitempty" gorm:"embedded;embeddedPrefix:platform_"`
 
 	// The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.
-	PullSecret *string `json:"pull_secret,omitempty"`
+	PullSecret *string `json:"pull_secret,omitempty" secret:"true"`
 
 	// Schedule workloads on masters
 	SchedulableMasters *bool `json:"schedulable_masters,omitempty"`
@@ -175,6 +178,10 @@ func (m *V2ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMirrorRegistryConfiguration(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -469,6 +476,25 @@ func (m *V2ClusterUpdateParams) validateMachineNetworks(formats strfmt.Registry)
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) validateMirrorRegistryConfiguration(formats strfmt.Registry) error {
+	if swag.IsZero(m.MirrorRegistryConfiguration) { // not required
+		return nil
+	}
+
+	if m.MirrorRegistryConfiguration != nil {
+		if err := m.MirrorRegistryConfiguration.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mirror_registry_configuration")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("mirror_registry_configuration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) validateName(formats strfmt.Registry) error {
 	if swag.IsZero(m.Name) { // not required
 		return nil
@@ -642,6 +668,10 @@ func (m *V2ClusterUpdateParams) ContextValidate(ctx context.Context, formats str
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMirrorRegistryConfiguration(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateOlmOperators(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -788,6 +818,22 @@ func (m *V2ClusterUpdateParams) contextValidateMachineNetworks(ctx context.Conte
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) contextValidateMirrorRegistryConfiguration(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MirrorRegistryConfiguration != nil {
+		if err := m.MirrorRegistryConfiguration.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mirror_registry_configuration")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("mirror_registry_configuration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) contextValidateOlmOperators(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.OlmOperators); i++ {
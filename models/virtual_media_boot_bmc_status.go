@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// VirtualMediaBootBmcStatus virtual media boot bmc status
+//
+// swagger:model virtual-media-boot-bmc-status
+type VirtualMediaBootBmcStatus struct {
+
+	// The address of the BMC.
+	Address string `json:"address,omitempty"`
+
+	// status
+	// Enum: [in-progress succeeded failed]
+	Status string `json:"status,omitempty"`
+
+	// The error of a failed boot.
+	StatusInfo string `json:"status_info,omitempty"`
+
+	// updated at
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty"`
+}
+
+// Validate validates this virtual media boot bmc status
+func (m *VirtualMediaBootBmcStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var virtualMediaBootBmcStatusTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["in-progress","succeeded","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		virtualMediaBootBmcStatusTypeStatusPropEnum = append(virtualMediaBootBmcStatusTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// VirtualMediaBootBmcStatusStatusInProgress captures enum value "in-progress"
+	VirtualMediaBootBmcStatusStatusInProgress string = "in-progress"
+
+	// VirtualMediaBootBmcStatusStatusSucceeded captures enum value "succeeded"
+	VirtualMediaBootBmcStatusStatusSucceeded string = "succeeded"
+
+	// VirtualMediaBootBmcStatusStatusFailed captures enum value "failed"
+	VirtualMediaBootBmcStatusStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *VirtualMediaBootBmcStatus) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, virtualMediaBootBmcStatusTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *VirtualMediaBootBmcStatus) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *VirtualMediaBootBmcStatus) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this virtual media boot bmc status based on context it is used
+func (m *VirtualMediaBootBmcStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VirtualMediaBootBmcStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VirtualMediaBootBmcStatus) UnmarshalBinary(b []byte) error {
+	var res VirtualMediaBootBmcStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
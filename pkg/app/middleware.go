@@ -3,8 +3,10 @@ package app
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -121,12 +123,21 @@ func WithIPXEScriptMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// retryAfterError is implemented by the errors of requests that can be sent again later, such as throttled requests
+type retryAfterError interface {
+	RetryAfter() time.Duration
+}
+
 func WrapServeError() func(http.ResponseWriter, *http.Request, error) {
 	unsupportedHTTPCodes := map[int32]struct{}{
 		http.StatusUnprocessableEntity: {},
 	}
 
 	return func(rw http.ResponseWriter, r *http.Request, err error) {
+		var retryErr retryAfterError
+		if errors.As(err, &retryErr) {
+			rw.Header().Set("Retry-After", strconv.Itoa(int(math.Max(1, math.Ceil(retryErr.RetryAfter().Seconds())))))
+		}
 		if shouldModifyError(err, unsupportedHTTPCodes) {
 			err = unwrapCompositeError(err)
 			message := err.Error()
@@ -159,3 +159,27 @@ var _ = Describe("WithInitrdViaHTTPMiddleware", func() {
 		Expect(respStatus).To(Equal(200))
 	})
 })
+
+type throttledError struct {
+	retryAfter time.Duration
+}
+
+func (e *throttledError) Error() string             { return "too many requests" }
+func (e *throttledError) Code() int32               { return http.StatusTooManyRequests }
+func (e *throttledError) RetryAfter() time.Duration { return e.retryAfter }
+
+var _ = Describe("WrapServeError", func() {
+	It("sets the Retry-After header of throttled requests", func() {
+		rw := httptest.NewRecorder()
+		WrapServeError()(rw, httptest.NewRequest(http.MethodGet, "/", nil), &throttledError{retryAfter: 2500 * time.Millisecond})
+		Expect(rw.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(rw.Header().Get("Retry-After")).To(Equal("3"))
+	})
+
+	It("doesn't set the Retry-After header of other errors", func() {
+		rw := httptest.NewRecorder()
+		WrapServeError()(rw, httptest.NewRequest(http.MethodGet, "/", nil), fmt.Errorf("failure"))
+		Expect(rw.Code).To(Equal(http.StatusInternalServerError))
+		Expect(rw.Header().Get("Retry-After")).To(BeEmpty())
+	})
+})
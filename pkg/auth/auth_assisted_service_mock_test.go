@@ -13,9 +13,11 @@ import (
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
 	"github.com/openshift/assisted-service/restapi"
+	audit_api "github.com/openshift/assisted-service/restapi/operations/audit"
 	eventsapi "github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	managed_domains_api "github.com/openshift/assisted-service/restapi/operations/managed_domains"
+	service_accounts_api "github.com/openshift/assisted-service/restapi/operations/service_accounts"
 	versionsapi "github.com/openshift/assisted-service/restapi/operations/versions"
 )
 
@@ -141,6 +143,38 @@ func (f fakeInventory) V2DownloadHostIgnition(ctx context.Context, params instal
 		nil)
 }
 
+func (f fakeInventory) V2GenerateHostStaticNetworkConfig(ctx context.Context, params installer.V2GenerateHostStaticNetworkConfigParams) middleware.Responder {
+	return installer.NewV2GenerateHostStaticNetworkConfigOK()
+}
+
+func (f fakeInventory) V2ValidateStaticNetworkConfig(ctx context.Context, params installer.V2ValidateStaticNetworkConfigParams) middleware.Responder {
+	return installer.NewV2ValidateStaticNetworkConfigOK()
+}
+
+func (f fakeInventory) V2RemoveHostFromCluster(ctx context.Context, params installer.V2RemoveHostFromClusterParams) middleware.Responder {
+	return installer.NewV2RemoveHostFromClusterAccepted()
+}
+
+func (f fakeInventory) V2HostPowerAction(ctx context.Context, params installer.V2HostPowerActionParams) middleware.Responder {
+	return installer.NewV2HostPowerActionOK()
+}
+
+func (f fakeInventory) V2UpdateHostBmcCredentials(ctx context.Context, params installer.V2UpdateHostBmcCredentialsParams) middleware.Responder {
+	return installer.NewV2UpdateHostBmcCredentialsOK()
+}
+
+func (f fakeInventory) V2DeleteHostBmcCredentials(ctx context.Context, params installer.V2DeleteHostBmcCredentialsParams) middleware.Responder {
+	return installer.NewV2DeleteHostBmcCredentialsOK()
+}
+
+func (f fakeInventory) V2InfraEnvVirtualMediaBoot(ctx context.Context, params installer.V2InfraEnvVirtualMediaBootParams) middleware.Responder {
+	return installer.NewV2InfraEnvVirtualMediaBootAccepted()
+}
+
+func (f fakeInventory) V2GetInfraEnvVirtualMediaBoot(ctx context.Context, params installer.V2GetInfraEnvVirtualMediaBootParams) middleware.Responder {
+	return installer.NewV2GetInfraEnvVirtualMediaBootOK()
+}
+
 func (f fakeInventory) V2UpdateHostInstallerArgs(ctx context.Context, params installer.V2UpdateHostInstallerArgsParams) middleware.Responder {
 	return installer.NewV2UpdateHostInstallerArgsCreated()
 }
@@ -318,6 +352,24 @@ func (f fakeVersionsAPI) V2ListReleaseSources(
 	return versionsapi.NewV2ListReleaseSourcesOK()
 }
 
+func (f fakeVersionsAPI) V2ListCustomReleaseImages(
+	_ context.Context,
+	_ versionsapi.V2ListCustomReleaseImagesParams) middleware.Responder {
+	return versionsapi.NewV2ListCustomReleaseImagesOK()
+}
+
+func (f fakeVersionsAPI) V2RegisterCustomReleaseImage(
+	_ context.Context,
+	_ versionsapi.V2RegisterCustomReleaseImageParams) middleware.Responder {
+	return versionsapi.NewV2RegisterCustomReleaseImageCreated()
+}
+
+func (f fakeVersionsAPI) V2DeregisterCustomReleaseImage(
+	_ context.Context,
+	_ versionsapi.V2DeregisterCustomReleaseImageParams) middleware.Responder {
+	return versionsapi.NewV2DeregisterCustomReleaseImageNoContent()
+}
+
 type fakeManagedDomainsAPI struct{}
 
 func (f fakeManagedDomainsAPI) V2ListManagedDomains(
@@ -326,6 +378,52 @@ func (f fakeManagedDomainsAPI) V2ListManagedDomains(
 	return managed_domains_api.NewV2ListManagedDomainsOK()
 }
 
+type fakeServiceAccountsAPI struct{}
+
+func (f fakeServiceAccountsAPI) V2ListServiceAccounts(
+	_ context.Context,
+	_ service_accounts_api.V2ListServiceAccountsParams) middleware.Responder {
+	return service_accounts_api.NewV2ListServiceAccountsOK()
+}
+
+func (f fakeServiceAccountsAPI) V2CreateServiceAccount(
+	_ context.Context,
+	_ service_accounts_api.V2CreateServiceAccountParams) middleware.Responder {
+	return service_accounts_api.NewV2CreateServiceAccountCreated()
+}
+
+func (f fakeServiceAccountsAPI) V2DeleteServiceAccount(
+	_ context.Context,
+	_ service_accounts_api.V2DeleteServiceAccountParams) middleware.Responder {
+	return service_accounts_api.NewV2DeleteServiceAccountNoContent()
+}
+
+func (f fakeServiceAccountsAPI) V2ListServiceAccountTokens(
+	_ context.Context,
+	_ service_accounts_api.V2ListServiceAccountTokensParams) middleware.Responder {
+	return service_accounts_api.NewV2ListServiceAccountTokensOK()
+}
+
+func (f fakeServiceAccountsAPI) V2CreateServiceAccountToken(
+	_ context.Context,
+	_ service_accounts_api.V2CreateServiceAccountTokenParams) middleware.Responder {
+	return service_accounts_api.NewV2CreateServiceAccountTokenCreated()
+}
+
+func (f fakeServiceAccountsAPI) V2RevokeServiceAccountToken(
+	_ context.Context,
+	_ service_accounts_api.V2RevokeServiceAccountTokenParams) middleware.Responder {
+	return service_accounts_api.NewV2RevokeServiceAccountTokenNoContent()
+}
+
+type fakeAuditAPI struct{}
+
+func (f fakeAuditAPI) V2ListAuditEvents(
+	_ context.Context,
+	_ audit_api.V2ListAuditEventsParams) middleware.Responder {
+	return audit_api.NewV2ListAuditEventsOK()
+}
+
 func (f fakeInventory) V2SetIgnoredValidations(ctx context.Context, params installer.V2SetIgnoredValidationsParams) middleware.Responder {
 	return installer.NewV2SetIgnoredValidationsCreated()
 }
@@ -345,3 +443,7 @@ func (f fakeInventory) V2UpdateClusterUISettings(ctx context.Context, params ins
 func (f fakeInventory) V2UpdateClusterFinalizingProgress(ctx context.Context, params installer.V2UpdateClusterFinalizingProgressParams) middleware.Responder {
 	return installer.NewV2UpdateClusterFinalizingProgressOK()
 }
+
+func (f fakeInventory) V2GetQuotaUsage(ctx context.Context, params installer.V2GetQuotaUsageParams) middleware.Responder {
+	return installer.NewV2GetQuotaUsageOK()
+}
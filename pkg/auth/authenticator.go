@@ -18,8 +18,15 @@ const (
 	TypeRHSSO      AuthType = "rhsso"
 	TypeLocal      AuthType = "local"
 	TypeAgentLocal AuthType = "agent-installer-local"
+	TypeOIDC       AuthType = "oidc"
 )
 
+// UsesLocalTokens returns true if the agents and the download URLs are authenticated with the tokens signed by the
+// key of the service
+func (t AuthType) UsesLocalTokens() bool {
+	return t == TypeLocal || t == TypeOIDC
+}
+
 type Authenticator interface {
 	CreateAuthenticator() func(name, in string, authenticate security.TokenAuthentication) runtime.Authenticator
 	AuthUserAuth(token string) (interface{}, error)
@@ -42,6 +49,7 @@ type Config struct {
 	AdminUsers                 []string `envconfig:"ADMIN_USERS" default:""`
 	EnableOrgTenancy           bool     `envconfig:"ENABLE_ORG_TENANCY" default:"false"`
 	EnableOrgBasedFeatureGates bool     `envconfig:"ENABLE_ORG_BASED_FEATURE_GATES" default:"false"`
+	OIDC                       OIDCConfig
 }
 
 func NewAuthenticator(cfg *Config, ocmClient *ocm.Client, log logrus.FieldLogger, db *gorm.DB) (a Authenticator, err error) {
@@ -54,6 +62,8 @@ func NewAuthenticator(cfg *Config, ocmClient *ocm.Client, log logrus.FieldLogger
 		a, err = NewLocalAuthenticator(cfg, log, db)
 	case TypeAgentLocal:
 		a, err = NewAgentLocalAuthenticator(cfg, log)
+	case TypeOIDC:
+		a, err = NewOIDCAuthenticator(cfg, log, db)
 	default:
 		err = fmt.Errorf("invalid authenticator type %v", cfg.AuthType)
 	}
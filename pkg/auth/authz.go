@@ -55,8 +55,16 @@ func NewAuthzHandler(cfg *Config, ocmCLient *ocm.Client, log logrus.FieldLogger,
 			db:     db,
 		}
 
+	case TypeLocal:
+		authzr = &LocalAuthzHandler{log: log}
 	case TypeAgentLocal:
 		authzr = &AgentLocalAuthzHandler{}
+	case TypeOIDC:
+		authzr = &OIDCAuthzHandler{
+			log:               log,
+			db:                db,
+			orgTenancyEnabled: cfg.EnableOrgTenancy,
+		}
 	default:
 		authzr = &NoneHandler{}
 	}
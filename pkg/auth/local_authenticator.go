@@ -3,6 +3,7 @@ package auth
 import (
 	"crypto"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/runtime"
@@ -11,6 +12,8 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/openshift/assisted-service/internal/serviceaccount"
+	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
@@ -106,8 +109,49 @@ func (a *LocalAuthenticator) AuthAgentAuth(token string) (interface{}, error) {
 	return ocm.AdminPayload(), nil
 }
 
-func (a *LocalAuthenticator) AuthUserAuth(_ string) (interface{}, error) {
-	return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("User Authentication not allowed for local auth"))
+// AuthUserAuth authenticates the API tokens of service accounts, and the tokens signed by the key of the service
+// with the userAuth auth_scheme claim, which are used by the administrators to manage the service accounts. The
+// tokens of the administrators can't be revoked, so they must expire within gencrypto.MaxLocalUserJWTLifetime.
+func (a *LocalAuthenticator) AuthUserAuth(token string) (interface{}, error) {
+	if fields := strings.Fields(token); len(fields) == 2 && strings.EqualFold(fields[0], "bearer") {
+		token = fields[1]
+	}
+	if serviceaccount.IsToken(token) {
+		return a.authServiceAccountToken(token)
+	}
+
+	t, err := validateToken(token, a.publicKey)
+	if err != nil {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("User Authentication not allowed for local auth"))
+	}
+	claims, ok := t.Claims.(jwt.MapClaims)
+	if !ok || claims["auth_scheme"] != "userAuth" {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("User Authentication not allowed for local auth"))
+	}
+	// the parser refuses the expired tokens, a minute is allowed for the clock skew of the signer
+	exp, ok := claims["exp"].(float64)
+	if !ok || time.Unix(int64(exp), 0).After(time.Now().Add(gencrypto.MaxLocalUserJWTLifetime+time.Minute)) {
+		return nil, common.NewInfraError(http.StatusUnauthorized,
+			errors.Errorf("Administrator tokens must expire within %s", gencrypto.MaxLocalUserJWTLifetime))
+	}
+	return ocm.AdminPayload(), nil
+}
+
+func (a *LocalAuthenticator) authServiceAccountToken(token string) (interface{}, error) {
+	account, saToken, err := serviceaccount.Authenticate(a.log, a.db, token)
+	if err != nil {
+		a.log.WithError(err).Info("failed to authenticate service account token")
+		return nil, common.NewInfraError(http.StatusUnauthorized, err)
+	}
+	payload := &ocm.AuthPayload{Username: serviceaccount.UserName(account)}
+	switch *saToken.Scope {
+	case models.ServiceAccountTokenScopeClusterAdmin:
+		payload.Role = ocm.AdminRole
+	default:
+		payload.Role = ocm.ReadOnlyAdminRole
+	}
+	a.log.Debugf("Authenticating service account %s with API token %s", *account.Name, *saToken.Name)
+	return payload, nil
 }
 
 func (a *LocalAuthenticator) AuthURLAuth(token string) (interface{}, error) {
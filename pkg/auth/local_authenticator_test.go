@@ -1,17 +1,25 @@
 package auth
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/openshift/assisted-service/internal/serviceaccount"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi/operations/service_accounts"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -130,3 +138,119 @@ var _ = Describe("AuthAgentAuth", func() {
 		validateErrorResponse(err)
 	})
 })
+
+var _ = Describe("Local AuthUserAuth", func() {
+	var (
+		a          *LocalAuthenticator
+		db         *gorm.DB
+		dbName     string
+		privateKey string
+		handler    *serviceaccount.Handler
+		accountID  strfmt.UUID
+		ctx        = context.Background()
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		pubKey, privKey, err := gencrypto.ECDSAKeyPairPEM()
+		Expect(err).ToNot(HaveOccurred())
+		privateKey = privKey
+		a, err = NewLocalAuthenticator(&Config{ECPublicKeyPEM: pubKey}, logrus.New(), db)
+		Expect(err).ToNot(HaveOccurred())
+
+		handler = serviceaccount.NewHandler(logrus.New(), db, true)
+		reply := handler.V2CreateServiceAccount(ctx, service_accounts.V2CreateServiceAccountParams{
+			NewServiceAccountParams: &models.ServiceAccountCreateParams{Name: swag.String("ci")},
+		})
+		Expect(reply).To(BeAssignableToTypeOf(service_accounts.NewV2CreateServiceAccountCreated()))
+		accountID = *reply.(*service_accounts.V2CreateServiceAccountCreated).Payload.ID
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createToken := func(scope models.ServiceAccountTokenScope) *models.ServiceAccountToken {
+		reply := handler.V2CreateServiceAccountToken(ctx, service_accounts.V2CreateServiceAccountTokenParams{
+			ServiceAccountID: accountID,
+			NewServiceAccountTokenParams: &models.ServiceAccountTokenCreateParams{
+				Name:  swag.String("token"),
+				Scope: scope.Pointer(),
+			},
+		})
+		Expect(reply).To(BeAssignableToTypeOf(service_accounts.NewV2CreateServiceAccountTokenCreated()))
+		return reply.(*service_accounts.V2CreateServiceAccountTokenCreated).Payload
+	}
+
+	signToken := func(claims jwt.MapClaims) string {
+		key, err := jwt.ParseECPrivateKeyFromPEM([]byte(privateKey))
+		Expect(err).ToNot(HaveOccurred())
+		token, err := jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(key)
+		Expect(err).ToNot(HaveOccurred())
+		return token
+	}
+
+	It("authenticates the tokens of service accounts by their scope", func() {
+		adminToken := createToken(models.ServiceAccountTokenScopeClusterAdmin)
+		payload, err := a.AuthUserAuth("Bearer " + adminToken.Token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload).To(Equal(&ocm.AuthPayload{Username: "serviceaccount:ci", Role: ocm.AdminRole}))
+
+		readOnlyToken := createToken(models.ServiceAccountTokenScopeReadOnly)
+		payload, err = a.AuthUserAuth(readOnlyToken.Token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload).To(Equal(&ocm.AuthPayload{Username: "serviceaccount:ci", Role: ocm.ReadOnlyAdminRole}))
+	})
+
+	It("fails revoked tokens", func() {
+		token := createToken(models.ServiceAccountTokenScopeClusterAdmin)
+		reply := handler.V2RevokeServiceAccountToken(ctx, service_accounts.V2RevokeServiceAccountTokenParams{
+			ServiceAccountID: accountID,
+			TokenID:          *token.ID,
+		})
+		Expect(reply).To(BeAssignableToTypeOf(service_accounts.NewV2RevokeServiceAccountTokenNoContent()))
+		_, err := a.AuthUserAuth(token.Token)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.InfraErrorResponse).StatusCode()).To(Equal(int32(401)))
+	})
+
+	It("fails unknown tokens", func() {
+		_, err := a.AuthUserAuth(serviceaccount.TokenPrefix + "unknown")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("authenticates the tokens of the administrators as admin", func() {
+		payload, err := a.AuthUserAuth(signToken(jwt.MapClaims{"auth_scheme": "userAuth", "exp": time.Now().Add(15 * time.Minute).Unix()}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload).To(Equal(ocm.AdminPayload()))
+	})
+
+	It("fails tokens of the administrators without a short expiration", func() {
+		_, err := a.AuthUserAuth(signToken(jwt.MapClaims{"auth_scheme": "userAuth"}))
+		Expect(err).To(HaveOccurred())
+		_, err = a.AuthUserAuth(signToken(jwt.MapClaims{"auth_scheme": "userAuth", "exp": time.Now().Add(24 * time.Hour).Unix()}))
+		Expect(err).To(HaveOccurred())
+		_, err = a.AuthUserAuth(signToken(jwt.MapClaims{"auth_scheme": "userAuth", "exp": time.Now().Add(-time.Minute).Unix()}))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("authenticates the tokens of the administrators generated by the service", func() {
+		Expect(os.Setenv("EC_PRIVATE_KEY_PEM", privateKey)).To(Succeed())
+		defer os.Unsetenv("EC_PRIVATE_KEY_PEM")
+		token, err := gencrypto.LocalUserJWT(gencrypto.MaxLocalUserJWTLifetime)
+		Expect(err).ToNot(HaveOccurred())
+		payload, err := a.AuthUserAuth("Bearer " + token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload).To(Equal(ocm.AdminPayload()))
+
+		_, err = gencrypto.LocalUserJWT(2 * gencrypto.MaxLocalUserJWTLifetime)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails tokens of the service without the userAuth claim", func() {
+		_, err := a.AuthUserAuth(signToken(jwt.MapClaims{string(gencrypto.InfraEnvKey): uuid.New().String()}))
+		Expect(err).To(HaveOccurred())
+		_, err = a.AuthUserAuth(signToken(jwt.MapClaims{"auth_scheme": "agentAuth"}))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/sirupsen/logrus"
+)
+
+/*
+LocalAuthzHandler is the authorizer middleware that is being used for
+
+	local authentication. As there is no tenancy in this case, it authorizes
+	any request, except for user requests whose role, derived from the scope
+	of their service account token, isn't allowed to access the route
+*/
+type LocalAuthzHandler struct {
+	NoneHandler
+	log logrus.FieldLogger
+}
+
+func (a *LocalAuthzHandler) CreateAuthorizer() func(*http.Request) error {
+	return a.authorizerMiddleware
+}
+
+func (a *LocalAuthzHandler) authorizerMiddleware(request *http.Request) error {
+	route := middleware.MatchedRouteFrom(request)
+	if route.Authenticator.Schemes[0] != "userAuth" {
+		return nil
+	}
+	payload := ocm.PayloadFromContext(request.Context())
+	if !hasSufficientRole(a.log, request, payload) {
+		return common.NewInfraError(
+			http.StatusForbidden,
+			fmt.Errorf(
+				"%s: Unauthorized to access route (insufficient role %s)",
+				payload.Username, payload.Role))
+	}
+	return nil
+}
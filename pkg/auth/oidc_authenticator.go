@@ -0,0 +1,399 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/serviceaccount"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
+)
+
+const (
+	oidcRequestTimeout = 30 * time.Second
+
+	// oidcMinKeysRefreshInterval bounds how often the keys are downloaded again when a token is signed by an unknown
+	// key, so tokens with made up key IDs can't flood the identity provider, whether the downloads succeed or not
+	oidcMinKeysRefreshInterval = time.Minute
+)
+
+// OIDCConfig is the configuration of the OpenID Connect authentication of the users
+type OIDCConfig struct {
+	IssuerURL string `envconfig:"OIDC_ISSUER_URL" default:""`
+	// ClientID is the audience that the tokens must be issued for
+	ClientID string `envconfig:"OIDC_CLIENT_ID" default:""`
+	// CACertFile is the file of the CA certificates of the identity provider, the system CAs are used when it's empty
+	CACertFile string `envconfig:"OIDC_CA_CERT_FILE" default:""`
+	// KeysRefreshInterval is how often the signing keys of the identity provider are downloaded again
+	KeysRefreshInterval time.Duration `envconfig:"OIDC_KEYS_REFRESH_INTERVAL" default:"1h"`
+	// The claims are JSON paths separated by dots, e.g. realm_access.roles
+	UsernameClaim string `envconfig:"OIDC_USERNAME_CLAIM" default:"preferred_username"`
+	OrgClaim      string `envconfig:"OIDC_ORG_CLAIM" default:""`
+	RolesClaim    string `envconfig:"OIDC_ROLES_CLAIM" default:"groups"`
+	// The users with one of these roles get the admin and the read-only-admin roles
+	AdminRoles         []string `envconfig:"OIDC_ADMIN_ROLES" default:""`
+	ReadOnlyAdminRoles []string `envconfig:"OIDC_READ_ONLY_ADMIN_ROLES" default:""`
+}
+
+// OIDCAuthenticator authenticates the users with the tokens issued by an OpenID Connect identity provider, such as
+// Keycloak, Dex or Azure AD. The agents, the download URLs and the service accounts are authenticated with the tokens
+// of the service, as with local authentication.
+type OIDCAuthenticator struct {
+	*LocalAuthenticator
+	config            OIDCConfig
+	adminUsers        []string
+	orgTenancyEnabled bool
+	httpClient        *http.Client
+	jwksURI           string
+
+	keysMutex sync.RWMutex
+	keys      map[string]interface{}
+	// keysRefreshAttemptedAt is the time of the last download of the keys, including the failed ones
+	keysRefreshAttemptedAt time.Time
+	// keysGroup lets the concurrent requests with unknown keys share a single download of the keys
+	keysGroup singleflight.Group
+}
+
+// oidcDiscovery is the part of the discovery document of the identity provider used by the service
+type oidcDiscovery struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+type oidcJWK struct {
+	KID string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func NewOIDCAuthenticator(cfg *Config, log logrus.FieldLogger, db *gorm.DB) (*OIDCAuthenticator, error) {
+	if cfg.OIDC.IssuerURL == "" {
+		return nil, errors.New("OIDC authentication requires OIDC_ISSUER_URL")
+	}
+	if cfg.OIDC.ClientID == "" {
+		return nil, errors.New("OIDC authentication requires OIDC_CLIENT_ID")
+	}
+	if cfg.EnableOrgTenancy && cfg.OIDC.OrgClaim == "" {
+		return nil, errors.New("organization tenancy with OIDC authentication requires OIDC_ORG_CLAIM")
+	}
+	local, err := NewLocalAuthenticator(cfg, log, db)
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err := oidcHTTPClient(cfg.OIDC.CACertFile)
+	if err != nil {
+		return nil, err
+	}
+	a := &OIDCAuthenticator{
+		LocalAuthenticator: local,
+		config:             cfg.OIDC,
+		adminUsers:         cfg.AdminUsers,
+		orgTenancyEnabled:  cfg.EnableOrgTenancy,
+		httpClient:         httpClient,
+	}
+	if err = a.discover(); err != nil {
+		return nil, err
+	}
+	if err = a.refreshKeys(); err != nil {
+		return nil, err
+	}
+	go a.refreshKeysPeriodically()
+	return a, nil
+}
+
+var _ Authenticator = &OIDCAuthenticator{}
+
+func oidcHTTPClient(caCertFile string) (*http.Client, error) {
+	client := &http.Client{Timeout: oidcRequestTimeout}
+	if caCertFile == "" {
+		return client, nil
+	}
+	data, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the CA certificates of the identity provider %s", caCertFile)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.Errorf("no CA certificate found in %s", caCertFile)
+	}
+	client.Transport = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}}
+	return client, nil
+}
+
+func (a *OIDCAuthenticator) AuthType() AuthType {
+	return TypeOIDC
+}
+
+func (a *OIDCAuthenticator) EnableOrgTenancy() bool {
+	return a.orgTenancyEnabled
+}
+
+func (a *OIDCAuthenticator) getJSON(url string, result interface{}) error {
+	res, err := a.httpClient.Get(url)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %s", url)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to get %s: %s", url, res.Status)
+	}
+	return errors.Wrapf(json.NewDecoder(res.Body).Decode(result), "failed to parse %s", url)
+}
+
+// discover gets the URL of the signing keys from the discovery document of the identity provider
+func (a *OIDCAuthenticator) discover() error {
+	var discovery oidcDiscovery
+	if err := a.getJSON(strings.TrimSuffix(a.config.IssuerURL, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+		return errors.Wrap(err, "OIDC discovery failed")
+	}
+	if discovery.Issuer != a.config.IssuerURL {
+		return errors.Errorf("OIDC discovery returned issuer %s instead of %s", discovery.Issuer, a.config.IssuerURL)
+	}
+	if discovery.JWKSURI == "" {
+		return errors.New("OIDC discovery returned no jwks_uri")
+	}
+	a.jwksURI = discovery.JWKSURI
+	return nil
+}
+
+// refreshKeys downloads the signing keys of the identity provider. The identity provider publishes the new keys
+// before it signs tokens with them, so rotated keys are picked up by the next refresh.
+func (a *OIDCAuthenticator) refreshKeys() error {
+	a.keysMutex.Lock()
+	a.keysRefreshAttemptedAt = time.Now()
+	a.keysMutex.Unlock()
+	var jwks struct {
+		Keys []oidcJWK `json:"keys"`
+	}
+	if err := a.getJSON(a.jwksURI, &jwks); err != nil {
+		return errors.Wrap(err, "failed to get the signing keys of the identity provider")
+	}
+	keys := make(map[string]interface{})
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := parseOIDCKey(jwk)
+		if err != nil {
+			a.log.WithError(err).Warnf("Ignoring signing key %s of the identity provider", jwk.KID)
+			continue
+		}
+		keys[jwk.KID] = key
+	}
+	if len(keys) == 0 {
+		return errors.New("the identity provider has no supported signing keys")
+	}
+	a.keysMutex.Lock()
+	defer a.keysMutex.Unlock()
+	a.keys = keys
+	return nil
+}
+
+func (a *OIDCAuthenticator) refreshKeysPeriodically() {
+	for range time.Tick(a.config.KeysRefreshInterval) {
+		if err := a.refreshKeys(); err != nil {
+			a.log.WithError(err).Error("Failed to refresh the signing keys of the identity provider")
+		}
+	}
+}
+
+func parseOIDCKey(jwk oidcJWK) (interface{}, error) {
+	decode := func(value string) (*big.Int, error) {
+		data, err := base64.RawURLEncoding.DecodeString(value)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetBytes(data), nil
+	}
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decode(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %s", jwk.Crv)
+		}
+		x, err := decode(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %s", jwk.Kty)
+	}
+}
+
+// getKey returns the signing key with the given ID, and whether the keys may be downloaded again to find it
+func (a *OIDCAuthenticator) getKey(kid string) (interface{}, bool, bool) {
+	a.keysMutex.RLock()
+	defer a.keysMutex.RUnlock()
+	key, ok := a.keys[kid]
+	return key, ok, time.Since(a.keysRefreshAttemptedAt) >= oidcMinKeysRefreshInterval
+}
+
+// getSigningKey returns the key of the identity provider that signed the token. When the key isn't known, the keys
+// are downloaded again in case the identity provider rotated them since the last refresh.
+func (a *OIDCAuthenticator) getSigningKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok, canRefresh := a.getKey(kid)
+	if ok {
+		return key, nil
+	}
+	if canRefresh {
+		_, err, _ := a.keysGroup.Do("keys", func() (interface{}, error) {
+			// the keys may have been downloaded by another request while this one was waiting
+			if _, _, canRefresh = a.getKey(kid); !canRefresh {
+				return nil, nil
+			}
+			return nil, a.refreshKeys()
+		})
+		if err != nil {
+			return nil, err
+		}
+		if key, ok, _ = a.getKey(kid); ok {
+			return key, nil
+		}
+	}
+	return nil, errors.Errorf("no signing key %s", kid)
+}
+
+// AuthUserAuth authenticates the tokens issued by the identity provider, the claims of the token are mapped to the
+// user name, the organization and the role of the user. The API tokens of the service accounts aren't issued by the
+// identity provider, they are authenticated by the service as with local authentication.
+func (a *OIDCAuthenticator) AuthUserAuth(token string) (interface{}, error) {
+	fields := strings.Fields(token)
+	if len(fields) != 2 || !strings.EqualFold(fields[0], "bearer") {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Authorization header format must be Bearer {token}"))
+	}
+	if serviceaccount.IsToken(fields[1]) {
+		return a.authServiceAccountToken(fields[1])
+	}
+	parser := &jwt.Parser{ValidMethods: []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}}
+	parsed, err := parser.Parse(fields[1], a.getSigningKey)
+	if err != nil || !parsed.Valid {
+		a.log.WithError(err).Info("failed to validate OIDC token")
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Error parsing token or token is invalid"))
+	}
+	claims, ok := parsed.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Unable to parse JWT token claims"))
+	}
+	if err = a.validateClaims(claims); err != nil {
+		a.log.WithError(err).Info("invalid OIDC token claims")
+		return nil, common.NewInfraError(http.StatusUnauthorized, err)
+	}
+
+	payload := &ocm.AuthPayload{}
+	payload.Username, _ = oidcClaim(claims, a.config.UsernameClaim).(string)
+	if payload.Username == "" {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Missing %s claim in token", a.config.UsernameClaim))
+	}
+	if a.config.OrgClaim != "" {
+		payload.Organization, _ = oidcClaim(claims, a.config.OrgClaim).(string)
+	}
+	if a.orgTenancyEnabled && payload.Organization == "" {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Missing %s claim in token", a.config.OrgClaim))
+	}
+	payload.Email, _ = claims["email"].(string)
+	payload.FirstName, _ = claims["given_name"].(string)
+	payload.LastName, _ = claims["family_name"].(string)
+	payload.Role = a.getRole(payload.Username, oidcClaimStrings(claims, a.config.RolesClaim))
+	return payload, nil
+}
+
+// validateClaims checks that the token was issued for the service by the identity provider, the expiration is checked
+// by the parser
+func (a *OIDCAuthenticator) validateClaims(claims jwt.MapClaims) error {
+	if !claims.VerifyIssuer(a.config.IssuerURL, true) {
+		return errors.Errorf("token isn't issued by %s", a.config.IssuerURL)
+	}
+	if _, ok := claims["exp"]; !ok {
+		return errors.New("token has no expiration")
+	}
+	if !claims.VerifyAudience(a.config.ClientID, true) {
+		return errors.Errorf("token isn't issued for %s", a.config.ClientID)
+	}
+	return nil
+}
+
+func (a *OIDCAuthenticator) getRole(username string, roles []string) ocm.RoleType {
+	switch {
+	case funk.ContainsString(a.adminUsers, username) || len(funk.IntersectString(roles, a.config.AdminRoles)) > 0:
+		return ocm.AdminRole
+	case len(funk.IntersectString(roles, a.config.ReadOnlyAdminRoles)) > 0:
+		return ocm.ReadOnlyAdminRole
+	default:
+		return ocm.UserRole
+	}
+}
+
+// oidcClaim returns the value of a claim, nested claims are separated by dots
+func oidcClaim(claims jwt.MapClaims, path string) interface{} {
+	var value interface{} = map[string]interface{}(claims)
+	for _, name := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+	return value
+}
+
+// oidcClaimStrings returns the values of a claim that is either a string or a list of strings
+func oidcClaimStrings(claims jwt.MapClaims, path string) []string {
+	switch value := oidcClaim(claims, path).(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		var values []string
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,476 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/openshift/assisted-service/internal/serviceaccount"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/openshift/assisted-service/restapi/operations/service_accounts"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// fakeOIDCProvider is a local OpenID Connect identity provider that serves the discovery document and the signing
+// keys, and signs tokens with its current key
+type fakeOIDCProvider struct {
+	server *httptest.Server
+	issuer string
+
+	mutex      sync.Mutex
+	kid        string
+	method     jwt.SigningMethod
+	privateKey interface{}
+	jwks       []map[string]string
+	jwksCalls  int
+}
+
+func newFakeOIDCProvider() *fakeOIDCProvider {
+	p := &fakeOIDCProvider{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		Expect(json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   p.issuer,
+			"jwks_uri": p.issuer + "/keys",
+		})).To(Succeed())
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+		p.jwksCalls++
+		Expect(json.NewEncoder(w).Encode(map[string]interface{}{"keys": p.jwks})).To(Succeed())
+	})
+	p.server = httptest.NewServer(mux)
+	p.issuer = p.server.URL
+	p.rotateRSAKey()
+	return p
+}
+
+func encodeBigInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+// rotateRSAKey publishes a new RSA key next to the previous keys, and signs the next tokens with it
+func (p *fakeOIDCProvider) rotateRSAKey() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.kid = uuid.New().String()
+	p.method = jwt.SigningMethodRS256
+	p.privateKey = key
+	p.jwks = append(p.jwks, map[string]string{
+		"kid": p.kid, "kty": "RSA", "use": "sig", "alg": "RS256",
+		"n": encodeBigInt(key.N), "e": encodeBigInt(big.NewInt(int64(key.E))),
+	})
+}
+
+// rotateECKey publishes a new EC key next to the previous keys, and signs the next tokens with it
+func (p *fakeOIDCProvider) rotateECKey() {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.kid = uuid.New().String()
+	p.method = jwt.SigningMethodES256
+	p.privateKey = key
+	p.jwks = append(p.jwks, map[string]string{
+		"kid": p.kid, "kty": "EC", "use": "sig", "crv": "P-256",
+		"x": encodeBigInt(key.X), "y": encodeBigInt(key.Y),
+	})
+}
+
+func (p *fakeOIDCProvider) token(claims jwt.MapClaims) string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	token := jwt.NewWithClaims(p.method, claims)
+	token.Header["kid"] = p.kid
+	signed, err := token.SignedString(p.privateKey)
+	Expect(err).NotTo(HaveOccurred())
+	return "Bearer " + signed
+}
+
+func (p *fakeOIDCProvider) claims(username string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":                p.issuer,
+		"aud":                "assisted-service",
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"preferred_username": username,
+		"email":              username + "@example.com",
+		"tenant":             map[string]interface{}{"id": "org-1"},
+		"groups":             []interface{}{"developers"},
+	}
+}
+
+func newOIDCConfig(issuer string) *Config {
+	pubKey, _, err := gencrypto.ECDSAKeyPairPEM()
+	Expect(err).NotTo(HaveOccurred())
+	return &Config{
+		AuthType:       TypeOIDC,
+		ECPublicKeyPEM: pubKey,
+		AdminUsers:     []string{"root"},
+		OIDC: OIDCConfig{
+			IssuerURL:          issuer,
+			ClientID:           "assisted-service",
+			UsernameClaim:      "preferred_username",
+			OrgClaim:           "tenant.id",
+			RolesClaim:         "groups",
+			AdminRoles:         []string{"admins"},
+			ReadOnlyAdminRoles: []string{"auditors"},
+		},
+	}
+}
+
+var _ = Describe("OIDCAuthenticator", func() {
+	var (
+		provider *fakeOIDCProvider
+		cfg      *Config
+		a        *OIDCAuthenticator
+	)
+
+	BeforeEach(func() {
+		provider = newFakeOIDCProvider()
+		cfg = newOIDCConfig(provider.issuer)
+		var err error
+		a, err = NewOIDCAuthenticator(cfg, logrus.New(), nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		provider.server.Close()
+	})
+
+	expectUnauthorized := func(err error) {
+		Expect(err).To(HaveOccurred())
+		infraError, ok := err.(*common.InfraErrorResponse)
+		Expect(ok).To(BeTrue())
+		Expect(infraError.StatusCode()).To(Equal(int32(http.StatusUnauthorized)))
+	}
+
+	authenticate := func(claims jwt.MapClaims) *ocm.AuthPayload {
+		payload, err := a.AuthUserAuth(provider.token(claims))
+		Expect(err).NotTo(HaveOccurred())
+		return payload.(*ocm.AuthPayload)
+	}
+
+	It("is created by the authenticator factory", func() {
+		authenticator, err := NewAuthenticator(cfg, nil, logrus.New(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authenticator.AuthType()).To(Equal(TypeOIDC))
+		Expect(authenticator.AuthType().UsesLocalTokens()).To(BeTrue())
+	})
+
+	It("maps the claims of the token to the user", func() {
+		payload := authenticate(provider.claims("jdoe"))
+		Expect(payload.Username).To(Equal("jdoe"))
+		Expect(payload.Email).To(Equal("jdoe@example.com"))
+		Expect(payload.Organization).To(Equal("org-1"))
+		Expect(payload.Role).To(Equal(ocm.UserRole))
+	})
+
+	It("maps the roles of the token", func() {
+		claims := provider.claims("jdoe")
+		claims["groups"] = []interface{}{"developers", "admins"}
+		Expect(authenticate(claims).Role).To(Equal(ocm.AdminRole))
+
+		claims["groups"] = "auditors"
+		Expect(authenticate(claims).Role).To(Equal(ocm.ReadOnlyAdminRole))
+
+		Expect(authenticate(provider.claims("root")).Role).To(Equal(ocm.AdminRole))
+	})
+
+	It("maps nested claims", func() {
+		cfg.OIDC.RolesClaim = "realm_access.roles"
+		var err error
+		a, err = NewOIDCAuthenticator(cfg, logrus.New(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		claims := provider.claims("jdoe")
+		claims["realm_access"] = map[string]interface{}{"roles": []interface{}{"admins"}}
+		Expect(authenticate(claims).Role).To(Equal(ocm.AdminRole))
+	})
+
+	It("accepts tokens signed by EC keys", func() {
+		provider.rotateECKey()
+		a.keysRefreshAttemptedAt = time.Now().Add(-2 * oidcMinKeysRefreshInterval)
+		Expect(authenticate(provider.claims("jdoe")).Username).To(Equal("jdoe"))
+	})
+
+	It("downloads the keys again when the identity provider rotates its key", func() {
+		Expect(provider.jwksCalls).To(Equal(1))
+		provider.rotateRSAKey()
+
+		// The keys were just downloaded, the new key isn't known yet
+		_, err := a.AuthUserAuth(provider.token(provider.claims("jdoe")))
+		expectUnauthorized(err)
+		Expect(provider.jwksCalls).To(Equal(1))
+
+		a.keysRefreshAttemptedAt = time.Now().Add(-2 * oidcMinKeysRefreshInterval)
+		Expect(authenticate(provider.claims("jdoe")).Username).To(Equal("jdoe"))
+		Expect(provider.jwksCalls).To(Equal(2))
+	})
+
+	It("downloads the keys once for the concurrent tokens signed by unknown keys", func() {
+		provider.rotateRSAKey()
+		a.keysRefreshAttemptedAt = time.Now().Add(-2 * oidcMinKeysRefreshInterval)
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				_, _ = a.AuthUserAuth(provider.token(provider.claims("jdoe")))
+			}()
+		}
+		wg.Wait()
+		Expect(provider.jwksCalls).To(Equal(2))
+	})
+
+	It("doesn't download the keys again right after a failed download", func() {
+		provider.rotateRSAKey()
+		provider.server.Close()
+		a.keysRefreshAttemptedAt = time.Now().Add(-2 * oidcMinKeysRefreshInterval)
+		_, err := a.AuthUserAuth(provider.token(provider.claims("jdoe")))
+		expectUnauthorized(err)
+		Expect(time.Since(a.keysRefreshAttemptedAt)).To(BeNumerically("<", oidcMinKeysRefreshInterval))
+	})
+
+	It("rejects the tokens that aren't issued for the service", func() {
+		claims := provider.claims("jdoe")
+		claims["iss"] = "https://other-issuer.example.com"
+		_, err := a.AuthUserAuth(provider.token(claims))
+		expectUnauthorized(err)
+
+		claims = provider.claims("jdoe")
+		claims["aud"] = "other-client"
+		_, err = a.AuthUserAuth(provider.token(claims))
+		expectUnauthorized(err)
+
+		delete(claims, "aud")
+		_, err = a.AuthUserAuth(provider.token(claims))
+		expectUnauthorized(err)
+	})
+
+	It("rejects the expired tokens and the tokens without expiration", func() {
+		claims := provider.claims("jdoe")
+		claims["exp"] = time.Now().Add(-time.Minute).Unix()
+		_, err := a.AuthUserAuth(provider.token(claims))
+		expectUnauthorized(err)
+
+		delete(claims, "exp")
+		_, err = a.AuthUserAuth(provider.token(claims))
+		expectUnauthorized(err)
+	})
+
+	It("rejects the tokens that aren't signed by the identity provider", func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, provider.claims("jdoe"))
+		token.Header["kid"] = provider.kid
+		signed, err := token.SignedString(key)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = a.AuthUserAuth("Bearer " + signed)
+		expectUnauthorized(err)
+	})
+
+	It("rejects the tokens without user name", func() {
+		claims := provider.claims("jdoe")
+		delete(claims, "preferred_username")
+		_, err := a.AuthUserAuth(provider.token(claims))
+		expectUnauthorized(err)
+	})
+
+	It("rejects the tokens without organization when tenancy is enabled", func() {
+		cfg.EnableOrgTenancy = true
+		var err error
+		a, err = NewOIDCAuthenticator(cfg, logrus.New(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		claims := provider.claims("jdoe")
+		delete(claims, "tenant")
+		_, err = a.AuthUserAuth(provider.token(claims))
+		expectUnauthorized(err)
+	})
+
+	It("rejects the authorization headers without bearer", func() {
+		_, err := a.AuthUserAuth(provider.token(provider.claims("jdoe"))[len("Bearer "):])
+		expectUnauthorized(err)
+	})
+
+	It("doesn't accept the tokens of the identity provider as agent tokens", func() {
+		token := provider.token(provider.claims("jdoe"))[len("Bearer "):]
+		_, err := a.AuthAgentAuth(token)
+		expectUnauthorized(err)
+	})
+
+	It("authenticates the tokens of the service accounts", func() {
+		db, dbName := common.PrepareTestDB()
+		defer common.DeleteTestDB(db, dbName)
+		var err error
+		a, err = NewOIDCAuthenticator(cfg, logrus.New(), db)
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := context.Background()
+		handler := serviceaccount.NewHandler(logrus.New(), db, true)
+		reply := handler.V2CreateServiceAccount(ctx, service_accounts.V2CreateServiceAccountParams{
+			NewServiceAccountParams: &models.ServiceAccountCreateParams{Name: swag.String("ci")},
+		})
+		Expect(reply).To(BeAssignableToTypeOf(service_accounts.NewV2CreateServiceAccountCreated()))
+		reply = handler.V2CreateServiceAccountToken(ctx, service_accounts.V2CreateServiceAccountTokenParams{
+			ServiceAccountID: *reply.(*service_accounts.V2CreateServiceAccountCreated).Payload.ID,
+			NewServiceAccountTokenParams: &models.ServiceAccountTokenCreateParams{
+				Name:  swag.String("token"),
+				Scope: models.ServiceAccountTokenScopeClusterAdmin.Pointer(),
+			},
+		})
+		Expect(reply).To(BeAssignableToTypeOf(service_accounts.NewV2CreateServiceAccountTokenCreated()))
+		token := reply.(*service_accounts.V2CreateServiceAccountTokenCreated).Payload.Token
+
+		payload, err := a.AuthUserAuth("Bearer " + token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payload).To(Equal(&ocm.AuthPayload{Username: "serviceaccount:ci", Role: ocm.AdminRole}))
+
+		_, err = a.AuthUserAuth("Bearer " + serviceaccount.TokenPrefix + "unknown")
+		expectUnauthorized(err)
+	})
+
+	It("fails without issuer", func() {
+		cfg.OIDC.IssuerURL = ""
+		_, err := NewOIDCAuthenticator(cfg, logrus.New(), nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails without client ID", func() {
+		cfg.OIDC.ClientID = ""
+		_, err := NewOIDCAuthenticator(cfg, logrus.New(), nil)
+		Expect(err).To(MatchError(ContainSubstring("OIDC_CLIENT_ID")))
+	})
+
+	It("fails when the discovery returns another issuer", func() {
+		cfg.OIDC.IssuerURL = provider.issuer + "/"
+		_, err := NewOIDCAuthenticator(cfg, logrus.New(), nil)
+		Expect(err).To(MatchError(ContainSubstring("OIDC discovery returned issuer")))
+	})
+
+	It("fails when the identity provider is unreachable", func() {
+		provider.server.Close()
+		_, err := NewOIDCAuthenticator(cfg, logrus.New(), nil)
+		Expect(err).To(MatchError(ContainSubstring("OIDC discovery failed")))
+	})
+})
+
+var _ = Describe("OIDCAuthzHandler", func() {
+	var (
+		db         *gorm.DB
+		dbName     string
+		handler    Authorizer
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+		cfg        *Config
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, UserName: "jdoe", OrgID: "org-1"}}).Error).To(Succeed())
+		Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID, UserName: "jdoe", OrgID: "org-1"}}).Error).To(Succeed())
+		cfg = &Config{AuthType: TypeOIDC}
+		handler = NewAuthzHandler(cfg, nil, logrus.New(), db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	contextOf := func(payload *ocm.AuthPayload) context.Context {
+		return context.WithValue(context.Background(), restapi.AuthKey, payload)
+	}
+
+	countClusters := func(ctx context.Context) int64 {
+		var count int64
+		Expect(handler.OwnedBy(ctx, db.Model(&common.Cluster{})).Count(&count).Error).To(Succeed())
+		return count
+	}
+
+	It("is created by the authorizer factory", func() {
+		_, ok := handler.(*OIDCAuthzHandler)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("limits the users to their resources", func() {
+		owner := contextOf(&ocm.AuthPayload{Username: "jdoe", Organization: "org-1", Role: ocm.UserRole})
+		colleague := contextOf(&ocm.AuthPayload{Username: "asmith", Organization: "org-1", Role: ocm.UserRole})
+		Expect(countClusters(owner)).To(Equal(int64(1)))
+		Expect(countClusters(colleague)).To(Equal(int64(0)))
+
+		allowed, err := handler.HasAccessTo(owner, &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, UpdateAction)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		allowed, err = handler.HasAccessTo(colleague, &common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID}}, ReadAction)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+	})
+
+	It("shares the resources of the organization when tenancy is enabled", func() {
+		cfg.EnableOrgTenancy = true
+		handler = NewAuthzHandler(cfg, nil, logrus.New(), db)
+		colleague := contextOf(&ocm.AuthPayload{Username: "asmith", Organization: "org-1", Role: ocm.UserRole})
+		stranger := contextOf(&ocm.AuthPayload{Username: "bob", Organization: "org-2", Role: ocm.UserRole})
+		Expect(countClusters(colleague)).To(Equal(int64(1)))
+		Expect(countClusters(stranger)).To(Equal(int64(0)))
+
+		allowed, err := handler.HasAccessTo(colleague, &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, UpdateAction)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("checks the access the same way as it lists the resources when tenancy is enabled", func() {
+		cfg.EnableOrgTenancy = true
+		handler = NewAuthzHandler(cfg, nil, logrus.New(), db)
+		// The creator of the cluster moved to another organization
+		creator := contextOf(&ocm.AuthPayload{Username: "jdoe", Organization: "org-2", Role: ocm.UserRole})
+		Expect(countClusters(creator)).To(Equal(int64(0)))
+
+		allowed, err := handler.HasAccessTo(creator, &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, ReadAction)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+	})
+
+	It("gives the admins access to all the resources", func() {
+		admin := contextOf(&ocm.AuthPayload{Username: "root", Role: ocm.AdminRole})
+		readOnlyAdmin := contextOf(&ocm.AuthPayload{Username: "auditor", Role: ocm.ReadOnlyAdminRole})
+		Expect(handler.IsAdmin(admin)).To(BeTrue())
+		Expect(handler.IsAdmin(readOnlyAdmin)).To(BeTrue())
+		Expect(countClusters(readOnlyAdmin)).To(Equal(int64(1)))
+
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+		allowed, err := handler.HasAccessTo(admin, cluster, DeleteAction)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		allowed, err = handler.HasAccessTo(readOnlyAdmin, cluster, ReadAction)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		allowed, err = handler.HasAccessTo(readOnlyAdmin, cluster, UpdateAction)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+	})
+})
@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openshift/assisted-service/internal/common"
+	params "github.com/openshift/assisted-service/pkg/context"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+/*
+OIDCAuthzHandler is the authorizer middleware that is being used for
+
+	OIDC authentication. The role and the organization of the users come
+	from the claims of their tokens: admins access all the resources, and
+	the other users access the resources they own, or the resources of
+	their organization when tenancy is enabled
+*/
+type OIDCAuthzHandler struct {
+	log               logrus.FieldLogger
+	db                *gorm.DB
+	orgTenancyEnabled bool
+}
+
+func (a *OIDCAuthzHandler) CreateAuthorizer() func(*http.Request) error {
+	return a.authorizerMiddleware
+}
+
+func (a *OIDCAuthzHandler) IsAdmin(ctx context.Context) bool {
+	role := ocm.PayloadFromContext(ctx).Role
+	return role == ocm.AdminRole || role == ocm.ReadOnlyAdminRole
+}
+
+func (a *OIDCAuthzHandler) OwnedBy(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if a.IsAdmin(ctx) {
+		return db
+	}
+	if a.orgTenancyEnabled {
+		return db.Where("org_id = ?", ocm.OrgIDFromContext(ctx))
+	}
+	return db.Where("user_name = ?", ocm.UserNameFromContext(ctx))
+}
+
+func (a *OIDCAuthzHandler) OwnedByUser(ctx context.Context, db *gorm.DB, username string) *gorm.DB {
+	if username == "" {
+		return a.OwnedBy(ctx, db)
+	}
+	return a.OwnedBy(ctx, db).Where("user_name = ?", username)
+}
+
+func (a *OIDCAuthzHandler) HasAccessTo(ctx context.Context, obj interface{}, action Action) (bool, error) {
+	payload := ocm.PayloadFromContext(ctx)
+	switch payload.Role {
+	case ocm.AdminRole:
+		return true, nil
+	case ocm.ReadOnlyAdminRole:
+		if action == ReadAction {
+			return true, nil
+		}
+	}
+	if cluster, ok := obj.(*common.Cluster); ok && cluster != nil {
+		return a.isOwner(cluster.ID.String(), &common.Cluster{}, payload)
+	}
+	if infraEnv, ok := obj.(*common.InfraEnv); ok && infraEnv != nil {
+		return a.isOwner(infraEnv.ID.String(), &common.InfraEnv{}, payload)
+	}
+	if host, ok := obj.(*common.Host); ok && host != nil {
+		if host.ClusterID != nil {
+			return a.isOwner(host.ClusterID.String(), &common.Cluster{}, payload)
+		}
+		return a.isOwner(host.InfraEnvID.String(), &common.InfraEnv{}, payload)
+	}
+	return false, errors.New("can not perform access check on this object")
+}
+
+func (a *OIDCAuthzHandler) HasOrgBasedCapability(ctx context.Context, capability string) (bool, error) {
+	return true, nil
+}
+
+// isOwner returns true if the object belongs to the organization of the user when tenancy is enabled, or was created
+// by the user otherwise, the same way as OwnedBy
+func (a *OIDCAuthzHandler) isOwner(id string, obj interface{}, payload *ocm.AuthPayload) (bool, error) {
+	if a.db == nil {
+		return true, nil
+	}
+	query := a.db.Select("id").Where("id = ?", id)
+	if a.orgTenancyEnabled {
+		query = query.Where("org_id = ?", payload.Organization)
+	} else {
+		query = query.Where("user_name = ?", payload.Username)
+	}
+	return handleOwnershipQueryError(query.Take(obj).Error)
+}
+
+func (a *OIDCAuthzHandler) authorizerMiddleware(request *http.Request) error {
+	route := middleware.MatchedRouteFrom(request)
+	if route.Authenticator.Schemes[0] != "userAuth" {
+		return nil
+	}
+	payload := ocm.PayloadFromContext(request.Context())
+	if !hasSufficientRole(a.log, request, payload) {
+		return common.NewInfraError(
+			http.StatusForbidden,
+			fmt.Errorf(
+				"%s: Unauthorized to access route (insufficient role %s)",
+				payload.Username, payload.Role))
+	}
+	if funk.Contains([]ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole}, payload.Role) {
+		return nil
+	}
+
+	// List requests and resources outside the scope of clusters or infraEnvs handle their authorization at the
+	// application level
+	var (
+		isAllowed = true
+		err       error
+	)
+	if clusterID := params.GetParam(request.Context(), params.ClusterId); clusterID != "" {
+		isAllowed, err = a.isOwner(clusterID, &common.Cluster{}, payload)
+	} else if infraEnvID := params.GetParam(request.Context(), params.InfraEnvId); infraEnvID != "" {
+		isAllowed, err = a.isOwner(infraEnvID, &common.InfraEnv{}, payload)
+	}
+	if err != nil {
+		a.log.WithError(err).Error("Failed to verify access to object")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if !isAllowed {
+		return common.NewApiError(http.StatusNotFound, fmt.Errorf("Object Not Found"))
+	}
+	return nil
+}
@@ -387,11 +387,16 @@ func (a *AuthzHandler) hasClusterEditRole(payload *ocm.AuthPayload, action, subs
 func (a *AuthzHandler) hasSufficientRole(
 	request *http.Request,
 	payload *ocm.AuthPayload) bool {
+	return hasSufficientRole(a.log, request, payload)
+}
 
+// hasSufficientRole returns true if the role of the payload is one of the scopes of the security scheme that
+// authenticated the request
+func hasSufficientRole(log logrus.FieldLogger, request *http.Request, payload *ocm.AuthPayload) bool {
 	route := middleware.MatchedRouteFrom(request)
 
 	allScopesAreAllowedResponse := func() bool {
-		a.log.Debugf(
+		log.Debugf(
 			"%s: Authorized user: %s all roles are allowed",
 			route.PathPattern, payload.Username)
 		return true
@@ -411,13 +416,13 @@ func (a *AuthzHandler) hasSufficientRole(
 			return allScopesAreAllowedResponse()
 		}
 		if funk.Contains(policyScopes, string(payload.Role)) {
-			a.log.Debugf(
+			log.Debugf(
 				"%s: Authorized user: %s for role: %s",
 				route.PathPattern, payload.Username, payload.Role)
 			return true
 		}
 	}
-	a.log.Warnf(
+	log.Warnf(
 		"Unauthorized user %s: insufficient role: %s allowed roles: %q",
 		payload.Username,
 		payload.Role,
@@ -21,9 +21,11 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/client"
+	"github.com/openshift/assisted-service/client/audit"
 	"github.com/openshift/assisted-service/client/events"
 	"github.com/openshift/assisted-service/client/installer"
 	"github.com/openshift/assisted-service/client/managed_domains"
+	"github.com/openshift/assisted-service/client/service_accounts"
 	"github.com/openshift/assisted-service/client/versions"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
@@ -54,6 +56,13 @@ var _ = Describe("NewAuthzHandler", func() {
 		_, ok = handler.(*NoneHandler)
 		Expect(ok).To(BeTrue())
 	})
+
+	It("Limits the routes of service accounts with local auth", func() {
+		cfg := &Config{AuthType: TypeLocal}
+		handler := NewAuthzHandler(cfg, nil, logrus.New(), nil)
+		_, ok := handler.(*LocalAuthzHandler)
+		Expect(ok).To(BeTrue())
+	})
 })
 
 var _ = Describe("Authz email domain", func() {
@@ -705,12 +714,14 @@ var _ = Describe("authz", func() {
 					Cache:         authzCache,
 				},
 				log.WithField("pkg", "auth"), nil).CreateAuthorizer(),
-			InstallerAPI:      fakeInventory{},
-			EventsAPI:         &fakeEventsAPI{},
-			Logger:            logrus.Printf,
-			VersionsAPI:       fakeVersionsAPI{},
-			ManagedDomainsAPI: fakeManagedDomainsAPI{},
-			InnerMiddleware:   nil,
+			InstallerAPI:       fakeInventory{},
+			EventsAPI:          &fakeEventsAPI{},
+			AuditAPI:           fakeAuditAPI{},
+			Logger:             logrus.Printf,
+			VersionsAPI:        fakeVersionsAPI{},
+			ManagedDomainsAPI:  fakeManagedDomainsAPI{},
+			ServiceAccountsAPI: fakeServiceAccountsAPI{},
+			InnerMiddleware:    nil,
 		})
 	Expect(err).To(BeNil())
 
@@ -979,6 +990,42 @@ var _ = Describe("authz", func() {
 			apiCall:                listSupportedOpenshiftVersions,
 			expectUnauthorizedCode: http.StatusForbidden,
 		},
+		{
+			name:                   "list custom release images",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:                listCustomReleaseImages,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "list service accounts",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole},
+			apiCall:                listServiceAccounts,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "list audit events",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole},
+			apiCall:                listAuditEvents,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "create service account token",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole},
+			apiCall:                createServiceAccountToken,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "get quota usage",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:                getQuotaUsage,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "register custom release image",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:                registerCustomReleaseImage,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
 		{
 			name:                   "register add hosts cluster",
 			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
@@ -1444,6 +1491,51 @@ func listSupportedOpenshiftVersions(ctx context.Context, cli *client.AssistedIns
 	return err
 }
 
+func listServiceAccounts(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.ServiceAccounts.V2ListServiceAccounts(ctx, &service_accounts.V2ListServiceAccountsParams{})
+	return err
+}
+
+func listAuditEvents(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Audit.V2ListAuditEvents(ctx, &audit.V2ListAuditEventsParams{})
+	return err
+}
+
+func createServiceAccountToken(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.ServiceAccounts.V2CreateServiceAccountToken(ctx, &service_accounts.V2CreateServiceAccountTokenParams{
+		ServiceAccountID: strfmt.UUID(uuid.New().String()),
+		NewServiceAccountTokenParams: &models.ServiceAccountTokenCreateParams{
+			Name:  swag.String("token"),
+			Scope: models.ServiceAccountTokenScopeReadOnly.Pointer(),
+		},
+	})
+	return err
+}
+
+func getQuotaUsage(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.V2GetQuotaUsage(ctx, &installer.V2GetQuotaUsageParams{})
+	return err
+}
+
+func listCustomReleaseImages(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Versions.V2ListCustomReleaseImages(
+		ctx,
+		&versions.V2ListCustomReleaseImagesParams{})
+	return err
+}
+
+func registerCustomReleaseImage(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Versions.V2RegisterCustomReleaseImage(
+		ctx,
+		&versions.V2RegisterCustomReleaseImageParams{
+			NewCustomReleaseImageParams: &models.CustomReleaseImageCreateParams{
+				URL:        swag.String("quay.io/openshift-release-dev/ocp-release:4.16.3-x86_64"),
+				PullSecret: swag.String("{\"auths\":{}}"),
+			},
+		})
+	return err
+}
+
 func registerAddHostsCluster(ctx context.Context, cli *client.AssistedInstall) error {
 	clusterName := "add-hosts-cluster"
 	apiVIPDnsname := "api-vip.redhat.com"
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
 )
 
 //go:generate mockgen -source=generator.go -package=mirrorregistries -destination=mock_generator.go
@@ -103,9 +105,41 @@ func ExtractLocationMirrorDataFromRegistriesFromToml(registriesConfToml string)
 	return registriesConfList, nil
 }
 
+// GetMirrorImageReferences returns the references that a digest image reference resolves to through the mirrors,
+// in the order they are tried. Like the container runtime, the most specific location that matches the repository of
+// the image is used. Nil is returned for images that aren't referenced by digest or that don't match any location.
+func GetMirrorImageReferences(image string, registriesConf []RegistriesConf) []string {
+	repository, digest, found := strings.Cut(image, "@")
+	if !found {
+		return nil
+	}
+	var match *RegistriesConf
+	for i := range registriesConf {
+		location := registriesConf[i].Location
+		if repository != location && !strings.HasPrefix(repository, location+"/") {
+			continue
+		}
+		if match == nil || len(location) > len(match.Location) {
+			match = &registriesConf[i]
+		}
+	}
+	if match == nil {
+		return nil
+	}
+	references := make([]string, 0, len(match.Mirror))
+	for _, mirror := range match.Mirror {
+		references = append(references, mirror+strings.TrimPrefix(repository, match.Location)+"@"+digest)
+	}
+	return references
+}
+
 // GenerateInsecurePolicyJSON returns a base64 encoded minimal policy.json that disables signature enforcement
 func (m *mirrorRegistriesConfigBuilder) GenerateInsecurePolicyJSON() (string, error) {
-	if !m.ForceInsecurePolicy {
+	return generateInsecurePolicyJSON(m.ForceInsecurePolicy)
+}
+
+func generateInsecurePolicyJSON(forceInsecurePolicy bool) (string, error) {
+	if !forceInsecurePolicy {
 		return "", nil
 	}
 
@@ -129,3 +163,69 @@ func (m *mirrorRegistriesConfigBuilder) GenerateInsecurePolicyJSON() (string, er
 
 	return base64.StdEncoding.EncodeToString(data), nil
 }
+
+// ParseMirrorRegistryConfiguration returns the mirror registry configuration of a cluster or an infra-env given the
+// content of registries.conf and the CA bundle of the mirrors. Nil is returned if registries.conf is empty.
+func ParseMirrorRegistryConfiguration(registriesConf, caBundleCrt string) (*common.MirrorRegistryConfiguration, error) {
+	if registriesConf == "" {
+		return nil, nil
+	}
+
+	imageDigestMirrors, imageTagMirrors, insecure, err := GetImageRegistries(registriesConf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.MirrorRegistryConfiguration{
+		ImageDigestMirrors: imageDigestMirrors,
+		ImageTagMirrors:    imageTagMirrors,
+		Insecure:           insecure,
+		CaBundleCrt:        caBundleCrt,
+		RegistriesConf:     registriesConf,
+	}, nil
+}
+
+type configurationMirrorRegistriesConfigBuilder struct {
+	configuration       *common.MirrorRegistryConfiguration
+	forceInsecurePolicy bool
+}
+
+// NewFromConfiguration returns a builder for the mirror registries configured for a cluster or an infra-env, in
+// place of the ones configured for the service
+func NewFromConfiguration(configuration *common.MirrorRegistryConfiguration, forceInsecurePolicy bool) ServiceMirrorRegistriesConfigBuilder {
+	return &configurationMirrorRegistriesConfigBuilder{
+		configuration:       configuration,
+		forceInsecurePolicy: forceInsecurePolicy,
+	}
+}
+
+func (m *configurationMirrorRegistriesConfigBuilder) IsMirrorRegistriesConfigured() bool {
+	return common.IsMirrorConfigurationSet(m.configuration)
+}
+
+// GetMirrorCA returns the CA bundle of the mirrors, which is optional for a cluster or an infra-env
+func (m *configurationMirrorRegistriesConfigBuilder) GetMirrorCA() ([]byte, error) {
+	if !m.IsMirrorRegistriesConfigured() {
+		return nil, errors.New("mirror registries are not configured")
+	}
+	return []byte(m.configuration.CaBundleCrt), nil
+}
+
+func (m *configurationMirrorRegistriesConfigBuilder) GetMirrorRegistries() ([]byte, error) {
+	if !m.IsMirrorRegistriesConfigured() {
+		return nil, errors.New("mirror registries are not configured")
+	}
+	return []byte(m.configuration.RegistriesConf), nil
+}
+
+func (m *configurationMirrorRegistriesConfigBuilder) ExtractLocationMirrorDataFromRegistries() ([]RegistriesConf, error) {
+	contents, err := m.GetMirrorRegistries()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractLocationMirrorDataFromRegistriesFromToml(string(contents))
+}
+
+func (m *configurationMirrorRegistriesConfigBuilder) GenerateInsecurePolicyJSON() (string, error) {
+	return generateInsecurePolicyJSON(m.forceInsecurePolicy)
+}
@@ -75,6 +75,34 @@ var _ = Describe("Generator tests", func() {
 
 	})
 
+	var _ = Describe("GetMirrorImageReferences", func() {
+		registriesConf := []RegistriesConf{
+			{Location: "quay.io/openshift-release-dev", Mirror: []string{"mirror.example.com/ocp"}},
+			{Location: "quay.io/openshift-release-dev/ocp-v4.0-art-dev", Mirror: []string{"mirror.example.com/art", "backup.example.com/art"}},
+		}
+
+		It("resolves through the most specific location", func() {
+			Expect(GetMirrorImageReferences("quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:1234", registriesConf)).To(Equal([]string{
+				"mirror.example.com/art@sha256:1234",
+				"backup.example.com/art@sha256:1234",
+			}))
+		})
+
+		It("keeps the path below the location", func() {
+			Expect(GetMirrorImageReferences("quay.io/openshift-release-dev/ocp-release@sha256:1234", registriesConf)).To(Equal([]string{
+				"mirror.example.com/ocp/ocp-release@sha256:1234",
+			}))
+		})
+
+		It("doesn't resolve repositories that only share a name prefix", func() {
+			Expect(GetMirrorImageReferences("quay.io/openshift-release-dev-other/image@sha256:1234", registriesConf)).To(BeNil())
+		})
+
+		It("doesn't resolve images referenced by tag", func() {
+			Expect(GetMirrorImageReferences("quay.io/openshift-release-dev/ocp-release:4.17.11-x86_64", registriesConf)).To(BeNil())
+		})
+	})
+
 	var _ = Describe("IsMirrorRegistriesConfigured", func() {
 		It("returns false when CA and registry.conf don't exist", func() {
 			m := mirrorRegistriesConfigBuilder{
@@ -320,6 +348,54 @@ var _ = Describe("Per cluster mirror registry tests", func() {
 	})
 })
 
+var _ = Describe("ParseMirrorRegistryConfiguration", func() {
+	It("returns nil without registries.conf", func() {
+		configuration, err := ParseMirrorRegistryConfiguration("", "ca")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configuration).To(BeNil())
+	})
+
+	It("parses the mirrors of registries.conf", func() {
+		configuration, err := ParseMirrorRegistryConfiguration(getInsecureRegistryToml(), "ca")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configuration.RegistriesConf).To(Equal(getInsecureRegistryToml()))
+		Expect(configuration.CaBundleCrt).To(Equal("ca"))
+		Expect(configuration.ImageDigestMirrors).To(HaveLen(1))
+		Expect(configuration.ImageDigestMirrors[0].Source).To(Equal(sourceRegistry))
+		Expect(configuration.Insecure).To(ConsistOf(mirrorRegistry))
+	})
+
+	It("fails with an invalid registries.conf", func() {
+		_, err := ParseMirrorRegistryConfiguration(getInvalidRegistryToml(), "")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("NewFromConfiguration", func() {
+	It("isn't configured without a configuration", func() {
+		builder := NewFromConfiguration(nil, false)
+		Expect(builder.IsMirrorRegistriesConfigured()).To(BeFalse())
+		_, err := builder.ExtractLocationMirrorDataFromRegistries()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("uses the given configuration", func() {
+		configuration, err := ParseMirrorRegistryConfiguration(getSecureRegistryToml(), "ca")
+		Expect(err).NotTo(HaveOccurred())
+		builder := NewFromConfiguration(configuration, true)
+		Expect(builder.IsMirrorRegistriesConfigured()).To(BeTrue())
+		ca, err := builder.GetMirrorCA()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(ca)).To(Equal("ca"))
+		registriesConf, err := builder.ExtractLocationMirrorDataFromRegistries()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(registriesConf).To(Equal([]RegistriesConf{{Location: sourceRegistry, Mirror: []string{mirrorRegistry}}}))
+		policy, err := builder.GenerateInsecurePolicyJSON()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).NotTo(BeEmpty())
+	})
+})
+
 func TestGeneratePolicyJSON_ForceInsecure(t *testing.T) {
 	builder := mirrorRegistriesConfigBuilder{
 		MirrorRegistriesConfigPath:      "/some/path",
@@ -0,0 +1,113 @@
+package secretdump
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// DumpSecretJSON generates a JSON representation of a JSON document with
+// the values of secret fields, such as pull secrets and passwords, replaced
+// by "<SECRET>". The document is redacted along its swagger schema: a field
+// is secret when its property is tagged `secret:"true"` by x-go-custom-tag,
+// the same tag that the generated models get, so that the documents and the
+// structs are redacted the same way. The fields of nested objects, arrays
+// and maps are redacted as well. A document whose schema is secret itself
+// is redacted as a whole. An error is returned if the schema refers to an
+// unknown definition, since the secrets can't be found then.
+func DumpSecretJSON(data []byte, schema *spec.Schema, definitions spec.Definitions) (string, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return "", err
+	}
+	if isSecretSchema(schema) {
+		value = "<SECRET>"
+	} else if err := redactSecretFields(value, schema, definitions); err != nil {
+		return "", err
+	}
+	var redacted strings.Builder
+	encoder := json.NewEncoder(&redacted)
+	// keeps the "<SECRET>" placeholders readable
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(redacted.String(), "\n"), nil
+}
+
+// redactSecretFields replaces the values of the secret fields of the objects in place
+func redactSecretFields(value interface{}, schema *spec.Schema, definitions spec.Definitions) error {
+	schema, err := resolveSchema(schema, definitions)
+	if err != nil || schema == nil {
+		return err
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, field := range v {
+			fieldSchema, err := propertySchema(schema, name, definitions)
+			if err != nil {
+				return err
+			}
+			if field != nil && isSecretSchema(fieldSchema) {
+				v[name] = "<SECRET>"
+			} else if err = redactSecretFields(field, fieldSchema, definitions); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		if schema.Items == nil || schema.Items.Schema == nil {
+			return nil
+		}
+		for i := range v {
+			if err = redactSecretFields(v[i], schema.Items.Schema, definitions); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolveSchema returns the definition that the schema refers to, or the schema itself if it isn't a reference
+func resolveSchema(schema *spec.Schema, definitions spec.Definitions) (*spec.Schema, error) {
+	for schema != nil && schema.Ref.String() != "" {
+		name := strings.TrimPrefix(schema.Ref.String(), "#/definitions/")
+		definition, ok := definitions[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown definition %s", schema.Ref.String())
+		}
+		schema = &definition
+	}
+	return schema, nil
+}
+
+// propertySchema returns the schema of a property of an object, including the properties of the schemas it's
+// composed of and the values of maps. It returns nil for the properties that the schema doesn't describe.
+func propertySchema(schema *spec.Schema, name string, definitions spec.Definitions) (*spec.Schema, error) {
+	if property, ok := schema.Properties[name]; ok {
+		return &property, nil
+	}
+	for i := range schema.AllOf {
+		composed, err := resolveSchema(&schema.AllOf[i], definitions)
+		if err != nil {
+			return nil, err
+		}
+		if property, err := propertySchema(composed, name, definitions); property != nil || err != nil {
+			return property, err
+		}
+	}
+	if schema.AdditionalProperties != nil {
+		return schema.AdditionalProperties.Schema, nil
+	}
+	return nil, nil
+}
+
+func isSecretSchema(schema *spec.Schema) bool {
+	if schema == nil {
+		return false
+	}
+	tag, _ := schema.Extensions.GetString("x-go-custom-tag")
+	return reflect.StructTag(tag).Get("secret") == "true"
+}
@@ -1,9 +1,11 @@
 package secretdump
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
+	"github.com/go-openapi/spec"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -82,4 +84,89 @@ struct Example {
 			Expect(actual).To(Equal(expected))
 		})
 	})
+
+	Context("Dump secret JSON", func() {
+		var definitions spec.Definitions
+
+		BeforeEach(func() {
+			Expect(json.Unmarshal([]byte(`{
+				"cluster": {
+					"type": "object",
+					"properties": {
+						"name": {"type": "string"},
+						"pull_secret": {"type": "string", "x-go-custom-tag": "secret:\"true\""},
+						"password": {"type": "string"},
+						"platform": {"$ref": "#/definitions/platform"},
+						"hosts": {"type": "array", "items": {"$ref": "#/definitions/host"}},
+						"labels": {"type": "object", "additionalProperties": {"$ref": "#/definitions/credentials"}}
+					}
+				},
+				"platform": {
+					"type": "object",
+					"properties": {
+						"vsphere": {
+							"type": "object",
+							"properties": {
+								"username": {"type": "string"},
+								"password": {"type": "string", "x-go-custom-tag": "gorm:\"-\" secret:\"true\""}
+							}
+						}
+					}
+				},
+				"host": {
+					"allOf": [{"$ref": "#/definitions/credentials"}, {"type": "object", "properties": {"id": {"type": "string"}}}]
+				},
+				"credentials": {
+					"type": "object",
+					"properties": {"token": {"type": "string", "x-go-custom-tag": "secret:\"true\""}}
+				}
+			}`), &definitions)).To(Succeed())
+		})
+
+		It("redacts the fields tagged as secret in the schema", func() {
+			actual, err := DumpSecretJSON([]byte(`{
+				"name": "cluster",
+				"pull_secret": "{\"auths\": {}}",
+				"password": "NotTaggedAsSecret",
+				"platform": {"vsphere": {"username": "admin", "password": "ThisIsASecret"}},
+				"hosts": [{"id": "1", "token": "ThisIsAnotherSecret"}, {"id": "2", "token": null}],
+				"labels": {"a": {"token": "ThisIsAThirdSecret"}}
+			}`), &spec.Schema{SchemaProps: spec.SchemaProps{Ref: spec.MustCreateRef("#/definitions/cluster")}}, definitions)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(MatchJSON(`{
+				"name": "cluster",
+				"pull_secret": "<SECRET>",
+				"password": "NotTaggedAsSecret",
+				"platform": {"vsphere": {"username": "admin", "password": "<SECRET>"}},
+				"hosts": [{"id": "1", "token": "<SECRET>"}, {"id": "2", "token": null}],
+				"labels": {"a": {"token": "<SECRET>"}}
+			}`))
+			Expect(actual).NotTo(ContainSubstring("ThisIs"))
+		})
+
+		It("redacts the documents whose schema is secret", func() {
+			schema := spec.StringProperty()
+			schema.AddExtension("x-go-custom-tag", `secret:"true"`)
+			actual, err := DumpSecretJSON([]byte(`"ThisIsASecret"`), schema, definitions)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(Equal(`"<SECRET>"`))
+		})
+
+		It("keeps the documents without schema", func() {
+			actual, err := DumpSecretJSON([]byte(`{"name": "cluster"}`), nil, definitions)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(MatchJSON(`{"name": "cluster"}`))
+		})
+
+		It("fails on unknown definitions", func() {
+			_, err := DumpSecretJSON([]byte(`{"name": "cluster"}`),
+				&spec.Schema{SchemaProps: spec.SchemaProps{Ref: spec.MustCreateRef("#/definitions/unknown")}}, definitions)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails on invalid JSON", func() {
+			_, err := DumpSecretJSON([]byte("not json"), nil, definitions)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -33,6 +33,7 @@ type StaticNetworkConfig interface {
 	GenerateStaticNetworkConfigDataYAML(staticNetworkConfigStr string) ([]StaticNetworkConfigData, error)
 	FormatStaticNetworkConfigForDB(staticNetworkConfig []*models.HostStaticNetworkConfig) (string, error)
 	ValidateStaticConfigParamsYAML(staticNetworkConfig []*models.HostStaticNetworkConfig) error
+	ValidateStaticNetworkConfigPerHost(staticNetworkConfig []*models.HostStaticNetworkConfig, expectedSubnets []string) *models.StaticNetworkConfigValidation
 	ShouldUseNmstateService(staticNetworkConfigStr, openshiftVersion string) (bool, error)
 }
 
@@ -304,6 +305,9 @@ func (s *StaticNetworkConfigGenerator) formatNMConnection(nmConnection string) (
 	return buf.String(), nil
 }
 
+// See 'man systemd.net-naming-scheme' for interface naming protocol
+var predicatableIfaceNamePattern = regexp.MustCompile("^en[PsvxXbucaipod]")
+
 func (s *StaticNetworkConfigGenerator) validateInterfaceNamesExistenceYAML(macInterfaceMap models.MacInterfaceMap, networksYaml string) error {
 	interfaceNames := lo.Map(macInterfaceMap, func(m *models.MacInterfaceMapItems0, _ int) string { return m.LogicalNicName })
 
@@ -316,8 +320,6 @@ func (s *StaticNetworkConfigGenerator) validateInterfaceNamesExistenceYAML(macIn
 		return err
 	}
 
-	// See 'man systemd.net-naming-scheme' for interface naming protocol
-	predicatableIfaceNamePattern := regexp.MustCompile("^en[PsvxXbucaipod]")
 	interfaceWithmacIdentifier := make(map[string]struct{})
 
 	interfaces, exists := config["interfaces"]
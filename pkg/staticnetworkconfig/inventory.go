@@ -0,0 +1,305 @@
+package staticnetworkconfig
+
+import (
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	familyIPv4 = 2
+	familyIPv6 = 10
+)
+
+type nmstateState struct {
+	DNSResolver *nmstateDNSResolver `yaml:"dns-resolver,omitempty"`
+	Interfaces  []*nmstateInterface `yaml:"interfaces"`
+	Routes      *nmstateRoutes      `yaml:"routes,omitempty"`
+}
+
+type nmstateDNSResolver struct {
+	Config nmstateDNSConfig `yaml:"config"`
+}
+
+type nmstateDNSConfig struct {
+	Server []string `yaml:"server"`
+}
+
+type nmstateInterface struct {
+	Name            string                  `yaml:"name"`
+	Type            string                  `yaml:"type"`
+	State           string                  `yaml:"state"`
+	MacAddress      string                  `yaml:"mac-address,omitempty"`
+	Identifier      string                  `yaml:"identifier,omitempty"`
+	LinkAggregation *nmstateLinkAggregation `yaml:"link-aggregation,omitempty"`
+	Vlan            *nmstateVlan            `yaml:"vlan,omitempty"`
+	IPv4            *nmstateIP              `yaml:"ipv4"`
+	IPv6            *nmstateIP              `yaml:"ipv6"`
+}
+
+type nmstateLinkAggregation struct {
+	Mode string   `yaml:"mode"`
+	Port []string `yaml:"port"`
+}
+
+type nmstateVlan struct {
+	BaseIface string `yaml:"base-iface"`
+	ID        int64  `yaml:"id"`
+}
+
+type nmstateIP struct {
+	Enabled  bool              `yaml:"enabled"`
+	DHCP     *bool             `yaml:"dhcp,omitempty"`
+	Autoconf *bool             `yaml:"autoconf,omitempty"`
+	Address  []*nmstateAddress `yaml:"address,omitempty"`
+}
+
+type nmstateAddress struct {
+	IP           string `yaml:"ip"`
+	PrefixLength int    `yaml:"prefix-length"`
+}
+
+type nmstateRoutes struct {
+	Config []*nmstateRoute `yaml:"config"`
+}
+
+type nmstateRoute struct {
+	Destination      string `yaml:"destination"`
+	NextHopAddress   string `yaml:"next-hop-address"`
+	NextHopInterface string `yaml:"next-hop-interface"`
+}
+
+// GenerateFromInventory proposes the static network configuration of a host from its discovered inventory. The
+// physical interfaces keep their names and their current addresses, usually leased by DHCP, and become static.
+// The addresses of the ports of a bond move to the bond, and the default routes are kept.
+func GenerateFromInventory(inventory *models.Inventory, params *models.HostStaticNetworkConfigGenerateParams) (*models.HostStaticNetworkConfig, error) {
+	if params == nil {
+		params = &models.HostStaticNetworkConfigGenerateParams{}
+	}
+	physical := make(map[string]*models.Interface)
+	var names []string
+	for _, iface := range inventory.Interfaces {
+		if (iface.Type == "" || iface.Type == "physical") && iface.MacAddress != "" {
+			physical[iface.Name] = iface
+			names = append(names, iface.Name)
+		}
+	}
+	sort.Strings(names)
+
+	bondOfPort := make(map[string]string)
+	bondNames := make(map[string]struct{})
+	for _, bond := range params.Bonds {
+		name := swag.StringValue(bond.Name)
+		if _, ok := physical[name]; ok {
+			return nil, errors.Errorf("bond %s has the name of a physical interface of the host", name)
+		}
+		if _, ok := bondNames[name]; ok {
+			return nil, errors.Errorf("bond %s is defined more than once", name)
+		}
+		bondNames[name] = struct{}{}
+		for _, port := range bond.Ports {
+			if _, ok := physical[port]; !ok {
+				return nil, errors.Errorf("port %s of bond %s isn't a physical interface of the host", port, name)
+			}
+			if other, ok := bondOfPort[port]; ok {
+				return nil, errors.Errorf("interface %s is a port of both bond %s and bond %s", port, other, name)
+			}
+			bondOfPort[port] = name
+		}
+	}
+
+	vlanBases := make(map[string]struct{})
+	for _, vlan := range params.Vlans {
+		base := swag.StringValue(vlan.BaseInterface)
+		if _, isBond := bondNames[base]; !isBond {
+			if _, ok := physical[base]; !ok {
+				return nil, errors.Errorf("base interface %s of VLAN %d is neither a physical interface of the host nor a bond",
+					base, swag.Int64Value(vlan.ID))
+			}
+			if bond, ok := bondOfPort[base]; ok {
+				return nil, errors.Errorf("base interface %s of VLAN %d is a port of bond %s", base, swag.Int64Value(vlan.ID), bond)
+			}
+		}
+		vlanBases[base] = struct{}{}
+	}
+
+	state := &nmstateState{}
+	macInterfaceMap := models.MacInterfaceMap{}
+	hasAddresses := false
+	for _, name := range names {
+		iface := physical[name]
+		ethernet := &nmstateInterface{Name: name, Type: "ethernet", State: "up"}
+		if _, ok := bondOfPort[name]; ok {
+			ethernet.IPv4, ethernet.IPv6 = disabledIP(), disabledIP()
+		} else {
+			ipv4, ipv6 := staticIPs(iface)
+			_, isVlanBase := vlanBases[name]
+			if ipv4 == nil && ipv6 == nil && !isVlanBase {
+				continue
+			}
+			hasAddresses = hasAddresses || ipv4 != nil || ipv6 != nil
+			ethernet.IPv4, ethernet.IPv6 = orDisabled(ipv4), orDisabled(ipv6)
+		}
+		state.Interfaces = append(state.Interfaces, ethernet)
+		macInterfaceMap = append(macInterfaceMap, &models.MacInterfaceMapItems0{
+			LogicalNicName: name,
+			MacAddress:     strings.ToLower(iface.MacAddress),
+		})
+	}
+
+	for _, bond := range params.Bonds {
+		var ipv4, ipv6 *nmstateIP
+		for _, port := range bond.Ports {
+			portIPv4, portIPv6 := staticIPs(physical[port])
+			ipv4, ipv6 = mergeIPs(ipv4, portIPv4), mergeIPs(ipv6, portIPv6)
+		}
+		hasAddresses = hasAddresses || ipv4 != nil || ipv6 != nil
+		mode := swag.StringValue(bond.Mode)
+		if mode == "" {
+			mode = models.StaticNetworkConfigBondModeActiveBackup
+		}
+		state.Interfaces = append(state.Interfaces, &nmstateInterface{
+			Name:            swag.StringValue(bond.Name),
+			Type:            "bond",
+			State:           "up",
+			LinkAggregation: &nmstateLinkAggregation{Mode: mode, Port: bond.Ports},
+			IPv4:            orDisabled(ipv4),
+			IPv6:            orDisabled(ipv6),
+		})
+	}
+
+	discovered := make(map[string]*models.Interface)
+	for _, iface := range inventory.Interfaces {
+		discovered[iface.Name] = iface
+	}
+	for _, vlan := range params.Vlans {
+		base := swag.StringValue(vlan.BaseInterface)
+		name := base + "." + swag.FormatInt64(swag.Int64Value(vlan.ID))
+		var ipv4, ipv6 *nmstateIP
+		// The addresses of the VLAN are only known when the host already uses it
+		if iface, ok := discovered[name]; ok {
+			ipv4, ipv6 = staticIPs(iface)
+		}
+		hasAddresses = hasAddresses || ipv4 != nil || ipv6 != nil
+		state.Interfaces = append(state.Interfaces, &nmstateInterface{
+			Name:  name,
+			Type:  "vlan",
+			State: "up",
+			Vlan:  &nmstateVlan{BaseIface: base, ID: swag.Int64Value(vlan.ID)},
+			IPv4:  orDisabled(ipv4),
+			IPv6:  orDisabled(ipv6),
+		})
+	}
+
+	if !hasAddresses {
+		return nil, errors.New("no address was discovered on the interfaces of the host")
+	}
+
+	routes := &nmstateRoutes{}
+	for _, family := range []int32{familyIPv4, familyIPv6} {
+		route := defaultRoute(inventory.Routes, family)
+		if route == nil {
+			continue
+		}
+		nextHopInterface := route.Interface
+		if bond, ok := bondOfPort[nextHopInterface]; ok {
+			nextHopInterface = bond
+		}
+		destination := "0.0.0.0/0"
+		if family == familyIPv6 {
+			destination = "::/0"
+		}
+		routes.Config = append(routes.Config, &nmstateRoute{
+			Destination:      destination,
+			NextHopAddress:   route.Gateway,
+			NextHopInterface: nextHopInterface,
+		})
+	}
+	if len(routes.Config) > 0 {
+		state.Routes = routes
+	}
+
+	if len(params.DNSServers) > 0 {
+		state.DNSResolver = &nmstateDNSResolver{Config: nmstateDNSConfig{Server: params.DNSServers}}
+	}
+
+	networkYaml, err := yaml.Marshal(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the network yaml")
+	}
+	return &models.HostStaticNetworkConfig{
+		MacInterfaceMap: macInterfaceMap,
+		NetworkYaml:     string(networkYaml),
+	}, nil
+}
+
+// staticIPs returns the static configuration of the discovered addresses of an interface, nil for the families
+// without addresses. IPv6 link-local addresses are skipped as they are generated by the host.
+func staticIPs(iface *models.Interface) (*nmstateIP, *nmstateIP) {
+	return staticIP(iface.IPV4Addresses, false), staticIP(iface.IPV6Addresses, true)
+}
+
+func staticIP(cidrs []string, ipv6 bool) *nmstateIP {
+	var addresses []*nmstateAddress
+	for _, cidr := range cidrs {
+		ip, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil || ip.IsLinkLocalUnicast() {
+			continue
+		}
+		prefixLength, _ := ipNet.Mask.Size()
+		addresses = append(addresses, &nmstateAddress{IP: ip.String(), PrefixLength: prefixLength})
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+	ret := &nmstateIP{Enabled: true, DHCP: swag.Bool(false), Address: addresses}
+	if ipv6 {
+		ret.Autoconf = swag.Bool(false)
+	}
+	return ret
+}
+
+func mergeIPs(ip1, ip2 *nmstateIP) *nmstateIP {
+	if ip1 == nil {
+		return ip2
+	}
+	if ip2 != nil {
+		ip1.Address = append(ip1.Address, ip2.Address...)
+	}
+	return ip1
+}
+
+func disabledIP() *nmstateIP {
+	return &nmstateIP{Enabled: false}
+}
+
+func orDisabled(ip *nmstateIP) *nmstateIP {
+	if ip == nil {
+		return disabledIP()
+	}
+	return ip
+}
+
+// defaultRoute returns the default route of the family with the lowest metric
+func defaultRoute(routes []*models.Route, family int32) *models.Route {
+	var ret *models.Route
+	for _, r := range routes {
+		if r.Family != family {
+			continue
+		}
+		gw := net.ParseIP(r.Gateway)
+		dst := net.ParseIP(strings.Split(r.Destination, "/")[0])
+		if gw == nil || gw.IsUnspecified() || dst == nil || !dst.IsUnspecified() {
+			continue
+		}
+		if ret == nil || r.Metric < ret.Metric {
+			ret = r
+		}
+	}
+	return ret
+}
@@ -0,0 +1,193 @@
+package staticnetworkconfig_test
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	snc "github.com/openshift/assisted-service/pkg/staticnetworkconfig"
+)
+
+var _ = Describe("GenerateFromInventory", func() {
+	var inventory *models.Inventory
+
+	BeforeEach(func() {
+		inventory = &models.Inventory{
+			Interfaces: []*models.Interface{
+				{
+					Name:          "ens3",
+					Type:          "physical",
+					MacAddress:    "52:54:00:AA:BB:01",
+					IPV4Addresses: []string{"192.0.2.10/24"},
+					IPV6Addresses: []string{"fe80::5054:ff:feaa:bb01/64", "2001:db8::10/64"},
+				},
+				{
+					Name:       "ens4",
+					Type:       "physical",
+					MacAddress: "52:54:00:aa:bb:02",
+				},
+				{
+					Name:       "ens5",
+					Type:       "physical",
+					MacAddress: "52:54:00:aa:bb:03",
+				},
+			},
+			Routes: []*models.Route{
+				{Family: 2, Interface: "ens3", Destination: "0.0.0.0", Gateway: "192.0.2.1", Metric: 100},
+				{Family: 2, Interface: "ens3", Destination: "192.0.2.0", Gateway: "0.0.0.0", Metric: 100},
+				{Family: 10, Interface: "ens3", Destination: "::", Gateway: "2001:db8::1", Metric: 100},
+			},
+		}
+	})
+
+	It("keeps the discovered addresses and default routes of the physical interfaces", func() {
+		config, err := snc.GenerateFromInventory(inventory, &models.HostStaticNetworkConfigGenerateParams{
+			DNSServers: []string{"192.0.2.53"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.MacInterfaceMap).To(ConsistOf(
+			&models.MacInterfaceMapItems0{LogicalNicName: "ens3", MacAddress: "52:54:00:aa:bb:01"},
+		))
+		Expect(config.NetworkYaml).To(MatchYAML(`dns-resolver:
+  config:
+    server:
+    - 192.0.2.53
+interfaces:
+- name: ens3
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    dhcp: false
+    address:
+    - ip: 192.0.2.10
+      prefix-length: 24
+  ipv6:
+    enabled: true
+    dhcp: false
+    autoconf: false
+    address:
+    - ip: 2001:db8::10
+      prefix-length: 64
+routes:
+  config:
+  - destination: 0.0.0.0/0
+    next-hop-address: 192.0.2.1
+    next-hop-interface: ens3
+  - destination: ::/0
+    next-hop-address: 2001:db8::1
+    next-hop-interface: ens3
+`))
+	})
+
+	It("moves the addresses of the ports to their bond and creates the VLANs", func() {
+		config, err := snc.GenerateFromInventory(inventory, &models.HostStaticNetworkConfigGenerateParams{
+			Bonds: []*models.StaticNetworkConfigBond{
+				{Name: swag.String("bond0"), Mode: swag.String(models.StaticNetworkConfigBondModeNr8023ad), Ports: []string{"ens3", "ens4"}},
+			},
+			Vlans: []*models.StaticNetworkConfigVlan{
+				{BaseInterface: swag.String("ens5"), ID: swag.Int64(100)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.MacInterfaceMap).To(ConsistOf(
+			&models.MacInterfaceMapItems0{LogicalNicName: "ens3", MacAddress: "52:54:00:aa:bb:01"},
+			&models.MacInterfaceMapItems0{LogicalNicName: "ens4", MacAddress: "52:54:00:aa:bb:02"},
+			&models.MacInterfaceMapItems0{LogicalNicName: "ens5", MacAddress: "52:54:00:aa:bb:03"},
+		))
+		Expect(config.NetworkYaml).To(MatchYAML(`interfaces:
+- name: ens3
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: false
+  ipv6:
+    enabled: false
+- name: ens4
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: false
+  ipv6:
+    enabled: false
+- name: ens5
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: false
+  ipv6:
+    enabled: false
+- name: bond0
+  type: bond
+  state: up
+  link-aggregation:
+    mode: 802.3ad
+    port:
+    - ens3
+    - ens4
+  ipv4:
+    enabled: true
+    dhcp: false
+    address:
+    - ip: 192.0.2.10
+      prefix-length: 24
+  ipv6:
+    enabled: true
+    dhcp: false
+    autoconf: false
+    address:
+    - ip: 2001:db8::10
+      prefix-length: 64
+- name: ens5.100
+  type: vlan
+  state: up
+  vlan:
+    base-iface: ens5
+    id: 100
+  ipv4:
+    enabled: false
+  ipv6:
+    enabled: false
+routes:
+  config:
+  - destination: 0.0.0.0/0
+    next-hop-address: 192.0.2.1
+    next-hop-interface: bond0
+  - destination: ::/0
+    next-hop-address: 2001:db8::1
+    next-hop-interface: bond0
+`))
+	})
+
+	It("refuses bonds of unknown interfaces", func() {
+		_, err := snc.GenerateFromInventory(inventory, &models.HostStaticNetworkConfigGenerateParams{
+			Bonds: []*models.StaticNetworkConfigBond{{Name: swag.String("bond0"), Ports: []string{"ens3", "eth9"}}},
+		})
+		Expect(err).To(MatchError(ContainSubstring("port eth9 of bond bond0 isn't a physical interface")))
+	})
+
+	It("refuses interfaces in several bonds", func() {
+		_, err := snc.GenerateFromInventory(inventory, &models.HostStaticNetworkConfigGenerateParams{
+			Bonds: []*models.StaticNetworkConfigBond{
+				{Name: swag.String("bond0"), Ports: []string{"ens3", "ens4"}},
+				{Name: swag.String("bond1"), Ports: []string{"ens4", "ens5"}},
+			},
+		})
+		Expect(err).To(MatchError(ContainSubstring("interface ens4 is a port of both bond bond0 and bond bond1")))
+	})
+
+	It("refuses VLANs on bond ports", func() {
+		_, err := snc.GenerateFromInventory(inventory, &models.HostStaticNetworkConfigGenerateParams{
+			Bonds: []*models.StaticNetworkConfigBond{{Name: swag.String("bond0"), Ports: []string{"ens3", "ens4"}}},
+			Vlans: []*models.StaticNetworkConfigVlan{{BaseInterface: swag.String("ens4"), ID: swag.Int64(10)}},
+		})
+		Expect(err).To(MatchError(ContainSubstring("base interface ens4 of VLAN 10 is a port of bond bond0")))
+	})
+
+	It("fails when no address was discovered", func() {
+		inventory.Interfaces[0].IPV4Addresses = nil
+		inventory.Interfaces[0].IPV6Addresses = []string{"fe80::5054:ff:feaa:bb01/64"}
+		_, err := snc.GenerateFromInventory(inventory, nil)
+		Expect(err).To(MatchError(ContainSubstring("no address was discovered")))
+	})
+})
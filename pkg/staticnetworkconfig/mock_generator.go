@@ -108,3 +108,17 @@ func (mr *MockStaticNetworkConfigMockRecorder) ValidateStaticConfigParamsYAML(st
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateStaticConfigParamsYAML", reflect.TypeOf((*MockStaticNetworkConfig)(nil).ValidateStaticConfigParamsYAML), staticNetworkConfig)
 }
+
+// ValidateStaticNetworkConfigPerHost mocks base method.
+func (m *MockStaticNetworkConfig) ValidateStaticNetworkConfigPerHost(staticNetworkConfig []*models.HostStaticNetworkConfig, expectedSubnets []string) *models.StaticNetworkConfigValidation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateStaticNetworkConfigPerHost", staticNetworkConfig, expectedSubnets)
+	ret0, _ := ret[0].(*models.StaticNetworkConfigValidation)
+	return ret0
+}
+
+// ValidateStaticNetworkConfigPerHost indicates an expected call of ValidateStaticNetworkConfigPerHost.
+func (mr *MockStaticNetworkConfigMockRecorder) ValidateStaticNetworkConfigPerHost(staticNetworkConfig, expectedSubnets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateStaticNetworkConfigPerHost", reflect.TypeOf((*MockStaticNetworkConfig)(nil).ValidateStaticNetworkConfigPerHost), staticNetworkConfig, expectedSubnets)
+}
@@ -0,0 +1,268 @@
+package staticnetworkconfig
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"gopkg.in/yaml.v2"
+)
+
+type hostAddress struct {
+	iface string
+	ip    net.IP
+	ipNet *net.IPNet
+}
+
+// ValidateStaticNetworkConfigPerHost validates the configuration of each host independently and reports all the
+// errors found, instead of stopping at the first one like ValidateStaticConfigParamsYAML. The addresses of the
+// hosts are checked against the expected subnets and against the addresses of the other hosts. The NetworkManager
+// keyfiles generated by nmstate are returned for the hosts without errors.
+func (s *StaticNetworkConfigGenerator) ValidateStaticNetworkConfigPerHost(staticNetworkConfig []*models.HostStaticNetworkConfig,
+	expectedSubnets []string) *models.StaticNetworkConfigValidation {
+	var subnets []*net.IPNet
+	for _, subnet := range expectedSubnets {
+		_, ipNet, err := net.ParseCIDR(subnet)
+		if err != nil {
+			s.log.WithError(err).Warnf("Ignoring invalid expected subnet %s", subnet)
+			continue
+		}
+		subnets = append(subnets, ipNet)
+	}
+
+	ret := &models.StaticNetworkConfigValidation{
+		Valid: swag.Bool(true),
+		Hosts: []*models.StaticNetworkConfigHostValidation{},
+	}
+	ipOwners := make(map[string]int)
+	for i, hostConfig := range staticNetworkConfig {
+		hostErrors, addresses, parsed := s.validateHostStaticNetworkConfig(hostConfig, subnets)
+		for _, address := range addresses {
+			key := address.ip.String()
+			if owner, ok := ipOwners[key]; ok && owner != i {
+				hostErrors = append(hostErrors, newValidationError(models.StaticNetworkConfigValidationErrorCodeDuplicateIP,
+					address.iface, "address %s is also used by host %d", key, owner))
+				continue
+			}
+			ipOwners[key] = i
+		}
+
+		hostValidation := &models.StaticNetworkConfigHostValidation{HostIndex: swag.Int64(int64(i))}
+		if parsed {
+			output, err := s.generateConfiguration(hostConfig.NetworkYaml)
+			if err != nil {
+				hostErrors = append(hostErrors, newValidationError(models.StaticNetworkConfigValidationErrorCodeNmstateError, "", "%s", err.Error()))
+			} else if len(hostErrors) == 0 {
+				files, err := s.createNMConnectionFiles(output, "")
+				if err != nil {
+					hostErrors = append(hostErrors, newValidationError(models.StaticNetworkConfigValidationErrorCodeNmstateError, "", "%s", err.Error()))
+				}
+				for _, file := range files {
+					hostValidation.NmconnectionFiles = append(hostValidation.NmconnectionFiles, &models.StaticNetworkConfigFile{
+						Path:     file.FilePath,
+						Contents: file.FileContents,
+					})
+				}
+			}
+		}
+		hostValidation.Errors = hostErrors
+		hostValidation.Valid = swag.Bool(len(hostErrors) == 0)
+		if len(hostErrors) > 0 {
+			ret.Valid = swag.Bool(false)
+		}
+		ret.Hosts = append(ret.Hosts, hostValidation)
+	}
+	return ret
+}
+
+// validateHostStaticNetworkConfig runs the checks that don't need nmstate on the configuration of a host. It returns
+// the errors found, the static addresses of the host and whether its network yaml could be parsed.
+func (s *StaticNetworkConfigGenerator) validateHostStaticNetworkConfig(hostConfig *models.HostStaticNetworkConfig,
+	subnets []*net.IPNet) ([]*models.StaticNetworkConfigValidationError, []*hostAddress, bool) {
+	var ret []*models.StaticNetworkConfigValidationError
+
+	if len(hostConfig.MacInterfaceMap) == 0 {
+		ret = append(ret, newValidationError(models.StaticNetworkConfigValidationErrorCodeMissingMacMapping, "",
+			"at least one interface must be mapped to a MAC address"))
+	}
+	mappedInterfaces := make(map[string]struct{})
+	macs := make(map[string]string)
+	for _, item := range hostConfig.MacInterfaceMap {
+		if _, ok := mappedInterfaces[item.LogicalNicName]; ok {
+			ret = append(ret, newValidationError(models.StaticNetworkConfigValidationErrorCodeDuplicateMacMapping, item.LogicalNicName,
+				"interface %s is mapped more than once", item.LogicalNicName))
+		}
+		mappedInterfaces[item.LogicalNicName] = struct{}{}
+		mac := strings.ToLower(item.MacAddress)
+		if other, ok := macs[mac]; ok {
+			ret = append(ret, newValidationError(models.StaticNetworkConfigValidationErrorCodeDuplicateMacMapping, item.LogicalNicName,
+				"MAC address %s is mapped to both interface %s and interface %s", item.MacAddress, other, item.LogicalNicName))
+		}
+		macs[mac] = item.LogicalNicName
+	}
+
+	var state nmstateState
+	if hostConfig.NetworkYaml == "" {
+		return append(ret, newValidationError(models.StaticNetworkConfigValidationErrorCodeInvalidYaml, "", "the network yaml is empty")), nil, false
+	}
+	if err := yaml.Unmarshal([]byte(hostConfig.NetworkYaml), &state); err != nil {
+		return append(ret, newValidationError(models.StaticNetworkConfigValidationErrorCodeInvalidYaml, "", "%s", err.Error())), nil, false
+	}
+
+	interfaces := make(map[string]*nmstateInterface)
+	for _, iface := range state.Interfaces {
+		if iface == nil || iface.Name == "" {
+			ret = append(ret, newValidationError(models.StaticNetworkConfigValidationErrorCodeInvalidYaml, "",
+				"interface name not found in networks configuration"))
+			continue
+		}
+		interfaces[iface.Name] = iface
+	}
+	isMapped := func(name string) bool {
+		if _, ok := mappedInterfaces[name]; ok {
+			return true
+		}
+		if iface, ok := interfaces[name]; ok && iface.Identifier == "mac-address" {
+			return true
+		}
+		return predicatableIfaceNamePattern.MatchString(name)
+	}
+	for _, iface := range state.Interfaces {
+		if iface == nil || iface.Name == "" {
+			continue
+		}
+		switch iface.Type {
+		case "802-3-ethernet", "ethernet":
+			if !isMapped(iface.Name) {
+				ret = append(ret, newValidationError(models.StaticNetworkConfigValidationErrorCodeMissingMacMapping, iface.Name,
+					"mac-interface mapping for interface %s is missing and not a physical interface", iface.Name))
+			}
+		case "bond":
+			if iface.LinkAggregation == nil {
+				continue
+			}
+			for _, port := range iface.LinkAggregation.Port {
+				if !isMapped(port) {
+					ret = append(ret, newValidationError(models.StaticNetworkConfigValidationErrorCodeMissingMacMapping, port,
+						"mac-interface mapping for port %s of bond %s is missing and not a physical interface", port, iface.Name))
+				}
+			}
+		}
+	}
+
+	var addresses []*hostAddress
+	seen := make(map[string]string)
+	for _, iface := range state.Interfaces {
+		if iface == nil || iface.Name == "" {
+			continue
+		}
+		for _, ip := range []*nmstateIP{iface.IPv4, iface.IPv6} {
+			if ip == nil {
+				continue
+			}
+			for _, address := range ip.Address {
+				if address == nil {
+					continue
+				}
+				cidr := fmt.Sprintf("%s/%d", address.IP, address.PrefixLength)
+				parsedIP, ipNet, err := net.ParseCIDR(cidr)
+				if err != nil {
+					ret = append(ret, newValidationError(models.StaticNetworkConfigValidationErrorCodeInvalidYaml, iface.Name,
+						"address %s of interface %s is invalid", cidr, iface.Name))
+					continue
+				}
+				if other, ok := seen[parsedIP.String()]; ok {
+					ret = append(ret, newValidationError(models.StaticNetworkConfigValidationErrorCodeDuplicateIP, iface.Name,
+						"address %s is also set on interface %s", parsedIP, other))
+					continue
+				}
+				seen[parsedIP.String()] = iface.Name
+				if !inExpectedSubnets(parsedIP, subnets) {
+					ret = append(ret, newValidationError(models.StaticNetworkConfigValidationErrorCodeIPOutsideSubnet, iface.Name,
+						"address %s of interface %s isn't in any of the expected subnets", parsedIP, iface.Name))
+				}
+				addresses = append(addresses, &hostAddress{iface: iface.Name, ip: parsedIP, ipNet: ipNet})
+			}
+		}
+	}
+
+	if state.Routes != nil {
+		for _, route := range state.Routes.Config {
+			if route == nil {
+				continue
+			}
+			if err := validateRoute(route, interfaces, addresses); err != nil {
+				ret = append(ret, newValidationError(models.StaticNetworkConfigValidationErrorCodeInvalidRoute, route.NextHopInterface,
+					"route to %s: %s", route.Destination, err.Error()))
+			}
+		}
+	}
+	return ret, addresses, true
+}
+
+// validateRoute checks that the destination and the next hop of a route are valid, and that the next hop is in the
+// subnet of one of the static addresses of the next hop interface, if it has static addresses of the family.
+func validateRoute(route *nmstateRoute, interfaces map[string]*nmstateInterface, addresses []*hostAddress) error {
+	_, destination, err := net.ParseCIDR(route.Destination)
+	if err != nil {
+		return fmt.Errorf("destination %s isn't a valid subnet", route.Destination)
+	}
+	if route.NextHopInterface != "" {
+		if _, ok := interfaces[route.NextHopInterface]; !ok {
+			return fmt.Errorf("next hop interface %s isn't defined", route.NextHopInterface)
+		}
+	}
+	if route.NextHopAddress == "" {
+		return nil
+	}
+	nextHop := net.ParseIP(route.NextHopAddress)
+	if nextHop == nil {
+		return fmt.Errorf("next hop address %s isn't a valid address", route.NextHopAddress)
+	}
+	if (nextHop.To4() == nil) != (destination.IP.To4() == nil) {
+		return fmt.Errorf("next hop address %s and destination aren't of the same family", route.NextHopAddress)
+	}
+	if route.NextHopInterface == "" {
+		return nil
+	}
+	hasStaticAddresses := false
+	for _, address := range addresses {
+		if address.iface != route.NextHopInterface || (address.ip.To4() == nil) != (nextHop.To4() == nil) {
+			continue
+		}
+		if address.ipNet.Contains(nextHop) {
+			return nil
+		}
+		hasStaticAddresses = true
+	}
+	if hasStaticAddresses {
+		return fmt.Errorf("next hop address %s isn't in the subnets of interface %s", route.NextHopAddress, route.NextHopInterface)
+	}
+	return nil
+}
+
+// inExpectedSubnets returns whether the address is in one of the subnets of its family. Addresses of a family
+// without subnets are always accepted.
+func inExpectedSubnets(ip net.IP, subnets []*net.IPNet) bool {
+	hasFamily := false
+	for _, subnet := range subnets {
+		if (subnet.IP.To4() == nil) != (ip.To4() == nil) {
+			continue
+		}
+		if subnet.Contains(ip) {
+			return true
+		}
+		hasFamily = true
+	}
+	return !hasFamily
+}
+
+func newValidationError(code, iface, format string, args ...interface{}) *models.StaticNetworkConfigValidationError {
+	return &models.StaticNetworkConfigValidationError{
+		Code:      swag.String(code),
+		Interface: iface,
+		Message:   swag.String(fmt.Sprintf(format, args...)),
+	}
+}
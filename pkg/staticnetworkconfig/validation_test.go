@@ -0,0 +1,206 @@
+package staticnetworkconfig_test
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	snc "github.com/openshift/assisted-service/pkg/staticnetworkconfig"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("ValidateStaticNetworkConfigPerHost", func() {
+	var (
+		staticNetworkGenerator = snc.New(logrus.New(), snc.Config{MinVersionForNmstateService: common.MinimalVersionForNmstatectl})
+		macInterfaceMap        models.MacInterfaceMap
+	)
+
+	hostErrors := func(validation *models.StaticNetworkConfigValidation, index int, code string) []*models.StaticNetworkConfigValidationError {
+		var ret []*models.StaticNetworkConfigValidationError
+		for _, validationError := range validation.Hosts[index].Errors {
+			if swag.StringValue(validationError.Code) == code {
+				ret = append(ret, validationError)
+			}
+		}
+		return ret
+	}
+
+	BeforeEach(func() {
+		macInterfaceMap = models.MacInterfaceMap{{LogicalNicName: "eth0", MacAddress: "52:54:00:aa:bb:01"}}
+	})
+
+	It("previews the keyfiles of valid hosts", func() {
+		validation := staticNetworkGenerator.ValidateStaticNetworkConfigPerHost([]*models.HostStaticNetworkConfig{{
+			MacInterfaceMap: macInterfaceMap,
+			NetworkYaml: `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    address:
+    - ip: 192.0.2.10
+      prefix-length: 24`,
+		}}, []string{"192.0.2.0/24"})
+		Expect(swag.BoolValue(validation.Valid)).To(BeTrue())
+		Expect(validation.Hosts).To(HaveLen(1))
+		Expect(validation.Hosts[0].Errors).To(BeEmpty())
+		Expect(validation.Hosts[0].NmconnectionFiles).ToNot(BeEmpty())
+		Expect(validation.Hosts[0].NmconnectionFiles[0].Path).To(Equal("eth0.nmconnection"))
+		Expect(validation.Hosts[0].NmconnectionFiles[0].Contents).To(ContainSubstring("address0=192.0.2.10/24"))
+	})
+
+	It("reports invalid yaml", func() {
+		validation := staticNetworkGenerator.ValidateStaticNetworkConfigPerHost([]*models.HostStaticNetworkConfig{
+			{MacInterfaceMap: macInterfaceMap, NetworkYaml: "interfaces: [: bad"},
+		}, nil)
+		Expect(swag.BoolValue(validation.Valid)).To(BeFalse())
+		Expect(validation.Hosts[0].Errors).To(HaveLen(1))
+		Expect(hostErrors(validation, 0, models.StaticNetworkConfigValidationErrorCodeInvalidYaml)).To(HaveLen(1))
+		Expect(validation.Hosts[0].NmconnectionFiles).To(BeEmpty())
+	})
+
+	It("reports the interfaces without MAC mapping", func() {
+		validation := staticNetworkGenerator.ValidateStaticNetworkConfigPerHost([]*models.HostStaticNetworkConfig{{
+			MacInterfaceMap: macInterfaceMap,
+			NetworkYaml: `interfaces:
+- name: eth1
+  type: ethernet
+  state: up
+- name: eth2
+  type: ethernet
+  state: up
+  identifier: mac-address
+  mac-address: 52:54:00:aa:bb:02
+- name: ens3
+  type: ethernet
+  state: up
+- name: bond0
+  type: bond
+  state: up
+  link-aggregation:
+    mode: active-backup
+    port:
+    - eth0
+    - eth4`,
+		}}, nil)
+		Expect(swag.BoolValue(validation.Hosts[0].Valid)).To(BeFalse())
+		missing := hostErrors(validation, 0, models.StaticNetworkConfigValidationErrorCodeMissingMacMapping)
+		Expect(missing).To(HaveLen(2))
+		Expect(missing[0].Interface).To(Equal("eth1"))
+		Expect(missing[1].Interface).To(Equal("eth4"))
+		Expect(swag.StringValue(missing[1].Message)).To(ContainSubstring("port eth4 of bond bond0"))
+	})
+
+	It("reports duplicate MAC mappings", func() {
+		validation := staticNetworkGenerator.ValidateStaticNetworkConfigPerHost([]*models.HostStaticNetworkConfig{{
+			MacInterfaceMap: models.MacInterfaceMap{
+				{LogicalNicName: "eth0", MacAddress: "52:54:00:aa:bb:01"},
+				{LogicalNicName: "eth1", MacAddress: "52:54:00:AA:BB:01"},
+			},
+			NetworkYaml: "interfaces: []",
+		}}, nil)
+		duplicates := hostErrors(validation, 0, models.StaticNetworkConfigValidationErrorCodeDuplicateMacMapping)
+		Expect(duplicates).To(HaveLen(1))
+		Expect(duplicates[0].Interface).To(Equal("eth1"))
+	})
+
+	It("reports duplicate addresses within a host and across hosts", func() {
+		validation := staticNetworkGenerator.ValidateStaticNetworkConfigPerHost([]*models.HostStaticNetworkConfig{
+			{
+				MacInterfaceMap: macInterfaceMap,
+				NetworkYaml: `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    address:
+    - ip: 192.0.2.10
+      prefix-length: 24
+    - ip: 192.0.2.10
+      prefix-length: 24`,
+			},
+			{
+				MacInterfaceMap: models.MacInterfaceMap{{LogicalNicName: "eth0", MacAddress: "52:54:00:aa:bb:02"}},
+				NetworkYaml: `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    address:
+    - ip: 192.0.2.10
+      prefix-length: 24`,
+			},
+		}, nil)
+		Expect(validation.Hosts).To(HaveLen(2))
+		Expect(hostErrors(validation, 0, models.StaticNetworkConfigValidationErrorCodeDuplicateIP)).To(HaveLen(1))
+		duplicates := hostErrors(validation, 1, models.StaticNetworkConfigValidationErrorCodeDuplicateIP)
+		Expect(duplicates).To(HaveLen(1))
+		Expect(swag.StringValue(duplicates[0].Message)).To(Equal("address 192.0.2.10 is also used by host 0"))
+	})
+
+	It("reports addresses outside of the expected subnets of their family", func() {
+		validation := staticNetworkGenerator.ValidateStaticNetworkConfigPerHost([]*models.HostStaticNetworkConfig{{
+			MacInterfaceMap: macInterfaceMap,
+			NetworkYaml: `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    address:
+    - ip: 198.51.100.10
+      prefix-length: 24
+  ipv6:
+    enabled: true
+    address:
+    - ip: 2001:db8::10
+      prefix-length: 64`,
+		}}, []string{"192.0.2.0/24"})
+		outside := hostErrors(validation, 0, models.StaticNetworkConfigValidationErrorCodeIPOutsideSubnet)
+		Expect(outside).To(HaveLen(1))
+		Expect(outside[0].Interface).To(Equal("eth0"))
+		Expect(swag.StringValue(outside[0].Message)).To(ContainSubstring("198.51.100.10"))
+	})
+
+	It("reports invalid routes", func() {
+		validation := staticNetworkGenerator.ValidateStaticNetworkConfigPerHost([]*models.HostStaticNetworkConfig{{
+			MacInterfaceMap: macInterfaceMap,
+			NetworkYaml: `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    address:
+    - ip: 192.0.2.10
+      prefix-length: 24
+routes:
+  config:
+  - destination: 0.0.0.0/0
+    next-hop-address: 192.0.2.1
+    next-hop-interface: eth0
+  - destination: 198.51.100.0/24
+    next-hop-address: 203.0.113.1
+    next-hop-interface: eth0
+  - destination: 203.0.113.0/24
+    next-hop-address: 192.0.2.1
+    next-hop-interface: eth9
+  - destination: not-a-subnet
+    next-hop-address: 192.0.2.1
+    next-hop-interface: eth0
+  - destination: ::/0
+    next-hop-address: 192.0.2.1
+    next-hop-interface: eth0`,
+		}}, nil)
+		routes := hostErrors(validation, 0, models.StaticNetworkConfigValidationErrorCodeInvalidRoute)
+		Expect(routes).To(HaveLen(4))
+		Expect(swag.StringValue(routes[0].Message)).To(ContainSubstring("isn't in the subnets of interface eth0"))
+		Expect(swag.StringValue(routes[1].Message)).To(ContainSubstring("next hop interface eth9 isn't defined"))
+		Expect(swag.StringValue(routes[2].Message)).To(ContainSubstring("isn't a valid subnet"))
+		Expect(swag.StringValue(routes[3].Message)).To(ContainSubstring("aren't of the same family"))
+	})
+})
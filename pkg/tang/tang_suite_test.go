@@ -0,0 +1,13 @@
+package tang
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTang(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tang")
+}
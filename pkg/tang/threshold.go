@@ -0,0 +1,142 @@
+package tang
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// ClevisSSSPin is the Clevis pin that implements the threshold policies
+const ClevisSSSPin = "sss"
+
+// ThresholdSpec is the unlock policy of the threshold disk encryption mode, which combines the TPM and the Tang
+// servers with Shamir Secret Sharing. The disk is unlocked when Threshold of its pins are available: the TPM, if
+// TPM2 is set, and the Tang servers, which are available when TangThreshold of them are reachable.
+type ThresholdSpec struct {
+	Threshold     int  `json:"threshold"`
+	TPM2          bool `json:"tpm2"`
+	TangThreshold int  `json:"tang_threshold"`
+}
+
+func UnmarshalThresholdSpec(thresholdSpecStr string) (*ThresholdSpec, error) {
+	var spec ThresholdSpec
+	if err := json.Unmarshal([]byte(thresholdSpecStr), &spec); err != nil {
+		return nil, errors.Wrap(err, "Unable to unmarshal threshold_spec")
+	}
+	return &spec, nil
+}
+
+// ValidateTangServers returns an error if one of the Tang servers doesn't have a valid URL and a thumbprint
+func ValidateTangServers(tangServers []TangServer) error {
+	for _, ts := range tangServers {
+		if _, err := url.ParseRequestURI(ts.Url); err != nil {
+			return errors.Wrap(err, "Tang URL isn't valid")
+		}
+		if ts.Thumbprint == "" {
+			return errors.New("Tang thumbprint isn't set")
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the policy can't be satisfied by its pins
+func (s *ThresholdSpec) Validate(tangServers []TangServer) error {
+	if len(tangServers) == 0 {
+		return errors.New("The threshold mode requires at least one Tang server")
+	}
+	if s.TangThreshold < 1 || s.TangThreshold > len(tangServers) {
+		return errors.Errorf("The Tang threshold must be between 1 and the number of Tang servers (%d), got %d",
+			len(tangServers), s.TangThreshold)
+	}
+	if s.Threshold < 1 || s.Threshold > s.pinCount() {
+		return errors.Errorf("The threshold must be between 1 and the number of pins (%d), got %d", s.pinCount(), s.Threshold)
+	}
+	return nil
+}
+
+// pinCount returns the number of pins of the policy, the Tang servers count as a single pin
+func (s *ThresholdSpec) pinCount() int {
+	if s.TPM2 {
+		return 2
+	}
+	return 1
+}
+
+type clevisTang struct {
+	Url        string `json:"url"`
+	Thumbprint string `json:"thp"`
+}
+
+type clevisSSS struct {
+	Threshold int                        `json:"t"`
+	Pins      map[string]json.RawMessage `json:"pins"`
+}
+
+// ClevisConfig returns the configuration of the Clevis sss pin that implements the policy
+func (s *ThresholdSpec) ClevisConfig(tangServers []TangServer) (string, error) {
+	tangPins := make([]clevisTang, 0, len(tangServers))
+	for _, ts := range tangServers {
+		tangPins = append(tangPins, clevisTang{Url: ts.Url, Thumbprint: ts.Thumbprint})
+	}
+	tangConfig, err := json.Marshal(tangPins)
+	if err != nil {
+		return "", err
+	}
+	tangSSS := clevisSSS{Threshold: s.TangThreshold, Pins: map[string]json.RawMessage{"tang": tangConfig}}
+
+	config := tangSSS
+	if s.TPM2 {
+		tangSSSConfig, err := json.Marshal(tangSSS)
+		if err != nil {
+			return "", err
+		}
+		config = clevisSSS{
+			Threshold: s.Threshold,
+			Pins: map[string]json.RawMessage{
+				"tpm2":       json.RawMessage("{}"),
+				ClevisSSSPin: tangSSSConfig,
+			},
+		}
+	}
+	ret, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+// ParseClevisConfig returns the policy and the Tang servers of the configuration of a Clevis sss pin, as generated
+// by ClevisConfig
+func ParseClevisConfig(config string) (*ThresholdSpec, []TangServer, error) {
+	var sss clevisSSS
+	if err := json.Unmarshal([]byte(config), &sss); err != nil {
+		return nil, nil, errors.Wrap(err, "Unable to unmarshal the Clevis sss configuration")
+	}
+	spec := &ThresholdSpec{Threshold: 1, TangThreshold: sss.Threshold}
+	if _, ok := sss.Pins["tpm2"]; ok {
+		spec.TPM2 = true
+		spec.Threshold = sss.Threshold
+		nested, ok := sss.Pins[ClevisSSSPin]
+		if !ok {
+			return spec, nil, nil
+		}
+		sss = clevisSSS{}
+		if err := json.Unmarshal(nested, &sss); err != nil {
+			return nil, nil, errors.Wrap(err, "Unable to unmarshal the nested Clevis sss configuration")
+		}
+		spec.TangThreshold = sss.Threshold
+	}
+
+	var tangPins []clevisTang
+	if tangConfig, ok := sss.Pins["tang"]; ok {
+		if err := json.Unmarshal(tangConfig, &tangPins); err != nil {
+			return nil, nil, errors.Wrap(err, "Unable to unmarshal the Clevis tang pins")
+		}
+	}
+	tangServers := make([]TangServer, 0, len(tangPins))
+	for _, pin := range tangPins {
+		tangServers = append(tangServers, TangServer{Url: pin.Url, Thumbprint: pin.Thumbprint})
+	}
+	return spec, tangServers, nil
+}
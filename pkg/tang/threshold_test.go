@@ -0,0 +1,78 @@
+package tang
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Threshold spec", func() {
+	tangServers := []TangServer{
+		{Url: "http://tang1.example.com:7500", Thumbprint: "PLjNyRdGw03zlRoGjQYMahSZGu9"},
+		{Url: "http://tang2.example.com:7500", Thumbprint: "XYjNyRdGw03zlRoGjQYMahSZGu3"},
+	}
+
+	It("unmarshals the policy", func() {
+		spec, err := UnmarshalThresholdSpec(`{"threshold":2,"tpm2":true,"tang_threshold":1}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*spec).To(Equal(ThresholdSpec{Threshold: 2, TPM2: true, TangThreshold: 1}))
+
+		_, err = UnmarshalThresholdSpec("not json")
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("validates the policy",
+		func(spec ThresholdSpec, servers []TangServer, errorSubstring string) {
+			err := spec.Validate(servers)
+			if errorSubstring == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(errorSubstring)))
+			}
+		},
+		Entry("TPM and Tang", ThresholdSpec{Threshold: 2, TPM2: true, TangThreshold: 2}, tangServers, ""),
+		Entry("TPM or Tang", ThresholdSpec{Threshold: 1, TPM2: true, TangThreshold: 1}, tangServers, ""),
+		Entry("Tang only", ThresholdSpec{Threshold: 1, TangThreshold: 2}, tangServers, ""),
+		Entry("no Tang server", ThresholdSpec{Threshold: 1, TPM2: true, TangThreshold: 1}, nil, "at least one Tang server"),
+		Entry("too many Tang servers required", ThresholdSpec{Threshold: 1, TangThreshold: 3}, tangServers, "number of Tang servers"),
+		Entry("no Tang server required", ThresholdSpec{Threshold: 1, TangThreshold: 0}, tangServers, "number of Tang servers"),
+		Entry("too many pins required", ThresholdSpec{Threshold: 2, TangThreshold: 1}, tangServers, "number of pins"),
+		Entry("no pin required", ThresholdSpec{Threshold: 0, TPM2: true, TangThreshold: 1}, tangServers, "number of pins"),
+	)
+
+	It("generates the clevis configuration of a policy combining the TPM and the Tang servers", func() {
+		spec := ThresholdSpec{Threshold: 2, TPM2: true, TangThreshold: 1}
+		config, err := spec.ClevisConfig(tangServers)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(MatchJSON(`{"t":2,"pins":{"tpm2":{},"sss":{"t":1,"pins":{"tang":[` +
+			`{"url":"http://tang1.example.com:7500","thp":"PLjNyRdGw03zlRoGjQYMahSZGu9"},` +
+			`{"url":"http://tang2.example.com:7500","thp":"XYjNyRdGw03zlRoGjQYMahSZGu3"}]}}}}`))
+
+		parsedSpec, parsedServers, err := ParseClevisConfig(config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*parsedSpec).To(Equal(spec))
+		Expect(parsedServers).To(Equal(tangServers))
+	})
+
+	It("generates the clevis configuration of a policy of Tang servers only", func() {
+		spec := ThresholdSpec{Threshold: 1, TangThreshold: 2}
+		config, err := spec.ClevisConfig(tangServers)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(MatchJSON(`{"t":2,"pins":{"tang":[` +
+			`{"url":"http://tang1.example.com:7500","thp":"PLjNyRdGw03zlRoGjQYMahSZGu9"},` +
+			`{"url":"http://tang2.example.com:7500","thp":"XYjNyRdGw03zlRoGjQYMahSZGu3"}]}}`))
+
+		parsedSpec, parsedServers, err := ParseClevisConfig(config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*parsedSpec).To(Equal(spec))
+		Expect(parsedServers).To(Equal(tangServers))
+	})
+
+	It("validates the Tang servers", func() {
+		Expect(ValidateTangServers(tangServers)).To(Succeed())
+		Expect(ValidateTangServers([]TangServer{{Url: "tang.example.com", Thumbprint: "abc"}})).
+			To(MatchError(ContainSubstring("Tang URL isn't valid")))
+		Expect(ValidateTangServers([]TangServer{{Url: "http://tang.example.com"}})).
+			To(MatchError(ContainSubstring("Tang thumbprint isn't set")))
+	})
+})
@@ -15,11 +15,13 @@ import (
 	"github.com/go-openapi/runtime/security"
 
 	"github.com/openshift/assisted-service/restapi/operations"
+	"github.com/openshift/assisted-service/restapi/operations/audit"
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/openshift/assisted-service/restapi/operations/service_accounts"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
 )
 
@@ -27,6 +29,14 @@ type contextKey string
 
 const AuthKey contextKey = "Auth"
 
+//go:generate mockery -name AuditAPI -inpkg
+
+/* AuditAPI  */
+type AuditAPI interface {
+	/* V2ListAuditEvents Retrieves the audit log of the API requests that changed resources, the most recent first. */
+	V2ListAuditEvents(ctx context.Context, params audit.V2ListAuditEventsParams) middleware.Responder
+}
+
 //go:generate mockery -name EventsAPI -inpkg
 
 /* EventsAPI  */
@@ -136,6 +146,9 @@ type InstallerAPI interface {
 	/* V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%. */
 	V2CompleteInstallation(ctx context.Context, params installer.V2CompleteInstallationParams) middleware.Responder
 
+	/* V2DeleteHostBmcCredentials Removes the credentials of the BMC of the host. */
+	V2DeleteHostBmcCredentials(ctx context.Context, params installer.V2DeleteHostBmcCredentialsParams) middleware.Responder
+
 	/* V2DeregisterCluster Deletes an OpenShift cluster definition. */
 	V2DeregisterCluster(ctx context.Context, params installer.V2DeregisterClusterParams) middleware.Responder
 
@@ -148,6 +161,9 @@ type InstallerAPI interface {
 	/* V2DownloadInfraEnvFiles Downloads the customized ignition file for this host */
 	V2DownloadInfraEnvFiles(ctx context.Context, params installer.V2DownloadInfraEnvFilesParams) middleware.Responder
 
+	/* V2GenerateHostStaticNetworkConfig Generates the static network configuration of a host from its discovered inventory, and optionally applies it to the infra-env of the host. */
+	V2GenerateHostStaticNetworkConfig(ctx context.Context, params installer.V2GenerateHostStaticNetworkConfigParams) middleware.Responder
+
 	/* V2GetCluster Retrieves the details of the OpenShift cluster. */
 	V2GetCluster(ctx context.Context, params installer.V2GetClusterParams) middleware.Responder
 
@@ -163,15 +179,27 @@ type InstallerAPI interface {
 	/* V2GetIgnoredValidations Fetch the validations which are to be ignored for this cluster. */
 	V2GetIgnoredValidations(ctx context.Context, params installer.V2GetIgnoredValidationsParams) middleware.Responder
 
+	/* V2GetInfraEnvVirtualMediaBoot Retrieves the status of the last virtual media boot of the infra-env. */
+	V2GetInfraEnvVirtualMediaBoot(ctx context.Context, params installer.V2GetInfraEnvVirtualMediaBootParams) middleware.Responder
+
 	/* V2GetNextSteps Retrieves the next operations that the host agent needs to perform. */
 	V2GetNextSteps(ctx context.Context, params installer.V2GetNextStepsParams) middleware.Responder
 
 	/* V2GetPreflightRequirements Get preflight requirements for a cluster. */
 	V2GetPreflightRequirements(ctx context.Context, params installer.V2GetPreflightRequirementsParams) middleware.Responder
 
+	/* V2GetQuotaUsage Retrieves the usage and the quotas of the resources of the organization of the user. */
+	V2GetQuotaUsage(ctx context.Context, params installer.V2GetQuotaUsageParams) middleware.Responder
+
+	/* V2HostPowerAction Runs a power action on the host through its BMC and returns once the BMC accepted it. The BMC credentials of the host must be set. */
+	V2HostPowerAction(ctx context.Context, params installer.V2HostPowerActionParams) middleware.Responder
+
 	/* V2ImportCluster Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster */
 	V2ImportCluster(ctx context.Context, params installer.V2ImportClusterParams) middleware.Responder
 
+	/* V2InfraEnvVirtualMediaBoot Boots servers from the discovery ISO of the infra-env. The ISO is mounted as Redfish virtual media through the BMC of each server, set as the next boot device and the server is powered on or rebooted. The servers are booted in the background, the status of each BMC is returned by the GET request. */
+	V2InfraEnvVirtualMediaBoot(ctx context.Context, params installer.V2InfraEnvVirtualMediaBootParams) middleware.Responder
+
 	/* V2InstallCluster Installs the OpenShift cluster. */
 	V2InstallCluster(ctx context.Context, params installer.V2InstallClusterParams) middleware.Responder
 
@@ -196,6 +224,9 @@ type InstallerAPI interface {
 	/* V2RegisterHost Registers a new OpenShift agent. */
 	V2RegisterHost(ctx context.Context, params installer.V2RegisterHostParams) middleware.Responder
 
+	/* V2RemoveHostFromCluster Removes the node of an installed host from its cluster. The node is cordoned, drained and deleted, and the host is reclaimed and reboots into discovery. Only hosts managed by the kube-api are supported, the progress is reported in the NodeRemoval condition of their agent. */
+	V2RemoveHostFromCluster(ctx context.Context, params installer.V2RemoveHostFromClusterParams) middleware.Responder
+
 	/* V2ResetCluster Resets a failed installation. */
 	V2ResetCluster(ctx context.Context, params installer.V2ResetClusterParams) middleware.Responder
 
@@ -220,6 +251,9 @@ type InstallerAPI interface {
 	/* V2UpdateHost Update an Openshift host */
 	V2UpdateHost(ctx context.Context, params installer.V2UpdateHostParams) middleware.Responder
 
+	/* V2UpdateHostBmcCredentials Sets the credentials of the BMC of the host, used to manage its power. The password is stored encrypted, the credentials can't be set unless an encryption key provider is configured. Only Redfish BMCs are supported. */
+	V2UpdateHostBmcCredentials(ctx context.Context, params installer.V2UpdateHostBmcCredentialsParams) middleware.Responder
+
 	/* V2UpdateHostIgnition Patch the ignition file for this host */
 	V2UpdateHostIgnition(ctx context.Context, params installer.V2UpdateHostIgnitionParams) middleware.Responder
 
@@ -234,6 +268,9 @@ type InstallerAPI interface {
 
 	/* V2UploadClusterIngressCert Transfer the ingress certificate for the cluster. */
 	V2UploadClusterIngressCert(ctx context.Context, params installer.V2UploadClusterIngressCertParams) middleware.Responder
+
+	/* V2ValidateStaticNetworkConfig Validates each host of a static network configuration independently, without creating an infra-env, and previews the NetworkManager keyfiles generated for the valid hosts. */
+	V2ValidateStaticNetworkConfig(ctx context.Context, params installer.V2ValidateStaticNetworkConfigParams) middleware.Responder
 }
 
 //go:generate mockery -name ManagedDomainsAPI -inpkg
@@ -287,27 +324,63 @@ type OperatorsAPI interface {
 	V2ReportMonitoredOperatorStatus(ctx context.Context, params operators.V2ReportMonitoredOperatorStatusParams) middleware.Responder
 }
 
+//go:generate mockery -name ServiceAccountsAPI -inpkg
+
+/* ServiceAccountsAPI  */
+type ServiceAccountsAPI interface {
+	/* V2CreateServiceAccount Creates a service account. API tokens are issued to service accounts for automation such as CI. */
+	V2CreateServiceAccount(ctx context.Context, params service_accounts.V2CreateServiceAccountParams) middleware.Responder
+
+	/* V2CreateServiceAccountToken Issues an API token to a service account. The secret of the token is only returned by this request. */
+	V2CreateServiceAccountToken(ctx context.Context, params service_accounts.V2CreateServiceAccountTokenParams) middleware.Responder
+
+	/* V2DeleteServiceAccount Deletes a service account and its API tokens. */
+	V2DeleteServiceAccount(ctx context.Context, params service_accounts.V2DeleteServiceAccountParams) middleware.Responder
+
+	/* V2ListServiceAccountTokens Retrieves the API tokens of a service account, without their secrets. */
+	V2ListServiceAccountTokens(ctx context.Context, params service_accounts.V2ListServiceAccountTokensParams) middleware.Responder
+
+	/* V2ListServiceAccounts Retrieves the service accounts. */
+	V2ListServiceAccounts(ctx context.Context, params service_accounts.V2ListServiceAccountsParams) middleware.Responder
+
+	/* V2RevokeServiceAccountToken Revokes an API token of a service account. */
+	V2RevokeServiceAccountToken(ctx context.Context, params service_accounts.V2RevokeServiceAccountTokenParams) middleware.Responder
+}
+
 //go:generate mockery -name VersionsAPI -inpkg
 
 /* VersionsAPI  */
 type VersionsAPI interface {
+	/* V2DeregisterCustomReleaseImage Deregisters a custom release image. */
+	V2DeregisterCustomReleaseImage(ctx context.Context, params versions.V2DeregisterCustomReleaseImageParams) middleware.Responder
+
 	/* V2ListComponentVersions List of component versions. */
 	V2ListComponentVersions(ctx context.Context, params versions.V2ListComponentVersionsParams) middleware.Responder
 
+	/* V2ListCustomReleaseImages Retrieves the custom release images that are registered by the organization of the user. */
+	V2ListCustomReleaseImages(ctx context.Context, params versions.V2ListCustomReleaseImagesParams) middleware.Responder
+
 	/* V2ListReleaseSources Retrieves openshift release sources configuration. */
 	V2ListReleaseSources(ctx context.Context, params versions.V2ListReleaseSourcesParams) middleware.Responder
 
 	/* V2ListSupportedOpenshiftVersions Retrieves the list of OpenShift supported versions. */
 	V2ListSupportedOpenshiftVersions(ctx context.Context, params versions.V2ListSupportedOpenshiftVersionsParams) middleware.Responder
+
+	/* V2RegisterCustomReleaseImage Registers a custom release image, such as a nightly or a custom built payload. The OpenShift version and
+	   the CPU architectures are read from the metadata of the release image, and the release image is available
+	   in the OpenShift versions of the organization of the user. */
+	V2RegisterCustomReleaseImage(ctx context.Context, params versions.V2RegisterCustomReleaseImageParams) middleware.Responder
 }
 
 // Config is configuration for Handler
 type Config struct {
+	AuditAPI
 	EventsAPI
 	InstallerAPI
 	ManagedDomainsAPI
 	ManifestsAPI
 	OperatorsAPI
+	ServiceAccountsAPI
 	VersionsAPI
 	Logger func(string, ...interface{})
 	// InnerMiddleware is for the handler executors. These do not apply to the swagger.json document.
@@ -638,11 +711,36 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2CompleteInstallation(ctx, params)
 	})
+	api.ServiceAccountsV2CreateServiceAccountHandler = service_accounts.V2CreateServiceAccountHandlerFunc(func(params service_accounts.V2CreateServiceAccountParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ServiceAccountsAPI.V2CreateServiceAccount(ctx, params)
+	})
+	api.ServiceAccountsV2CreateServiceAccountTokenHandler = service_accounts.V2CreateServiceAccountTokenHandlerFunc(func(params service_accounts.V2CreateServiceAccountTokenParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ServiceAccountsAPI.V2CreateServiceAccountToken(ctx, params)
+	})
+	api.InstallerV2DeleteHostBmcCredentialsHandler = installer.V2DeleteHostBmcCredentialsHandlerFunc(func(params installer.V2DeleteHostBmcCredentialsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2DeleteHostBmcCredentials(ctx, params)
+	})
+	api.ServiceAccountsV2DeleteServiceAccountHandler = service_accounts.V2DeleteServiceAccountHandlerFunc(func(params service_accounts.V2DeleteServiceAccountParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ServiceAccountsAPI.V2DeleteServiceAccount(ctx, params)
+	})
 	api.InstallerV2DeregisterClusterHandler = installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DeregisterCluster(ctx, params)
 	})
+	api.VersionsV2DeregisterCustomReleaseImageHandler = versions.V2DeregisterCustomReleaseImageHandlerFunc(func(params versions.V2DeregisterCustomReleaseImageParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.VersionsAPI.V2DeregisterCustomReleaseImage(ctx, params)
+	})
 	api.InstallerV2DeregisterHostHandler = installer.V2DeregisterHostHandlerFunc(func(params installer.V2DeregisterHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -663,6 +761,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DownloadInfraEnvFiles(ctx, params)
 	})
+	api.InstallerV2GenerateHostStaticNetworkConfigHandler = installer.V2GenerateHostStaticNetworkConfigHandlerFunc(func(params installer.V2GenerateHostStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GenerateHostStaticNetworkConfig(ctx, params)
+	})
 	api.InstallerV2GetClusterHandler = installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -688,6 +791,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetIgnoredValidations(ctx, params)
 	})
+	api.InstallerV2GetInfraEnvVirtualMediaBootHandler = installer.V2GetInfraEnvVirtualMediaBootHandlerFunc(func(params installer.V2GetInfraEnvVirtualMediaBootParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetInfraEnvVirtualMediaBoot(ctx, params)
+	})
 	api.InstallerV2GetNextStepsHandler = installer.V2GetNextStepsHandlerFunc(func(params installer.V2GetNextStepsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -698,11 +806,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetPreflightRequirements(ctx, params)
 	})
+	api.InstallerV2GetQuotaUsageHandler = installer.V2GetQuotaUsageHandlerFunc(func(params installer.V2GetQuotaUsageParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetQuotaUsage(ctx, params)
+	})
+	api.InstallerV2HostPowerActionHandler = installer.V2HostPowerActionHandlerFunc(func(params installer.V2HostPowerActionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2HostPowerAction(ctx, params)
+	})
 	api.InstallerV2ImportClusterHandler = installer.V2ImportClusterHandlerFunc(func(params installer.V2ImportClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ImportCluster(ctx, params)
 	})
+	api.InstallerV2InfraEnvVirtualMediaBootHandler = installer.V2InfraEnvVirtualMediaBootHandlerFunc(func(params installer.V2InfraEnvVirtualMediaBootParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2InfraEnvVirtualMediaBoot(ctx, params)
+	})
 	api.InstallerV2InstallClusterHandler = installer.V2InstallClusterHandlerFunc(func(params installer.V2InstallClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -713,6 +836,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2InstallHost(ctx, params)
 	})
+	api.AuditV2ListAuditEventsHandler = audit.V2ListAuditEventsHandlerFunc(func(params audit.V2ListAuditEventsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AuditAPI.V2ListAuditEvents(ctx, params)
+	})
 	api.InstallerV2ListClustersHandler = installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -723,6 +851,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.VersionsAPI.V2ListComponentVersions(ctx, params)
 	})
+	api.VersionsV2ListCustomReleaseImagesHandler = versions.V2ListCustomReleaseImagesHandlerFunc(func(params versions.V2ListCustomReleaseImagesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.VersionsAPI.V2ListCustomReleaseImages(ctx, params)
+	})
 	api.EventsV2ListEventsHandler = events.V2ListEventsHandlerFunc(func(params events.V2ListEventsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -738,6 +871,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.VersionsAPI.V2ListReleaseSources(ctx, params)
 	})
+	api.ServiceAccountsV2ListServiceAccountTokensHandler = service_accounts.V2ListServiceAccountTokensHandlerFunc(func(params service_accounts.V2ListServiceAccountTokensParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ServiceAccountsAPI.V2ListServiceAccountTokens(ctx, params)
+	})
+	api.ServiceAccountsV2ListServiceAccountsHandler = service_accounts.V2ListServiceAccountsHandlerFunc(func(params service_accounts.V2ListServiceAccountsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ServiceAccountsAPI.V2ListServiceAccounts(ctx, params)
+	})
 	api.VersionsV2ListSupportedOpenshiftVersionsHandler = versions.V2ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.V2ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -753,6 +896,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2RegisterCluster(ctx, params)
 	})
+	api.VersionsV2RegisterCustomReleaseImageHandler = versions.V2RegisterCustomReleaseImageHandlerFunc(func(params versions.V2RegisterCustomReleaseImageParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.VersionsAPI.V2RegisterCustomReleaseImage(ctx, params)
+	})
 	api.InstallerV2RegisterDisconnectedClusterHandler = installer.V2RegisterDisconnectedClusterHandlerFunc(func(params installer.V2RegisterDisconnectedClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -763,6 +911,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2RegisterHost(ctx, params)
 	})
+	api.InstallerV2RemoveHostFromClusterHandler = installer.V2RemoveHostFromClusterHandlerFunc(func(params installer.V2RemoveHostFromClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2RemoveHostFromCluster(ctx, params)
+	})
 	api.OperatorsV2ReportMonitoredOperatorStatusHandler = operators.V2ReportMonitoredOperatorStatusHandlerFunc(func(params operators.V2ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -783,6 +936,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ResetHostValidation(ctx, params)
 	})
+	api.ServiceAccountsV2RevokeServiceAccountTokenHandler = service_accounts.V2RevokeServiceAccountTokenHandlerFunc(func(params service_accounts.V2RevokeServiceAccountTokenParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ServiceAccountsAPI.V2RevokeServiceAccountToken(ctx, params)
+	})
 	api.InstallerV2SetIgnoredValidationsHandler = installer.V2SetIgnoredValidationsHandlerFunc(func(params installer.V2SetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -813,6 +971,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UpdateHost(ctx, params)
 	})
+	api.InstallerV2UpdateHostBmcCredentialsHandler = installer.V2UpdateHostBmcCredentialsHandlerFunc(func(params installer.V2UpdateHostBmcCredentialsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2UpdateHostBmcCredentials(ctx, params)
+	})
 	api.InstallerV2UpdateHostIgnitionHandler = installer.V2UpdateHostIgnitionHandlerFunc(func(params installer.V2UpdateHostIgnitionParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -838,6 +1001,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UploadClusterIngressCert(ctx, params)
 	})
+	api.InstallerV2ValidateStaticNetworkConfigHandler = installer.V2ValidateStaticNetworkConfigHandlerFunc(func(params installer.V2ValidateStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ValidateStaticNetworkConfig(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
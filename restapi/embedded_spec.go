@@ -41,6 +41,104 @@ func init() {
   "host": "api.openshift.com",
   "basePath": "/api/assisted-install",
   "paths": {
+    "/v2/audit-events": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Retrieves the audit log of the API requests that changed resources, the most recent first.",
+        "tags": [
+          "audit"
+        ],
+        "operationId": "v2ListAuditEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only the requests received at or after this time are retrieved.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only the requests received before this time are retrieved.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only the requests of this user are retrieved.",
+            "name": "username",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only the requests of the users of this organization are retrieved.",
+            "name": "org_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only the requests on this resource are retrieved.",
+            "name": "resource_id",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Number of records to skip before starting to return the records.",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/audit-event-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/clusters": {
       "get": {
         "security": [
@@ -1750,7 +1848,8 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "type": "string",
+              "x-go-custom-tag": "secret:\"true\""
             }
           }
         ],
@@ -3076,6 +3175,170 @@ func init() {
         }
       }
     },
+    "/v2/custom-release-images": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the custom release images that are registered by the organization of the user.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListCustomReleaseImages",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/custom-release-images"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Registers a custom release image, such as a nightly or a custom built payload. The OpenShift version and\nthe CPU architectures are read from the metadata of the release image, and the release image is available\nin the OpenShift versions of the organization of the user.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2RegisterCustomReleaseImage",
+        "parameters": [
+          {
+            "description": "The release image to register.",
+            "name": "new-custom-release-image-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/custom-release-image-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/custom-release-image"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/custom-release-images/{custom_release_image_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Deregisters a custom release image.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2DeregisterCustomReleaseImage",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The custom release image to deregister.",
+            "name": "custom_release_image_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/domains": {
       "get": {
         "security": [
@@ -3618,38 +3881,102 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
-          }
-        ],
-        "description": "Retrieves the details of the infra-env.",
+    "/v2/infra-envs/validate-static-network": {
+      "post": {
+        "description": "Validates each host of a static network configuration independently, without creating an infra-env, and previews the NetworkManager keyfiles generated for the valid hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnv",
+        "operationId": "v2ValidateStaticNetworkConfig",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
+            "description": "The static network configuration to validate.",
+            "name": "static-network-config-validation-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/static-network-config-validation-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/static-network-config-validation"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the infra-env.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetInfraEnv",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
             "description": "The infra-env to be retrieved.",
             "name": "infra_env_id",
             "in": "path",
@@ -3854,6 +4181,157 @@ func init() {
         }
       }
     },
+    "/v2/infra-envs/{infra_env_id}/actions/virtual-media-boot": {
+      "get": {
+        "description": "Retrieves the status of the last virtual media boot of the infra-env.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetInfraEnvVirtualMediaBoot",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose virtual media boot status is retrieved.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env-virtual-media-boot"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Boots servers from the discovery ISO of the infra-env. The ISO is mounted as Redfish virtual media through the BMC of each server, set as the next boot device and the server is powered on or rebooted. The servers are booted in the background, the status of each BMC is returned by the GET request.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2InfraEnvVirtualMediaBoot",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose discovery ISO is booted.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The BMCs of the servers to boot.",
+            "name": "infra-env-virtual-media-boot-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/infra-env-virtual-media-boot-params"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env-virtual-media-boot"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/infra-envs/{infra_env_id}/downloads/files": {
       "get": {
         "security": [
@@ -4809,18 +5287,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power": {
       "post": {
-        "description": "reset a failed host for day2 cluster.",
+        "description": "Runs a power action on the host through its BMC and returns once the BMC accepted it. The BMC credentials of the host must be set.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetHost",
+        "operationId": "v2HostPowerAction",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being reset.",
+            "description": "The infra-env of the host whose power is managed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -4828,10 +5306,19 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being reset.",
+            "description": "The host whose power is managed.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The power action to run.",
+            "name": "host-power-action-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-power-action-params"
+            }
           }
         ],
         "responses": {
@@ -4841,6 +5328,12 @@ func init() {
               "$ref": "#/definitions/host"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -4859,8 +5352,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
-            "description": "Error.",
+            "description": "Conflict.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4870,23 +5369,34 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
-        "tags": [
-          "installer"
+          },
+          "502": {
+            "description": "The BMC failed to run the action.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/remove-from-cluster": {
+      "post": {
+        "description": "Removes the node of an installed host from its cluster. The node is cordoned, drained and deleted, and the host is reclaimed and reboots into discovery. Only hosts managed by the kube-api are supported, the progress is reported in the NodeRemoval condition of their agent.",
+        "tags": [
+          "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "v2ResetHostValidation",
+        "operationId": "v2RemoveHostFromCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that its validation is being reset.",
+            "description": "The infra-env of the host that is being removed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -4894,28 +5404,29 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that its validation is being reset.",
+            "description": "The host that is being removed.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "description": "The parameters of the removal of the node.",
+            "name": "host-node-removal-params",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/host-node-removal-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4938,8 +5449,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
-            "description": "Error.",
+            "description": "Conflict.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4949,22 +5466,28 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
       "post": {
-        "description": "Unbind host to a cluster",
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UnbindHost",
+        "operationId": "v2ResetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The infra-env of the host that is being reset.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -4972,7 +5495,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being bound.",
+            "description": "The host that is being reset.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -4985,12 +5508,6 @@ func init() {
               "$ref": "#/definitions/host"
             }
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -5009,32 +5526,93 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Conflict.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
+        "tags": [
+          "installer"
+        ],
+        "summary": "Reset failed host validation.",
+        "operationId": "v2ResetHostValidation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that its validation is being reset.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
-          "500": {
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5042,18 +5620,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
-      "get": {
-        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
+      "post": {
+        "description": "Unbind host to a cluster",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHostIgnition",
+        "operationId": "UnbindHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be obtained.",
+            "description": "The infra-env of the host that is being bound.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5061,7 +5639,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be obtained.",
+            "description": "The host that is being bound.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -5071,7 +5649,13 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -5098,6 +5682,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5117,18 +5707,20 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/bmc-credentials": {
+      "put": {
+        "description": "Sets the credentials of the BMC of the host, used to manage its power. The password is stored encrypted, the credentials can't be set unless an encryption key provider is configured. Only Redfish BMCs are supported.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostIgnition",
+        "operationId": "v2UpdateHostBmcCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be updated.",
+            "description": "The infra-env of the host whose BMC credentials are set.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5136,24 +5728,27 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
+            "description": "The host whose BMC credentials are set.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
+            "description": "The credentials of the BMC of the host.",
+            "name": "host-bmc-credentials",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/host-bmc-credentials"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "400": {
             "description": "Error.",
@@ -5185,33 +5780,37 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+      },
+      "delete": {
+        "description": "Removes the credentials of the BMC of the host.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallerArgs",
+        "operationId": "v2DeleteHostBmcCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "description": "The infra-env of the host whose BMC credentials are removed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5219,23 +5818,14 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
+            "description": "The host whose BMC credentials are removed.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/installer-args-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
@@ -5272,7 +5862,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Conflict.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5283,8 +5873,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5292,23 +5882,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
       "get": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetNextSteps",
+        "operationId": "v2GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is retrieving instructions.",
+            "description": "The infra-env of the host whose ignition file should be obtained.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5316,29 +5901,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is retrieving instructions.",
+            "description": "The host whose ignition file should be obtained.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "integer",
-            "description": "The time on the host as seconds since the Unix epoch.",
-            "name": "timestamp",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/host-ignition-params"
             }
           },
           "401": {
@@ -5385,28 +5958,17 @@ func init() {
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Posts the result of the operations from the host agent.",
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "v2PostStepReply",
+        "operationId": "v2UpdateHostIgnition",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is posting results.",
+            "description": "The infra-env of the host whose ignition file should be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5414,22 +5976,23 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is posting results.",
+            "description": "The host whose ignition file should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The results to be posted.",
-            "name": "reply",
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/step-reply"
+              "$ref": "#/definitions/host-ignition-params"
             }
           }
         ],
         "responses": {
-          "204": {
+          "201": {
             "description": "Success."
           },
           "400": {
@@ -5473,33 +6036,22 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostLogsProgress",
+        "operationId": "v2UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose log progress is being updated.",
+            "description": "The infra-env of the host whose installer arguments should be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5507,24 +6059,33 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose log progress is being updated.",
+            "description": "The host whose installer arguments should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/installer-args-params"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5567,39 +6128,27 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
+      "get": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallProgress",
+        "operationId": "v2GetNextSteps",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host being updated.",
+            "description": "The infra-env of the host that is retrieving instructions.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5607,24 +6156,30 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to update.",
+            "description": "The host that is retrieving instructions.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
+            "type": "integer",
+            "description": "The time on the host as seconds since the Unix epoch.",
+            "name": "timestamp",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/steps"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5656,6 +6211,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -5663,29 +6224,60 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
+      },
       "post": {
-        "description": "Regenerate InfraEnv token signing key.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegenerateInfraEnvSigningKey",
+        "operationId": "v2PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The target InfraEnv.",
+            "description": "The infra-env of the host that is posting results.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is posting results.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
           }
         ],
         "responses": {
           "204": {
             "description": "Success."
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -5715,49 +6307,91 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/openshift-versions": {
-      "get": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
+      "put": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+        "description": "Update log collection state and progress.",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "v2ListSupportedOpenshiftVersions",
+        "operationId": "v2UpdateHostLogsProgress",
         "parameters": [
           {
             "type": "string",
-            "description": "Retrieves only the versions that contain the specified substring in their display name.",
-            "name": "version",
-            "in": "query"
+            "format": "uuid",
+            "description": "The infra-env whose log progress is being updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "boolean",
-            "description": "If true, returns only the latest version for each minor.",
-            "name": "only_latest",
-            "in": "query"
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose log progress is being updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Update cluster install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "400": {
-            "description": "Bad Request",
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5768,6 +6402,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -5777,87 +6417,87 @@ func init() {
         }
       }
     },
-    "/v2/operators/bundles": {
-      "get": {
-        "description": "Retrieves a list of available bundles filtered by support level.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation progress.",
         "tags": [
-          "operators"
+          "installer"
         ],
-        "summary": "Get list of available bundles",
-        "operationId": "V2ListBundles",
+        "operationId": "v2UpdateHostInstallProgress",
         "parameters": [
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster. If the parameter is not specified, only feature_ids parameter is taken into account.",
-            "name": "openshift_version",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "x86_64",
-              "aarch64",
-              "arm64",
-              "ppc64le",
-              "s390x",
-              "multi"
-            ],
-            "type": "string",
-            "default": "x86_64",
-            "description": "The CPU architecture of the image (x86_64/arm64/etc). openshift_version must be set.",
-            "name": "cpu_architecture",
-            "in": "query"
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
           },
           {
-            "enum": [
-              "baremetal",
-              "none",
-              "nutanix",
-              "vsphere",
-              "external"
-            ],
             "type": "string",
-            "description": "The provider platform type. openshift_version must be set.",
-            "name": "platform_type",
-            "in": "query"
+            "format": "uuid",
+            "description": "The infra-env of the host being updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external or if openshift_version is not set.",
-            "name": "external_platform_name",
-            "in": "query"
+            "format": "uuid",
+            "description": "The ID of the host to update.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "array",
-            "items": {
-              "enum": [
-                "SNO"
-              ],
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
-            "name": "feature_ids",
-            "in": "query"
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success",
+            "description": "Update install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/bundle"
-              }
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "400": {
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
-            "description": "Internal server error",
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5865,41 +6505,45 @@ func init() {
         }
       }
     },
-    "/v2/operators/bundles/{id}": {
-      "get": {
-        "description": "Retrieves an array of operator properties for the specified bundle when some features are activated.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config": {
+      "post": {
+        "description": "Generates the static network configuration of a host from its discovered inventory, and optionally applies it to the infra-env of the host.",
         "tags": [
-          "operators"
+          "installer"
         ],
-        "summary": "Get operator properties for a bundle",
-        "operationId": "V2GetBundle",
+        "operationId": "v2GenerateHostStaticNetworkConfig",
         "parameters": [
           {
             "type": "string",
-            "description": "Identifier of the bundle, for example, ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai` + "`" + `.",
-            "name": "id",
+            "format": "uuid",
+            "description": "The infra-env of the host whose static network configuration should be generated.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "array",
-            "items": {
-              "enum": [
-                "SNO"
-              ],
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
-            "name": "feature_ids",
-            "in": "query"
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose static network configuration should be generated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The optional bonds, VLANs and DNS servers of the static network configuration.",
+            "name": "generate-static-network-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-static-network-config-generate-params"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/bundle"
+              "$ref": "#/definitions/host_static_network_config"
             }
           },
           "400": {
@@ -5908,90 +6552,71 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
           "404": {
-            "description": "Bundle not found",
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
           "500": {
-            "description": "Internal server error",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/release-sources": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves openshift release sources configuration.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "v2ListReleaseSources",
-        "responses": {
-          "200": {
-            "description": "Success.",
+          },
+          "503": {
+            "description": "Unavailable.",
             "schema": {
-              "$ref": "#/definitions/release-sources"
+              "$ref": "#/definitions/error"
             }
           }
         }
       }
     },
-    "/v2/support-levels/architectures": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the architecture support-levels for each OpenShift version.",
+    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
+      "post": {
+        "description": "Regenerate InfraEnv token signing key.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetSupportedArchitectures",
+        "operationId": "RegenerateInfraEnvSigningKey",
         "parameters": [
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster.",
-            "name": "openshift_version",
-            "in": "query",
+            "format": "uuid",
+            "description": "The target InfraEnv.",
+            "name": "infra_env_id",
+            "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "architectures": {
-                  "description": "Keys will be one of architecture-support-level-id enum.",
-                  "$ref": "#/definitions/support-levels"
-                }
-              }
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -6011,8 +6636,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -6020,7 +6651,7 @@ func init() {
         }
       }
     },
-    "/v2/support-levels/features": {
+    "/v2/openshift-versions": {
       "get": {
         "security": [
           {
@@ -6031,51 +6662,22 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the features support levels for each OpenShift version.",
+        "description": "Retrieves the list of OpenShift supported versions.",
         "tags": [
-          "installer"
+          "versions"
         ],
-        "operationId": "GetSupportedFeatures",
+        "operationId": "v2ListSupportedOpenshiftVersions",
         "parameters": [
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster.",
-            "name": "openshift_version",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "x86_64",
-              "aarch64",
-              "arm64",
-              "ppc64le",
-              "s390x",
-              "multi"
-            ],
-            "type": "string",
-            "default": "x86_64",
-            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-            "name": "cpu_architecture",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "baremetal",
-              "none",
-              "nutanix",
-              "vsphere",
-              "external"
-            ],
-            "type": "string",
-            "description": "The provider platform type.",
-            "name": "platform_type",
+            "description": "Retrieves only the versions that contain the specified substring in their display name.",
+            "name": "version",
             "in": "query"
           },
           {
-            "type": "string",
-            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
-            "name": "external_platform_name",
+            "type": "boolean",
+            "description": "If true, returns only the latest version for each minor.",
+            "name": "only_latest",
             "in": "query"
           }
         ],
@@ -6083,34 +6685,16 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "features": {
-                  "description": "Keys will be one of features-support-level-id enum.",
-                  "$ref": "#/definitions/support-levels"
-                }
-              }
+              "$ref": "#/definitions/openshift-versions"
             }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -6125,33 +6709,25 @@ func init() {
         }
       }
     },
-    "/v2/support-levels/features/detailed": {
+    "/v2/operators/bundles": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves detailed features information including support level, incompatibilities, and operator dependencies.",
+        "description": "Retrieves a list of available bundles filtered by support level.",
         "tags": [
-          "installer"
+          "operators"
         ],
-        "operationId": "GetDetailedSupportedFeatures",
+        "summary": "Get list of available bundles",
+        "operationId": "V2ListBundles",
         "parameters": [
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster.",
+            "description": "Version of the OpenShift cluster. If the parameter is not specified, only feature_ids parameter is taken into account.",
             "name": "openshift_version",
-            "in": "query",
-            "required": true
+            "in": "query"
           },
           {
             "enum": [
               "x86_64",
+              "aarch64",
               "arm64",
               "ppc64le",
               "s390x",
@@ -6159,7 +6735,7 @@ func init() {
             ],
             "type": "string",
             "default": "x86_64",
-            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc). openshift_version must be set.",
             "name": "cpu_architecture",
             "in": "query"
           },
@@ -6169,38 +6745,41 @@ func init() {
               "none",
               "nutanix",
               "vsphere",
-              "external"
+              "external",
+              "openstack"
             ],
             "type": "string",
-            "description": "The provider platform type.",
+            "description": "The provider platform type. openshift_version must be set.",
             "name": "platform_type",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external or if openshift_version is not set.",
             "name": "external_platform_name",
             "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "SNO"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
+            "name": "feature_ids",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
+            "description": "Success",
             "schema": {
-              "type": "object",
-              "properties": {
-                "features": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/feature"
-                  }
-                },
-                "operators": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/operator"
-                  }
-                }
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/bundle"
               }
             }
           },
@@ -6210,20 +6789,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
           "500": {
-            "description": "Internal Server Error.",
+            "description": "Internal server error",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -6231,67 +6798,57 @@ func init() {
         }
       }
     },
-    "/v2/supported-operators": {
+    "/v2/operators/bundles/{id}": {
       "get": {
-        "description": "Retrieves the list of supported operators.",
+        "description": "Retrieves an array of operator properties for the specified bundle when some features are activated.",
         "tags": [
           "operators"
         ],
-        "operationId": "V2ListSupportedOperators",
+        "summary": "Get operator properties for a bundle",
+        "operationId": "V2GetBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Identifier of the bundle, for example, ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai` + "`" + `.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "SNO"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
+            "name": "feature_ids",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Success.",
+            "description": "Success",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string",
-                "enum": [
-                  "amd-gpu",
-                  "lso",
-                  "mtv",
-                  "openshift-ai",
-                  "osc",
-                  "servicemesh",
-                  "authorino",
-                  "cnv",
-                  "nvidia-gpu",
-                  "pipelines",
-                  "odf",
-                  "lvm",
-                  "mce",
-                  "node-feature-discovery",
-                  "serverless",
-                  "nmstate",
-                  "kmm",
-                  "node-healthcheck",
-                  "self-node-remediation",
-                  "fence-agents-remediation",
-                  "node-maintenance",
-                  "kube-descheduler",
-                  "cluster-observability",
-                  "numa-resources",
-                  "oadp",
-                  "metallb",
-                  "loki",
-                  "openshift-logging"
-                ]
-              }
+              "$ref": "#/definitions/bundle"
             }
           },
-          "401": {
-            "description": "Unauthorized.",
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
-          "403": {
-            "description": "Forbidden.",
+          "404": {
+            "description": "Bundle not found",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
-            "description": "Error.",
+            "description": "Internal server error",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -6299,7 +6856,7 @@ func init() {
         }
       }
     },
-    "/v2/supported-operators/{operator_name}": {
+    "/v2/quota-usage": {
       "get": {
         "security": [
           {
@@ -6310,25 +6867,16 @@ func init() {
             ]
           }
         ],
-        "description": "Lists properties for an operator.",
+        "description": "Retrieves the usage and the quotas of the resources of the organization of the user.",
         "tags": [
-          "operators"
-        ],
-        "operationId": "V2ListOperatorProperties",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
-            "in": "path",
-            "required": true
-          }
+          "installer"
         ],
+        "operationId": "v2GetQuotaUsage",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "$ref": "#/definitions/quota-usage"
             }
           },
           "401": {
@@ -6343,12 +6891,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -6357,1724 +6899,4063 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "api_vip": {
-      "description": "The virtual IP used to reach the OpenShift cluster's API.",
-      "type": "object",
-      "properties": {
-        "cluster_id": {
-          "description": "The cluster that this VIP is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ip": {
-          "description": "The IP address.",
-          "$ref": "#/definitions/ip"
-        },
-        "verification": {
-          "description": "API VIP verification result.",
-          "$ref": "#/definitions/vip_verification"
-        }
-      }
     },
-    "api_vip_connectivity_additional_request_header": {
-      "type": "object",
-      "properties": {
-        "key": {
-          "description": "Value of the header's key when making a request",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value corresponding to the header key",
-          "type": "string"
+    "/v2/release-sources": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves openshift release sources configuration.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListReleaseSources",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/release-sources"
+            }
+          }
         }
       }
     },
-    "api_vip_connectivity_request": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "ca_certificate": {
-          "description": "A CA certficate to be used when contacting the URL via https.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "ignition_endpoint_token": {
-          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url (DEPRECATED use request_headers to pass this token).",
-          "type": "string",
-          "x-nullable": true
-        },
-        "request_headers": {
-          "description": "Additional request headers to include when fetching the ignition from ignition_endpoint_url.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip_connectivity_additional_request_header"
+    "/v2/service-accounts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Retrieves the service accounts.",
+        "tags": [
+          "service_accounts"
+        ],
+        "operationId": "v2ListServiceAccounts",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/service-account-list"
+            }
           },
-          "x-nullable": true,
-          "x-omitempty": true
-        },
-        "url": {
-          "description": "URL address of the API.",
-          "type": "string"
-        },
-        "verify_cidr": {
-          "description": "Whether to verify if the API VIP belongs to one of the interfaces (DEPRECATED).",
-          "type": "boolean"
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "api_vip_connectivity_response": {
-      "description": "The response from the day-2 agent's attempt to download the worker ignition file from the API machine config server of the target cluster.\nNote - the name \"API VIP connectivity\" is old and misleading and is preserved for backwards compatibility.",
-      "type": "object",
-      "properties": {
-        "download_error": {
-          "description": "The error that occurred while downloading the worker ignition file, ignored when is_success is true",
-          "type": "string"
-        },
-        "ignition": {
-          "description": "Ignition file fetched from the target cluster's API machine config server.\nThis ignition file may be incomplete as almost all files / systemd units are removed from it by the agent in order to save space.",
-          "type": "string"
-        },
-        "is_success": {
-          "description": "Whether the agent was able to download the ignition or not",
-          "type": "boolean"
-        },
-        "url": {
-          "description": "This parameter mirrors the url parameter of the corresponding api_vip_connectivity_request",
-          "type": "string"
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Creates a service account. API tokens are issued to service accounts for automation such as CI.",
+        "tags": [
+          "service_accounts"
+        ],
+        "operationId": "v2CreateServiceAccount",
+        "parameters": [
+          {
+            "description": "The service account to create.",
+            "name": "new-service-account-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/service-account-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/service-account"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "architecture-support-level-id": {
-      "type": "string",
-      "enum": [
-        "X86_64_ARCHITECTURE",
-        "ARM64_ARCHITECTURE",
-        "PPC64LE_ARCHITECTURE",
-        "S390X_ARCHITECTURE",
-        "MULTIARCH_RELEASE_IMAGE"
-      ]
-    },
-    "bind-host-params": {
-      "required": [
-        "cluster_id"
-      ],
-      "properties": {
-        "cluster_id": {
-          "type": "string",
-          "format": "uuid"
+    "/v2/service-accounts/{service_account_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Deletes a service account and its API tokens.",
+        "tags": [
+          "service_accounts"
+        ],
+        "operationId": "v2DeleteServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The service account.",
+            "name": "service_account_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "boot": {
-      "type": "object",
-      "properties": {
-        "command_line": {
-          "type": "string"
-        },
-        "current_boot_mode": {
-          "type": "string"
-        },
-        "device_type": {
-          "type": "string",
-          "enum": [
-            "persistent",
-            "ephemeral"
-          ]
-        },
-        "pxe_interface": {
-          "type": "string"
-        },
-        "secure_boot_state": {
-          "$ref": "#/definitions/secure-boot-state"
+    "/v2/service-accounts/{service_account_id}/tokens": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Retrieves the API tokens of a service account, without their secrets.",
+        "tags": [
+          "service_accounts"
+        ],
+        "operationId": "v2ListServiceAccountTokens",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The service account.",
+            "name": "service_account_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/service-account-token-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "bundle": {
-      "type": "object",
-      "properties": {
-        "description": {
-          "description": "Longer human friendly description for the bundle, usually one or more sentences.\n",
-          "type": "string"
-        },
-        "id": {
-          "description": "Unique identifier of the bundle, for example ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai-nvidia` + "`" + `.",
-          "type": "string"
-        },
-        "operators": {
-          "description": "List of operators associated with the bundle.",
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Issues an API token to a service account. The secret of the token is only returned by this request.",
+        "tags": [
+          "service_accounts"
+        ],
+        "operationId": "v2CreateServiceAccountToken",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The service account.",
+            "name": "service_account_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The API token to issue.",
+            "name": "new-service-account-token-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/service-account-token-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/service-account-token"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "title": {
-          "description": "Short human friendly description for the bundle, usually only a few words, for example ` + "`" + `Virtualization` + "`" + ` or\n` + "`" + `OpenShift AI (NVIDIA)` + "`" + `.\n",
-          "type": "string"
         }
       }
     },
-    "cluster": {
-      "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "image_info",
-        "status",
-        "status_info"
-      ],
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "ams_subscription_id": {
-          "description": "Unique identifier of the AMS subscription in OCM.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip"
+    "/v2/service-accounts/{service_account_id}/tokens/{token_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Revokes an API token of a service account.",
+        "tags": [
+          "service_accounts"
+        ],
+        "operationId": "v2RevokeServiceAccountToken",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The service account.",
+            "name": "service_account_id",
+            "in": "path",
+            "required": true
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The API token to revoke.",
+            "name": "token_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "connectivity_majority_groups": {
-          "description": "Json formatted string containing the majority groups for connectivity checks.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "control_plane_count": {
-          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
-          "type": "integer"
-        },
-        "controller_logs_collected_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "controller_logs_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ],
-          "x-nullable": false
-        },
-        "created_at": {
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/support-levels/architectures": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the architecture support-levels for each OpenShift version.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetSupportedArchitectures",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "architectures": {
+                  "description": "Keys will be one of architecture-support-level-id enum.",
+                  "$ref": "#/definitions/support-levels"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/support-levels/features": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the features support levels for each OpenShift version.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetSupportedFeatures",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
+            "type": "string",
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external",
+              "openstack"
+            ],
+            "type": "string",
+            "description": "The provider platform type.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
+            "name": "external_platform_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "features": {
+                  "description": "Keys will be one of features-support-level-id enum.",
+                  "$ref": "#/definitions/support-levels"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/support-levels/features/detailed": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves detailed features information including support level, incompatibilities, and operator dependencies.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetDetailedSupportedFeatures",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "x86_64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
+            "type": "string",
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external",
+              "openstack"
+            ],
+            "type": "string",
+            "description": "The provider platform type.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
+            "name": "external_platform_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "features": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/feature"
+                  }
+                },
+                "operators": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/operator"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string",
+                "enum": [
+                  "amd-gpu",
+                  "lso",
+                  "mtv",
+                  "openshift-ai",
+                  "osc",
+                  "servicemesh",
+                  "authorino",
+                  "cnv",
+                  "nvidia-gpu",
+                  "pipelines",
+                  "odf",
+                  "lvm",
+                  "mce",
+                  "node-feature-discovery",
+                  "serverless",
+                  "nmstate",
+                  "kmm",
+                  "node-healthcheck",
+                  "self-node-remediation",
+                  "fence-agents-remediation",
+                  "node-maintenance",
+                  "kube-descheduler",
+                  "cluster-observability",
+                  "numa-resources",
+                  "oadp",
+                  "metallb",
+                  "loki",
+                  "openshift-logging"
+                ]
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators/{operator_name}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists properties for an operator.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListOperatorProperties",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-properties"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "api_vip": {
+      "description": "The virtual IP used to reach the OpenShift cluster's API.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this VIP is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ip": {
+          "description": "The IP address.",
+          "$ref": "#/definitions/ip"
+        },
+        "verification": {
+          "description": "API VIP verification result.",
+          "$ref": "#/definitions/vip_verification"
+        }
+      }
+    },
+    "api_vip_connectivity_additional_request_header": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "description": "Value of the header's key when making a request",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value corresponding to the header key",
+          "type": "string"
+        }
+      }
+    },
+    "api_vip_connectivity_request": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "ca_certificate": {
+          "description": "A CA certficate to be used when contacting the URL via https.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ignition_endpoint_token": {
+          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url (DEPRECATED use request_headers to pass this token).",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
+          "x-nullable": true
+        },
+        "request_headers": {
+          "description": "Additional request headers to include when fetching the ignition from ignition_endpoint_url.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip_connectivity_additional_request_header"
+          },
+          "x-nullable": true,
+          "x-omitempty": true
+        },
+        "url": {
+          "description": "URL address of the API.",
+          "type": "string"
+        },
+        "verify_cidr": {
+          "description": "Whether to verify if the API VIP belongs to one of the interfaces (DEPRECATED).",
+          "type": "boolean"
+        }
+      }
+    },
+    "api_vip_connectivity_response": {
+      "description": "The response from the day-2 agent's attempt to download the worker ignition file from the API machine config server of the target cluster.\nNote - the name \"API VIP connectivity\" is old and misleading and is preserved for backwards compatibility.",
+      "type": "object",
+      "properties": {
+        "download_error": {
+          "description": "The error that occurred while downloading the worker ignition file, ignored when is_success is true",
+          "type": "string"
+        },
+        "ignition": {
+          "description": "Ignition file fetched from the target cluster's API machine config server.\nThis ignition file may be incomplete as almost all files / systemd units are removed from it by the agent in order to save space.",
+          "type": "string"
+        },
+        "is_success": {
+          "description": "Whether the agent was able to download the ignition or not",
+          "type": "boolean"
+        },
+        "url": {
+          "description": "This parameter mirrors the url parameter of the corresponding api_vip_connectivity_request",
+          "type": "string"
+        }
+      }
+    },
+    "architecture-support-level-id": {
+      "type": "string",
+      "enum": [
+        "X86_64_ARCHITECTURE",
+        "ARM64_ARCHITECTURE",
+        "PPC64LE_ARCHITECTURE",
+        "S390X_ARCHITECTURE",
+        "MULTIARCH_RELEASE_IMAGE"
+      ]
+    },
+    "audit-event": {
+      "type": "object",
+      "required": [
+        "id",
+        "created_at",
+        "method",
+        "path",
+        "status_code"
+      ],
+      "properties": {
+        "action": {
+          "description": "The API operation of the request.",
+          "type": "string"
+        },
+        "created_at": {
+          "description": "The time the request was received.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "id": {
+          "description": "Unique identifier of the audit event.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey;autoIncrement\""
+        },
+        "method": {
+          "description": "The HTTP method of the request.",
+          "type": "string"
+        },
+        "org_id": {
+          "description": "The organization of the user that sent the request.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "path": {
+          "description": "The path of the request.",
+          "type": "string"
+        },
+        "request_diff": {
+          "description": "The body of the request in JSON format, the values of secret fields such as pull secrets and passwords are redacted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "request_id": {
+          "description": "Unique identifier of the request.",
+          "type": "string"
+        },
+        "resource_id": {
+          "description": "Unique identifier of the resource the request changed, empty for creations.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "resource_type": {
+          "description": "The type of the resource the request changed, e.g. cluster or host.",
+          "type": "string"
+        },
+        "status_code": {
+          "description": "The HTTP status code of the response.",
+          "type": "integer"
+        },
+        "username": {
+          "description": "The user that sent the request.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "audit-event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/audit-event"
+      }
+    },
+    "bind-host-params": {
+      "required": [
+        "cluster_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "bios": {
+      "type": "object",
+      "properties": {
+        "release_date": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "command_line": {
+          "type": "string"
+        },
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "device_type": {
+          "type": "string",
+          "enum": [
+            "persistent",
+            "ephemeral"
+          ]
+        },
+        "pxe_interface": {
+          "type": "string"
+        },
+        "secure_boot_state": {
+          "$ref": "#/definitions/secure-boot-state"
+        }
+      }
+    },
+    "bundle": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "description": "Longer human friendly description for the bundle, usually one or more sentences.\n",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the bundle, for example ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai-nvidia` + "`" + `.",
+          "type": "string"
+        },
+        "operators": {
+          "description": "List of operators associated with the bundle.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "title": {
+          "description": "Short human friendly description for the bundle, usually only a few words, for example ` + "`" + `Virtualization` + "`" + ` or\n` + "`" + `OpenShift AI (NVIDIA)` + "`" + `.\n",
+          "type": "string"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "ams_subscription_id": {
+          "description": "Unique identifier of the AMS subscription in OCM.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "connectivity_majority_groups": {
+          "description": "Json formatted string containing the majority groups for connectivity checks.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "control_plane_count": {
+          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
+          "type": "integer"
+        },
+        "controller_logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "controller_logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ],
+          "x-nullable": false
+        },
+        "created_at": {
           "description": "The time that this cluster was created.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "deleted_at": {
+          "description": "swagger:ignore",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "gorm.io/gorm"
+            },
+            "type": "DeletedAt"
+          },
+          "x-nullable": false
+        },
+        "disk_encryption": {
+          "description": "Information regarding hosts' installation disks encryption.",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "enabled_host_count": {
+          "description": "hosts associated to this cluster that are not in 'disabled' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "feature_usage": {
+          "description": "JSON-formatted string containing the usage information by feature name",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "high_availability_mode": {
+          "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_networks": {
+          "description": "List of host networks to be filled during query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_network"
+          },
+          "x-go-custom-tag": "gorm:\"-\"",
+          "x-nullable": true
+        },
+        "hosts": {
+          "description": "Hosts that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+          "type": "string",
+          "enum": [
+            "none",
+            "masters",
+            "arbiters",
+            "workers",
+            "masters,arbiters",
+            "masters,workers",
+            "arbiters,workers",
+            "masters,arbiters,workers",
+            "all"
+          ]
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ignored_cluster_validations": {
+          "description": "Json formatted string containing a list of cluster validations to be ignored. May also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "ignored_host_validations": {
+          "description": "Json formatted string containing a list of host validations to be ignored. May also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "image_info": {
+          "$ref": "#/definitions/image_info"
+        },
+        "imported": {
+          "description": "Indicates whether this cluster is an imported day-2 cluster or a\nregular cluster. Clusters are considered imported when they are\ncreated via the ../clusters/import endpoint. Day-2 clusters converted\nfrom day-1 clusters by kube-api controllers or the\n../clusters/\u003ccluster_id\u003e/actions/allow-add-hosts endpoint are not\nconsidered imported. Imported clusters usually lack a lot of\ninformation and are filled with default values that don't necessarily\nreflect the actual cluster they represent",
+          "type": "boolean",
+          "default": false
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}"
+        },
+        "install_started_at": {
+          "description": "The time that this cluster started installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "ip_collisions": {
+          "description": "Json formatted string containing ip collisions detected in the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n'DisconnectedCluster' for clusters with embedded ignition for offline installation,\n",
+          "type": "string",
+          "enum": [
+            "Cluster",
+            "AddHostsCluster",
+            "DisconnectedCluster"
+          ]
+        },
+        "last-installation-preparation": {
+          "$ref": "#/definitions/last-installation-preparation"
+        },
+        "load_balancer": {
+          "$ref": "#/definitions/load_balancer"
+        },
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "$ref": "#/definitions/logs_state"
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "monitored_operators": {
+          "description": "Operators that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/monitored-operator"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string"
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "openshift_cluster_id": {
+          "description": "Cluster ID on OCP system.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "org_soft_timeouts_enabled": {
+          "description": "Indication if organization soft timeouts is enabled for the cluster.",
+          "type": "boolean"
+        },
+        "platform": {
+          "$ref": "#/definitions/platform"
+        },
+        "progress": {
+          "description": "Installation progress percentages of the cluster.",
+          "$ref": "#/definitions/cluster-progress-info"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "ready_host_count": {
+          "description": "hosts associated to this cluster that are in 'known' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "schedulable_masters_forced_true": {
+          "description": "Indicates if schedule workloads on masters will be enabled regardless the value of 'schedulable_masters' property.\nSet to 'true' when not enough hosts are associated with this cluster to disable the scheduling on masters.\n",
+          "type": "boolean",
+          "default": true
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "preparing-for-installation",
+            "pending-for-input",
+            "installing",
+            "finalizing",
+            "installed",
+            "adding-hosts",
+            "cancelled",
+            "installing-pending-user-action",
+            "unmonitored"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the cluster status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string"
+        },
+        "total_host_count": {
+          "description": "All hosts associated to this cluster.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "user_name": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version",
+        "pull_secret"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          }
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-nullable": true
+        },
+        "control_plane_count": {
+          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ],
+          "x-nullable": false
+        },
+        "disk_encryption": {
+          "description": "Installation disks encryption mode and host roles to be applied.",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "high_availability_mode": {
+          "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "none",
+            "masters",
+            "arbiters",
+            "workers",
+            "masters,arbiters",
+            "masters,workers",
+            "arbiters,workers",
+            "masters,arbiters,workers",
+            "all"
+          ]
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          }
+        },
+        "load_balancer": {
+          "$ref": "#/definitions/load_balancer"
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-nullable": true
+        },
+        "mirror_registry_configuration": {
+          "x-nullable": true,
+          "$ref": "#/definitions/mirror-registry-configuration-params"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "platform": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-finalizing-progress": {
+      "type": "object",
+      "properties": {
+        "finalizing_stage": {
+          "$ref": "#/definitions/finalizing-stage"
+        }
+      }
+    },
+    "cluster-host-requirements": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "Unique identifier of the host the requirements relate to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ocp": {
+          "description": "Host requirements for the OCP installation",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "operators": {
+          "description": "Host requirements related to requested operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-host-requirements"
+          }
+        },
+        "total": {
+          "description": "Total host requirements for the cluster configuration",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "cluster-host-requirements-details": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "description": "Required number of CPU cores",
+          "type": "integer"
+        },
+        "disk_size_gb": {
+          "description": "Required disk size in GB",
+          "type": "integer"
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Required installation disk speed in ms",
+          "type": "integer"
+        },
+        "network_latency_threshold_ms": {
+          "description": "Maximum network average latency (RTT) at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "network_throughput_mbps": {
+          "description": "Minimum network throughput in Mbps between the hosts of the role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "packet_loss_percentage": {
+          "description": "Maximum packet loss allowed at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "ram_mib": {
+          "description": "Required number of RAM in MiB",
+          "type": "integer"
+        },
+        "tpm_enabled_in_bios": {
+          "description": "Whether TPM module should be enabled in host's BIOS.",
+          "type": "boolean"
+        }
+      }
+    },
+    "cluster-host-requirements-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-host-requirements"
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-progress-info": {
+      "type": "object",
+      "properties": {
+        "finalizing_stage": {
+          "$ref": "#/definitions/finalizing-stage"
+        },
+        "finalizing_stage_percentage": {
+          "type": "integer"
+        },
+        "finalizing_stage_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "finalizing_stage_timed_out": {
+          "type": "boolean"
+        },
+        "installing_stage_percentage": {
+          "type": "integer"
+        },
+        "preparing_for_installation_stage_percentage": {
+          "type": "integer"
+        },
+        "total_percentage": {
+          "type": "integer"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+    },
+    "cluster-validation-id": {
+      "type": "string",
+      "enum": [
+        "machine-cidr-defined",
+        "cluster-cidr-defined",
+        "service-cidr-defined",
+        "no-cidrs-overlapping",
+        "networks-same-address-families",
+        "network-prefix-valid",
+        "machine-cidr-equals-to-calculated-cidr",
+        "api-vips-defined",
+        "api-vips-valid",
+        "ingress-vips-defined",
+        "ingress-vips-valid",
+        "all-hosts-are-ready-to-install",
+        "sufficient-masters-count",
+        "dns-domain-defined",
+        "pull-secret-set",
+        "ntp-server-configured",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "odf-requirements-satisfied",
+        "cnv-requirements-satisfied",
+        "lvm-requirements-satisfied",
+        "mce-requirements-satisfied",
+        "mtv-requirements-satisfied",
+        "osc-requirements-satisfied",
+        "network-type-valid",
+        "platform-requirements-satisfied",
+        "node-feature-discovery-requirements-satisfied",
+        "nvidia-gpu-requirements-satisfied",
+        "pipelines-requirements-satisfied",
+        "servicemesh-requirements-satisfied",
+        "serverless-requirements-satisfied",
+        "openshift-ai-requirements-satisfied",
+        "openshift-ai-gpu-requirements-satisfied",
+        "authorino-requirements-satisfied",
+        "nmstate-requirements-satisfied",
+        "amd-gpu-requirements-satisfied",
+        "kmm-requirements-satisfied",
+        "node-healthcheck-requirements-satisfied",
+        "self-node-remediation-requirements-satisfied",
+        "fence-agents-remediation-requirements-satisfied",
+        "node-maintenance-requirements-satisfied",
+        "kube-descheduler-requirements-satisfied",
+        "cluster-observability-requirements-satisfied",
+        "numa-resources-requirements-satisfied",
+        "oadp-requirements-satisfied",
+        "metallb-requirements-satisfied",
+        "loki-requirements-satisfied",
+        "openshift-logging-requirements-satisfied",
+        "release-image-signature-verified",
+        "mirror-registries-contain-release-images",
+        "vsphere-credentials-valid"
+      ]
+    },
+    "cluster_default_config": {
+      "type": "object",
+      "properties": {
+        "cluster_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "cluster_network_host_prefix": {
+          "type": "integer",
+          "maximum": 32,
+          "minimum": 1
+        },
+        "cluster_networks_dualstack": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
+        "cluster_networks_ipv4": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
+        "forbidden_hostnames": {
+          "description": "This provides a list of forbidden hostnames. If this list is empty or not present, this implies that the UI should fall back to a hard coded list.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "inactive_deletion_hours": {
+          "type": "integer"
+        },
+        "ntp_source": {
+          "type": "string",
+          "x-omitempty": false
+        },
+        "service_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "service_networks_dualstack": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          }
+        },
+        "service_networks_ipv4": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          }
+        }
+      }
+    },
+    "cluster_network": {
+      "description": "A network from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
+        },
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example if is set to 23, then each node is assigned a /23 subnet out of the given CIDR, which allows for 510 (2^(32 - 23) - 2) pod IPs addresses.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        }
+      }
+    },
+    "completion-params": {
+      "type": "object",
+      "required": [
+        "is_success"
+      ],
+      "properties": {
+        "data": {
+          "description": "additional data from the cluster",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "error_info": {
+          "type": "string"
+        },
+        "is_success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "connectivity-check-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-check-nic"
+          }
+        }
+      }
+    },
+    "connectivity-check-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
+        "mac": {
+          "type": "string",
+          "format": "mac"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "connectivity-check-params": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-check-host"
+      }
+    },
+    "connectivity-remote-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        },
+        "mtu_report": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mtu-report"
+          }
+        },
+        "throughput_mbps": {
+          "description": "Network throughput in Mbps measured between the hosts by the network-throughput-check step, not set until\nit is measured.\n",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        }
+      }
+    },
+    "connectivity-report": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
+    "container_image_availability": {
+      "type": "object",
+      "properties": {
+        "download_rate": {
+          "description": "The rate of size/time in seconds MBps.",
+          "type": "number"
+        },
+        "name": {
+          "description": "A fully qualified image name (FQIN).",
+          "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/container_image_availability_result"
+        },
+        "size_bytes": {
+          "description": "Size of the image in bytes.",
+          "type": "number"
+        },
+        "time": {
+          "description": "Seconds it took to pull the image.",
+          "type": "number"
+        }
+      }
+    },
+    "container_image_availability_request": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of image names to be checked.",
+          "type": "array",
+          "items": {
+            "description": "A fully qualified image name (FQIN).",
+            "type": "string",
+            "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+          }
+        },
+        "timeout": {
+          "description": "Positive number represents a timeout in seconds for a pull operation.",
+          "type": "integer"
+        }
+      }
+    },
+    "container_image_availability_response": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container_image_availability"
+          }
+        }
+      }
+    },
+    "container_image_availability_result": {
+      "description": "Image availability result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
+    "cpu": {
+      "type": "object",
+      "properties": {
+        "architecture": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "frequency": {
+          "type": "number"
+        },
+        "model_name": {
+          "type": "string"
+        }
+      }
+    },
+    "create-manifest-params": {
+      "type": "object",
+      "required": [
+        "file_name",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "base64 encoded manifest content.",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
+        },
+        "file_name": {
+          "description": "The name of the manifest to customize the installed OCP cluster.",
+          "type": "string",
+          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$"
+        },
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "credentials": {
+      "type": "object",
+      "properties": {
+        "console_url": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "custom-release-image": {
+      "type": "object",
+      "required": [
+        "id",
+        "url",
+        "openshift_version",
+        "version",
+        "cpu_architecture"
+      ],
+      "properties": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image, multi for multi-architecture images.",
+          "type": "string",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ]
+        },
+        "cpu_architectures": {
+          "description": "List of CPU architectures provided by the image.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster, from the release metadata.",
+          "type": "string"
+        },
+        "org_id": {
+          "description": "The organization that the release image is available to.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"uniqueIndex:idx_custom_release_images_org_id_url\""
+        },
+        "url": {
+          "description": "The release image.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"uniqueIndex:idx_custom_release_images_org_id_url\""
+        },
+        "user_name": {
+          "description": "The user that registered the release image.",
+          "type": "string"
+        },
+        "version": {
+          "description": "OCP version from the release metadata.",
+          "type": "string"
+        }
+      }
+    },
+    "custom-release-image-create-params": {
+      "type": "object",
+      "required": [
+        "url",
+        "pull_secret"
+      ],
+      "properties": {
+        "pull_secret": {
+          "description": "The pull secret used to read the metadata of the release image. It is not stored.",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
+        },
+        "url": {
+          "description": "The release image to register.",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "custom-release-images": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/custom-release-image"
+      }
+    },
+    "custom_diagnostic_result": {
+      "description": "The result of the last run of a custom diagnostic step on a host.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The standard error of the step, truncated to 64KiB.",
+          "type": "string"
+        },
+        "exit_code": {
+          "description": "The exit code of the step.",
+          "type": "integer"
+        },
+        "name": {
+          "description": "The name of the custom diagnostic step.",
+          "type": "string"
+        },
+        "output": {
+          "description": "The standard output of the step, truncated to 64KiB.",
+          "type": "string"
+        },
+        "updated_at": {
+          "description": "The time the result was reported by the host.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "dhcp_allocation_request": {
+      "type": "object",
+      "required": [
+        "interface",
+        "api_vip_mac",
+        "ingress_vip_mac"
+      ],
+      "properties": {
+        "api_vip_lease": {
+          "description": "Contents of lease file to be used for API virtual IP.",
+          "type": "string"
+        },
+        "api_vip_mac": {
+          "description": "MAC address for the API virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of lease file to be used for for Ingress virtual IP.",
+          "type": "string"
+        },
+        "ingress_vip_mac": {
+          "description": "MAC address for the Ingress virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "interface": {
+          "description": "The network interface (NIC) to run the DHCP requests on.",
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_response": {
+      "type": "object",
+      "required": [
+        "api_vip_address",
+        "ingress_vip_address"
+      ],
+      "properties": {
+        "api_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
+          "type": "string",
+          "format": "ipv4"
         },
-        "deleted_at": {
-          "description": "swagger:ignore",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "gorm.io/gorm"
+        "api_vip_lease": {
+          "description": "Contents of last acquired lease for API virtual IP.",
+          "type": "string"
+        },
+        "ingress_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of last acquired lease for Ingress virtual IP.",
+          "type": "string"
+        }
+      }
+    },
+    "disconnected-cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version"
+      ],
+      "properties": {
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "disk": {
+      "type": "object",
+      "properties": {
+        "bootable": {
+          "type": "boolean"
+        },
+        "by_id": {
+          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
+          "type": "string"
+        },
+        "by_path": {
+          "description": "by-path is the shortest physical path to the device",
+          "type": "string"
+        },
+        "drive_type": {
+          "$ref": "#/definitions/drive_type"
+        },
+        "has_uuid": {
+          "type": "boolean"
+        },
+        "hctl": {
+          "type": "string"
+        },
+        "health": {
+          "$ref": "#/definitions/disk_health"
+        },
+        "holders": {
+          "description": "A comma-separated list of disk names that this disk belongs to",
+          "type": "string"
+        },
+        "id": {
+          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
+          "type": "string"
+        },
+        "installation_eligibility": {
+          "type": "object",
+          "properties": {
+            "eligible": {
+              "description": "Whether the disk is eligible for installation or not.",
+              "type": "boolean"
             },
-            "type": "DeletedAt"
+            "not_eligible_reasons": {
+              "description": "Reasons for why this disk is not eligible for installation.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           },
           "x-nullable": false
         },
-        "disk_encryption": {
-          "description": "Information regarding hosts' installation disks encryption.",
-          "$ref": "#/definitions/disk-encryption"
+        "io_perf": {
+          "$ref": "#/definitions/io_perf"
         },
-        "email_domain": {
+        "is_installation_media": {
+          "description": "Whether the disk appears to be an installation media or not",
+          "type": "boolean"
+        },
+        "iscsi": {
+          "$ref": "#/definitions/iscsi"
+        },
+        "model": {
           "type": "string"
         },
-        "enabled_host_count": {
-          "description": "hosts associated to this cluster that are not in 'disabled' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
+        "name": {
+          "type": "string"
         },
-        "feature_usage": {
-          "description": "JSON-formatted string containing the usage information by feature name",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "partitionTypes": {
+          "type": "string"
         },
-        "high_availability_mode": {
-          "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+        "path": {
+          "type": "string"
+        },
+        "removable": {
+          "type": "boolean"
+        },
+        "serial": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "smart": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "wwn": {
+          "type": "string"
+        }
+      }
+    },
+    "disk-config-params": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/disk-role"
+        }
+      }
+    },
+    "disk-encryption": {
+      "type": "object",
+      "properties": {
+        "enable_on": {
+          "description": "Enable/disable disk encryption on master nodes, arbiter nodes, worker nodes, or a combination of them.",
           "type": "string",
-          "default": "Full",
+          "default": "none",
           "enum": [
-            "Full",
-            "None"
+            "none",
+            "masters",
+            "arbiters",
+            "workers",
+            "masters,arbiters",
+            "masters,workers",
+            "arbiters,workers",
+            "masters,arbiters,workers",
+            "all"
           ]
         },
-        "host_networks": {
-          "description": "List of host networks to be filled during query.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_network"
-          },
-          "x-go-custom-tag": "gorm:\"-\"",
-          "x-nullable": true
+        "mode": {
+          "description": "The disk encryption mode to use. The threshold mode combines the TPM and the Tang servers with Shamir Secret\nSharing, according to threshold_spec.\n",
+          "type": "string",
+          "default": "tpmv2",
+          "enum": [
+            "tpmv2",
+            "tang",
+            "threshold"
+          ]
         },
-        "hosts": {
-          "description": "Hosts that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        "tang_servers": {
+          "description": "JSON-formatted string containing additional information regarding tang's configuration",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
         },
-        "href": {
-          "description": "Self link.",
+        "threshold_spec": {
+          "description": "JSON-formatted string containing the unlock policy of the threshold mode. The disk is unlocked when threshold\nof its pins are available: the TPM, if tpm2 is set, and the Tang servers, which are available when\ntang_threshold of them are reachable.\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"threshold\":2,\"tpm2\":true,\"tang_threshold\":1}"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
+    },
+    "disk-role": {
+      "type": "string",
+      "enum": [
+        "none",
+        "install"
+      ]
+    },
+    "disk-skip-formatting-params": {
+      "description": "Allows an addition or removal of a host disk from the host's skip_formatting_disks list",
+      "type": "object",
+      "required": [
+        "disk_id",
+        "skip_formatting"
+      ],
+      "properties": {
+        "disk_id": {
+          "description": "The ID of the disk that is being added to or removed from the host's skip_formatting_disks list",
           "type": "string"
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string"
+        "skip_formatting": {
+          "description": "True if you wish to add the disk to the skip_formatting_disks list, false if you wish to remove it",
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_health": {
+      "description": "The health of a disk, derived by the service from the SMART data the agent reports in the smart field of the\ndisk. Not set when the disk doesn't report SMART data.\n",
+      "type": "object",
+      "properties": {
+        "media_errors": {
+          "description": "Number of unrecovered data integrity errors of an NVMe disk.",
+          "type": "integer"
+        },
+        "pending_sectors": {
+          "description": "Number of unstable sectors that are waiting to be remapped.",
+          "type": "integer"
+        },
+        "percentage_used": {
+          "description": "Estimate of the percentage of the life of an NVMe disk that is used.",
+          "type": "integer"
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        "power_on_hours": {
+          "type": "integer"
         },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
-          "type": "string",
-          "enum": [
-            "none",
-            "masters",
-            "arbiters",
-            "workers",
-            "masters,arbiters",
-            "masters,workers",
-            "arbiters,workers",
-            "masters,arbiters,workers",
-            "all"
-          ]
+        "predicted_failure": {
+          "description": "Whether the disk predicts its own failure, because its overall health self-assessment failed or one of its\nattributes is below its threshold.\n",
+          "type": "boolean"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+        "reallocated_sectors": {
+          "description": "Number of sectors that the disk remapped because of read or write errors.",
+          "type": "integer"
         },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "$ref": "#/definitions/ignition-endpoint"
+        "smart_passed": {
+          "description": "The result of the overall SMART health self-assessment of the disk.",
+          "type": "boolean"
         },
-        "ignored_cluster_validations": {
-          "description": "Json formatted string containing a list of cluster validations to be ignored. May also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "temperature_celsius": {
+          "type": "integer"
+        }
+      }
+    },
+    "disk_info": {
+      "type": "object",
+      "properties": {
+        "disk_speed": {
+          "$ref": "#/definitions/disk_speed"
         },
-        "ignored_host_validations": {
-          "description": "Json formatted string containing a list of host validations to be ignored. May also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
+        "id": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "uuid"
         },
-        "image_info": {
-          "$ref": "#/definitions/image_info"
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed": {
+      "type": "object",
+      "properties": {
+        "exit_code": {
+          "type": "integer"
         },
-        "imported": {
-          "description": "Indicates whether this cluster is an imported day-2 cluster or a\nregular cluster. Clusters are considered imported when they are\ncreated via the ../clusters/import endpoint. Day-2 clusters converted\nfrom day-1 clusters by kube-api controllers or the\n../clusters/\u003ccluster_id\u003e/actions/allow-add-hosts endpoint are not\nconsidered imported. Imported clusters usually lack a lot of\ninformation and are filled with default values that don't necessarily\nreflect the actual cluster they represent",
-          "type": "boolean",
-          "default": false
+        "speed_ms": {
+          "type": "integer"
         },
-        "ingress_vips": {
-          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+        "tested": {
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_speed_check_request": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "description": "--filename argument for fio (expects a file or a block device path).",
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed_check_response": {
+      "type": "object",
+      "properties": {
+        "io_sync_duration": {
+          "description": "The 99th percentile of fdatasync durations in milliseconds.",
+          "type": "integer"
+        },
+        "path": {
+          "description": "The device path.",
+          "type": "string"
+        }
+      }
+    },
+    "domain_resolution_request": {
+      "type": "object",
+      "required": [
+        "domains"
+      ],
+      "properties": {
+        "domains": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/ingress_vip"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
+            "type": "object",
+            "x-go-type": {
+              "import": {
+                "path": "github.com/openshift/assisted-service/models"
+              },
+              "type": "DomainResolutionRequestDomain"
+            }
+          }
+        }
+      }
+    },
+    "domain_resolution_response": {
+      "type": "object",
+      "required": [
+        "resolutions"
+      ],
+      "properties": {
+        "resolutions": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "cnames": {
+                "description": "The cnames that were resolved for the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string"
+                }
+              },
+              "domain_name": {
+                "description": "The domain that was resolved",
+                "type": "string"
+              },
+              "ipv4_addresses": {
+                "description": "The IPv4 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv4"
+                }
+              },
+              "ipv6_addresses": {
+                "description": "The IPv6 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv6"
+                }
+              }
+            },
+            "x-go-name": "DomainResolutionResponseDomain"
+          }
+        }
+      }
+    },
+    "download_boot_artifacts_request": {
+      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
+      "type": "object",
+      "required": [
+        "kernel_url",
+        "rootfs_url",
+        "initrd_url",
+        "host_fs_mount_dir"
+      ],
+      "properties": {
+        "host_fs_mount_dir": {
+          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
+          "type": "string"
         },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "initrd_url": {
+          "description": "URL address to download the initrd.",
+          "type": "string"
         },
-        "install_config_overrides": {
-          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}"
+        "kernel_url": {
+          "description": "URL address to download the kernel.",
+          "type": "string"
         },
-        "install_started_at": {
-          "description": "The time that this cluster started installation.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "rootfs_url": {
+          "description": "URL address to download the rootfs.",
+          "type": "string"
+        }
+      }
+    },
+    "drive_type": {
+      "type": "string",
+      "enum": [
+        "Unknown",
+        "HDD",
+        "FDD",
+        "ODD",
+        "SSD",
+        "virtual",
+        "Multipath",
+        "iSCSI",
+        "FC",
+        "LVM",
+        "RAID",
+        "ECKD",
+        "ECKD (ESE)",
+        "FBA"
+      ]
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+          "type": "string"
         },
-        "ip_collisions": {
-          "description": "Json formatted string containing ip collisions detected in the cluster.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
         },
         "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n'DisconnectedCluster' for clusters with embedded ignition for offline installation,\n",
+          "description": "Indicates the type of this object. Will always be 'Error'.",
           "type": "string",
           "enum": [
-            "Cluster",
-            "AddHostsCluster",
-            "DisconnectedCluster"
+            "Error"
           ]
         },
-        "last-installation-preparation": {
-          "$ref": "#/definitions/last-installation-preparation"
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "severity",
+        "message",
+        "event_time"
+      ],
+      "properties": {
+        "category": {
+          "type": "string",
+          "enum": [
+            "user",
+            "metrics"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'user'\""
         },
-        "load_balancer": {
-          "$ref": "#/definitions/load_balancer"
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
         },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "$ref": "#/definitions/logs_state"
+        "event_time": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
         },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/machine_network"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+        "infra_env_id": {
+          "description": "Unique identifier of the infra-env this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
           "x-nullable": true
         },
-        "monitored_operators": {
-          "description": "Operators that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/monitored-operator"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
         },
         "name": {
-          "description": "Name of the OpenShift cluster.",
+          "description": "Event Name.",
           "type": "string"
         },
-        "network_type": {
-          "description": "The desired network type used.",
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
           "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string"
-        },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "openshift_cluster_id": {
-          "description": "Cluster ID on OCP system.",
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
           "type": "string",
           "format": "uuid"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "org_id": {
-          "type": "string"
-        },
-        "org_soft_timeouts_enabled": {
-          "description": "Indication if organization soft timeouts is enabled for the cluster.",
-          "type": "boolean"
-        },
-        "platform": {
-          "$ref": "#/definitions/platform"
-        },
-        "progress": {
-          "description": "Installation progress percentages of the cluster.",
-          "$ref": "#/definitions/cluster-progress-info"
-        },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
-          "type": "boolean"
-        },
-        "ready_host_count": {
-          "description": "hosts associated to this cluster that are in 'known' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
-        },
-        "schedulable_masters_forced_true": {
-          "description": "Indicates if schedule workloads on masters will be enabled regardless the value of 'schedulable_masters' property.\nSet to 'true' when not enough hosts are associated with this cluster to disable the scheduling on masters.\n",
-          "type": "boolean",
-          "default": true
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "severity": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "feature": {
+      "type": "object",
+      "required": [
+        "feature-support-level-id",
+        "support_level",
+        "incompatibilities"
+      ],
+      "properties": {
+        "feature-support-level-id": {
+          "$ref": "#/definitions/feature-support-level-id"
         },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
+        "incompatibilities": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/service_network"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
+            "$ref": "#/definitions/feature-support-level-id"
+          }
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "reason": {
+          "$ref": "#/definitions/incompatibility-reason"
+        },
+        "support_level": {
+          "$ref": "#/definitions/support-level"
+        }
+      }
+    },
+    "feature-support-level-id": {
+      "type": "string",
+      "enum": [
+        "SNO",
+        "TNA",
+        "VIP_AUTO_ALLOC",
+        "CUSTOM_MANIFEST",
+        "SINGLE_NODE_EXPANSION",
+        "LVM",
+        "ODF",
+        "LSO",
+        "CNV",
+        "MCE",
+        "MTV",
+        "OSC",
+        "NUTANIX_INTEGRATION",
+        "BAREMETAL_PLATFORM",
+        "NONE_PLATFORM",
+        "VSPHERE_INTEGRATION",
+        "OPENSTACK_INTEGRATION",
+        "DUAL_STACK_VIPS",
+        "CLUSTER_MANAGED_NETWORKING",
+        "USER_MANAGED_NETWORKING",
+        "MINIMAL_ISO",
+        "FULL_ISO",
+        "EXTERNAL_PLATFORM_OCI",
+        "DUAL_STACK",
+        "PLATFORM_MANAGED_NETWORKING",
+        "EXTERNAL_PLATFORM",
+        "OVN_NETWORK_TYPE",
+        "SDN_NETWORK_TYPE",
+        "NODE_FEATURE_DISCOVERY",
+        "NVIDIA_GPU",
+        "PIPELINES",
+        "SERVICEMESH",
+        "SERVERLESS",
+        "OPENSHIFT_AI",
+        "NON_STANDARD_HA_CONTROL_PLANE",
+        "AUTHORINO",
+        "USER_MANAGED_LOAD_BALANCER",
+        "NMSTATE",
+        "AMD_GPU",
+        "KMM",
+        "NODE_HEALTHCHECK",
+        "SELF_NODE_REMEDIATION",
+        "FENCE_AGENTS_REMEDIATION",
+        "NODE_MAINTENANCE",
+        "KUBE_DESCHEDULER",
+        "CLUSTER_OBSERVABILITY",
+        "NUMA_RESOURCES",
+        "OADP",
+        "METALLB",
+        "DUAL_STACK_PRIMARY_IPV6",
+        "LOKI",
+        "OPENSHIFT_LOGGING"
+      ],
+      "x-nullable": false
+    },
+    "fencing-credentials-params": {
+      "type": "object",
+      "required": [
+        "address",
+        "username",
+        "password"
+      ],
+      "properties": {
+        "address": {
+          "description": "The URL of the host's BMC, for example https://bmc1.example.com.",
           "type": "string"
         },
-        "status": {
-          "description": "Status of the OpenShift cluster.",
+        "certificate_verification": {
+          "description": "Whether to enable or disable certificate verification when connecting to the host's BMC.",
           "type": "string",
+          "default": "Enabled",
           "enum": [
-            "insufficient",
-            "ready",
-            "error",
-            "preparing-for-installation",
-            "pending-for-input",
-            "installing",
-            "finalizing",
-            "installed",
-            "adding-hosts",
-            "cancelled",
-            "installing-pending-user-action",
-            "unmonitored"
+            "Enabled",
+            "Disabled"
           ]
         },
-        "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        },
-        "status_updated_at": {
-          "description": "The last time that the cluster status was updated.",
+        "password": {
+          "description": "The password to connect to the host's BMC.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-go-custom-tag": "secret:\"true\""
         },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
+        "username": {
+          "description": "The username to connect to the host's BMC.",
           "type": "string"
+        }
+      }
+    },
+    "finalizing-stage": {
+      "description": "Cluster finalizing stage managed by controller",
+      "type": "string",
+      "enum": [
+        "Waiting for cluster operators",
+        "Adding router ca",
+        "Applying olm manifests",
+        "Waiting for olm operators csv initialization",
+        "Waiting for olm operators csv",
+        "Waiting for OLM operator setup jobs",
+        "Done"
+      ]
+    },
+    "free-addresses-list": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "format": "ipv4"
+      }
+    },
+    "free_addresses_request": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+      }
+    },
+    "free_network_addresses": {
+      "type": "object",
+      "properties": {
+        "free_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv4"
+          }
         },
-        "total_host_count": {
-          "description": "All hosts associated to this cluster.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "updated_at": {
-          "description": "The last time that this cluster was updated.",
+        "network": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        }
+      }
+    },
+    "free_networks_addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free_network_addresses"
+      }
+    },
+    "gpu": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "Device address (for example \"0000:00:02.0\")",
+          "type": "string"
         },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
+        "device_id": {
+          "description": "ID of the device (for example \"3ea0\")",
+          "type": "string"
         },
-        "user_name": {
+        "name": {
+          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
           "type": "string"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "vendor": {
+          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+          "type": "string"
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+        "vendor_id": {
+          "description": "ID of the vendor (for example \"8086\")",
+          "type": "string"
         }
       }
     },
-    "cluster-create-params": {
+    "host": {
       "type": "object",
       "required": [
-        "name",
-        "openshift_version",
-        "pull_secret"
+        "kind",
+        "id",
+        "href",
+        "status",
+        "status_info"
       ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+        "api_vip_connectivity": {
+          "description": "Contains a serialized api_vip_connectivity_response",
           "type": "string",
-          "x-nullable": true
-        },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip"
-          }
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+        "bmc_address": {
+          "description": "The address of the BMC of the host, set with its BMC credentials.",
           "type": "string"
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "10.128.0.0/14",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "default": 23,
-          "maximum": 128,
-          "minimum": 1
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
-          },
-          "x-nullable": true
-        },
-        "control_plane_count": {
-          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
-          "type": "integer",
-          "x-nullable": true
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ],
-          "x-nullable": false
-        },
-        "disk_encryption": {
-          "description": "Installation disks encryption mode and host roles to be applied.",
-          "$ref": "#/definitions/disk-encryption"
-        },
-        "high_availability_mode": {
-          "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
-          "type": "string",
-          "default": "all",
-          "enum": [
-            "none",
-            "masters",
-            "arbiters",
-            "workers",
-            "masters,arbiters",
-            "masters,workers",
-            "arbiters,workers",
-            "masters,arbiters,workers",
-            "all"
-          ]
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "$ref": "#/definitions/ignition-endpoint"
-        },
-        "ingress_vips": {
-          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ingress_vip"
-          }
-        },
-        "load_balancer": {
-          "$ref": "#/definitions/load_balancer"
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/machine_network"
-          },
-          "x-nullable": true
+        "bmc_credentials_set": {
+          "description": "True if the credentials of the BMC of the host are set.",
+          "type": "boolean"
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1
+        "bootstrap": {
+          "type": "boolean"
         },
-        "network_type": {
-          "description": "The desired network type used.",
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
           "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
           "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"foreignkey:Cluster\"",
           "x-nullable": true
         },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
+        "connection_timed_out": {
+          "description": "Indicate that connection to assisted service was timed out when soft timeout is enabled.",
+          "type": "boolean"
         },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-create-params"
+        "connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
           }
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+        "custom_diagnostics": {
+          "description": "Contains a serialized list of custom_diagnostic_result",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "platform": {
-          "x-nullable": true,
-          "$ref": "#/definitions/platform"
+        "deleted_at": {
+          "description": "swagger:ignore",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "gorm.io/gorm"
+            },
+            "type": "DeletedAt"
+          },
+          "x-nullable": false
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+        "discovery_agent_version": {
           "type": "string"
         },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
+        "disks_info": {
+          "description": "Additional information about disks, formatted as JSON.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "disks_to_be_formatted": {
+          "description": "A comma-separated list of disks that will be formatted once\ninstallation begins, unless otherwise set to be skipped by\nskip_formatting_disks. This means that this list also includes disks\nthat appear in skip_formatting_disks. This property is managed by the\nservice and cannot be modified by the user.",
           "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
-          },
-          "x-nullable": true
+        "domain_name_resolutions": {
+          "description": "The domain name resolution result.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
+        "fencing_credentials": {
+          "description": "The host's BMC credentials that will be used in TNF.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
+        "free_addresses": {
           "type": "string",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
+        "href": {
+          "description": "Self link.",
+          "type": "string"
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
-        }
-      }
-    },
-    "cluster-finalizing-progress": {
-      "type": "object",
-      "properties": {
-        "finalizing_stage": {
-          "$ref": "#/definitions/finalizing-stage"
-        }
-      }
-    },
-    "cluster-host-requirements": {
-      "type": "object",
-      "properties": {
-        "host_id": {
-          "description": "Unique identifier of the host the requirements relate to.",
+        "id": {
+          "description": "Unique identifier of the object.",
           "type": "string",
-          "format": "uuid"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "ocp": {
-          "description": "Host requirements for the OCP installation",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
         },
-        "operators": {
-          "description": "Host requirements related to requested operators",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-host-requirements"
-          }
+        "ignition_endpoint_token_set": {
+          "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
+          "type": "boolean"
         },
-        "total": {
-          "description": "Total host requirements for the cluster configuration",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        }
-      }
-    },
-    "cluster-host-requirements-details": {
-      "type": "object",
-      "properties": {
-        "cpu_cores": {
-          "description": "Required number of CPU cores",
-          "type": "integer"
+        "images_status": {
+          "description": "Array of image statuses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "disk_size_gb": {
-          "description": "Required disk size in GB",
-          "type": "integer"
+        "infra_env_id": {
+          "description": "The infra-env that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey;foreignkey:InfraEnvID\""
         },
-        "installation_disk_speed_threshold_ms": {
-          "description": "Required installation disk speed in ms",
-          "type": "integer"
+        "installation_disk_id": {
+          "description": "Contains the inventory disk id to install on.",
+          "type": "string"
         },
-        "network_latency_threshold_ms": {
-          "description": "Maximum network average latency (RTT) at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "installation_disk_path": {
+          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+          "type": "string",
+          "example": "/dev/sda"
         },
-        "packet_loss_percentage": {
-          "description": "Maximum packet loss allowed at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "installer_args": {
+          "type": "string"
         },
-        "ram_mib": {
-          "description": "Required number of RAM in MiB",
-          "type": "integer"
+        "installer_version": {
+          "description": "Installer version.",
+          "type": "string"
         },
-        "tpm_enabled_in_bios": {
-          "description": "Whether TPM module should be enabled in host's BIOS.",
-          "type": "boolean"
-        }
-      }
-    },
-    "cluster-host-requirements-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster-host-requirements"
-      }
-    },
-    "cluster-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster"
-      }
-    },
-    "cluster-progress-info": {
-      "type": "object",
-      "properties": {
-        "finalizing_stage": {
-          "$ref": "#/definitions/finalizing-stage"
+        "inventory": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
+          "type": "string",
+          "enum": [
+            "Host",
+            "AddToExistingClusterHost"
+          ]
+        },
+        "logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "finalizing_stage_percentage": {
-          "type": "integer"
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "$ref": "#/definitions/logs_state"
         },
-        "finalizing_stage_started_at": {
+        "logs_started_at": {
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "finalizing_stage_timed_out": {
-          "type": "boolean"
+        "machine_config_pool_name": {
+          "type": "string"
         },
-        "installing_stage_percentage": {
-          "type": "integer"
+        "media_status": {
+          "type": "string",
+          "default": "connected",
+          "enum": [
+            "connected",
+            "disconnected"
+          ],
+          "x-nullable": true
         },
-        "preparing_for_installation_stage_percentage": {
-          "type": "integer"
+        "mirror_registry_check": {
+          "description": "Contains a serialized mirror_registry_check_response",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "total_percentage": {
-          "type": "integer"
-        }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
-    },
-    "cluster-validation-id": {
-      "type": "string",
-      "enum": [
-        "machine-cidr-defined",
-        "cluster-cidr-defined",
-        "service-cidr-defined",
-        "no-cidrs-overlapping",
-        "networks-same-address-families",
-        "network-prefix-valid",
-        "machine-cidr-equals-to-calculated-cidr",
-        "api-vips-defined",
-        "api-vips-valid",
-        "ingress-vips-defined",
-        "ingress-vips-valid",
-        "all-hosts-are-ready-to-install",
-        "sufficient-masters-count",
-        "dns-domain-defined",
-        "pull-secret-set",
-        "ntp-server-configured",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "odf-requirements-satisfied",
-        "cnv-requirements-satisfied",
-        "lvm-requirements-satisfied",
-        "mce-requirements-satisfied",
-        "mtv-requirements-satisfied",
-        "osc-requirements-satisfied",
-        "network-type-valid",
-        "platform-requirements-satisfied",
-        "node-feature-discovery-requirements-satisfied",
-        "nvidia-gpu-requirements-satisfied",
-        "pipelines-requirements-satisfied",
-        "servicemesh-requirements-satisfied",
-        "serverless-requirements-satisfied",
-        "openshift-ai-requirements-satisfied",
-        "openshift-ai-gpu-requirements-satisfied",
-        "authorino-requirements-satisfied",
-        "nmstate-requirements-satisfied",
-        "amd-gpu-requirements-satisfied",
-        "kmm-requirements-satisfied",
-        "node-healthcheck-requirements-satisfied",
-        "self-node-remediation-requirements-satisfied",
-        "fence-agents-remediation-requirements-satisfied",
-        "node-maintenance-requirements-satisfied",
-        "kube-descheduler-requirements-satisfied",
-        "cluster-observability-requirements-satisfied",
-        "numa-resources-requirements-satisfied",
-        "oadp-requirements-satisfied",
-        "metallb-requirements-satisfied",
-        "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
-      ]
-    },
-    "cluster_default_config": {
-      "type": "object",
-      "properties": {
-        "cluster_network_cidr": {
+        "node_labels": {
+          "description": "Json containing node's labels.",
           "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "cluster_network_host_prefix": {
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
+        "ntp_sources": {
+          "description": "The configured NTP sources on the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "cluster_networks_dualstack": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
-          }
+        "progress": {
+          "$ref": "#/definitions/host-progress-info"
         },
-        "cluster_networks_ipv4": {
+        "progress_stages": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/cluster_network"
-          }
+            "$ref": "#/definitions/host-stage"
+          },
+          "x-go-custom-tag": "gorm:\"-\"",
+          "x-nullable": true
         },
-        "forbidden_hostnames": {
-          "description": "This provides a list of forbidden hostnames. If this list is empty or not present, this implies that the UI should fall back to a hard coded list.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "registered_at": {
+          "description": "The last time the host's agent tried to register in the service.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "inactive_deletion_hours": {
-          "type": "integer"
+        "requested_hostname": {
+          "type": "string"
         },
-        "ntp_source": {
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "skip_formatting_disks": {
+          "description": "A comma-seperated list of host disks that the service will avoid\nformatting.",
           "type": "string",
-          "x-omitempty": false
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "service_network_cidr": {
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
           "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "service_networks_dualstack": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
-          }
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "service_networks_ipv4": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
-          }
-        }
-      }
-    },
-    "cluster_network": {
-      "description": "A network from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-      "type": "object",
-      "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
+        "status": {
+          "type": "string",
+          "enum": [
+            "discovering",
+            "known",
+            "disconnected",
+            "insufficient",
+            "disabled",
+            "preparing-for-installation",
+            "preparing-failed",
+            "preparing-successful",
+            "pending-for-input",
+            "installing",
+            "installing-in-progress",
+            "installing-pending-user-action",
+            "resetting-pending-user-action",
+            "installed",
+            "error",
+            "resetting",
+            "added-to-existing-cluster",
+            "cancelled",
+            "binding",
+            "unbinding",
+            "unbinding-pending-user-action",
+            "known-unbound",
+            "disconnected-unbound",
+            "insufficient-unbound",
+            "disabled-unbound",
+            "discovering-unbound",
+            "reclaiming",
+            "reclaiming-rebooting"
+          ]
         },
-        "cluster_id": {
-          "description": "The cluster that this network is associated with.",
+        "status_info": {
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example if is set to 23, then each node is assigned a /23 subnet out of the given CIDR, which allows for 510 (2^(32 - 23) - 2) pod IPs addresses.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
-        }
-      }
-    },
-    "completion-params": {
-      "type": "object",
-      "required": [
-        "is_success"
-      ],
-      "properties": {
-        "data": {
-          "description": "additional data from the cluster",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+        "status_updated_at": {
+          "description": "The last time that the host status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "suggested_role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "tang_connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "timestamp": {
+          "description": "The time on the host as seconds since the Unix epoch.",
+          "type": "integer"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
           }
         },
-        "error_info": {
+        "user_name": {
           "type": "string"
         },
-        "is_success": {
-          "type": "boolean"
-        }
-      }
-    },
-    "connectivity-check-host": {
-      "type": "object",
-      "properties": {
-        "host_id": {
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
           "type": "string",
-          "format": "uuid"
-        },
-        "nics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-check-nic"
-          }
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "connectivity-check-nic": {
+    "host-bmc-credentials": {
       "type": "object",
+      "required": [
+        "address",
+        "username",
+        "password"
+      ],
       "properties": {
-        "ip_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-          }
+        "address": {
+          "description": "The address of the BMC, e.g. redfish+https://192.0.2.10/redfish/v1/Systems/1. Only Redfish BMCs are supported, IPMI addresses (ipmi://) are rejected, use the Redfish address of the BMC instead. The redfish scheme uses https. When the path of a Redfish address doesn't point to a system, the first system of the BMC is used.",
+          "type": "string"
         },
-        "mac": {
+        "disable_certificate_verification": {
+          "description": "Whether the certificate of a BMC reached with https is verified.",
+          "type": "boolean",
+          "default": false
+        },
+        "password": {
           "type": "string",
-          "format": "mac"
+          "format": "password",
+          "minLength": 1,
+          "x-go-custom-tag": "secret:\"true\""
         },
-        "name": {
-          "type": "string"
+        "username": {
+          "type": "string",
+          "minLength": 1
         }
       }
     },
-    "connectivity-check-params": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/connectivity-check-host"
-      }
-    },
-    "connectivity-remote-host": {
+    "host-create-params": {
       "type": "object",
+      "required": [
+        "host_id"
+      ],
       "properties": {
+        "discovery_agent_version": {
+          "type": "string"
+        },
         "host_id": {
           "type": "string",
           "format": "uuid"
-        },
-        "l2_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l2-connectivity"
-          }
-        },
-        "l3_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l3-connectivity"
-          }
-        },
-        "mtu_report": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/mtu-report"
-          }
         }
       }
     },
-    "connectivity-report": {
-      "type": "object",
+    "host-ignition-params": {
       "properties": {
-        "remote_hosts": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-remote-host"
-          }
+        "config": {
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
         }
       }
     },
-    "container_image_availability": {
-      "type": "object",
-      "properties": {
-        "download_rate": {
-          "description": "The rate of size/time in seconds MBps.",
-          "type": "number"
-        },
-        "name": {
-          "description": "A fully qualified image name (FQIN).",
-          "type": "string"
-        },
-        "result": {
-          "$ref": "#/definitions/container_image_availability_result"
-        },
-        "size_bytes": {
-          "description": "Size of the image in bytes.",
-          "type": "number"
-        },
-        "time": {
-          "description": "Seconds it took to pull the image.",
-          "type": "number"
-        }
+    "host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host"
       }
     },
-    "container_image_availability_request": {
+    "host-node-removal-params": {
       "type": "object",
-      "required": [
-        "images"
-      ],
       "properties": {
-        "images": {
-          "description": "List of image names to be checked.",
-          "type": "array",
-          "items": {
-            "description": "A fully qualified image name (FQIN).",
-            "type": "string",
-            "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-          }
-        },
-        "timeout": {
-          "description": "Positive number represents a timeout in seconds for a pull operation.",
+        "drain_timeout": {
+          "description": "How long to wait for the node to drain, in seconds, before removing it anyway. Defaults to 10 minutes.",
           "type": "integer"
         }
       }
     },
-    "container_image_availability_response": {
+    "host-power-action-params": {
       "type": "object",
       "required": [
-        "images"
+        "action"
       ],
       "properties": {
-        "images": {
-          "description": "List of images that were checked.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/container_image_availability"
-          }
+        "action": {
+          "description": "The power action. boot-from-iso mounts the discovery ISO of the infra-env of the host as virtual media, sets it as the next boot device and powers the host on, or restarts it when it is already on.",
+          "type": "string",
+          "enum": [
+            "power-on",
+            "power-off",
+            "reboot",
+            "boot-from-iso"
+          ]
         }
       }
     },
-    "container_image_availability_result": {
-      "description": "Image availability result.",
-      "type": "string",
-      "enum": [
-        "success",
-        "failure"
-      ]
-    },
-    "cpu": {
+    "host-progress": {
       "type": "object",
       "properties": {
-        "architecture": {
-          "type": "string"
-        },
-        "count": {
-          "type": "integer"
-        },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "frequency": {
-          "type": "number"
+        "current_stage": {
+          "$ref": "#/definitions/host-stage"
         },
-        "model_name": {
-          "type": "string"
+        "progress_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         }
       }
     },
-    "create-manifest-params": {
+    "host-progress-info": {
       "type": "object",
-      "required": [
-        "file_name",
-        "content"
-      ],
       "properties": {
-        "content": {
-          "description": "base64 encoded manifest content.",
-          "type": "string"
+        "current_stage": {
+          "$ref": "#/definitions/host-stage"
         },
-        "file_name": {
-          "description": "The name of the manifest to customize the installed OCP cluster.",
+        "installation_percentage": {
+          "type": "integer"
+        },
+        "progress_info": {
           "type": "string",
-          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$"
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
           "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
-        }
-      }
-    },
-    "credentials": {
-      "type": "object",
-      "properties": {
-        "console_url": {
-          "type": "string"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "password": {
-          "type": "string"
+        "stage_timed_out": {
+          "description": "Indicate of the current stage has been timed out.",
+          "type": "boolean"
         },
-        "username": {
-          "type": "string"
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+    },
+    "host-role": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "arbiter",
+        "worker",
+        "bootstrap"
+      ]
+    },
+    "host-role-update-params": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "arbiter",
+        "worker"
+      ]
+    },
+    "host-stage": {
+      "type": "string",
+      "enum": [
+        "Starting installation",
+        "Waiting for control plane",
+        "Waiting for bootkube",
+        "Waiting for controller",
+        "Installing",
+        "Writing image to disk",
+        "Copying registry data to disk",
+        "Rebooting",
+        "Waiting for ignition",
+        "Configuring",
+        "Joined",
+        "Done",
+        "Failed"
+      ]
     },
-    "dhcp_allocation_request": {
+    "host-static-network-config-generate-params": {
       "type": "object",
-      "required": [
-        "interface",
-        "api_vip_mac",
-        "ingress_vip_mac"
-      ],
       "properties": {
-        "api_vip_lease": {
-          "description": "Contents of lease file to be used for API virtual IP.",
-          "type": "string"
-        },
-        "api_vip_mac": {
-          "description": "MAC address for the API virtual IP.",
-          "type": "string",
-          "format": "mac"
+        "apply": {
+          "description": "Whether to replace the static network configuration of the host in its infra-env with the generated one.",
+          "type": "boolean",
+          "default": false
         },
-        "ingress_vip_lease": {
-          "description": "Contents of lease file to be used for for Ingress virtual IP.",
-          "type": "string"
+        "bonds": {
+          "description": "Bonds to create from the physical interfaces of the host.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-bond"
+          }
         },
-        "ingress_vip_mac": {
-          "description": "MAC address for the Ingress virtual IP.",
-          "type": "string",
-          "format": "mac"
+        "dns_servers": {
+          "description": "DNS servers of the host, the discovered inventory doesn't contain them.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "interface": {
-          "description": "The network interface (NIC) to run the DHCP requests on.",
-          "type": "string"
+        "vlans": {
+          "description": "VLANs to create on the physical interfaces or bonds of the host.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-vlan"
+          }
         }
       }
     },
-    "dhcp_allocation_response": {
+    "host-type-hardware-requirements": {
       "type": "object",
-      "required": [
-        "api_vip_address",
-        "ingress_vip_address"
-      ],
       "properties": {
-        "api_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
-          "type": "string",
-          "format": "ipv4"
-        },
-        "api_vip_lease": {
-          "description": "Contents of last acquired lease for API virtual IP.",
-          "type": "string"
-        },
-        "ingress_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
-          "type": "string",
-          "format": "ipv4"
+        "qualitative": {
+          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "ingress_vip_lease": {
-          "description": "Contents of last acquired lease for Ingress virtual IP.",
-          "type": "string"
+        "quantitative": {
+          "description": "Host requirements that can be quantified",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "disconnected-cluster-create-params": {
+    "host-type-hardware-requirements-wrapper": {
       "type": "object",
-      "required": [
-        "name",
-        "openshift_version"
-      ],
       "properties": {
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1
+        "master": {
+          "description": "Requirements towards a master node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+        "worker": {
+          "description": "Requirements towards a worker node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
         }
       }
     },
-    "disk": {
+    "host-update-params": {
       "type": "object",
       "properties": {
-        "bootable": {
-          "type": "boolean"
-        },
-        "by_id": {
-          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
-          "type": "string"
-        },
-        "by_path": {
-          "description": "by-path is the shortest physical path to the device",
-          "type": "string"
-        },
-        "drive_type": {
-          "$ref": "#/definitions/drive_type"
-        },
-        "has_uuid": {
-          "type": "boolean"
-        },
-        "hctl": {
-          "type": "string"
-        },
-        "holders": {
-          "description": "A comma-separated list of disk names that this disk belongs to",
-          "type": "string"
-        },
-        "id": {
-          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
-          "type": "string"
-        },
-        "installation_eligibility": {
-          "type": "object",
-          "properties": {
-            "eligible": {
-              "description": "Whether the disk is eligible for installation or not.",
-              "type": "boolean"
-            },
-            "not_eligible_reasons": {
-              "description": "Reasons for why this disk is not eligible for installation.",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+        "disks_selected_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk-config-params"
           },
-          "x-nullable": false
-        },
-        "io_perf": {
-          "$ref": "#/definitions/io_perf"
-        },
-        "is_installation_media": {
-          "description": "Whether the disk appears to be an installation media or not",
-          "type": "boolean"
-        },
-        "iscsi": {
-          "$ref": "#/definitions/iscsi"
-        },
-        "model": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "partitionTypes": {
-          "type": "string"
-        },
-        "path": {
-          "type": "string"
-        },
-        "removable": {
-          "type": "boolean"
-        },
-        "serial": {
-          "type": "string"
-        },
-        "size_bytes": {
-          "type": "integer"
+          "x-nullable": true
         },
-        "smart": {
-          "type": "string"
+        "disks_skip_formatting": {
+          "description": "Allows changing the host's skip_formatting_disks parameter",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk-skip-formatting-params"
+          },
+          "x-nullable": true
         },
-        "vendor": {
-          "type": "string"
+        "fencing_credentials": {
+          "description": "The host's BMC credentials that will be used in TNF.",
+          "x-nullable": true,
+          "$ref": "#/definitions/fencing-credentials-params"
         },
-        "wwn": {
-          "type": "string"
-        }
-      }
-    },
-    "disk-config-params": {
-      "type": "object",
-      "required": [
-        "id"
-      ],
-      "properties": {
-        "id": {
-          "type": "string"
+        "host_name": {
+          "type": "string",
+          "x-nullable": true
         },
-        "role": {
-          "$ref": "#/definitions/disk-role"
-        }
-      }
-    },
-    "disk-encryption": {
-      "type": "object",
-      "properties": {
-        "enable_on": {
-          "description": "Enable/disable disk encryption on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+        "host_role": {
           "type": "string",
-          "default": "none",
           "enum": [
-            "none",
-            "masters",
-            "arbiters",
-            "workers",
-            "masters,arbiters",
-            "masters,workers",
-            "arbiters,workers",
-            "masters,arbiters,workers",
-            "all"
-          ]
+            "auto-assign",
+            "master",
+            "arbiter",
+            "worker"
+          ],
+          "x-nullable": true
         },
-        "mode": {
-          "description": "The disk encryption mode to use.",
+        "ignition_endpoint_http_headers": {
+          "description": "JSON-formatted string of additional HTTP headers when fetching the ignition.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-endpoint-http-headers-params"
+          },
+          "x-nullable": true
+        },
+        "ignition_endpoint_token": {
+          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
           "type": "string",
-          "default": "tpmv2",
-          "enum": [
-            "tpmv2",
-            "tang"
-          ]
+          "x-go-custom-tag": "secret:\"true\"",
+          "x-nullable": true
         },
-        "tang_servers": {
-          "description": "JSON-formatted string containing additional information regarding tang's configuration",
+        "machine_config_pool_name": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
+          "x-nullable": true
+        },
+        "node_labels": {
+          "description": "Labels to be added to the corresponding node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/node-label-params"
+          },
+          "x-nullable": true
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
+      }
     },
-    "disk-role": {
+    "host-validation-id": {
       "type": "string",
       "enum": [
-        "none",
-        "install"
+        "connected",
+        "media-connected",
+        "has-inventory",
+        "has-min-cpu-cores",
+        "has-min-valid-disks",
+        "has-min-memory",
+        "machine-cidr-defined",
+        "has-cpu-cores-for-role",
+        "has-memory-for-role",
+        "hostname-unique",
+        "hostname-valid",
+        "belongs-to-machine-cidr",
+        "ignition-downloadable",
+        "belongs-to-majority-group",
+        "valid-platform-network-settings",
+        "ntp-synced",
+        "time-synced-between-host-and-service",
+        "container-images-available",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "odf-requirements-satisfied",
+        "lvm-requirements-satisfied",
+        "mce-requirements-satisfied",
+        "mtv-requirements-satisfied",
+        "osc-requirements-satisfied",
+        "sufficient-installation-disk-speed",
+        "cnv-requirements-satisfied",
+        "sufficient-network-latency-requirement-for-role",
+        "sufficient-packet-loss-requirement-for-role",
+        "sufficient-network-throughput-requirement-for-role",
+        "has-default-route",
+        "api-domain-name-resolved-correctly",
+        "api-int-domain-name-resolved-correctly",
+        "apps-domain-name-resolved-correctly",
+        "release-domain-name-resolved-correctly",
+        "compatible-with-cluster-platform",
+        "dns-wildcard-not-configured",
+        "disk-encryption-requirements-satisfied",
+        "non-overlapping-subnets",
+        "vsphere-disk-uuid-enabled",
+        "compatible-agent",
+        "no-skip-installation-disk",
+        "installation-disk-healthy",
+        "firmware-compliant",
+        "no-skip-missing-disk",
+        "no-ip-collisions-in-network",
+        "no-iscsi-nic-belongs-to-machine-cidr",
+        "node-feature-discovery-requirements-satisfied",
+        "nvidia-gpu-requirements-satisfied",
+        "pipelines-requirements-satisfied",
+        "servicemesh-requirements-satisfied",
+        "serverless-requirements-satisfied",
+        "openshift-ai-requirements-satisfied",
+        "authorino-requirements-satisfied",
+        "mtu-valid",
+        "nmstate-requirements-satisfied",
+        "amd-gpu-requirements-satisfied",
+        "kmm-requirements-satisfied",
+        "node-healthcheck-requirements-satisfied",
+        "self-node-remediation-requirements-satisfied",
+        "fence-agents-remediation-requirements-satisfied",
+        "node-maintenance-requirements-satisfied",
+        "kube-descheduler-requirements-satisfied",
+        "cluster-observability-requirements-satisfied",
+        "numa-resources-requirements-satisfied",
+        "oadp-requirements-satisfied",
+        "metallb-requirements-satisfied",
+        "loki-requirements-satisfied",
+        "openshift-logging-requirements-satisfied"
       ]
     },
-    "disk-skip-formatting-params": {
-      "description": "Allows an addition or removal of a host disk from the host's skip_formatting_disks list",
+    "host_network": {
       "type": "object",
-      "required": [
-        "disk_id",
-        "skip_formatting"
-      ],
       "properties": {
-        "disk_id": {
-          "description": "The ID of the disk that is being added to or removed from the host's skip_formatting_disks list",
+        "cidr": {
           "type": "string"
         },
-        "skip_formatting": {
-          "description": "True if you wish to add the disk to the skip_formatting_disks list, false if you wish to remove it",
-          "type": "boolean"
+        "host_ids": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
         }
       }
     },
-    "disk_info": {
-      "type": "object",
-      "properties": {
-        "disk_speed": {
-          "$ref": "#/definitions/disk_speed"
-        },
-        "id": {
-          "type": "string",
-          "format": "uuid"
+    "host_registration_response": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/host"
         },
-        "path": {
-          "type": "string"
+        {
+          "properties": {
+            "next_step_runner_command": {
+              "description": "Command for starting the next step runner",
+              "type": "object",
+              "properties": {
+                "args": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "command": {
+                  "type": "string"
+                },
+                "retry_seconds": {
+                  "description": "How long in seconds to wait before retrying registration if the command fails",
+                  "type": "integer"
+                }
+              }
+            }
+          }
         }
-      }
+      ]
     },
-    "disk_speed": {
+    "host_static_network_config": {
       "type": "object",
       "properties": {
-        "exit_code": {
-          "type": "integer"
-        },
-        "speed_ms": {
-          "type": "integer"
+        "mac_interface_map": {
+          "description": "mapping of host macs to logical interfaces used in the network yaml",
+          "$ref": "#/definitions/mac_interface_map"
         },
-        "tested": {
-          "type": "boolean"
-        }
-      }
-    },
-    "disk_speed_check_request": {
-      "type": "object",
-      "required": [
-        "path"
-      ],
-      "properties": {
-        "path": {
-          "description": "--filename argument for fio (expects a file or a block device path).",
+        "network_yaml": {
+          "description": "yaml string that can be processed by nmstate",
           "type": "string"
         }
       }
     },
-    "disk_speed_check_response": {
+    "ignition-endpoint": {
+      "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
       "type": "object",
       "properties": {
-        "io_sync_duration": {
-          "description": "The 99th percentile of fdatasync durations in milliseconds.",
-          "type": "integer"
+        "ca_certificate": {
+          "description": "base64 encoded CA certficate to be used when contacting the URL via https.",
+          "type": "string",
+          "x-nullable": true
         },
-        "path": {
-          "description": "The device path.",
-          "type": "string"
+        "url": {
+          "description": "The URL for the ignition endpoint.",
+          "type": "string",
+          "x-nullable": true
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:ignition_endpoint_\""
     },
-    "domain_resolution_request": {
+    "ignition-endpoint-http-headers-params": {
       "type": "object",
       "required": [
-        "domains"
+        "key",
+        "value"
       ],
       "properties": {
-        "domains": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "x-go-type": {
-              "import": {
-                "path": "github.com/openshift/assisted-service/models"
-              },
-              "type": "DomainResolutionRequestDomain"
-            }
-          }
+        "key": {
+          "description": "The key for the http header's key-value pair.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value for the http header's key-value pair.",
+          "type": "string"
         }
       }
     },
-    "domain_resolution_response": {
+    "ignored-validations": {
       "type": "object",
-      "required": [
-        "resolutions"
-      ],
       "properties": {
-        "resolutions": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "cnames": {
-                "description": "The cnames that were resolved for the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string"
-                }
-              },
-              "domain_name": {
-                "description": "The domain that was resolved",
-                "type": "string"
-              },
-              "ipv4_addresses": {
-                "description": "The IPv4 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv4"
-                }
-              },
-              "ipv6_addresses": {
-                "description": "The IPv6 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv6"
-                }
-              }
-            },
-            "x-go-name": "DomainResolutionResponseDomain"
+        "cluster-validation-ids": {
+          "description": "JSON-formatted list of cluster validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
+          "type": "string",
+          "format": "string"
+        },
+        "host-validation-ids": {
+          "description": "JSON-formatted list of host validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
+          "type": "string",
+          "format": "string"
+        }
+      }
+    },
+    "image-create-params": {
+      "type": "object",
+      "properties": {
+        "image_type": {
+          "description": "Type of image that should be generated.",
+          "$ref": "#/definitions/image_type"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
           }
         }
       }
     },
-    "download_boot_artifacts_request": {
-      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
+    "image_info": {
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "download_url": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "generator_version": {
+          "description": "Image generator version.",
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/image_type"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:image_\""
+    },
+    "image_type": {
+      "type": "string",
+      "enum": [
+        "full-iso",
+        "minimal-iso",
+        "disconnected-iso"
+      ]
+    },
+    "import-cluster-params": {
       "type": "object",
       "required": [
-        "kernel_url",
-        "rootfs_url",
-        "initrd_url",
-        "host_fs_mount_dir"
+        "name",
+        "api_vip_dnsname",
+        "openshift_cluster_id"
       ],
       "properties": {
-        "host_fs_mount_dir": {
-          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
+        "api_vip_dnsname": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
           "type": "string"
         },
-        "initrd_url": {
-          "description": "URL address to download the initrd.",
+        "name": {
+          "description": "OpenShift cluster name.",
           "type": "string"
         },
-        "kernel_url": {
-          "description": "URL address to download the kernel.",
-          "type": "string"
+        "openshift_cluster_id": {
+          "description": "The id of the OCP cluster, that hosts will be added to",
+          "type": "string",
+          "format": "uuid"
         },
-        "rootfs_url": {
-          "description": "URL address to download the rootfs.",
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         }
       }
     },
-    "drive_type": {
+    "incompatibility-reason": {
       "type": "string",
       "enum": [
-        "Unknown",
-        "HDD",
-        "FDD",
-        "ODD",
-        "SSD",
-        "virtual",
-        "Multipath",
-        "iSCSI",
-        "FC",
-        "LVM",
-        "RAID",
-        "ECKD",
-        "ECKD (ESE)",
-        "FBA"
+        "cpuArchitecture",
+        "platform",
+        "openshiftVersion",
+        "ociExternalIntegrationDisabled"
       ]
     },
-    "error": {
+    "infra-env": {
       "type": "object",
       "required": [
         "kind",
         "id",
         "href",
-        "code",
-        "reason"
+        "name",
+        "type",
+        "updated_at",
+        "created_at"
       ],
       "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
+          "type": "string",
+          "x-nullable": false
+        },
+        "cluster_id": {
+          "description": "If set, all hosts that register will be associated with the specified cluster.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ],
+          "x-nullable": false
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "download_url": {
+          "type": "string"
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "generator_version": {
+          "description": "Image generator version.",
           "type": "string"
         },
         "href": {
@@ -8082,3468 +10963,3852 @@ func init() {
           "type": "string"
         },
         "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ignition_config_override": {
+          "description": "Json formatted string containing the user overrides for the initial ignition config.",
+          "type": "string"
+        },
+        "kernel_arguments": {
+          "description": "JSON formatted string array representing the discovery image kernel arguments.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "x-nullable": true
         },
         "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
+          "description": "Indicates the type of this object.",
           "type": "string",
           "enum": [
-            "Error"
+            "InfraEnv"
           ]
         },
-        "reason": {
-          "description": "Human-readable description of the error.",
+        "name": {
+          "description": "Name of the infra-env.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "rendezvous_ip": {
+          "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
+          "type": "string",
+          "pattern": "^(?:$|(?:(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])|(?:([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:(:[0-9a-fA-F]{1,4}){1,6}|:(:[0-9a-fA-F]{1,4}){1,7}|:|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9]))))$",
+          "x-nullable": true
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation.",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/image_type"
+        },
+        "updated_at": {
+          "description": "The last time that this infra-env was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_name": {
           "type": "string"
         }
       }
     },
-    "event": {
+    "infra-env-create-params": {
       "type": "object",
       "required": [
-        "severity",
-        "message",
-        "event_time"
+        "name",
+        "pull_secret"
       ],
       "properties": {
-        "category": {
-          "type": "string",
-          "enum": [
-            "user",
-            "metrics"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'user'\""
-        },
-        "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
           "x-nullable": true
         },
-        "event_time": {
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "maxLength": 65535,
+          "x-nullable": false
         },
-        "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
+        "cluster_id": {
+          "description": "If set, all hosts that register will be associated with the specified cluster.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
           "x-nullable": true
         },
-        "infra_env_id": {
-          "description": "Unique identifier of the infra-env this event relates to.",
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
-          "x-nullable": true
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ],
+          "x-nullable": false
         },
-        "message": {
+        "ignition_config_override": {
+          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+          "x-go-custom-tag": "secret:\"true\""
+        },
+        "image_type": {
+          "$ref": "#/definitions/image_type"
+        },
+        "kernel_arguments": {
+          "$ref": "#/definitions/kernel_arguments"
+        },
+        "mirror_registry_configuration": {
+          "x-nullable": true,
+          "$ref": "#/definitions/mirror-registry-configuration-params"
         },
         "name": {
-          "description": "Event Name.",
+          "description": "Name of the infra-env.",
           "type": "string"
         },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+          "type": "string"
+        },
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "x-go-custom-tag": "secret:\"true\""
         },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
+        "rendezvous_ip": {
+          "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
           "type": "string",
-          "format": "uuid"
+          "pattern": "^(?:$|(?:(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])|(?:([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:(:[0-9a-fA-F]{1,4}){1,6}|:(:[0-9a-fA-F]{1,4}){1,7}|:|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9]))))$",
+          "x-nullable": true
         },
-        "severity": {
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
           "type": "string",
-          "enum": [
-            "info",
-            "warning",
-            "error",
-            "critical"
-          ]
+          "x-nullable": true
+        },
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
         }
       }
     },
-    "event-list": {
+    "infra-env-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/event"
+        "$ref": "#/definitions/infra-env"
       }
     },
-    "feature": {
+    "infra-env-update-params": {
       "type": "object",
-      "required": [
-        "feature-support-level-id",
-        "support_level",
-        "incompatibilities"
-      ],
       "properties": {
-        "feature-support-level-id": {
-          "$ref": "#/definitions/feature-support-level-id"
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
         },
-        "incompatibilities": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/feature-support-level-id"
-          }
+        "additional_trust_bundle": {
+          "description": "Allows users to change the additional_trust_bundle infra-env field",
+          "type": "string",
+          "maxLength": 65535,
+          "x-nullable": true
         },
-        "reason": {
-          "$ref": "#/definitions/incompatibility-reason"
+        "ignition_config_override": {
+          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
         },
-        "support_level": {
-          "$ref": "#/definitions/support-level"
-        }
-      }
-    },
-    "feature-support-level-id": {
-      "type": "string",
-      "enum": [
-        "SNO",
-        "TNA",
-        "VIP_AUTO_ALLOC",
-        "CUSTOM_MANIFEST",
-        "SINGLE_NODE_EXPANSION",
-        "LVM",
-        "ODF",
-        "LSO",
-        "CNV",
-        "MCE",
-        "MTV",
-        "OSC",
-        "NUTANIX_INTEGRATION",
-        "BAREMETAL_PLATFORM",
-        "NONE_PLATFORM",
-        "VSPHERE_INTEGRATION",
-        "DUAL_STACK_VIPS",
-        "CLUSTER_MANAGED_NETWORKING",
-        "USER_MANAGED_NETWORKING",
-        "MINIMAL_ISO",
-        "FULL_ISO",
-        "EXTERNAL_PLATFORM_OCI",
-        "DUAL_STACK",
-        "PLATFORM_MANAGED_NETWORKING",
-        "EXTERNAL_PLATFORM",
-        "OVN_NETWORK_TYPE",
-        "SDN_NETWORK_TYPE",
-        "NODE_FEATURE_DISCOVERY",
-        "NVIDIA_GPU",
-        "PIPELINES",
-        "SERVICEMESH",
-        "SERVERLESS",
-        "OPENSHIFT_AI",
-        "NON_STANDARD_HA_CONTROL_PLANE",
-        "AUTHORINO",
-        "USER_MANAGED_LOAD_BALANCER",
-        "NMSTATE",
-        "AMD_GPU",
-        "KMM",
-        "NODE_HEALTHCHECK",
-        "SELF_NODE_REMEDIATION",
-        "FENCE_AGENTS_REMEDIATION",
-        "NODE_MAINTENANCE",
-        "KUBE_DESCHEDULER",
-        "CLUSTER_OBSERVABILITY",
-        "NUMA_RESOURCES",
-        "OADP",
-        "METALLB",
-        "DUAL_STACK_PRIMARY_IPV6",
-        "LOKI",
-        "OPENSHIFT_LOGGING"
-      ],
-      "x-nullable": false
-    },
-    "fencing-credentials-params": {
-      "type": "object",
-      "required": [
-        "address",
-        "username",
-        "password"
-      ],
-      "properties": {
-        "address": {
-          "description": "The URL of the host's BMC, for example https://bmc1.example.com.",
-          "type": "string"
+        "image_type": {
+          "$ref": "#/definitions/image_type"
         },
-        "certificate_verification": {
-          "description": "Whether to enable or disable certificate verification when connecting to the host's BMC.",
+        "kernel_arguments": {
+          "$ref": "#/definitions/kernel_arguments"
+        },
+        "mirror_registry_configuration": {
+          "x-nullable": true,
+          "$ref": "#/definitions/mirror-registry-configuration-params"
+        },
+        "openshift_version": {
+          "description": "Version of the OS image",
           "type": "string",
-          "default": "Enabled",
-          "enum": [
-            "Enabled",
-            "Disabled"
-          ]
+          "x-nullable": true
         },
-        "password": {
-          "description": "The password to connect to the host's BMC.",
-          "type": "string"
+        "proxy": {
+          "$ref": "#/definitions/proxy"
         },
-        "username": {
-          "description": "The username to connect to the host's BMC.",
-          "type": "string"
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
+        },
+        "rendezvous_ip": {
+          "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
+          "type": "string",
+          "pattern": "^(?:$|(?:(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])|(?:([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:(:[0-9a-fA-F]{1,4}){1,6}|:(:[0-9a-fA-F]{1,4}){1,7}|:|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9]))))$",
+          "x-nullable": true
+        },
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
         }
       }
     },
-    "finalizing-stage": {
-      "description": "Cluster finalizing stage managed by controller",
-      "type": "string",
-      "enum": [
-        "Waiting for cluster operators",
-        "Adding router ca",
-        "Applying olm manifests",
-        "Waiting for olm operators csv initialization",
-        "Waiting for olm operators csv",
-        "Waiting for OLM operator setup jobs",
-        "Done"
-      ]
-    },
-    "free-addresses-list": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "format": "ipv4"
-      }
-    },
-    "free_addresses_request": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-      }
-    },
-    "free_network_addresses": {
+    "infra-env-virtual-media-boot": {
       "type": "object",
       "properties": {
-        "free_addresses": {
+        "bmcs": {
+          "description": "The status of the boot of each BMC.",
           "type": "array",
           "items": {
-            "type": "string",
-            "format": "ipv4"
+            "$ref": "#/definitions/virtual-media-boot-bmc-status"
           }
         },
-        "network": {
+        "started_at": {
+          "description": "The time the virtual media boot was requested.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "format": "date-time"
         }
       }
     },
-    "free_networks_addresses": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/free_network_addresses"
+    "infra-env-virtual-media-boot-params": {
+      "type": "object",
+      "required": [
+        "bmcs"
+      ],
+      "properties": {
+        "bmcs": {
+          "description": "The BMCs of the servers to boot from the discovery ISO. Only Redfish BMCs are supported.",
+          "type": "array",
+          "maxItems": 100,
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/host-bmc-credentials"
+          }
+        }
       }
     },
-    "gpu": {
+    "infra_error": {
       "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
       "properties": {
-        "address": {
-          "description": "Device address (for example \"0000:00:02.0\")",
-          "type": "string"
-        },
-        "device_id": {
-          "description": "ID of the device (for example \"3ea0\")",
-          "type": "string"
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 403,
+          "minimum": 401
         },
-        "name": {
-          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+        "message": {
+          "description": "Human-readable description of the error.",
           "type": "string"
+        }
+      }
+    },
+    "ingress-cert-params": {
+      "type": "string"
+    },
+    "ingress_vip": {
+      "description": "The virtual IP used for cluster ingress traffic.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this VIP is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "vendor": {
-          "description": "The name of the device vendor (for example \"Intel Corporation\")",
-          "type": "string"
+        "ip": {
+          "description": "The IP address.",
+          "$ref": "#/definitions/ip"
         },
-        "vendor_id": {
-          "description": "ID of the vendor (for example \"8086\")",
-          "type": "string"
+        "verification": {
+          "description": "Ingress VIP verification result.",
+          "$ref": "#/definitions/vip_verification"
         }
       }
     },
-    "host": {
+    "install_cmd_request": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "status",
-        "status_info"
+        "cluster_id",
+        "infra_env_id",
+        "host_id",
+        "role",
+        "boot_device",
+        "controller_image",
+        "installer_image"
       ],
       "properties": {
-        "api_vip_connectivity": {
-          "description": "Contains a serialized api_vip_connectivity_response",
+        "boot_device": {
+          "description": "Boot device to write image on",
+          "type": "string"
+        },
+        "check_cvo": {
+          "description": "Check CVO status if needed",
+          "type": "boolean",
+          "default": true
+        },
+        "cluster_id": {
+          "description": "Cluster id",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "uuid"
         },
-        "bootstrap": {
+        "control_plane_count": {
+          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
+          "type": "integer"
+        },
+        "controller_image": {
+          "description": "Assisted installer controller image",
+          "type": "string",
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+        },
+        "coreos_image": {
+          "description": "CoreOS container image to use if installing to the local device",
+          "type": "string"
+        },
+        "disks_to_format": {
+          "description": "List of disks to format",
+          "type": "array",
+          "items": {
+            "description": "Disk to format",
+            "type": "string"
+          }
+        },
+        "enable_skip_mco_reboot": {
+          "description": "If true, assisted service will attempt to skip MCO reboot",
           "type": "boolean"
         },
-        "checked_in_at": {
-          "description": "The last time the host's agent communicated with the service.",
+        "host_id": {
+          "description": "Host id",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "uuid"
         },
-        "cluster_id": {
-          "description": "The cluster that this host is associated with.",
+        "infra_env_id": {
+          "description": "Infra env id",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"foreignkey:Cluster\"",
-          "x-nullable": true
+          "format": "uuid"
         },
-        "connection_timed_out": {
-          "description": "Indicate that connection to assisted service was timed out when soft timeout is enabled.",
-          "type": "boolean"
+        "installer_args": {
+          "description": "Core-os installer addtional args",
+          "type": "string"
         },
-        "connectivity": {
+        "installer_image": {
+          "description": "Assisted installer image",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
-        "created_at": {
+        "mco_image": {
+          "description": "Machine config operator image",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+        },
+        "must_gather_image": {
+          "description": "Must-gather images to use",
+          "type": "string"
+        },
+        "notify_num_reboots": {
+          "description": "If true, notify number of reboots by assisted controller",
+          "type": "boolean"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "service_ips": {
+          "description": "List of service ips",
+          "type": "array",
+          "items": {
+            "description": "Service ip.",
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
           }
         },
-        "deleted_at": {
-          "description": "swagger:ignore",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "gorm.io/gorm"
-            },
-            "type": "DeletedAt"
+        "skip_installation_disk_cleanup": {
+          "description": "Skip formatting installation disk",
+          "type": "boolean"
+        }
+      }
+    },
+    "installer-args-params": {
+      "type": "object",
+      "properties": {
+        "args": {
+          "description": "List of additional arguments passed to coreos-installer",
+          "type": "array",
+          "items": {
+            "type": "string"
           },
-          "x-nullable": false
-        },
-        "discovery_agent_version": {
+          "example": [
+            "--append-karg",
+            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
+            "--save-partindex",
+            "1",
+            "-n"
+          ]
+        }
+      }
+    },
+    "interface": {
+      "type": "object",
+      "properties": {
+        "biosdevname": {
           "type": "string"
         },
-        "disks_info": {
-          "description": "Additional information about disks, formatted as JSON.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "disks_to_be_formatted": {
-          "description": "A comma-separated list of disks that will be formatted once\ninstallation begins, unless otherwise set to be skipped by\nskip_formatting_disks. This means that this list also includes disks\nthat appear in skip_formatting_disks. This property is managed by the\nservice and cannot be modified by the user.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "domain_name_resolutions": {
-          "description": "The domain name resolution result.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "fencing_credentials": {
-          "description": "The host's BMC credentials that will be used in TNF.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "free_addresses": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "href": {
-          "description": "Self link.",
+        "client_id": {
           "type": "string"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "ignition_endpoint_token_set": {
-          "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
+        "has_carrier": {
           "type": "boolean"
         },
-        "images_status": {
-          "description": "Array of image statuses.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "infra_env_id": {
-          "description": "The infra-env that this host is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey;foreignkey:InfraEnvID\""
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "installation_disk_id": {
-          "description": "Contains the inventory disk id to install on.",
+        "mac_address": {
           "type": "string"
         },
-        "installation_disk_path": {
-          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
-          "type": "string",
-          "example": "/dev/sda"
+        "mtu": {
+          "type": "integer"
         },
-        "installer_args": {
+        "name": {
           "type": "string"
         },
-        "installer_version": {
-          "description": "Installer version.",
+        "product": {
           "type": "string"
         },
-        "inventory": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
-          "type": "string",
-          "enum": [
-            "Host",
-            "AddToExistingClusterHost"
-          ]
-        },
-        "logs_collected_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "speed_mbps": {
+          "type": "integer"
         },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "$ref": "#/definitions/logs_state"
+        "sriov_total_vfs": {
+          "description": "The number of SR-IOV virtual functions that the interface supports, 0 if it doesn't support SR-IOV.",
+          "type": "integer"
         },
-        "logs_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "type": {
+          "type": "string"
         },
-        "machine_config_pool_name": {
+        "vendor": {
           "type": "string"
+        }
+      }
+    },
+    "inventory": {
+      "type": "object",
+      "properties": {
+        "bios": {
+          "$ref": "#/definitions/bios"
         },
-        "media_status": {
-          "type": "string",
-          "default": "connected",
-          "enum": [
-            "connected",
-            "disconnected"
-          ],
-          "x-nullable": true
+        "bmc_address": {
+          "type": "string"
         },
-        "node_labels": {
-          "description": "Json containing node's labels.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "bmc_v6address": {
+          "type": "string"
         },
-        "ntp_sources": {
-          "description": "The configured NTP sources on the host.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "boot": {
+          "$ref": "#/definitions/boot"
         },
-        "progress": {
-          "$ref": "#/definitions/host-progress-info"
+        "cpu": {
+          "$ref": "#/definitions/cpu"
         },
-        "progress_stages": {
+        "disks": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host-stage"
-          },
-          "x-go-custom-tag": "gorm:\"-\"",
-          "x-nullable": true
+            "$ref": "#/definitions/disk"
+          }
         },
-        "registered_at": {
-          "description": "The last time the host's agent tried to register in the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "gpus": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/gpu"
+          }
         },
-        "requested_hostname": {
+        "hostname": {
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
-        },
-        "skip_formatting_disks": {
-          "description": "A comma-seperated list of host disks that the service will avoid\nformatting.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "status": {
-          "type": "string",
-          "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "preparing-for-installation",
-            "preparing-failed",
-            "preparing-successful",
-            "pending-for-input",
-            "installing",
-            "installing-in-progress",
-            "installing-pending-user-action",
-            "resetting-pending-user-action",
-            "installed",
-            "error",
-            "resetting",
-            "added-to-existing-cluster",
-            "cancelled",
-            "binding",
-            "unbinding",
-            "unbinding-pending-user-action",
-            "known-unbound",
-            "disconnected-unbound",
-            "insufficient-unbound",
-            "disabled-unbound",
-            "discovering-unbound",
-            "reclaiming",
-            "reclaiming-rebooting"
-          ]
-        },
-        "status_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        },
-        "status_updated_at": {
-          "description": "The last time that the host status was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "suggested_role": {
-          "$ref": "#/definitions/host-role"
-        },
-        "tang_connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/interface"
+          }
         },
-        "timestamp": {
-          "description": "The time on the host as seconds since the Unix epoch.",
-          "type": "integer"
+        "memory": {
+          "$ref": "#/definitions/memory"
         },
-        "updated_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+        "routes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/route"
           }
         },
-        "user_name": {
-          "type": "string"
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
+        "tpm_version": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "enum": [
+            "none",
+            "1.2",
+            "2.0"
+          ]
         }
       }
     },
-    "host-create-params": {
+    "io_perf": {
       "type": "object",
-      "required": [
-        "host_id"
-      ],
       "properties": {
-        "discovery_agent_version": {
-          "type": "string"
-        },
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "sync_duration": {
+          "description": "99th percentile of fsync duration in milliseconds",
+          "type": "integer"
         }
       }
     },
-    "host-ignition-params": {
+    "ip": {
+      "type": "string",
+      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+      "x-go-custom-tag": "gorm:\"primaryKey\""
+    },
+    "iscsi": {
+      "type": "object",
       "properties": {
-        "config": {
+        "host_ip_address": {
+          "description": "Host IP address used to reach iSCSI target",
           "type": "string"
         }
       }
     },
-    "host-list": {
+    "kernel_argument": {
+      "description": "pair of [operation, argument] specifying the argument and what operation should be applied on it.",
+      "type": "object",
+      "properties": {
+        "operation": {
+          "description": "The operation to apply on the kernel argument.",
+          "type": "string",
+          "enum": [
+            "append",
+            "replace",
+            "delete"
+          ]
+        },
+        "value": {
+          "description": "Kernel argument can have the form \u003cparameter\u003e or \u003cparameter\u003e=\u003cvalue\u003e. The following examples should\nbe supported:\nrd.net.timeout.carrier=60\nisolcpus=1,2,10-20,100-2000:2/25\nquiet\nThe parsing by the command line parser in linux kernel is much looser and this pattern follows it.\n",
+          "type": "string",
+          "pattern": "^(?:(?:[^ \\t\\n\\r\"]+)|(?:\"[^\"]*\"))+$"
+        }
+      }
+    },
+    "kernel_arguments": {
+      "description": "List of kernel arugment objects that define the operations and values to be applied.",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/host"
-      }
+        "$ref": "#/definitions/kernel_argument"
+      },
+      "x-omitempty": false
     },
-    "host-progress": {
+    "l2-connectivity": {
       "type": "object",
       "properties": {
-        "current_stage": {
-          "$ref": "#/definitions/host-stage"
+        "outgoing_ip_address": {
+          "type": "string"
         },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "remote_mac": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "host-progress-info": {
+    "l3-connectivity": {
       "type": "object",
       "properties": {
-        "current_stage": {
-          "$ref": "#/definitions/host-stage"
+        "average_rtt_ms": {
+          "description": "Average round trip time in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
         },
-        "installation_percentage": {
-          "type": "integer"
+        "outgoing_nic": {
+          "type": "string"
         },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "packet_loss_percentage": {
+          "description": "Percentage of packets lost during connectivity check.",
+          "type": "number",
+          "format": "double"
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "remote_ip_address": {
+          "type": "string"
         },
-        "stage_timed_out": {
-          "description": "Indicate of the current stage has been timed out.",
+        "successful": {
           "type": "boolean"
+        }
+      }
+    },
+    "last-installation-preparation": {
+      "description": "Gives the status of the last installation preparation (if any)",
+      "type": "object",
+      "properties": {
+        "reason": {
+          "description": "The reason for the preparation status if applicable",
+          "type": "string"
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
+        "status": {
+          "description": "The last installation preparation status",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "default": "not_started",
+          "enum": [
+            "not_started",
+            "failed",
+            "success"
+          ],
+          "x-nullable": false
         }
       },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
-    },
-    "host-role": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "arbiter",
-        "worker",
-        "bootstrap"
-      ]
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:last_installation_preparation_\"",
+      "x-nullable": false
     },
-    "host-role-update-params": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "arbiter",
-        "worker"
-      ]
+    "list-managed-domains": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/managed-domain"
+      }
     },
-    "host-stage": {
-      "type": "string",
-      "enum": [
-        "Starting installation",
-        "Waiting for control plane",
-        "Waiting for bootkube",
-        "Waiting for controller",
-        "Installing",
-        "Writing image to disk",
-        "Copying registry data to disk",
-        "Rebooting",
-        "Waiting for ignition",
-        "Configuring",
-        "Joined",
-        "Done",
-        "Failed"
-      ]
+    "list-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/manifest"
+      }
     },
-    "host-type-hardware-requirements": {
+    "list-versions": {
       "type": "object",
       "properties": {
-        "qualitative": {
-          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "release_tag": {
+          "type": "string"
         },
-        "quantitative": {
-          "description": "Host requirements that can be quantified",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "versions": {
+          "$ref": "#/definitions/versions"
         }
       }
     },
-    "host-type-hardware-requirements-wrapper": {
+    "load_balancer": {
       "type": "object",
       "properties": {
-        "master": {
-          "description": "Requirements towards a master node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
-        },
-        "worker": {
-          "description": "Requirements towards a worker node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
+        "type": {
+          "description": "Indicates if the load balancer will be managed by the cluster or by the user. This is optional and The\ndefault is ` + "`" + `cluster-managed` + "`" + `.\n\n` + "`" + `cluster-managed` + "`" + ` means that the cluster will start the components that assign the API and ingress VIPs to the\nnodes of the cluster automatically.\n\n` + "`" + `user-managed` + "`" + ` means that the user is responsible for configuring an external load balancer and assign the\nAPI and ingress VIPs to it. Note that this configuration needs to be completed before starting the\ninstallation of the cluster, as it is needed during the installation process.\n",
+          "type": "string",
+          "enum": [
+            "cluster-managed",
+            "user-managed"
+          ],
+          "x-go-custom-tag": "gorm:\"not null;check:load_balancer_type in ('cluster-managed', 'user-managed');default:'cluster-managed'\""
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:load_balancer_\""
     },
-    "host-update-params": {
+    "logs-progress-params": {
       "type": "object",
+      "required": [
+        "logs_state"
+      ],
       "properties": {
-        "disks_selected_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk-config-params"
-          },
-          "x-nullable": true
-        },
-        "disks_skip_formatting": {
-          "description": "Allows changing the host's skip_formatting_disks parameter",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk-skip-formatting-params"
-          },
-          "x-nullable": true
-        },
-        "fencing_credentials": {
-          "description": "The host's BMC credentials that will be used in TNF.",
-          "x-nullable": true,
-          "$ref": "#/definitions/fencing-credentials-params"
+        "logs_state": {
+          "description": "The state of collecting logs.",
+          "$ref": "#/definitions/logs_state"
+        }
+      }
+    },
+    "logs_gather_cmd_request": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "infra_env_id",
+        "host_id",
+        "bootstrap",
+        "installer_gather"
+      ],
+      "properties": {
+        "bootstrap": {
+          "description": "Host is bootstrap or not",
+          "type": "boolean"
         },
-        "host_name": {
+        "cluster_id": {
+          "description": "Cluster id",
           "type": "string",
-          "x-nullable": true
+          "format": "uuid"
         },
-        "host_role": {
+        "host_id": {
+          "description": "Host id",
           "type": "string",
-          "enum": [
-            "auto-assign",
-            "master",
-            "arbiter",
-            "worker"
-          ],
-          "x-nullable": true
-        },
-        "ignition_endpoint_http_headers": {
-          "description": "JSON-formatted string of additional HTTP headers when fetching the ignition.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ignition-endpoint-http-headers-params"
-          },
-          "x-nullable": true
+          "format": "uuid"
         },
-        "ignition_endpoint_token": {
-          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
+        "infra_env_id": {
+          "description": "Infra env id",
           "type": "string",
-          "x-nullable": true
+          "format": "uuid"
         },
-        "machine_config_pool_name": {
-          "type": "string",
-          "x-nullable": true
+        "installer_gather": {
+          "description": "Run installer gather logs",
+          "type": "boolean",
+          "default": true
         },
-        "node_labels": {
-          "description": "Labels to be added to the corresponding node.",
+        "master_ips": {
+          "description": "List of master ips",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/node-label-params"
-          },
-          "x-nullable": true
+            "description": "Master ip.",
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
         }
       }
     },
-    "host-validation-id": {
+    "logs_state": {
       "type": "string",
       "enum": [
-        "connected",
-        "media-connected",
-        "has-inventory",
-        "has-min-cpu-cores",
-        "has-min-valid-disks",
-        "has-min-memory",
-        "machine-cidr-defined",
-        "has-cpu-cores-for-role",
-        "has-memory-for-role",
-        "hostname-unique",
-        "hostname-valid",
-        "belongs-to-machine-cidr",
-        "ignition-downloadable",
-        "belongs-to-majority-group",
-        "valid-platform-network-settings",
-        "ntp-synced",
-        "time-synced-between-host-and-service",
-        "container-images-available",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "odf-requirements-satisfied",
-        "lvm-requirements-satisfied",
-        "mce-requirements-satisfied",
-        "mtv-requirements-satisfied",
-        "osc-requirements-satisfied",
-        "sufficient-installation-disk-speed",
-        "cnv-requirements-satisfied",
-        "sufficient-network-latency-requirement-for-role",
-        "sufficient-packet-loss-requirement-for-role",
-        "has-default-route",
-        "api-domain-name-resolved-correctly",
-        "api-int-domain-name-resolved-correctly",
-        "apps-domain-name-resolved-correctly",
-        "release-domain-name-resolved-correctly",
-        "compatible-with-cluster-platform",
-        "dns-wildcard-not-configured",
-        "disk-encryption-requirements-satisfied",
-        "non-overlapping-subnets",
-        "vsphere-disk-uuid-enabled",
-        "compatible-agent",
-        "no-skip-installation-disk",
-        "no-skip-missing-disk",
-        "no-ip-collisions-in-network",
-        "no-iscsi-nic-belongs-to-machine-cidr",
-        "node-feature-discovery-requirements-satisfied",
-        "nvidia-gpu-requirements-satisfied",
-        "pipelines-requirements-satisfied",
-        "servicemesh-requirements-satisfied",
-        "serverless-requirements-satisfied",
-        "openshift-ai-requirements-satisfied",
-        "authorino-requirements-satisfied",
-        "mtu-valid",
-        "nmstate-requirements-satisfied",
-        "amd-gpu-requirements-satisfied",
-        "kmm-requirements-satisfied",
-        "node-healthcheck-requirements-satisfied",
-        "self-node-remediation-requirements-satisfied",
-        "fence-agents-remediation-requirements-satisfied",
-        "node-maintenance-requirements-satisfied",
-        "kube-descheduler-requirements-satisfied",
-        "cluster-observability-requirements-satisfied",
-        "numa-resources-requirements-satisfied",
-        "oadp-requirements-satisfied",
-        "metallb-requirements-satisfied",
-        "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
+        "requested",
+        "collecting",
+        "completed",
+        "timeout",
+        ""
+      ],
+      "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+    },
+    "logs_type": {
+      "type": "string",
+      "enum": [
+        "host",
+        "controller",
+        "all",
+        ""
       ]
     },
-    "host_network": {
-      "type": "object",
-      "properties": {
-        "cidr": {
-          "type": "string"
-        },
-        "host_ids": {
-          "type": "array",
-          "items": {
+    "mac_interface_map": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "logical_nic_name": {
+            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
+            "type": "string"
+          },
+          "mac_address": {
+            "description": "mac address present on the host",
             "type": "string",
-            "format": "uuid"
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
           }
         }
       }
     },
-    "host_registration_response": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/host"
-        },
-        {
-          "properties": {
-            "next_step_runner_command": {
-              "description": "Command for starting the next step runner",
-              "type": "object",
-              "properties": {
-                "args": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "command": {
-                  "type": "string"
-                },
-                "retry_seconds": {
-                  "description": "How long in seconds to wait before retrying registration if the command fails",
-                  "type": "integer"
-                }
-              }
-            }
-          }
-        }
-      ]
-    },
-    "host_static_network_config": {
+    "machine_network": {
+      "description": "A network that all hosts belonging to the cluster should have an interface with IP address in. The VIPs (if exist) belong to this network.",
       "type": "object",
       "properties": {
-        "mac_interface_map": {
-          "description": "mapping of host macs to logical interfaces used in the network yaml",
-          "$ref": "#/definitions/mac_interface_map"
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
         },
-        "network_yaml": {
-          "description": "yaml string that can be processed by nmstate",
-          "type": "string"
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         }
       }
     },
-    "ignition-endpoint": {
-      "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+    "managed-domain": {
       "type": "object",
       "properties": {
-        "ca_certificate": {
-          "description": "base64 encoded CA certficate to be used when contacting the URL via https.",
-          "type": "string",
-          "x-nullable": true
+        "domain": {
+          "type": "string"
         },
-        "url": {
-          "description": "The URL for the ignition endpoint.",
+        "provider": {
           "type": "string",
-          "x-nullable": true
+          "enum": [
+            "route53"
+          ]
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:ignition_endpoint_\""
+      }
     },
-    "ignition-endpoint-http-headers-params": {
+    "manifest": {
       "type": "object",
-      "required": [
-        "key",
-        "value"
-      ],
       "properties": {
-        "key": {
-          "description": "The key for the http header's key-value pair.",
+        "file_name": {
+          "description": "The file name prefaced by the folder that contains it.",
           "type": "string"
         },
-        "value": {
-          "description": "The value for the http header's key-value pair.",
-          "type": "string"
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        },
+        "manifest_source": {
+          "description": "Describes whether manifest is sourced from a user or created by the system.",
+          "type": "string",
+          "enum": [
+            "user",
+            "system"
+          ]
         }
       }
     },
-    "ignored-validations": {
+    "memory": {
       "type": "object",
       "properties": {
-        "cluster-validation-ids": {
-          "description": "JSON-formatted list of cluster validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
-          "type": "string",
-          "format": "string"
+        "physical_bytes": {
+          "type": "integer"
         },
-        "host-validation-ids": {
-          "description": "JSON-formatted list of host validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
-          "type": "string",
-          "format": "string"
+        "physical_bytes_method": {
+          "description": "The method by which the physical memory was set",
+          "$ref": "#/definitions/memory_method"
+        },
+        "usable_bytes": {
+          "type": "integer"
         }
       }
     },
-    "image-create-params": {
+    "memory_method": {
+      "type": "string",
+      "enum": [
+        "dmidecode",
+        "ghw",
+        "meminfo"
+      ]
+    },
+    "mirror-registry-configuration-params": {
+      "description": "Mirror registries that the images of the release are pulled from, instead of the mirror registries\nconfigured for the service.",
       "type": "object",
       "properties": {
-        "image_type": {
-          "description": "Type of image that should be generated.",
-          "$ref": "#/definitions/image_type"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
+        "ca_bundle_crt": {
+          "description": "PEM-encoded X.509 certificate bundle used to trust the mirror registries.",
+          "type": "string",
+          "maxLength": 65535
         },
-        "static_network_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_static_network_config"
-          }
+        "registries_conf": {
+          "description": "Content of a registries.conf file (TOML) that lists the mirrors of the registries. Only the registries\nwith mirrors are taken into account. An empty value removes the mirror registry configuration.",
+          "type": "string",
+          "maxLength": 65535
         }
       }
     },
-    "image_info": {
+    "mirror_registry_check_request": {
       "type": "object",
+      "required": [
+        "images"
+      ],
       "properties": {
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+        "images": {
+          "description": "Images of the release payload, referenced by digest, that must resolve through the mirror registries\nconfigured on the host.\n",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         },
-        "download_url": {
-          "type": "string"
-        },
-        "expires_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "generator_version": {
-          "description": "Image generator version.",
-          "type": "string"
-        },
-        "size_bytes": {
+        "timeout": {
+          "description": "Maximum number of seconds to spend checking the images.",
           "type": "integer"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
-        },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation",
-          "type": "string"
-        },
-        "type": {
-          "$ref": "#/definitions/image_type"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:image_\""
-    },
-    "image_type": {
-      "type": "string",
-      "enum": [
-        "full-iso",
-        "minimal-iso",
-        "disconnected-iso"
-      ]
+      }
     },
-    "import-cluster-params": {
+    "mirror_registry_check_response": {
       "type": "object",
       "required": [
-        "name",
-        "api_vip_dnsname",
-        "openshift_cluster_id"
+        "images"
       ],
       "properties": {
-        "api_vip_dnsname": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string"
-        },
-        "name": {
-          "description": "OpenShift cluster name.",
-          "type": "string"
-        },
-        "openshift_cluster_id": {
-          "description": "The id of the OCP cluster, that hosts will be added to",
-          "type": "string",
-          "format": "uuid"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mirror_registry_image_status"
+          }
         }
       }
     },
-    "incompatibility-reason": {
+    "mirror_registry_image_result": {
+      "description": "Whether the image resolves through the mirror registries. Missing means that the registries reported\nthat the image doesn't exist, failure means that the image couldn't be checked.\n",
       "type": "string",
       "enum": [
-        "cpuArchitecture",
-        "platform",
-        "openshiftVersion",
-        "ociExternalIntegrationDisabled"
+        "available",
+        "missing",
+        "failure"
       ]
     },
-    "infra-env": {
-      "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "name",
-        "type",
-        "updated_at",
-        "created_at"
-      ],
-      "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "additional_trust_bundle": {
-          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
-          "type": "string",
-          "x-nullable": false
-        },
-        "cluster_id": {
-          "description": "If set, all hosts that register will be associated with the specified cluster.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\""
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x"
-          ],
-          "x-nullable": false
-        },
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
-        },
-        "download_url": {
-          "type": "string"
-        },
-        "email_domain": {
-          "type": "string"
-        },
-        "expires_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "generator_version": {
-          "description": "Image generator version.",
-          "type": "string"
-        },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
-        },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ignition_config_override": {
-          "description": "Json formatted string containing the user overrides for the initial ignition config.",
-          "type": "string"
-        },
-        "kernel_arguments": {
-          "description": "JSON formatted string array representing the discovery image kernel arguments.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "x-nullable": true
-        },
-        "kind": {
-          "description": "Indicates the type of this object.",
-          "type": "string",
-          "enum": [
-            "InfraEnv"
-          ]
-        },
-        "name": {
-          "description": "Name of the infra-env.",
-          "type": "string"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
-          "type": "string"
-        },
-        "org_id": {
-          "type": "string"
-        },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
-        },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
-          "type": "boolean"
-        },
-        "rendezvous_ip": {
-          "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
-          "type": "string",
-          "pattern": "^(?:$|(?:(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])|(?:([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:(:[0-9a-fA-F]{1,4}){1,6}|:(:[0-9a-fA-F]{1,4}){1,7}|:|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9]))))$",
-          "x-nullable": true
-        },
-        "size_bytes": {
-          "type": "integer"
-        },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
+    "mirror_registry_image_status": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The error that occurred while checking the image, if the result is failure.",
           "type": "string"
         },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation.",
+        "mirror": {
+          "description": "The mirror the image was resolved through, empty if it couldn't be resolved.",
           "type": "string"
         },
-        "type": {
-          "$ref": "#/definitions/image_type"
+        "name": {
+          "description": "The image reference, as sent in the request.",
+          "type": "string"
         },
-        "updated_at": {
-          "description": "The last time that this infra-env was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+        "result": {
+          "$ref": "#/definitions/mirror_registry_image_result"
+        }
+      }
+    },
+    "monitored-operator": {
+      "type": "object",
+      "properties": {
+        "bundles": {
+          "description": "List of identifier of the bundles associated with the operator. Can be empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
           "x-go-type": {
             "hints": {
               "noValidation": true
             },
             "import": {
-              "package": "time"
+              "package": "github.com/lib/pq"
             },
-            "type": "Time"
+            "type": "StringArray"
           }
         },
-        "user_name": {
-          "type": "string"
-        }
-      }
-    },
-    "infra-env-create-params": {
-      "type": "object",
-      "required": [
-        "name",
-        "pull_secret"
-      ],
-      "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "additional_trust_bundle": {
-          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
-          "type": "string",
-          "maxLength": 65535,
-          "x-nullable": false
-        },
         "cluster_id": {
-          "description": "If set, all hosts that register will be associated with the specified cluster.",
+          "description": "The cluster that this operator is associated with.",
           "type": "string",
           "format": "uuid",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+        "dependency_only": {
+          "description": "Whether the operator can't be installed without being required by another operator.",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "Unique name of the operator.",
           "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x"
-          ],
-          "x-nullable": false
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+        "namespace": {
+          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
           "type": "string"
         },
-        "image_type": {
-          "$ref": "#/definitions/image_type"
+        "operator_type": {
+          "$ref": "#/definitions/operator-type"
         },
-        "kernel_arguments": {
-          "$ref": "#/definitions/kernel_arguments"
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "name": {
-          "description": "Name of the infra-env.",
-          "type": "string"
+        "status": {
+          "$ref": "#/definitions/operator-status"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+        "status_info": {
+          "description": "Detailed information about the operator state.",
           "type": "string"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
+        "status_updated_at": {
+          "description": "Time at which the operator was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+        "subscription_name": {
+          "description": "The name of the subscription of the operator.",
           "type": "string"
         },
-        "rendezvous_ip": {
-          "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
-          "type": "string",
-          "pattern": "^(?:$|(?:(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])|(?:([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:(:[0-9a-fA-F]{1,4}){1,6}|:(:[0-9a-fA-F]{1,4}){1,7}|:|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9]))))$",
-          "x-nullable": true
-        },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string",
-          "x-nullable": true
+        "timeout_seconds": {
+          "description": "Positive number represents a timeout in seconds for the operator to be available.",
+          "type": "integer"
         },
-        "static_network_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_static_network_config"
-          }
+        "version": {
+          "description": "Operator version",
+          "type": "string"
         }
       }
     },
-    "infra-env-list": {
+    "monitored-operators-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/infra-env"
+        "$ref": "#/definitions/monitored-operator"
       }
     },
-    "infra-env-update-params": {
+    "mtu-report": {
       "type": "object",
       "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
+        "mtu_successful": {
+          "type": "boolean"
         },
-        "additional_trust_bundle": {
-          "description": "Allows users to change the additional_trust_bundle infra-env field",
-          "type": "string",
-          "maxLength": 65535,
-          "x-nullable": true
+        "outgoing_nic": {
+          "type": "string"
         },
-        "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+        "remote_ip_address": {
           "type": "string"
+        }
+      }
+    },
+    "network_throughput_check_request": {
+      "type": "object",
+      "required": [
+        "port",
+        "duration",
+        "remote_hosts"
+      ],
+      "properties": {
+        "duration": {
+          "description": "Number of seconds to send traffic to each remote host for.",
+          "type": "integer"
         },
-        "image_type": {
-          "$ref": "#/definitions/image_type"
+        "port": {
+          "description": "The TCP port of the throughput server. The agent starts the server on this port if it isn't running yet,\nso that the other hosts can measure the throughput to this host.\n",
+          "type": "integer"
         },
-        "kernel_arguments": {
-          "$ref": "#/definitions/kernel_arguments"
+        "remote_hosts": {
+          "description": "The hosts to measure the throughput to, one after the other. May be empty.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network_throughput_remote_host"
+          }
+        }
+      }
+    },
+    "network_throughput_check_response": {
+      "type": "object",
+      "required": [
+        "remote_hosts"
+      ],
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network_throughput_remote_host"
+          }
+        }
+      }
+    },
+    "network_throughput_remote_host": {
+      "type": "object",
+      "required": [
+        "host_id",
+        "ip_address"
+      ],
+      "properties": {
+        "error": {
+          "description": "The error that occurred while measuring the throughput, set in the response.",
+          "type": "string"
         },
-        "openshift_version": {
-          "description": "Version of the OS image",
+        "host_id": {
           "type": "string",
-          "x-nullable": true
-        },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
+          "format": "uuid"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+        "ip_address": {
+          "description": "The address of the throughput server of the remote host.",
           "type": "string"
         },
-        "rendezvous_ip": {
-          "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
+        "throughput_mbps": {
+          "description": "The measured throughput in Mbps, set in the response.",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "next_step_cmd_request": {
+      "type": "object",
+      "required": [
+        "infra_env_id",
+        "host_id",
+        "agent_version"
+      ],
+      "properties": {
+        "agent_version": {
+          "description": "Agent image version",
           "type": "string",
-          "pattern": "^(?:$|(?:(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])|(?:([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:(:[0-9a-fA-F]{1,4}){1,6}|:(:[0-9a-fA-F]{1,4}){1,7}|:|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1?[0-9])?[0-9])\\.){3}(25[0-5]|(2[0-4]|1?[0-9])?[0-9]))))$",
-          "x-nullable": true
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
+        "host_id": {
+          "description": "Host id",
           "type": "string",
-          "x-nullable": true
+          "format": "uuid"
+        },
+        "infra_env_id": {
+          "description": "Infra env id",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "node-label-params": {
+      "type": "object",
+      "required": [
+        "key",
+        "value"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key for the label's key-value pair.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value for the label's key-value pair.",
+          "type": "string"
+        }
+      }
+    },
+    "ntp_source": {
+      "type": "object",
+      "properties": {
+        "source_name": {
+          "description": "NTP source name or IP.",
+          "type": "string"
         },
-        "static_network_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_static_network_config"
-          }
+        "source_state": {
+          "description": "Indication of state of an NTP source.",
+          "$ref": "#/definitions/source_state"
         }
       }
     },
-    "infra_error": {
+    "ntp_synchronization_request": {
       "type": "object",
       "required": [
-        "code",
-        "message"
+        "ntp_source"
       ],
       "properties": {
-        "code": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 403,
-          "minimum": 401
-        },
-        "message": {
-          "description": "Human-readable description of the error.",
+        "ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
           "type": "string"
         }
       }
     },
-    "ingress-cert-params": {
-      "type": "string"
-    },
-    "ingress_vip": {
-      "description": "The virtual IP used for cluster ingress traffic.",
-      "type": "object",
+    "ntp_synchronization_response": {
       "properties": {
-        "cluster_id": {
-          "description": "The cluster that this VIP is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ip": {
-          "description": "The IP address.",
-          "$ref": "#/definitions/ip"
-        },
-        "verification": {
-          "description": "Ingress VIP verification result.",
-          "$ref": "#/definitions/vip_verification"
+        "ntp_sources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ntp_source"
+          }
         }
       }
     },
-    "install_cmd_request": {
+    "openshift-version": {
       "type": "object",
       "required": [
-        "cluster_id",
-        "infra_env_id",
-        "host_id",
-        "role",
-        "boot_device",
-        "controller_image",
-        "installer_image"
+        "display_name",
+        "support_level",
+        "cpu_architectures"
       ],
       "properties": {
-        "boot_device": {
-          "description": "Boot device to write image on",
-          "type": "string"
-        },
-        "check_cvo": {
-          "description": "Check CVO status if needed",
-          "type": "boolean",
-          "default": true
-        },
-        "cluster_id": {
-          "description": "Cluster id",
-          "type": "string",
-          "format": "uuid"
-        },
-        "control_plane_count": {
-          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
-          "type": "integer"
-        },
-        "controller_image": {
-          "description": "Assisted installer controller image",
-          "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-        },
-        "coreos_image": {
-          "description": "CoreOS container image to use if installing to the local device",
-          "type": "string"
-        },
-        "disks_to_format": {
-          "description": "List of disks to format",
+        "cpu_architectures": {
+          "description": "Available CPU architectures.",
           "type": "array",
           "items": {
-            "description": "Disk to format",
             "type": "string"
           }
         },
-        "enable_skip_mco_reboot": {
-          "description": "If true, assisted service will attempt to skip MCO reboot",
+        "default": {
+          "description": "Indication that the version is the recommended one.",
           "type": "boolean"
         },
-        "host_id": {
-          "description": "Host id",
-          "type": "string",
-          "format": "uuid"
-        },
-        "infra_env_id": {
-          "description": "Infra env id",
-          "type": "string",
-          "format": "uuid"
-        },
-        "installer_args": {
-          "description": "Core-os installer addtional args",
+        "display_name": {
+          "description": "Name of the version to be presented to the user.",
           "type": "string"
         },
-        "installer_image": {
-          "description": "Assisted installer image",
-          "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-        },
-        "mco_image": {
-          "description": "Machine config operator image",
+        "support_level": {
+          "description": "Level of support of the version.",
           "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+          "enum": [
+            "beta",
+            "production",
+            "maintenance",
+            "end-of-life"
+          ]
+        }
+      }
+    },
+    "openshift-versions": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/openshift-version"
+      }
+    },
+    "operator": {
+      "type": "object",
+      "required": [
+        "feature-support-level-id",
+        "support_level",
+        "incompatibilities",
+        "name",
+        "dependencies"
+      ],
+      "properties": {
+        "dependencies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/feature-support-level-id"
+          }
         },
-        "must_gather_image": {
-          "description": "Must-gather images to use",
-          "type": "string"
+        "feature-support-level-id": {
+          "$ref": "#/definitions/feature-support-level-id"
         },
-        "notify_num_reboots": {
-          "description": "If true, notify number of reboots by assisted controller",
-          "type": "boolean"
+        "incompatibilities": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/feature-support-level-id"
+          }
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "name": {
+          "description": "Name of the operator",
           "type": "string"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
+        "reason": {
+          "$ref": "#/definitions/incompatibility-reason"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
+        "support_level": {
+          "$ref": "#/definitions/support-level"
+        }
+      }
+    },
+    "operator-create-params": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
         },
-        "service_ips": {
-          "description": "List of service ips",
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "operator-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "dependencies": {
+          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
           "type": "array",
           "items": {
-            "description": "Service ip.",
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+            "type": "string"
           }
         },
-        "skip_installation_disk_cleanup": {
-          "description": "Skip formatting installation disk",
-          "type": "boolean"
+        "operator_name": {
+          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "string"
+        },
+        "requirements": {
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
         }
       }
     },
-    "installer-args-params": {
+    "operator-host-requirements": {
       "type": "object",
       "properties": {
-        "args": {
-          "description": "List of additional arguments passed to coreos-installer",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "--append-karg",
-            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
-            "--save-partindex",
-            "1",
-            "-n"
-          ]
+        "operator_name": {
+          "description": "Name of the operator",
+          "type": "string"
+        },
+        "requirements": {
+          "description": "Host requirements for the operator",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "operator-monitor-report": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Unique name of the operator.",
+          "type": "string"
+        },
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
+          "type": "string"
+        },
+        "version": {
+          "description": "operator version.",
+          "type": "string"
         }
       }
     },
-    "interface": {
+    "operator-properties": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/operator-property"
+      }
+    },
+    "operator-property": {
       "type": "object",
       "properties": {
-        "biosdevname": {
-          "type": "string"
+        "data_type": {
+          "description": "Type of the property",
+          "type": "string",
+          "enum": [
+            "boolean",
+            "string",
+            "integer",
+            "float"
+          ]
         },
-        "client_id": {
+        "default_value": {
+          "description": "Default value for the property",
           "type": "string"
         },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "description": {
+          "description": "Description of a property",
+          "type": "string"
         },
-        "has_carrier": {
+        "mandatory": {
+          "description": "Indicates whether the property is reqired",
           "type": "boolean"
         },
-        "ipv4_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "name": {
+          "description": "Name of the property",
+          "type": "string"
         },
-        "ipv6_addresses": {
+        "options": {
+          "description": "Values to select from",
           "type": "array",
           "items": {
             "type": "string"
           }
+        }
+      }
+    },
+    "operator-status": {
+      "description": "Represents the operator state.",
+      "type": "string",
+      "enum": [
+        "failed",
+        "progressing",
+        "available"
+      ]
+    },
+    "operator-type": {
+      "description": "Kind of operator. Different types are monitored by the service differently.",
+      "type": "string",
+      "enum": [
+        "builtin",
+        "olm"
+      ]
+    },
+    "os-image": {
+      "type": "object",
+      "required": [
+        "openshift_version",
+        "cpu_architecture",
+        "url",
+        "version"
+      ],
+      "properties": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
         },
-        "mac_address": {
-          "type": "string"
-        },
-        "mtu": {
-          "type": "integer"
+        "openshift_version": {
+          "description": "Version of the operating system image",
+          "type": "string",
+          "example": "4.12"
         },
-        "name": {
+        "url": {
+          "description": "The base OS image used for the discovery iso.",
           "type": "string"
         },
-        "product": {
+        "version": {
+          "description": "Build ID of the OS image.",
           "type": "string"
-        },
-        "speed_mbps": {
-          "type": "integer"
+        }
+      }
+    },
+    "os-images": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/os-image"
+      }
+    },
+    "platform": {
+      "description": "The configuration for the specific platform upon which to perform the installation.",
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "external": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform_external"
         },
         "type": {
-          "type": "string"
+          "$ref": "#/definitions/platform_type"
         },
-        "vendor": {
-          "type": "string"
+        "vsphere": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform_vsphere"
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:platform_\""
     },
-    "inventory": {
+    "platform_external": {
+      "description": "Configuration used when installing with an external platform type.",
       "type": "object",
       "properties": {
-        "bmc_address": {
-          "type": "string"
+        "cloud_controller_manager": {
+          "description": "When set to external, this property will enable an external cloud provider.",
+          "type": "string",
+          "default": "",
+          "enum": [
+            "",
+            "External"
+          ],
+          "x-nullable": true
         },
-        "bmc_v6address": {
+        "platform_name": {
+          "description": "Holds the arbitrary string representing the infrastructure provider name.",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:external_\""
+    },
+    "platform_type": {
+      "type": "string",
+      "enum": [
+        "baremetal",
+        "nutanix",
+        "vsphere",
+        "none",
+        "external",
+        "openstack"
+      ]
+    },
+    "platform_vsphere": {
+      "description": "The vCenter server and the vSphere objects that the cluster is installed on. When they are set, the service checks\nthem before the installation and writes them to the install config instead of placeholders.",
+      "type": "object",
+      "properties": {
+        "cluster": {
+          "description": "The name of the vSphere cluster that the hosts belong to.",
           "type": "string"
         },
-        "boot": {
-          "$ref": "#/definitions/boot"
-        },
-        "cpu": {
-          "$ref": "#/definitions/cpu"
-        },
-        "disks": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk"
-          }
+        "datacenter": {
+          "description": "The name of the datacenter.",
+          "type": "string"
         },
-        "gpus": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/gpu"
-          }
+        "default_datastore": {
+          "description": "The name of the datastore used for dynamic provisioning of the persistent volumes.",
+          "type": "string"
         },
-        "hostname": {
+        "folder": {
+          "description": "The absolute path of an existing folder for the virtual machines, for example /datacenter/vm/folder. The\ninstaller creates a folder named after the cluster when it isn't set.",
           "type": "string"
         },
-        "interfaces": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/interface"
-          }
+        "network": {
+          "description": "The name of the network that the hosts are connected to.",
+          "type": "string"
         },
-        "memory": {
-          "$ref": "#/definitions/memory"
+        "password": {
+          "description": "The password of the vCenter user. It is stored apart from the cluster and is never returned.",
+          "type": "string",
+          "format": "password",
+          "x-go-custom-tag": "gorm:\"-\" secret:\"true\""
         },
-        "routes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/route"
-          }
+        "password_set": {
+          "description": "True if the password of the vCenter user is set.",
+          "type": "boolean",
+          "readOnly": true
         },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
+        "username": {
+          "description": "The name of the vCenter user.",
+          "type": "string"
         },
-        "tpm_version": {
-          "type": "string",
-          "enum": [
-            "none",
-            "1.2",
-            "2.0"
-          ]
+        "vcenter": {
+          "description": "The fully-qualified hostname or IP address of the vCenter server.",
+          "type": "string"
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:vsphere_\""
     },
-    "io_perf": {
+    "preflight-hardware-requirements": {
       "type": "object",
       "properties": {
-        "sync_duration": {
-          "description": "99th percentile of fsync duration in milliseconds",
-          "type": "integer"
+        "ocp": {
+          "description": "Preflight OCP requirements",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        },
+        "operators": {
+          "description": "Preflight operators hardware requirements",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-hardware-requirements"
+          }
         }
       }
     },
-    "ip": {
-      "type": "string",
-      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-      "x-go-custom-tag": "gorm:\"primaryKey\""
-    },
-    "iscsi": {
+    "presigned-url": {
       "type": "object",
+      "required": [
+        "url"
+      ],
       "properties": {
-        "host_ip_address": {
-          "description": "Host IP address used to reach iSCSI target",
+        "expires_at": {
+          "description": "Expiration time for the URL token.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "url": {
+          "description": "Pre-signed URL for downloading the infra-env discovery image.",
           "type": "string"
         }
       }
     },
-    "kernel_argument": {
-      "description": "pair of [operation, argument] specifying the argument and what operation should be applied on it.",
+    "proxy": {
       "type": "object",
       "properties": {
-        "operation": {
-          "description": "The operation to apply on the kernel argument.",
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
-          "enum": [
-            "append",
-            "replace",
-            "delete"
-          ]
+          "x-go-custom-tag": "secret:\"true\"",
+          "x-nullable": true
         },
-        "value": {
-          "description": "Kernel argument can have the form \u003cparameter\u003e or \u003cparameter\u003e=\u003cvalue\u003e. The following examples should\nbe supported:\nrd.net.timeout.carrier=60\nisolcpus=1,2,10-20,100-2000:2/25\nquiet\nThe parsing by the command line parser in linux kernel is much looser and this pattern follows it.\n",
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
-          "pattern": "^(?:(?:[^ \\t\\n\\r\"]+)|(?:\"[^\"]*\"))+$"
+          "x-go-custom-tag": "gorm:\"column:https_proxy\" secret:\"true\"",
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
         }
-      }
-    },
-    "kernel_arguments": {
-      "description": "List of kernel arugment objects that define the operations and values to be applied.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/kernel_argument"
       },
-      "x-omitempty": false
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:proxy_\""
     },
-    "l2-connectivity": {
+    "quota-usage": {
       "type": "object",
+      "required": [
+        "org_id",
+        "resources"
+      ],
       "properties": {
-        "outgoing_ip_address": {
-          "type": "string"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "remote_ip_address": {
-          "type": "string"
-        },
-        "remote_mac": {
+        "org_id": {
+          "description": "The organization that the usage belongs to.",
           "type": "string"
         },
-        "successful": {
-          "type": "boolean"
+        "resources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resource-quota-usage"
+          }
         }
       }
     },
-    "l3-connectivity": {
+    "reboot_for_reclaim_request": {
+      "description": "Information sent to the agent for rebooting a host into discovery.",
       "type": "object",
+      "required": [
+        "host_fs_mount_dir"
+      ],
       "properties": {
-        "average_rtt_ms": {
-          "description": "Average round trip time in milliseconds.",
-          "type": "number",
-          "format": "double",
-          "x-go-name": "AverageRTTMs"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "packet_loss_percentage": {
-          "description": "Percentage of packets lost during connectivity check.",
-          "type": "number",
-          "format": "double"
-        },
-        "remote_ip_address": {
+        "host_fs_mount_dir": {
+          "description": "The base directory on the host that contains the /boot folder. The host needs to\nchroot into this directory in order to properly reboot.",
           "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
         }
       }
     },
-    "last-installation-preparation": {
-      "description": "Gives the status of the last installation preparation (if any)",
+    "release-channel": {
+      "description": "Release channel.",
+      "type": "string",
+      "enum": [
+        "candidate",
+        "fast",
+        "stable",
+        "eus"
+      ]
+    },
+    "release-image": {
       "type": "object",
+      "required": [
+        "openshift_version",
+        "cpu_architecture",
+        "url",
+        "version"
+      ],
       "properties": {
-        "reason": {
-          "description": "The reason for the preparation status if applicable",
+        "cpu_architecture": {
+          "description": "(DEPRECATED) The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
+        },
+        "cpu_architectures": {
+          "description": "List of CPU architectures provided by the image.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        },
+        "default": {
+          "description": "Indication that the version is the recommended one.",
+          "type": "boolean"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
-        "status": {
-          "description": "The last installation preparation status",
+        "support_level": {
+          "description": "Level of support of the version.",
           "type": "string",
-          "default": "not_started",
           "enum": [
-            "not_started",
-            "failed",
-            "success"
-          ],
-          "x-nullable": false
+            "beta",
+            "production",
+            "maintenance",
+            "end-of-life"
+          ]
+        },
+        "url": {
+          "description": "The installation image of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primarykey\""
+        },
+        "version": {
+          "description": "OCP version from the release metadata.",
+          "type": "string"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:last_installation_preparation_\"",
-      "x-nullable": false
-    },
-    "list-managed-domains": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/managed-domain"
       }
     },
-    "list-manifests": {
+    "release-images": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/manifest"
+        "$ref": "#/definitions/release-image"
       }
     },
-    "list-versions": {
+    "release-source": {
       "type": "object",
+      "required": [
+        "openshift_version",
+        "multi_cpu_architectures",
+        "upgrade_channels"
+      ],
       "properties": {
-        "release_tag": {
-          "type": "string"
+        "multi_cpu_architectures": {
+          "type": "array",
+          "items": {
+            "description": "Supported CPU architecture for multi-architecture releases in this OpenShift version..",
+            "type": "string",
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x"
+            ]
+          }
         },
-        "versions": {
-          "$ref": "#/definitions/versions"
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string",
+          "example": "4.14"
+        },
+        "upgrade_channels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/upgrade-channel"
+          }
         }
       }
     },
-    "load_balancer": {
-      "type": "object",
-      "properties": {
-        "type": {
-          "description": "Indicates if the load balancer will be managed by the cluster or by the user. This is optional and The\ndefault is ` + "`" + `cluster-managed` + "`" + `.\n\n` + "`" + `cluster-managed` + "`" + ` means that the cluster will start the components that assign the API and ingress VIPs to the\nnodes of the cluster automatically.\n\n` + "`" + `user-managed` + "`" + ` means that the user is responsible for configuring an external load balancer and assign the\nAPI and ingress VIPs to it. Note that this configuration needs to be completed before starting the\ninstallation of the cluster, as it is needed during the installation process.\n",
-          "type": "string",
-          "enum": [
-            "cluster-managed",
-            "user-managed"
-          ],
-          "x-go-custom-tag": "gorm:\"not null;check:load_balancer_type in ('cluster-managed', 'user-managed');default:'cluster-managed'\""
-        }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:load_balancer_\""
+    "release-sources": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/release-source"
+      }
     },
-    "logs-progress-params": {
+    "resource-quota-usage": {
       "type": "object",
       "required": [
-        "logs_state"
+        "resource",
+        "used",
+        "limit"
       ],
       "properties": {
-        "logs_state": {
-          "description": "The state of collecting logs.",
-          "$ref": "#/definitions/logs_state"
+        "limit": {
+          "description": "The quota of the resource, 0 if it is unlimited.",
+          "type": "integer"
+        },
+        "resource": {
+          "description": "The limited resource: the clusters that aren't installed yet, the registered hosts, and the cluster\ninstallations and discovery image generations of the last 24 hours.\n",
+          "type": "string",
+          "enum": [
+            "clusters",
+            "hosts",
+            "installs-per-day",
+            "iso-generations-per-day"
+          ]
+        },
+        "used": {
+          "description": "The amount of the resource that the organization uses.",
+          "type": "integer"
         }
       }
     },
-    "logs_gather_cmd_request": {
+    "route": {
       "type": "object",
-      "required": [
-        "cluster_id",
-        "infra_env_id",
-        "host_id",
-        "bootstrap",
-        "installer_gather"
-      ],
       "properties": {
-        "bootstrap": {
-          "description": "Host is bootstrap or not",
-          "type": "boolean"
-        },
-        "cluster_id": {
-          "description": "Cluster id",
-          "type": "string",
-          "format": "uuid"
+        "destination": {
+          "description": "The destination network or destination host",
+          "type": "string"
         },
-        "host_id": {
-          "description": "Host id",
-          "type": "string",
-          "format": "uuid"
+        "family": {
+          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
+          "type": "integer",
+          "format": "int32"
         },
-        "infra_env_id": {
-          "description": "Infra env id",
-          "type": "string",
-          "format": "uuid"
+        "gateway": {
+          "description": "Gateway address where the packets are sent",
+          "type": "string"
         },
-        "installer_gather": {
-          "description": "Run installer gather logs",
-          "type": "boolean",
-          "default": true
+        "interface": {
+          "description": "Interface to which packets for this route will be sent",
+          "type": "string"
         },
-        "master_ips": {
-          "description": "List of master ips",
-          "type": "array",
-          "items": {
-            "description": "Master ip.",
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-          }
+        "metric": {
+          "description": "Route priority metric",
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "logs_state": {
-      "type": "string",
-      "enum": [
-        "requested",
-        "collecting",
-        "completed",
-        "timeout",
-        ""
-      ],
-      "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-    },
-    "logs_type": {
+    "secure-boot-state": {
       "type": "string",
       "enum": [
-        "host",
-        "controller",
-        "all",
-        ""
+        "Unknown",
+        "NotSupported",
+        "Enabled",
+        "Disabled"
       ]
     },
-    "mac_interface_map": {
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "logical_nic_name": {
-            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
-            "type": "string"
-          },
-          "mac_address": {
-            "description": "mac address present on the host",
-            "type": "string",
-            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
-          }
-        }
-      }
-    },
-    "machine_network": {
-      "description": "A network that all hosts belonging to the cluster should have an interface with IP address in. The VIPs (if exist) belong to this network.",
+    "service-account": {
       "type": "object",
+      "required": [
+        "id",
+        "name",
+        "created_at"
+      ],
       "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "cluster_id": {
-          "description": "The cluster that this network is associated with.",
+        "description": {
+          "description": "Description of the service account.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the service account.",
           "type": "string",
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "name": {
+          "description": "Name of the service account, the user name of the requests authenticated by its tokens.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"uniqueIndex\""
         }
       }
     },
-    "managed-domain": {
+    "service-account-create-params": {
       "type": "object",
+      "required": [
+        "name"
+      ],
       "properties": {
-        "domain": {
+        "description": {
+          "description": "Description of the service account.",
           "type": "string"
         },
-        "provider": {
+        "name": {
+          "description": "Name of the service account.",
           "type": "string",
-          "enum": [
-            "route53"
-          ]
+          "maxLength": 63,
+          "pattern": "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
         }
       }
     },
-    "manifest": {
+    "service-account-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/service-account"
+      }
+    },
+    "service-account-token": {
       "type": "object",
+      "required": [
+        "id",
+        "service_account_id",
+        "name",
+        "scope",
+        "created_at"
+      ],
       "properties": {
-        "file_name": {
-          "description": "The file name prefaced by the folder that contains it.",
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "expires_at": {
+          "description": "The time the API token expires, never if it isn't set.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Unique identifier of the API token.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "last_used_at": {
+          "description": "The last time the API token authenticated a request, at a granularity of a minute.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the API token.",
           "type": "string"
         },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+        "revoked_at": {
+          "description": "The time the API token was revoked.",
           "type": "string",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
         },
-        "manifest_source": {
-          "description": "Describes whether manifest is sourced from a user or created by the system.",
+        "scope": {
+          "$ref": "#/definitions/service-account-token-scope"
+        },
+        "service_account_id": {
+          "description": "The service account that the API token was issued to.",
           "type": "string",
-          "enum": [
-            "user",
-            "system"
-          ]
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "token": {
+          "description": "The secret of the API token. It is only returned when the token is issued, and it isn't stored.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"-\""
         }
       }
     },
-    "memory": {
+    "service-account-token-create-params": {
       "type": "object",
+      "required": [
+        "name",
+        "scope"
+      ],
       "properties": {
-        "physical_bytes": {
+        "expires_in_days": {
+          "description": "The number of days until the API token expires, 0 for a token that never expires.",
           "type": "integer"
         },
-        "physical_bytes_method": {
-          "description": "The method by which the physical memory was set",
-          "$ref": "#/definitions/memory_method"
+        "name": {
+          "description": "Name of the API token.",
+          "type": "string",
+          "maxLength": 256
         },
-        "usable_bytes": {
-          "type": "integer"
+        "scope": {
+          "$ref": "#/definitions/service-account-token-scope"
         }
       }
     },
-    "memory_method": {
+    "service-account-token-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/service-account-token"
+      }
+    },
+    "service-account-token-scope": {
+      "description": "The permissions of an API token. read-only tokens can only retrieve resources, cluster-admin tokens can manage\nall the resources, including the service accounts.\n",
       "type": "string",
       "enum": [
-        "dmidecode",
-        "ghw",
-        "meminfo"
+        "read-only",
+        "cluster-admin"
       ]
     },
-    "monitored-operator": {
+    "service_network": {
+      "description": "IP address block for service IP blocks.",
       "type": "object",
       "properties": {
-        "bundles": {
-          "description": "List of identifier of the bundles associated with the operator. Can be empty.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "x-go-custom-tag": "gorm:\"type:text[]\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "github.com/lib/pq"
-            },
-            "type": "StringArray"
-          }
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
         },
         "cluster_id": {
-          "description": "The cluster that this operator is associated with.",
+          "description": "A network to use for service IP addresses. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "dependency_only": {
-          "description": "Whether the operator can't be installed without being required by another operator.",
-          "type": "boolean"
-        },
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "namespace": {
-          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
-          "type": "string"
-        },
-        "operator_type": {
-          "$ref": "#/definitions/operator-type"
-        },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
-        },
-        "status_updated_at": {
-          "description": "Time at which the operator was last updated.",
+        }
+      }
+    },
+    "source_state": {
+      "type": "string",
+      "enum": [
+        "synced",
+        "combined",
+        "not_combined",
+        "error",
+        "variable",
+        "unreachable"
+      ]
+    },
+    "static-network-config-bond": {
+      "type": "object",
+      "required": [
+        "name",
+        "ports"
+      ],
+      "properties": {
+        "mode": {
+          "description": "Bonding mode of the bond.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "subscription_name": {
-          "description": "The name of the subscription of the operator.",
-          "type": "string"
+          "default": "active-backup",
+          "enum": [
+            "balance-rr",
+            "active-backup",
+            "balance-xor",
+            "broadcast",
+            "802.3ad",
+            "balance-tlb",
+            "balance-alb"
+          ]
         },
-        "timeout_seconds": {
-          "description": "Positive number represents a timeout in seconds for the operator to be available.",
-          "type": "integer"
+        "name": {
+          "description": "Name of the bond interface.",
+          "type": "string",
+          "pattern": "^bond[0-9]+$"
         },
-        "version": {
-          "description": "Operator version",
-          "type": "string"
+        "ports": {
+          "description": "Names of the physical interfaces of the bond, as discovered in the host inventory.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "monitored-operators-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/monitored-operator"
-      }
-    },
-    "mtu-report": {
+    "static-network-config-file": {
       "type": "object",
       "properties": {
-        "mtu_successful": {
-          "type": "boolean"
-        },
-        "outgoing_nic": {
+        "contents": {
+          "description": "The contents of the file.",
           "type": "string"
         },
-        "remote_ip_address": {
+        "path": {
+          "description": "The path of the file, relative to the directory of the host.",
           "type": "string"
         }
       }
     },
-    "next_step_cmd_request": {
+    "static-network-config-host-validation": {
       "type": "object",
       "required": [
-        "infra_env_id",
-        "host_id",
-        "agent_version"
+        "host_index",
+        "valid"
       ],
       "properties": {
-        "agent_version": {
-          "description": "Agent image version",
-          "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+        "errors": {
+          "description": "The errors found in the configuration of the host.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-validation-error"
+          }
         },
-        "host_id": {
-          "description": "Host id",
-          "type": "string",
-          "format": "uuid"
+        "host_index": {
+          "description": "The index of the host in the static network configuration.",
+          "type": "integer"
         },
-        "infra_env_id": {
-          "description": "Infra env id",
-          "type": "string",
-          "format": "uuid"
+        "nmconnection_files": {
+          "description": "The NetworkManager keyfiles generated by nmstate for the host, when its configuration is valid.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-file"
+          }
+        },
+        "valid": {
+          "description": "Whether the configuration of the host is valid.",
+          "type": "boolean"
         }
       }
     },
-    "node-label-params": {
+    "static-network-config-validation": {
       "type": "object",
       "required": [
-        "key",
-        "value"
+        "valid",
+        "hosts"
       ],
       "properties": {
-        "key": {
-          "description": "The key for the label's key-value pair.",
-          "type": "string"
+        "hosts": {
+          "description": "The validation of each host, in the order of the static network configuration.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-host-validation"
+          }
         },
-        "value": {
-          "description": "The value for the label's key-value pair.",
-          "type": "string"
+        "valid": {
+          "description": "Whether the configuration of all the hosts is valid.",
+          "type": "boolean"
         }
       }
     },
-    "ntp_source": {
+    "static-network-config-validation-error": {
       "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
       "properties": {
-        "source_name": {
-          "description": "NTP source name or IP.",
+        "code": {
+          "description": "The kind of the error.",
+          "type": "string",
+          "enum": [
+            "invalid-yaml",
+            "missing-mac-mapping",
+            "duplicate-mac-mapping",
+            "duplicate-ip",
+            "ip-outside-subnet",
+            "invalid-route",
+            "nmstate-error"
+          ]
+        },
+        "interface": {
+          "description": "The interface of the error, empty for errors of the whole host.",
           "type": "string"
         },
-        "source_state": {
-          "description": "Indication of state of an NTP source.",
-          "$ref": "#/definitions/source_state"
+        "message": {
+          "description": "The description of the error.",
+          "type": "string"
         }
       }
     },
-    "ntp_synchronization_request": {
+    "static-network-config-validation-params": {
       "type": "object",
       "required": [
-        "ntp_source"
+        "static_network_config"
       ],
       "properties": {
-        "ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        }
-      }
-    },
-    "ntp_synchronization_response": {
-      "properties": {
-        "ntp_sources": {
+        "expected_subnets": {
+          "description": "The subnets the static addresses of the hosts are expected in, usually the machine networks of the cluster. The addresses of a family without expected subnets aren't checked.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/ntp_source"
+            "$ref": "#/definitions/subnet"
+          }
+        },
+        "static_network_config": {
+          "description": "The static network configuration of the hosts, as set in the infra-env.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
           }
         }
       }
     },
-    "openshift-version": {
+    "static-network-config-vlan": {
       "type": "object",
       "required": [
-        "display_name",
-        "support_level",
-        "cpu_architectures"
+        "base_interface",
+        "id"
       ],
       "properties": {
-        "cpu_architectures": {
-          "description": "Available CPU architectures.",
+        "base_interface": {
+          "description": "Name of the physical interface or the bond that carries the VLAN.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The VLAN ID.",
+          "type": "integer",
+          "maximum": 4094,
+          "minimum": 1
+        }
+      }
+    },
+    "step": {
+      "type": "object",
+      "properties": {
+        "args": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
-        },
-        "display_name": {
-          "description": "Name of the version to be presented to the user.",
+        "step_id": {
           "type": "string"
         },
-        "support_level": {
-          "description": "Level of support of the version.",
-          "type": "string",
-          "enum": [
-            "beta",
-            "production",
-            "maintenance",
-            "end-of-life"
-          ]
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "openshift-versions": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/openshift-version"
-      }
-    },
-    "operator": {
+    "step-reply": {
       "type": "object",
-      "required": [
-        "feature-support-level-id",
-        "support_level",
-        "incompatibilities",
-        "name",
-        "dependencies"
-      ],
       "properties": {
-        "dependencies": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/feature-support-level-id"
-          }
-        },
-        "feature-support-level-id": {
-          "$ref": "#/definitions/feature-support-level-id"
+        "error": {
+          "type": "string"
         },
-        "incompatibilities": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/feature-support-level-id"
-          }
+        "exit_code": {
+          "type": "integer"
         },
-        "name": {
-          "description": "Name of the operator",
+        "output": {
           "type": "string"
         },
-        "reason": {
-          "$ref": "#/definitions/incompatibility-reason"
+        "step_id": {
+          "type": "string"
         },
-        "support_level": {
-          "$ref": "#/definitions/support-level"
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "operator-create-params": {
+    "step-type": {
+      "type": "string",
+      "enum": [
+        "connectivity-check",
+        "execute",
+        "inventory",
+        "install",
+        "free-network-addresses",
+        "dhcp-lease-allocate",
+        "api-vip-connectivity-check",
+        "tang-connectivity-check",
+        "ntp-synchronizer",
+        "installation-disk-speed-check",
+        "container-image-availability",
+        "domain-resolution",
+        "stop-installation",
+        "logs-gather",
+        "next-step-runner",
+        "upgrade-agent",
+        "download-boot-artifacts",
+        "reboot-for-reclaim",
+        "verify-vips",
+        "mirror-registry-check",
+        "network-throughput-check"
+      ]
+    },
+    "steps": {
       "type": "object",
       "properties": {
-        "name": {
-          "type": "string"
+        "instructions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/step"
+          }
         },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
+        "next_instruction_seconds": {
+          "type": "integer"
+        },
+        "post_step_action": {
+          "description": "What to do after finishing to run step instructions",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "default": "continue",
+          "enum": [
+            "exit",
+            "continue"
+          ]
         }
       }
     },
-    "operator-hardware-requirements": {
+    "steps-reply": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/step-reply"
+      }
+    },
+    "subnet": {
+      "type": "string",
+      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+      "x-go-custom-tag": "gorm:\"primaryKey\""
+    },
+    "support-level": {
+      "type": "string",
+      "enum": [
+        "supported",
+        "unsupported",
+        "tech-preview",
+        "dev-preview",
+        "unavailable"
+      ],
+      "x-nullable": false
+    },
+    "support-levels": {
+      "description": "Map of feature ID or CPU architecture alongside their support level",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/support-level"
+      }
+    },
+    "system_vendor": {
       "type": "object",
       "properties": {
-        "dependencies": {
-          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "manufacturer": {
+          "type": "string"
         },
-        "operator_name": {
-          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+        "product_name": {
           "type": "string"
         },
-        "requirements": {
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        "serial_number": {
+          "type": "string"
+        },
+        "virtual": {
+          "description": "Whether the machine appears to be a virtual machine or not",
+          "type": "boolean"
         }
       }
     },
-    "operator-host-requirements": {
+    "tang_connectivity_request": {
       "type": "object",
+      "required": [
+        "tang_servers"
+      ],
       "properties": {
-        "operator_name": {
-          "description": "Name of the operator",
+        "tang_servers": {
+          "description": "JSON-formatted string containing additional information regarding tang's configuration",
           "type": "string"
-        },
-        "requirements": {
-          "description": "Host requirements for the operator",
-          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "operator-monitor-report": {
+    "tang_connectivity_response": {
       "type": "object",
       "properties": {
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string"
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
+        "is_success": {
+          "description": "Tang check result.",
+          "type": "boolean"
         },
-        "version": {
-          "description": "operator version.",
-          "type": "string"
+        "tang_server_response": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "payload": {
+                "description": "Tang response payload.",
+                "type": "string"
+              },
+              "signatures": {
+                "type": "array",
+                "items": {
+                  "type": "object",
+                  "properties": {
+                    "protected": {
+                      "type": "string"
+                    },
+                    "signature": {
+                      "type": "string"
+                    }
+                  },
+                  "x-go-name": "TangServerSignatures"
+                }
+              },
+              "tang_url": {
+                "description": "Tang URL.",
+                "type": "string"
+              }
+            },
+            "x-go-name": "TangServerResponse"
+          }
         }
       }
     },
-    "operator-properties": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/operator-property"
-      }
-    },
-    "operator-property": {
+    "update-manifest-params": {
       "type": "object",
+      "required": [
+        "folder",
+        "file_name"
+      ],
       "properties": {
-        "data_type": {
-          "description": "Type of the property",
+        "file_name": {
+          "description": "The file name for the manifest to modify.",
           "type": "string",
-          "enum": [
-            "boolean",
-            "string",
-            "integer",
-            "float"
-          ]
-        },
-        "default_value": {
-          "description": "Default value for the property",
-          "type": "string"
+          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
+          "x-nullable": false
         },
-        "description": {
-          "description": "Description of a property",
-          "type": "string"
+        "folder": {
+          "description": "The folder for the manifest to modify.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ],
+          "x-nullable": false
         },
-        "mandatory": {
-          "description": "Indicates whether the property is reqired",
-          "type": "boolean"
+        "updated_content": {
+          "description": "The new base64 encoded manifest content.",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
+          "x-nullable": true
         },
-        "name": {
-          "description": "Name of the property",
-          "type": "string"
+        "updated_file_name": {
+          "description": "The new file name for the manifest.",
+          "type": "string",
+          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
+          "x-nullable": true
         },
-        "options": {
-          "description": "Values to select from",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "updated_folder": {
+          "description": "The new folder for the manifest. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ],
+          "x-nullable": true
         }
       }
     },
-    "operator-status": {
-      "description": "Represents the operator state.",
-      "type": "string",
-      "enum": [
-        "failed",
-        "progressing",
-        "available"
-      ]
-    },
-    "operator-type": {
-      "description": "Kind of operator. Different types are monitored by the service differently.",
-      "type": "string",
-      "enum": [
-        "builtin",
-        "olm"
-      ]
-    },
-    "os-image": {
+    "upgrade-channel": {
       "type": "object",
       "required": [
-        "openshift_version",
         "cpu_architecture",
-        "url",
-        "version"
+        "channels"
       ],
       "properties": {
+        "channels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/release-channel"
+          }
+        },
         "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "description": "The CPU architecture of the image.",
           "type": "string",
-          "default": "x86_64",
           "enum": [
             "x86_64",
             "aarch64",
             "arm64",
             "ppc64le",
-            "s390x"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
-        },
-        "openshift_version": {
-          "description": "Version of the operating system image",
-          "type": "string",
-          "example": "4.12"
-        },
-        "url": {
-          "description": "The base OS image used for the discovery iso.",
-          "type": "string"
-        },
-        "version": {
-          "description": "Build ID of the OS image.",
-          "type": "string"
+            "s390x",
+            "multi"
+          ]
         }
       }
     },
-    "os-images": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/os-image"
-      }
-    },
-    "platform": {
-      "description": "The configuration for the specific platform upon which to perform the installation.",
+    "upgrade_agent_request": {
       "type": "object",
-      "required": [
-        "type"
-      ],
       "properties": {
-        "external": {
-          "x-nullable": true,
-          "$ref": "#/definitions/platform_external"
-        },
-        "type": {
-          "$ref": "#/definitions/platform_type"
+        "agent_image": {
+          "description": "Full image reference of the image that the agent should upgrade to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
+          "type": "string"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:platform_\""
+      }
     },
-    "platform_external": {
-      "description": "Configuration used when installing with an external platform type.",
+    "upgrade_agent_response": {
       "type": "object",
       "properties": {
-        "cloud_controller_manager": {
-          "description": "When set to external, this property will enable an external cloud provider.",
-          "type": "string",
-          "default": "",
-          "enum": [
-            "",
-            "External"
-          ],
-          "x-nullable": true
+        "agent_image": {
+          "description": "Full image reference of the image that the agent has upgraded to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
+          "type": "string"
         },
-        "platform_name": {
-          "description": "Holds the arbitrary string representing the infrastructure provider name.",
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": true
+        "result": {
+          "$ref": "#/definitions/upgrade_agent_result"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:external_\""
+      }
     },
-    "platform_type": {
+    "upgrade_agent_result": {
+      "description": "Agent upgrade result.",
       "type": "string",
       "enum": [
-        "baremetal",
-        "nutanix",
-        "vsphere",
-        "none",
-        "external"
+        "success",
+        "failure"
       ]
     },
-    "preflight-hardware-requirements": {
+    "usage": {
       "type": "object",
       "properties": {
-        "ocp": {
-          "description": "Preflight OCP requirements",
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
-        },
-        "operators": {
-          "description": "Preflight operators hardware requirements",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-hardware-requirements"
+        "data": {
+          "description": "additional properties of the feature",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
           }
-        }
-      }
-    },
-    "presigned-url": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "expires_at": {
-          "description": "Expiration time for the URL token.",
-          "type": "string",
-          "format": "date-time"
         },
-        "url": {
-          "description": "Pre-signed URL for downloading the infra-env discovery image.",
+        "id": {
+          "description": "Unique idenftifier of the feature",
+          "type": "string"
+        },
+        "name": {
+          "description": "name of the feature to track",
           "type": "string"
         }
       }
     },
-    "proxy": {
+    "v2-cluster-update-params": {
       "type": "object",
       "properties": {
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
           "type": "string",
           "x-nullable": true
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\"",
           "x-nullable": true
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          },
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
           "type": "string",
           "x-nullable": true
-        }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:proxy_\""
-    },
-    "reboot_for_reclaim_request": {
-      "description": "Information sent to the agent for rebooting a host into discovery.",
-      "type": "object",
-      "required": [
-        "host_fs_mount_dir"
-      ],
-      "properties": {
-        "host_fs_mount_dir": {
-          "description": "The base directory on the host that contains the /boot folder. The host needs to\nchroot into this directory in order to properly reboot.",
-          "type": "string"
-        }
-      }
-    },
-    "release-channel": {
-      "description": "Release channel.",
-      "type": "string",
-      "enum": [
-        "candidate",
-        "fast",
-        "stable",
-        "eus"
-      ]
-    },
-    "release-image": {
-      "type": "object",
-      "required": [
-        "openshift_version",
-        "cpu_architecture",
-        "url",
-        "version"
-      ],
-      "properties": {
-        "cpu_architecture": {
-          "description": "(DEPRECATED) The CPU architecture of the image (x86_64/arm64/etc).",
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
         },
-        "cpu_architectures": {
-          "description": "List of CPU architectures provided by the image.",
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/cluster_network"
           },
-          "x-go-custom-tag": "gorm:\"type:text[]\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "github.com/lib/pq"
-            },
-            "type": "StringArray"
-          }
+          "x-nullable": true
         },
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
+        "control_plane_count": {
+          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
+          "type": "integer",
+          "x-nullable": true
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+        "disk_encryption": {
+          "description": "Installation disks encryption mode and host roles to be applied.",
+          "$ref": "#/definitions/disk-encryption"
         },
-        "support_level": {
-          "description": "Level of support of the version.",
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
           "type": "string",
           "enum": [
-            "beta",
-            "production",
-            "maintenance",
-            "end-of-life"
-          ]
+            "none",
+            "masters",
+            "arbiters",
+            "workers",
+            "masters,arbiters",
+            "masters,workers",
+            "arbiters,workers",
+            "masters,arbiters,workers",
+            "all"
+          ],
+          "x-nullable": true
         },
-        "url": {
-          "description": "The installation image of the OpenShift cluster.",
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          },
+          "x-nullable": true
+        },
+        "load_balancer": {
+          "$ref": "#/definitions/load_balancer"
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"primarykey\""
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
         },
-        "version": {
-          "description": "OCP version from the release metadata.",
-          "type": "string"
-        }
-      }
-    },
-    "release-images": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/release-image"
-      }
-    },
-    "release-source": {
-      "type": "object",
-      "required": [
-        "openshift_version",
-        "multi_cpu_architectures",
-        "upgrade_channels"
-      ],
-      "properties": {
-        "multi_cpu_architectures": {
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
           "type": "array",
           "items": {
-            "description": "Supported CPU architecture for multi-architecture releases in this OpenShift version..",
-            "type": "string",
-            "enum": [
-              "x86_64",
-              "aarch64",
-              "arm64",
-              "ppc64le",
-              "s390x"
-            ]
-          }
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-nullable": true
+        },
+        "mirror_registry_configuration": {
+          "x-nullable": true,
+          "$ref": "#/definitions/mirror-registry-configuration-params"
+        },
+        "name": {
+          "description": "OpenShift cluster name.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
           "type": "string",
-          "example": "4.14"
+          "x-nullable": true
         },
-        "upgrade_channels": {
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/upgrade-channel"
+            "$ref": "#/definitions/operator-create-params"
           }
-        }
-      }
-    },
-    "release-sources": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/release-source"
-      }
-    },
-    "route": {
-      "type": "object",
-      "properties": {
-        "destination": {
-          "description": "The destination network or destination host",
-          "type": "string"
-        },
-        "family": {
-          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
-          "type": "integer",
-          "format": "int32"
         },
-        "gateway": {
-          "description": "Gateway address where the packets are sent",
-          "type": "string"
+        "platform": {
+          "$ref": "#/definitions/platform"
         },
-        "interface": {
-          "description": "Interface to which packets for this route will be sent",
-          "type": "string"
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
+          "x-nullable": true
         },
-        "metric": {
-          "description": "Route priority metric",
-          "type": "integer",
-          "format": "int32"
-        }
-      }
-    },
-    "secure-boot-state": {
-      "type": "string",
-      "enum": [
-        "Unknown",
-        "NotSupported",
-        "Enabled",
-        "Disabled"
-      ]
-    },
-    "service_network": {
-      "description": "IP address block for service IP blocks.",
-      "type": "object",
-      "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
         },
-        "cluster_id": {
-          "description": "A network to use for service IP addresses. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        }
-      }
-    },
-    "source_state": {
-      "type": "string",
-      "enum": [
-        "synced",
-        "combined",
-        "not_combined",
-        "error",
-        "variable",
-        "unreachable"
-      ]
-    },
-    "step": {
-      "type": "object",
-      "properties": {
-        "args": {
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
           "type": "array",
           "items": {
-            "type": "string"
-          }
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
         },
-        "step_id": {
-          "type": "string"
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string",
+          "x-nullable": true
         },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
         }
       }
     },
-    "step-reply": {
+    "verified_vip": {
+      "description": "Single VIP verification result.",
       "type": "object",
       "properties": {
-        "error": {
-          "type": "string"
-        },
-        "exit_code": {
-          "type": "integer"
-        },
-        "output": {
-          "type": "string"
+        "verification": {
+          "$ref": "#/definitions/vip_verification"
         },
-        "step_id": {
-          "type": "string"
+        "vip": {
+          "$ref": "#/definitions/ip"
         },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
+        "vip_type": {
+          "$ref": "#/definitions/vip_type"
         }
       }
     },
-    "step-type": {
-      "type": "string",
-      "enum": [
-        "connectivity-check",
-        "execute",
-        "inventory",
-        "install",
-        "free-network-addresses",
-        "dhcp-lease-allocate",
-        "api-vip-connectivity-check",
-        "tang-connectivity-check",
-        "ntp-synchronizer",
-        "installation-disk-speed-check",
-        "container-image-availability",
-        "domain-resolution",
-        "stop-installation",
-        "logs-gather",
-        "next-step-runner",
-        "upgrade-agent",
-        "download-boot-artifacts",
-        "reboot-for-reclaim",
-        "verify-vips"
-      ]
-    },
-    "steps": {
+    "verify_vip": {
+      "description": "Request to verify single vip.",
       "type": "object",
       "properties": {
-        "instructions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/step"
-          }
-        },
-        "next_instruction_seconds": {
-          "type": "integer"
+        "vip": {
+          "$ref": "#/definitions/ip"
         },
-        "post_step_action": {
-          "description": "What to do after finishing to run step instructions",
-          "type": "string",
-          "default": "continue",
-          "enum": [
-            "exit",
-            "continue"
-          ]
+        "vip_type": {
+          "$ref": "#/definitions/vip_type"
         }
       }
     },
-    "steps-reply": {
+    "verify_vips_request": {
+      "description": "list of vips to be verified.",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/step-reply"
+        "$ref": "#/definitions/verify_vip"
       }
     },
-    "subnet": {
-      "type": "string",
-      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-      "x-go-custom-tag": "gorm:\"primaryKey\""
+    "verify_vips_response": {
+      "description": "list of verified vips.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/verified_vip"
+      }
     },
-    "support-level": {
-      "type": "string",
-      "enum": [
-        "supported",
-        "unsupported",
-        "tech-preview",
-        "dev-preview",
-        "unavailable"
-      ],
-      "x-nullable": false
+    "versioned-host-requirements": {
+      "type": "object",
+      "properties": {
+        "arbiter": {
+          "description": "Arbiter node requirements",
+          "x-go-name": "ArbiterRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "edge-worker": {
+          "description": "Edge Worker OpenShift node requirements",
+          "x-go-name": "EdgeWorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "master": {
+          "description": "Master node requirements",
+          "x-go-name": "MasterRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "sno": {
+          "description": "Single node OpenShift node requirements",
+          "x-go-name": "SNORequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "version": {
+          "description": "Version of the component for which requirements are defined",
+          "type": "string"
+        },
+        "worker": {
+          "description": "Worker node requirements",
+          "x-go-name": "WorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
     },
-    "support-levels": {
-      "description": "Map of feature ID or CPU architecture alongside their support level",
+    "versions": {
       "type": "object",
       "additionalProperties": {
-        "$ref": "#/definitions/support-level"
+        "type": "string"
       }
     },
-    "system_vendor": {
+    "vip_type": {
+      "description": "The vip type.",
+      "type": "string",
+      "enum": [
+        "api",
+        "ingress"
+      ]
+    },
+    "vip_verification": {
+      "description": "vip verification result.",
+      "type": "string",
+      "default": "unverified",
+      "enum": [
+        "unverified",
+        "failed",
+        "succeeded"
+      ]
+    },
+    "virtual-media-boot-bmc-status": {
       "type": "object",
       "properties": {
-        "manufacturer": {
+        "address": {
+          "description": "The address of the BMC.",
           "type": "string"
         },
-        "product_name": {
-          "type": "string"
+        "status": {
+          "type": "string",
+          "enum": [
+            "in-progress",
+            "succeeded",
+            "failed"
+          ]
         },
-        "serial_number": {
+        "status_info": {
+          "description": "The error of a failed boot.",
           "type": "string"
         },
-        "virtual": {
-          "description": "Whether the machine appears to be a virtual machine or not",
-          "type": "boolean"
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
         }
       }
+    }
+  },
+  "securityDefinitions": {
+    "agentAuth": {
+      "type": "apiKey",
+      "name": "X-Secret-Key",
+      "in": "header"
     },
-    "tang_connectivity_request": {
-      "type": "object",
-      "required": [
-        "tang_servers"
-      ],
-      "properties": {
-        "tang_servers": {
-          "description": "JSON-formatted string containing additional information regarding tang's configuration",
-          "type": "string"
-        }
-      }
+    "imageAuth": {
+      "type": "apiKey",
+      "name": "Image-Token",
+      "in": "header"
     },
-    "tang_connectivity_response": {
-      "type": "object",
-      "properties": {
-        "is_success": {
-          "description": "Tang check result.",
-          "type": "boolean"
-        },
-        "tang_server_response": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "payload": {
-                "description": "Tang response payload.",
-                "type": "string"
-              },
-              "signatures": {
-                "type": "array",
-                "items": {
-                  "type": "object",
-                  "properties": {
-                    "protected": {
-                      "type": "string"
-                    },
-                    "signature": {
-                      "type": "string"
-                    }
-                  },
-                  "x-go-name": "TangServerSignatures"
-                }
-              },
-              "tang_url": {
-                "description": "Tang URL.",
-                "type": "string"
-              }
-            },
-            "x-go-name": "TangServerResponse"
+    "imageURLAuth": {
+      "type": "apiKey",
+      "name": "image_token",
+      "in": "query"
+    },
+    "urlAuth": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
+    },
+    "userAuth": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    },
+    "watcherAuth": {
+      "type": "apiKey",
+      "name": "Watcher-Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "userAuth": [
+        "admin",
+        "user"
+      ]
+    }
+  ],
+  "tags": [
+    {
+      "description": "Agent-driven installation",
+      "name": "Assisted installation"
+    },
+    {
+      "description": "Audit log of the changes made through the API.",
+      "name": "audit"
+    },
+    {
+      "description": "Events related to a cluster installation.",
+      "name": "events"
+    },
+    {
+      "description": "General OpenShift cluster installation APIs.",
+      "name": "installer"
+    },
+    {
+      "description": "Managed dns domains for a cluster installation.",
+      "name": "managed_domains"
+    },
+    {
+      "description": "Manifests for customizing a cluster installation.",
+      "name": "manifests"
+    },
+    {
+      "description": "Information regarding supported operators.",
+      "name": "operators"
+    },
+    {
+      "description": "Service accounts and their API tokens, for local authentication.",
+      "name": "service_accounts"
+    },
+    {
+      "description": "Information regarding versions.",
+      "name": "versions"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Assisted installation",
+    "title": "AssistedInstall",
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "1.0.0"
+  },
+  "host": "api.openshift.com",
+  "basePath": "/api/assisted-install",
+  "paths": {
+    "/v2/audit-events": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Retrieves the audit log of the API requests that changed resources, the most recent first.",
+        "tags": [
+          "audit"
+        ],
+        "operationId": "v2ListAuditEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only the requests received at or after this time are retrieved.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only the requests received before this time are retrieved.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only the requests of this user are retrieved.",
+            "name": "username",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only the requests of the users of this organization are retrieved.",
+            "name": "org_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only the requests on this resource are retrieved.",
+            "name": "resource_id",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Number of records to skip before starting to return the records.",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/audit-event-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "update-manifest-params": {
-      "type": "object",
-      "required": [
-        "folder",
-        "file_name"
-      ],
-      "properties": {
-        "file_name": {
-          "description": "The file name for the manifest to modify.",
-          "type": "string",
-          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
-          "x-nullable": false
-        },
-        "folder": {
-          "description": "The folder for the manifest to modify.",
-          "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ],
-          "x-nullable": false
-        },
-        "updated_content": {
-          "description": "The new base64 encoded manifest content.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "updated_file_name": {
-          "description": "The new file name for the manifest.",
-          "type": "string",
-          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
-          "x-nullable": true
-        },
-        "updated_folder": {
-          "description": "The new folder for the manifest. Manifests can be placed in 'manifests' or 'openshift' directories.",
-          "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ],
-          "x-nullable": true
+    "/v2/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "watcherAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListClusters",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include hosts in the returned list.",
+            "name": "with_hosts",
+            "in": "query",
+            "allowEmptyValue": true
+          },
+          {
+            "type": "string",
+            "description": "If provided, returns only clusters that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates a new OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2RegisterCluster",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "upgrade-channel": {
-      "type": "object",
-      "required": [
-        "cpu_architecture",
-        "channels"
-      ],
-      "properties": {
-        "channels": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/release-channel"
+    "/v2/clusters/default-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterDefaultConfig",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_default_config"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image.",
-          "type": "string",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ]
         }
       }
     },
-    "upgrade_agent_request": {
-      "type": "object",
-      "properties": {
-        "agent_image": {
-          "description": "Full image reference of the image that the agent should upgrade to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
-          "type": "string"
+    "/v2/clusters/disconnected": {
+      "post": {
+        "description": "Create a disconnected OpenShift cluster for offline installation with embedded ignition",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2RegisterDisconnectedCluster",
+        "parameters": [
+          {
+            "description": "Parameters for creating a disconnected cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/disconnected-cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "upgrade_agent_response": {
-      "type": "object",
-      "properties": {
-        "agent_image": {
-          "description": "Full image reference of the image that the agent has upgraded to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
-          "type": "string"
-        },
-        "result": {
-          "$ref": "#/definitions/upgrade_agent_result"
+    "/v2/clusters/import": {
+      "post": {
+        "description": "Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ImportCluster",
+        "parameters": [
+          {
+            "description": "Parameters for importing a OCP cluster for adding nodes.",
+            "name": "new-import-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/import-cluster-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "upgrade_agent_result": {
-      "description": "Agent upgrade result.",
-      "type": "string",
-      "enum": [
-        "success",
-        "failure"
-      ]
-    },
-    "usage": {
-      "type": "object",
-      "properties": {
-        "data": {
-          "description": "additional properties of the feature",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+    "/v2/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "watcherAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, do not include hosts.",
+            "name": "exclude-hosts",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "id": {
-          "description": "Unique idenftifier of the feature",
-          "type": "string"
-        },
-        "name": {
-          "description": "name of the feature to track",
-          "type": "string"
         }
-      }
-    },
-    "v2-cluster-update-params": {
-      "type": "object",
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip"
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2UpdateCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1,
-          "x-nullable": true
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2-cluster-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
-          "x-nullable": true
-        },
-        "control_plane_count": {
-          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
-          "type": "integer",
-          "x-nullable": true
-        },
-        "disk_encryption": {
-          "description": "Installation disks encryption mode and host roles to be applied.",
-          "$ref": "#/definitions/disk-encryption"
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
-          "type": "string",
-          "enum": [
-            "none",
-            "masters",
-            "arbiters",
-            "workers",
-            "masters,arbiters",
-            "masters,workers",
-            "arbiters,workers",
-            "masters,arbiters,workers",
-            "all"
-          ],
-          "x-nullable": true
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "$ref": "#/definitions/ignition-endpoint"
-        },
-        "ingress_vips": {
-          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ingress_vip"
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "x-nullable": true
-        },
-        "load_balancer": {
-          "$ref": "#/definitions/load_balancer"
-        },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/machine_network"
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
-          "x-nullable": true
-        },
-        "name": {
-          "description": "OpenShift cluster name.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1,
-          "x-nullable": true
-        },
-        "network_type": {
-          "description": "The desired network type used.",
-          "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-create-params"
-          }
-        },
-        "platform": {
-          "$ref": "#/definitions/platform"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "x-nullable": true
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "verified_vip": {
-      "description": "Single VIP verification result.",
-      "type": "object",
-      "properties": {
-        "verification": {
-          "$ref": "#/definitions/vip_verification"
-        },
-        "vip": {
-          "$ref": "#/definitions/ip"
-        },
-        "vip_type": {
-          "$ref": "#/definitions/vip_type"
+    "/v2/clusters/{cluster_id}/actions/allow-add-hosts": {
+      "post": {
+        "description": "Transforms installed cluster to a state which allows adding hosts.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "TransformClusterToAddingHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to transform.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "verify_vip": {
-      "description": "Request to verify single vip.",
-      "type": "object",
-      "properties": {
-        "vip": {
-          "$ref": "#/definitions/ip"
-        },
-        "vip_type": {
-          "$ref": "#/definitions/vip_type"
+    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
+      "post": {
+        "description": "Deprecated, maintained for legacy purposes. Does the same thing as allow-add-hosts. Use allow-add-hosts instead.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "TransformClusterToDay2",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to transform.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "verify_vips_request": {
-      "description": "list of vips to be verified.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/verify_vip"
-      }
-    },
-    "verify_vips_response": {
-      "description": "list of verified vips.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/verified_vip"
-      }
-    },
-    "versioned-host-requirements": {
-      "type": "object",
-      "properties": {
-        "arbiter": {
-          "description": "Arbiter node requirements",
-          "x-go-name": "ArbiterRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "edge-worker": {
-          "description": "Edge Worker OpenShift node requirements",
-          "x-go-name": "EdgeWorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "master": {
-          "description": "Master node requirements",
-          "x-go-name": "MasterRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "sno": {
-          "description": "Single node OpenShift node requirements",
-          "x-go-name": "SNORequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "version": {
-          "description": "Version of the component for which requirements are defined",
-          "type": "string"
-        },
-        "worker": {
-          "description": "Worker node requirements",
-          "x-go-name": "WorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+    "/v2/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2CancelInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be canceled.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "versions": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
-      }
-    },
-    "vip_type": {
-      "description": "The vip type.",
-      "type": "string",
-      "enum": [
-        "api",
-        "ingress"
-      ]
-    },
-    "vip_verification": {
-      "description": "vip verification result.",
-      "type": "string",
-      "default": "unverified",
-      "enum": [
-        "unverified",
-        "failed",
-        "succeeded"
-      ]
-    }
-  },
-  "securityDefinitions": {
-    "agentAuth": {
-      "type": "apiKey",
-      "name": "X-Secret-Key",
-      "in": "header"
-    },
-    "imageAuth": {
-      "type": "apiKey",
-      "name": "Image-Token",
-      "in": "header"
-    },
-    "imageURLAuth": {
-      "type": "apiKey",
-      "name": "image_token",
-      "in": "query"
-    },
-    "urlAuth": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "query"
-    },
-    "userAuth": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    },
-    "watcherAuth": {
-      "type": "apiKey",
-      "name": "Watcher-Authorization",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "userAuth": [
-        "admin",
-        "user"
-      ]
-    }
-  ],
-  "tags": [
-    {
-      "description": "Agent-driven installation",
-      "name": "Assisted installation"
-    },
-    {
-      "description": "Events related to a cluster installation.",
-      "name": "events"
-    },
-    {
-      "description": "General OpenShift cluster installation APIs.",
-      "name": "installer"
-    },
-    {
-      "description": "Managed dns domains for a cluster installation.",
-      "name": "managed_domains"
-    },
-    {
-      "description": "Manifests for customizing a cluster installation.",
-      "name": "manifests"
-    },
-    {
-      "description": "Information regarding supported operators.",
-      "name": "operators"
-    },
-    {
-      "description": "Information regarding versions.",
-      "name": "versions"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Assisted installation",
-    "title": "AssistedInstall",
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
-    },
-    "version": "1.0.0"
-  },
-  "host": "api.openshift.com",
-  "basePath": "/api/assisted-install",
-  "paths": {
-    "/v2/clusters": {
-      "get": {
+    "/v2/clusters/{cluster_id}/actions/complete-installation": {
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "watcherAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ListClusters",
+        "operationId": "v2CompleteInstallation",
         "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
+            "description": "The cluster whose installation is being completing.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
-            "in": "query"
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Include hosts in the returned list.",
-            "name": "with_hosts",
-            "in": "query",
-            "allowEmptyValue": true
+            "type": "string",
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2InstallCluster",
+        "parameters": [
           {
             "type": "string",
-            "description": "If provided, returns only clusters that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
+            "format": "uuid",
+            "description": "The cluster to be installed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -11558,56 +14823,57 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
+      }
+    },
+    "/v2/clusters/{cluster_id}/actions/reset": {
       "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+        "description": "Resets a failed installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterCluster",
+        "operationId": "v2ResetCluster",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be reset.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "202": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -11620,12 +14886,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11635,27 +14913,35 @@ func init() {
         }
       }
     },
-    "/v2/clusters/default-config": {
+    "/v2/clusters/{cluster_id}/credentials": {
       "get": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
               "user"
             ]
           }
         ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetClusterDefaultConfig",
+        "operationId": "V2GetCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose admin credentials should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
+              "$ref": "#/definitions/credentials"
             }
           },
           "401": {
@@ -11670,6 +14956,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11679,35 +14983,56 @@ func init() {
         }
       }
     },
-    "/v2/clusters/disconnected": {
-      "post": {
-        "description": "Create a disconnected OpenShift cluster for offline installation with embedded ignition",
+    "/v2/clusters/{cluster_id}/downloads/credentials": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Downloads credentials relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterDisconnectedCluster",
+        "operationId": "V2DownloadClusterCredentials",
         "parameters": [
           {
-            "description": "Parameters for creating a disconnected cluster.",
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/disconnected-cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the credential file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
+            "type": "string",
+            "description": "The credential file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -11722,56 +15047,116 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/import": {
-      "post": {
-        "description": "Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ImportCluster",
+        "operationId": "V2GetPresignedForClusterCredentials",
         "parameters": [
           {
-            "description": "Parameters for importing a OCP cluster for adding nodes.",
-            "name": "new-import-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/import-cluster-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/presigned-url"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "400": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "401": {
-            "description": "Unauthorized.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
-          "403": {
-            "description": "Forbidden.",
+          "409": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -11783,7 +15168,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}": {
+    "/v2/clusters/{cluster_id}/downloads/files": {
       "get": {
         "security": [
           {
@@ -11797,48 +15182,55 @@ func init() {
             "agentAuth": []
           },
           {
-            "watcherAuth": []
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetCluster",
+        "operationId": "V2DownloadClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "custom_manifests.json",
+              "custom_manifests.yaml",
+              "arbiter.ign"
+            ],
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
             "in": "header"
-          },
-          {
-            "type": "boolean",
-            "description": "If true, do not include hosts.",
-            "name": "exclude-hosts",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "file"
             }
           },
           "401": {
@@ -11865,6 +15257,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11878,26 +15276,86 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DeregisterCluster",
+        "operationId": "V2GetPresignedForClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned-url"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11936,43 +15394,72 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Get a list of cluster hosts according to supplied filters.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UpdateCluster",
+        "operationId": "ListClusterHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
+            "description": "The cluster whose hosts should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/v2-cluster-update-params"
-            }
+            "enum": [
+              "master",
+              "arbiter",
+              "worker",
+              "auto-assign"
+            ],
+            "type": "string",
+            "description": "Role to request.",
+            "name": "role",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Hosts status to request.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true return the host's inventory.",
+            "name": "with-inventory",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true return the host's connectivity.",
+            "name": "with-connectivity",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -11993,49 +15480,89 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/ignored-validations": {
+      "get": {
+        "description": "Fetch the validations which are to be ignored for this cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetIgnoredValidations",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose failing validations should be ignored according to this list.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ignored-validations"
+            }
           },
-          "409": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/allow-add-hosts": {
-      "post": {
-        "description": "Transforms installed cluster to a state which allows adding hosts.",
+      },
+      "put": {
+        "description": "Register the validations which are to be ignored for this cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "TransformClusterToAddingHosts",
+        "operationId": "v2SetIgnoredValidations",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to transform.",
+            "description": "The cluster whose failing validations should be ignored according to this list.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The validations to be ignored.",
+            "name": "ignored_validations",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ignored-validations"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/ignored-validations"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -12056,18 +15583,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12077,29 +15592,37 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
-      "post": {
-        "description": "Deprecated, maintained for legacy purposes. Does the same thing as allow-add-hosts. Use allow-add-hosts instead.",
+    "/v2/clusters/{cluster_id}/install-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "TransformClusterToDay2",
-        "deprecated": true,
+        "operationId": "v2GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to transform.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "string"
             }
           },
           "401": {
@@ -12126,12 +15649,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12139,30 +15656,41 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/cancel": {
-      "post": {
-        "description": "Cancels an ongoing installation.",
+      },
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2CancelInstallation",
+        "operationId": "v2UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "x-go-custom-tag": "secret:\"true\""
+            }
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -12189,12 +15717,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12204,48 +15726,61 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/complete-installation": {
-      "post": {
+    "/v2/clusters/{cluster_id}/logs": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2CompleteInstallation",
+        "operationId": "V2DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "file"
             }
           },
           "401": {
@@ -12283,42 +15818,72 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/install": {
+      },
       "post": {
-        "description": "Installs the OpenShift cluster.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallCluster",
+        "operationId": "V2UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "maxLength": 262144000,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env ID of the host.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Success."
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -12358,33 +15923,50 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/reset": {
-      "post": {
-        "description": "Resets a failed installation.",
+    "/v2/clusters/{cluster_id}/logs-progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetCluster",
+        "operationId": "v2UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -12421,39 +16003,50 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/credentials": {
+    "/v2/clusters/{cluster_id}/manifests": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetCredentials",
+        "operationId": "V2ListClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include system generated manifests in results? Default is false.",
+            "name": "include_system_generated",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -12493,58 +16086,48 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/credentials": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Downloads credentials relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2DownloadClusterCredentials",
+        "operationId": "V2CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the credential file that should be downloaded.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The credential file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -12582,47 +16165,38 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster admin credentials.",
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetPresignedForClusterCredentials",
+        "operationId": "V2DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "The file to be downloaded.",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
             "name": "file_name",
             "in": "query",
             "required": true
@@ -12630,16 +16204,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -12678,71 +16243,48 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/files": {
-      "get": {
+      },
+      "patch": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Updates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2DownloadClusterFiles",
+        "operationId": "V2UpdateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster for which a new manifest should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "custom_manifests.json",
-              "custom_manifests.yaml",
-              "arbiter.ign"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The manifest to be updated.",
+            "name": "UpdateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/update-manifest-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -12780,93 +16322,58 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
+    "/v2/clusters/{cluster_id}/manifests/files": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetPresignedForClusterFiles",
+        "operationId": "v2DownloadClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose manifest should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
             "in": "query"
           },
           {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
+            "type": "string",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -12908,62 +16415,32 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/hosts": {
+    "/v2/clusters/{cluster_id}/monitored-operators": {
       "get": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Get a list of cluster hosts according to supplied filters.",
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "ListClusterHosts",
+        "operationId": "V2ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be retrieved.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "master",
-              "arbiter",
-              "worker",
-              "auto-assign"
-            ],
-            "type": "string",
-            "description": "Role to request.",
-            "name": "role",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "Hosts status to request.",
-            "name": "status",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's inventory.",
-            "name": "with-inventory",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's connectivity.",
-            "name": "with-connectivity",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
             "in": "query"
           }
         ],
@@ -12971,7 +16448,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/monitored-operators-list"
             }
           },
           "401": {
@@ -12992,46 +16469,13 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/clusters/{cluster_id}/ignored-validations": {
-      "get": {
-        "description": "Fetch the validations which are to be ignored for this cluster.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2GetIgnoredValidations",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose failing validations should be ignored according to this list.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/ignored-validations"
-            }
-          },
-          "400": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -13040,39 +16484,42 @@ func init() {
         }
       },
       "put": {
-        "description": "Register the validations which are to be ignored for this cluster.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "v2SetIgnoredValidations",
+        "operationId": "v2ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose failing validations should be ignored according to this list.",
+            "description": "The cluster whose operators are being monitored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The validations to be ignored.",
-            "name": "ignored_validations",
+            "description": "The operators monitor report.",
+            "name": "report-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ignored-validations"
+              "$ref": "#/definitions/operator-monitor-report"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/ignored-validations"
-            }
+          "200": {
+            "description": "Success."
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13095,16 +16542,34 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/install-config": {
+    "/v2/clusters/{cluster_id}/preflight-requirements": {
       "get": {
         "security": [
           {
@@ -13115,16 +16580,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get the cluster's install config YAML.",
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetClusterInstallConfig",
+        "operationId": "v2GetPreflightRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster to return preflight requirements for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -13134,7 +16599,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -13168,41 +16633,42 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation finalizing progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterInstallConfig",
+        "operationId": "v2UpdateClusterFinalizingProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
+            "description": "New progress value.",
+            "name": "finalizing-progress",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/cluster-finalizing-progress"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "200": {
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -13233,11 +16699,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs": {
+    "/v2/clusters/{cluster_id}/supported-platforms": {
       "get": {
         "security": [
           {
@@ -13246,52 +16718,88 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
+        "description": "A list of platforms that this cluster can support in its current configuration.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterSupportedPlatforms",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose platform types should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
         ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/platform_type"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/ui-settings": {
+      "get": {
+        "description": "Fetch cluster specific UI settings.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterLogs",
+        "operationId": "V2GetClusterUISettings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster for which UI settings should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -13312,18 +16820,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13332,69 +16828,40 @@ func init() {
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+      "put": {
+        "description": "Update cluster specific UI settings.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UploadLogs",
+        "operationId": "V2UpdateClusterUISettings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster for which UI settings should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "maxLength": 262144000,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env ID of the host.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
+            "description": "Settings for the installer UI.",
+            "name": "ui-settings",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13417,67 +16884,61 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs-progress": {
-      "put": {
+    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterLogsProgress",
+        "operationId": "v2UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster to associate with the ingress certificate.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/ingress-cert-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -13503,12 +16964,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13524,40 +16979,53 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests": {
+    "/v2/component-versions": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "List of component versions.",
         "tags": [
-          "manifests"
+          "versions"
         ],
-        "operationId": "V2ListClusterManifests",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
+        "operationId": "v2ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/v2/custom-release-images": {
+      "get": {
+        "security": [
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Include system generated manifests in results? Default is false.",
-            "name": "include_system_generated",
-            "in": "query"
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
+        "description": "Retrieves the custom release images that are registered by the organization of the user.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListCustomReleaseImages",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "$ref": "#/definitions/custom-release-images"
             }
           },
           "401": {
@@ -13572,18 +17040,64 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Registers a custom release image, such as a nightly or a custom built payload. The OpenShift version and\nthe CPU architectures are read from the metadata of the release image, and the release image is available\nin the OpenShift versions of the organization of the user.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2RegisterCustomReleaseImage",
+        "parameters": [
+          {
+            "description": "The release image to register.",
+            "name": "new-custom-release-image-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/custom-release-image-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/custom-release-image"
+            }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "400": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -13597,49 +17111,36 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/custom-release-images/{custom_release_image_id}": {
+      "delete": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "user"
+            ]
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Deregisters a custom release image.",
         "tags": [
-          "manifests"
+          "versions"
         ],
-        "operationId": "V2CreateClusterManifest",
+        "operationId": "v2DeregisterCustomReleaseImage",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
-            "name": "cluster_id",
+            "description": "The custom release image to deregister.",
+            "name": "custom_release_image_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/manifest"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -13659,16 +17160,36 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "409": {
-            "description": "Error.",
+          }
+        }
+      }
+    },
+    "/v2/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "V2ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/list-managed-domains"
             }
           },
           "500": {
@@ -13678,44 +17199,161 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      }
+    },
+    "/v2/events": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "watcherAuth": []
+          }
+        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "manifests"
+          "events"
         ],
-        "operationId": "V2DeleteClusterManifest",
+        "operationId": "v2ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for (DEPRECATED. Use ` + "`" + `host_ids` + "`" + ` instead).",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string",
+              "format": "uuid"
+            },
+            "description": "Hosts in the specified cluster to return events for.",
+            "name": "host_ids",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to return events for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Number of records to skip before starting to return the records.",
+            "name": "offset",
+            "in": "query"
           },
           {
             "enum": [
-              "manifests",
-              "openshift"
+              "ascending",
+              "descending"
             ],
             "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
+            "default": "ascending",
+            "description": "Order by event_time of events retrieved.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "Retrieved events severities.",
+            "name": "severities",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "Retrieved events message pattern.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Deleted hosts flag.",
+            "name": "deleted_hosts",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Cluster level events flag.",
+            "name": "cluster_level",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "Event-Count": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events retrieved."
+              },
+              "Severity-Count-Critical": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'critical'."
+              },
+              "Severity-Count-Error": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'error'."
+              },
+              "Severity-Count-Info": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'info'."
+              },
+              "Severity-Count-Warning": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'warning'."
+              }
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -13741,12 +17379,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13755,42 +17387,31 @@ func init() {
           }
         }
       },
-      "patch": {
+      "post": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Updates a manifest for customizing cluster installation.",
+        "description": "Add new assisted installer event.",
         "tags": [
-          "manifests"
+          "events"
         ],
-        "operationId": "V2UpdateClusterManifest",
+        "operationId": "v2TriggerEvent",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster for which a new manifest should be updated.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The manifest to be updated.",
-            "name": "UpdateManifestParams",
+            "description": "The event to be created.",
+            "name": "trigger-event-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/update-manifest-params"
+              "$ref": "#/definitions/event"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/manifest"
-            }
+          "201": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -13823,7 +17444,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Cluster cannot accept new agents due to its current state.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13833,50 +17454,59 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests/files": {
+    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Downloads cluster manifest.",
+        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "v2DownloadClusterManifest",
+        "operationId": "v2DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
-            "name": "cluster_id",
+            "description": "The infra-env of the host whose ignition file should be downloaded.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
             "required": true
           }
         ],
@@ -13922,36 +17552,47 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/monitored-operators": {
+    "/v2/infra-envs": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "watcherAuth": []
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Retrieves the list of infra-envs.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "V2ListOfClusterOperators",
+        "operationId": "ListInfraEnvs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "If provided, returns only infra-envs which directly reference this cluster.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
+            "description": "If provided, returns only infra-envs that are owned by the specified user.",
+            "name": "owner",
             "in": "query"
           }
         ],
@@ -13959,7 +17600,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/infra-env-list"
             }
           },
           "401": {
@@ -13974,63 +17615,58 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Controller API to report of monitored operators.",
+      "post": {
+        "description": "Creates a new OpenShift Discovery ISO.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "v2ReportMonitoredOperatorStatus",
+        "operationId": "RegisterInfraEnv",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The operators monitor report.",
-            "name": "report-params",
+            "description": "The parameters for the generated ISO.",
+            "name": "infraenv-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
+              "$ref": "#/definitions/infra-env-create-params"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env"
+            }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14071,8 +17707,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14080,37 +17716,35 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/preflight-requirements": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get preflight requirements for a cluster.",
+    "/v2/infra-envs/validate-static-network": {
+      "post": {
+        "description": "Validates each host of a static network configuration independently, without creating an infra-env, and previews the NetworkManager keyfiles generated for the valid hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetPreflightRequirements",
+        "operationId": "v2ValidateStaticNetworkConfig",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to return preflight requirements for.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "The static network configuration to validate.",
+            "name": "static-network-config-validation-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/static-network-config-validation-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/static-network-config-validation"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -14125,12 +17759,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -14142,44 +17770,60 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/progress": {
-      "put": {
+    "/v2/infra-envs/{infra_env_id}": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
           }
         ],
-        "description": "Update installation finalizing progress.",
+        "description": "Retrieves the details of the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterFinalizingProgress",
+        "operationId": "GetInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster being updated.",
-            "name": "cluster_id",
+            "description": "The infra-env to be retrieved.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "finalizing-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-finalizing-progress"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -14211,6 +17855,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -14218,43 +17868,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/supported-platforms": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "A list of platforms that this cluster can support in its current configuration.",
+      },
+      "delete": {
+        "description": "Deletes an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterSupportedPlatforms",
+        "operationId": "DeregisterInfraEnv",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose platform types should be retrieved.",
-            "name": "cluster_id",
+            "format": "uuid",
+            "description": "The infra-env to be deleted.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/platform_type"
-              }
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -14274,37 +17907,62 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/ui-settings": {
-      "get": {
-        "description": "Fetch cluster specific UI settings.",
+      },
+      "patch": {
+        "description": "Updates an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetClusterUISettings",
+        "operationId": "UpdateInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be retrieved.",
-            "name": "cluster_id",
+            "description": "The infra-env to be updated.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "infra-env-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/infra-env-update-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "400": {
@@ -14331,44 +17989,55 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "put": {
-        "description": "Update cluster specific UI settings.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/actions/virtual-media-boot": {
+      "get": {
+        "description": "Retrieves the status of the last virtual media boot of the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UpdateClusterUISettings",
+        "operationId": "v2GetInfraEnvVirtualMediaBoot",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be updated.",
-            "name": "cluster_id",
+            "description": "The infra-env whose virtual media boot status is retrieved.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Settings for the installer UI.",
-            "name": "ui-settings",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/infra-env-virtual-media-boot"
             }
           },
           "400": {
@@ -14395,55 +18064,57 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
+      },
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Boots servers from the discovery ISO of the infra-env. The ISO is mounted as Redfish virtual media through the BMC of each server, set as the next boot device and the server is powered on or rebooted. The servers are booted in the background, the status of each BMC is returned by the GET request.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UploadClusterIngressCert",
+        "operationId": "v2InfraEnvVirtualMediaBoot",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
-            "name": "cluster_id",
+            "description": "The infra-env whose discovery ISO is booted.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
+            "description": "The BMCs of the servers to boot.",
+            "name": "infra-env-virtual-media-boot-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
+              "$ref": "#/definitions/infra-env-virtual-media-boot-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env-virtual-media-boot"
+            }
           },
           "400": {
             "description": "Error.",
@@ -14475,6 +18146,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -14490,7 +18167,7 @@ func init() {
         }
       }
     },
-    "/v2/component-versions": {
+    "/v2/infra-envs/{infra_env_id}/downloads/files": {
       "get": {
         "security": [
           {
@@ -14499,44 +18176,118 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
           }
         ],
-        "description": "List of component versions.",
+        "description": "Downloads the customized ignition file for this host",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "v2ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/v2/domains": {
-      "get": {
-        "security": [
+        "operationId": "v2DownloadInfraEnvFiles",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose file should be downloaded.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "discovery.ign",
+              "ipxe-script",
+              "static-network-config"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "mac",
+            "description": "Mac address of the host running ipxe script.",
+            "name": "mac",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
+            "type": "string",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "full-iso",
+              "minimal-iso"
+            ],
+            "type": "string",
+            "description": "Overrides the ISO type for the disovery ignition, either 'full-iso' or 'minimal-iso'.",
+            "name": "discovery_iso_type",
+            "in": "query"
           }
         ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "V2ListManagedDomains",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Bad Request.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -14544,127 +18295,57 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/events": {
+    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "watcherAuth": []
-          }
-        ],
-        "description": "Lists events for a cluster.",
+        "description": "Creates a new pre-signed download URL for the infra-env.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2ListEvents",
+        "operationId": "GetInfraEnvPresignedFileURL",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
-            "name": "cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A host in the specified cluster to return events for (DEPRECATED. Use ` + "`" + `host_ids` + "`" + ` instead).",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string",
-              "format": "uuid"
-            },
-            "description": "Hosts in the specified cluster to return events for.",
-            "name": "host_ids",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env to return events for.",
+            "description": "The file's infra-env.",
             "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "The maximum number of records to retrieve.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Number of records to skip before starting to return the records.",
-            "name": "offset",
-            "in": "query"
+            "in": "path",
+            "required": true
           },
           {
             "enum": [
-              "ascending",
-              "descending"
+              "discovery.ign",
+              "ipxe-script"
             ],
             "type": "string",
-            "default": "ascending",
-            "description": "Order by event_time of events retrieved.",
-            "name": "order",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "enum": [
-                "info",
-                "warning",
-                "error",
-                "critical"
-              ],
-              "type": "string"
-            },
-            "description": "Retrieved events severities.",
-            "name": "severities",
-            "in": "query"
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           },
           {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
             "type": "string",
-            "description": "Retrieved events message pattern.",
-            "name": "message",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Deleted hosts flag.",
-            "name": "deleted_hosts",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Cluster level events flag.",
-            "name": "cluster_level",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
             "in": "query"
           }
         ],
@@ -14672,34 +18353,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
-            },
-            "headers": {
-              "Event-Count": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events retrieved."
-              },
-              "Severity-Count-Critical": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'critical'."
-              },
-              "Severity-Count-Error": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'error'."
-              },
-              "Severity-Count-Info": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'info'."
-              },
-              "Severity-Count-Warning": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'warning'."
-              }
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "401": {
@@ -14731,37 +18385,48 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Add new assisted installer event.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
+      "get": {
+        "description": "Creates a new pre-signed image download URL for the infra-env.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2TriggerEvent",
+        "operationId": "GetInfraEnvDownloadURL",
         "parameters": [
           {
-            "description": "The event to be created.",
-            "name": "trigger-event-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/event"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to be retrieved.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned-url"
+            }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14790,12 +18455,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -14817,7 +18476,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
+    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
       "get": {
         "security": [
           {
@@ -14828,31 +18487,26 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
+        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "installer"
-        ],
-        "operationId": "v2DownloadHostIgnition",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be downloaded.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
+          "installer"
+        ],
+        "operationId": "DownloadMinimalInitrd",
+        "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
-            "name": "host_id",
+            "description": "The infra-env of the host that should be retrieved.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           }
@@ -14861,9 +18515,13 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "type": "string",
+              "format": "binary"
             }
           },
+          "204": {
+            "description": "Empty Success."
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -14889,7 +18547,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Conflict.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14899,17 +18557,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs": {
+    "/v2/infra-envs/{infra_env_id}/hosts": {
       "get": {
         "security": [
           {
@@ -14920,34 +18572,29 @@ func init() {
             ]
           },
           {
-            "watcherAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of infra-envs.",
+        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "ListInfraEnvs",
+        "operationId": "v2ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "If provided, returns only infra-envs which directly reference this cluster.",
-            "name": "cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If provided, returns only infra-envs that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
+            "description": "The infra-env that the hosts are asociated with.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env-list"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -14989,27 +18636,46 @@ func init() {
         }
       },
       "post": {
-        "description": "Creates a new OpenShift Discovery ISO.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Registers a new OpenShift agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterInfraEnv",
+        "operationId": "v2RegisterHost",
         "parameters": [
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "infraenv-create-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env that the agent is associated with.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The description of the agent being registered.",
+            "name": "new-host-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/infra-env-create-params"
+              "$ref": "#/definitions/host-create-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is registering the agent.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/host_registration_response"
             }
           },
           "400": {
@@ -15043,7 +18709,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Cluster cannot accept new agents due to its current state.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15059,11 +18725,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
       "get": {
         "security": [
           {
@@ -15072,40 +18744,36 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
           }
         ],
-        "description": "Retrieves the details of the infra-env.",
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnv",
+        "operationId": "v2GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
+            "description": "The infra-env of the host that should be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -15143,35 +18811,43 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
       "delete": {
-        "description": "Deletes an infra-env.",
+        "description": "Deregisters an OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterInfraEnv",
+        "operationId": "v2DeregisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be deleted.",
+            "description": "The infra-env of the host that should be deregistered.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "204": {
             "description": "Success."
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -15196,48 +18872,44 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
       "patch": {
-        "description": "Updates an infra-env.",
+        "description": "Update an Openshift host",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateInfraEnv",
+        "operationId": "v2UpdateHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be updated.",
+            "description": "The infra-env ID of the host to be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
           {
             "description": "The properties to update.",
-            "name": "infra-env-update-params",
+            "name": "host-update-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/infra-env-update-params"
+              "$ref": "#/definitions/host-update-params"
             }
           }
         ],
@@ -15245,7 +18917,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -15289,105 +18961,53 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
-          }
-        ],
-        "description": "Downloads the customized ignition file for this host",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
+      "post": {
+        "description": "Bind host to a cluster",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadInfraEnvFiles",
+        "operationId": "BindHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose file should be downloaded.",
+            "description": "The infra-env of the host that is being bound.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script",
-              "static-network-config"
-            ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "mac",
-            "description": "Mac address of the host running ipxe script.",
-            "name": "mac",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
-            "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "full-iso",
-              "minimal-iso"
-            ],
-            "type": "string",
-            "description": "Overrides the ISO type for the disovery ignition, either 'full-iso' or 'minimal-iso'.",
-            "name": "discovery_iso_type",
-            "in": "query"
+            "description": "The parameters for the host binding.",
+            "name": "bind-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bind-host-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
-            "description": "Bad Request.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15416,12 +19036,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -15443,49 +19057,36 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
-      "get": {
-        "description": "Creates a new pre-signed download URL for the infra-env.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnvPresignedFileURL",
+        "operationId": "v2InstallHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The file's infra-env.",
+            "description": "The infra-env of the host that is being installed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script"
-            ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host that is being installed.",
+            "name": "host_id",
+            "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
-            "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -15506,8 +19107,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15517,48 +19118,53 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
-      "get": {
-        "description": "Creates a new pre-signed image download URL for the infra-env.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power": {
+      "post": {
+        "description": "Runs a power action on the host through its BMC and returns once the BMC accepted it. The BMC credentials of the host must be set.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnvDownloadURL",
+        "operationId": "v2HostPowerAction",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
+            "description": "The infra-env of the host whose power is managed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose power is managed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The power action to run.",
+            "name": "host-power-action-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-power-action-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
-            "description": "Bad Request.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15587,14 +19193,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "502": {
+            "description": "The BMC failed to run the action.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15608,51 +19220,51 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          }
-        ],
-        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/remove-from-cluster": {
+      "post": {
+        "description": "Removes the node of an installed host from its cluster. The node is cordoned, drained and deleted, and the host is reclaimed and reboots into discovery. Only hosts managed by the kube-api are supported, the progress is reported in the NodeRemoval condition of their agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadMinimalInitrd",
+        "operationId": "v2RemoveHostFromCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
+            "description": "The infra-env of the host that is being removed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being removed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The parameters of the removal of the node.",
+            "name": "host-node-removal-params",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/host-node-removal-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/host"
             }
           },
-          "204": {
-            "description": "Empty Success."
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -15689,44 +19301,46 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ListHosts",
+        "operationId": "v2ResetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the hosts are asociated with.",
+            "description": "The infra-env of the host that is being reset.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -15741,77 +19355,69 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Registers a new OpenShift agent.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterHost",
+        "summary": "Reset failed host validation.",
+        "operationId": "v2ResetHostValidation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the agent is associated with.",
+            "description": "The infra-env of the host that its validation is being reset.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The description of the agent being registered.",
-            "name": "new-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is registering the agent.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host_registration_response"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15834,32 +19440,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "501": {
-            "description": "Not implemented.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15867,27 +19455,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the details of the OpenShift host.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
+      "post": {
+        "description": "Unbind host to a cluster",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHost",
+        "operationId": "UnbindHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
+            "description": "The infra-env of the host that is being bound.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -15895,7 +19474,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be retrieved.",
+            "description": "The host that is being bound.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -15908,6 +19487,12 @@ func init() {
               "$ref": "#/definitions/host"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -15932,6 +19517,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -15943,20 +19534,28 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/bmc-credentials": {
+      "put": {
+        "description": "Sets the credentials of the BMC of the host, used to manage its power. The password is stored encrypted, the credentials can't be set unless an encryption key provider is configured. Only Redfish BMCs are supported.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DeregisterHost",
+        "operationId": "v2UpdateHostBmcCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be deregistered.",
+            "description": "The infra-env of the host whose BMC credentials are set.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -15964,15 +19563,27 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be deregistered.",
+            "description": "The host whose BMC credentials are set.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The credentials of the BMC of the host.",
+            "name": "host-bmc-credentials",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-bmc-credentials"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "400": {
             "description": "Error.",
@@ -16004,25 +19615,37 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       },
-      "patch": {
-        "description": "Update an Openshift host",
+      "delete": {
+        "description": "Removes the credentials of the BMC of the host.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHost",
+        "operationId": "v2DeleteHostBmcCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env ID of the host to be updated.",
+            "description": "The infra-env of the host whose BMC credentials are removed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16030,23 +19653,14 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be updated.",
+            "description": "The host whose BMC credentials are removed.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The properties to update.",
-            "name": "host-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-update-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
@@ -16083,7 +19697,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Conflict.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16093,22 +19707,28 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
-      "post": {
-        "description": "Bind host to a cluster",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
+      "get": {
+        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
         "tags": [
           "installer"
         ],
-        "operationId": "BindHost",
+        "operationId": "v2GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The infra-env of the host whose ignition file should be obtained.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16116,32 +19736,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being bound.",
+            "description": "The host whose ignition file should be obtained.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The parameters for the host binding.",
-            "name": "bind-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bind-host-params"
-            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-ignition-params"
             }
           },
           "401": {
@@ -16187,20 +19792,18 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallHost",
+        "operationId": "v2UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being installed.",
+            "description": "The infra-env of the host whose ignition file should be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16208,17 +19811,29 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being installed.",
+            "description": "The host whose ignition file should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -16239,8 +19854,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16250,22 +19865,28 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetHost",
+        "operationId": "v2UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being reset.",
+            "description": "The infra-env of the host whose installer arguments should be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16273,19 +19894,34 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being reset.",
+            "description": "The host whose installer arguments should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -16304,6 +19940,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -16315,23 +19957,33 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "v2ResetHostValidation",
+        "operationId": "v2GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that its validation is being reset.",
+            "description": "The infra-env of the host that is retrieving instructions.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16339,30 +19991,29 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that its validation is being reset.",
+            "description": "The host that is retrieving instructions.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
+          {
+            "type": "integer",
+            "description": "The time on the host as seconds since the Unix epoch.",
+            "name": "timestamp",
+            "in": "query"
+          },
           {
             "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Bad Request",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -16383,8 +20034,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16394,22 +20045,43 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
+      },
       "post": {
-        "description": "Unbind host to a cluster",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "UnbindHost",
+        "operationId": "v2PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The infra-env of the host that is posting results.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16417,18 +20089,23 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being bound.",
+            "description": "The host that is posting results.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
+          "204": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -16460,12 +20137,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Conflict.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -16487,18 +20158,23 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
-      "get": {
-        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHostIgnition",
+        "operationId": "v2UpdateHostLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be obtained.",
+            "description": "The infra-env whose log progress is being updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16506,18 +20182,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be obtained.",
+            "description": "The host whose log progress is being updated.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -16543,6 +20225,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -16562,18 +20250,31 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostIgnition",
+        "operationId": "v2UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be updated.",
+            "description": "The infra-env of the host being updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16581,30 +20282,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
+            "description": "The ID of the host to update.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
+            "description": "New progress value.",
+            "name": "host-progress",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/host-progress"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "200": {
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -16636,8 +20331,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16645,18 +20340,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config": {
+      "post": {
+        "description": "Generates the static network configuration of a host from its discovered inventory, and optionally applies it to the infra-env of the host.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallerArgs",
+        "operationId": "v2GenerateHostStaticNetworkConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "description": "The infra-env of the host whose static network configuration should be generated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16664,26 +20359,26 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
+            "description": "The host whose static network configuration should be generated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
+            "description": "The optional bonds, VLANs and DNS servers of the static network configuration.",
+            "name": "generate-static-network-config-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/installer-args-params"
+              "$ref": "#/definitions/host-static-network-config-generate-params"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host_static_network_config"
             }
           },
           "400": {
@@ -16728,8 +20423,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16737,54 +20432,26 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
-      "get": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
+      "post": {
+        "description": "Regenerate InfraEnv token signing key.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetNextSteps",
+        "operationId": "RegenerateInfraEnvSigningKey",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is retrieving instructions.",
+            "description": "The target InfraEnv.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is retrieving instructions.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "description": "The time on the host as seconds since the Unix epoch.",
-            "name": "timestamp",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/steps"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -16815,9 +20482,55 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/openshift-versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListSupportedOpenshiftVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Retrieves only the versions that contain the specified substring in their display name.",
+            "name": "version",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, returns only the latest version for each minor.",
+            "name": "only_latest",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16829,53 +20542,81 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Posts the result of the operations from the host agent.",
+      }
+    },
+    "/v2/operators/bundles": {
+      "get": {
+        "description": "Retrieves a list of available bundles filtered by support level.",
         "tags": [
-          "installer"
+          "operators"
         ],
-        "operationId": "v2PostStepReply",
+        "summary": "Get list of available bundles",
+        "operationId": "V2ListBundles",
         "parameters": [
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "Version of the OpenShift cluster. If the parameter is not specified, only feature_ids parameter is taken into account.",
+            "name": "openshift_version",
+            "in": "query"
           },
           {
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host that is posting results.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc). openshift_version must be set.",
+            "name": "cpu_architecture",
+            "in": "query"
           },
           {
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external",
+              "openstack"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is posting results.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The provider platform type. openshift_version must be set.",
+            "name": "platform_type",
+            "in": "query"
           },
           {
-            "description": "The results to be posted.",
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
+            "type": "string",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external or if openshift_version is not set.",
+            "name": "external_platform_name",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "SNO"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
+            "name": "feature_ids",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/bundle"
+              }
+            }
           },
           "400": {
             "description": "Error.",
@@ -16883,44 +20624,66 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
+          "500": {
+            "description": "Internal server error",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/operators/bundles/{id}": {
+      "get": {
+        "description": "Retrieves an array of operator properties for the specified bundle when some features are activated.",
+        "tags": [
+          "operators"
+        ],
+        "summary": "Get operator properties for a bundle",
+        "operationId": "V2GetBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Identifier of the bundle, for example, ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai` + "`" + `.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "SNO"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
+            "name": "feature_ids",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/bundle"
             }
           },
-          "500": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "404": {
+            "description": "Bundle not found",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Internal server error",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16928,48 +20691,28 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
-      "put": {
+    "/v2/quota-usage": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Retrieves the usage and the quotas of the resources of the organization of the user.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostLogsProgress",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env whose log progress is being updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose log progress is being updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
+        "operationId": "v2GetQuotaUsage",
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/quota-usage"
             }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -16983,93 +20726,119 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
+          }
+        }
+      }
+    },
+    "/v2/release-sources": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves openshift release sources configuration.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListReleaseSources",
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/release-sources"
             }
-          },
-          "409": {
-            "description": "Error.",
+          }
+        }
+      }
+    },
+    "/v2/service-accounts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Retrieves the service accounts.",
+        "tags": [
+          "service_accounts"
+        ],
+        "operationId": "v2ListServiceAccounts",
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/service-account-list"
             }
           },
-          "500": {
-            "description": "Error.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
+          },
+          "403": {
+            "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
-      "put": {
+      },
+      "post": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin"
+            ]
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Creates a service account. API tokens are issued to service accounts for automation such as CI.",
         "tags": [
-          "installer"
+          "service_accounts"
         ],
-        "operationId": "v2UpdateHostInstallProgress",
+        "operationId": "v2CreateServiceAccount",
         "parameters": [
           {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host being updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The ID of the host to update.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
+            "description": "The service account to create.",
+            "name": "new-service-account-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-progress"
+              "$ref": "#/definitions/service-account-create-params"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Update install progress."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/service-account"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -17083,46 +20852,41 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
-      "post": {
-        "description": "Regenerate InfraEnv token signing key.",
+    "/v2/service-accounts/{service_account_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Deletes a service account and its API tokens.",
         "tags": [
-          "installer"
+          "service_accounts"
         ],
-        "operationId": "RegenerateInfraEnvSigningKey",
+        "operationId": "v2DeleteServiceAccount",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The target InfraEnv.",
-            "name": "infra_env_id",
+            "description": "The service account.",
+            "name": "service_account_id",
             "in": "path",
             "required": true
           }
@@ -17149,12 +20913,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -17164,203 +20922,129 @@ func init() {
         }
       }
     },
-    "/v2/openshift-versions": {
+    "/v2/service-accounts/{service_account_id}/tokens": {
       "get": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
-              "user"
+              "read-only-admin"
             ]
           }
         ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+        "description": "Retrieves the API tokens of a service account, without their secrets.",
         "tags": [
-          "versions"
+          "service_accounts"
         ],
-        "operationId": "v2ListSupportedOpenshiftVersions",
+        "operationId": "v2ListServiceAccountTokens",
         "parameters": [
           {
             "type": "string",
-            "description": "Retrieves only the versions that contain the specified substring in their display name.",
-            "name": "version",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true, returns only the latest version for each minor.",
-            "name": "only_latest",
-            "in": "query"
+            "format": "uuid",
+            "description": "The service account.",
+            "name": "service_account_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
-            }
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/service-account-token-list"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
-          }
-        }
-      }
-    },
-    "/v2/operators/bundles": {
-      "get": {
-        "description": "Retrieves a list of available bundles filtered by support level.",
-        "tags": [
-          "operators"
-        ],
-        "summary": "Get list of available bundles",
-        "operationId": "V2ListBundles",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Version of the OpenShift cluster. If the parameter is not specified, only feature_ids parameter is taken into account.",
-            "name": "openshift_version",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "x86_64",
-              "aarch64",
-              "arm64",
-              "ppc64le",
-              "s390x",
-              "multi"
-            ],
-            "type": "string",
-            "default": "x86_64",
-            "description": "The CPU architecture of the image (x86_64/arm64/etc). openshift_version must be set.",
-            "name": "cpu_architecture",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "baremetal",
-              "none",
-              "nutanix",
-              "vsphere",
-              "external"
-            ],
-            "type": "string",
-            "description": "The provider platform type. openshift_version must be set.",
-            "name": "platform_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external or if openshift_version is not set.",
-            "name": "external_platform_name",
-            "in": "query"
           },
-          {
-            "type": "array",
-            "items": {
-              "enum": [
-                "SNO"
-              ],
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
-            "name": "feature_ids",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success",
+          "403": {
+            "description": "Forbidden.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/bundle"
-              }
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "400": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
           "500": {
-            "description": "Internal server error",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/operators/bundles/{id}": {
-      "get": {
-        "description": "Retrieves an array of operator properties for the specified bundle when some features are activated.",
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Issues an API token to a service account. The secret of the token is only returned by this request.",
         "tags": [
-          "operators"
+          "service_accounts"
         ],
-        "summary": "Get operator properties for a bundle",
-        "operationId": "V2GetBundle",
+        "operationId": "v2CreateServiceAccountToken",
         "parameters": [
           {
             "type": "string",
-            "description": "Identifier of the bundle, for example, ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai` + "`" + `.",
-            "name": "id",
+            "format": "uuid",
+            "description": "The service account.",
+            "name": "service_account_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "array",
-            "items": {
-              "enum": [
-                "SNO"
-              ],
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
-            "name": "feature_ids",
-            "in": "query"
+            "description": "The API token to issue.",
+            "name": "new-service-account-token-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/service-account-token-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success",
+          "201": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/bundle"
+              "$ref": "#/definitions/service-account-token"
             }
           },
           "400": {
             "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
             }
           },
           "404": {
-            "description": "Bundle not found",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
           "500": {
-            "description": "Internal server error",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -17368,27 +21052,64 @@ func init() {
         }
       }
     },
-    "/v2/release-sources": {
-      "get": {
+    "/v2/service-accounts/{service_account_id}/tokens/{token_id}": {
+      "delete": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
+              "admin"
             ]
           }
         ],
-        "description": "Retrieves openshift release sources configuration.",
+        "description": "Revokes an API token of a service account.",
         "tags": [
-          "versions"
+          "service_accounts"
+        ],
+        "operationId": "v2RevokeServiceAccountToken",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The service account.",
+            "name": "service_account_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The API token to revoke.",
+            "name": "token_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "v2ListReleaseSources",
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/release-sources"
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
@@ -17510,7 +21231,8 @@ func init() {
               "none",
               "nutanix",
               "vsphere",
-              "external"
+              "external",
+              "openstack"
             ],
             "type": "string",
             "description": "The provider platform type.",
@@ -17614,7 +21336,8 @@ func init() {
               "none",
               "nutanix",
               "vsphere",
-              "external"
+              "external",
+              "openstack"
             ],
             "type": "string",
             "description": "The provider platform type.",
@@ -17970,6 +21693,7 @@ func init() {
         "ignition_endpoint_token": {
           "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url (DEPRECATED use request_headers to pass this token).",
           "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
           "x-nullable": true
         },
         "request_headers": {
@@ -18023,6 +21747,80 @@ func init() {
         "MULTIARCH_RELEASE_IMAGE"
       ]
     },
+    "audit-event": {
+      "type": "object",
+      "required": [
+        "id",
+        "created_at",
+        "method",
+        "path",
+        "status_code"
+      ],
+      "properties": {
+        "action": {
+          "description": "The API operation of the request.",
+          "type": "string"
+        },
+        "created_at": {
+          "description": "The time the request was received.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "id": {
+          "description": "Unique identifier of the audit event.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey;autoIncrement\""
+        },
+        "method": {
+          "description": "The HTTP method of the request.",
+          "type": "string"
+        },
+        "org_id": {
+          "description": "The organization of the user that sent the request.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "path": {
+          "description": "The path of the request.",
+          "type": "string"
+        },
+        "request_diff": {
+          "description": "The body of the request in JSON format, the values of secret fields such as pull secrets and passwords are redacted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "request_id": {
+          "description": "Unique identifier of the request.",
+          "type": "string"
+        },
+        "resource_id": {
+          "description": "Unique identifier of the resource the request changed, empty for creations.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "resource_type": {
+          "description": "The type of the resource the request changed, e.g. cluster or host.",
+          "type": "string"
+        },
+        "status_code": {
+          "description": "The HTTP status code of the response.",
+          "type": "integer"
+        },
+        "username": {
+          "description": "The user that sent the request.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "audit-event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/audit-event"
+      }
+    },
     "bind-host-params": {
       "required": [
         "cluster_id"
@@ -18034,6 +21832,20 @@ func init() {
         }
       }
     },
+    "bios": {
+      "type": "object",
+      "properties": {
+        "release_date": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
     "boot": {
       "type": "object",
       "properties": {
@@ -18611,11 +22423,13 @@ func init() {
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
           "x-nullable": true
         },
         "https_proxy": {
           "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
           "x-nullable": true
         },
         "hyperthreading": {
@@ -18656,6 +22470,10 @@ func init() {
           },
           "x-nullable": true
         },
+        "mirror_registry_configuration": {
+          "x-nullable": true,
+          "$ref": "#/definitions/mirror-registry-configuration-params"
+        },
         "name": {
           "description": "Name of the OpenShift cluster.",
           "type": "string",
@@ -18697,7 +22515,8 @@ func init() {
         },
         "pull_secret": {
           "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
         },
         "schedulable_masters": {
           "description": "Schedule workloads on masters",
@@ -18795,6 +22614,12 @@ func init() {
           "format": "double",
           "x-nullable": true
         },
+        "network_throughput_mbps": {
+          "description": "Minimum network throughput in Mbps between the hosts of the role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
         "packet_loss_percentage": {
           "description": "Maximum packet loss allowed at L3 for role.",
           "type": "number",
@@ -18902,7 +22727,10 @@ func init() {
         "oadp-requirements-satisfied",
         "metallb-requirements-satisfied",
         "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
+        "openshift-logging-requirements-satisfied",
+        "release-image-signature-verified",
+        "mirror-registries-contain-release-images",
+        "vsphere-credentials-valid"
       ]
     },
     "cluster_default_config": {
@@ -19068,6 +22896,12 @@ func init() {
           "items": {
             "$ref": "#/definitions/mtu-report"
           }
+        },
+        "throughput_mbps": {
+          "description": "Network throughput in Mbps measured between the hosts by the network-throughput-check step, not set until\nit is measured.\n",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
         }
       }
     },
@@ -19182,7 +23016,8 @@ func init() {
       "properties": {
         "content": {
           "description": "base64 encoded manifest content.",
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
         },
         "file_name": {
           "description": "The name of the manifest to customize the installed OCP cluster.",
@@ -19214,6 +23049,131 @@ func init() {
         }
       }
     },
+    "custom-release-image": {
+      "type": "object",
+      "required": [
+        "id",
+        "url",
+        "openshift_version",
+        "version",
+        "cpu_architecture"
+      ],
+      "properties": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image, multi for multi-architecture images.",
+          "type": "string",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ]
+        },
+        "cpu_architectures": {
+          "description": "List of CPU architectures provided by the image.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster, from the release metadata.",
+          "type": "string"
+        },
+        "org_id": {
+          "description": "The organization that the release image is available to.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"uniqueIndex:idx_custom_release_images_org_id_url\""
+        },
+        "url": {
+          "description": "The release image.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"uniqueIndex:idx_custom_release_images_org_id_url\""
+        },
+        "user_name": {
+          "description": "The user that registered the release image.",
+          "type": "string"
+        },
+        "version": {
+          "description": "OCP version from the release metadata.",
+          "type": "string"
+        }
+      }
+    },
+    "custom-release-image-create-params": {
+      "type": "object",
+      "required": [
+        "url",
+        "pull_secret"
+      ],
+      "properties": {
+        "pull_secret": {
+          "description": "The pull secret used to read the metadata of the release image. It is not stored.",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
+        },
+        "url": {
+          "description": "The release image to register.",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "custom-release-images": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/custom-release-image"
+      }
+    },
+    "custom_diagnostic_result": {
+      "description": "The result of the last run of a custom diagnostic step on a host.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The standard error of the step, truncated to 64KiB.",
+          "type": "string"
+        },
+        "exit_code": {
+          "description": "The exit code of the step.",
+          "type": "integer"
+        },
+        "name": {
+          "description": "The name of the custom diagnostic step.",
+          "type": "string"
+        },
+        "output": {
+          "description": "The standard output of the step, truncated to 64KiB.",
+          "type": "string"
+        },
+        "updated_at": {
+          "description": "The time the result was reported by the host.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "dhcp_allocation_request": {
       "type": "object",
       "required": [
@@ -19315,6 +23275,9 @@ func init() {
         "hctl": {
           "type": "string"
         },
+        "health": {
+          "$ref": "#/definitions/disk_health"
+        },
         "holders": {
           "description": "A comma-separated list of disk names that this disk belongs to",
           "type": "string"
@@ -19416,12 +23379,13 @@ func init() {
           ]
         },
         "mode": {
-          "description": "The disk encryption mode to use.",
+          "description": "The disk encryption mode to use. The threshold mode combines the TPM and the Tang servers with Shamir Secret\nSharing, according to threshold_spec.\n",
           "type": "string",
           "default": "tpmv2",
           "enum": [
             "tpmv2",
-            "tang"
+            "tang",
+            "threshold"
           ]
         },
         "tang_servers": {
@@ -19429,6 +23393,12 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\"",
           "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
+        },
+        "threshold_spec": {
+          "description": "JSON-formatted string containing the unlock policy of the threshold mode. The disk is unlocked when threshold\nof its pins are available: the TPM, if tpm2 is set, and the Tang servers, which are available when\ntang_threshold of them are reachable.\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"threshold\":2,\"tpm2\":true,\"tang_threshold\":1}"
         }
       },
       "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
@@ -19458,6 +23428,42 @@ func init() {
         }
       }
     },
+    "disk_health": {
+      "description": "The health of a disk, derived by the service from the SMART data the agent reports in the smart field of the\ndisk. Not set when the disk doesn't report SMART data.\n",
+      "type": "object",
+      "properties": {
+        "media_errors": {
+          "description": "Number of unrecovered data integrity errors of an NVMe disk.",
+          "type": "integer"
+        },
+        "pending_sectors": {
+          "description": "Number of unstable sectors that are waiting to be remapped.",
+          "type": "integer"
+        },
+        "percentage_used": {
+          "description": "Estimate of the percentage of the life of an NVMe disk that is used.",
+          "type": "integer"
+        },
+        "power_on_hours": {
+          "type": "integer"
+        },
+        "predicted_failure": {
+          "description": "Whether the disk predicts its own failure, because its overall health self-assessment failed or one of its\nattributes is below its threshold.\n",
+          "type": "boolean"
+        },
+        "reallocated_sectors": {
+          "description": "Number of sectors that the disk remapped because of read or write errors.",
+          "type": "integer"
+        },
+        "smart_passed": {
+          "description": "The result of the overall SMART health self-assessment of the disk.",
+          "type": "boolean"
+        },
+        "temperature_celsius": {
+          "type": "integer"
+        }
+      }
+    },
     "disk_info": {
       "type": "object",
       "properties": {
@@ -19752,6 +23758,7 @@ func init() {
         "BAREMETAL_PLATFORM",
         "NONE_PLATFORM",
         "VSPHERE_INTEGRATION",
+        "OPENSTACK_INTEGRATION",
         "DUAL_STACK_VIPS",
         "CLUSTER_MANAGED_NETWORKING",
         "USER_MANAGED_NETWORKING",
@@ -19813,7 +23820,8 @@ func init() {
         },
         "password": {
           "description": "The password to connect to the host's BMC.",
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
         },
         "username": {
           "description": "The username to connect to the host's BMC.",
@@ -19910,6 +23918,14 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "bmc_address": {
+          "description": "The address of the BMC of the host, set with its BMC credentials.",
+          "type": "string"
+        },
+        "bmc_credentials_set": {
+          "description": "True if the credentials of the BMC of the host are set.",
+          "type": "boolean"
+        },
         "bootstrap": {
           "type": "boolean"
         },
@@ -19948,6 +23964,11 @@ func init() {
             "type": "Time"
           }
         },
+        "custom_diagnostics": {
+          "description": "Contains a serialized list of custom_diagnostic_result",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "deleted_at": {
           "description": "swagger:ignore",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
@@ -20074,6 +24095,11 @@ func init() {
           ],
           "x-nullable": true
         },
+        "mirror_registry_check": {
+          "description": "Contains a serialized mirror_registry_check_response",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "node_labels": {
           "description": "Json containing node's labels.",
           "type": "string",
@@ -20202,6 +24228,35 @@ func init() {
         }
       }
     },
+    "host-bmc-credentials": {
+      "type": "object",
+      "required": [
+        "address",
+        "username",
+        "password"
+      ],
+      "properties": {
+        "address": {
+          "description": "The address of the BMC, e.g. redfish+https://192.0.2.10/redfish/v1/Systems/1. Only Redfish BMCs are supported, IPMI addresses (ipmi://) are rejected, use the Redfish address of the BMC instead. The redfish scheme uses https. When the path of a Redfish address doesn't point to a system, the first system of the BMC is used.",
+          "type": "string"
+        },
+        "disable_certificate_verification": {
+          "description": "Whether the certificate of a BMC reached with https is verified.",
+          "type": "boolean",
+          "default": false
+        },
+        "password": {
+          "type": "string",
+          "format": "password",
+          "minLength": 1,
+          "x-go-custom-tag": "secret:\"true\""
+        },
+        "username": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
     "host-create-params": {
       "type": "object",
       "required": [
@@ -20220,7 +24275,8 @@ func init() {
     "host-ignition-params": {
       "properties": {
         "config": {
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
         }
       }
     },
@@ -20230,6 +24286,34 @@ func init() {
         "$ref": "#/definitions/host"
       }
     },
+    "host-node-removal-params": {
+      "type": "object",
+      "properties": {
+        "drain_timeout": {
+          "description": "How long to wait for the node to drain, in seconds, before removing it anyway. Defaults to 10 minutes.",
+          "type": "integer",
+          "minimum": 0
+        }
+      }
+    },
+    "host-power-action-params": {
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "description": "The power action. boot-from-iso mounts the discovery ISO of the infra-env of the host as virtual media, sets it as the next boot device and powers the host on, or restarts it when it is already on.",
+          "type": "string",
+          "enum": [
+            "power-on",
+            "power-off",
+            "reboot",
+            "boot-from-iso"
+          ]
+        }
+      }
+    },
     "host-progress": {
       "type": "object",
       "properties": {
@@ -20311,6 +24395,37 @@ func init() {
         "Failed"
       ]
     },
+    "host-static-network-config-generate-params": {
+      "type": "object",
+      "properties": {
+        "apply": {
+          "description": "Whether to replace the static network configuration of the host in its infra-env with the generated one.",
+          "type": "boolean",
+          "default": false
+        },
+        "bonds": {
+          "description": "Bonds to create from the physical interfaces of the host.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-bond"
+          }
+        },
+        "dns_servers": {
+          "description": "DNS servers of the host, the discovered inventory doesn't contain them.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "vlans": {
+          "description": "VLANs to create on the physical interfaces or bonds of the host.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-vlan"
+          }
+        }
+      }
+    },
     "host-type-hardware-requirements": {
       "type": "object",
       "properties": {
@@ -20388,6 +24503,7 @@ func init() {
         "ignition_endpoint_token": {
           "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
           "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
           "x-nullable": true
         },
         "machine_config_pool_name": {
@@ -20436,6 +24552,7 @@ func init() {
         "cnv-requirements-satisfied",
         "sufficient-network-latency-requirement-for-role",
         "sufficient-packet-loss-requirement-for-role",
+        "sufficient-network-throughput-requirement-for-role",
         "has-default-route",
         "api-domain-name-resolved-correctly",
         "api-int-domain-name-resolved-correctly",
@@ -20448,6 +24565,8 @@ func init() {
         "vsphere-disk-uuid-enabled",
         "compatible-agent",
         "no-skip-installation-disk",
+        "installation-disk-healthy",
+        "firmware-compliant",
         "no-skip-missing-disk",
         "no-ip-collisions-in-network",
         "no-iscsi-nic-belongs-to-machine-cidr",
@@ -20886,7 +25005,8 @@ func init() {
         },
         "ignition_config_override": {
           "description": "JSON formatted string containing the user overrides for the initial ignition config.",
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
         },
         "image_type": {
           "$ref": "#/definitions/image_type"
@@ -20894,6 +25014,10 @@ func init() {
         "kernel_arguments": {
           "$ref": "#/definitions/kernel_arguments"
         },
+        "mirror_registry_configuration": {
+          "x-nullable": true,
+          "$ref": "#/definitions/mirror-registry-configuration-params"
+        },
         "name": {
           "description": "Name of the infra-env.",
           "type": "string"
@@ -20907,7 +25031,8 @@ func init() {
         },
         "pull_secret": {
           "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
         },
         "rendezvous_ip": {
           "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
@@ -20950,7 +25075,8 @@ func init() {
         },
         "ignition_config_override": {
           "description": "JSON formatted string containing the user overrides for the initial ignition config.",
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
         },
         "image_type": {
           "$ref": "#/definitions/image_type"
@@ -20958,6 +25084,10 @@ func init() {
         "kernel_arguments": {
           "$ref": "#/definitions/kernel_arguments"
         },
+        "mirror_registry_configuration": {
+          "x-nullable": true,
+          "$ref": "#/definitions/mirror-registry-configuration-params"
+        },
         "openshift_version": {
           "description": "Version of the OS image",
           "type": "string",
@@ -20968,7 +25098,8 @@ func init() {
         },
         "pull_secret": {
           "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
         },
         "rendezvous_ip": {
           "description": "The IP address of the host that will act as the rendezvous (bootstrap) node for agent-based installations.\nThis is optional for disconnected-iso image type and specifies which host will run the assisted service\nduring the bootstrap phase. All other hosts will connect to this IP to coordinate the installation.",
@@ -20989,6 +25120,40 @@ func init() {
         }
       }
     },
+    "infra-env-virtual-media-boot": {
+      "type": "object",
+      "properties": {
+        "bmcs": {
+          "description": "The status of the boot of each BMC.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/virtual-media-boot-bmc-status"
+          }
+        },
+        "started_at": {
+          "description": "The time the virtual media boot was requested.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "infra-env-virtual-media-boot-params": {
+      "type": "object",
+      "required": [
+        "bmcs"
+      ],
+      "properties": {
+        "bmcs": {
+          "description": "The BMCs of the servers to boot from the discovery ISO. Only Redfish BMCs are supported.",
+          "type": "array",
+          "maxItems": 100,
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/host-bmc-credentials"
+          }
+        }
+      }
+    },
     "infra_error": {
       "type": "object",
       "required": [
@@ -21204,6 +25369,10 @@ func init() {
         "speed_mbps": {
           "type": "integer"
         },
+        "sriov_total_vfs": {
+          "description": "The number of SR-IOV virtual functions that the interface supports, 0 if it doesn't support SR-IOV.",
+          "type": "integer"
+        },
         "type": {
           "type": "string"
         },
@@ -21215,6 +25384,9 @@ func init() {
     "inventory": {
       "type": "object",
       "properties": {
+        "bios": {
+          "$ref": "#/definitions/bios"
+        },
         "bmc_address": {
           "type": "string"
         },
@@ -21588,6 +25760,85 @@ func init() {
         "meminfo"
       ]
     },
+    "mirror-registry-configuration-params": {
+      "description": "Mirror registries that the images of the release are pulled from, instead of the mirror registries\nconfigured for the service.",
+      "type": "object",
+      "properties": {
+        "ca_bundle_crt": {
+          "description": "PEM-encoded X.509 certificate bundle used to trust the mirror registries.",
+          "type": "string",
+          "maxLength": 65535
+        },
+        "registries_conf": {
+          "description": "Content of a registries.conf file (TOML) that lists the mirrors of the registries. Only the registries\nwith mirrors are taken into account. An empty value removes the mirror registry configuration.",
+          "type": "string",
+          "maxLength": 65535
+        }
+      }
+    },
+    "mirror_registry_check_request": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "Images of the release payload, referenced by digest, that must resolve through the mirror registries\nconfigured on the host.\n",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "timeout": {
+          "description": "Maximum number of seconds to spend checking the images.",
+          "type": "integer"
+        }
+      }
+    },
+    "mirror_registry_check_response": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mirror_registry_image_status"
+          }
+        }
+      }
+    },
+    "mirror_registry_image_result": {
+      "description": "Whether the image resolves through the mirror registries. Missing means that the registries reported\nthat the image doesn't exist, failure means that the image couldn't be checked.\n",
+      "type": "string",
+      "enum": [
+        "available",
+        "missing",
+        "failure"
+      ]
+    },
+    "mirror_registry_image_status": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The error that occurred while checking the image, if the result is failure.",
+          "type": "string"
+        },
+        "mirror": {
+          "description": "The mirror the image was resolved through, empty if it couldn't be resolved.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The image reference, as sent in the request.",
+          "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/mirror_registry_image_result"
+        }
+      }
+    },
     "monitored-operator": {
       "type": "object",
       "properties": {
@@ -21682,6 +25933,71 @@ func init() {
         }
       }
     },
+    "network_throughput_check_request": {
+      "type": "object",
+      "required": [
+        "port",
+        "duration",
+        "remote_hosts"
+      ],
+      "properties": {
+        "duration": {
+          "description": "Number of seconds to send traffic to each remote host for.",
+          "type": "integer"
+        },
+        "port": {
+          "description": "The TCP port of the throughput server. The agent starts the server on this port if it isn't running yet,\nso that the other hosts can measure the throughput to this host.\n",
+          "type": "integer"
+        },
+        "remote_hosts": {
+          "description": "The hosts to measure the throughput to, one after the other. May be empty.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network_throughput_remote_host"
+          }
+        }
+      }
+    },
+    "network_throughput_check_response": {
+      "type": "object",
+      "required": [
+        "remote_hosts"
+      ],
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network_throughput_remote_host"
+          }
+        }
+      }
+    },
+    "network_throughput_remote_host": {
+      "type": "object",
+      "required": [
+        "host_id",
+        "ip_address"
+      ],
+      "properties": {
+        "error": {
+          "description": "The error that occurred while measuring the throughput, set in the response.",
+          "type": "string"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip_address": {
+          "description": "The address of the throughput server of the remote host.",
+          "type": "string"
+        },
+        "throughput_mbps": {
+          "description": "The measured throughput in Mbps, set in the response.",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
     "next_step_cmd_request": {
       "type": "object",
       "required": [
@@ -22019,6 +26335,10 @@ func init() {
         },
         "type": {
           "$ref": "#/definitions/platform_type"
+        },
+        "vsphere": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform_vsphere"
         }
       },
       "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:platform_\""
@@ -22053,9 +26373,56 @@ func init() {
         "nutanix",
         "vsphere",
         "none",
-        "external"
+        "external",
+        "openstack"
       ]
     },
+    "platform_vsphere": {
+      "description": "The vCenter server and the vSphere objects that the cluster is installed on. When they are set, the service checks\nthem before the installation and writes them to the install config instead of placeholders.",
+      "type": "object",
+      "properties": {
+        "cluster": {
+          "description": "The name of the vSphere cluster that the hosts belong to.",
+          "type": "string"
+        },
+        "datacenter": {
+          "description": "The name of the datacenter.",
+          "type": "string"
+        },
+        "default_datastore": {
+          "description": "The name of the datastore used for dynamic provisioning of the persistent volumes.",
+          "type": "string"
+        },
+        "folder": {
+          "description": "The absolute path of an existing folder for the virtual machines, for example /datacenter/vm/folder. The\ninstaller creates a folder named after the cluster when it isn't set.",
+          "type": "string"
+        },
+        "network": {
+          "description": "The name of the network that the hosts are connected to.",
+          "type": "string"
+        },
+        "password": {
+          "description": "The password of the vCenter user. It is stored apart from the cluster and is never returned.",
+          "type": "string",
+          "format": "password",
+          "x-go-custom-tag": "gorm:\"-\" secret:\"true\""
+        },
+        "password_set": {
+          "description": "True if the password of the vCenter user is set.",
+          "type": "boolean",
+          "readOnly": true
+        },
+        "username": {
+          "description": "The name of the vCenter user.",
+          "type": "string"
+        },
+        "vcenter": {
+          "description": "The fully-qualified hostname or IP address of the vCenter server.",
+          "type": "string"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:vsphere_\""
+    },
     "preflight-hardware-requirements": {
       "type": "object",
       "properties": {
@@ -22095,12 +26462,13 @@ func init() {
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
           "x-nullable": true
         },
         "https_proxy": {
           "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\"",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\" secret:\"true\"",
           "x-nullable": true
         },
         "no_proxy": {
@@ -22111,6 +26479,25 @@ func init() {
       },
       "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:proxy_\""
     },
+    "quota-usage": {
+      "type": "object",
+      "required": [
+        "org_id",
+        "resources"
+      ],
+      "properties": {
+        "org_id": {
+          "description": "The organization that the usage belongs to.",
+          "type": "string"
+        },
+        "resources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resource-quota-usage"
+          }
+        }
+      }
+    },
     "reboot_for_reclaim_request": {
       "description": "Information sent to the agent for rebooting a host into discovery.",
       "type": "object",
@@ -22250,6 +26637,34 @@ func init() {
         "$ref": "#/definitions/release-source"
       }
     },
+    "resource-quota-usage": {
+      "type": "object",
+      "required": [
+        "resource",
+        "used",
+        "limit"
+      ],
+      "properties": {
+        "limit": {
+          "description": "The quota of the resource, 0 if it is unlimited.",
+          "type": "integer"
+        },
+        "resource": {
+          "description": "The limited resource: the clusters that aren't installed yet, the registered hosts, and the cluster\ninstallations and discovery image generations of the last 24 hours.\n",
+          "type": "string",
+          "enum": [
+            "clusters",
+            "hosts",
+            "installs-per-day",
+            "iso-generations-per-day"
+          ]
+        },
+        "used": {
+          "description": "The amount of the resource that the organization uses.",
+          "type": "integer"
+        }
+      }
+    },
     "route": {
       "type": "object",
       "properties": {
@@ -22286,6 +26701,158 @@ func init() {
         "Disabled"
       ]
     },
+    "service-account": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "created_at"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "description": {
+          "description": "Description of the service account.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the service account.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "name": {
+          "description": "Name of the service account, the user name of the requests authenticated by its tokens.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"uniqueIndex\""
+        }
+      }
+    },
+    "service-account-create-params": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "description": {
+          "description": "Description of the service account.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the service account.",
+          "type": "string",
+          "maxLength": 63,
+          "pattern": "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+        }
+      }
+    },
+    "service-account-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/service-account"
+      }
+    },
+    "service-account-token": {
+      "type": "object",
+      "required": [
+        "id",
+        "service_account_id",
+        "name",
+        "scope",
+        "created_at"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "expires_at": {
+          "description": "The time the API token expires, never if it isn't set.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Unique identifier of the API token.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "last_used_at": {
+          "description": "The last time the API token authenticated a request, at a granularity of a minute.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the API token.",
+          "type": "string"
+        },
+        "revoked_at": {
+          "description": "The time the API token was revoked.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "scope": {
+          "$ref": "#/definitions/service-account-token-scope"
+        },
+        "service_account_id": {
+          "description": "The service account that the API token was issued to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "token": {
+          "description": "The secret of the API token. It is only returned when the token is issued, and it isn't stored.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"-\""
+        }
+      }
+    },
+    "service-account-token-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "scope"
+      ],
+      "properties": {
+        "expires_in_days": {
+          "description": "The number of days until the API token expires, 0 for a token that never expires.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "name": {
+          "description": "Name of the API token.",
+          "type": "string",
+          "maxLength": 256
+        },
+        "scope": {
+          "$ref": "#/definitions/service-account-token-scope"
+        }
+      }
+    },
+    "service-account-token-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/service-account-token"
+      }
+    },
+    "service-account-token-scope": {
+      "description": "The permissions of an API token. read-only tokens can only retrieve resources, cluster-admin tokens can manage\nall the resources, including the service accounts.\n",
+      "type": "string",
+      "enum": [
+        "read-only",
+        "cluster-admin"
+      ]
+    },
     "service_network": {
       "description": "IP address block for service IP blocks.",
       "type": "object",
@@ -22313,6 +26880,177 @@ func init() {
         "unreachable"
       ]
     },
+    "static-network-config-bond": {
+      "type": "object",
+      "required": [
+        "name",
+        "ports"
+      ],
+      "properties": {
+        "mode": {
+          "description": "Bonding mode of the bond.",
+          "type": "string",
+          "default": "active-backup",
+          "enum": [
+            "balance-rr",
+            "active-backup",
+            "balance-xor",
+            "broadcast",
+            "802.3ad",
+            "balance-tlb",
+            "balance-alb"
+          ]
+        },
+        "name": {
+          "description": "Name of the bond interface.",
+          "type": "string",
+          "pattern": "^bond[0-9]+$"
+        },
+        "ports": {
+          "description": "Names of the physical interfaces of the bond, as discovered in the host inventory.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "static-network-config-file": {
+      "type": "object",
+      "properties": {
+        "contents": {
+          "description": "The contents of the file.",
+          "type": "string"
+        },
+        "path": {
+          "description": "The path of the file, relative to the directory of the host.",
+          "type": "string"
+        }
+      }
+    },
+    "static-network-config-host-validation": {
+      "type": "object",
+      "required": [
+        "host_index",
+        "valid"
+      ],
+      "properties": {
+        "errors": {
+          "description": "The errors found in the configuration of the host.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-validation-error"
+          }
+        },
+        "host_index": {
+          "description": "The index of the host in the static network configuration.",
+          "type": "integer"
+        },
+        "nmconnection_files": {
+          "description": "The NetworkManager keyfiles generated by nmstate for the host, when its configuration is valid.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-file"
+          }
+        },
+        "valid": {
+          "description": "Whether the configuration of the host is valid.",
+          "type": "boolean"
+        }
+      }
+    },
+    "static-network-config-validation": {
+      "type": "object",
+      "required": [
+        "valid",
+        "hosts"
+      ],
+      "properties": {
+        "hosts": {
+          "description": "The validation of each host, in the order of the static network configuration.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-host-validation"
+          }
+        },
+        "valid": {
+          "description": "Whether the configuration of all the hosts is valid.",
+          "type": "boolean"
+        }
+      }
+    },
+    "static-network-config-validation-error": {
+      "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "The kind of the error.",
+          "type": "string",
+          "enum": [
+            "invalid-yaml",
+            "missing-mac-mapping",
+            "duplicate-mac-mapping",
+            "duplicate-ip",
+            "ip-outside-subnet",
+            "invalid-route",
+            "nmstate-error"
+          ]
+        },
+        "interface": {
+          "description": "The interface of the error, empty for errors of the whole host.",
+          "type": "string"
+        },
+        "message": {
+          "description": "The description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "static-network-config-validation-params": {
+      "type": "object",
+      "required": [
+        "static_network_config"
+      ],
+      "properties": {
+        "expected_subnets": {
+          "description": "The subnets the static addresses of the hosts are expected in, usually the machine networks of the cluster. The addresses of a family without expected subnets aren't checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/subnet"
+          }
+        },
+        "static_network_config": {
+          "description": "The static network configuration of the hosts, as set in the infra-env.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        }
+      }
+    },
+    "static-network-config-vlan": {
+      "type": "object",
+      "required": [
+        "base_interface",
+        "id"
+      ],
+      "properties": {
+        "base_interface": {
+          "description": "Name of the physical interface or the bond that carries the VLAN.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The VLAN ID.",
+          "type": "integer",
+          "maximum": 4094,
+          "minimum": 1
+        }
+      }
+    },
     "step": {
       "type": "object",
       "properties": {
@@ -22371,7 +27109,9 @@ func init() {
         "upgrade-agent",
         "download-boot-artifacts",
         "reboot-for-reclaim",
-        "verify-vips"
+        "verify-vips",
+        "mirror-registry-check",
+        "network-throughput-check"
       ]
     },
     "steps": {
@@ -22497,6 +27237,7 @@ func init() {
         "updated_content": {
           "description": "The new base64 encoded manifest content.",
           "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
           "x-nullable": true
         },
         "updated_file_name": {
@@ -22652,11 +27393,13 @@ func init() {
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
           "x-nullable": true
         },
         "https_proxy": {
           "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
           "x-nullable": true
         },
         "hyperthreading": {
@@ -22704,6 +27447,10 @@ func init() {
           },
           "x-nullable": true
         },
+        "mirror_registry_configuration": {
+          "x-nullable": true,
+          "$ref": "#/definitions/mirror-registry-configuration-params"
+        },
         "name": {
           "description": "OpenShift cluster name.",
           "type": "string",
@@ -22738,6 +27485,7 @@ func init() {
         "pull_secret": {
           "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
           "type": "string",
+          "x-go-custom-tag": "secret:\"true\"",
           "x-nullable": true
         },
         "schedulable_masters": {
@@ -22879,6 +27627,31 @@ func init() {
         "failed",
         "succeeded"
       ]
+    },
+    "virtual-media-boot-bmc-status": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "The address of the BMC.",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "in-progress",
+            "succeeded",
+            "failed"
+          ]
+        },
+        "status_info": {
+          "description": "The error of a failed boot.",
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
     }
   },
   "securityDefinitions": {
@@ -22926,6 +27699,10 @@ func init() {
       "description": "Agent-driven installation",
       "name": "Assisted installation"
     },
+    {
+      "description": "Audit log of the changes made through the API.",
+      "name": "audit"
+    },
     {
       "description": "Events related to a cluster installation.",
       "name": "events"
@@ -22946,6 +27723,10 @@ func init() {
       "description": "Information regarding supported operators.",
       "name": "operators"
     },
+    {
+      "description": "Service accounts and their API tokens, for local authentication.",
+      "name": "service_accounts"
+    },
     {
       "description": "Information regarding versions.",
       "name": "versions"
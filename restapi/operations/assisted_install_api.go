@@ -19,11 +19,13 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/openshift/assisted-service/restapi/operations/audit"
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/openshift/assisted-service/restapi/operations/service_accounts"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
 )
 
@@ -174,9 +176,24 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2CompleteInstallationHandler: installer.V2CompleteInstallationHandlerFunc(func(params installer.V2CompleteInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2CompleteInstallation has not yet been implemented")
 		}),
+		ServiceAccountsV2CreateServiceAccountHandler: service_accounts.V2CreateServiceAccountHandlerFunc(func(params service_accounts.V2CreateServiceAccountParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation service_accounts.V2CreateServiceAccount has not yet been implemented")
+		}),
+		ServiceAccountsV2CreateServiceAccountTokenHandler: service_accounts.V2CreateServiceAccountTokenHandlerFunc(func(params service_accounts.V2CreateServiceAccountTokenParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation service_accounts.V2CreateServiceAccountToken has not yet been implemented")
+		}),
+		InstallerV2DeleteHostBmcCredentialsHandler: installer.V2DeleteHostBmcCredentialsHandlerFunc(func(params installer.V2DeleteHostBmcCredentialsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2DeleteHostBmcCredentials has not yet been implemented")
+		}),
+		ServiceAccountsV2DeleteServiceAccountHandler: service_accounts.V2DeleteServiceAccountHandlerFunc(func(params service_accounts.V2DeleteServiceAccountParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation service_accounts.V2DeleteServiceAccount has not yet been implemented")
+		}),
 		InstallerV2DeregisterClusterHandler: installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DeregisterCluster has not yet been implemented")
 		}),
+		VersionsV2DeregisterCustomReleaseImageHandler: versions.V2DeregisterCustomReleaseImageHandlerFunc(func(params versions.V2DeregisterCustomReleaseImageParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation versions.V2DeregisterCustomReleaseImage has not yet been implemented")
+		}),
 		InstallerV2DeregisterHostHandler: installer.V2DeregisterHostHandlerFunc(func(params installer.V2DeregisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DeregisterHost has not yet been implemented")
 		}),
@@ -189,6 +206,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2DownloadInfraEnvFilesHandler: installer.V2DownloadInfraEnvFilesHandlerFunc(func(params installer.V2DownloadInfraEnvFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DownloadInfraEnvFiles has not yet been implemented")
 		}),
+		InstallerV2GenerateHostStaticNetworkConfigHandler: installer.V2GenerateHostStaticNetworkConfigHandlerFunc(func(params installer.V2GenerateHostStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GenerateHostStaticNetworkConfig has not yet been implemented")
+		}),
 		InstallerV2GetClusterHandler: installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetCluster has not yet been implemented")
 		}),
@@ -204,27 +224,45 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2GetIgnoredValidationsHandler: installer.V2GetIgnoredValidationsHandlerFunc(func(params installer.V2GetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetIgnoredValidations has not yet been implemented")
 		}),
+		InstallerV2GetInfraEnvVirtualMediaBootHandler: installer.V2GetInfraEnvVirtualMediaBootHandlerFunc(func(params installer.V2GetInfraEnvVirtualMediaBootParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetInfraEnvVirtualMediaBoot has not yet been implemented")
+		}),
 		InstallerV2GetNextStepsHandler: installer.V2GetNextStepsHandlerFunc(func(params installer.V2GetNextStepsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetNextSteps has not yet been implemented")
 		}),
 		InstallerV2GetPreflightRequirementsHandler: installer.V2GetPreflightRequirementsHandlerFunc(func(params installer.V2GetPreflightRequirementsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetPreflightRequirements has not yet been implemented")
 		}),
+		InstallerV2GetQuotaUsageHandler: installer.V2GetQuotaUsageHandlerFunc(func(params installer.V2GetQuotaUsageParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetQuotaUsage has not yet been implemented")
+		}),
+		InstallerV2HostPowerActionHandler: installer.V2HostPowerActionHandlerFunc(func(params installer.V2HostPowerActionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2HostPowerAction has not yet been implemented")
+		}),
 		InstallerV2ImportClusterHandler: installer.V2ImportClusterHandlerFunc(func(params installer.V2ImportClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ImportCluster has not yet been implemented")
 		}),
+		InstallerV2InfraEnvVirtualMediaBootHandler: installer.V2InfraEnvVirtualMediaBootHandlerFunc(func(params installer.V2InfraEnvVirtualMediaBootParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2InfraEnvVirtualMediaBoot has not yet been implemented")
+		}),
 		InstallerV2InstallClusterHandler: installer.V2InstallClusterHandlerFunc(func(params installer.V2InstallClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2InstallCluster has not yet been implemented")
 		}),
 		InstallerV2InstallHostHandler: installer.V2InstallHostHandlerFunc(func(params installer.V2InstallHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2InstallHost has not yet been implemented")
 		}),
+		AuditV2ListAuditEventsHandler: audit.V2ListAuditEventsHandlerFunc(func(params audit.V2ListAuditEventsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation audit.V2ListAuditEvents has not yet been implemented")
+		}),
 		InstallerV2ListClustersHandler: installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListClusters has not yet been implemented")
 		}),
 		VersionsV2ListComponentVersionsHandler: versions.V2ListComponentVersionsHandlerFunc(func(params versions.V2ListComponentVersionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.V2ListComponentVersions has not yet been implemented")
 		}),
+		VersionsV2ListCustomReleaseImagesHandler: versions.V2ListCustomReleaseImagesHandlerFunc(func(params versions.V2ListCustomReleaseImagesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation versions.V2ListCustomReleaseImages has not yet been implemented")
+		}),
 		EventsV2ListEventsHandler: events.V2ListEventsHandlerFunc(func(params events.V2ListEventsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.V2ListEvents has not yet been implemented")
 		}),
@@ -234,6 +272,12 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		VersionsV2ListReleaseSourcesHandler: versions.V2ListReleaseSourcesHandlerFunc(func(params versions.V2ListReleaseSourcesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.V2ListReleaseSources has not yet been implemented")
 		}),
+		ServiceAccountsV2ListServiceAccountTokensHandler: service_accounts.V2ListServiceAccountTokensHandlerFunc(func(params service_accounts.V2ListServiceAccountTokensParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation service_accounts.V2ListServiceAccountTokens has not yet been implemented")
+		}),
+		ServiceAccountsV2ListServiceAccountsHandler: service_accounts.V2ListServiceAccountsHandlerFunc(func(params service_accounts.V2ListServiceAccountsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation service_accounts.V2ListServiceAccounts has not yet been implemented")
+		}),
 		VersionsV2ListSupportedOpenshiftVersionsHandler: versions.V2ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.V2ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.V2ListSupportedOpenshiftVersions has not yet been implemented")
 		}),
@@ -243,12 +287,18 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2RegisterClusterHandler: installer.V2RegisterClusterHandlerFunc(func(params installer.V2RegisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterCluster has not yet been implemented")
 		}),
+		VersionsV2RegisterCustomReleaseImageHandler: versions.V2RegisterCustomReleaseImageHandlerFunc(func(params versions.V2RegisterCustomReleaseImageParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation versions.V2RegisterCustomReleaseImage has not yet been implemented")
+		}),
 		InstallerV2RegisterDisconnectedClusterHandler: installer.V2RegisterDisconnectedClusterHandlerFunc(func(params installer.V2RegisterDisconnectedClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterDisconnectedCluster has not yet been implemented")
 		}),
 		InstallerV2RegisterHostHandler: installer.V2RegisterHostHandlerFunc(func(params installer.V2RegisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterHost has not yet been implemented")
 		}),
+		InstallerV2RemoveHostFromClusterHandler: installer.V2RemoveHostFromClusterHandlerFunc(func(params installer.V2RemoveHostFromClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2RemoveHostFromCluster has not yet been implemented")
+		}),
 		OperatorsV2ReportMonitoredOperatorStatusHandler: operators.V2ReportMonitoredOperatorStatusHandlerFunc(func(params operators.V2ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ReportMonitoredOperatorStatus has not yet been implemented")
 		}),
@@ -261,6 +311,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2ResetHostValidationHandler: installer.V2ResetHostValidationHandlerFunc(func(params installer.V2ResetHostValidationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ResetHostValidation has not yet been implemented")
 		}),
+		ServiceAccountsV2RevokeServiceAccountTokenHandler: service_accounts.V2RevokeServiceAccountTokenHandlerFunc(func(params service_accounts.V2RevokeServiceAccountTokenParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation service_accounts.V2RevokeServiceAccountToken has not yet been implemented")
+		}),
 		InstallerV2SetIgnoredValidationsHandler: installer.V2SetIgnoredValidationsHandlerFunc(func(params installer.V2SetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2SetIgnoredValidations has not yet been implemented")
 		}),
@@ -279,6 +332,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UpdateHostHandler: installer.V2UpdateHostHandlerFunc(func(params installer.V2UpdateHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateHost has not yet been implemented")
 		}),
+		InstallerV2UpdateHostBmcCredentialsHandler: installer.V2UpdateHostBmcCredentialsHandlerFunc(func(params installer.V2UpdateHostBmcCredentialsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2UpdateHostBmcCredentials has not yet been implemented")
+		}),
 		InstallerV2UpdateHostIgnitionHandler: installer.V2UpdateHostIgnitionHandlerFunc(func(params installer.V2UpdateHostIgnitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateHostIgnition has not yet been implemented")
 		}),
@@ -294,6 +350,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UploadClusterIngressCertHandler: installer.V2UploadClusterIngressCertHandlerFunc(func(params installer.V2UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UploadClusterIngressCert has not yet been implemented")
 		}),
+		InstallerV2ValidateStaticNetworkConfigHandler: installer.V2ValidateStaticNetworkConfigHandlerFunc(func(params installer.V2ValidateStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ValidateStaticNetworkConfig has not yet been implemented")
+		}),
 
 		// Applies when the "X-Secret-Key" header is set
 		AgentAuthAuth: func(token string) (interface{}, error) {
@@ -472,8 +531,18 @@ type AssistedInstallAPI struct {
 	InstallerV2UploadLogsHandler installer.V2UploadLogsHandler
 	// InstallerV2CompleteInstallationHandler sets the operation handler for the v2 complete installation operation
 	InstallerV2CompleteInstallationHandler installer.V2CompleteInstallationHandler
+	// ServiceAccountsV2CreateServiceAccountHandler sets the operation handler for the v2 create service account operation
+	ServiceAccountsV2CreateServiceAccountHandler service_accounts.V2CreateServiceAccountHandler
+	// ServiceAccountsV2CreateServiceAccountTokenHandler sets the operation handler for the v2 create service account token operation
+	ServiceAccountsV2CreateServiceAccountTokenHandler service_accounts.V2CreateServiceAccountTokenHandler
+	// InstallerV2DeleteHostBmcCredentialsHandler sets the operation handler for the v2 delete host bmc credentials operation
+	InstallerV2DeleteHostBmcCredentialsHandler installer.V2DeleteHostBmcCredentialsHandler
+	// ServiceAccountsV2DeleteServiceAccountHandler sets the operation handler for the v2 delete service account operation
+	ServiceAccountsV2DeleteServiceAccountHandler service_accounts.V2DeleteServiceAccountHandler
 	// InstallerV2DeregisterClusterHandler sets the operation handler for the v2 deregister cluster operation
 	InstallerV2DeregisterClusterHandler installer.V2DeregisterClusterHandler
+	// VersionsV2DeregisterCustomReleaseImageHandler sets the operation handler for the v2 deregister custom release image operation
+	VersionsV2DeregisterCustomReleaseImageHandler versions.V2DeregisterCustomReleaseImageHandler
 	// InstallerV2DeregisterHostHandler sets the operation handler for the v2 deregister host operation
 	InstallerV2DeregisterHostHandler installer.V2DeregisterHostHandler
 	// ManifestsV2DownloadClusterManifestHandler sets the operation handler for the v2 download cluster manifest operation
@@ -482,6 +551,8 @@ type AssistedInstallAPI struct {
 	InstallerV2DownloadHostIgnitionHandler installer.V2DownloadHostIgnitionHandler
 	// InstallerV2DownloadInfraEnvFilesHandler sets the operation handler for the v2 download infra env files operation
 	InstallerV2DownloadInfraEnvFilesHandler installer.V2DownloadInfraEnvFilesHandler
+	// InstallerV2GenerateHostStaticNetworkConfigHandler sets the operation handler for the v2 generate host static network config operation
+	InstallerV2GenerateHostStaticNetworkConfigHandler installer.V2GenerateHostStaticNetworkConfigHandler
 	// InstallerV2GetClusterHandler sets the operation handler for the v2 get cluster operation
 	InstallerV2GetClusterHandler installer.V2GetClusterHandler
 	// InstallerV2GetClusterInstallConfigHandler sets the operation handler for the v2 get cluster install config operation
@@ -492,36 +563,56 @@ type AssistedInstallAPI struct {
 	InstallerV2GetHostIgnitionHandler installer.V2GetHostIgnitionHandler
 	// InstallerV2GetIgnoredValidationsHandler sets the operation handler for the v2 get ignored validations operation
 	InstallerV2GetIgnoredValidationsHandler installer.V2GetIgnoredValidationsHandler
+	// InstallerV2GetInfraEnvVirtualMediaBootHandler sets the operation handler for the v2 get infra env virtual media boot operation
+	InstallerV2GetInfraEnvVirtualMediaBootHandler installer.V2GetInfraEnvVirtualMediaBootHandler
 	// InstallerV2GetNextStepsHandler sets the operation handler for the v2 get next steps operation
 	InstallerV2GetNextStepsHandler installer.V2GetNextStepsHandler
 	// InstallerV2GetPreflightRequirementsHandler sets the operation handler for the v2 get preflight requirements operation
 	InstallerV2GetPreflightRequirementsHandler installer.V2GetPreflightRequirementsHandler
+	// InstallerV2GetQuotaUsageHandler sets the operation handler for the v2 get quota usage operation
+	InstallerV2GetQuotaUsageHandler installer.V2GetQuotaUsageHandler
+	// InstallerV2HostPowerActionHandler sets the operation handler for the v2 host power action operation
+	InstallerV2HostPowerActionHandler installer.V2HostPowerActionHandler
 	// InstallerV2ImportClusterHandler sets the operation handler for the v2 import cluster operation
 	InstallerV2ImportClusterHandler installer.V2ImportClusterHandler
+	// InstallerV2InfraEnvVirtualMediaBootHandler sets the operation handler for the v2 infra env virtual media boot operation
+	InstallerV2InfraEnvVirtualMediaBootHandler installer.V2InfraEnvVirtualMediaBootHandler
 	// InstallerV2InstallClusterHandler sets the operation handler for the v2 install cluster operation
 	InstallerV2InstallClusterHandler installer.V2InstallClusterHandler
 	// InstallerV2InstallHostHandler sets the operation handler for the v2 install host operation
 	InstallerV2InstallHostHandler installer.V2InstallHostHandler
+	// AuditV2ListAuditEventsHandler sets the operation handler for the v2 list audit events operation
+	AuditV2ListAuditEventsHandler audit.V2ListAuditEventsHandler
 	// InstallerV2ListClustersHandler sets the operation handler for the v2 list clusters operation
 	InstallerV2ListClustersHandler installer.V2ListClustersHandler
 	// VersionsV2ListComponentVersionsHandler sets the operation handler for the v2 list component versions operation
 	VersionsV2ListComponentVersionsHandler versions.V2ListComponentVersionsHandler
+	// VersionsV2ListCustomReleaseImagesHandler sets the operation handler for the v2 list custom release images operation
+	VersionsV2ListCustomReleaseImagesHandler versions.V2ListCustomReleaseImagesHandler
 	// EventsV2ListEventsHandler sets the operation handler for the v2 list events operation
 	EventsV2ListEventsHandler events.V2ListEventsHandler
 	// InstallerV2ListHostsHandler sets the operation handler for the v2 list hosts operation
 	InstallerV2ListHostsHandler installer.V2ListHostsHandler
 	// VersionsV2ListReleaseSourcesHandler sets the operation handler for the v2 list release sources operation
 	VersionsV2ListReleaseSourcesHandler versions.V2ListReleaseSourcesHandler
+	// ServiceAccountsV2ListServiceAccountTokensHandler sets the operation handler for the v2 list service account tokens operation
+	ServiceAccountsV2ListServiceAccountTokensHandler service_accounts.V2ListServiceAccountTokensHandler
+	// ServiceAccountsV2ListServiceAccountsHandler sets the operation handler for the v2 list service accounts operation
+	ServiceAccountsV2ListServiceAccountsHandler service_accounts.V2ListServiceAccountsHandler
 	// VersionsV2ListSupportedOpenshiftVersionsHandler sets the operation handler for the v2 list supported openshift versions operation
 	VersionsV2ListSupportedOpenshiftVersionsHandler versions.V2ListSupportedOpenshiftVersionsHandler
 	// InstallerV2PostStepReplyHandler sets the operation handler for the v2 post step reply operation
 	InstallerV2PostStepReplyHandler installer.V2PostStepReplyHandler
 	// InstallerV2RegisterClusterHandler sets the operation handler for the v2 register cluster operation
 	InstallerV2RegisterClusterHandler installer.V2RegisterClusterHandler
+	// VersionsV2RegisterCustomReleaseImageHandler sets the operation handler for the v2 register custom release image operation
+	VersionsV2RegisterCustomReleaseImageHandler versions.V2RegisterCustomReleaseImageHandler
 	// InstallerV2RegisterDisconnectedClusterHandler sets the operation handler for the v2 register disconnected cluster operation
 	InstallerV2RegisterDisconnectedClusterHandler installer.V2RegisterDisconnectedClusterHandler
 	// InstallerV2RegisterHostHandler sets the operation handler for the v2 register host operation
 	InstallerV2RegisterHostHandler installer.V2RegisterHostHandler
+	// InstallerV2RemoveHostFromClusterHandler sets the operation handler for the v2 remove host from cluster operation
+	InstallerV2RemoveHostFromClusterHandler installer.V2RemoveHostFromClusterHandler
 	// OperatorsV2ReportMonitoredOperatorStatusHandler sets the operation handler for the v2 report monitored operator status operation
 	OperatorsV2ReportMonitoredOperatorStatusHandler operators.V2ReportMonitoredOperatorStatusHandler
 	// InstallerV2ResetClusterHandler sets the operation handler for the v2 reset cluster operation
@@ -530,6 +621,8 @@ type AssistedInstallAPI struct {
 	InstallerV2ResetHostHandler installer.V2ResetHostHandler
 	// InstallerV2ResetHostValidationHandler sets the operation handler for the v2 reset host validation operation
 	InstallerV2ResetHostValidationHandler installer.V2ResetHostValidationHandler
+	// ServiceAccountsV2RevokeServiceAccountTokenHandler sets the operation handler for the v2 revoke service account token operation
+	ServiceAccountsV2RevokeServiceAccountTokenHandler service_accounts.V2RevokeServiceAccountTokenHandler
 	// InstallerV2SetIgnoredValidationsHandler sets the operation handler for the v2 set ignored validations operation
 	InstallerV2SetIgnoredValidationsHandler installer.V2SetIgnoredValidationsHandler
 	// EventsV2TriggerEventHandler sets the operation handler for the v2 trigger event operation
@@ -542,6 +635,8 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateClusterLogsProgressHandler installer.V2UpdateClusterLogsProgressHandler
 	// InstallerV2UpdateHostHandler sets the operation handler for the v2 update host operation
 	InstallerV2UpdateHostHandler installer.V2UpdateHostHandler
+	// InstallerV2UpdateHostBmcCredentialsHandler sets the operation handler for the v2 update host bmc credentials operation
+	InstallerV2UpdateHostBmcCredentialsHandler installer.V2UpdateHostBmcCredentialsHandler
 	// InstallerV2UpdateHostIgnitionHandler sets the operation handler for the v2 update host ignition operation
 	InstallerV2UpdateHostIgnitionHandler installer.V2UpdateHostIgnitionHandler
 	// InstallerV2UpdateHostInstallProgressHandler sets the operation handler for the v2 update host install progress operation
@@ -552,6 +647,8 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateHostLogsProgressHandler installer.V2UpdateHostLogsProgressHandler
 	// InstallerV2UploadClusterIngressCertHandler sets the operation handler for the v2 upload cluster ingress cert operation
 	InstallerV2UploadClusterIngressCertHandler installer.V2UploadClusterIngressCertHandler
+	// InstallerV2ValidateStaticNetworkConfigHandler sets the operation handler for the v2 validate static network config operation
+	InstallerV2ValidateStaticNetworkConfigHandler installer.V2ValidateStaticNetworkConfigHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -777,9 +874,24 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2CompleteInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.V2CompleteInstallationHandler")
 	}
+	if o.ServiceAccountsV2CreateServiceAccountHandler == nil {
+		unregistered = append(unregistered, "service_accounts.V2CreateServiceAccountHandler")
+	}
+	if o.ServiceAccountsV2CreateServiceAccountTokenHandler == nil {
+		unregistered = append(unregistered, "service_accounts.V2CreateServiceAccountTokenHandler")
+	}
+	if o.InstallerV2DeleteHostBmcCredentialsHandler == nil {
+		unregistered = append(unregistered, "installer.V2DeleteHostBmcCredentialsHandler")
+	}
+	if o.ServiceAccountsV2DeleteServiceAccountHandler == nil {
+		unregistered = append(unregistered, "service_accounts.V2DeleteServiceAccountHandler")
+	}
 	if o.InstallerV2DeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2DeregisterClusterHandler")
 	}
+	if o.VersionsV2DeregisterCustomReleaseImageHandler == nil {
+		unregistered = append(unregistered, "versions.V2DeregisterCustomReleaseImageHandler")
+	}
 	if o.InstallerV2DeregisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2DeregisterHostHandler")
 	}
@@ -792,6 +904,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2DownloadInfraEnvFilesHandler == nil {
 		unregistered = append(unregistered, "installer.V2DownloadInfraEnvFilesHandler")
 	}
+	if o.InstallerV2GenerateHostStaticNetworkConfigHandler == nil {
+		unregistered = append(unregistered, "installer.V2GenerateHostStaticNetworkConfigHandler")
+	}
 	if o.InstallerV2GetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterHandler")
 	}
@@ -807,27 +922,45 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2GetIgnoredValidationsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetIgnoredValidationsHandler")
 	}
+	if o.InstallerV2GetInfraEnvVirtualMediaBootHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetInfraEnvVirtualMediaBootHandler")
+	}
 	if o.InstallerV2GetNextStepsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetNextStepsHandler")
 	}
 	if o.InstallerV2GetPreflightRequirementsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetPreflightRequirementsHandler")
 	}
+	if o.InstallerV2GetQuotaUsageHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetQuotaUsageHandler")
+	}
+	if o.InstallerV2HostPowerActionHandler == nil {
+		unregistered = append(unregistered, "installer.V2HostPowerActionHandler")
+	}
 	if o.InstallerV2ImportClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2ImportClusterHandler")
 	}
+	if o.InstallerV2InfraEnvVirtualMediaBootHandler == nil {
+		unregistered = append(unregistered, "installer.V2InfraEnvVirtualMediaBootHandler")
+	}
 	if o.InstallerV2InstallClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2InstallClusterHandler")
 	}
 	if o.InstallerV2InstallHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2InstallHostHandler")
 	}
+	if o.AuditV2ListAuditEventsHandler == nil {
+		unregistered = append(unregistered, "audit.V2ListAuditEventsHandler")
+	}
 	if o.InstallerV2ListClustersHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListClustersHandler")
 	}
 	if o.VersionsV2ListComponentVersionsHandler == nil {
 		unregistered = append(unregistered, "versions.V2ListComponentVersionsHandler")
 	}
+	if o.VersionsV2ListCustomReleaseImagesHandler == nil {
+		unregistered = append(unregistered, "versions.V2ListCustomReleaseImagesHandler")
+	}
 	if o.EventsV2ListEventsHandler == nil {
 		unregistered = append(unregistered, "events.V2ListEventsHandler")
 	}
@@ -837,6 +970,12 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.VersionsV2ListReleaseSourcesHandler == nil {
 		unregistered = append(unregistered, "versions.V2ListReleaseSourcesHandler")
 	}
+	if o.ServiceAccountsV2ListServiceAccountTokensHandler == nil {
+		unregistered = append(unregistered, "service_accounts.V2ListServiceAccountTokensHandler")
+	}
+	if o.ServiceAccountsV2ListServiceAccountsHandler == nil {
+		unregistered = append(unregistered, "service_accounts.V2ListServiceAccountsHandler")
+	}
 	if o.VersionsV2ListSupportedOpenshiftVersionsHandler == nil {
 		unregistered = append(unregistered, "versions.V2ListSupportedOpenshiftVersionsHandler")
 	}
@@ -846,12 +985,18 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2RegisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterClusterHandler")
 	}
+	if o.VersionsV2RegisterCustomReleaseImageHandler == nil {
+		unregistered = append(unregistered, "versions.V2RegisterCustomReleaseImageHandler")
+	}
 	if o.InstallerV2RegisterDisconnectedClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterDisconnectedClusterHandler")
 	}
 	if o.InstallerV2RegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterHostHandler")
 	}
+	if o.InstallerV2RemoveHostFromClusterHandler == nil {
+		unregistered = append(unregistered, "installer.V2RemoveHostFromClusterHandler")
+	}
 	if o.OperatorsV2ReportMonitoredOperatorStatusHandler == nil {
 		unregistered = append(unregistered, "operators.V2ReportMonitoredOperatorStatusHandler")
 	}
@@ -864,6 +1009,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2ResetHostValidationHandler == nil {
 		unregistered = append(unregistered, "installer.V2ResetHostValidationHandler")
 	}
+	if o.ServiceAccountsV2RevokeServiceAccountTokenHandler == nil {
+		unregistered = append(unregistered, "service_accounts.V2RevokeServiceAccountTokenHandler")
+	}
 	if o.InstallerV2SetIgnoredValidationsHandler == nil {
 		unregistered = append(unregistered, "installer.V2SetIgnoredValidationsHandler")
 	}
@@ -882,6 +1030,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UpdateHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateHostHandler")
 	}
+	if o.InstallerV2UpdateHostBmcCredentialsHandler == nil {
+		unregistered = append(unregistered, "installer.V2UpdateHostBmcCredentialsHandler")
+	}
 	if o.InstallerV2UpdateHostIgnitionHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateHostIgnitionHandler")
 	}
@@ -897,6 +1048,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UploadClusterIngressCertHandler == nil {
 		unregistered = append(unregistered, "installer.V2UploadClusterIngressCertHandler")
 	}
+	if o.InstallerV2ValidateStaticNetworkConfigHandler == nil {
+		unregistered = append(unregistered, "installer.V2ValidateStaticNetworkConfigHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -1182,6 +1336,22 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/complete-installation"] = installer.NewV2CompleteInstallation(o.context, o.InstallerV2CompleteInstallationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/service-accounts"] = service_accounts.NewV2CreateServiceAccount(o.context, o.ServiceAccountsV2CreateServiceAccountHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/service-accounts/{service_account_id}/tokens"] = service_accounts.NewV2CreateServiceAccountToken(o.context, o.ServiceAccountsV2CreateServiceAccountTokenHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/bmc-credentials"] = installer.NewV2DeleteHostBmcCredentials(o.context, o.InstallerV2DeleteHostBmcCredentialsHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/service-accounts/{service_account_id}"] = service_accounts.NewV2DeleteServiceAccount(o.context, o.ServiceAccountsV2DeleteServiceAccountHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -1189,6 +1359,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/v2/custom-release-images/{custom_release_image_id}"] = versions.NewV2DeregisterCustomReleaseImage(o.context, o.VersionsV2DeregisterCustomReleaseImageHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2DeregisterHost(o.context, o.InstallerV2DeregisterHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1202,6 +1376,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/downloads/files"] = installer.NewV2DownloadInfraEnvFiles(o.context, o.InstallerV2DownloadInfraEnvFilesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config"] = installer.NewV2GenerateHostStaticNetworkConfig(o.context, o.InstallerV2GenerateHostStaticNetworkConfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1225,11 +1403,23 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/actions/virtual-media-boot"] = installer.NewV2GetInfraEnvVirtualMediaBoot(o.context, o.InstallerV2GetInfraEnvVirtualMediaBootHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions"] = installer.NewV2GetNextSteps(o.context, o.InstallerV2GetNextStepsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/preflight-requirements"] = installer.NewV2GetPreflightRequirements(o.context, o.InstallerV2GetPreflightRequirementsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/quota-usage"] = installer.NewV2GetQuotaUsage(o.context, o.InstallerV2GetQuotaUsageHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power"] = installer.NewV2HostPowerAction(o.context, o.InstallerV2HostPowerActionHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1237,6 +1427,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/actions/virtual-media-boot"] = installer.NewV2InfraEnvVirtualMediaBoot(o.context, o.InstallerV2InfraEnvVirtualMediaBootHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/install"] = installer.NewV2InstallCluster(o.context, o.InstallerV2InstallClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1245,6 +1439,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/audit-events"] = audit.NewV2ListAuditEvents(o.context, o.AuditV2ListAuditEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters"] = installer.NewV2ListClusters(o.context, o.InstallerV2ListClustersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1253,6 +1451,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/custom-release-images"] = versions.NewV2ListCustomReleaseImages(o.context, o.VersionsV2ListCustomReleaseImagesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/events"] = events.NewV2ListEvents(o.context, o.EventsV2ListEventsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1265,6 +1467,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/service-accounts/{service_account_id}/tokens"] = service_accounts.NewV2ListServiceAccountTokens(o.context, o.ServiceAccountsV2ListServiceAccountTokensHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/service-accounts"] = service_accounts.NewV2ListServiceAccounts(o.context, o.ServiceAccountsV2ListServiceAccountsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/openshift-versions"] = versions.NewV2ListSupportedOpenshiftVersions(o.context, o.VersionsV2ListSupportedOpenshiftVersionsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1277,11 +1487,19 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/custom-release-images"] = versions.NewV2RegisterCustomReleaseImage(o.context, o.VersionsV2RegisterCustomReleaseImageHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters/disconnected"] = installer.NewV2RegisterDisconnectedCluster(o.context, o.InstallerV2RegisterDisconnectedClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2RegisterHost(o.context, o.InstallerV2RegisterHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/remove-from-cluster"] = installer.NewV2RemoveHostFromCluster(o.context, o.InstallerV2RemoveHostFromClusterHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1298,6 +1516,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}"] = installer.NewV2ResetHostValidation(o.context, o.InstallerV2ResetHostValidationHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/service-accounts/{service_account_id}/tokens/{token_id}"] = service_accounts.NewV2RevokeServiceAccountToken(o.context, o.ServiceAccountsV2RevokeServiceAccountTokenHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1322,6 +1544,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2UpdateHost(o.context, o.InstallerV2UpdateHostHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/bmc-credentials"] = installer.NewV2UpdateHostBmcCredentials(o.context, o.InstallerV2UpdateHostBmcCredentialsHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -1342,6 +1568,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/uploads/ingress-cert"] = installer.NewV2UploadClusterIngressCert(o.context, o.InstallerV2UploadClusterIngressCertHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/validate-static-network"] = installer.NewV2ValidateStaticNetworkConfig(o.context, o.InstallerV2ValidateStaticNetworkConfigHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
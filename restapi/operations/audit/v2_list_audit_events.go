@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListAuditEventsHandlerFunc turns a function with the right signature into a v2 list audit events handler
+type V2ListAuditEventsHandlerFunc func(V2ListAuditEventsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListAuditEventsHandlerFunc) Handle(params V2ListAuditEventsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListAuditEventsHandler interface for that can handle valid v2 list audit events params
+type V2ListAuditEventsHandler interface {
+	Handle(V2ListAuditEventsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListAuditEvents creates a new http.Handler for the v2 list audit events operation
+func NewV2ListAuditEvents(ctx *middleware.Context, handler V2ListAuditEventsHandler) *V2ListAuditEvents {
+	return &V2ListAuditEvents{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListAuditEvents swagger:route GET /v2/audit-events audit v2ListAuditEvents
+
+Retrieves the audit log of the API requests that changed resources, the most recent first.
+*/
+type V2ListAuditEvents struct {
+	Context *middleware.Context
+	Handler V2ListAuditEventsHandler
+}
+
+func (o *V2ListAuditEvents) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListAuditEventsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,333 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2ListAuditEventsParams creates a new V2ListAuditEventsParams object
+// with the default values initialized.
+func NewV2ListAuditEventsParams() V2ListAuditEventsParams {
+
+	var (
+		// initialize parameters with default values
+
+		limitDefault = int64(100)
+	)
+
+	return V2ListAuditEventsParams{
+		Limit: &limitDefault,
+	}
+}
+
+// V2ListAuditEventsParams contains all the bound params for the v2 list audit events operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2ListAuditEvents
+type V2ListAuditEventsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The maximum number of records to retrieve.
+	  Maximum: 1000
+	  Minimum: 1
+	  In: query
+	  Default: 100
+	*/
+	Limit *int64
+	/*Number of records to skip before starting to return the records.
+	  Minimum: 0
+	  In: query
+	*/
+	Offset *int64
+	/*Only the requests of the users of this organization are retrieved.
+	  In: query
+	*/
+	OrgID *string
+	/*Only the requests on this resource are retrieved.
+	  In: query
+	*/
+	ResourceID *string
+	/*Only the requests received at or after this time are retrieved.
+	  In: query
+	*/
+	Since *strfmt.DateTime
+	/*Only the requests received before this time are retrieved.
+	  In: query
+	*/
+	Until *strfmt.DateTime
+	/*Only the requests of this user are retrieved.
+	  In: query
+	*/
+	Username *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2ListAuditEventsParams() beforehand.
+func (o *V2ListAuditEventsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOffset, qhkOffset, _ := qs.GetOK("offset")
+	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrgID, qhkOrgID, _ := qs.GetOK("org_id")
+	if err := o.bindOrgID(qOrgID, qhkOrgID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qResourceID, qhkResourceID, _ := qs.GetOK("resource_id")
+	if err := o.bindResourceID(qResourceID, qhkResourceID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSince, qhkSince, _ := qs.GetOK("since")
+	if err := o.bindSince(qSince, qhkSince, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qUntil, qhkUntil, _ := qs.GetOK("until")
+	if err := o.bindUntil(qUntil, qhkUntil, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qUsername, qhkUsername, _ := qs.GetOK("username")
+	if err := o.bindUsername(qUsername, qhkUsername, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *V2ListAuditEventsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2ListAuditEventsParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *V2ListAuditEventsParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOffset binds and validates parameter Offset from query.
+func (o *V2ListAuditEventsParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("offset", "query", "int64", raw)
+	}
+	o.Offset = &value
+
+	if err := o.validateOffset(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOffset carries on validations for parameter Offset
+func (o *V2ListAuditEventsParams) validateOffset(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("offset", "query", *o.Offset, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOrgID binds and validates parameter OrgID from query.
+func (o *V2ListAuditEventsParams) bindOrgID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.OrgID = &raw
+
+	return nil
+}
+
+// bindResourceID binds and validates parameter ResourceID from query.
+func (o *V2ListAuditEventsParams) bindResourceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.ResourceID = &raw
+
+	return nil
+}
+
+// bindSince binds and validates parameter Since from query.
+func (o *V2ListAuditEventsParams) bindSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("since", "query", "strfmt.DateTime", raw)
+	}
+	o.Since = (value.(*strfmt.DateTime))
+
+	if err := o.validateSince(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSince carries on validations for parameter Since
+func (o *V2ListAuditEventsParams) validateSince(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("since", "query", "date-time", o.Since.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindUntil binds and validates parameter Until from query.
+func (o *V2ListAuditEventsParams) bindUntil(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("until", "query", "strfmt.DateTime", raw)
+	}
+	o.Until = (value.(*strfmt.DateTime))
+
+	if err := o.validateUntil(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateUntil carries on validations for parameter Until
+func (o *V2ListAuditEventsParams) validateUntil(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("until", "query", "date-time", o.Until.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindUsername binds and validates parameter Username from query.
+func (o *V2ListAuditEventsParams) bindUsername(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Username = &raw
+
+	return nil
+}
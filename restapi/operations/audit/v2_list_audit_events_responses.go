@@ -0,0 +1,242 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListAuditEventsOKCode is the HTTP code returned for type V2ListAuditEventsOK
+const V2ListAuditEventsOKCode int = 200
+
+/*
+V2ListAuditEventsOK Success.
+
+swagger:response v2ListAuditEventsOK
+*/
+type V2ListAuditEventsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.AuditEventList `json:"body,omitempty"`
+}
+
+// NewV2ListAuditEventsOK creates V2ListAuditEventsOK with default headers values
+func NewV2ListAuditEventsOK() *V2ListAuditEventsOK {
+
+	return &V2ListAuditEventsOK{}
+}
+
+// WithPayload adds the payload to the v2 list audit events o k response
+func (o *V2ListAuditEventsOK) WithPayload(payload models.AuditEventList) *V2ListAuditEventsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list audit events o k response
+func (o *V2ListAuditEventsOK) SetPayload(payload models.AuditEventList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListAuditEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.AuditEventList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListAuditEventsBadRequestCode is the HTTP code returned for type V2ListAuditEventsBadRequest
+const V2ListAuditEventsBadRequestCode int = 400
+
+/*
+V2ListAuditEventsBadRequest Error.
+
+swagger:response v2ListAuditEventsBadRequest
+*/
+type V2ListAuditEventsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListAuditEventsBadRequest creates V2ListAuditEventsBadRequest with default headers values
+func NewV2ListAuditEventsBadRequest() *V2ListAuditEventsBadRequest {
+
+	return &V2ListAuditEventsBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 list audit events bad request response
+func (o *V2ListAuditEventsBadRequest) WithPayload(payload *models.Error) *V2ListAuditEventsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list audit events bad request response
+func (o *V2ListAuditEventsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListAuditEventsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListAuditEventsUnauthorizedCode is the HTTP code returned for type V2ListAuditEventsUnauthorized
+const V2ListAuditEventsUnauthorizedCode int = 401
+
+/*
+V2ListAuditEventsUnauthorized Unauthorized.
+
+swagger:response v2ListAuditEventsUnauthorized
+*/
+type V2ListAuditEventsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListAuditEventsUnauthorized creates V2ListAuditEventsUnauthorized with default headers values
+func NewV2ListAuditEventsUnauthorized() *V2ListAuditEventsUnauthorized {
+
+	return &V2ListAuditEventsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list audit events unauthorized response
+func (o *V2ListAuditEventsUnauthorized) WithPayload(payload *models.InfraError) *V2ListAuditEventsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list audit events unauthorized response
+func (o *V2ListAuditEventsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListAuditEventsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListAuditEventsForbiddenCode is the HTTP code returned for type V2ListAuditEventsForbidden
+const V2ListAuditEventsForbiddenCode int = 403
+
+/*
+V2ListAuditEventsForbidden Forbidden.
+
+swagger:response v2ListAuditEventsForbidden
+*/
+type V2ListAuditEventsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListAuditEventsForbidden creates V2ListAuditEventsForbidden with default headers values
+func NewV2ListAuditEventsForbidden() *V2ListAuditEventsForbidden {
+
+	return &V2ListAuditEventsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list audit events forbidden response
+func (o *V2ListAuditEventsForbidden) WithPayload(payload *models.InfraError) *V2ListAuditEventsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list audit events forbidden response
+func (o *V2ListAuditEventsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListAuditEventsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListAuditEventsInternalServerErrorCode is the HTTP code returned for type V2ListAuditEventsInternalServerError
+const V2ListAuditEventsInternalServerErrorCode int = 500
+
+/*
+V2ListAuditEventsInternalServerError Error.
+
+swagger:response v2ListAuditEventsInternalServerError
+*/
+type V2ListAuditEventsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListAuditEventsInternalServerError creates V2ListAuditEventsInternalServerError with default headers values
+func NewV2ListAuditEventsInternalServerError() *V2ListAuditEventsInternalServerError {
+
+	return &V2ListAuditEventsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list audit events internal server error response
+func (o *V2ListAuditEventsInternalServerError) WithPayload(payload *models.Error) *V2ListAuditEventsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list audit events internal server error response
+func (o *V2ListAuditEventsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListAuditEventsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
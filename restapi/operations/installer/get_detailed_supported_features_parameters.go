@@ -192,7 +192,7 @@ func (o *GetDetailedSupportedFeaturesParams) bindPlatformType(rawData []string,
 // validatePlatformType carries on validations for parameter PlatformType
 func (o *GetDetailedSupportedFeaturesParams) validatePlatformType(formats strfmt.Registry) error {
 
-	if err := validate.EnumCase("platform_type", "query", *o.PlatformType, []interface{}{"baremetal", "none", "nutanix", "vsphere", "external"}, true); err != nil {
+	if err := validate.EnumCase("platform_type", "query", *o.PlatformType, []interface{}{"baremetal", "none", "nutanix", "vsphere", "external", "openstack"}, true); err != nil {
 		return err
 	}
 
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GenerateHostStaticNetworkConfigHandlerFunc turns a function with the right signature into a v2 generate host static network config handler
+type V2GenerateHostStaticNetworkConfigHandlerFunc func(V2GenerateHostStaticNetworkConfigParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GenerateHostStaticNetworkConfigHandlerFunc) Handle(params V2GenerateHostStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GenerateHostStaticNetworkConfigHandler interface for that can handle valid v2 generate host static network config params
+type V2GenerateHostStaticNetworkConfigHandler interface {
+	Handle(V2GenerateHostStaticNetworkConfigParams, interface{}) middleware.Responder
+}
+
+// NewV2GenerateHostStaticNetworkConfig creates a new http.Handler for the v2 generate host static network config operation
+func NewV2GenerateHostStaticNetworkConfig(ctx *middleware.Context, handler V2GenerateHostStaticNetworkConfigHandler) *V2GenerateHostStaticNetworkConfig {
+	return &V2GenerateHostStaticNetworkConfig{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GenerateHostStaticNetworkConfig swagger:route POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/static-network-config installer v2GenerateHostStaticNetworkConfig
+
+Generates the static network configuration of a host from its discovered inventory, and optionally applies it to the infra-env of the host.
+*/
+type V2GenerateHostStaticNetworkConfig struct {
+	Context *middleware.Context
+	Handler V2GenerateHostStaticNetworkConfigHandler
+}
+
+func (o *V2GenerateHostStaticNetworkConfig) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GenerateHostStaticNetworkConfigParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,419 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GenerateHostStaticNetworkConfigOKCode is the HTTP code returned for type V2GenerateHostStaticNetworkConfigOK
+const V2GenerateHostStaticNetworkConfigOKCode int = 200
+
+/*
+V2GenerateHostStaticNetworkConfigOK Success.
+
+swagger:response v2GenerateHostStaticNetworkConfigOK
+*/
+type V2GenerateHostStaticNetworkConfigOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.HostStaticNetworkConfig `json:"body,omitempty"`
+}
+
+// NewV2GenerateHostStaticNetworkConfigOK creates V2GenerateHostStaticNetworkConfigOK with default headers values
+func NewV2GenerateHostStaticNetworkConfigOK() *V2GenerateHostStaticNetworkConfigOK {
+
+	return &V2GenerateHostStaticNetworkConfigOK{}
+}
+
+// WithPayload adds the payload to the v2 generate host static network config o k response
+func (o *V2GenerateHostStaticNetworkConfigOK) WithPayload(payload *models.HostStaticNetworkConfig) *V2GenerateHostStaticNetworkConfigOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 generate host static network config o k response
+func (o *V2GenerateHostStaticNetworkConfigOK) SetPayload(payload *models.HostStaticNetworkConfig) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GenerateHostStaticNetworkConfigOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GenerateHostStaticNetworkConfigBadRequestCode is the HTTP code returned for type V2GenerateHostStaticNetworkConfigBadRequest
+const V2GenerateHostStaticNetworkConfigBadRequestCode int = 400
+
+/*
+V2GenerateHostStaticNetworkConfigBadRequest Error.
+
+swagger:response v2GenerateHostStaticNetworkConfigBadRequest
+*/
+type V2GenerateHostStaticNetworkConfigBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GenerateHostStaticNetworkConfigBadRequest creates V2GenerateHostStaticNetworkConfigBadRequest with default headers values
+func NewV2GenerateHostStaticNetworkConfigBadRequest() *V2GenerateHostStaticNetworkConfigBadRequest {
+
+	return &V2GenerateHostStaticNetworkConfigBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 generate host static network config bad request response
+func (o *V2GenerateHostStaticNetworkConfigBadRequest) WithPayload(payload *models.Error) *V2GenerateHostStaticNetworkConfigBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 generate host static network config bad request response
+func (o *V2GenerateHostStaticNetworkConfigBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GenerateHostStaticNetworkConfigBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GenerateHostStaticNetworkConfigUnauthorizedCode is the HTTP code returned for type V2GenerateHostStaticNetworkConfigUnauthorized
+const V2GenerateHostStaticNetworkConfigUnauthorizedCode int = 401
+
+/*
+V2GenerateHostStaticNetworkConfigUnauthorized Unauthorized.
+
+swagger:response v2GenerateHostStaticNetworkConfigUnauthorized
+*/
+type V2GenerateHostStaticNetworkConfigUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GenerateHostStaticNetworkConfigUnauthorized creates V2GenerateHostStaticNetworkConfigUnauthorized with default headers values
+func NewV2GenerateHostStaticNetworkConfigUnauthorized() *V2GenerateHostStaticNetworkConfigUnauthorized {
+
+	return &V2GenerateHostStaticNetworkConfigUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 generate host static network config unauthorized response
+func (o *V2GenerateHostStaticNetworkConfigUnauthorized) WithPayload(payload *models.InfraError) *V2GenerateHostStaticNetworkConfigUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 generate host static network config unauthorized response
+func (o *V2GenerateHostStaticNetworkConfigUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GenerateHostStaticNetworkConfigUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GenerateHostStaticNetworkConfigForbiddenCode is the HTTP code returned for type V2GenerateHostStaticNetworkConfigForbidden
+const V2GenerateHostStaticNetworkConfigForbiddenCode int = 403
+
+/*
+V2GenerateHostStaticNetworkConfigForbidden Forbidden.
+
+swagger:response v2GenerateHostStaticNetworkConfigForbidden
+*/
+type V2GenerateHostStaticNetworkConfigForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GenerateHostStaticNetworkConfigForbidden creates V2GenerateHostStaticNetworkConfigForbidden with default headers values
+func NewV2GenerateHostStaticNetworkConfigForbidden() *V2GenerateHostStaticNetworkConfigForbidden {
+
+	return &V2GenerateHostStaticNetworkConfigForbidden{}
+}
+
+// WithPayload adds the payload to the v2 generate host static network config forbidden response
+func (o *V2GenerateHostStaticNetworkConfigForbidden) WithPayload(payload *models.InfraError) *V2GenerateHostStaticNetworkConfigForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 generate host static network config forbidden response
+func (o *V2GenerateHostStaticNetworkConfigForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GenerateHostStaticNetworkConfigForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GenerateHostStaticNetworkConfigNotFoundCode is the HTTP code returned for type V2GenerateHostStaticNetworkConfigNotFound
+const V2GenerateHostStaticNetworkConfigNotFoundCode int = 404
+
+/*
+V2GenerateHostStaticNetworkConfigNotFound Error.
+
+swagger:response v2GenerateHostStaticNetworkConfigNotFound
+*/
+type V2GenerateHostStaticNetworkConfigNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GenerateHostStaticNetworkConfigNotFound creates V2GenerateHostStaticNetworkConfigNotFound with default headers values
+func NewV2GenerateHostStaticNetworkConfigNotFound() *V2GenerateHostStaticNetworkConfigNotFound {
+
+	return &V2GenerateHostStaticNetworkConfigNotFound{}
+}
+
+// WithPayload adds the payload to the v2 generate host static network config not found response
+func (o *V2GenerateHostStaticNetworkConfigNotFound) WithPayload(payload *models.Error) *V2GenerateHostStaticNetworkConfigNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 generate host static network config not found response
+func (o *V2GenerateHostStaticNetworkConfigNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GenerateHostStaticNetworkConfigNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GenerateHostStaticNetworkConfigMethodNotAllowedCode is the HTTP code returned for type V2GenerateHostStaticNetworkConfigMethodNotAllowed
+const V2GenerateHostStaticNetworkConfigMethodNotAllowedCode int = 405
+
+/*
+V2GenerateHostStaticNetworkConfigMethodNotAllowed Method Not Allowed.
+
+swagger:response v2GenerateHostStaticNetworkConfigMethodNotAllowed
+*/
+type V2GenerateHostStaticNetworkConfigMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GenerateHostStaticNetworkConfigMethodNotAllowed creates V2GenerateHostStaticNetworkConfigMethodNotAllowed with default headers values
+func NewV2GenerateHostStaticNetworkConfigMethodNotAllowed() *V2GenerateHostStaticNetworkConfigMethodNotAllowed {
+
+	return &V2GenerateHostStaticNetworkConfigMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 generate host static network config method not allowed response
+func (o *V2GenerateHostStaticNetworkConfigMethodNotAllowed) WithPayload(payload *models.Error) *V2GenerateHostStaticNetworkConfigMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 generate host static network config method not allowed response
+func (o *V2GenerateHostStaticNetworkConfigMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GenerateHostStaticNetworkConfigMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GenerateHostStaticNetworkConfigConflictCode is the HTTP code returned for type V2GenerateHostStaticNetworkConfigConflict
+const V2GenerateHostStaticNetworkConfigConflictCode int = 409
+
+/*
+V2GenerateHostStaticNetworkConfigConflict Error.
+
+swagger:response v2GenerateHostStaticNetworkConfigConflict
+*/
+type V2GenerateHostStaticNetworkConfigConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GenerateHostStaticNetworkConfigConflict creates V2GenerateHostStaticNetworkConfigConflict with default headers values
+func NewV2GenerateHostStaticNetworkConfigConflict() *V2GenerateHostStaticNetworkConfigConflict {
+
+	return &V2GenerateHostStaticNetworkConfigConflict{}
+}
+
+// WithPayload adds the payload to the v2 generate host static network config conflict response
+func (o *V2GenerateHostStaticNetworkConfigConflict) WithPayload(payload *models.Error) *V2GenerateHostStaticNetworkConfigConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 generate host static network config conflict response
+func (o *V2GenerateHostStaticNetworkConfigConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GenerateHostStaticNetworkConfigConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GenerateHostStaticNetworkConfigInternalServerErrorCode is the HTTP code returned for type V2GenerateHostStaticNetworkConfigInternalServerError
+const V2GenerateHostStaticNetworkConfigInternalServerErrorCode int = 500
+
+/*
+V2GenerateHostStaticNetworkConfigInternalServerError Error.
+
+swagger:response v2GenerateHostStaticNetworkConfigInternalServerError
+*/
+type V2GenerateHostStaticNetworkConfigInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GenerateHostStaticNetworkConfigInternalServerError creates V2GenerateHostStaticNetworkConfigInternalServerError with default headers values
+func NewV2GenerateHostStaticNetworkConfigInternalServerError() *V2GenerateHostStaticNetworkConfigInternalServerError {
+
+	return &V2GenerateHostStaticNetworkConfigInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 generate host static network config internal server error response
+func (o *V2GenerateHostStaticNetworkConfigInternalServerError) WithPayload(payload *models.Error) *V2GenerateHostStaticNetworkConfigInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 generate host static network config internal server error response
+func (o *V2GenerateHostStaticNetworkConfigInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GenerateHostStaticNetworkConfigInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GenerateHostStaticNetworkConfigServiceUnavailableCode is the HTTP code returned for type V2GenerateHostStaticNetworkConfigServiceUnavailable
+const V2GenerateHostStaticNetworkConfigServiceUnavailableCode int = 503
+
+/*
+V2GenerateHostStaticNetworkConfigServiceUnavailable Unavailable.
+
+swagger:response v2GenerateHostStaticNetworkConfigServiceUnavailable
+*/
+type V2GenerateHostStaticNetworkConfigServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GenerateHostStaticNetworkConfigServiceUnavailable creates V2GenerateHostStaticNetworkConfigServiceUnavailable with default headers values
+func NewV2GenerateHostStaticNetworkConfigServiceUnavailable() *V2GenerateHostStaticNetworkConfigServiceUnavailable {
+
+	return &V2GenerateHostStaticNetworkConfigServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the v2 generate host static network config service unavailable response
+func (o *V2GenerateHostStaticNetworkConfigServiceUnavailable) WithPayload(payload *models.Error) *V2GenerateHostStaticNetworkConfigServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 generate host static network config service unavailable response
+func (o *V2GenerateHostStaticNetworkConfigServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GenerateHostStaticNetworkConfigServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetInfraEnvVirtualMediaBootHandlerFunc turns a function with the right signature into a v2 get infra env virtual media boot handler
+type V2GetInfraEnvVirtualMediaBootHandlerFunc func(V2GetInfraEnvVirtualMediaBootParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetInfraEnvVirtualMediaBootHandlerFunc) Handle(params V2GetInfraEnvVirtualMediaBootParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetInfraEnvVirtualMediaBootHandler interface for that can handle valid v2 get infra env virtual media boot params
+type V2GetInfraEnvVirtualMediaBootHandler interface {
+	Handle(V2GetInfraEnvVirtualMediaBootParams, interface{}) middleware.Responder
+}
+
+// NewV2GetInfraEnvVirtualMediaBoot creates a new http.Handler for the v2 get infra env virtual media boot operation
+func NewV2GetInfraEnvVirtualMediaBoot(ctx *middleware.Context, handler V2GetInfraEnvVirtualMediaBootHandler) *V2GetInfraEnvVirtualMediaBoot {
+	return &V2GetInfraEnvVirtualMediaBoot{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetInfraEnvVirtualMediaBoot swagger:route GET /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot installer v2GetInfraEnvVirtualMediaBoot
+
+Retrieves the status of the last virtual media boot of the infra-env.
+*/
+type V2GetInfraEnvVirtualMediaBoot struct {
+	Context *middleware.Context
+	Handler V2GetInfraEnvVirtualMediaBootHandler
+}
+
+func (o *V2GetInfraEnvVirtualMediaBoot) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetInfraEnvVirtualMediaBootParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetInfraEnvVirtualMediaBootOKCode is the HTTP code returned for type V2GetInfraEnvVirtualMediaBootOK
+const V2GetInfraEnvVirtualMediaBootOKCode int = 200
+
+/*
+V2GetInfraEnvVirtualMediaBootOK Success.
+
+swagger:response v2GetInfraEnvVirtualMediaBootOK
+*/
+type V2GetInfraEnvVirtualMediaBootOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraEnvVirtualMediaBoot `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvVirtualMediaBootOK creates V2GetInfraEnvVirtualMediaBootOK with default headers values
+func NewV2GetInfraEnvVirtualMediaBootOK() *V2GetInfraEnvVirtualMediaBootOK {
+
+	return &V2GetInfraEnvVirtualMediaBootOK{}
+}
+
+// WithPayload adds the payload to the v2 get infra env virtual media boot o k response
+func (o *V2GetInfraEnvVirtualMediaBootOK) WithPayload(payload *models.InfraEnvVirtualMediaBoot) *V2GetInfraEnvVirtualMediaBootOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env virtual media boot o k response
+func (o *V2GetInfraEnvVirtualMediaBootOK) SetPayload(payload *models.InfraEnvVirtualMediaBoot) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvVirtualMediaBootOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInfraEnvVirtualMediaBootBadRequestCode is the HTTP code returned for type V2GetInfraEnvVirtualMediaBootBadRequest
+const V2GetInfraEnvVirtualMediaBootBadRequestCode int = 400
+
+/*
+V2GetInfraEnvVirtualMediaBootBadRequest Error.
+
+swagger:response v2GetInfraEnvVirtualMediaBootBadRequest
+*/
+type V2GetInfraEnvVirtualMediaBootBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvVirtualMediaBootBadRequest creates V2GetInfraEnvVirtualMediaBootBadRequest with default headers values
+func NewV2GetInfraEnvVirtualMediaBootBadRequest() *V2GetInfraEnvVirtualMediaBootBadRequest {
+
+	return &V2GetInfraEnvVirtualMediaBootBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 get infra env virtual media boot bad request response
+func (o *V2GetInfraEnvVirtualMediaBootBadRequest) WithPayload(payload *models.Error) *V2GetInfraEnvVirtualMediaBootBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env virtual media boot bad request response
+func (o *V2GetInfraEnvVirtualMediaBootBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvVirtualMediaBootBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInfraEnvVirtualMediaBootUnauthorizedCode is the HTTP code returned for type V2GetInfraEnvVirtualMediaBootUnauthorized
+const V2GetInfraEnvVirtualMediaBootUnauthorizedCode int = 401
+
+/*
+V2GetInfraEnvVirtualMediaBootUnauthorized Unauthorized.
+
+swagger:response v2GetInfraEnvVirtualMediaBootUnauthorized
+*/
+type V2GetInfraEnvVirtualMediaBootUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvVirtualMediaBootUnauthorized creates V2GetInfraEnvVirtualMediaBootUnauthorized with default headers values
+func NewV2GetInfraEnvVirtualMediaBootUnauthorized() *V2GetInfraEnvVirtualMediaBootUnauthorized {
+
+	return &V2GetInfraEnvVirtualMediaBootUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get infra env virtual media boot unauthorized response
+func (o *V2GetInfraEnvVirtualMediaBootUnauthorized) WithPayload(payload *models.InfraError) *V2GetInfraEnvVirtualMediaBootUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env virtual media boot unauthorized response
+func (o *V2GetInfraEnvVirtualMediaBootUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvVirtualMediaBootUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInfraEnvVirtualMediaBootForbiddenCode is the HTTP code returned for type V2GetInfraEnvVirtualMediaBootForbidden
+const V2GetInfraEnvVirtualMediaBootForbiddenCode int = 403
+
+/*
+V2GetInfraEnvVirtualMediaBootForbidden Forbidden.
+
+swagger:response v2GetInfraEnvVirtualMediaBootForbidden
+*/
+type V2GetInfraEnvVirtualMediaBootForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvVirtualMediaBootForbidden creates V2GetInfraEnvVirtualMediaBootForbidden with default headers values
+func NewV2GetInfraEnvVirtualMediaBootForbidden() *V2GetInfraEnvVirtualMediaBootForbidden {
+
+	return &V2GetInfraEnvVirtualMediaBootForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get infra env virtual media boot forbidden response
+func (o *V2GetInfraEnvVirtualMediaBootForbidden) WithPayload(payload *models.InfraError) *V2GetInfraEnvVirtualMediaBootForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env virtual media boot forbidden response
+func (o *V2GetInfraEnvVirtualMediaBootForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvVirtualMediaBootForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInfraEnvVirtualMediaBootNotFoundCode is the HTTP code returned for type V2GetInfraEnvVirtualMediaBootNotFound
+const V2GetInfraEnvVirtualMediaBootNotFoundCode int = 404
+
+/*
+V2GetInfraEnvVirtualMediaBootNotFound Error.
+
+swagger:response v2GetInfraEnvVirtualMediaBootNotFound
+*/
+type V2GetInfraEnvVirtualMediaBootNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvVirtualMediaBootNotFound creates V2GetInfraEnvVirtualMediaBootNotFound with default headers values
+func NewV2GetInfraEnvVirtualMediaBootNotFound() *V2GetInfraEnvVirtualMediaBootNotFound {
+
+	return &V2GetInfraEnvVirtualMediaBootNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get infra env virtual media boot not found response
+func (o *V2GetInfraEnvVirtualMediaBootNotFound) WithPayload(payload *models.Error) *V2GetInfraEnvVirtualMediaBootNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env virtual media boot not found response
+func (o *V2GetInfraEnvVirtualMediaBootNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvVirtualMediaBootNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInfraEnvVirtualMediaBootMethodNotAllowedCode is the HTTP code returned for type V2GetInfraEnvVirtualMediaBootMethodNotAllowed
+const V2GetInfraEnvVirtualMediaBootMethodNotAllowedCode int = 405
+
+/*
+V2GetInfraEnvVirtualMediaBootMethodNotAllowed Method Not Allowed.
+
+swagger:response v2GetInfraEnvVirtualMediaBootMethodNotAllowed
+*/
+type V2GetInfraEnvVirtualMediaBootMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvVirtualMediaBootMethodNotAllowed creates V2GetInfraEnvVirtualMediaBootMethodNotAllowed with default headers values
+func NewV2GetInfraEnvVirtualMediaBootMethodNotAllowed() *V2GetInfraEnvVirtualMediaBootMethodNotAllowed {
+
+	return &V2GetInfraEnvVirtualMediaBootMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 get infra env virtual media boot method not allowed response
+func (o *V2GetInfraEnvVirtualMediaBootMethodNotAllowed) WithPayload(payload *models.Error) *V2GetInfraEnvVirtualMediaBootMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env virtual media boot method not allowed response
+func (o *V2GetInfraEnvVirtualMediaBootMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvVirtualMediaBootMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInfraEnvVirtualMediaBootInternalServerErrorCode is the HTTP code returned for type V2GetInfraEnvVirtualMediaBootInternalServerError
+const V2GetInfraEnvVirtualMediaBootInternalServerErrorCode int = 500
+
+/*
+V2GetInfraEnvVirtualMediaBootInternalServerError Error.
+
+swagger:response v2GetInfraEnvVirtualMediaBootInternalServerError
+*/
+type V2GetInfraEnvVirtualMediaBootInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvVirtualMediaBootInternalServerError creates V2GetInfraEnvVirtualMediaBootInternalServerError with default headers values
+func NewV2GetInfraEnvVirtualMediaBootInternalServerError() *V2GetInfraEnvVirtualMediaBootInternalServerError {
+
+	return &V2GetInfraEnvVirtualMediaBootInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get infra env virtual media boot internal server error response
+func (o *V2GetInfraEnvVirtualMediaBootInternalServerError) WithPayload(payload *models.Error) *V2GetInfraEnvVirtualMediaBootInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env virtual media boot internal server error response
+func (o *V2GetInfraEnvVirtualMediaBootInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvVirtualMediaBootInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInfraEnvVirtualMediaBootServiceUnavailableCode is the HTTP code returned for type V2GetInfraEnvVirtualMediaBootServiceUnavailable
+const V2GetInfraEnvVirtualMediaBootServiceUnavailableCode int = 503
+
+/*
+V2GetInfraEnvVirtualMediaBootServiceUnavailable Unavailable.
+
+swagger:response v2GetInfraEnvVirtualMediaBootServiceUnavailable
+*/
+type V2GetInfraEnvVirtualMediaBootServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvVirtualMediaBootServiceUnavailable creates V2GetInfraEnvVirtualMediaBootServiceUnavailable with default headers values
+func NewV2GetInfraEnvVirtualMediaBootServiceUnavailable() *V2GetInfraEnvVirtualMediaBootServiceUnavailable {
+
+	return &V2GetInfraEnvVirtualMediaBootServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the v2 get infra env virtual media boot service unavailable response
+func (o *V2GetInfraEnvVirtualMediaBootServiceUnavailable) WithPayload(payload *models.Error) *V2GetInfraEnvVirtualMediaBootServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env virtual media boot service unavailable response
+func (o *V2GetInfraEnvVirtualMediaBootServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvVirtualMediaBootServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
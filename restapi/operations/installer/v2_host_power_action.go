@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2HostPowerActionHandlerFunc turns a function with the right signature into a v2 host power action handler
+type V2HostPowerActionHandlerFunc func(V2HostPowerActionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2HostPowerActionHandlerFunc) Handle(params V2HostPowerActionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2HostPowerActionHandler interface for that can handle valid v2 host power action params
+type V2HostPowerActionHandler interface {
+	Handle(V2HostPowerActionParams, interface{}) middleware.Responder
+}
+
+// NewV2HostPowerAction creates a new http.Handler for the v2 host power action operation
+func NewV2HostPowerAction(ctx *middleware.Context, handler V2HostPowerActionHandler) *V2HostPowerAction {
+	return &V2HostPowerAction{Context: ctx, Handler: handler}
+}
+
+/*
+	V2HostPowerAction swagger:route POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/power installer v2HostPowerAction
+
+Runs a power action on the host through its BMC and returns once the BMC accepted it. The BMC credentials of the host must be set.
+*/
+type V2HostPowerAction struct {
+	Context *middleware.Context
+	Handler V2HostPowerActionHandler
+}
+
+func (o *V2HostPowerAction) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2HostPowerActionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2HostPowerActionParams creates a new V2HostPowerActionParams object
+//
+// There are no default values defined in the spec.
+func NewV2HostPowerActionParams() V2HostPowerActionParams {
+
+	return V2HostPowerActionParams{}
+}
+
+// V2HostPowerActionParams contains all the bound params for the v2 host power action operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2HostPowerAction
+type V2HostPowerActionParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The power action to run.
+	  Required: true
+	  In: body
+	*/
+	HostPowerActionParams *models.HostPowerActionParams
+	/*The host whose power is managed.
+	  Required: true
+	  In: path
+	*/
+	HostID strfmt.UUID
+	/*The infra-env of the host whose power is managed.
+	  Required: true
+	  In: path
+	*/
+	InfraEnvID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2HostPowerActionParams() beforehand.
+func (o *V2HostPowerActionParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.HostPowerActionParams
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("hostPowerActionParams", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("hostPowerActionParams", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.HostPowerActionParams = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("hostPowerActionParams", "body", ""))
+	}
+
+	rHostID, rhkHostID, _ := route.Params.GetOK("host_id")
+	if err := o.bindHostID(rHostID, rhkHostID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rInfraEnvID, rhkInfraEnvID, _ := route.Params.GetOK("infra_env_id")
+	if err := o.bindInfraEnvID(rInfraEnvID, rhkInfraEnvID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindHostID binds and validates parameter HostID from path.
+func (o *V2HostPowerActionParams) bindHostID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("host_id", "path", "strfmt.UUID", raw)
+	}
+	o.HostID = *(value.(*strfmt.UUID))
+
+	if err := o.validateHostID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateHostID carries on validations for parameter HostID
+func (o *V2HostPowerActionParams) validateHostID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("host_id", "path", "uuid", o.HostID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindInfraEnvID binds and validates parameter InfraEnvID from path.
+func (o *V2HostPowerActionParams) bindInfraEnvID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("infra_env_id", "path", "strfmt.UUID", raw)
+	}
+	o.InfraEnvID = *(value.(*strfmt.UUID))
+
+	if err := o.validateInfraEnvID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateInfraEnvID carries on validations for parameter InfraEnvID
+func (o *V2HostPowerActionParams) validateInfraEnvID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("infra_env_id", "path", "uuid", o.InfraEnvID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
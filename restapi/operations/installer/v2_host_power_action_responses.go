@@ -0,0 +1,464 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2HostPowerActionOKCode is the HTTP code returned for type V2HostPowerActionOK
+const V2HostPowerActionOKCode int = 200
+
+/*
+V2HostPowerActionOK Success.
+
+swagger:response v2HostPowerActionOK
+*/
+type V2HostPowerActionOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Host `json:"body,omitempty"`
+}
+
+// NewV2HostPowerActionOK creates V2HostPowerActionOK with default headers values
+func NewV2HostPowerActionOK() *V2HostPowerActionOK {
+
+	return &V2HostPowerActionOK{}
+}
+
+// WithPayload adds the payload to the v2 host power action o k response
+func (o *V2HostPowerActionOK) WithPayload(payload *models.Host) *V2HostPowerActionOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 host power action o k response
+func (o *V2HostPowerActionOK) SetPayload(payload *models.Host) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2HostPowerActionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2HostPowerActionBadRequestCode is the HTTP code returned for type V2HostPowerActionBadRequest
+const V2HostPowerActionBadRequestCode int = 400
+
+/*
+V2HostPowerActionBadRequest Error.
+
+swagger:response v2HostPowerActionBadRequest
+*/
+type V2HostPowerActionBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2HostPowerActionBadRequest creates V2HostPowerActionBadRequest with default headers values
+func NewV2HostPowerActionBadRequest() *V2HostPowerActionBadRequest {
+
+	return &V2HostPowerActionBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 host power action bad request response
+func (o *V2HostPowerActionBadRequest) WithPayload(payload *models.Error) *V2HostPowerActionBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 host power action bad request response
+func (o *V2HostPowerActionBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2HostPowerActionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2HostPowerActionUnauthorizedCode is the HTTP code returned for type V2HostPowerActionUnauthorized
+const V2HostPowerActionUnauthorizedCode int = 401
+
+/*
+V2HostPowerActionUnauthorized Unauthorized.
+
+swagger:response v2HostPowerActionUnauthorized
+*/
+type V2HostPowerActionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2HostPowerActionUnauthorized creates V2HostPowerActionUnauthorized with default headers values
+func NewV2HostPowerActionUnauthorized() *V2HostPowerActionUnauthorized {
+
+	return &V2HostPowerActionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 host power action unauthorized response
+func (o *V2HostPowerActionUnauthorized) WithPayload(payload *models.InfraError) *V2HostPowerActionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 host power action unauthorized response
+func (o *V2HostPowerActionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2HostPowerActionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2HostPowerActionForbiddenCode is the HTTP code returned for type V2HostPowerActionForbidden
+const V2HostPowerActionForbiddenCode int = 403
+
+/*
+V2HostPowerActionForbidden Forbidden.
+
+swagger:response v2HostPowerActionForbidden
+*/
+type V2HostPowerActionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2HostPowerActionForbidden creates V2HostPowerActionForbidden with default headers values
+func NewV2HostPowerActionForbidden() *V2HostPowerActionForbidden {
+
+	return &V2HostPowerActionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 host power action forbidden response
+func (o *V2HostPowerActionForbidden) WithPayload(payload *models.InfraError) *V2HostPowerActionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 host power action forbidden response
+func (o *V2HostPowerActionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2HostPowerActionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2HostPowerActionNotFoundCode is the HTTP code returned for type V2HostPowerActionNotFound
+const V2HostPowerActionNotFoundCode int = 404
+
+/*
+V2HostPowerActionNotFound Error.
+
+swagger:response v2HostPowerActionNotFound
+*/
+type V2HostPowerActionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2HostPowerActionNotFound creates V2HostPowerActionNotFound with default headers values
+func NewV2HostPowerActionNotFound() *V2HostPowerActionNotFound {
+
+	return &V2HostPowerActionNotFound{}
+}
+
+// WithPayload adds the payload to the v2 host power action not found response
+func (o *V2HostPowerActionNotFound) WithPayload(payload *models.Error) *V2HostPowerActionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 host power action not found response
+func (o *V2HostPowerActionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2HostPowerActionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2HostPowerActionMethodNotAllowedCode is the HTTP code returned for type V2HostPowerActionMethodNotAllowed
+const V2HostPowerActionMethodNotAllowedCode int = 405
+
+/*
+V2HostPowerActionMethodNotAllowed Method Not Allowed.
+
+swagger:response v2HostPowerActionMethodNotAllowed
+*/
+type V2HostPowerActionMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2HostPowerActionMethodNotAllowed creates V2HostPowerActionMethodNotAllowed with default headers values
+func NewV2HostPowerActionMethodNotAllowed() *V2HostPowerActionMethodNotAllowed {
+
+	return &V2HostPowerActionMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 host power action method not allowed response
+func (o *V2HostPowerActionMethodNotAllowed) WithPayload(payload *models.Error) *V2HostPowerActionMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 host power action method not allowed response
+func (o *V2HostPowerActionMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2HostPowerActionMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2HostPowerActionConflictCode is the HTTP code returned for type V2HostPowerActionConflict
+const V2HostPowerActionConflictCode int = 409
+
+/*
+V2HostPowerActionConflict Conflict.
+
+swagger:response v2HostPowerActionConflict
+*/
+type V2HostPowerActionConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2HostPowerActionConflict creates V2HostPowerActionConflict with default headers values
+func NewV2HostPowerActionConflict() *V2HostPowerActionConflict {
+
+	return &V2HostPowerActionConflict{}
+}
+
+// WithPayload adds the payload to the v2 host power action conflict response
+func (o *V2HostPowerActionConflict) WithPayload(payload *models.Error) *V2HostPowerActionConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 host power action conflict response
+func (o *V2HostPowerActionConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2HostPowerActionConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2HostPowerActionInternalServerErrorCode is the HTTP code returned for type V2HostPowerActionInternalServerError
+const V2HostPowerActionInternalServerErrorCode int = 500
+
+/*
+V2HostPowerActionInternalServerError Error.
+
+swagger:response v2HostPowerActionInternalServerError
+*/
+type V2HostPowerActionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2HostPowerActionInternalServerError creates V2HostPowerActionInternalServerError with default headers values
+func NewV2HostPowerActionInternalServerError() *V2HostPowerActionInternalServerError {
+
+	return &V2HostPowerActionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 host power action internal server error response
+func (o *V2HostPowerActionInternalServerError) WithPayload(payload *models.Error) *V2HostPowerActionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 host power action internal server error response
+func (o *V2HostPowerActionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2HostPowerActionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2HostPowerActionBadGatewayCode is the HTTP code returned for type V2HostPowerActionBadGateway
+const V2HostPowerActionBadGatewayCode int = 502
+
+/*
+V2HostPowerActionBadGateway The BMC failed to run the action.
+
+swagger:response v2HostPowerActionBadGateway
+*/
+type V2HostPowerActionBadGateway struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2HostPowerActionBadGateway creates V2HostPowerActionBadGateway with default headers values
+func NewV2HostPowerActionBadGateway() *V2HostPowerActionBadGateway {
+
+	return &V2HostPowerActionBadGateway{}
+}
+
+// WithPayload adds the payload to the v2 host power action bad gateway response
+func (o *V2HostPowerActionBadGateway) WithPayload(payload *models.Error) *V2HostPowerActionBadGateway {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 host power action bad gateway response
+func (o *V2HostPowerActionBadGateway) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2HostPowerActionBadGateway) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(502)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2HostPowerActionServiceUnavailableCode is the HTTP code returned for type V2HostPowerActionServiceUnavailable
+const V2HostPowerActionServiceUnavailableCode int = 503
+
+/*
+V2HostPowerActionServiceUnavailable Unavailable.
+
+swagger:response v2HostPowerActionServiceUnavailable
+*/
+type V2HostPowerActionServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2HostPowerActionServiceUnavailable creates V2HostPowerActionServiceUnavailable with default headers values
+func NewV2HostPowerActionServiceUnavailable() *V2HostPowerActionServiceUnavailable {
+
+	return &V2HostPowerActionServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the v2 host power action service unavailable response
+func (o *V2HostPowerActionServiceUnavailable) WithPayload(payload *models.Error) *V2HostPowerActionServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 host power action service unavailable response
+func (o *V2HostPowerActionServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2HostPowerActionServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
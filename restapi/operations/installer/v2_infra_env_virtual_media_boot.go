@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2InfraEnvVirtualMediaBootHandlerFunc turns a function with the right signature into a v2 infra env virtual media boot handler
+type V2InfraEnvVirtualMediaBootHandlerFunc func(V2InfraEnvVirtualMediaBootParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2InfraEnvVirtualMediaBootHandlerFunc) Handle(params V2InfraEnvVirtualMediaBootParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2InfraEnvVirtualMediaBootHandler interface for that can handle valid v2 infra env virtual media boot params
+type V2InfraEnvVirtualMediaBootHandler interface {
+	Handle(V2InfraEnvVirtualMediaBootParams, interface{}) middleware.Responder
+}
+
+// NewV2InfraEnvVirtualMediaBoot creates a new http.Handler for the v2 infra env virtual media boot operation
+func NewV2InfraEnvVirtualMediaBoot(ctx *middleware.Context, handler V2InfraEnvVirtualMediaBootHandler) *V2InfraEnvVirtualMediaBoot {
+	return &V2InfraEnvVirtualMediaBoot{Context: ctx, Handler: handler}
+}
+
+/*
+	V2InfraEnvVirtualMediaBoot swagger:route POST /v2/infra-envs/{infra_env_id}/actions/virtual-media-boot installer v2InfraEnvVirtualMediaBoot
+
+Boots servers from the discovery ISO of the infra-env. The ISO is mounted as Redfish virtual media through the BMC of each server, set as the next boot device and the server is powered on or rebooted. The servers are booted in the background, the status of each BMC is returned by the GET request.
+*/
+type V2InfraEnvVirtualMediaBoot struct {
+	Context *middleware.Context
+	Handler V2InfraEnvVirtualMediaBootHandler
+}
+
+func (o *V2InfraEnvVirtualMediaBoot) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2InfraEnvVirtualMediaBootParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,419 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2InfraEnvVirtualMediaBootAcceptedCode is the HTTP code returned for type V2InfraEnvVirtualMediaBootAccepted
+const V2InfraEnvVirtualMediaBootAcceptedCode int = 202
+
+/*
+V2InfraEnvVirtualMediaBootAccepted Success.
+
+swagger:response v2InfraEnvVirtualMediaBootAccepted
+*/
+type V2InfraEnvVirtualMediaBootAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraEnvVirtualMediaBoot `json:"body,omitempty"`
+}
+
+// NewV2InfraEnvVirtualMediaBootAccepted creates V2InfraEnvVirtualMediaBootAccepted with default headers values
+func NewV2InfraEnvVirtualMediaBootAccepted() *V2InfraEnvVirtualMediaBootAccepted {
+
+	return &V2InfraEnvVirtualMediaBootAccepted{}
+}
+
+// WithPayload adds the payload to the v2 infra env virtual media boot accepted response
+func (o *V2InfraEnvVirtualMediaBootAccepted) WithPayload(payload *models.InfraEnvVirtualMediaBoot) *V2InfraEnvVirtualMediaBootAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 infra env virtual media boot accepted response
+func (o *V2InfraEnvVirtualMediaBootAccepted) SetPayload(payload *models.InfraEnvVirtualMediaBoot) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InfraEnvVirtualMediaBootAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InfraEnvVirtualMediaBootBadRequestCode is the HTTP code returned for type V2InfraEnvVirtualMediaBootBadRequest
+const V2InfraEnvVirtualMediaBootBadRequestCode int = 400
+
+/*
+V2InfraEnvVirtualMediaBootBadRequest Error.
+
+swagger:response v2InfraEnvVirtualMediaBootBadRequest
+*/
+type V2InfraEnvVirtualMediaBootBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InfraEnvVirtualMediaBootBadRequest creates V2InfraEnvVirtualMediaBootBadRequest with default headers values
+func NewV2InfraEnvVirtualMediaBootBadRequest() *V2InfraEnvVirtualMediaBootBadRequest {
+
+	return &V2InfraEnvVirtualMediaBootBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 infra env virtual media boot bad request response
+func (o *V2InfraEnvVirtualMediaBootBadRequest) WithPayload(payload *models.Error) *V2InfraEnvVirtualMediaBootBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 infra env virtual media boot bad request response
+func (o *V2InfraEnvVirtualMediaBootBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InfraEnvVirtualMediaBootBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InfraEnvVirtualMediaBootUnauthorizedCode is the HTTP code returned for type V2InfraEnvVirtualMediaBootUnauthorized
+const V2InfraEnvVirtualMediaBootUnauthorizedCode int = 401
+
+/*
+V2InfraEnvVirtualMediaBootUnauthorized Unauthorized.
+
+swagger:response v2InfraEnvVirtualMediaBootUnauthorized
+*/
+type V2InfraEnvVirtualMediaBootUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2InfraEnvVirtualMediaBootUnauthorized creates V2InfraEnvVirtualMediaBootUnauthorized with default headers values
+func NewV2InfraEnvVirtualMediaBootUnauthorized() *V2InfraEnvVirtualMediaBootUnauthorized {
+
+	return &V2InfraEnvVirtualMediaBootUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 infra env virtual media boot unauthorized response
+func (o *V2InfraEnvVirtualMediaBootUnauthorized) WithPayload(payload *models.InfraError) *V2InfraEnvVirtualMediaBootUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 infra env virtual media boot unauthorized response
+func (o *V2InfraEnvVirtualMediaBootUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InfraEnvVirtualMediaBootUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InfraEnvVirtualMediaBootForbiddenCode is the HTTP code returned for type V2InfraEnvVirtualMediaBootForbidden
+const V2InfraEnvVirtualMediaBootForbiddenCode int = 403
+
+/*
+V2InfraEnvVirtualMediaBootForbidden Forbidden.
+
+swagger:response v2InfraEnvVirtualMediaBootForbidden
+*/
+type V2InfraEnvVirtualMediaBootForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2InfraEnvVirtualMediaBootForbidden creates V2InfraEnvVirtualMediaBootForbidden with default headers values
+func NewV2InfraEnvVirtualMediaBootForbidden() *V2InfraEnvVirtualMediaBootForbidden {
+
+	return &V2InfraEnvVirtualMediaBootForbidden{}
+}
+
+// WithPayload adds the payload to the v2 infra env virtual media boot forbidden response
+func (o *V2InfraEnvVirtualMediaBootForbidden) WithPayload(payload *models.InfraError) *V2InfraEnvVirtualMediaBootForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 infra env virtual media boot forbidden response
+func (o *V2InfraEnvVirtualMediaBootForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InfraEnvVirtualMediaBootForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InfraEnvVirtualMediaBootNotFoundCode is the HTTP code returned for type V2InfraEnvVirtualMediaBootNotFound
+const V2InfraEnvVirtualMediaBootNotFoundCode int = 404
+
+/*
+V2InfraEnvVirtualMediaBootNotFound Error.
+
+swagger:response v2InfraEnvVirtualMediaBootNotFound
+*/
+type V2InfraEnvVirtualMediaBootNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InfraEnvVirtualMediaBootNotFound creates V2InfraEnvVirtualMediaBootNotFound with default headers values
+func NewV2InfraEnvVirtualMediaBootNotFound() *V2InfraEnvVirtualMediaBootNotFound {
+
+	return &V2InfraEnvVirtualMediaBootNotFound{}
+}
+
+// WithPayload adds the payload to the v2 infra env virtual media boot not found response
+func (o *V2InfraEnvVirtualMediaBootNotFound) WithPayload(payload *models.Error) *V2InfraEnvVirtualMediaBootNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 infra env virtual media boot not found response
+func (o *V2InfraEnvVirtualMediaBootNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InfraEnvVirtualMediaBootNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InfraEnvVirtualMediaBootMethodNotAllowedCode is the HTTP code returned for type V2InfraEnvVirtualMediaBootMethodNotAllowed
+const V2InfraEnvVirtualMediaBootMethodNotAllowedCode int = 405
+
+/*
+V2InfraEnvVirtualMediaBootMethodNotAllowed Method Not Allowed.
+
+swagger:response v2InfraEnvVirtualMediaBootMethodNotAllowed
+*/
+type V2InfraEnvVirtualMediaBootMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InfraEnvVirtualMediaBootMethodNotAllowed creates V2InfraEnvVirtualMediaBootMethodNotAllowed with default headers values
+func NewV2InfraEnvVirtualMediaBootMethodNotAllowed() *V2InfraEnvVirtualMediaBootMethodNotAllowed {
+
+	return &V2InfraEnvVirtualMediaBootMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 infra env virtual media boot method not allowed response
+func (o *V2InfraEnvVirtualMediaBootMethodNotAllowed) WithPayload(payload *models.Error) *V2InfraEnvVirtualMediaBootMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 infra env virtual media boot method not allowed response
+func (o *V2InfraEnvVirtualMediaBootMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InfraEnvVirtualMediaBootMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InfraEnvVirtualMediaBootConflictCode is the HTTP code returned for type V2InfraEnvVirtualMediaBootConflict
+const V2InfraEnvVirtualMediaBootConflictCode int = 409
+
+/*
+V2InfraEnvVirtualMediaBootConflict Conflict.
+
+swagger:response v2InfraEnvVirtualMediaBootConflict
+*/
+type V2InfraEnvVirtualMediaBootConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InfraEnvVirtualMediaBootConflict creates V2InfraEnvVirtualMediaBootConflict with default headers values
+func NewV2InfraEnvVirtualMediaBootConflict() *V2InfraEnvVirtualMediaBootConflict {
+
+	return &V2InfraEnvVirtualMediaBootConflict{}
+}
+
+// WithPayload adds the payload to the v2 infra env virtual media boot conflict response
+func (o *V2InfraEnvVirtualMediaBootConflict) WithPayload(payload *models.Error) *V2InfraEnvVirtualMediaBootConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 infra env virtual media boot conflict response
+func (o *V2InfraEnvVirtualMediaBootConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InfraEnvVirtualMediaBootConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InfraEnvVirtualMediaBootInternalServerErrorCode is the HTTP code returned for type V2InfraEnvVirtualMediaBootInternalServerError
+const V2InfraEnvVirtualMediaBootInternalServerErrorCode int = 500
+
+/*
+V2InfraEnvVirtualMediaBootInternalServerError Error.
+
+swagger:response v2InfraEnvVirtualMediaBootInternalServerError
+*/
+type V2InfraEnvVirtualMediaBootInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InfraEnvVirtualMediaBootInternalServerError creates V2InfraEnvVirtualMediaBootInternalServerError with default headers values
+func NewV2InfraEnvVirtualMediaBootInternalServerError() *V2InfraEnvVirtualMediaBootInternalServerError {
+
+	return &V2InfraEnvVirtualMediaBootInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 infra env virtual media boot internal server error response
+func (o *V2InfraEnvVirtualMediaBootInternalServerError) WithPayload(payload *models.Error) *V2InfraEnvVirtualMediaBootInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 infra env virtual media boot internal server error response
+func (o *V2InfraEnvVirtualMediaBootInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InfraEnvVirtualMediaBootInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InfraEnvVirtualMediaBootServiceUnavailableCode is the HTTP code returned for type V2InfraEnvVirtualMediaBootServiceUnavailable
+const V2InfraEnvVirtualMediaBootServiceUnavailableCode int = 503
+
+/*
+V2InfraEnvVirtualMediaBootServiceUnavailable Unavailable.
+
+swagger:response v2InfraEnvVirtualMediaBootServiceUnavailable
+*/
+type V2InfraEnvVirtualMediaBootServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InfraEnvVirtualMediaBootServiceUnavailable creates V2InfraEnvVirtualMediaBootServiceUnavailable with default headers values
+func NewV2InfraEnvVirtualMediaBootServiceUnavailable() *V2InfraEnvVirtualMediaBootServiceUnavailable {
+
+	return &V2InfraEnvVirtualMediaBootServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the v2 infra env virtual media boot service unavailable response
+func (o *V2InfraEnvVirtualMediaBootServiceUnavailable) WithPayload(payload *models.Error) *V2InfraEnvVirtualMediaBootServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 infra env virtual media boot service unavailable response
+func (o *V2InfraEnvVirtualMediaBootServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InfraEnvVirtualMediaBootServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
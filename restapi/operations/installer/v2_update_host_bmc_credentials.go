@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2UpdateHostBmcCredentialsHandlerFunc turns a function with the right signature into a v2 update host bmc credentials handler
+type V2UpdateHostBmcCredentialsHandlerFunc func(V2UpdateHostBmcCredentialsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2UpdateHostBmcCredentialsHandlerFunc) Handle(params V2UpdateHostBmcCredentialsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2UpdateHostBmcCredentialsHandler interface for that can handle valid v2 update host bmc credentials params
+type V2UpdateHostBmcCredentialsHandler interface {
+	Handle(V2UpdateHostBmcCredentialsParams, interface{}) middleware.Responder
+}
+
+// NewV2UpdateHostBmcCredentials creates a new http.Handler for the v2 update host bmc credentials operation
+func NewV2UpdateHostBmcCredentials(ctx *middleware.Context, handler V2UpdateHostBmcCredentialsHandler) *V2UpdateHostBmcCredentials {
+	return &V2UpdateHostBmcCredentials{Context: ctx, Handler: handler}
+}
+
+/*
+	V2UpdateHostBmcCredentials swagger:route PUT /v2/infra-envs/{infra_env_id}/hosts/{host_id}/bmc-credentials installer v2UpdateHostBmcCredentials
+
+Sets the credentials of the BMC of the host, used to manage its power. The password is stored encrypted, the credentials can't be set unless an encryption key provider is configured. Only Redfish BMCs are supported.
+*/
+type V2UpdateHostBmcCredentials struct {
+	Context *middleware.Context
+	Handler V2UpdateHostBmcCredentialsHandler
+}
+
+func (o *V2UpdateHostBmcCredentials) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2UpdateHostBmcCredentialsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ValidateStaticNetworkConfigHandlerFunc turns a function with the right signature into a v2 validate static network config handler
+type V2ValidateStaticNetworkConfigHandlerFunc func(V2ValidateStaticNetworkConfigParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ValidateStaticNetworkConfigHandlerFunc) Handle(params V2ValidateStaticNetworkConfigParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ValidateStaticNetworkConfigHandler interface for that can handle valid v2 validate static network config params
+type V2ValidateStaticNetworkConfigHandler interface {
+	Handle(V2ValidateStaticNetworkConfigParams, interface{}) middleware.Responder
+}
+
+// NewV2ValidateStaticNetworkConfig creates a new http.Handler for the v2 validate static network config operation
+func NewV2ValidateStaticNetworkConfig(ctx *middleware.Context, handler V2ValidateStaticNetworkConfigHandler) *V2ValidateStaticNetworkConfig {
+	return &V2ValidateStaticNetworkConfig{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ValidateStaticNetworkConfig swagger:route POST /v2/infra-envs/validate-static-network installer v2ValidateStaticNetworkConfig
+
+Validates each host of a static network configuration independently, without creating an infra-env, and previews the NetworkManager keyfiles generated for the valid hosts.
+*/
+type V2ValidateStaticNetworkConfig struct {
+	Context *middleware.Context
+	Handler V2ValidateStaticNetworkConfigHandler
+}
+
+func (o *V2ValidateStaticNetworkConfig) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ValidateStaticNetworkConfigParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/validate"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2ValidateStaticNetworkConfigParams creates a new V2ValidateStaticNetworkConfigParams object
+//
+// There are no default values defined in the spec.
+func NewV2ValidateStaticNetworkConfigParams() V2ValidateStaticNetworkConfigParams {
+
+	return V2ValidateStaticNetworkConfigParams{}
+}
+
+// V2ValidateStaticNetworkConfigParams contains all the bound params for the v2 validate static network config operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2ValidateStaticNetworkConfig
+type V2ValidateStaticNetworkConfigParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The static network configuration to validate.
+	  Required: true
+	  In: body
+	*/
+	StaticNetworkConfigValidationParams *models.StaticNetworkConfigValidationParams
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2ValidateStaticNetworkConfigParams() beforehand.
+func (o *V2ValidateStaticNetworkConfigParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.StaticNetworkConfigValidationParams
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("staticNetworkConfigValidationParams", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("staticNetworkConfigValidationParams", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.StaticNetworkConfigValidationParams = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("staticNetworkConfigValidationParams", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
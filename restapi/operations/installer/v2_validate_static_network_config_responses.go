@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ValidateStaticNetworkConfigOKCode is the HTTP code returned for type V2ValidateStaticNetworkConfigOK
+const V2ValidateStaticNetworkConfigOKCode int = 200
+
+/*
+V2ValidateStaticNetworkConfigOK Success.
+
+swagger:response v2ValidateStaticNetworkConfigOK
+*/
+type V2ValidateStaticNetworkConfigOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.StaticNetworkConfigValidation `json:"body,omitempty"`
+}
+
+// NewV2ValidateStaticNetworkConfigOK creates V2ValidateStaticNetworkConfigOK with default headers values
+func NewV2ValidateStaticNetworkConfigOK() *V2ValidateStaticNetworkConfigOK {
+
+	return &V2ValidateStaticNetworkConfigOK{}
+}
+
+// WithPayload adds the payload to the v2 validate static network config o k response
+func (o *V2ValidateStaticNetworkConfigOK) WithPayload(payload *models.StaticNetworkConfigValidation) *V2ValidateStaticNetworkConfigOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 validate static network config o k response
+func (o *V2ValidateStaticNetworkConfigOK) SetPayload(payload *models.StaticNetworkConfigValidation) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ValidateStaticNetworkConfigOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ValidateStaticNetworkConfigBadRequestCode is the HTTP code returned for type V2ValidateStaticNetworkConfigBadRequest
+const V2ValidateStaticNetworkConfigBadRequestCode int = 400
+
+/*
+V2ValidateStaticNetworkConfigBadRequest Error.
+
+swagger:response v2ValidateStaticNetworkConfigBadRequest
+*/
+type V2ValidateStaticNetworkConfigBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ValidateStaticNetworkConfigBadRequest creates V2ValidateStaticNetworkConfigBadRequest with default headers values
+func NewV2ValidateStaticNetworkConfigBadRequest() *V2ValidateStaticNetworkConfigBadRequest {
+
+	return &V2ValidateStaticNetworkConfigBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 validate static network config bad request response
+func (o *V2ValidateStaticNetworkConfigBadRequest) WithPayload(payload *models.Error) *V2ValidateStaticNetworkConfigBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 validate static network config bad request response
+func (o *V2ValidateStaticNetworkConfigBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ValidateStaticNetworkConfigBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ValidateStaticNetworkConfigUnauthorizedCode is the HTTP code returned for type V2ValidateStaticNetworkConfigUnauthorized
+const V2ValidateStaticNetworkConfigUnauthorizedCode int = 401
+
+/*
+V2ValidateStaticNetworkConfigUnauthorized Unauthorized.
+
+swagger:response v2ValidateStaticNetworkConfigUnauthorized
+*/
+type V2ValidateStaticNetworkConfigUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ValidateStaticNetworkConfigUnauthorized creates V2ValidateStaticNetworkConfigUnauthorized with default headers values
+func NewV2ValidateStaticNetworkConfigUnauthorized() *V2ValidateStaticNetworkConfigUnauthorized {
+
+	return &V2ValidateStaticNetworkConfigUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 validate static network config unauthorized response
+func (o *V2ValidateStaticNetworkConfigUnauthorized) WithPayload(payload *models.InfraError) *V2ValidateStaticNetworkConfigUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 validate static network config unauthorized response
+func (o *V2ValidateStaticNetworkConfigUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ValidateStaticNetworkConfigUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ValidateStaticNetworkConfigForbiddenCode is the HTTP code returned for type V2ValidateStaticNetworkConfigForbidden
+const V2ValidateStaticNetworkConfigForbiddenCode int = 403
+
+/*
+V2ValidateStaticNetworkConfigForbidden Forbidden.
+
+swagger:response v2ValidateStaticNetworkConfigForbidden
+*/
+type V2ValidateStaticNetworkConfigForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ValidateStaticNetworkConfigForbidden creates V2ValidateStaticNetworkConfigForbidden with default headers values
+func NewV2ValidateStaticNetworkConfigForbidden() *V2ValidateStaticNetworkConfigForbidden {
+
+	return &V2ValidateStaticNetworkConfigForbidden{}
+}
+
+// WithPayload adds the payload to the v2 validate static network config forbidden response
+func (o *V2ValidateStaticNetworkConfigForbidden) WithPayload(payload *models.InfraError) *V2ValidateStaticNetworkConfigForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 validate static network config forbidden response
+func (o *V2ValidateStaticNetworkConfigForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ValidateStaticNetworkConfigForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ValidateStaticNetworkConfigMethodNotAllowedCode is the HTTP code returned for type V2ValidateStaticNetworkConfigMethodNotAllowed
+const V2ValidateStaticNetworkConfigMethodNotAllowedCode int = 405
+
+/*
+V2ValidateStaticNetworkConfigMethodNotAllowed Method Not Allowed.
+
+swagger:response v2ValidateStaticNetworkConfigMethodNotAllowed
+*/
+type V2ValidateStaticNetworkConfigMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ValidateStaticNetworkConfigMethodNotAllowed creates V2ValidateStaticNetworkConfigMethodNotAllowed with default headers values
+func NewV2ValidateStaticNetworkConfigMethodNotAllowed() *V2ValidateStaticNetworkConfigMethodNotAllowed {
+
+	return &V2ValidateStaticNetworkConfigMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 validate static network config method not allowed response
+func (o *V2ValidateStaticNetworkConfigMethodNotAllowed) WithPayload(payload *models.Error) *V2ValidateStaticNetworkConfigMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 validate static network config method not allowed response
+func (o *V2ValidateStaticNetworkConfigMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ValidateStaticNetworkConfigMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ValidateStaticNetworkConfigInternalServerErrorCode is the HTTP code returned for type V2ValidateStaticNetworkConfigInternalServerError
+const V2ValidateStaticNetworkConfigInternalServerErrorCode int = 500
+
+/*
+V2ValidateStaticNetworkConfigInternalServerError Error.
+
+swagger:response v2ValidateStaticNetworkConfigInternalServerError
+*/
+type V2ValidateStaticNetworkConfigInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ValidateStaticNetworkConfigInternalServerError creates V2ValidateStaticNetworkConfigInternalServerError with default headers values
+func NewV2ValidateStaticNetworkConfigInternalServerError() *V2ValidateStaticNetworkConfigInternalServerError {
+
+	return &V2ValidateStaticNetworkConfigInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 validate static network config internal server error response
+func (o *V2ValidateStaticNetworkConfigInternalServerError) WithPayload(payload *models.Error) *V2ValidateStaticNetworkConfigInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 validate static network config internal server error response
+func (o *V2ValidateStaticNetworkConfigInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ValidateStaticNetworkConfigInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ValidateStaticNetworkConfigServiceUnavailableCode is the HTTP code returned for type V2ValidateStaticNetworkConfigServiceUnavailable
+const V2ValidateStaticNetworkConfigServiceUnavailableCode int = 503
+
+/*
+V2ValidateStaticNetworkConfigServiceUnavailable Unavailable.
+
+swagger:response v2ValidateStaticNetworkConfigServiceUnavailable
+*/
+type V2ValidateStaticNetworkConfigServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ValidateStaticNetworkConfigServiceUnavailable creates V2ValidateStaticNetworkConfigServiceUnavailable with default headers values
+func NewV2ValidateStaticNetworkConfigServiceUnavailable() *V2ValidateStaticNetworkConfigServiceUnavailable {
+
+	return &V2ValidateStaticNetworkConfigServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the v2 validate static network config service unavailable response
+func (o *V2ValidateStaticNetworkConfigServiceUnavailable) WithPayload(payload *models.Error) *V2ValidateStaticNetworkConfigServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 validate static network config service unavailable response
+func (o *V2ValidateStaticNetworkConfigServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ValidateStaticNetworkConfigServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+)
+
+// V2ValidateStaticNetworkConfigURL generates an URL for the v2 validate static network config operation
+type V2ValidateStaticNetworkConfigURL struct {
+	_basePath string
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *V2ValidateStaticNetworkConfigURL) WithBasePath(bp string) *V2ValidateStaticNetworkConfigURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *V2ValidateStaticNetworkConfigURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *V2ValidateStaticNetworkConfigURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/v2/infra-envs/validate-static-network"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api/assisted-install"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *V2ValidateStaticNetworkConfigURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *V2ValidateStaticNetworkConfigURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *V2ValidateStaticNetworkConfigURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on V2ValidateStaticNetworkConfigURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on V2ValidateStaticNetworkConfigURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *V2ValidateStaticNetworkConfigURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -225,7 +225,7 @@ func (o *V2ListBundlesParams) bindPlatformType(rawData []string, hasKey bool, fo
 // validatePlatformType carries on validations for parameter PlatformType
 func (o *V2ListBundlesParams) validatePlatformType(formats strfmt.Registry) error {
 
-	if err := validate.EnumCase("platform_type", "query", *o.PlatformType, []interface{}{"baremetal", "none", "nutanix", "vsphere", "external"}, true); err != nil {
+	if err := validate.EnumCase("platform_type", "query", *o.PlatformType, []interface{}{"baremetal", "none", "nutanix", "vsphere", "external", "openstack"}, true); err != nil {
 		return err
 	}
 